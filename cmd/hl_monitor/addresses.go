@@ -0,0 +1,133 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/watchlist"
+)
+
+const addressesUsage = `usage: hl_monitor [-config cfg.toml] addresses <command> [options]
+
+读写 hl_watch_addresses（含标签、信号推送渠道偏好和备注），用于在环境间迁移监控地址。
+
+commands:
+  export [-format csv|json] [-output file]           导出全部监控地址，默认输出到标准输出
+  import [-format csv|json] [-input file] [-dry-run] 按 (player_id, address) 写入，已存在的记录被覆盖，默认读取标准输入
+
+未指定 -format 时按文件扩展名判断，无法判断时为 csv；[logger] console 开启时日志同样写到标准输出，导出时建议使用 -output`
+
+// runAddresses 执行监控地址导入导出子命令，返回进程退出码
+func runAddresses(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, addressesUsage)
+		return 2
+	}
+
+	switch args[0] {
+	case "export":
+		return runAddressesExport(args[1:])
+	case "import":
+		return runAddressesImport(args[1:])
+	default:
+		fmt.Fprintln(os.Stderr, addressesUsage)
+		return 2
+	}
+}
+
+func runAddressesExport(args []string) int {
+	fs := flag.NewFlagSet("addresses export", flag.ContinueOnError)
+	fs.Usage = func() { fmt.Fprintln(os.Stderr, addressesUsage) }
+	formatArg := fs.String("format", "", "csv / json")
+	output := fs.String("output", "", "输出文件，为空时输出到标准输出")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	format, ok := addressesFormat(*formatArg, *output)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unsupported format %q\n", *formatArg)
+		return 2
+	}
+
+	rows, err := dao.WatchAddress().ListAll()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "list watch addresses: %v\n", err)
+		return 1
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := watchlist.Export(w, format, rows); err != nil {
+		fmt.Fprintf(os.Stderr, "export: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(os.Stderr, "exported %d address(es)\n", len(rows))
+	return 0
+}
+
+func runAddressesImport(args []string) int {
+	fs := flag.NewFlagSet("addresses import", flag.ContinueOnError)
+	fs.Usage = func() { fmt.Fprintln(os.Stderr, addressesUsage) }
+	formatArg := fs.String("format", "", "csv / json")
+	input := fs.String("input", "", "输入文件，为空时读取标准输入")
+	dryRun := fs.Bool("dry-run", false, "只校验并显示条数，不写入数据库")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	format, ok := addressesFormat(*formatArg, *input)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unsupported format %q\n", *formatArg)
+		return 2
+	}
+
+	var r io.Reader = os.Stdin
+	if *input != "" {
+		f, err := os.Open(*input)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer f.Close()
+		r = f
+	}
+	rows, err := watchlist.Import(r, format)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import: %v\n", err)
+		return 1
+	}
+	if *dryRun {
+		fmt.Printf("validated %d address(es), nothing written (dry run)\n", len(rows))
+		return 0
+	}
+
+	if err := dao.WatchAddress().BatchUpsert(rows); err != nil {
+		fmt.Fprintf(os.Stderr, "write watch addresses: %v\n", err)
+		return 1
+	}
+	fmt.Printf("imported %d address(es)\n", len(rows))
+	return 0
+}
+
+// addressesFormat 确定格式：显式指定优先，其次按文件扩展名，默认 csv
+func addressesFormat(format, path string) (string, bool) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+		if !watchlist.ValidFormat(format) {
+			format = watchlist.FormatCSV
+		}
+	}
+	return format, watchlist.ValidFormat(format)
+}
@@ -3,22 +3,35 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 	"time"
 
 	"github.com/utrading/utrading-hl-monitor/internal/cache"
+	"github.com/utrading/utrading-hl-monitor/internal/capture"
 	"github.com/utrading/utrading-hl-monitor/internal/cleaner"
+	"github.com/utrading/utrading-hl-monitor/internal/openorders"
 	"github.com/utrading/utrading-hl-monitor/internal/processor"
 	"github.com/utrading/utrading-hl-monitor/internal/symbol"
 
 	"github.com/utrading/utrading-hl-monitor/config"
 	"github.com/utrading/utrading-hl-monitor/internal/address"
+	"github.com/utrading/utrading-hl-monitor/internal/addressgroup"
+	"github.com/utrading/utrading-hl-monitor/internal/addressmeta"
 	"github.com/utrading/utrading-hl-monitor/internal/dal"
 	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/leader"
 	"github.com/utrading/utrading-hl-monitor/internal/manager"
 	"github.com/utrading/utrading-hl-monitor/internal/monitor"
 	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/internal/selftest"
+	"github.com/utrading/utrading-hl-monitor/internal/valuation"
+	"github.com/utrading/utrading-hl-monitor/internal/watchdog"
+	"github.com/utrading/utrading-hl-monitor/internal/webhook"
 	"github.com/utrading/utrading-hl-monitor/internal/ws"
+	"github.com/utrading/utrading-hl-monitor/pkg/lifecycle"
 	"github.com/utrading/utrading-hl-monitor/pkg/logger"
 	"github.com/utrading/utrading-hl-monitor/pkg/sigproc"
 )
@@ -32,7 +45,8 @@ func main() {
 
 	// 加载配置
 	if err := config.Init(configFile); err != nil {
-		panic(err)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
 	cfg := config.Get()
 
@@ -45,27 +59,126 @@ func main() {
 	logger.Info().Msg("hl_monitor service starting...")
 
 	// 初始化指标
-	monitor.InitMetrics()
+	monitor.InitMetrics(nil)
+
+	// 模拟回放子命令：hl_monitor -config cfg.toml simulate -input capture.ndjson [-speed 10x]
+	// 默认写入内存 SQLite，由子命令自行初始化存储
+	if flag.Arg(0) == "simulate" {
+		code := runSimulate(cfg, flag.Args()[1:])
+		dal.CloseMySQL()
+		logger.Close()
+		os.Exit(code)
+	}
 
 	// 初始化数据库
-	dal.InitMysqlDB(cfg.MySQL)
+	initStorage(cfg)
+
+	// 数据库迁移子命令：hl_monitor -config cfg.toml migrate <up|down|version|force>
+	if flag.Arg(0) == "migrate" {
+		code := runMigrate(flag.Args()[1:])
+		dal.CloseMySQL()
+		logger.Close()
+		os.Exit(code)
+	}
 
-	// 自动迁移表结构
-	dal.AutoMigrate()
+	// 检查表结构版本，auto_migrate 开启时先执行待执行的迁移
+	if err := dal.EnsureSchema(cfg.Storage.AutoMigrate); err != nil {
+		logger.Fatal().Err(err).Msg("database schema check failed")
+	}
+
+	// 数据库熔断：连续连接失败后快速失败，批量写入转入内存缓冲
+	if err := dal.EnableBreaker(cfg.Storage); err != nil {
+		logger.Fatal().Err(err).Msg("enable database breaker failed")
+	}
 
 	// 初始化 DAO
 	dao.InitDAO(dal.MySQL())
 
+	// 监控地址导入导出子命令：hl_monitor -config cfg.toml addresses <export|import> [-format csv|json]
+	if flag.Arg(0) == "addresses" {
+		code := runAddresses(flag.Args()[1:])
+		dal.CloseMySQL()
+		logger.Close()
+		os.Exit(code)
+	}
+
+	// symbol 改名子命令：hl_monitor -config cfg.toml symbols <list|rename> [-asset-type futures|spot] OLD NEW
+	if flag.Arg(0) == "symbols" {
+		code := runSymbols(cfg, flag.Args()[1:])
+		dal.CloseMySQL()
+		logger.Close()
+		os.Exit(code)
+	}
+
+	// 生命周期管理：组件按依赖顺序启动，按相反顺序停止
+	lc := lifecycle.NewManager(lifecycle.DefaultStopTimeout)
+	lc.MustRegister(lifecycle.Component{
+		Name: "mysql",
+		Stop: lifecycle.Func(dal.CloseMySQL),
+	})
+	lc.MustRegister(lifecycle.Component{
+		Name: "config",
+		Stop: lifecycle.Func(config.Stop),
+	})
+
 	// 创建数据清理器
 	dataCleaner := cleaner.NewCleaner(dal.MySQL())
-	dataCleaner.Start()
+	lc.MustRegister(lifecycle.Component{
+		Name:      "cleaner",
+		DependsOn: []string{"mysql"},
+		Start:     func(context.Context) error { dataCleaner.Start(); return nil },
+		Stop:      lifecycle.Func(dataCleaner.Stop),
+	})
 
 	// 初始化 NATS（带自动重连）
 	publisher, err := nats.NewPublisher(cfg.NATS)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("init nats publisher failed")
 	}
-	defer publisher.Close()
+	lc.MustRegister(lifecycle.Component{
+		Name: "nats",
+		Stop: func(context.Context) error { return publisher.Close() },
+	})
+
+	// 信号发布器，启用 webhook 时在 NATS 发布成功后同时推送到 HTTP 端点
+	var signalPublisher manager.Publisher = publisher
+	signalDeps := []string{"nats"}
+	if cfg.Webhook.Enabled && len(cfg.Webhook.Endpoints) > 0 {
+		webhookSink := webhook.NewSink(cfg.Webhook)
+		webhookSink.Start()
+		signalPublisher = webhookSink.Wrap(publisher)
+		lc.MustRegister(lifecycle.Component{
+			Name:      "webhook",
+			DependsOn: []string{"nats"},
+			Stop:      lifecycle.Func(webhookSink.Stop),
+		})
+		signalDeps = append(signalDeps, "webhook")
+	}
+
+	// 地址分组（可选）：信号额外发布到所属分组的主题，消费者按通配符订阅
+	if cfg.AddressGroups.Enabled {
+		groupRouter := addressgroup.NewRouter(cfg.AddressGroups)
+		publisher.SetSubjectRouter(groupRouter)
+		lc.MustRegister(lifecycle.Component{
+			Name:      "address_groups",
+			DependsOn: []string{"mysql", "nats"},
+			Start:     func(context.Context) error { return groupRouter.Start() },
+			Stop:      lifecycle.Func(groupRouter.Stop),
+		})
+		signalDeps = append(signalDeps, "address_groups")
+	}
+
+	// 信号级别（可选）：critical 信号额外发布到告警主题
+	if cfg.Severity.Enabled {
+		publisher.SetCriticalSubject(cfg.Severity.CriticalSubject)
+	}
+
+	// 自检心跳（可选）：测试地址的信号只发布到自检主题，需包在最外层
+	var heartbeat *selftest.Heartbeat
+	if cfg.SelfTest.Enabled {
+		heartbeat = selftest.NewHeartbeat(cfg.SelfTest, publisher)
+		signalPublisher = heartbeat.Wrap(signalPublisher)
+	}
 
 	// 初始化 WebSocket
 	ctx, cancel := context.WithCancel(context.Background())
@@ -77,52 +190,420 @@ func main() {
 		cfg.HLMonitor.MaxConnections,
 		cfg.HLMonitor.MaxSubscriptionsPerConnection,
 	)
+	wsPoolManager.SetCompression(cfg.HLMonitor.WSCompression)
+	wsPoolManager.SetHeartbeat(cfg.HLMonitor.WSPingInterval, cfg.HLMonitor.WSReadTimeout)
+	if len(cfg.HLMonitor.WSHeaders) > 0 || cfg.HLMonitor.WSProxy != "" {
+		wsHeaders := make(http.Header, len(cfg.HLMonitor.WSHeaders))
+		for k, v := range cfg.HLMonitor.WSHeaders {
+			wsHeaders.Set(k, v)
+		}
+		var wsProxy *url.URL
+		if cfg.HLMonitor.WSProxy != "" {
+			if wsProxy, err = url.Parse(cfg.HLMonitor.WSProxy); err != nil {
+				logger.Fatal().Err(err).Msg("parse ws proxy failed")
+			}
+		}
+		wsPoolManager.SetDialOptions(wsHeaders, wsProxy)
+	}
+	wsPoolManager.Traffic().SetObserver(monitor.AddWSReceivedBytes)
+	if cfg.SchemaCheck.Enabled {
+		schemaChecker := ws.NewSchemaChecker(cfg.SchemaCheck.Every, cfg.SchemaCheck.LogInterval)
+		schemaChecker.SetObserver(monitor.IncWSSchemaDrift)
+		wsPoolManager.SetSchemaChecker(schemaChecker)
+	}
+	// 原始消息录制（可选）：用于 simulate 回放和事故排查
+	if cfg.Capture.Enabled {
+		captureStorage, err := capture.NewStorage(cfg.Capture)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("init capture storage failed")
+		}
+		capturer := capture.NewCapturer(cfg.Capture, captureStorage)
+		wsPoolManager.SetMessageTap(capturer.Capture)
+		lc.MustRegister(lifecycle.Component{
+			Name:  "capture",
+			Start: func(context.Context) error { return capturer.Start() },
+			Stop:  lifecycle.Func(capturer.Stop),
+		})
+	}
 	if err = wsPoolManager.Start(ctx); err != nil {
 		logger.Fatal().Err(err).Msg("start ws pool manager failed")
 	}
+	lc.MustRegister(lifecycle.Component{
+		Name: "ws_pool",
+		Stop: func(context.Context) error { return wsPoolManager.Close() },
+	})
 
 	// 创建 Symbol 管理器（内部会加载 Symbol 数据）
-	symbolManager, err := symbol.NewManager()
+	symbolManager, err := symbol.NewManager(cfg.Symbol)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("init symbol manager failed")
 	}
-	defer symbolManager.Close()
+	// 按改名映射替换 symbol，与已改写的历史记录保持一致
+	if cfg.Symbol.ApplyRenames {
+		if err = applySymbolRenames(symbolManager); err != nil {
+			logger.Warn().Err(err).Msg("load symbol renames failed")
+		}
+	}
+	lc.MustRegister(lifecycle.Component{
+		Name: "symbol",
+		Stop: func(context.Context) error { return symbolManager.Close() },
+	})
+
+	// 排空阶段（消息队列、发送队列、批量写入缓冲）共享的总截止时间，收到关闭信号时开始计时
+	drainCtx := context.Background()
 
 	// 创建批量写入器
-	batchWriter := processor.NewBatchWriter(nil)
+	batchWriter := newBatchWriter(cfg)
 	batchWriter.Start()
+	lc.MustRegister(lifecycle.Component{
+		Name:      "batch_writer",
+		DependsOn: []string{"mysql"},
+		Stop: func(context.Context) error {
+			processor.ReportDrain("batch_writer", batchWriter.StopAndDrain(drainCtx))
+			return nil
+		},
+		Timeout: cfg.Shutdown.DrainTimeout,
+	})
 
 	// 初始化仓位管理器（监听仓位变化，使用 ws.PoolManager）
-	posManager := manager.NewPositionManager(wsPoolManager, symbolManager.PriceCache(), symbolManager.SymbolCache(), batchWriter)
+	posManager := newPositionManager(cfg, wsPoolManager, symbolManager, batchWriter)
+	lc.MustRegister(lifecycle.Component{
+		Name:      "position_manager",
+		DependsOn: []string{"ws_pool", "symbol", "batch_writer"},
+		Stop:      func(context.Context) error { return posManager.Shutdown(drainCtx) },
+		Timeout:   cfg.Shutdown.DrainTimeout,
+	})
 
 	// 获取仓位余额缓存（从 PositionManager 传递给 SubscriptionManager）
 	positionBalanceCache := posManager.PositionBalanceCache()
+	positionBalanceCache.SetStaleTTL(cfg.PositionRate.StaleTTL)
 
 	// 创建 PairCategory 缓存（启动时加载并定时刷新）
 	pairCategoryCache := cache.NewPairCategoryCache()
 	pairCategoryCache.Start()
 
 	// 初始化订阅管理器（监听订单成交，也使用 ws.PoolManager）
-	subManager := manager.NewSubscriptionManager(wsPoolManager, publisher, symbolManager.SymbolCache(), positionBalanceCache, pairCategoryCache, batchWriter)
+	subManager := manager.NewSubscriptionManager(wsPoolManager, signalPublisher, symbolManager.SymbolCache(), positionBalanceCache, pairCategoryCache, batchWriter)
+	if err = configureSubscriptionManager(cfg, subManager, symbolManager); err != nil {
+		logger.Fatal().Err(err).Msg("configure subscription manager failed")
+	}
+	subManager.SetCancelPublisher(publisher)
+	subManager.SetDelistedPublisher(publisher)
+	symbolManager.SetDelistedHandler(subManager.HandleAssetsDelisted)
+	subManager.StartCacheStatsLog(cfg.HLMonitor.CacheStatsLogInterval)
+	if cfg.OrderAggregation.LeverageLookup {
+		subManager.SetLeverageSource(symbolManager.Info())
+	}
+	subscribeThrottle := manager.NewSubscribeThrottle(cfg.SubscribeThrottle)
+	subManager.SetSubscribeThrottle(subscribeThrottle)
+	posManager.SetSubscribeThrottle(subscribeThrottle)
+
+	// 成交高水位：重启后跳过订阅快照中已处理过的成交
+	var fillWatermarks *manager.FillWatermarks
+	if cfg.FillWatermark.Enabled {
+		fillWatermarks = manager.NewFillWatermarks(cfg.FillWatermark)
+		subManager.SetFillWatermarks(fillWatermarks)
+	}
+	// 订单状态预标记持久化（可选）：终止状态先于成交到达时，重启后仍能在成交到达时立即发送
+	var statusTracker *processor.PersistentStatusTracker
+	if cfg.StatusTracker.Persist {
+		statusTracker = processor.NewPersistentStatusTracker(cfg.StatusTracker)
+		subManager.SetStatusTracker(statusTracker)
+		lc.MustRegister(lifecycle.Component{
+			Name:      "status_tracker",
+			DependsOn: []string{"mysql"},
+			Start:     func(context.Context) error { return statusTracker.Start() },
+			Stop:      lifecycle.Func(statusTracker.Stop),
+		})
+	}
+
+	// 原始成交留存（可选）
+	if cfg.Fills.Enabled {
+		subManager.SetPersistFills(true)
+		dataCleaner.SetFillRetention(cfg.Fills.Retention)
+	}
+
+	// 成交对账（可选）：每日核对 hl_fills 与订单聚合、已发布信号的数量，差异写入 hl_fill_discrepancies
+	if cfg.FillReconcile.Enabled {
+		dataCleaner.SetAggregationRetention(cfg.FillReconcile.AggregationRetention)
+		fillReconciler := manager.NewFillReconciler(cfg.FillReconcile)
+		lc.MustRegister(lifecycle.Component{
+			Name:      "fill_reconciler",
+			DependsOn: []string{"mysql"},
+			Start:     func(context.Context) error { fillReconciler.Start(); return nil },
+			Stop:      lifecycle.Func(fillReconciler.Stop),
+		})
+	}
+
+	// 地址每日盈亏（可选）
+	var pnlTracker *manager.PnLTracker
+	if cfg.PnL.Enabled {
+		pnlTracker = manager.NewPnLTracker(cfg.PnL, positionBalanceCache)
+		subManager.SetPnLRecorder(pnlTracker)
+		lc.MustRegister(lifecycle.Component{
+			Name:      "pnl_tracker",
+			DependsOn: []string{"mysql"},
+			Start:     func(context.Context) error { return pnlTracker.Start() },
+			Stop:      lifecycle.Func(pnlTracker.Stop),
+		})
+	}
+
+	// 信号每日统计（可选）：定期从 hl_address_signals 汇总到 hl_signal_daily_stats
+	if cfg.SignalStats.Enabled {
+		signalStats := manager.NewSignalStatsRollup(cfg.SignalStats)
+		lc.MustRegister(lifecycle.Component{
+			Name:      "signal_stats",
+			DependsOn: []string{"mysql"},
+			Start:     func(context.Context) error { signalStats.Start(); return nil },
+			Stop:      lifecycle.Func(signalStats.Stop),
+		})
+	}
+
+	// 信号发件箱（可选）：信号与订单聚合同事务写库，由分发器发布到 NATS
+	var signalOutbox *processor.SignalOutbox
+	if cfg.Outbox.Enabled {
+		signalOutbox = processor.NewSignalOutbox(cfg.Outbox, signalPublisher)
+		subManager.SetSignalOutbox(signalOutbox)
+		lc.MustRegister(lifecycle.Component{
+			Name:      "signal_outbox",
+			DependsOn: append([]string{"mysql"}, signalDeps...),
+			Start:     func(context.Context) error { return signalOutbox.Start() },
+			Stop:      lifecycle.Func(signalOutbox.Stop),
+		})
+	}
+	posManager.SetOpenOrderTracker(subManager)
+
+	// 挂单镜像（可选）：webData2 挂单快照 + orderUpdates 增量维护 hl_open_orders
+	subDeps := append([]string{"position_manager"}, signalDeps...)
+	if pnlTracker != nil {
+		subDeps = append(subDeps, "pnl_tracker") // 订阅管理器先停止，盈亏统计写入最后的增量
+	}
+	if signalOutbox != nil {
+		subDeps = append(subDeps, "signal_outbox") // 订阅管理器先停止，发件箱发布剩余信号
+	}
+	if statusTracker != nil {
+		subDeps = append(subDeps, "status_tracker") // 启动时先加载标记，停止时写入剩余变更
+	}
+	if cfg.OpenOrders.Enabled {
+		openOrderMirror := openorders.NewMirror(cfg.OpenOrders, symbolManager.SymbolCache())
+		subManager.SetOpenOrderMirror(openOrderMirror)
+		lc.MustRegister(lifecycle.Component{
+			Name:      "open_orders",
+			DependsOn: []string{"mysql"},
+			Start:     func(context.Context) error { return openOrderMirror.Start() },
+			Stop:      lifecycle.Func(openOrderMirror.Stop),
+		})
+		subDeps = append(subDeps, "open_orders")
+	}
+
+	lc.MustRegister(lifecycle.Component{
+		Name:      "subscription_manager",
+		DependsOn: subDeps,
+		Stop:      func(context.Context) error { return subManager.Shutdown(drainCtx) },
+		Timeout:   cfg.Shutdown.DrainTimeout,
+	})
+
+	// 地址标签（可选）：已知实体标签与金库名称，附加到信号和调试接口
+	var metaResolver *addressmeta.Resolver
+	if cfg.AddressMeta.Enabled {
+		var vaults addressmeta.VaultFetcher
+		if cfg.AddressMeta.ResolveVaults {
+			vaults = symbolManager.Info()
+		}
+		metaResolver = addressmeta.NewResolver(cfg.AddressMeta, vaults)
+		subManager.SetAddressLabeler(metaResolver)
+		lc.MustRegister(lifecycle.Component{
+			Name:  "address_meta",
+			Start: func(context.Context) error { metaResolver.Start(); return nil },
+			Stop:  lifecycle.Func(metaResolver.Stop),
+		})
+	}
+
+	if heartbeat != nil {
+		heartbeat.SetInjector(subManager)
+		lc.MustRegister(lifecycle.Component{
+			Name:      "selftest",
+			DependsOn: []string{"subscription_manager", "batch_writer", "nats", "mysql"},
+			Start:     func(context.Context) error { return heartbeat.Start() },
+			Stop:      lifecycle.Func(heartbeat.Stop),
+		})
+	}
+
+	// 看门狗（可选）：后台循环心跳停滞时记录日志和指标，开启 restart 时只重启该组件
+	if cfg.Watchdog.Enabled {
+		supervisor := watchdog.NewSupervisor(cfg.Watchdog)
+		components := append(subManager.OrderProcessor().WatchdogComponents(), batchWriter.WatchdogComponents()...)
+		components = append(components, watchdog.Component{
+			Name:    "dispatcher",
+			Probe:   wsPoolManager.ProbeDispatcher,
+			Restart: wsPoolManager.RestartDispatcher,
+		})
+		for _, c := range components {
+			supervisor.Watch(c)
+		}
+		lc.MustRegister(lifecycle.Component{
+			Name:      "watchdog",
+			DependsOn: []string{"subscription_manager", "batch_writer", "ws_pool"},
+			Start:     func(context.Context) error { supervisor.Start(); return nil },
+			Stop:      lifecycle.Func(supervisor.Stop),
+		})
+	}
 
 	// 加载已发送的订单到去重缓存（防止服务重启后重复处理）
+	subManager.SetDedupMaxEntries(cfg.HLMonitor.DedupMaxEntries)
 	deduper := subManager.GetDeduper()
 	if err = deduper.LoadFromDB(dao.OrderAggregation()); err != nil {
 		logger.Warn().Err(err).Msg("failed to load sent orders to dedup cache")
 	}
 
+	// 恢复未发送的聚合（成交水位已越过这些成交，快照不会重放）
+	if _, err = subManager.OrderProcessor().RestorePending(dao.OrderAggregation()); err != nil {
+		logger.Warn().Err(err).Msg("failed to restore pending order aggregations")
+	}
+
+	// 按服务实例划分去重作用域（可选）
+	var addressScopes *cache.AddressScopes
+	if cfg.HLMonitor.DedupScopeByServer {
+		addressScopes = cache.NewAddressScopes()
+		subManager.SetAddressScopes(addressScopes)
+	}
+
+	// 热备模式：备实例保持连接和缓存，取得租约后才发送信号
+	var elector *leader.Elector
+	if cfg.HA.Enabled {
+		elector = leader.NewElector(cfg.HA)
+		subManager.SetLeaderChecker(elector)
+		if signalOutbox != nil {
+			signalOutbox.SetLeaderChecker(elector)
+		}
+		if heartbeat != nil {
+			heartbeat.SetLeaderChecker(elector)
+		}
+		// 依赖订阅管理器：先于订阅管理器停止，释放租约后备实例立即接管
+		lc.MustRegister(lifecycle.Component{
+			Name:      "leader",
+			DependsOn: []string{"subscription_manager", "mysql"},
+			Start:     func(context.Context) error { elector.Start(); return nil },
+			Stop:      lifecycle.Func(elector.Stop),
+		})
+	}
+
+	// 成交订阅者与仓位订阅者
+	fillSubs := []address.AddressSubscriber{subManager}
+	positionSubs := []address.AddressSubscriber{posManager}
+	loaderDeps := []string{"subscription_manager", "position_manager"}
+
+	// 外部队列模式：接入层与处理层通过 NATS JetStream 解耦，可独立部署和扩容
+	if cfg.Queue.Mode == processor.QueueModeNATS {
+		broker, err := nats.NewJetStreamBroker(publisher.Conn, cfg.Queue)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("init jetstream broker failed")
+		}
+		queue := processor.NewExternalQueue(broker, cfg.Queue.Partitions)
+		if cfg.Queue.Role != processor.QueueRoleIngest {
+			queue.SetHandler(subManager.OrderProcessor())
+			if err = queue.Start(cfg.Queue.ConsumePartitions); err != nil {
+				logger.Fatal().Err(err).Msg("start external queue failed")
+			}
+		}
+		subManager.SetQueue(queue)
+
+		switch cfg.Queue.Role {
+		case processor.QueueRoleIngest:
+			positionSubs = nil // 接入层不处理仓位
+		case processor.QueueRoleProcess:
+			fillSubs = nil // 处理层不订阅成交，仅维护仓位缓存
+		}
+
+		logger.Info().Str("role", cfg.Queue.Role).Int("partitions", cfg.Queue.Partitions).Msg("external queue mode enabled")
+	}
+
+	// 地址订阅者，启用休眠策略时由 DormancyManager 包装
+	subscribers := append(append([]address.AddressSubscriber{}, fillSubs...), positionSubs...)
+	if cfg.Dormancy.Enabled {
+		dormancyManager := address.NewDormancyManager(
+			fillSubs,
+			positionSubs,
+			symbolManager.Info(),
+			cfg.Dormancy,
+		)
+		subManager.SetActivityRecorder(dormancyManager)
+		posManager.SetActivityRecorder(dormancyManager)
+		subscribers = []address.AddressSubscriber{dormancyManager}
+		// 停止时持久化活跃时间
+		lc.MustRegister(lifecycle.Component{
+			Name:      "dormancy",
+			DependsOn: []string{"subscription_manager", "position_manager", "mysql"},
+			Start:     func(context.Context) error { return dormancyManager.Start() },
+			Stop:      lifecycle.Func(dormancyManager.Stop),
+		})
+		loaderDeps = append(loaderDeps, "dormancy")
+	}
+
+	// 地址标签不受休眠影响，始终跟随加载器
+	if metaResolver != nil {
+		subscribers = append(subscribers, metaResolver)
+		loaderDeps = append(loaderDeps, "address_meta")
+	}
+
+	// 充值/提现/转账监控（可选），与成交订阅同在接入层，不受休眠影响
+	if cfg.Transfers.Enabled && fillSubs != nil {
+		transferMonitor := manager.NewTransferMonitor(wsPoolManager, publisher, cfg.Transfers)
+		transferMonitor.SetSubscribeThrottle(subscribeThrottle)
+		if metaResolver != nil {
+			transferMonitor.SetAddressLabeler(metaResolver)
+		}
+		if elector != nil {
+			transferMonitor.SetLeaderChecker(elector)
+		}
+		lc.MustRegister(lifecycle.Component{
+			Name:      "transfers",
+			DependsOn: []string{"ws_pool", "nats"},
+			Stop:      func(context.Context) error { return transferMonitor.Close() },
+		})
+		subscribers = append(subscribers, transferMonitor)
+		loaderDeps = append(loaderDeps, "transfers")
+	}
+
+	// 成交高水位需在订阅地址前加载
+	if fillWatermarks != nil {
+		lc.MustRegister(lifecycle.Component{
+			Name:      "fill_watermark",
+			DependsOn: []string{"mysql"},
+			Start:     func(context.Context) error { return fillWatermarks.Start() },
+			Stop:      lifecycle.Func(fillWatermarks.Stop),
+		})
+		loaderDeps = append(loaderDeps, "fill_watermark")
+	}
+
 	// 初始化地址加载器（从 hl_watch_addresses 表加载）
 	addrLoader := address.NewAddressLoader(
-		[]address.AddressSubscriber{subManager, posManager},
+		subscribers,
 		cfg.HLMonitor.AddressReloadInterval,
 		cfg.HLMonitor.AddressRemoveGrace,
 	)
 
-	// 启动地址加载器
-	if err = addrLoader.Start(); err != nil {
-		logger.Fatal().Err(err).Msg("start address loader failed")
+	if addressScopes != nil {
+		addrLoader.SetAddressScopes(addressScopes)
 	}
 
+	// 订单聚合策略：hl_watch_addresses.aggregation 标记为 scalper 的地址逐笔发送，swing 使用更长的聚合超时
+	aggregations := processor.NewAggregationStrategies(cfg.OrderAggregation.SwingTimeout)
+	subManager.SetAggregationStrategies(aggregations)
+	addrLoader.SetAggregationAssigner(aggregations)
+
+	// 信号过滤：hl_watch_addresses.signal_filter 限定地址只发送开仓/平仓或合约/现货信号
+	signalFilters := processor.NewSignalFilters()
+	subManager.SetSignalFilters(signalFilters)
+	addrLoader.SetSignalFilterAssigner(signalFilters)
+
+	lc.MustRegister(lifecycle.Component{
+		Name:      "address_loader",
+		DependsOn: append(loaderDeps, "mysql"),
+		Start:     func(context.Context) error { return addrLoader.Start() },
+		Stop:      lifecycle.Func(addrLoader.Stop),
+	})
+
 	// 初始化健康检查服务器
 	healthServer := monitor.NewHealthServer(
 		cfg.HLMonitor.HealthServerAddr,
@@ -130,10 +611,96 @@ func main() {
 		wsPoolManager,
 		publisher,
 	)
-	if err = healthServer.Start(ctx); err != nil {
-		logger.Fatal().Err(err).Msg("start health server failed")
+	lc.MustRegister(lifecycle.Component{
+		Name:      "health_server",
+		DependsOn: []string{"subscription_manager"},
+		Start:     healthServer.Start,
+		Stop:      healthServer.Stop,
+	})
+
+	// 启动上游 API 健康探测
+	upstreamProbe := monitor.NewUpstreamProbe(symbolManager.Info(), cfg.HLMonitor.UpstreamProbeInterval)
+	healthServer.SetUpstream(upstreamProbe)
+	lc.MustRegister(lifecycle.Component{
+		Name:  "upstream_probe",
+		Start: func(context.Context) error { upstreamProbe.Start(); return nil },
+		Stop:  lifecycle.Func(upstreamProbe.Stop),
+	})
+
+	// 采集监控账户 REST 请求额度（可选）
+	if cfg.HLMonitor.RateLimitAddress != "" {
+		rateLimitCollector := monitor.NewRateLimitCollector(symbolManager.Info(), cfg.HLMonitor.RateLimitAddress, cfg.HLMonitor.RateLimitInterval)
+		lc.MustRegister(lifecycle.Component{
+			Name:  "rate_limit_collector",
+			Start: func(context.Context) error { rateLimitCollector.Start(); return nil },
+			Stop:  lifecycle.Func(rateLimitCollector.Stop),
+		})
+	}
+	healthServer.SetSubscriptions(wsPoolManager)
+	healthServer.SetCacheStats(subManager)
+	healthServer.SetAdminToken(cfg.HLMonitor.AdminToken)
+	healthServer.SetSecurity(monitor.ServerSecurity{
+		TLSCertFile:  cfg.HealthServer.TLSCertFile,
+		TLSKeyFile:   cfg.HealthServer.TLSKeyFile,
+		ClientCAFile: cfg.HealthServer.ClientCAFile,
+		AuthToken:    cfg.HealthServer.AuthToken,
+		AuthExempt:   cfg.HealthServer.AuthExempt,
+	})
+	healthServer.SetReadinessChecks(monitor.ReadinessChecks{
+		MySQL:           cfg.HealthServer.ReadyCheckMySQL,
+		NATS:            cfg.HealthServer.ReadyCheckNATS,
+		MaxWriteBacklog: cfg.HealthServer.ReadyMaxWriteBacklog,
+		MaxQueueDepth:   cfg.HealthServer.ReadyMaxQueueDepth,
+	}, monitor.PingFunc(dal.Ping), batchWriter, subManager)
+	if cfg.HLMonitor.SignalStreamMaxClients > 0 {
+		signalStream := monitor.NewSignalStream(cfg.HLMonitor.SignalStreamMaxClients)
+		publisher.SetSignalStream(signalStream)
+		healthServer.SetSignalStream(signalStream)
+	}
+	healthServer.AddPausable("subscription_manager", subManager)
+	healthServer.AddPausable("position_manager", posManager)
+	healthServer.SetSubscriptionControl(addrLoader)
+	if metaResolver != nil {
+		healthServer.SetAddressLabeler(metaResolver)
+	}
+	if elector != nil {
+		healthServer.SetLeader(elector)
+	}
+	if pnlTracker != nil {
+		healthServer.SetPnLStats(pnlTracker)
+	}
+
+	// NATS 控制面（可选）：通过消息总线添加/移除地址、查询统计、手动发送聚合
+	if cfg.Control.Enabled {
+		controlServer := nats.NewControlServer(publisher.Conn, cfg.Control)
+		controlServer.SetAddressControl(addrLoader)
+		controlServer.SetOrderFlusher(subManager.OrderProcessor())
+		controlServer.AddStats("subscriptions", subManager)
+		controlServer.AddStats("positions", posManager)
+		controlServer.AddStats("ws_pool", wsPoolManager)
+		lc.MustRegister(lifecycle.Component{
+			Name:      "nats_control",
+			DependsOn: []string{"nats", "address_loader"},
+			Start:     func(context.Context) error { return controlServer.Start() },
+			Stop:      lifecycle.Func(controlServer.Stop),
+		})
+	}
+
+	// 启动仓位对账（可选）
+	if cfg.Reconcile.Enabled {
+		reconciler := manager.NewReconciler(posManager, symbolManager.Info(), cfg.Reconcile)
+		reconciler.SetDexRegistry(symbolManager.DexRegistry())
+		lc.MustRegister(lifecycle.Component{
+			Name:      "reconciler",
+			DependsOn: []string{"position_manager"},
+			Start:     func(context.Context) error { reconciler.Start(); return nil },
+			Stop:      lifecycle.Func(reconciler.Stop),
+		})
+	}
+
+	if err = lc.Start(ctx); err != nil {
+		logger.Fatal().Err(err).Msg("start components failed")
 	}
-	defer healthServer.Stop(context.Background())
 
 	logger.Info().
 		Str("ws_url", cfg.HLMonitor.HyperliquidWSURL).
@@ -141,45 +708,104 @@ func main() {
 		Msg("hl_monitor service started successfully")
 
 	// 优雅关闭
+	stopped := make(chan struct{})
 	sigproc.GracefulShutdown(func(sig os.Signal) {
+		defer close(stopped)
 		logger.Info().Str("signal", sig.String()).Msg("shutting down...")
 
-		// 停止数据清理器
-		dataCleaner.Stop()
-
 		// 停止接收新信号
 		cancel()
 
-		// 停止地址加载器
-		addrLoader.Stop()
-
-		// 关闭订阅管理器
-		subManager.Close()
-
-		// 关闭仓位管理器
-		posManager.Close()
+		var drainCancel context.CancelFunc
+		drainCtx, drainCancel = context.WithTimeout(context.Background(), cfg.Shutdown.DrainTimeout)
+		defer drainCancel()
 
-		// 关闭 ws PoolManager
-		wsPoolManager.Close()
+		if err := lc.Stop(context.Background()); err != nil {
+			logger.Warn().Err(err).Msg("some components failed to stop cleanly")
+		}
 
-		// 关闭健康检查服务器
-		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer shutdownCancel()
-		healthServer.Stop(shutdownCtx)
+		// 推送最终指标（含排空结果），健康检查服务停止后 Prometheus 已无法抓取
+		if cfg.Shutdown.PushGatewayURL != "" {
+			pushCtx, pushCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			if err := monitor.PushMetrics(pushCtx, cfg.Shutdown.PushGatewayURL, "hl_monitor"); err != nil {
+				logger.Warn().Err(err).Msg("push final metrics failed")
+			}
+			pushCancel()
+		}
 
-		// 关闭配置重载
-		config.Stop()
+		logger.Info().Msg("hl_monitor service stopped")
+	})
 
-		// 关闭批量写入器
-		batchWriter.Stop()
+	<-stopped
+}
 
-		// 关闭数据库
-		dal.CloseMySQL()
+// initStorage 按配置连接数据库（默认 MySQL，单机/开发环境可使用 SQLite）
+func initStorage(cfg *config.Config) {
+	if cfg.Storage.Driver == dal.DriverSQLite {
+		dal.InitSQLiteDB(cfg.Storage)
+	} else {
+		dal.InitMysqlDB(cfg.MySQL)
+	}
+}
 
-		logger.Info().Msg("hl_monitor service stopped")
+// newBatchWriter 按配置创建批量写入器（服务与模拟回放共用）
+func newBatchWriter(cfg *config.Config) *processor.BatchWriter {
+	return processor.NewBatchWriter(&processor.BatchWriterConfig{
+		BatchSize:        cfg.BatchWriter.BatchSize,
+		FlushInterval:    cfg.BatchWriter.FlushInterval,
+		MaxBufferSize:    cfg.Storage.BufferMaxItems,
+		OverflowPolicy:   cfg.Storage.BufferOverflow,
+		Adaptive:         cfg.BatchWriter.Adaptive,
+		TargetLatency:    cfg.BatchWriter.TargetLatency,
+		MinBatchSize:     cfg.BatchWriter.MinBatchSize,
+		MaxBatchSize:     cfg.BatchWriter.MaxBatchSize,
+		MinFlushInterval: cfg.BatchWriter.MinFlushInterval,
+		MaxFlushInterval: cfg.BatchWriter.MaxFlushInterval,
 	})
+}
+
+// newPositionManager 按配置创建仓位管理器（服务与模拟回放共用）
+func newPositionManager(cfg *config.Config, pool *ws.PoolManager, symbolManager *symbol.Manager, batchWriter *processor.BatchWriter) *manager.PositionManager {
+	posManager := manager.NewPositionManager(pool, symbolManager.PriceCache(), symbolManager.SymbolCache(), batchWriter)
+	posManager.SetDustThresholds(cfg.SpotDust)
+	posManager.SetValuation(cfg.Valuation)
+	posManager.SetSymbolNormalizer(symbolManager.Normalizer())
+	posManager.SetProcessLanes(cfg.Queue.Lanes)
+	return posManager
+}
 
-	<-ctx.Done()
+// configureSubscriptionManager 按配置设置订单聚合和信号生成参数（服务与模拟回放共用）
+func configureSubscriptionManager(cfg *config.Config, subManager *manager.SubscriptionManager, symbolManager *symbol.Manager) error {
+	subManager.SetProcessLanes(cfg.Queue.Lanes)
+	subManager.SetPriceCache(symbolManager.PriceCache())
+	subManager.SetMarketCtxCache(symbolManager.MarketCtxCache())
+	subManager.SetValuer(valuation.NewValuer(cfg.Valuation, symbolManager.PriceCache(), symbolManager.SymbolCache()))
+	subManager.SetSymbolNormalizer(symbolManager.Normalizer())
+	positionRates, err := processor.NewPositionRateStrategy(cfg.PositionRate)
+	if err != nil {
+		return fmt.Errorf("init position rate strategy: %w", err)
+	}
+	subManager.SetPositionRateStrategy(positionRates)
+	if cfg.Severity.Enabled {
+		subManager.SetSeverityClassifier(processor.NewSeverityClassifier(cfg.Severity))
+	}
+	subManager.SetCloidGrouping(cfg.OrderAggregation.GroupByCloid, cfg.OrderAggregation.CloidReplaceWindow)
+	subManager.SetMaxPending(cfg.OrderAggregation.MaxPending)
+	subManager.SetSpotSellMode(cfg.OrderAggregation.SpotSellMode)
+	subManager.SetProgressThresholds(cfg.OrderAggregation.ProgressThresholds)
+	subManager.SetOidOwnerLimits(cfg.OrderAggregation.OidOwnerTTL, cfg.OrderAggregation.OidOwnerMaxSize)
+	subManager.SetStatusTracker(processor.NewOrderStatusTracker(cfg.StatusTracker.TTL))
+	if dir := cfg.OrderAggregation.HookPluginDir; dir != "" {
+		hooks, err := processor.LoadSignalHookPlugins(dir)
+		if err != nil {
+			return fmt.Errorf("load signal hook plugins: %w", err)
+		}
+		for _, h := range hooks {
+			subManager.AddSignalHook(h.Name, h.Hook)
+			logger.Info().Str("hook", h.Name).Msg("signal hook plugin loaded")
+		}
+	}
+	return nil
 }
 
 func initLogger(cfg *config.Config) error {
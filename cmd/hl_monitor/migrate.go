@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/utrading/utrading-hl-monitor/internal/dal"
+)
+
+const migrateUsage = `usage: hl_monitor [-config cfg.toml] migrate <command>
+
+commands:
+  up          执行所有待执行的迁移
+  down [N]    回滚最近 N 个版本（默认 1）
+  version     显示当前版本
+  force V     将版本标记为 V 并清除 dirty（人工修复失败的迁移后使用）`
+
+// runMigrate 执行迁移子命令，返回进程退出码
+func runMigrate(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	m, err := dal.NewMigrator(dal.MySQL(), dal.Driver())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := m.Up()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Printf("applied %d migration(s)\n", applied)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps <= 0 {
+				fmt.Fprintf(os.Stderr, "invalid steps: %s\n", args[1])
+				return 2
+			}
+		}
+		reverted, err := m.Down(steps)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Printf("reverted %d migration(s)\n", reverted)
+	case "force":
+		if len(args) < 2 {
+			fmt.Fprintln(os.Stderr, migrateUsage)
+			return 2
+		}
+		version, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid version: %s\n", args[1])
+			return 2
+		}
+		if err := m.Force(uint(version)); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Printf("forced version %d\n", version)
+	case "version":
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	version, dirty, err := m.Version()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	fmt.Printf("version: %d (latest %d), dirty: %t\n", version, m.Latest(), dirty)
+	return 0
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
+	"github.com/utrading/utrading-hl-monitor/internal/dal"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/manager"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/internal/simulate"
+	"github.com/utrading/utrading-hl-monitor/internal/symbol"
+	"github.com/utrading/utrading-hl-monitor/internal/ws"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+const simulateUsage = `usage: hl_monitor [-config cfg.toml] simulate -input capture.ndjson [options]
+
+将录制的 WS 消息（每行 {"ts":毫秒,"channel":"userFills","data":{...}}）按录制节奏注入订阅管理器和仓位管理器，
+-input 可以是 .ndjson / .ndjson.gz 文件，也可以是 [capture] 的存储目录（其中的文件按 ts 归并回放），
+不建立真实连接，信号写入文件而非 NATS。订单聚合、信号等数据默认写入内存 SQLite，
+不影响配置的存储；指定 -persist 时才写入配置的存储。
+
+options:`
+
+// simulateMemoryDB 未指定 -persist 时使用的内存 SQLite，进程退出即丢弃
+const simulateMemoryDB = "file::memory:"
+
+// simulateSettle 回放结束后信号数量保持不变且无活跃订单的持续时间，视为处理完成
+const simulateSettle = time.Second
+
+// runSimulate 执行模拟回放子命令，返回进程退出码
+func runSimulate(cfg *config.Config, args []string) int {
+	fs := flag.NewFlagSet("simulate", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, simulateUsage)
+		fs.PrintDefaults()
+	}
+	input := fs.String("input", "", "录制文件（NDJSON，.gz 后缀按 gzip 解压）或 capture 目录")
+	speedArg := fs.String("speed", "1x", "回放速度：1x、10x、0.5x，max 表示不等待")
+	output := fs.String("output", "", "信号输出文件（NDJSON），为空时不输出")
+	golden := fs.String("golden", "", "期望信号文件（NDJSON），不一致时退出码为 1")
+	update := fs.Bool("update", false, "用本次信号覆盖 -golden 文件")
+	drain := fs.Duration("drain", 30*time.Second, "回放结束后等待聚合完成的最长时间")
+	persist := fs.Bool("persist", false, "订单聚合、信号等数据写入配置的存储（默认写入内存 SQLite）")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *input == "" {
+		fs.Usage()
+		return 2
+	}
+	speed, err := simulate.ParseSpeed(*speedArg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	// 默认使用空的内存库，模拟数据不会混入线上数据
+	autoMigrate := true
+	if *persist {
+		initStorage(cfg)
+		autoMigrate = cfg.Storage.AutoMigrate
+	} else {
+		dal.InitSQLiteDB(config.Storage{SQLitePath: simulateMemoryDB})
+	}
+	if err := dal.EnsureSchema(autoMigrate); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	dao.InitDAO(dal.MySQL())
+
+	users, err := scanUsers(*input)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "read %s: %v\n", *input, err)
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	recorder := simulate.NewRecorder()
+	stats, err := replay(ctx, cfg, *input, speed, *drain, users, recorder)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	signals := recorder.Signals()
+	fmt.Printf("replayed %d message(s) for %d address(es) in %s, %d failed, %d signal(s)\n",
+		stats.Messages, len(users), stats.Elapsed.Round(time.Millisecond), stats.Failed, len(signals))
+
+	if *output != "" {
+		if err := writeSignalsFile(*output, signals); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+	}
+	if *golden == "" {
+		return 0
+	}
+	if *update {
+		if err := writeSignalsFile(*golden, signals); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Printf("updated golden file %s\n", *golden)
+		return 0
+	}
+
+	want, err := readSignalsFile(*golden)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if diffs := simulate.Diff(signals, want); len(diffs) > 0 {
+		fmt.Fprintf(os.Stderr, "signals differ from golden file %s:\n", *golden)
+		for _, d := range diffs {
+			fmt.Fprintln(os.Stderr, d)
+		}
+		return 1
+	}
+	fmt.Printf("signals match golden file %s\n", *golden)
+	return 0
+}
+
+// replay 以离线连接池搭建订阅和仓位处理链路，回放录制并等待聚合完成
+func replay(
+	ctx context.Context,
+	cfg *config.Config,
+	input string,
+	speed float64,
+	drain time.Duration,
+	users []string,
+	recorder *simulate.Recorder,
+) (simulate.Stats, error) {
+	symbolManager, err := symbol.NewManager(cfg.Symbol)
+	if err != nil {
+		return simulate.Stats{}, fmt.Errorf("init symbol manager: %w", err)
+	}
+	defer symbolManager.Close()
+
+	pool := ws.NewOfflinePoolManager()
+	_ = pool.Start(ctx)
+	defer pool.Close()
+
+	batchWriter := newBatchWriter(cfg)
+	batchWriter.Start()
+	defer batchWriter.Stop()
+
+	posManager := newPositionManager(cfg, pool, symbolManager, batchWriter)
+	defer posManager.Close()
+
+	pairCategoryCache := cache.NewPairCategoryCache()
+	pairCategoryCache.Start()
+
+	subManager := manager.NewSubscriptionManager(pool, recorder, symbolManager.SymbolCache(), posManager.PositionBalanceCache(), pairCategoryCache, batchWriter)
+	if err = configureSubscriptionManager(cfg, subManager, symbolManager); err != nil {
+		_ = subManager.Close()
+		return simulate.Stats{}, err
+	}
+	posManager.SetOpenOrderTracker(subManager)
+	defer subManager.Close()
+
+	for _, addr := range users {
+		if err := posManager.SubscribeAddress(addr); err != nil {
+			return simulate.Stats{}, fmt.Errorf("subscribe positions %s: %w", addr, err)
+		}
+		if err := subManager.SubscribeAddress(addr); err != nil {
+			return simulate.Stats{}, fmt.Errorf("subscribe fills %s: %w", addr, err)
+		}
+	}
+
+	f, err := simulate.Open(input)
+	if err != nil {
+		return simulate.Stats{}, err
+	}
+	defer f.Close()
+
+	stats, err := simulate.NewReplayer(speed, pool.Inject).Run(ctx, f)
+	if err != nil && ctx.Err() == nil {
+		return stats, fmt.Errorf("replay %s: %w", input, err)
+	}
+
+	waitSettled(ctx, subManager, recorder, drain)
+	return stats, nil
+}
+
+// waitSettled 等待活跃订单清空且信号数量稳定，最长 drain
+func waitSettled(ctx context.Context, subManager *manager.SubscriptionManager, recorder *simulate.Recorder, drain time.Duration) {
+	deadline := time.Now().Add(drain)
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	last, stableSince := -1, time.Now()
+	for {
+		count := recorder.Count()
+		if count != last || subManager.OrderProcessor().ActiveCount() > 0 {
+			last, stableSince = count, time.Now()
+		} else if time.Since(stableSince) >= simulateSettle {
+			return
+		}
+		if time.Now().After(deadline) {
+			logger.Warn().Int("active_orders", subManager.OrderProcessor().ActiveCount()).
+				Msg("simulate drain timeout, pending aggregations are not flushed")
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func scanUsers(path string) ([]string, error) {
+	f, err := simulate.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return simulate.Users(f)
+}
+
+func writeSignalsFile(path string, signals []*nats.HlAddressSignal) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := simulate.WriteSignals(f, signals); err != nil {
+		f.Close()
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return f.Close()
+}
+
+func readSignalsFile(path string) ([]*nats.HlAddressSignal, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	signals, err := simulate.ReadSignals(f)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	return signals, nil
+}
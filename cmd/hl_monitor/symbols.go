@@ -0,0 +1,164 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/internal/symbol"
+)
+
+const symbolsUsage = `usage: hl_monitor [-config cfg.toml] symbols <command> [options]
+
+读写 hl_symbol_renames。Hyperliquid 资产改名或别名调整后，历史记录中仍是旧 symbol，用于将其改写为新 symbol。
+
+commands:
+  list                                                               显示全部改名映射
+  rename [-asset-type futures|spot] [-dry-run] [-no-publish] OLD NEW 记录映射并改写订单聚合、信号（含待发布消息体）、挂单镜像和每日统计，
+                                                                     完成后发布 hl.symbol.renamed 通知
+
+[symbol] apply_renames 开启时，服务启动后规则生成的 symbol 同样按映射替换；运行中的实例需重启后生效`
+
+// runSymbols 执行 symbol 改名子命令，返回进程退出码
+func runSymbols(cfg *config.Config, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, symbolsUsage)
+		return 2
+	}
+
+	switch args[0] {
+	case "list":
+		return runSymbolsList()
+	case "rename":
+		return runSymbolsRename(cfg, args[1:])
+	default:
+		fmt.Fprintln(os.Stderr, symbolsUsage)
+		return 2
+	}
+}
+
+func runSymbolsList() int {
+	renames, err := dao.SymbolRename().ListAll()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "list symbol renames: %v\n", err)
+		return 1
+	}
+	for _, r := range renames {
+		applied := "-"
+		if r.AppliedAt != nil {
+			applied = r.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Printf("%-8s %-24s -> %-24s rows=%d applied=%s\n", r.AssetType, r.OldSymbol, r.NewSymbol, r.RewrittenRows, applied)
+	}
+	fmt.Fprintf(os.Stderr, "%d rename(s)\n", len(renames))
+	return 0
+}
+
+func runSymbolsRename(cfg *config.Config, args []string) int {
+	fs := flag.NewFlagSet("symbols rename", flag.ContinueOnError)
+	fs.Usage = func() { fmt.Fprintln(os.Stderr, symbolsUsage) }
+	assetType := fs.String("asset-type", dao.AssetTypeFutures, "futures / spot")
+	dryRun := fs.Bool("dry-run", false, "只显示将改写的记录数，不写入数据库")
+	noPublish := fs.Bool("no-publish", false, "不发布 hl.symbol.renamed 通知")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 2 {
+		fmt.Fprintln(os.Stderr, symbolsUsage)
+		return 2
+	}
+	oldSymbol, newSymbol := fs.Arg(0), fs.Arg(1)
+	if *assetType != dao.AssetTypeFutures && *assetType != dao.AssetTypeSpot {
+		fmt.Fprintf(os.Stderr, "unsupported asset type %q\n", *assetType)
+		return 2
+	}
+	if oldSymbol == "" || newSymbol == "" || oldSymbol == newSymbol {
+		fmt.Fprintf(os.Stderr, "invalid rename %q -> %q\n", oldSymbol, newSymbol)
+		return 2
+	}
+
+	if *dryRun {
+		counts, err := dao.SymbolRename().CountRows(*assetType, oldSymbol)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "count rows: %v\n", err)
+			return 1
+		}
+		printRenameCounts(counts)
+		fmt.Printf("%s %s -> %s, nothing written (dry run)\n", *assetType, oldSymbol, newSymbol)
+		return 0
+	}
+
+	counts, err := dao.SymbolRename().Apply(*assetType, oldSymbol, newSymbol)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "rename: %v\n", err)
+		return 1
+	}
+	printRenameCounts(counts)
+	fmt.Printf("renamed %s %s -> %s\n", *assetType, oldSymbol, newSymbol)
+
+	if *noPublish {
+		return 0
+	}
+	if err = publishSymbolRenamed(cfg, &nats.HlSymbolRenamed{
+		AssetType:     *assetType,
+		OldSymbol:     oldSymbol,
+		NewSymbol:     newSymbol,
+		RewrittenRows: counts,
+		Timestamp:     time.Now().UnixMilli(),
+		TraceID:       nats.NewTraceID(),
+	}); err != nil {
+		fmt.Fprintf(os.Stderr, "publish rename notice: %v (records already rewritten)\n", err)
+		return 1
+	}
+	fmt.Printf("published %s\n", nats.TopicHLSymbolRenamed)
+	return 0
+}
+
+// publishSymbolRenamed 连接 NATS 发布改名通知后关闭连接
+func publishSymbolRenamed(cfg *config.Config, event *nats.HlSymbolRenamed) error {
+	publisher, err := nats.NewPublisher(cfg.NATS)
+	if err != nil {
+		return err
+	}
+	defer publisher.Close()
+	return publisher.PublishSymbolRenamed(event)
+}
+
+func printRenameCounts(counts map[string]int64) {
+	tables := make([]string, 0, len(counts))
+	for table := range counts {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		fmt.Printf("%-32s %d\n", table, counts[table])
+	}
+}
+
+// applySymbolRenames 按 hl_symbol_renames 替换规则生成的 symbol
+func applySymbolRenames(symbolManager *symbol.Manager) error {
+	renames, err := dao.SymbolRename().ListAll()
+	if err != nil {
+		return err
+	}
+	if len(renames) == 0 {
+		return nil
+	}
+
+	perp := make(map[string]string)
+	spot := make(map[string]string)
+	for _, r := range renames {
+		if r.AssetType == dao.AssetTypeSpot {
+			spot[r.OldSymbol] = r.NewSymbol
+		} else {
+			perp[r.OldSymbol] = r.NewSymbol
+		}
+	}
+	symbolManager.SetRenames(perp, spot)
+	return nil
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"sync"
 	"time"
@@ -10,12 +11,46 @@ import (
 )
 
 type HLMonitor struct {
-	HyperliquidWSURL              string        `toml:"hyperliquid_ws_url"`
-	HealthServerAddr              string        `toml:"health_server_addr"`
-	AddressReloadInterval         time.Duration `toml:"address_reload_interval"`
-	AddressRemoveGrace            time.Duration `toml:"address_remove_grace"`
-	MaxConnections                int           `toml:"max_connections"`
-	MaxSubscriptionsPerConnection int           `toml:"max_subscriptions_per_connection"`
+	HyperliquidWSURL              string            `toml:"hyperliquid_ws_url"`
+	HealthServerAddr              string            `toml:"health_server_addr"`
+	AddressReloadInterval         time.Duration     `toml:"address_reload_interval"`
+	AddressRemoveGrace            time.Duration     `toml:"address_remove_grace"`
+	MaxConnections                int               `toml:"max_connections"`
+	MaxSubscriptionsPerConnection int               `toml:"max_subscriptions_per_connection"`
+	UpstreamProbeInterval         time.Duration     `toml:"upstream_probe_interval"`
+	DedupScopeByServer            bool              `toml:"dedup_scope_by_server"`     // 按 hl_active_addresses.server_id 划分去重作用域
+	DedupMaxEntries               int               `toml:"dedup_max_entries"`         // 订单去重缓存条目上限，超出时淘汰最久未访问的条目，0 表示不限制
+	RateLimitAddress              string            `toml:"rate_limit_address"`        // 监控账户地址，非空时定期采集其 REST 请求额度
+	RateLimitInterval             time.Duration     `toml:"rate_limit_interval"`       // 请求额度采集间隔
+	WSCompression                 bool              `toml:"ws_compression"`            // WebSocket 协商 permessage-deflate 压缩
+	WSPingInterval                time.Duration     `toml:"ws_ping_interval"`          // WebSocket 心跳间隔
+	WSReadTimeout                 time.Duration     `toml:"ws_read_timeout"`           // 超过该时长未收到任何消息（含 pong）视为半开连接并重连，应大于心跳间隔
+	WSHeaders                     map[string]string `toml:"ws_headers"`                // WebSocket 握手附加请求头，如经鉴权代理接入时的令牌
+	WSProxy                       string            `toml:"ws_proxy"`                  // WebSocket 代理，http://（CONNECT）或 socks5://，user:pass 作为代理鉴权
+	AdminToken                    string            `toml:"admin_token"`               // 管理接口令牌（/admin/*），为空时不启用
+	SignalStreamMaxClients        int               `toml:"signal_stream_max_clients"` // /stream/signals SSE 最大同时连接数，0 关闭
+	CacheStatsLogInterval         time.Duration     `toml:"cache_stats_log_interval"`  // 去重、状态追踪、待处理订单缓存统计的日志间隔，0 关闭
+}
+
+// HealthServer 健康检查、指标及管理端点的 TLS 与认证
+type HealthServer struct {
+	TLSCertFile  string   `toml:"tls_cert_file"`  // 服务端证书，与 tls_key_file 同时配置时启用 HTTPS
+	TLSKeyFile   string   `toml:"tls_key_file"`   // 服务端私钥
+	ClientCAFile string   `toml:"client_ca_file"` // 客户端 CA，配置后要求客户端证书（mTLS），需同时启用 TLS
+	AuthToken    string   `toml:"auth_token"`     // 全部端点的 Bearer 令牌，为空时不校验；管理令牌同样放行
+	AuthExempt   []string `toml:"auth_exempt"`    // 免令牌校验的路径，默认存活/就绪探针
+
+	// 就绪检查（/health/ready）附加项，默认只检查运行状态和 WebSocket 连接
+	ReadyCheckMySQL      bool `toml:"ready_check_mysql"`       // 数据库 Ping 失败时未就绪
+	ReadyCheckNATS       bool `toml:"ready_check_nats"`        // NATS 断开时未就绪
+	ReadyMaxWriteBacklog int  `toml:"ready_max_write_backlog"` // 批量写入积压（队列 + 缓冲）超过该条数时未就绪，0 不检查
+	ReadyMaxQueueDepth   int  `toml:"ready_max_queue_depth"`   // 进程内消息队列积压超过该条数时未就绪，0 不检查
+}
+
+// Shutdown 优雅关闭
+type Shutdown struct {
+	DrainTimeout   time.Duration `toml:"drain_timeout"`    // 排空消息队列、发送队列和批量写入缓冲的总截止时间，超时后剩余数据被丢弃
+	PushGatewayURL string        `toml:"push_gateway_url"` // 关闭前将最终指标推送到 Prometheus Pushgateway，为空时不推送
 }
 
 type MySQL struct {
@@ -27,6 +62,35 @@ type MySQL struct {
 	SetConnMaxIdleTime int      `toml:"set_conn_max_idle_time"`
 	ProxyEnabled       bool     `toml:"proxy_enabled"`
 	ProxyAddr          string   `toml:"proxy_addr"`
+
+	TablePrefix string            `toml:"table_prefix"` // 表名前缀，多个环境共享同一 MySQL 实例时使用，如 prod_
+	TableNames  map[string]string `toml:"table_names"`  // 自定义表名（默认表名 -> 实际表名），优先于前缀
+}
+
+// Storage 持久化后端
+type Storage struct {
+	Driver      string `toml:"driver"`       // mysql（默认）/ sqlite：单机或开发环境，无需部署 MySQL
+	SQLitePath  string `toml:"sqlite_path"`  // SQLite 数据库文件路径（driver = sqlite 时生效）
+	AutoMigrate bool   `toml:"auto_migrate"` // 启动时执行待执行的迁移；关闭后版本落后拒绝启动，需先执行 hl_monitor migrate up
+
+	BreakerThreshold int           `toml:"breaker_threshold"` // 连续出现连接类错误达到该次数后熔断，熔断期间数据库操作立即失败；0 关闭
+	BreakerCooldown  time.Duration `toml:"breaker_cooldown"`  // 熔断后等待多久放行一次试探请求
+	BufferMaxItems   int           `toml:"buffer_max_items"`  // 数据库不可用时批量写入器在内存中缓冲的最大条数
+	BufferOverflow   string        `toml:"buffer_overflow"`   // 缓冲已满时的处理：drop_new 丢弃新数据 / drop_non_critical 优先淘汰仓位缓存、成交等可重建数据
+}
+
+// BatchWriter 仓位缓存、订单聚合、成交等批量写库参数
+// adaptive 开启后按单批写入耗时和积压在上下限内自动调整批量大小和刷新间隔（AIMD），batch_size / flush_interval 作为初始值
+type BatchWriter struct {
+	BatchSize     int           `toml:"batch_size"`     // 单批条数，缓冲达到该条数立即刷新
+	FlushInterval time.Duration `toml:"flush_interval"` // 定时刷新间隔
+
+	Adaptive         bool          `toml:"adaptive"`
+	TargetLatency    time.Duration `toml:"target_latency"` // 单批写入目标耗时，超过后批量减半、间隔加倍
+	MinBatchSize     int           `toml:"min_batch_size"`
+	MaxBatchSize     int           `toml:"max_batch_size"`
+	MinFlushInterval time.Duration `toml:"min_flush_interval"`
+	MaxFlushInterval time.Duration `toml:"max_flush_interval"`
 }
 
 type NATS struct {
@@ -35,6 +99,38 @@ type NATS struct {
 	MaxReconnects  int           `toml:"max_reconnects"`
 	PingInterval   time.Duration `toml:"ping_interval"`
 	ConnectTimeout time.Duration `toml:"connect_timeout"`
+	User           string        `toml:"user"` // 用户名密码认证，可通过 HLM_NATS_USER / HLM_NATS_PASSWORD 或 [secrets] 注入
+	Password       string        `toml:"password"`
+	Token          string        `toml:"token"` // Token 认证
+
+	Strategy string         `toml:"strategy"` // 多集群发布策略：failover（默认，按顺序使用第一个可用集群）/ fanout（同时发布到所有可用集群）
+	Standby  []NATSEndpoint `toml:"standby"`  // 备用集群，共用上面的认证与重连配置；主集群为 endpoint
+
+	Batch NATSBatch `toml:"batch"`
+}
+
+// NATSBatch 信号批量发布：同时 flush 的信号（如超时扫描集中触发）合并为一批发布，整批只等待一次确认
+type NATSBatch struct {
+	Enabled     bool          `toml:"enabled"`
+	MaxSize     int           `toml:"max_size"`      // 单批最多信号数
+	Linger      time.Duration `toml:"linger"`        // 首条信号到达后等待凑批的最长时间
+	Ack         string        `toml:"ack"`           // none: 不确认 / flush: 整批发布后 flush 一次确认服务端已收到（默认）/ jetstream: 异步发布到 JetStream 并等待全部 PubAck
+	MaxInFlight int           `toml:"max_in_flight"` // jetstream 模式下未确认的发布上限，达到上限时发布阻塞
+	AckTimeout  time.Duration `toml:"ack_timeout"`   // 等待确认的超时时间
+}
+
+// Control NATS 请求-应答控制面（添加/移除地址、统计、手动发送聚合）
+type Control struct {
+	Enabled    bool   `toml:"enabled"`
+	Subject    string `toml:"subject"`     // 控制主题
+	QueueGroup string `toml:"queue_group"` // 队列组，非空时同组实例中只有一个处理同一请求；为空时每个实例都处理，请求方收到最先的应答
+	Token      string `toml:"token"`       // 请求需携带的令牌，为空时不校验
+}
+
+// NATSEndpoint 备用 NATS 集群
+type NATSEndpoint struct {
+	Name     string `toml:"name"` // 集群名称（指标标签）
+	Endpoint string `toml:"endpoint"`
 }
 
 type Logger struct {
@@ -51,14 +147,330 @@ type OrderAggregation struct {
 	ScanInterval time.Duration `toml:"scan_interval"`
 	MaxRetry     int           `toml:"max_retry"`
 	RetryDelay   time.Duration `toml:"retry_delay"`
+
+	GroupByCloid       bool          `toml:"group_by_cloid"`       // 成交带 cloid 时按 address+cloid 聚合，同一 cloid 的拆单/改单只发送一个信号
+	CloidReplaceWindow time.Duration `toml:"cloid_replace_window"` // cloid 分组中订单被撤销后等待同 cloid 新订单的时间，超时未续单即发送
+
+	HookPluginDir string `toml:"hook_plugin_dir"` // 信号钩子插件目录（*.so，需与本程序同工具链构建），为空时不加载
+
+	OidOwnerTTL     time.Duration `toml:"oid_owner_ttl"`      // 成交 oid 到地址映射的过期时间（最近访问起算），未收到终止状态的条目过期后淘汰，0 表示不过期
+	OidOwnerMaxSize int           `toml:"oid_owner_max_size"` // 成交 oid 到地址映射的条目上限，超出时淘汰最久未访问的条目，0 表示不限制
+
+	MaxPending int `toml:"max_pending"` // 聚合中的订单上限，超出时按首笔成交时间从早到晚提前发送（trigger=evicted），0 表示不限制
+
+	SwingTimeout time.Duration `toml:"swing_timeout"` // hl_watch_addresses.aggregation = swing 的地址使用的聚合超时（短于 timeout 时取 timeout）；scalper 地址每笔成交立即发送
+
+	SpotSellMode string `toml:"spot_sell_mode"` // 现货方向映射: close（卖出一律为平多）/ detect（按成交前余额识别杠杆现货的开空、平空）
+
+	ProgressThresholds []float64 `toml:"progress_thresholds"` // 成交进度阈值（百分比，如 25/50/75），聚合完成前累计成交越过阈值时发送 is_partial 进度信号，为空时不发送
+
+	LeverageLookup bool `toml:"leverage_lookup"` // 合约信号的杠杆不在仓位缓存中时（首次开仓且 webData2 未更新）通过 REST activeAssetData 查询
+}
+
+// StatusTracker 订单终止状态预标记（orderUpdates 终止状态先于成交到达时，成交到达后立即发送）
+type StatusTracker struct {
+	TTL           time.Duration `toml:"ttl"`            // 标记过期时间
+	Persist       bool          `toml:"persist"`        // 持久化到 hl_order_status_marks，重启后恢复未过期的标记
+	FlushInterval time.Duration `toml:"flush_interval"` // 持久化间隔
+}
+
+// Dormancy 休眠地址策略
+type Dormancy struct {
+	Enabled            bool          `toml:"enabled"`
+	DormantAfter       time.Duration `toml:"dormant_after"`       // 无成交且无仓位变化超过该时长即休眠
+	Mode               string        `toml:"mode"`                // positions_only: 仅保留仓位订阅; unsubscribe: 完全取消订阅
+	CheckInterval      time.Duration `toml:"check_interval"`      // 休眠判定间隔
+	ReactivateInterval time.Duration `toml:"reactivate_interval"` // 休眠地址 REST 活跃检查间隔
+}
+
+// SpotDust 现货粉尘过滤阈值（USD 价值低于阈值的余额不计入仓位缓存）
+type SpotDust struct {
+	MinUSD     float64            `toml:"min_usd"`      // 默认最小价值，0 表示不过滤
+	CoinMinUSD map[string]float64 `toml:"coin_min_usd"` // 按币种覆盖最小价值，如 {"HYPE" = 5.0}
+}
+
+// Reconcile 仓位对账任务（抽样对比缓存与 REST 权威状态）
+type Reconcile struct {
+	Enabled    bool          `toml:"enabled"`
+	Interval   time.Duration `toml:"interval"`    // 对账间隔
+	SampleSize int           `toml:"sample_size"` // 每轮抽样地址数，0 表示全部
+	Tolerance  float64       `toml:"tolerance"`   // 账户价值相对误差容忍度
+	SelfHeal   bool          `toml:"self_heal"`   // 发现差异时使用 REST 数据修复缓存
+}
+
+// HA 热备模式（基于数据库租约选主，备实例保持连接和缓存但不发送信号）
+type HA struct {
+	Enabled       bool          `toml:"enabled"`
+	LeaseName     string        `toml:"lease_name"`     // 租约名称，同一组主备实例需一致
+	InstanceID    string        `toml:"instance_id"`    // 实例 ID，默认 hostname-pid
+	LeaseTTL      time.Duration `toml:"lease_ttl"`      // 租约有效期，主实例宕机后最长切换时间
+	RenewInterval time.Duration `toml:"renew_interval"` // 续约/抢占间隔
+}
+
+// Queue 消息队列模式（接入层与处理层可独立部署）
+type Queue struct {
+	Mode              string `toml:"mode"`               // memory: 进程内队列; nats: NATS JetStream 工作队列
+	Role              string `toml:"role"`               // all: 接入+处理; ingest: 仅接入 WS 写入队列; process: 仅消费队列处理
+	Stream            string `toml:"stream"`             // JetStream stream 名称
+	Subject           string `toml:"subject"`            // subject 前缀，实际 subject 为 <subject>.<partition>
+	Partitions        int    `toml:"partitions"`         // 分区数，同一地址固定路由到同一分区以保证顺序
+	ConsumePartitions []int  `toml:"consume_partitions"` // 处理层消费的分区，为空时消费全部
+	Lanes             int    `toml:"lanes"`              // 进程内队列的串行通道数，同一地址固定路由到同一通道，不同地址并行处理
+}
+
+// Valuation 仓位估值（计价货币与稳定币篮子）
+type Valuation struct {
+	Quote       string   `toml:"quote"`       // 计价货币: USD 或任意现货币种（如 BTC），仓位缓存和信号同时记录 USD 与计价货币价值
+	Stablecoins []string `toml:"stablecoins"` // 按 1 USD 计价的稳定币篮子
+}
+
+// AddressMeta 地址标签（已知实体与金库名称），附加到信号和调试接口
+type AddressMeta struct {
+	Enabled         bool              `toml:"enabled"`
+	Labels          map[string]string `toml:"labels"`           // 已知实体地址标签，如 {"0x..." = "Binance Hot Wallet"}
+	ResolveVaults   bool              `toml:"resolve_vaults"`   // 通过 vaultDetails 解析金库名称
+	RefreshInterval time.Duration     `toml:"refresh_interval"` // 金库名称刷新间隔
+}
+
+// SelfTest 自检心跳：定期为保留地址注入模拟成交，验证队列→处理器→NATS→数据库全链路
+type SelfTest struct {
+	Enabled  bool          `toml:"enabled"`
+	Interval time.Duration `toml:"interval"` // 注入间隔，同时是单次心跳的验证超时
+	Address  string        `toml:"address"`  // 保留测试地址，信号只发布到 subject，不进入正式主题和 webhook
+	Subject  string        `toml:"subject"`  // 自检信号的 NATS 主题
+	Coin     string        `toml:"coin"`     // 模拟成交的币种
+}
+
+// OpenOrders 监控地址挂单镜像（hl_open_orders），由 webData2 挂单快照和 orderUpdates 增量维护
+type OpenOrders struct {
+	Enabled       bool          `toml:"enabled"`
+	FlushInterval time.Duration `toml:"flush_interval"` // 变更地址合并写库间隔
+}
+
+// Symbol 资产名 → 下游 symbol 的规范化规则，按顺序匹配，首个命中的规则生效
+// 未命中任何合约规则或不在 perpDexs 注册表中的 dex 资产会被忽略
+type Symbol struct {
+	PerpRules []SymbolRule `toml:"perp_rules"` // 合约规则，匹配 meta 中的资产名（如 BTC、xyz:TSLA）
+	SpotRules []SymbolRule `toml:"spot_rules"` // 现货规则，匹配 base token 名，模板可用 {quote} 引用 quote token
+
+	MarketCtxInterval time.Duration `toml:"market_ctx_interval"` // 合约市场上下文（24h 成交额、未平仓量、资金费率）刷新间隔，0 表示不附带到信号
+	ApplyRenames      bool          `toml:"apply_renames"`       // 启动时读取 hl_symbol_renames，规则生成的 symbol 同样按改名映射替换
+}
+
+// SymbolRule 单条规范化规则
+type SymbolRule struct {
+	Match  string `toml:"match"`  // 正则表达式
+	Coin   string `toml:"coin"`   // 规范化 coin 模板，支持 $1 / ${name} 捕获组，默认 $0；结果会再经过别名映射
+	Symbol string `toml:"symbol"` // 下游 symbol 模板，支持捕获组及 {coin}、{quote} 占位符
+}
+
+// Webhook 信号 HTTP 推送（供无法接入 NATS 的消费方使用）
+type Webhook struct {
+	Enabled          bool              `toml:"enabled"`
+	Endpoints        []WebhookEndpoint `toml:"endpoints"`
+	Timeout          time.Duration     `toml:"timeout"`           // 单次请求超时
+	MaxRetries       int               `toml:"max_retries"`       // 失败重试次数
+	RetryBackoff     time.Duration     `toml:"retry_backoff"`     // 初始重试间隔，按指数退避
+	QueueSize        int               `toml:"queue_size"`        // 每个端点的待投递队列长度，满时丢弃
+	BreakerThreshold int               `toml:"breaker_threshold"` // 连续失败次数达到阈值后熔断
+	BreakerCooldown  time.Duration     `toml:"breaker_cooldown"`  // 熔断后等待多久再尝试
+}
+
+// WebhookEndpoint 单个 webhook 端点
+type WebhookEndpoint struct {
+	Name        string `toml:"name"` // 端点名称（用于日志和指标），默认取 URL 的 host
+	URL         string `toml:"url"`
+	Secret      string `toml:"secret"`       // HMAC-SHA256 签名密钥
+	MinSeverity string `toml:"min_severity"` // 只投递不低于该级别的信号（notable / critical），为空时投递全部，需开启 [severity]
+}
+
+// SubscribeThrottle WS 订阅限速，批量加载地址时错开订阅请求，避免触发 Hyperliquid 订阅频率限制
+type SubscribeThrottle struct {
+	Rate   float64       `toml:"rate"`   // 每秒最多发起的订阅请求数（每个地址 3 个订阅），0 表示不限速
+	Jitter time.Duration `toml:"jitter"` // 每次订阅叠加的随机延迟上限
+}
+
+// Fills 原始成交留存（hl_fills），保存 orderFills 推送的每笔成交，便于事后分析和争议核对
+type Fills struct {
+	Enabled   bool          `toml:"enabled"`
+	Retention time.Duration `toml:"retention"` // 保留时长（按成交时间），0 表示不清理
+}
+
+// FillReconcile 成交对账：每日核对前一 UTC 日已发送订单在 hl_fills 中的成交数量之和与订单聚合 TotalSize、
+// 已发布信号 Size 是否一致，差异写入 hl_fill_discrepancies；依赖 [fills] 留存原始成交
+type FillReconcile struct {
+	Enabled              bool          `toml:"enabled"`
+	RunHour              int           `toml:"run_hour"`              // 每日执行时刻（UTC 小时，0-23），核对前一自然日
+	Tolerance            float64       `toml:"tolerance"`             // 数量绝对误差容忍度
+	AggregationRetention time.Duration `toml:"aggregation_retention"` // 启用后订单聚合的保留时长（默认清理 2 小时前的聚合），需覆盖对账日
+}
+
+// FillWatermark 地址成交高水位，重启后跳过订阅快照中早于高水位的重放成交
+type FillWatermark struct {
+	Enabled       bool          `toml:"enabled"`
+	FlushInterval time.Duration `toml:"flush_interval"` // 持久化间隔
+}
+
+// PnL 地址每日盈亏统计（hl_address_pnl_daily）
+// 每笔成交到达时累计 closedPnl 为已实现盈亏（按 tid 去重），定期从仓位缓存汇总合约未实现盈亏
+type PnL struct {
+	Enabled       bool          `toml:"enabled"`
+	FlushInterval time.Duration `toml:"flush_interval"` // 未实现盈亏采样及写库间隔
+}
+
+// SignalStats 信号每日统计：定期从 hl_address_signals 重算当日及前 lookback_days 日的统计，覆盖写入 hl_signal_daily_stats
+// 原始信号保留 7 天，更早日期的统计不再重算
+type SignalStats struct {
+	Enabled      bool          `toml:"enabled"`
+	Interval     time.Duration `toml:"interval"`      // 汇总间隔
+	LookbackDays int           `toml:"lookback_days"` // 除当日外重算的天数，覆盖迟到写入（如数据库恢复后补写）的信号
+}
+
+// Watchdog 内部组件看门狗：订单发送循环、超时扫描、WS 分发协程池、批量写入刷新循环定期心跳，
+// 心跳停滞时记录日志和指标，可选重启该组件，无需重启整个进程
+type Watchdog struct {
+	Enabled       bool          `toml:"enabled"`
+	CheckInterval time.Duration `toml:"check_interval"` // 检查间隔
+	StallTimeout  time.Duration `toml:"stall_timeout"`  // 心跳超过该时长未更新视为停滞，需大于超时扫描间隔（30s）和批量写入刷新间隔
+	Restart       bool          `toml:"restart"`        // 停滞时重启组件（后台循环或分发协程池），关闭时只记录日志和指标
+}
+
+// Outbox 信号发件箱：订单聚合与信号在同一事务中写入 hl_address_signals，由分发器发布到 NATS 后标记
+// 进程崩溃不丢失、不重复生成信号；发布后标记前崩溃会重新投递，消费者按 idempotency_key 去重
+type Outbox struct {
+	Enabled      bool          `toml:"enabled"`
+	PollInterval time.Duration `toml:"poll_interval"` // 扫描待发布信号的间隔（新信号写入后立即触发分发）
+	BatchSize    int           `toml:"batch_size"`    // 每次扫描的最大条数
+	MaxAttempts  int           `toml:"max_attempts"`  // 单条信号发布失败达到该次数后转入死信，继续发布后续信号；0 不限制
+}
+
+// AddressGroups 地址分组（hl_address_groups / hl_address_group_members）
+// 信号除发布到 hl_address_signal 外，按所属分组的主题模板再发布一次，消费者可用 NATS 通配符订阅
+type AddressGroups struct {
+	Enabled         bool          `toml:"enabled"`
+	ReloadInterval  time.Duration `toml:"reload_interval"`  // 从数据库重新加载分组的间隔
+	DefaultTemplate string        `toml:"default_template"` // 分组未配置 subject_template 时使用的主题模板
+}
+
+// Transfers 监控地址的充值、提现和转账（userNonFundingLedgerUpdates），发布 hl.balance.transfer 事件
+// 每个地址额外占用一个 WS 订阅
+type Transfers struct {
+	Enabled bool    `toml:"enabled"`
+	MinUSD  float64 `toml:"min_usd"` // 低于该金额（USD）的变动只计入指标，不发布事件
+}
+
+// PositionRate 仓位比例分母策略（仅合约信号，现货信号始终以现货总价值为分母）
+type PositionRate struct {
+	Basis      string            `toml:"basis"`       // account_value（默认）/ withdrawable / free_collateral / margin_used
+	ScopeBasis map[string]string `toml:"scope_basis"` // 按去重作用域（逻辑消费者）覆盖分母，如 {"server-a" = "free_collateral"}
+	StaleTTL   time.Duration     `toml:"stale_ttl"`   // 超过该时长未收到 webData2 的地址余额视为过期，0 表示不判断
+	StaleMode  string            `toml:"stale_mode"`  // 余额过期时: annotate 照常计算并标记 balance_stale（默认）/ skip 不计算比例（置 0）
+}
+
+// Severity 信号级别（info / notable / critical），按成交名义价值、仓位比例和地址评分计算
+// critical 信号额外发布到 critical_subject；webhook 端点可按 min_severity 只接收高级别信号（告警通道）
+type Severity struct {
+	Enabled              bool               `toml:"enabled"`
+	NotableNotionalUSD   float64            `toml:"notable_notional_usd"`   // 成交名义价值（USD）达到该值为 notable，0 表示不按名义价值判断
+	CriticalNotionalUSD  float64            `toml:"critical_notional_usd"`  // 成交名义价值（USD）达到该值为 critical
+	NotablePositionRate  float64            `toml:"notable_position_rate"`  // 仓位比例（%）达到该值为 notable，余额缺失或过期时不参与判断
+	CriticalPositionRate float64            `toml:"critical_position_rate"` // 仓位比例（%）达到该值为 critical
+	BoostScore           float64            `toml:"boost_score"`            // 地址评分达到该值时级别提升一级，0 表示不提升
+	AddressScores        map[string]float64 `toml:"address_scores"`         // 地址评分，如 {"0xabc..." = 90}
+	CriticalSubject      string             `toml:"critical_subject"`       // critical 信号额外发布的 NATS 主题，为空时不发布
+}
+
+// SchemaCheck 上游 WS 消息结构检查：抽样比对 webData2 / userFills 的字段集合，发现 Hyperliquid 调整结构时上报指标和采样载荷
+type SchemaCheck struct {
+	Enabled     bool          `toml:"enabled"`
+	Every       int           `toml:"every"`        // 每个频道每 N 条消息检查一条
+	LogInterval time.Duration `toml:"log_interval"` // 同一字段差异记录采样载荷的最小间隔
+}
+
+// Capture 录制原始 WS 消息：按频道、地址分文件 gzip 压缩写入 work_dir，按 rotate_interval 轮转后存储到本地目录或 S3
+// 录制文件可直接用于 simulate 回放及事故排查
+type Capture struct {
+	Enabled        bool          `toml:"enabled"`
+	Duration       time.Duration `toml:"duration"`        // 录制时长，到期后自动停止，0 表示录制到进程退出
+	Channels       []string      `toml:"channels"`        // 录制的频道，为空时录制全部频道
+	Addresses      []string      `toml:"addresses"`       // 录制的地址，为空时录制全部地址；无 user 字段的消息（如 orderUpdates）始终录制
+	WorkDir        string        `toml:"work_dir"`        // 录制中文件的本地目录
+	RotateInterval time.Duration `toml:"rotate_interval"` // 文件轮转间隔，轮转后的文件交给存储
+	FlushSize      int           `toml:"flush_size"`      // 单个文件缓冲达到该字节数时压缩写入 work_dir
+	QueueSize      int           `toml:"queue_size"`      // 待写入消息队列长度，队列满时丢弃并计入指标，不阻塞消息分发
+	Storage        string        `toml:"storage"`         // local / s3
+	Dir            string        `toml:"dir"`             // local 存储目录
+	S3             CaptureS3     `toml:"s3"`
+}
+
+// CaptureS3 录制文件上传到 S3（PutObject，SigV4 签名），凭证读取 AWS_ACCESS_KEY_ID / AWS_SECRET_ACCESS_KEY / AWS_SESSION_TOKEN
+type CaptureS3 struct {
+	Bucket   string `toml:"bucket"`
+	Region   string `toml:"region"`   // 为空时读取 AWS_REGION
+	Endpoint string `toml:"endpoint"` // 自定义端点（MinIO / LocalStack），默认 https://s3.<region>.amazonaws.com，使用 path-style 地址
+	Prefix   string `toml:"prefix"`   // 对象键前缀，如 hl-monitor/prod/
+}
+
+// Secrets 从密钥管理服务读取敏感配置（DSN、NATS 凭证等），避免明文写在 TOML 中
+type Secrets struct {
+	Provider string            `toml:"provider"` // vault / aws，未配置 fields 时可留空
+	Fields   map[string]string `toml:"fields"`   // 配置路径 -> 密钥引用 "<path>#<key>"，如 {"mysql.dsn" = "hl-monitor/mysql#dsn"}
+	Timeout  time.Duration     `toml:"timeout"`  // 读取全部密钥的超时时间
+	Vault    VaultSecrets      `toml:"vault"`
+	AWS      AWSSecrets        `toml:"aws"`
+}
+
+// VaultSecrets HashiCorp Vault KV v2
+type VaultSecrets struct {
+	Address string `toml:"address"` // 为空时读取 VAULT_ADDR
+	Token   string `toml:"token"`   // 为空时读取 VAULT_TOKEN，建议通过 HLM_SECRETS_VAULT_TOKEN 注入
+	Mount   string `toml:"mount"`   // KV 挂载路径，默认 secret
+}
+
+// AWSSecrets AWS Secrets Manager，凭证读取 AWS_ACCESS_KEY_ID / AWS_SECRET_ACCESS_KEY / AWS_SESSION_TOKEN
+type AWSSecrets struct {
+	Region   string `toml:"region"`   // 为空时读取 AWS_REGION
+	Endpoint string `toml:"endpoint"` // 自定义端点（VPC endpoint / LocalStack），默认 https://secretsmanager.<region>.amazonaws.com
 }
 
 type Config struct {
-	HLMonitor        HLMonitor        `toml:"hl_monitor"`
-	MySQL            MySQL            `toml:"mysql"`
-	NATS             NATS             `toml:"nats"`
-	Logger           Logger           `toml:"log"`
-	OrderAggregation OrderAggregation `toml:"order_aggregation"`
+	HLMonitor         HLMonitor         `toml:"hl_monitor"`
+	HealthServer      HealthServer      `toml:"health_server"`
+	Shutdown          Shutdown          `toml:"shutdown"`
+	MySQL             MySQL             `toml:"mysql"`
+	Storage           Storage           `toml:"storage"`
+	BatchWriter       BatchWriter       `toml:"batch_writer"`
+	NATS              NATS              `toml:"nats"`
+	Control           Control           `toml:"control"`
+	Logger            Logger            `toml:"log"`
+	OrderAggregation  OrderAggregation  `toml:"order_aggregation"`
+	StatusTracker     StatusTracker     `toml:"status_tracker"`
+	Dormancy          Dormancy          `toml:"dormancy"`
+	SpotDust          SpotDust          `toml:"spot_dust"`
+	Reconcile         Reconcile         `toml:"reconcile"`
+	HA                HA                `toml:"ha"`
+	Queue             Queue             `toml:"queue"`
+	Webhook           Webhook           `toml:"webhook"`
+	Valuation         Valuation         `toml:"valuation"`
+	AddressMeta       AddressMeta       `toml:"address_meta"`
+	SelfTest          SelfTest          `toml:"selftest"`
+	Symbol            Symbol            `toml:"symbol"`
+	OpenOrders        OpenOrders        `toml:"open_orders"`
+	PositionRate      PositionRate      `toml:"position_rate"`
+	Fills             Fills             `toml:"fills"`
+	FillReconcile     FillReconcile     `toml:"fill_reconcile"`
+	SubscribeThrottle SubscribeThrottle `toml:"subscribe_throttle"`
+	Secrets           Secrets           `toml:"secrets"`
+	FillWatermark     FillWatermark     `toml:"fill_watermark"`
+	Transfers         Transfers         `toml:"transfers"`
+	PnL               PnL               `toml:"pnl"`
+	Outbox            Outbox            `toml:"outbox"`
+	AddressGroups     AddressGroups     `toml:"address_groups"`
+	Severity          Severity          `toml:"severity"`
+	SchemaCheck       SchemaCheck       `toml:"schema_check"`
+	Capture           Capture           `toml:"capture"`
+	SignalStats       SignalStats       `toml:"signal_stats"`
+	Watchdog          Watchdog          `toml:"watchdog"`
 }
 
 var (
@@ -78,6 +490,19 @@ func Default() *Config {
 			AddressRemoveGrace:            5 * time.Minute,
 			MaxConnections:                20,  // 默认最多 20 个连接
 			MaxSubscriptionsPerConnection: 150, // 每个连接最多订阅 150 个地址
+			UpstreamProbeInterval:         30 * time.Second,
+			DedupMaxEntries:               200000,
+			RateLimitInterval:             time.Minute,
+			WSPingInterval:                10 * time.Second,
+			WSReadTimeout:                 25 * time.Second,
+			SignalStreamMaxClients:        8,
+			CacheStatsLogInterval:         5 * time.Minute,
+		},
+		HealthServer: HealthServer{
+			AuthExempt: []string{"/health/live", "/health/ready"},
+		},
+		Shutdown: Shutdown{
+			DrainTimeout: 20 * time.Second,
 		},
 		MySQL: MySQL{
 			DSN:                "root:password@tcp(localhost:3306)/utrading?charset=utf8mb4&parseTime=True&loc=Local",
@@ -89,8 +514,39 @@ func Default() *Config {
 			ProxyEnabled:       false,
 			ProxyAddr:          "127.0.0.1:7890",
 		},
+		Storage: Storage{
+			Driver:      "mysql",
+			SQLitePath:  "data/hl_monitor.db",
+			AutoMigrate: true,
+
+			BreakerThreshold: 5,
+			BreakerCooldown:  10 * time.Second,
+			BufferMaxItems:   50000,
+			BufferOverflow:   "drop_non_critical",
+		},
+		BatchWriter: BatchWriter{
+			BatchSize:        100,
+			FlushInterval:    2 * time.Second,
+			TargetLatency:    200 * time.Millisecond,
+			MinBatchSize:     20,
+			MaxBatchSize:     1000,
+			MinFlushInterval: 200 * time.Millisecond,
+			MaxFlushInterval: 5 * time.Second,
+		},
 		NATS: NATS{
 			Endpoint: "nats://localhost:4222",
+			Strategy: "failover",
+			Batch: NATSBatch{
+				Enabled:     false,
+				MaxSize:     100,
+				Linger:      2 * time.Millisecond,
+				Ack:         "flush",
+				MaxInFlight: 256,
+				AckTimeout:  5 * time.Second,
+			},
+		},
+		Control: Control{
+			Subject: "hl.monitor.control",
 		},
 		Logger: Logger{
 			Level:      "info",
@@ -105,6 +561,165 @@ func Default() *Config {
 			ScanInterval: 30 * time.Second,
 			MaxRetry:     3,
 			RetryDelay:   1 * time.Second,
+
+			GroupByCloid:       true,
+			CloidReplaceWindow: 10 * time.Second,
+			SpotSellMode:       "close",
+			OidOwnerTTL:        time.Hour,
+			OidOwnerMaxSize:    100000,
+			MaxPending:         20000,
+			SwingTimeout:       30 * time.Minute,
+		},
+		StatusTracker: StatusTracker{
+			TTL:           10 * time.Minute,
+			Persist:       false,
+			FlushInterval: time.Second,
+		},
+		Dormancy: Dormancy{
+			Enabled:            false,
+			DormantAfter:       7 * 24 * time.Hour,
+			Mode:               "positions_only",
+			CheckInterval:      time.Hour,
+			ReactivateInterval: 10 * time.Minute,
+		},
+		SpotDust: SpotDust{
+			MinUSD:     1.0,
+			CoinMinUSD: map[string]float64{},
+		},
+		Reconcile: Reconcile{
+			Enabled:    false,
+			Interval:   10 * time.Minute,
+			SampleSize: 20,
+			Tolerance:  0.01,
+			SelfHeal:   false,
+		},
+		HA: HA{
+			Enabled:       false,
+			LeaseName:     "hl-monitor",
+			LeaseTTL:      3 * time.Second,
+			RenewInterval: 500 * time.Millisecond,
+		},
+		Queue: Queue{
+			Mode:       "memory",
+			Role:       "all",
+			Stream:     "HL_MONITOR_QUEUE",
+			Subject:    "hl_monitor.queue",
+			Partitions: 16,
+			Lanes:      8,
+		},
+		Webhook: Webhook{
+			Enabled:          false,
+			Timeout:          5 * time.Second,
+			MaxRetries:       3,
+			RetryBackoff:     500 * time.Millisecond,
+			QueueSize:        1000,
+			BreakerThreshold: 5,
+			BreakerCooldown:  30 * time.Second,
+		},
+		Valuation: Valuation{
+			Quote:       "USD",
+			Stablecoins: []string{"USDC", "USDT", "USDH"},
+		},
+		AddressMeta: AddressMeta{
+			ResolveVaults:   true,
+			RefreshInterval: 24 * time.Hour,
+		},
+		SelfTest: SelfTest{
+			Interval: time.Minute,
+			Address:  "0x5e1f7e5700000000000000000000000000000000",
+			Subject:  "hl_address_signal.selftest",
+			Coin:     "BTC",
+		},
+		OpenOrders: OpenOrders{
+			FlushInterval: time.Second,
+		},
+		SubscribeThrottle: SubscribeThrottle{
+			Rate:   20,
+			Jitter: 50 * time.Millisecond,
+		},
+		Fills: Fills{
+			Retention: 30 * 24 * time.Hour,
+		},
+		FillReconcile: FillReconcile{
+			RunHour:              1,
+			Tolerance:            1e-6,
+			AggregationRetention: 72 * time.Hour,
+		},
+		FillWatermark: FillWatermark{
+			Enabled:       true,
+			FlushInterval: 10 * time.Second,
+		},
+		Transfers: Transfers{
+			Enabled: false,
+			MinUSD:  0,
+		},
+		PnL: PnL{
+			Enabled:       false,
+			FlushInterval: time.Minute,
+		},
+		Outbox: Outbox{
+			Enabled:      false,
+			PollInterval: time.Second,
+			BatchSize:    100,
+		},
+		AddressGroups: AddressGroups{
+			Enabled:         false,
+			ReloadInterval:  time.Minute,
+			DefaultTemplate: "hl.signal.{group}.{symbol}",
+		},
+		Secrets: Secrets{
+			Fields:  map[string]string{},
+			Timeout: 10 * time.Second,
+			Vault:   VaultSecrets{Mount: "secret"},
+		},
+		PositionRate: PositionRate{
+			Basis:      "account_value",
+			ScopeBasis: map[string]string{},
+			StaleTTL:   2 * time.Minute,
+			StaleMode:  "annotate",
+		},
+		Severity: Severity{
+			NotableNotionalUSD:   100000,
+			CriticalNotionalUSD:  1000000,
+			NotablePositionRate:  25,
+			CriticalPositionRate: 75,
+			BoostScore:           80,
+			AddressScores:        map[string]float64{},
+			CriticalSubject:      "hl_address_signal.critical",
+		},
+		SchemaCheck: SchemaCheck{
+			Enabled:     true,
+			Every:       10,
+			LogInterval: 10 * time.Minute,
+		},
+		Capture: Capture{
+			Enabled:        false,
+			Duration:       time.Hour,
+			WorkDir:        "data/capture/work",
+			RotateInterval: 10 * time.Minute,
+			FlushSize:      256 * 1024,
+			QueueSize:      10000,
+			Storage:        "local",
+			Dir:            "data/capture",
+		},
+		SignalStats: SignalStats{
+			Interval:     10 * time.Minute,
+			LookbackDays: 1,
+		},
+		Watchdog: Watchdog{
+			CheckInterval: 10 * time.Second,
+			StallTimeout:  2 * time.Minute,
+		},
+		Symbol: Symbol{
+			PerpRules: []SymbolRule{
+				{Match: `^[a-z0-9]+:(.+)$`, Coin: "$1", Symbol: "{coin}USDC"},
+				{Match: `^([^:]+)$`, Coin: "$1", Symbol: "{coin}USDC"},
+			},
+			SpotRules: []SymbolRule{
+				{Match: `^(.+)$`, Coin: "$1", Symbol: "{coin}{quote}"},
+			},
+			MarketCtxInterval: time.Minute,
+			ApplyRenames:      true,
 		},
 	}
 }
@@ -114,6 +729,16 @@ func Load(path string) error {
 	if _, err := toml.DecodeFile(path, c); err != nil {
 		return err
 	}
+	// 覆盖顺序：TOML < 环境变量 < 密钥管理服务
+	if err := c.ApplyEnv(os.LookupEnv); err != nil {
+		return fmt.Errorf("invalid config %s:\n%w", path, err)
+	}
+	if err := c.resolveSecrets(); err != nil {
+		return fmt.Errorf("resolve secrets for %s:\n%w", path, err)
+	}
+	if err := c.Validate(); err != nil {
+		return fmt.Errorf("invalid config %s:\n%w", path, err)
+	}
 
 	info, err := os.Stat(path)
 	if err != nil {
@@ -0,0 +1,213 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/pkg/secrets"
+)
+
+// EnvPrefix 环境变量覆盖前缀，变量名为 HLM_<节>_<字段>，如 HLM_MYSQL_DSN、HLM_HL_MONITOR_MAX_CONNECTIONS
+const EnvPrefix = "HLM"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// ApplyEnv 使用环境变量覆盖配置项
+// 支持字符串、布尔、整数、浮点、时长，切片以逗号分隔，map 写作 k1=v1,k2=v2；结构体切片（如 webhook.endpoints）不支持
+func (c *Config) ApplyEnv(lookup func(string) (string, bool)) error {
+	var errs []error
+	walkFields(reflect.ValueOf(c).Elem(), nil, func(path []string, field reflect.Value) {
+		name := EnvPrefix + "_" + strings.ToUpper(strings.Join(path, "_"))
+		raw, ok := lookup(name)
+		if !ok {
+			return
+		}
+		if err := setField(field, raw); err != nil {
+			errs = append(errs, fmt.Errorf("env %s: %w", name, err))
+		}
+	})
+	return errors.Join(errs...)
+}
+
+// Set 按点分路径（如 mysql.dsn）设置配置项
+func (c *Config) Set(path, raw string) error {
+	var target reflect.Value
+	walkFields(reflect.ValueOf(c).Elem(), nil, func(p []string, field reflect.Value) {
+		if strings.Join(p, ".") == path {
+			target = field
+		}
+	})
+	if !target.IsValid() {
+		return fmt.Errorf("unknown config key %q", path)
+	}
+	return setField(target, raw)
+}
+
+// ResolveSecrets 从 [secrets] 配置的密钥管理服务读取 fields 中列出的配置项
+func (c *Config) ResolveSecrets(ctx context.Context) error {
+	if len(c.Secrets.Fields) == 0 {
+		return nil
+	}
+
+	fetcher, err := c.Secrets.fetcher()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for path, ref := range c.Secrets.Fields {
+		value, err := fetcher.Fetch(ctx, ref)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("secret for %s: %w", path, err))
+			continue
+		}
+		if err := c.Set(path, value); err != nil {
+			errs = append(errs, fmt.Errorf("secret for %s: %w", path, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Config) resolveSecrets() error {
+	timeout := c.Secrets.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return c.ResolveSecrets(ctx)
+}
+
+func (s Secrets) fetcher() (secrets.Fetcher, error) {
+	switch s.Provider {
+	case "vault":
+		address := firstNonEmpty(s.Vault.Address, os.Getenv("VAULT_ADDR"))
+		token := firstNonEmpty(s.Vault.Token, os.Getenv("VAULT_TOKEN"))
+		if address == "" || token == "" {
+			return nil, errors.New("secrets.vault: address and token are required (or VAULT_ADDR / VAULT_TOKEN)")
+		}
+		return secrets.NewVault(address, token, s.Vault.Mount), nil
+	case "aws":
+		region := firstNonEmpty(s.AWS.Region, os.Getenv("AWS_REGION"))
+		creds := secrets.AWSCredentialsFromEnv()
+		if region == "" || creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+			return nil, errors.New("secrets.aws: region and AWS_ACCESS_KEY_ID / AWS_SECRET_ACCESS_KEY are required")
+		}
+		return secrets.NewAWS(region, s.AWS.Endpoint, creds), nil
+	default:
+		return nil, fmt.Errorf("secrets.provider must be vault or aws when secrets.fields is set, got %q", s.Provider)
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// walkFields 按 toml 标签遍历可赋值的叶子字段
+func walkFields(v reflect.Value, path []string, fn func(path []string, field reflect.Value)) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, _, _ := strings.Cut(sf.Tag.Get("toml"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		field := v.Field(i)
+		fieldPath := append(append([]string{}, path...), name)
+		if sf.Type.Kind() == reflect.Struct {
+			walkFields(field, fieldPath, fn)
+			continue
+		}
+		if sf.Type.Kind() == reflect.Slice && sf.Type.Elem().Kind() == reflect.Struct {
+			continue
+		}
+		fn(fieldPath, field)
+	}
+}
+
+// setField 将字符串解析为字段类型并赋值
+func setField(field reflect.Value, raw string) error {
+	switch field.Kind() {
+	case reflect.Slice:
+		var parts []string
+		if strings.TrimSpace(raw) != "" {
+			parts = strings.Split(raw, ",")
+		}
+		slice := reflect.MakeSlice(field.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := setScalar(slice.Index(i), strings.TrimSpace(part)); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+		return nil
+	case reflect.Map:
+		m := reflect.MakeMap(field.Type())
+		for _, pair := range strings.Split(raw, ",") {
+			if strings.TrimSpace(pair) == "" {
+				continue
+			}
+			k, val, ok := strings.Cut(pair, "=")
+			if !ok {
+				return fmt.Errorf("invalid map entry %q, want key=value", pair)
+			}
+			elem := reflect.New(field.Type().Elem()).Elem()
+			if err := setScalar(elem, strings.TrimSpace(val)); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(strings.TrimSpace(k)), elem)
+		}
+		field.Set(m)
+		return nil
+	default:
+		return setScalar(field, raw)
+	}
+}
+
+func setScalar(field reflect.Value, raw string) error {
+	if field.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return nil
+}
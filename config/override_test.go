@@ -0,0 +1,104 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyEnv(t *testing.T) {
+	env := map[string]string{
+		"HLM_MYSQL_DSN":                  "user:pass@tcp(db:3306)/hl",
+		"HLM_HL_MONITOR_MAX_CONNECTIONS": "8",
+		"HLM_HL_MONITOR_WS_COMPRESSION":  "true",
+		"HLM_ORDER_AGGREGATION_TIMEOUT":  "90s",
+		"HLM_SPOT_DUST_MIN_USD":          "2.5",
+		"HLM_QUEUE_CONSUME_PARTITIONS":   "0, 2",
+		"HLM_POSITION_RATE_SCOPE_BASIS":  "server-a=withdrawable,server-b=margin_used",
+		"HLM_SECRETS_VAULT_TOKEN":        "s.token",
+		"HLM_WEBHOOK_ENDPOINTS":          "ignored",
+	}
+	lookup := func(key string) (string, bool) {
+		v, ok := env[key]
+		return v, ok
+	}
+
+	c := Default()
+	require.NoError(t, c.ApplyEnv(lookup))
+	assert.Equal(t, "user:pass@tcp(db:3306)/hl", c.MySQL.DSN)
+	assert.Equal(t, 8, c.HLMonitor.MaxConnections)
+	assert.True(t, c.HLMonitor.WSCompression)
+	assert.Equal(t, 90*time.Second, c.OrderAggregation.Timeout)
+	assert.Equal(t, 2.5, c.SpotDust.MinUSD)
+	assert.Equal(t, []int{0, 2}, c.Queue.ConsumePartitions)
+	assert.Equal(t, map[string]string{"server-a": "withdrawable", "server-b": "margin_used"}, c.PositionRate.ScopeBasis)
+	assert.Equal(t, "s.token", c.Secrets.Vault.Token)
+	assert.Empty(t, c.Webhook.Endpoints)
+
+	env = map[string]string{"HLM_HL_MONITOR_MAX_CONNECTIONS": "many", "HLM_NATS_PING_INTERVAL": "soon"}
+	err := Default().ApplyEnv(lookup)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "HLM_HL_MONITOR_MAX_CONNECTIONS")
+	assert.Contains(t, err.Error(), "HLM_NATS_PING_INTERVAL")
+}
+
+func TestResolveSecrets_Vault(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "s.token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/hl-monitor/mysql":
+			_, _ = w.Write([]byte(`{"data":{"data":{"dsn":"vault:dsn@tcp(db)/hl"}}}`))
+		case "/v1/secret/data/hl-monitor/nats":
+			_, _ = w.Write([]byte(`{"data":{"data":{"user":"hl","password":"pw"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := Default()
+	c.Secrets.Provider = "vault"
+	c.Secrets.Vault.Address = srv.URL
+	c.Secrets.Vault.Token = "s.token"
+	c.Secrets.Fields = map[string]string{
+		"mysql.dsn":     "hl-monitor/mysql#dsn",
+		"nats.user":     "hl-monitor/nats#user",
+		"nats.password": "hl-monitor/nats#password",
+	}
+	require.NoError(t, c.ResolveSecrets(context.Background()))
+	assert.Equal(t, "vault:dsn@tcp(db)/hl", c.MySQL.DSN)
+	assert.Equal(t, "hl", c.NATS.User)
+	assert.Equal(t, "pw", c.NATS.Password)
+
+	// 未知路径和缺失的密钥合并报错
+	c.Secrets.Fields = map[string]string{
+		"mysql.unknown": "hl-monitor/mysql#dsn",
+		"nats.token":    "hl-monitor/missing#token",
+	}
+	err := c.ResolveSecrets(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "mysql.unknown")
+	assert.Contains(t, err.Error(), "nats.token")
+
+	c.Secrets.Provider = ""
+	assert.Error(t, c.ResolveSecrets(context.Background()))
+}
+
+func TestLoad_EnvOverridesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cfg.toml")
+	require.NoError(t, os.WriteFile(path, []byte("[mysql]\ndsn = \"from-file\"\n"), 0o644))
+	t.Setenv("HLM_MYSQL_DSN", "from-env")
+
+	require.NoError(t, Load(path))
+	assert.Equal(t, "from-env", Get().MySQL.DSN)
+}
@@ -0,0 +1,395 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Validate 校验配置并补全可省略字段的默认值
+// 所有问题合并为一个错误返回，便于一次修正；Load 在替换当前配置前调用，
+// 因此启动时快速失败，热重载失败时保留原配置
+func (c *Config) Validate() error {
+	v := &validator{}
+
+	c.applyDefaults()
+
+	// 必填项
+	v.required("hl_monitor.hyperliquid_ws_url", c.HLMonitor.HyperliquidWSURL)
+	if u := c.HLMonitor.HyperliquidWSURL; u != "" && !strings.HasPrefix(u, "ws://") && !strings.HasPrefix(u, "wss://") {
+		v.addf("hl_monitor.hyperliquid_ws_url must start with ws:// or wss://, got %q", u)
+	}
+	v.oneOf("storage.driver", c.Storage.Driver, "mysql", "sqlite")
+	switch c.Storage.Driver {
+	case "mysql":
+		v.required("mysql.dsn", c.MySQL.DSN)
+		if c.MySQL.ProxyEnabled {
+			v.required("mysql.proxy_addr", c.MySQL.ProxyAddr)
+		}
+	case "sqlite":
+		v.required("storage.sqlite_path", c.Storage.SQLitePath)
+	}
+	v.atLeast("storage.breaker_threshold", c.Storage.BreakerThreshold, 0)
+	if c.Storage.BreakerThreshold > 0 {
+		v.positive("storage.breaker_cooldown", c.Storage.BreakerCooldown)
+	}
+	v.atLeast("storage.buffer_max_items", c.Storage.BufferMaxItems, 1)
+	v.oneOf("storage.buffer_overflow", c.Storage.BufferOverflow, "drop_new", "drop_non_critical")
+	v.atLeast("batch_writer.batch_size", c.BatchWriter.BatchSize, 1)
+	v.positive("batch_writer.flush_interval", c.BatchWriter.FlushInterval)
+	if c.BatchWriter.Adaptive {
+		v.positive("batch_writer.target_latency", c.BatchWriter.TargetLatency)
+		v.atLeast("batch_writer.min_batch_size", c.BatchWriter.MinBatchSize, 1)
+		v.atLeast("batch_writer.max_batch_size", c.BatchWriter.MaxBatchSize, c.BatchWriter.MinBatchSize)
+		v.positive("batch_writer.min_flush_interval", c.BatchWriter.MinFlushInterval)
+		if c.BatchWriter.MaxFlushInterval < c.BatchWriter.MinFlushInterval {
+			v.addf("batch_writer.max_flush_interval must be >= min_flush_interval (%s), got %s",
+				c.BatchWriter.MinFlushInterval, c.BatchWriter.MaxFlushInterval)
+		}
+	}
+	v.required("nats.endpoint", c.NATS.Endpoint)
+	v.oneOf("nats.strategy", c.NATS.Strategy, "failover", "fanout")
+	natsNames := map[string]bool{"primary": true}
+	for i, ep := range c.NATS.Standby {
+		v.required(fmt.Sprintf("nats.standby[%d].name", i), ep.Name)
+		v.required(fmt.Sprintf("nats.standby[%d].endpoint", i), ep.Endpoint)
+		if natsNames[ep.Name] {
+			v.addf("nats.standby[%d].name %q is duplicated (primary is reserved)", i, ep.Name)
+		}
+		natsNames[ep.Name] = true
+	}
+	if c.NATS.Batch.Enabled {
+		v.atLeast("nats.batch.max_size", c.NATS.Batch.MaxSize, 1)
+		v.nonNegative("nats.batch.linger", c.NATS.Batch.Linger)
+		v.oneOf("nats.batch.ack", c.NATS.Batch.Ack, "none", "flush", "jetstream")
+		v.atLeast("nats.batch.max_in_flight", c.NATS.Batch.MaxInFlight, 1)
+		v.positive("nats.batch.ack_timeout", c.NATS.Batch.AckTimeout)
+	}
+
+	// 连接与订阅
+	v.atLeast("hl_monitor.max_connections", c.HLMonitor.MaxConnections, 1)
+	v.atLeast("hl_monitor.max_subscriptions_per_connection", c.HLMonitor.MaxSubscriptionsPerConnection, 1)
+	v.positive("hl_monitor.address_reload_interval", c.HLMonitor.AddressReloadInterval)
+	v.nonNegative("hl_monitor.address_remove_grace", c.HLMonitor.AddressRemoveGrace)
+	v.positive("hl_monitor.upstream_probe_interval", c.HLMonitor.UpstreamProbeInterval)
+	v.positive("hl_monitor.ws_ping_interval", c.HLMonitor.WSPingInterval)
+	if c.HLMonitor.WSReadTimeout <= c.HLMonitor.WSPingInterval {
+		v.addf("hl_monitor.ws_read_timeout (%s) must be greater than ws_ping_interval (%s)", c.HLMonitor.WSReadTimeout, c.HLMonitor.WSPingInterval)
+	}
+	if p := c.HLMonitor.WSProxy; p != "" {
+		if u, err := url.Parse(p); err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "socks5") {
+			v.addf("hl_monitor.ws_proxy must be an http:// or socks5:// URL, got %q", p)
+		}
+	}
+	v.atLeast("hl_monitor.signal_stream_max_clients", c.HLMonitor.SignalStreamMaxClients, 0)
+	v.nonNegative("hl_monitor.cache_stats_log_interval", c.HLMonitor.CacheStatsLogInterval)
+	if c.HLMonitor.RateLimitAddress != "" {
+		v.positive("hl_monitor.rate_limit_interval", c.HLMonitor.RateLimitInterval)
+	}
+	if (c.HealthServer.TLSCertFile == "") != (c.HealthServer.TLSKeyFile == "") {
+		v.addf("health_server.tls_cert_file and tls_key_file must be set together")
+	}
+	if c.HealthServer.ClientCAFile != "" && c.HealthServer.TLSCertFile == "" {
+		v.addf("health_server.client_ca_file requires tls_cert_file and tls_key_file")
+	}
+	v.atLeast("health_server.ready_max_write_backlog", c.HealthServer.ReadyMaxWriteBacklog, 0)
+	v.atLeast("health_server.ready_max_queue_depth", c.HealthServer.ReadyMaxQueueDepth, 0)
+	if c.Control.Enabled && c.Control.Subject == "" {
+		v.addf("control.subject is required when control is enabled")
+	}
+	if c.Shutdown.DrainTimeout <= 0 {
+		v.addf("shutdown.drain_timeout must be > 0, got %v", c.Shutdown.DrainTimeout)
+	}
+	if c.SubscribeThrottle.Rate < 0 {
+		v.addf("subscribe_throttle.rate must be >= 0, got %v", c.SubscribeThrottle.Rate)
+	}
+	v.nonNegative("subscribe_throttle.jitter", c.SubscribeThrottle.Jitter)
+
+	v.oneOf("log.level", c.Logger.Level, "trace", "debug", "info", "warn", "error", "fatal", "panic")
+
+	// 订单聚合
+	v.positive("order_aggregation.timeout", c.OrderAggregation.Timeout)
+	v.positive("order_aggregation.scan_interval", c.OrderAggregation.ScanInterval)
+	v.atLeast("order_aggregation.max_retry", c.OrderAggregation.MaxRetry, 0)
+	v.nonNegative("order_aggregation.retry_delay", c.OrderAggregation.RetryDelay)
+	v.nonNegative("order_aggregation.oid_owner_ttl", c.OrderAggregation.OidOwnerTTL)
+	v.atLeast("order_aggregation.oid_owner_max_size", c.OrderAggregation.OidOwnerMaxSize, 0)
+	v.positive("order_aggregation.swing_timeout", c.OrderAggregation.SwingTimeout)
+	v.atLeast("hl_monitor.dedup_max_entries", c.HLMonitor.DedupMaxEntries, 0)
+	v.oneOf("order_aggregation.spot_sell_mode", c.OrderAggregation.SpotSellMode, "close", "detect")
+	for _, threshold := range c.OrderAggregation.ProgressThresholds {
+		if threshold <= 0 || threshold >= 100 {
+			v.addf("order_aggregation.progress_thresholds must be in (0, 100), got %v", threshold)
+		}
+	}
+	if c.OrderAggregation.GroupByCloid {
+		v.nonNegative("order_aggregation.cloid_replace_window", c.OrderAggregation.CloidReplaceWindow)
+	}
+	v.positive("status_tracker.ttl", c.StatusTracker.TTL)
+	if c.StatusTracker.Persist {
+		v.positive("status_tracker.flush_interval", c.StatusTracker.FlushInterval)
+	}
+
+	if c.SpotDust.MinUSD < 0 {
+		v.addf("spot_dust.min_usd must be >= 0, got %v", c.SpotDust.MinUSD)
+	}
+
+	// 可选功能仅在启用时校验
+	if c.Dormancy.Enabled {
+		v.positive("dormancy.dormant_after", c.Dormancy.DormantAfter)
+		v.positive("dormancy.check_interval", c.Dormancy.CheckInterval)
+		v.positive("dormancy.reactivate_interval", c.Dormancy.ReactivateInterval)
+		v.oneOf("dormancy.mode", c.Dormancy.Mode, "positions_only", "unsubscribe")
+	}
+	if c.Reconcile.Enabled {
+		v.positive("reconcile.interval", c.Reconcile.Interval)
+		v.atLeast("reconcile.sample_size", c.Reconcile.SampleSize, 0)
+		if c.Reconcile.Tolerance < 0 {
+			v.addf("reconcile.tolerance must be >= 0, got %v", c.Reconcile.Tolerance)
+		}
+	}
+	if c.HA.Enabled {
+		v.required("ha.lease_name", c.HA.LeaseName)
+		v.positive("ha.lease_ttl", c.HA.LeaseTTL)
+		v.positive("ha.renew_interval", c.HA.RenewInterval)
+		if c.HA.RenewInterval >= c.HA.LeaseTTL && c.HA.LeaseTTL > 0 {
+			v.addf("ha.renew_interval (%s) must be shorter than ha.lease_ttl (%s)", c.HA.RenewInterval, c.HA.LeaseTTL)
+		}
+	}
+	v.oneOf("queue.mode", c.Queue.Mode, "memory", "nats")
+	v.oneOf("queue.role", c.Queue.Role, "all", "ingest", "process")
+	v.atLeast("queue.lanes", c.Queue.Lanes, 1)
+	if c.Queue.Mode == "nats" {
+		v.required("queue.stream", c.Queue.Stream)
+		v.required("queue.subject", c.Queue.Subject)
+		v.atLeast("queue.partitions", c.Queue.Partitions, 1)
+		for _, p := range c.Queue.ConsumePartitions {
+			if p < 0 || p >= c.Queue.Partitions {
+				v.addf("queue.consume_partitions: partition %d out of range [0, %d)", p, c.Queue.Partitions)
+			}
+		}
+	}
+	if c.Webhook.Enabled {
+		if len(c.Webhook.Endpoints) == 0 {
+			v.addf("webhook.endpoints must not be empty when webhook is enabled")
+		}
+		for i, ep := range c.Webhook.Endpoints {
+			v.required(fmt.Sprintf("webhook.endpoints[%d].url", i), ep.URL)
+			if ep.MinSeverity != "" {
+				v.oneOf(fmt.Sprintf("webhook.endpoints[%d].min_severity", i), ep.MinSeverity, "info", "notable", "critical")
+			}
+		}
+		v.positive("webhook.timeout", c.Webhook.Timeout)
+		v.atLeast("webhook.max_retries", c.Webhook.MaxRetries, 0)
+		v.atLeast("webhook.queue_size", c.Webhook.QueueSize, 1)
+	}
+	if c.AddressMeta.Enabled && c.AddressMeta.ResolveVaults {
+		v.positive("address_meta.refresh_interval", c.AddressMeta.RefreshInterval)
+	}
+	if c.SelfTest.Enabled {
+		v.positive("selftest.interval", c.SelfTest.Interval)
+		v.required("selftest.address", c.SelfTest.Address)
+		v.required("selftest.subject", c.SelfTest.Subject)
+		v.required("selftest.coin", c.SelfTest.Coin)
+	}
+	if c.OpenOrders.Enabled {
+		v.positive("open_orders.flush_interval", c.OpenOrders.FlushInterval)
+	}
+	if c.Fills.Enabled {
+		v.nonNegative("fills.retention", c.Fills.Retention)
+	}
+	if c.FillReconcile.Enabled {
+		if !c.Fills.Enabled {
+			v.addf("fill_reconcile requires fills.enabled")
+		}
+		if c.FillReconcile.RunHour < 0 || c.FillReconcile.RunHour > 23 {
+			v.addf("fill_reconcile.run_hour must be within [0, 23], got %d", c.FillReconcile.RunHour)
+		}
+		if c.FillReconcile.Tolerance < 0 {
+			v.addf("fill_reconcile.tolerance must be >= 0, got %v", c.FillReconcile.Tolerance)
+		}
+		// 对账在次日 run_hour 执行，聚合和成交需保留到对账完成
+		if c.FillReconcile.AggregationRetention < 48*time.Hour {
+			v.addf("fill_reconcile.aggregation_retention must be >= 48h, got %v", c.FillReconcile.AggregationRetention)
+		}
+		if c.Fills.Retention > 0 && c.Fills.Retention < 48*time.Hour {
+			v.addf("fills.retention must be 0 or >= 48h when fill_reconcile is enabled, got %v", c.Fills.Retention)
+		}
+	}
+	if c.FillWatermark.Enabled {
+		v.positive("fill_watermark.flush_interval", c.FillWatermark.FlushInterval)
+	}
+	if c.PnL.Enabled {
+		v.positive("pnl.flush_interval", c.PnL.FlushInterval)
+	}
+	if c.SignalStats.Enabled {
+		v.positive("signal_stats.interval", c.SignalStats.Interval)
+		if d := c.SignalStats.LookbackDays; d < 0 || d > 6 {
+			v.addf("signal_stats.lookback_days must be between 0 and 6 (signals are kept for 7 days), got %d", d)
+		}
+	}
+	if c.Watchdog.Enabled {
+		v.positive("watchdog.check_interval", c.Watchdog.CheckInterval)
+		minStall := max(30*time.Second, c.BatchWriter.FlushInterval)
+		if c.BatchWriter.Adaptive {
+			minStall = max(minStall, c.BatchWriter.MaxFlushInterval)
+		}
+		if c.Watchdog.StallTimeout <= minStall {
+			v.addf("watchdog.stall_timeout must be greater than %s (timeout scan and batch writer flush interval), got %s",
+				minStall, c.Watchdog.StallTimeout)
+		}
+	}
+	if c.Outbox.Enabled {
+		v.positive("outbox.poll_interval", c.Outbox.PollInterval)
+		v.atLeast("outbox.batch_size", c.Outbox.BatchSize, 1)
+	}
+	if c.AddressGroups.Enabled {
+		v.positive("address_groups.reload_interval", c.AddressGroups.ReloadInterval)
+	}
+	v.nonNegative("position_rate.stale_ttl", c.PositionRate.StaleTTL)
+	v.oneOf("position_rate.stale_mode", c.PositionRate.StaleMode, "annotate", "skip")
+	if c.SchemaCheck.Enabled {
+		v.atLeast("schema_check.every", c.SchemaCheck.Every, 1)
+		v.positive("schema_check.log_interval", c.SchemaCheck.LogInterval)
+	}
+	if c.Capture.Enabled {
+		v.nonNegative("capture.duration", c.Capture.Duration)
+		v.required("capture.work_dir", c.Capture.WorkDir)
+		v.positive("capture.rotate_interval", c.Capture.RotateInterval)
+		v.atLeast("capture.flush_size", c.Capture.FlushSize, 1)
+		v.atLeast("capture.queue_size", c.Capture.QueueSize, 1)
+		v.oneOf("capture.storage", c.Capture.Storage, "local", "s3")
+		switch c.Capture.Storage {
+		case "local":
+			v.required("capture.dir", c.Capture.Dir)
+		case "s3":
+			v.required("capture.s3.bucket", c.Capture.S3.Bucket)
+		}
+	}
+	if c.Severity.Enabled {
+		s := c.Severity
+		for _, f := range []struct {
+			key   string
+			value float64
+		}{
+			{"severity.notable_notional_usd", s.NotableNotionalUSD},
+			{"severity.critical_notional_usd", s.CriticalNotionalUSD},
+			{"severity.notable_position_rate", s.NotablePositionRate},
+			{"severity.critical_position_rate", s.CriticalPositionRate},
+			{"severity.boost_score", s.BoostScore},
+		} {
+			if f.value < 0 {
+				v.addf("%s must be >= 0, got %v", f.key, f.value)
+			}
+		}
+		if s.NotableNotionalUSD > 0 && s.CriticalNotionalUSD > 0 && s.CriticalNotionalUSD < s.NotableNotionalUSD {
+			v.addf("severity.critical_notional_usd must be >= notable_notional_usd, got %v", s.CriticalNotionalUSD)
+		}
+		if s.NotablePositionRate > 0 && s.CriticalPositionRate > 0 && s.CriticalPositionRate < s.NotablePositionRate {
+			v.addf("severity.critical_position_rate must be >= notable_position_rate, got %v", s.CriticalPositionRate)
+		}
+	}
+	if c.Transfers.MinUSD < 0 {
+		v.addf("transfers.min_usd must be >= 0, got %v", c.Transfers.MinUSD)
+	}
+
+	return errors.Join(v.errs...)
+}
+
+// applyDefaults 补全显式置空或置零的可省略字段，取值与 Default() 一致
+// 仅覆盖组件本身也按默认值处理的字段，必填项和必须为正的间隔仍由 Validate 报错
+func (c *Config) applyDefaults() {
+	defaults := Default()
+
+	setDefault(&c.Storage.Driver, defaults.Storage.Driver)
+	setDefault(&c.Storage.SQLitePath, defaults.Storage.SQLitePath)
+	setDefault(&c.Storage.BufferOverflow, defaults.Storage.BufferOverflow)
+	setDefault(&c.Logger.Level, defaults.Logger.Level)
+	setDefault(&c.NATS.Strategy, defaults.NATS.Strategy)
+	setDefault(&c.Dormancy.Mode, defaults.Dormancy.Mode)
+	setDefault(&c.OrderAggregation.SpotSellMode, defaults.OrderAggregation.SpotSellMode)
+	setDefault(&c.Queue.Mode, defaults.Queue.Mode)
+	setDefault(&c.Queue.Role, defaults.Queue.Role)
+	setDefault(&c.Valuation.Quote, defaults.Valuation.Quote)
+	setDefault(&c.HA.LeaseName, defaults.HA.LeaseName)
+	setDefault(&c.PositionRate.Basis, defaults.PositionRate.Basis)
+	setDefault(&c.PositionRate.StaleMode, defaults.PositionRate.StaleMode)
+	setDefault(&c.SelfTest.Address, defaults.SelfTest.Address)
+	setDefault(&c.SelfTest.Subject, defaults.SelfTest.Subject)
+	setDefault(&c.SelfTest.Coin, defaults.SelfTest.Coin)
+	setDefault(&c.AddressGroups.DefaultTemplate, defaults.AddressGroups.DefaultTemplate)
+	setDefault(&c.Capture.Storage, defaults.Capture.Storage)
+	setDefault(&c.NATS.Batch.Ack, defaults.NATS.Batch.Ack)
+
+	setDefaultDuration(&c.HLMonitor.WSPingInterval, defaults.HLMonitor.WSPingInterval)
+	setDefaultDuration(&c.HLMonitor.WSReadTimeout, defaults.HLMonitor.WSReadTimeout)
+	setDefaultDuration(&c.HA.LeaseTTL, defaults.HA.LeaseTTL)
+	setDefaultDuration(&c.HA.RenewInterval, defaults.HA.RenewInterval)
+	setDefaultDuration(&c.Reconcile.Interval, defaults.Reconcile.Interval)
+	setDefaultDuration(&c.Webhook.Timeout, defaults.Webhook.Timeout)
+	setDefaultDuration(&c.SelfTest.Interval, defaults.SelfTest.Interval)
+	setDefaultDuration(&c.OpenOrders.FlushInterval, defaults.OpenOrders.FlushInterval)
+	setDefaultDuration(&c.StatusTracker.TTL, defaults.StatusTracker.TTL)
+	if c.Webhook.QueueSize <= 0 {
+		c.Webhook.QueueSize = defaults.Webhook.QueueSize
+	}
+
+	c.Storage.Driver = strings.ToLower(c.Storage.Driver)
+	c.Storage.BufferOverflow = strings.ToLower(c.Storage.BufferOverflow)
+	c.Logger.Level = strings.ToLower(c.Logger.Level)
+	c.NATS.Strategy = strings.ToLower(c.NATS.Strategy)
+}
+
+func setDefault(field *string, value string) {
+	if strings.TrimSpace(*field) == "" {
+		*field = value
+	}
+}
+
+func setDefaultDuration(field *time.Duration, value time.Duration) {
+	if *field == 0 {
+		*field = value
+	}
+}
+
+// validator 收集校验错误
+type validator struct {
+	errs []error
+}
+
+func (v *validator) addf(format string, args ...any) {
+	v.errs = append(v.errs, fmt.Errorf(format, args...))
+}
+
+func (v *validator) required(key, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.addf("%s is required", key)
+	}
+}
+
+func (v *validator) oneOf(key, value string, allowed ...string) {
+	if !slices.Contains(allowed, value) {
+		v.addf("%s must be one of %s, got %q", key, strings.Join(allowed, "/"), value)
+	}
+}
+
+func (v *validator) atLeast(key string, value, min int) {
+	if value < min {
+		v.addf("%s must be >= %d, got %d", key, min, value)
+	}
+}
+
+func (v *validator) positive(key string, d time.Duration) {
+	if d <= 0 {
+		v.addf("%s must be > 0, got %s", key, d)
+	}
+}
+
+func (v *validator) nonNegative(key string, d time.Duration) {
+	if d < 0 {
+		v.addf("%s must be >= 0, got %s", key, d)
+	}
+}
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate_Default(t *testing.T) {
+	assert.NoError(t, Default().Validate())
+}
+
+func TestValidate_AggregatesErrors(t *testing.T) {
+	c := Default()
+	c.HLMonitor.HyperliquidWSURL = "https://api.hyperliquid.xyz"
+	c.HLMonitor.MaxConnections = 0
+	c.HLMonitor.AddressReloadInterval = 0
+	c.HLMonitor.WSReadTimeout = 5 * time.Second
+	c.MySQL.DSN = ""
+	c.NATS.Endpoint = ""
+	c.Queue.Mode = "kafka"
+	c.HealthServer.ClientCAFile = "ca.crt"
+	c.Shutdown.DrainTimeout = 0
+	c.Control.Enabled = true
+	c.Control.Subject = ""
+	c.FillReconcile.Enabled = true
+	c.FillReconcile.RunHour = 24
+	c.HLMonitor.DedupMaxEntries = -1
+	c.PositionRate.StaleTTL = -time.Second
+	c.Severity.Enabled = true
+	c.Severity.CriticalNotionalUSD = 1000
+	c.Capture.Enabled = true
+	c.Capture.Storage = "s3"
+	c.NATS.Batch.Enabled = true
+	c.NATS.Batch.MaxSize = 0
+	c.OrderAggregation.SwingTimeout = 0
+	c.HLMonitor.WSProxy = "https://proxy:3128"
+	c.SignalStats.Enabled = true
+	c.SignalStats.LookbackDays = 7
+	c.HealthServer.ReadyMaxQueueDepth = -1
+	c.Watchdog.Enabled = true
+	c.Watchdog.StallTimeout = 30 * time.Second
+	c.OrderAggregation.ProgressThresholds = []float64{50, 100}
+	c.HLMonitor.CacheStatsLogInterval = -time.Minute
+
+	err := c.Validate()
+	require.Error(t, err)
+	for _, key := range []string{
+		"hl_monitor.hyperliquid_ws_url", "hl_monitor.max_connections", "hl_monitor.address_reload_interval",
+		"hl_monitor.ws_read_timeout", "mysql.dsn", "nats.endpoint", "queue.mode", "health_server.client_ca_file",
+		"shutdown.drain_timeout", "control.subject", "fill_reconcile requires fills.enabled", "fill_reconcile.run_hour",
+		"hl_monitor.dedup_max_entries", "position_rate.stale_ttl",
+		"severity.critical_notional_usd", "capture.s3.bucket",
+		"nats.batch.max_size", "order_aggregation.swing_timeout",
+		"hl_monitor.ws_proxy", "signal_stats.lookback_days", "health_server.ready_max_queue_depth",
+		"watchdog.stall_timeout", "order_aggregation.progress_thresholds", "hl_monitor.cache_stats_log_interval",
+	} {
+		assert.Contains(t, err.Error(), key)
+	}
+}
+
+func TestValidate_AppliesDefaults(t *testing.T) {
+	c := Default()
+	c.Storage.Driver = ""
+	c.Logger.Level = "DEBUG"
+	c.Queue.Role = ""
+	c.HA.Enabled = true
+	c.HA.LeaseTTL = 0
+	c.OpenOrders.FlushInterval = 0
+
+	require.NoError(t, c.Validate())
+	assert.Equal(t, "mysql", c.Storage.Driver)
+	assert.Equal(t, "debug", c.Logger.Level)
+	assert.Equal(t, "all", c.Queue.Role)
+	assert.Equal(t, 3*time.Second, c.HA.LeaseTTL)
+	assert.Equal(t, time.Second, c.OpenOrders.FlushInterval)
+
+	// SQLite 模式不要求 DSN
+	c.Storage.Driver = "sqlite"
+	c.MySQL.DSN = ""
+	assert.NoError(t, c.Validate())
+}
+
+func TestLoad_InvalidConfigKeepsCurrent(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.toml")
+	invalid := filepath.Join(dir, "invalid.toml")
+	require.NoError(t, os.WriteFile(valid, []byte("[hl_monitor]\nmax_connections = 5\n"), 0o644))
+	require.NoError(t, os.WriteFile(invalid, []byte("[hl_monitor]\nmax_connections = 0\n"), 0o644))
+
+	require.NoError(t, Load(valid))
+	err := Load(invalid)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "hl_monitor.max_connections must be >= 1")
+	assert.Equal(t, 5, Get().HLMonitor.MaxConnections)
+}
@@ -0,0 +1,420 @@
+package address
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// 休眠降级模式
+const (
+	DormancyModePositionsOnly = "positions_only" // 仅保留仓位订阅
+	DormancyModeUnsubscribe   = "unsubscribe"    // 完全取消订阅
+)
+
+// FillChecker 通过 REST 查询地址成交（由 hyperliquid.Info 实现）
+type FillChecker interface {
+	UserFillsByTime(ctx context.Context, address string, startTime int64, endTime *int64) ([]hl.Fill, error)
+}
+
+// ActivityStore 地址活跃度持久化接口
+type ActivityStore interface {
+	ListAll() ([]*models.HlAddressActivity, error)
+	BatchUpsertLastActive(lastActive map[string]time.Time) error
+	MarkDormant(address string, lastActive, since time.Time) error
+	MarkActive(address string, lastActive time.Time) error
+}
+
+// addressActivity 单个地址的活跃状态
+type addressActivity struct {
+	lastActive   time.Time
+	dormant      bool
+	dormantSince time.Time
+	subscribed   bool // 是否由加载器订阅中
+	dirty        bool // lastActive 是否待持久化
+}
+
+// DormancyManager 休眠地址管理器
+// 作为 AddressSubscriber 包装成交订阅与仓位订阅：长时间无成交且无仓位变化的地址
+// 自动降级（仅保留仓位订阅或完全取消订阅），并通过 REST 定期检查恢复活跃
+type DormancyManager struct {
+	fillSubs     []AddressSubscriber
+	positionSubs []AddressSubscriber
+	checker      FillChecker
+	store        ActivityStore
+	cfg          config.Dormancy
+
+	states map[string]*addressActivity
+	mu     sync.Mutex
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ AddressSubscriber = (*DormancyManager)(nil)
+
+// NewDormancyManager 创建休眠地址管理器
+// fillSubs 为成交订阅者（休眠即取消），positionSubs 为仓位订阅者（positions_only 模式下保留）
+func NewDormancyManager(
+	fillSubs, positionSubs []AddressSubscriber,
+	checker FillChecker,
+	cfg config.Dormancy,
+) *DormancyManager {
+	ctx, cancel := context.WithCancel(context.Background())
+	if cfg.Mode != DormancyModeUnsubscribe {
+		cfg.Mode = DormancyModePositionsOnly
+	}
+	return &DormancyManager{
+		fillSubs:     fillSubs,
+		positionSubs: positionSubs,
+		checker:      checker,
+		store:        dao.AddressActivity(),
+		cfg:          cfg,
+		states:       make(map[string]*addressActivity),
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+}
+
+// SetStore 设置持久化存储（可选，用于测试）
+func (d *DormancyManager) SetStore(store ActivityStore) {
+	d.store = store
+}
+
+// Start 从数据库加载活跃度并启动后台检查，需在地址加载器启动前调用
+func (d *DormancyManager) Start() error {
+	rows, err := d.store.ListAll()
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	for _, row := range rows {
+		st := &addressActivity{
+			lastActive: row.LastActiveAt,
+			dormant:    row.Dormant,
+		}
+		if row.DormantSince != nil {
+			st.dormantSince = *row.DormantSince
+		} else if row.Dormant {
+			st.dormantSince = row.LastActiveAt
+		}
+		d.states[row.Address] = st
+	}
+	d.mu.Unlock()
+
+	d.wg.Add(1)
+	goplus.Go(func() {
+		defer d.wg.Done()
+		d.run()
+	})
+
+	logger.Info().
+		Int("loaded", len(rows)).
+		Dur("dormant_after", d.cfg.DormantAfter).
+		Str("mode", d.cfg.Mode).
+		Msg("dormancy manager started")
+	return nil
+}
+
+// Stop 停止后台检查并持久化活跃时间
+func (d *DormancyManager) Stop() {
+	d.cancel()
+	d.wg.Wait()
+	d.flushLastActive()
+}
+
+func (d *DormancyManager) run() {
+	checkTicker := time.NewTicker(d.cfg.CheckInterval)
+	defer checkTicker.Stop()
+	reactivateTicker := time.NewTicker(d.cfg.ReactivateInterval)
+	defer reactivateTicker.Stop()
+
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case <-checkTicker.C:
+			d.flushLastActive()
+			d.checkDormant(time.Now())
+		case <-reactivateTicker.C:
+			d.checkReactivate()
+		}
+	}
+}
+
+// SubscribeAddress 订阅地址，休眠地址按降级模式订阅
+func (d *DormancyManager) SubscribeAddress(addr string) error {
+	d.mu.Lock()
+	st, ok := d.states[addr]
+	if !ok {
+		// 新地址从订阅时开始计算休眠窗口
+		st = &addressActivity{lastActive: time.Now(), dirty: true}
+		d.states[addr] = st
+	}
+	st.subscribed = true
+	dormant := st.dormant
+	d.mu.Unlock()
+
+	d.updateDormantGauge()
+
+	if dormant {
+		return d.subscribeDegraded(addr)
+	}
+	return subscribeAll(addr, d.fillSubs, d.positionSubs)
+}
+
+// UnsubscribeAddress 取消订阅地址（所有订阅者）
+func (d *DormancyManager) UnsubscribeAddress(addr string) error {
+	d.mu.Lock()
+	if st, ok := d.states[addr]; ok {
+		st.subscribed = false
+	}
+	d.mu.Unlock()
+
+	d.updateDormantGauge()
+
+	return unsubscribeAll(addr, d.fillSubs, d.positionSubs)
+}
+
+// Touch 记录地址活跃（成交或仓位变化），休眠地址将立即恢复
+func (d *DormancyManager) Touch(addr string) {
+	now := time.Now()
+
+	d.mu.Lock()
+	st, ok := d.states[addr]
+	if !ok || !st.subscribed {
+		d.mu.Unlock()
+		return
+	}
+	st.lastActive = now
+	st.dirty = true
+	dormant := st.dormant
+	d.mu.Unlock()
+
+	if dormant {
+		// 避免在 ws 回调中同步订阅
+		goplus.Go(func() {
+			d.reactivate(addr, now)
+		})
+	}
+}
+
+// IsDormant 判断地址是否处于休眠
+func (d *DormancyManager) IsDormant(addr string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	st, ok := d.states[addr]
+	return ok && st.dormant
+}
+
+// DormantCount 获取休眠中的订阅地址数量
+func (d *DormancyManager) DormantCount() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	count := 0
+	for _, st := range d.states {
+		if st.subscribed && st.dormant {
+			count++
+		}
+	}
+	return count
+}
+
+func (d *DormancyManager) updateDormantGauge() {
+	monitor.SetAddressesDormant(d.DormantCount())
+}
+
+// checkDormant 将超过休眠阈值的地址降级
+func (d *DormancyManager) checkDormant(now time.Time) {
+	var toDowngrade []string
+	lastActive := make(map[string]time.Time)
+
+	d.mu.Lock()
+	for addr, st := range d.states {
+		if !st.subscribed || st.dormant {
+			continue
+		}
+		if now.Sub(st.lastActive) >= d.cfg.DormantAfter {
+			st.dormant = true
+			st.dormantSince = now
+			toDowngrade = append(toDowngrade, addr)
+			lastActive[addr] = st.lastActive
+		}
+	}
+	d.mu.Unlock()
+
+	for _, addr := range toDowngrade {
+		if err := d.store.MarkDormant(addr, lastActive[addr], now); err != nil {
+			logger.Error().Err(err).Str("address", addr).Msg("mark address dormant failed")
+		}
+
+		subs := d.fillSubs
+		if d.cfg.Mode == DormancyModeUnsubscribe {
+			subs = append(append([]AddressSubscriber{}, d.fillSubs...), d.positionSubs...)
+		}
+		for _, sub := range subs {
+			if err := sub.UnsubscribeAddress(addr); err != nil {
+				logger.Error().Err(err).Str("address", addr).Msg("downgrade dormant address failed")
+			}
+		}
+
+		logger.Info().
+			Str("address", addr).
+			Time("last_active", lastActive[addr]).
+			Str("mode", d.cfg.Mode).
+			Msg("address marked dormant")
+	}
+
+	if len(toDowngrade) > 0 {
+		d.updateDormantGauge()
+	}
+}
+
+// checkReactivate 通过 REST 检查休眠地址是否有新成交
+func (d *DormancyManager) checkReactivate() {
+	if d.checker == nil {
+		return
+	}
+
+	type candidate struct {
+		addr  string
+		since time.Time
+	}
+
+	var candidates []candidate
+	d.mu.Lock()
+	for addr, st := range d.states {
+		if st.subscribed && st.dormant {
+			candidates = append(candidates, candidate{addr: addr, since: st.dormantSince})
+		}
+	}
+	d.mu.Unlock()
+
+	for _, c := range candidates {
+		select {
+		case <-d.ctx.Done():
+			return
+		default:
+		}
+
+		ctx, cancel := context.WithTimeout(d.ctx, 10*time.Second)
+		fills, err := d.checker.UserFillsByTime(ctx, c.addr, c.since.UnixMilli(), nil)
+		cancel()
+		if err != nil {
+			logger.Warn().Err(err).Str("address", c.addr).Msg("dormant address fill check failed")
+			continue
+		}
+		if len(fills) == 0 {
+			continue
+		}
+
+		var latest int64
+		for _, f := range fills {
+			if f.Time > latest {
+				latest = f.Time
+			}
+		}
+		d.reactivate(c.addr, time.UnixMilli(latest))
+	}
+}
+
+// reactivate 恢复休眠地址的完整订阅
+func (d *DormancyManager) reactivate(addr string, activeAt time.Time) {
+	d.mu.Lock()
+	st, ok := d.states[addr]
+	if !ok || !st.dormant || !st.subscribed {
+		d.mu.Unlock()
+		return
+	}
+	st.dormant = false
+	st.dormantSince = time.Time{}
+	if activeAt.After(st.lastActive) {
+		st.lastActive = activeAt
+	}
+	lastActive := st.lastActive
+	st.dirty = false
+	d.mu.Unlock()
+
+	if err := d.store.MarkActive(addr, lastActive); err != nil {
+		logger.Error().Err(err).Str("address", addr).Msg("mark address active failed")
+	}
+
+	if err := subscribeAll(addr, d.fillSubs, d.positionSubs); err != nil {
+		logger.Error().Err(err).Str("address", addr).Msg("reactivate address failed")
+	}
+
+	d.updateDormantGauge()
+
+	logger.Info().Str("address", addr).Time("last_active", lastActive).Msg("dormant address reactivated")
+}
+
+// subscribeDegraded 按降级模式订阅休眠地址
+func (d *DormancyManager) subscribeDegraded(addr string) error {
+	if d.cfg.Mode == DormancyModeUnsubscribe {
+		return nil
+	}
+	return subscribeAll(addr, d.positionSubs)
+}
+
+// flushLastActive 持久化变更的活跃时间
+func (d *DormancyManager) flushLastActive() {
+	pending := make(map[string]time.Time)
+
+	d.mu.Lock()
+	for addr, st := range d.states {
+		if st.dirty {
+			pending[addr] = st.lastActive
+			st.dirty = false
+		}
+	}
+	d.mu.Unlock()
+
+	if err := d.store.BatchUpsertLastActive(pending); err != nil {
+		logger.Error().Err(err).Int("count", len(pending)).Msg("persist address activity failed")
+
+		// 写入失败，下次重试
+		d.mu.Lock()
+		for addr := range pending {
+			if st, ok := d.states[addr]; ok {
+				st.dirty = true
+			}
+		}
+		d.mu.Unlock()
+	}
+}
+
+func subscribeAll(addr string, groups ...[]AddressSubscriber) error {
+	var firstErr error
+	for _, subs := range groups {
+		for _, sub := range subs {
+			if err := sub.SubscribeAddress(addr); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+func unsubscribeAll(addr string, groups ...[]AddressSubscriber) error {
+	var firstErr error
+	for _, subs := range groups {
+		for _, sub := range subs {
+			if err := sub.UnsubscribeAddress(addr); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
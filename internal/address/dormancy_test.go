@@ -0,0 +1,182 @@
+package address
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+type mockSubscriber struct {
+	mu    sync.Mutex
+	addrs map[string]bool
+}
+
+func newMockSubscriber() *mockSubscriber {
+	return &mockSubscriber{addrs: make(map[string]bool)}
+}
+
+func (m *mockSubscriber) SubscribeAddress(addr string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.addrs[addr] = true
+	return nil
+}
+
+func (m *mockSubscriber) UnsubscribeAddress(addr string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.addrs, addr)
+	return nil
+}
+
+func (m *mockSubscriber) has(addr string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.addrs[addr]
+}
+
+type mockActivityStore struct {
+	mu      sync.Mutex
+	rows    []*models.HlAddressActivity
+	dormant map[string]bool
+}
+
+func (s *mockActivityStore) ListAll() ([]*models.HlAddressActivity, error) {
+	return s.rows, nil
+}
+
+func (s *mockActivityStore) BatchUpsertLastActive(map[string]time.Time) error { return nil }
+
+func (s *mockActivityStore) MarkDormant(address string, _, _ time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dormant[address] = true
+	return nil
+}
+
+func (s *mockActivityStore) MarkActive(address string, _ time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dormant[address] = false
+	return nil
+}
+
+type mockFillChecker struct {
+	fills map[string][]hl.Fill
+}
+
+func (c *mockFillChecker) UserFillsByTime(_ context.Context, address string, _ int64, _ *int64) ([]hl.Fill, error) {
+	return c.fills[address], nil
+}
+
+func newTestDormancyManager(mode string, rows []*models.HlAddressActivity) (*DormancyManager, *mockSubscriber, *mockSubscriber, *mockActivityStore, *mockFillChecker) {
+	fills := newMockSubscriber()
+	positions := newMockSubscriber()
+	store := &mockActivityStore{rows: rows, dormant: make(map[string]bool)}
+	checker := &mockFillChecker{fills: make(map[string][]hl.Fill)}
+
+	d := NewDormancyManager(
+		[]AddressSubscriber{fills},
+		[]AddressSubscriber{positions},
+		checker,
+		config.Dormancy{
+			Enabled:            true,
+			DormantAfter:       time.Hour,
+			Mode:               mode,
+			CheckInterval:      time.Hour,
+			ReactivateInterval: time.Hour,
+		},
+	)
+	d.SetStore(store)
+	return d, fills, positions, store, checker
+}
+
+func TestDormancyManager_DowngradePositionsOnly(t *testing.T) {
+	d, fills, positions, store, _ := newTestDormancyManager(DormancyModePositionsOnly, nil)
+	require.NoError(t, d.Start())
+	defer d.Stop()
+
+	require.NoError(t, d.SubscribeAddress("0xabc"))
+	assert.True(t, fills.has("0xabc"))
+	assert.True(t, positions.has("0xabc"))
+
+	// 未超过阈值，不休眠
+	d.checkDormant(time.Now())
+	assert.False(t, d.IsDormant("0xabc"))
+
+	d.checkDormant(time.Now().Add(2 * time.Hour))
+	assert.True(t, d.IsDormant("0xabc"))
+	assert.True(t, store.dormant["0xabc"])
+	assert.False(t, fills.has("0xabc"))
+	assert.True(t, positions.has("0xabc"))
+	assert.Equal(t, 1, d.DormantCount())
+}
+
+func TestDormancyManager_DowngradeUnsubscribe(t *testing.T) {
+	d, fills, positions, _, _ := newTestDormancyManager(DormancyModeUnsubscribe, nil)
+	require.NoError(t, d.Start())
+	defer d.Stop()
+
+	require.NoError(t, d.SubscribeAddress("0xabc"))
+	d.checkDormant(time.Now().Add(2 * time.Hour))
+
+	assert.True(t, d.IsDormant("0xabc"))
+	assert.False(t, fills.has("0xabc"))
+	assert.False(t, positions.has("0xabc"))
+}
+
+func TestDormancyManager_SubscribeDormantFromDB(t *testing.T) {
+	since := time.Now().Add(-time.Hour)
+	d, fills, positions, _, _ := newTestDormancyManager(DormancyModePositionsOnly, []*models.HlAddressActivity{
+		{Address: "0xabc", LastActiveAt: since.Add(-48 * time.Hour), Dormant: true, DormantSince: &since},
+	})
+	require.NoError(t, d.Start())
+	defer d.Stop()
+
+	require.NoError(t, d.SubscribeAddress("0xabc"))
+	assert.False(t, fills.has("0xabc"))
+	assert.True(t, positions.has("0xabc"))
+}
+
+func TestDormancyManager_ReactivateByREST(t *testing.T) {
+	d, fills, _, store, checker := newTestDormancyManager(DormancyModePositionsOnly, nil)
+	require.NoError(t, d.Start())
+	defer d.Stop()
+
+	require.NoError(t, d.SubscribeAddress("0xabc"))
+	d.checkDormant(time.Now().Add(2 * time.Hour))
+	require.True(t, d.IsDormant("0xabc"))
+
+	// 无新成交，保持休眠
+	d.checkReactivate()
+	assert.True(t, d.IsDormant("0xabc"))
+
+	checker.fills["0xabc"] = []hl.Fill{{Coin: "BTC", Time: time.Now().UnixMilli()}}
+	d.checkReactivate()
+	assert.False(t, d.IsDormant("0xabc"))
+	assert.False(t, store.dormant["0xabc"])
+	assert.True(t, fills.has("0xabc"))
+}
+
+func TestDormancyManager_ReactivateByTouch(t *testing.T) {
+	d, fills, _, _, _ := newTestDormancyManager(DormancyModePositionsOnly, nil)
+	require.NoError(t, d.Start())
+	defer d.Stop()
+
+	require.NoError(t, d.SubscribeAddress("0xabc"))
+	d.checkDormant(time.Now().Add(2 * time.Hour))
+	require.True(t, d.IsDormant("0xabc"))
+
+	d.Touch("0xabc")
+	assert.Eventually(t, func() bool {
+		return !d.IsDormant("0xabc") && fills.has("0xabc")
+	}, time.Second, 10*time.Millisecond)
+}
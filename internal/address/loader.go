@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
 	"github.com/utrading/utrading-hl-monitor/internal/dao"
 	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
 	"github.com/utrading/utrading-hl-monitor/pkg/logger"
@@ -18,6 +19,16 @@ type AddressSubscriber interface {
 	UnsubscribeAddress(addr string) error
 }
 
+// AggregationAssigner 按地址分配订单聚合策略（由 processor.AggregationStrategies 实现）
+type AggregationAssigner interface {
+	Replace(assignments map[string]string)
+}
+
+// SignalFilterAssigner 按地址分配信号过滤（由 processor.SignalFilters 实现）
+type SignalFilterAssigner interface {
+	Replace(assignments map[string]string)
+}
+
 // AddressLoader 地址加载器 - 从 hl_active_addresses 表加载监控地址
 type AddressLoader struct {
 	subscribers   []AddressSubscriber
@@ -25,6 +36,12 @@ type AddressLoader struct {
 	removeGrace   time.Duration
 	lastAddrs     map[string]bool
 	pendingRemove map[string]time.Time // 待移除地址 → 发现消失的时间
+	scopes        *cache.AddressScopes // 地址去重作用域（可选，按服务实例划分）
+	aggregations  AggregationAssigner  // 订单聚合策略（可选，来自 hl_watch_addresses.aggregation）
+	filters       SignalFilterAssigner // 信号过滤（可选，来自 hl_watch_addresses.signal_filter）
+	suspended     bool                 // 已取消全部订阅，定期同步暂停直到 Resubscribe
+	manual        map[string]bool      // 运行时手动添加的地址，与数据库地址合并（重启后失效）
+	excluded      map[string]bool      // 运行时手动移除的地址，同步时忽略直到再次添加（重启后失效）
 	mu            sync.RWMutex
 	ctx           context.Context
 	cancel        context.CancelFunc
@@ -39,11 +56,28 @@ func NewAddressLoader(subscribers []AddressSubscriber, interval, removeGrace tim
 		removeGrace:   removeGrace,
 		lastAddrs:     make(map[string]bool),
 		pendingRemove: make(map[string]time.Time),
+		manual:        make(map[string]bool),
+		excluded:      make(map[string]bool),
 		ctx:           ctx,
 		cancel:        cancel,
 	}
 }
 
+// SetAddressScopes 设置地址去重作用域，启用后每次同步时按服务实例ID刷新
+func (l *AddressLoader) SetAddressScopes(scopes *cache.AddressScopes) {
+	l.scopes = scopes
+}
+
+// SetAggregationAssigner 设置订单聚合策略分配（可选），每次同步时按 hl_watch_addresses.aggregation 刷新
+func (l *AddressLoader) SetAggregationAssigner(assigner AggregationAssigner) {
+	l.aggregations = assigner
+}
+
+// SetSignalFilterAssigner 设置信号过滤分配（可选），每次同步时按 hl_watch_addresses.signal_filter 刷新
+func (l *AddressLoader) SetSignalFilterAssigner(assigner SignalFilterAssigner) {
+	l.filters = assigner
+}
+
 // Start 启动加载器
 func (l *AddressLoader) Start() error {
 	if err := l.loadAndSync(); err != nil {
@@ -73,15 +107,59 @@ func (l *AddressLoader) periodicReload() {
 }
 
 func (l *AddressLoader) loadAndSync() error {
+	l.mu.RLock()
+	suspended := l.suspended
+	l.mu.RUnlock()
+	if suspended {
+		logger.Debug().Msg("address sync suspended, skipping")
+		return nil
+	}
+
 	addrs, err := l.loadActiveAddresses()
 	if err != nil {
 		return err
 	}
 
+	// 先刷新作用域，保证新地址订阅前作用域已就绪
+	if l.scopes != nil {
+		scopes, err := dao.ActiveAddress().ListAddressScopes()
+		if err != nil {
+			logger.Error().Err(err).Msg("load address scopes failed")
+		} else {
+			l.scopes.Replace(scopes)
+		}
+	}
+
+	// 聚合策略和信号过滤在新地址订阅前刷新，首笔成交即按所属策略聚合、过滤；加载失败时沿用上一次的分配
+	if l.aggregations != nil {
+		assignments, err := dao.WatchAddress().ListAggregations()
+		if err != nil {
+			logger.Error().Err(err).Msg("load address aggregation strategies failed")
+		} else {
+			l.aggregations.Replace(assignments)
+		}
+	}
+	if l.filters != nil {
+		assignments, err := dao.WatchAddress().ListSignalFilters()
+		if err != nil {
+			logger.Error().Err(err).Msg("load address signal filters failed")
+		} else {
+			l.filters.Replace(assignments)
+		}
+	}
+
 	now := time.Now()
 
 	l.mu.Lock()
 
+	// 合并运行时手动添加/移除的地址
+	for addr := range l.manual {
+		addrs[addr] = true
+	}
+	for addr := range l.excluded {
+		delete(addrs, addr)
+	}
+
 	var toAdd, toUnsubscribe []string
 	var pendingCount, recoveredCount int
 
@@ -180,6 +258,103 @@ func (l *AddressLoader) loadActiveAddresses() (map[string]bool, error) {
 	return result, nil
 }
 
+// AddAddress 运行时添加监控地址并立即订阅，后续同步不会因数据库中不存在而移除
+// 手动添加的地址仅保存在内存中，重启后以数据库为准
+func (l *AddressLoader) AddAddress(addr string) error {
+	l.mu.Lock()
+	if l.suspended {
+		l.mu.Unlock()
+		return errors.New("address sync suspended")
+	}
+	l.manual[addr] = true
+	delete(l.excluded, addr)
+	delete(l.pendingRemove, addr)
+	subscribed := l.lastAddrs[addr]
+	l.lastAddrs[addr] = true
+	l.mu.Unlock()
+
+	if subscribed {
+		return nil
+	}
+
+	var errs []error
+	for _, sub := range l.subscribers {
+		if err := sub.SubscribeAddress(addr); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+	logger.Info().Str("address", addr).Msg("subscribed address added at runtime")
+	return nil
+}
+
+// RemoveAddress 运行时移除监控地址并立即取消订阅（不经过宽限期），
+// 后续同步忽略该地址直到再次 AddAddress，返回地址此前是否处于订阅中
+func (l *AddressLoader) RemoveAddress(addr string) (bool, error) {
+	l.mu.Lock()
+	delete(l.manual, addr)
+	l.excluded[addr] = true
+	delete(l.pendingRemove, addr)
+	subscribed := l.lastAddrs[addr]
+	delete(l.lastAddrs, addr)
+	l.mu.Unlock()
+
+	if !subscribed {
+		return false, nil
+	}
+
+	var errs []error
+	for _, sub := range l.subscribers {
+		if err := sub.UnsubscribeAddress(addr); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return true, err
+	}
+	logger.Info().Str("address", addr).Msg("unsubscribed address removed at runtime")
+	return true, nil
+}
+
+// UnsubscribeAll 取消全部地址的订阅并暂停定期同步，返回取消的地址数
+func (l *AddressLoader) UnsubscribeAll() int {
+	l.mu.Lock()
+	addrs := l.lastAddrs
+	l.lastAddrs = make(map[string]bool)
+	l.pendingRemove = make(map[string]time.Time)
+	l.suspended = true
+	l.mu.Unlock()
+
+	for addr := range addrs {
+		for _, sub := range l.subscribers {
+			if err := sub.UnsubscribeAddress(addr); err != nil {
+				logger.Error().Err(err).Str("address", addr).Msg("unsubscribe address failed")
+			}
+		}
+	}
+
+	logger.Warn().Int("addresses", len(addrs)).Msg("unsubscribed all addresses, address sync suspended")
+	return len(addrs)
+}
+
+// Resubscribe 恢复定期同步并立即重新订阅全部地址
+func (l *AddressLoader) Resubscribe() error {
+	l.mu.Lock()
+	l.suspended = false
+	l.mu.Unlock()
+
+	return l.loadAndSync()
+}
+
+// Suspended 是否已取消全部订阅
+func (l *AddressLoader) Suspended() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.suspended
+}
+
 // Stop 停止加载器
 func (l *AddressLoader) Stop() {
 	l.cancel()
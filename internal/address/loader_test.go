@@ -0,0 +1,36 @@
+package address
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressLoader_AddRemoveAddress(t *testing.T) {
+	sub := newMockSubscriber()
+	l := NewAddressLoader([]AddressSubscriber{sub}, time.Minute, time.Minute)
+
+	require.NoError(t, l.AddAddress("0xabc"))
+	assert.True(t, sub.has("0xabc"))
+	assert.True(t, l.manual["0xabc"])
+
+	removed, err := l.RemoveAddress("0xabc")
+	require.NoError(t, err)
+	assert.True(t, removed)
+	assert.False(t, sub.has("0xabc"))
+	assert.True(t, l.excluded["0xabc"], "removed address is ignored by later syncs")
+
+	removed, err = l.RemoveAddress("0xabc")
+	require.NoError(t, err)
+	assert.False(t, removed)
+
+	// 再次添加撤销排除
+	require.NoError(t, l.AddAddress("0xabc"))
+	assert.True(t, sub.has("0xabc"))
+	assert.False(t, l.excluded["0xabc"])
+
+	l.UnsubscribeAll()
+	assert.Error(t, l.AddAddress("0xdef"), "adding is rejected while sync is suspended")
+}
@@ -0,0 +1,228 @@
+package addressgroup
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// Store 地址分组存储（由 dao.AddressGroupDAO 实现）
+type Store interface {
+	ListEnabled() ([]*models.HlAddressGroup, error)
+	ListMembers() (map[string][]string, error)
+}
+
+// snapshot 一次加载的分组快照，加载后只读
+type snapshot struct {
+	templates map[string]string   // 分组名称 -> 主题模板
+	members   map[string][]string // 小写地址 -> 已启用的分组名称（有序）
+}
+
+// Router 地址分组主题路由
+// 定期从 hl_address_groups / hl_address_group_members 加载分组，实现 nats.SubjectRouter：
+// 信号按地址所属的每个已启用分组渲染主题模板，如 hl.signal.{group}.{symbol} -> hl.signal.whales.BTCUSDC
+type Router struct {
+	store           Store
+	interval        time.Duration
+	defaultTemplate string
+
+	current atomic.Pointer[snapshot]
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRouter 创建地址分组主题路由
+func NewRouter(cfg config.AddressGroups) *Router {
+	interval := cfg.ReloadInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &Router{
+		store:           dao.AddressGroup(),
+		interval:        interval,
+		defaultTemplate: cfg.DefaultTemplate,
+		ctx:             ctx,
+		cancel:          cancel,
+	}
+	r.current.Store(&snapshot{})
+	return r
+}
+
+// SetStore 设置分组存储（可选，用于测试）
+func (r *Router) SetStore(store Store) {
+	r.store = store
+}
+
+// Start 加载分组并启动定期重新加载，首次加载失败时返回错误
+func (r *Router) Start() error {
+	if err := r.Reload(); err != nil {
+		return err
+	}
+
+	r.wg.Add(1)
+	goplus.Go(func() {
+		defer r.wg.Done()
+		r.run()
+	})
+
+	logger.Info().Dur("reload_interval", r.interval).Msg("address group router started")
+	return nil
+}
+
+// Stop 停止定期重新加载
+func (r *Router) Stop() {
+	r.cancel()
+	r.wg.Wait()
+}
+
+func (r *Router) run() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Reload(); err != nil {
+				logger.Error().Err(err).Msg("address group reload failed")
+			}
+		}
+	}
+}
+
+// Reload 从存储重新加载分组，失败时保留上次的分组
+func (r *Router) Reload() error {
+	groups, err := r.store.ListEnabled()
+	if err != nil {
+		return err
+	}
+	members, err := r.store.ListMembers()
+	if err != nil {
+		return err
+	}
+
+	snap := &snapshot{
+		templates: make(map[string]string, len(groups)),
+		members:   make(map[string][]string),
+	}
+	for _, g := range groups {
+		tmpl := g.SubjectTemplate
+		if tmpl == "" {
+			tmpl = r.defaultTemplate
+		}
+		if !validTemplate(tmpl) {
+			logger.Warn().Str("group", g.Name).Str("template", tmpl).Msg("invalid address group subject template, skip")
+			continue
+		}
+		snap.templates[g.Name] = tmpl
+	}
+
+	total := 0
+	for addr, names := range members {
+		key := normalize(addr)
+		for _, name := range names {
+			if _, ok := snap.templates[name]; ok {
+				snap.members[key] = append(snap.members[key], name)
+				total++
+			}
+		}
+	}
+	for key := range snap.members {
+		sort.Strings(snap.members[key])
+		snap.members[key] = compact(snap.members[key])
+	}
+
+	r.current.Store(snap)
+	logger.Debug().Int("groups", len(snap.templates)).Int("members", total).Msg("address groups loaded")
+	return nil
+}
+
+// Groups 获取地址所属的已启用分组
+func (r *Router) Groups(address string) []string {
+	return r.current.Load().members[normalize(address)]
+}
+
+// Subjects 实现 nats.SubjectRouter，返回信号需要额外发布的分组主题
+func (r *Router) Subjects(signal *nats.HlAddressSignal) []nats.GroupSubject {
+	snap := r.current.Load()
+	groups := snap.members[normalize(signal.Address)]
+	if len(groups) == 0 {
+		return nil
+	}
+
+	subjects := make([]nats.GroupSubject, 0, len(groups))
+	for _, group := range groups {
+		subjects = append(subjects, nats.GroupSubject{
+			Group:   group,
+			Subject: render(snap.templates[group], group, signal),
+		})
+	}
+	return subjects
+}
+
+// render 渲染主题模板，占位符取值中的主题分隔符和通配符替换为下划线
+func render(tmpl, group string, signal *nats.HlAddressSignal) string {
+	return strings.NewReplacer(
+		"{group}", token(group),
+		"{symbol}", token(signal.Symbol),
+		"{address}", token(strings.ToLower(signal.Address)),
+		"{asset_type}", token(signal.AssetType),
+		"{direction}", token(signal.Direction),
+		"{side}", token(signal.Side),
+	).Replace(tmpl)
+}
+
+// token 将取值转换为单个主题片段，空值为 "_"
+func token(s string) string {
+	if s == "" {
+		return "_"
+	}
+	return strings.Map(func(c rune) rune {
+		switch c {
+		case '.', '*', '>', ' ', '\t', '\r', '\n':
+			return '_'
+		}
+		return c
+	}, s)
+}
+
+// validTemplate 模板不能为空，且不能包含通配符或空片段
+func validTemplate(tmpl string) bool {
+	if tmpl == "" || strings.ContainsAny(tmpl, "*> \t\r\n") {
+		return false
+	}
+	for _, part := range strings.Split(tmpl, ".") {
+		if part == "" {
+			return false
+		}
+	}
+	return true
+}
+
+func compact(names []string) []string {
+	out := names[:0]
+	for i, name := range names {
+		if i == 0 || name != names[i-1] {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+func normalize(addr string) string {
+	return strings.ToLower(strings.TrimSpace(addr))
+}
@@ -0,0 +1,98 @@
+package addressgroup
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+)
+
+type fakeStore struct {
+	groups  []*models.HlAddressGroup
+	members map[string][]string
+	err     error
+}
+
+func (f *fakeStore) ListEnabled() ([]*models.HlAddressGroup, error) {
+	return f.groups, f.err
+}
+
+func (f *fakeStore) ListMembers() (map[string][]string, error) {
+	return f.members, f.err
+}
+
+func newTestRouter(t *testing.T, store *fakeStore) *Router {
+	r := NewRouter(config.Default().AddressGroups)
+	r.SetStore(store)
+	require.NoError(t, r.Reload())
+	return r
+}
+
+func TestRouter_Subjects(t *testing.T) {
+	r := newTestRouter(t, &fakeStore{
+		groups: []*models.HlAddressGroup{
+			{Name: "whales"},
+			{Name: "smart-money", SubjectTemplate: "signals.{group}.{asset_type}.{direction}.{side}"},
+		},
+		members: map[string][]string{
+			"0xABC": {"whales", "smart-money"},
+			"0xdef": {"whales"},
+		},
+	})
+
+	signal := &nats.HlAddressSignal{Address: "0xabc", Symbol: "BTCUSDC", AssetType: "futures", Direction: "open", Side: "LONG"}
+	assert.Equal(t, []nats.GroupSubject{
+		{Group: "smart-money", Subject: "signals.smart-money.futures.open.LONG"},
+		{Group: "whales", Subject: "hl.signal.whales.BTCUSDC"},
+	}, r.Subjects(signal))
+
+	assert.Equal(t, []string{"whales"}, r.Groups("0xDEF"))
+	assert.Nil(t, r.Subjects(&nats.HlAddressSignal{Address: "0x999", Symbol: "BTCUSDC"}))
+}
+
+func TestRouter_SanitizeTokens(t *testing.T) {
+	r := newTestRouter(t, &fakeStore{
+		groups:  []*models.HlAddressGroup{{Name: "a.b", SubjectTemplate: "hl.{group}.{symbol}.{address}"}},
+		members: map[string][]string{"0xAbC": {"a.b"}},
+	})
+
+	subjects := r.Subjects(&nats.HlAddressSignal{Address: "0xAbC", Symbol: "xyz:TSLA USDC*"})
+	require.Len(t, subjects, 1)
+	assert.Equal(t, "hl.a_b.xyz:TSLA_USDC_.0xabc", subjects[0].Subject)
+}
+
+func TestRouter_SkipInvalidAndDisabled(t *testing.T) {
+	r := newTestRouter(t, &fakeStore{
+		groups: []*models.HlAddressGroup{
+			{Name: "wild", SubjectTemplate: "hl.signal.*"},
+			{Name: "empty", SubjectTemplate: "hl..{symbol}"},
+			{Name: "ok"},
+		},
+		// disabled 不在已启用分组中
+		members: map[string][]string{"0xabc": {"wild", "empty", "disabled", "ok", "ok"}},
+	})
+
+	assert.Equal(t, []string{"ok"}, r.Groups("0xabc"))
+}
+
+func TestRouter_ReloadFailureKeepsGroups(t *testing.T) {
+	store := &fakeStore{
+		groups:  []*models.HlAddressGroup{{Name: "whales"}},
+		members: map[string][]string{"0xabc": {"whales"}},
+	}
+	r := newTestRouter(t, store)
+
+	store.err = errors.New("db down")
+	assert.Error(t, r.Reload())
+	assert.Equal(t, []string{"whales"}, r.Groups("0xabc"))
+
+	store.err = nil
+	store.members = map[string][]string{}
+	require.NoError(t, r.Reload())
+	assert.Empty(t, r.Groups("0xabc"))
+}
@@ -0,0 +1,208 @@
+package addressmeta
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/pkg/concurrent"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// 标签来源
+const (
+	SourceStatic = "static" // 配置中的已知实体（交易所钱包等）
+	SourceVault  = "vault"  // vaultDetails 返回的金库名称
+)
+
+// VaultFetcher 金库详情查询接口（由 hyperliquid.Info 实现）
+type VaultFetcher interface {
+	VaultDetails(ctx context.Context, vaultAddress string) (*hl.VaultDetails, error)
+}
+
+// Meta 地址元数据
+type Meta struct {
+	Label      string    `json:"label"`
+	Source     string    `json:"source"`
+	ResolvedAt time.Time `json:"resolved_at"`
+}
+
+// Resolver 地址元数据解析器
+// 作为 AddressSubscriber 接收监控地址，优先使用配置中的已知实体标签，
+// 其余地址在后台通过 vaultDetails 解析金库名称；Label 只读缓存，不阻塞信号处理
+type Resolver struct {
+	static  map[string]string // 小写地址 -> 标签
+	vaults  VaultFetcher
+	refresh time.Duration
+
+	metas   concurrent.Map[string, Meta] // 已解析的地址（非金库缓存为空标签，避免重复查询）
+	pending chan string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewResolver 创建地址元数据解析器，vaults 为空时不解析金库名称
+func NewResolver(cfg config.AddressMeta, vaults VaultFetcher) *Resolver {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	refresh := cfg.RefreshInterval
+	if refresh <= 0 {
+		refresh = 24 * time.Hour
+	}
+
+	r := &Resolver{
+		static:  make(map[string]string, len(cfg.Labels)),
+		vaults:  vaults,
+		refresh: refresh,
+		pending: make(chan string, 1024),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	for addr, label := range cfg.Labels {
+		r.static[normalize(addr)] = label
+	}
+	return r
+}
+
+// Start 启动后台解析
+func (r *Resolver) Start() {
+	if r.vaults == nil {
+		return
+	}
+
+	r.wg.Add(1)
+	goplus.Go(func() {
+		defer r.wg.Done()
+		r.resolveLoop()
+	})
+
+	r.wg.Add(1)
+	goplus.Go(func() {
+		defer r.wg.Done()
+		r.refreshLoop()
+	})
+}
+
+// Stop 停止后台解析
+func (r *Resolver) Stop() {
+	r.cancel()
+	r.wg.Wait()
+}
+
+// SubscribeAddress 实现 AddressSubscriber，登记待解析地址
+func (r *Resolver) SubscribeAddress(addr string) error {
+	key := normalize(addr)
+	if _, ok := r.static[key]; ok || r.vaults == nil {
+		return nil
+	}
+	if _, ok := r.metas.Load(key); ok {
+		return nil
+	}
+	r.enqueue(key)
+	return nil
+}
+
+// UnsubscribeAddress 实现 AddressSubscriber，移除地址缓存
+func (r *Resolver) UnsubscribeAddress(addr string) error {
+	r.metas.Delete(normalize(addr))
+	return nil
+}
+
+// Label 获取地址标签，未知地址返回空字符串
+func (r *Resolver) Label(addr string) string {
+	meta, ok := r.Lookup(addr)
+	if !ok {
+		return ""
+	}
+	return meta.Label
+}
+
+// Lookup 获取地址元数据
+func (r *Resolver) Lookup(addr string) (Meta, bool) {
+	key := normalize(addr)
+	if label, ok := r.static[key]; ok {
+		return Meta{Label: label, Source: SourceStatic}, true
+	}
+	meta, ok := r.metas.Load(key)
+	if !ok || meta.Label == "" {
+		return Meta{}, false
+	}
+	return meta, true
+}
+
+// enqueue 加入解析队列，队列满时丢弃（下次刷新时补齐）
+func (r *Resolver) enqueue(key string) {
+	select {
+	case r.pending <- key:
+	default:
+		logger.Debug().Str("address", key).Msg("address meta queue full, skip")
+	}
+}
+
+// resolveLoop 逐个解析地址，串行执行以免占用 REST 额度
+func (r *Resolver) resolveLoop() {
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case key := <-r.pending:
+			r.resolve(key)
+		}
+	}
+}
+
+// refreshLoop 定期重新解析过期地址（金库可能改名或关闭）
+func (r *Resolver) refreshLoop() {
+	ticker := time.NewTicker(r.refresh)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			cutoff := time.Now().Add(-r.refresh)
+			r.metas.Range(func(key string, meta Meta) bool {
+				if meta.ResolvedAt.Before(cutoff) {
+					r.enqueue(key)
+				}
+				return true
+			})
+		}
+	}
+}
+
+// resolve 查询金库详情，非金库地址缓存为空标签
+func (r *Resolver) resolve(key string) {
+	ctx, cancel := context.WithTimeout(r.ctx, 10*time.Second)
+	defer cancel()
+
+	details, err := r.vaults.VaultDetails(ctx, key)
+	if err != nil && !errors.Is(err, hl.ErrVaultNotFound) {
+		// 请求失败不缓存，等待下次订阅或刷新
+		logger.Debug().Err(err).Str("address", key).Msg("resolve vault details failed")
+		return
+	}
+
+	meta := Meta{Source: SourceVault, ResolvedAt: time.Now()}
+	if details != nil {
+		meta.Label = details.Name
+	}
+	r.metas.Store(key, meta)
+
+	if meta.Label != "" {
+		logger.Info().Str("address", key).Str("label", meta.Label).Msg("address resolved as vault")
+	}
+}
+
+func normalize(addr string) string {
+	return strings.ToLower(strings.TrimSpace(addr))
+}
@@ -0,0 +1,103 @@
+package addressmeta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+)
+
+type fakeVaults struct {
+	mu     sync.Mutex
+	names  map[string]string
+	err    error
+	called []string
+}
+
+func (f *fakeVaults) VaultDetails(_ context.Context, addr string) (*hl.VaultDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.called = append(f.called, addr)
+	if f.err != nil {
+		return nil, f.err
+	}
+	name, ok := f.names[addr]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", hl.ErrVaultNotFound, addr)
+	}
+	return &hl.VaultDetails{Name: name, VaultAddress: addr}, nil
+}
+
+func (f *fakeVaults) calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.called)
+}
+
+func TestResolver_StaticLabel(t *testing.T) {
+	vaults := &fakeVaults{}
+	r := NewResolver(config.AddressMeta{
+		Labels: map[string]string{"0xABC": "Binance Hot Wallet"},
+	}, vaults)
+
+	assert.NoError(t, r.SubscribeAddress("0xabc"))
+	assert.Equal(t, "Binance Hot Wallet", r.Label("0xAbC"))
+
+	meta, ok := r.Lookup("0xabc")
+	assert.True(t, ok)
+	assert.Equal(t, SourceStatic, meta.Source)
+
+	// 静态标签不查询金库
+	assert.Equal(t, 0, len(r.pending))
+}
+
+func TestResolver_VaultName(t *testing.T) {
+	vaults := &fakeVaults{names: map[string]string{"0xvault": "HLP"}}
+	r := NewResolver(config.AddressMeta{}, vaults)
+	r.Start()
+	defer r.Stop()
+
+	assert.NoError(t, r.SubscribeAddress("0xVault"))
+	assert.NoError(t, r.SubscribeAddress("0xuser"))
+
+	assert.Eventually(t, func() bool { return r.Label("0xvault") == "HLP" }, time.Second, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		_, ok := r.metas.Load("0xuser")
+		return ok
+	}, time.Second, 10*time.Millisecond)
+
+	// 非金库地址无标签，且已缓存不再重复查询
+	assert.Equal(t, "", r.Label("0xuser"))
+	assert.NoError(t, r.SubscribeAddress("0xuser"))
+	assert.Equal(t, 2, vaults.calls())
+
+	// 取消订阅后清除缓存
+	assert.NoError(t, r.UnsubscribeAddress("0xvault"))
+	assert.Equal(t, "", r.Label("0xvault"))
+}
+
+func TestResolver_RequestErrorNotCached(t *testing.T) {
+	vaults := &fakeVaults{err: errors.New("rate limited")}
+	r := NewResolver(config.AddressMeta{}, vaults)
+
+	r.resolve("0xvault")
+	_, ok := r.metas.Load("0xvault")
+	assert.False(t, ok)
+}
+
+func TestResolver_WithoutVaults(t *testing.T) {
+	r := NewResolver(config.AddressMeta{}, nil)
+	r.Start()
+	defer r.Stop()
+
+	assert.NoError(t, r.SubscribeAddress("0xvault"))
+	assert.Equal(t, "", r.Label("0xvault"))
+	assert.Equal(t, 0, len(r.pending))
+}
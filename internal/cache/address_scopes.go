@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"slices"
+
+	"github.com/utrading/utrading-hl-monitor/pkg/concurrent"
+)
+
+// AddressScopes 地址 → 去重作用域映射
+// 同一地址被多个逻辑消费者监听时，每个消费者拥有独立的去重作用域
+type AddressScopes struct {
+	scopes concurrent.Map[string, []string]
+}
+
+// NewAddressScopes 创建地址作用域映射
+func NewAddressScopes() *AddressScopes {
+	return &AddressScopes{}
+}
+
+// Get 获取地址的作用域列表，未配置时返回默认作用域
+func (s *AddressScopes) Get(address string) []string {
+	if s == nil {
+		return []string{DefaultScope}
+	}
+	scopes, ok := s.scopes.Load(address)
+	if !ok || len(scopes) == 0 {
+		return []string{DefaultScope}
+	}
+	return scopes
+}
+
+// Set 设置地址的作用域列表（去重并排序）
+func (s *AddressScopes) Set(address string, scopes []string) {
+	sorted := slices.Clone(scopes)
+	slices.Sort(sorted)
+	s.scopes.Store(address, slices.Compact(sorted))
+}
+
+// Replace 使用完整映射替换当前作用域（移除不再存在的地址）
+func (s *AddressScopes) Replace(all map[string][]string) {
+	s.scopes.Range(func(addr string, _ []string) bool {
+		if _, ok := all[addr]; !ok {
+			s.scopes.Delete(addr)
+		}
+		return true
+	})
+	for addr, scopes := range all {
+		s.Set(addr, scopes)
+	}
+}
+
+// Delete 删除地址的作用域
+func (s *AddressScopes) Delete(address string) {
+	s.scopes.Delete(address)
+}
@@ -1,48 +1,294 @@
 package cache
 
 import (
+	"container/list"
 	"fmt"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/patrickmn/go-cache"
-
 	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
 	"github.com/utrading/utrading-hl-monitor/pkg/logger"
 )
 
-// DedupCache 订单去重缓存，使用 go-cache 实现 TTL 自动过期
+// 去重作用域
+const (
+	DefaultScope = ""  // 默认作用域（兼容无作用域的去重键）
+	AllScopes    = "*" // 通配作用域，标记后对所有作用域生效
+)
+
+const (
+	dedupShardCount    = 16          // 分片数
+	dedupSweepInterval = time.Second // 每次清理一个分片的间隔，全部分片轮转一遍为 16 秒
+	// dedupEntryOverhead 每个条目除键以外的估算内存（map 槽位 + LRU 与过期链表元素 + 条目结构体）
+	dedupEntryOverhead = 176
+)
+
+// 淘汰原因（指标标签）
+const (
+	dedupEvictTTL      = "ttl"
+	dedupEvictCapacity = "capacity"
+)
+
+// DedupCache 订单去重缓存
+// 按键哈希分为多个分片，各分片独立加锁并维护 LRU 链表和按标记时间排序的过期链表：
+// 后台每次清理一个分片的过期条目（增量过期，从最早标记的条目开始，遇到未过期的条目即停止），查询时也会剔除过期条目；
+// 设置条目上限后，分片超出其份额（上限 / 分片数）时淘汰最久未访问的条目
 type DedupCache struct {
-	cache *cache.Cache // go-cache 内置 TTL 和自动清理
-	ttl   time.Duration
+	shards     [dedupShardCount]dedupShard
+	ttl        time.Duration
+	maxEntries atomic.Int64 // 0 表示不限制
+	now        func() time.Time
+	hits       atomic.Int64 // 查询命中次数
+	misses     atomic.Int64 // 查询未命中次数（含已过期），按 IsSeenInScope 调用计数
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+type dedupShard struct {
+	mu       sync.Mutex
+	entries  map[string]*list.Element
+	lru      list.List // 前端为最近访问
+	expiry   list.List // 前端为最近标记，所有条目 TTL 相同，后端最先过期
+	keyBytes int       // 键长度之和（内存估算）
+	maxSize  int
+}
+
+type dedupEntry struct {
+	key    string
+	marked time.Time
+	expiry *list.Element // 在过期链表中的位置
 }
 
 // NewDedupCache 创建订单去重缓存
-// ttl: 订单保留时间（建议 30 分钟）
-// 清理间隔自动设为 2×TTL
+// ttl: 订单保留时间（建议 30 分钟），默认不限制条目数
 func NewDedupCache(ttl time.Duration) *DedupCache {
-	return &DedupCache{
-		cache: cache.New(ttl, ttl*2), // 清理间隔 = 2×TTL
-		ttl:   ttl,
+	return newDedupCache(ttl, time.Now)
+}
+
+func newDedupCache(ttl time.Duration, now func() time.Time) *DedupCache {
+	c := &DedupCache{
+		ttl:  ttl,
+		now:  now,
+		done: make(chan struct{}),
+	}
+	for i := range c.shards {
+		c.shards[i].entries = make(map[string]*list.Element)
+	}
+	goplus.Go(c.sweepLoop)
+	return c
+}
+
+// SetMaxEntries 设置条目上限（0 表示不限制），立即按新上限淘汰
+// 上限平均分配到各分片，按分片近似 LRU 淘汰
+func (c *DedupCache) SetMaxEntries(maxEntries int) {
+	maxEntries = max(maxEntries, 0)
+	c.maxEntries.Store(int64(maxEntries))
+	perShard := 0
+	if maxEntries > 0 {
+		perShard = (maxEntries + dedupShardCount - 1) / dedupShardCount
 	}
+
+	var evicted int
+	for i := range c.shards {
+		s := &c.shards[i]
+		s.mu.Lock()
+		s.maxSize = perShard
+		evicted += s.evictOverflowLocked()
+		s.mu.Unlock()
+	}
+	if evicted > 0 {
+		monitor.AddDedupCacheEvicted(dedupEvictCapacity, evicted)
+	}
+	c.reportSize()
+}
+
+// Close 停止后台清理
+func (c *DedupCache) Close() {
+	c.closeOnce.Do(func() { close(c.done) })
 }
 
-// IsSeen 检查订单是否已处理
+// IsSeen 检查订单是否已处理（默认作用域）
 func (c *DedupCache) IsSeen(address string, oid int64, direction string) bool {
-	key := c.dedupKey(address, oid, direction)
-	_, exists := c.cache.Get(key)
-	return exists
+	return c.IsSeenInScope(DefaultScope, address, oid, direction)
 }
 
-// Mark 标记订单为已处理
+// Mark 标记订单为已处理（默认作用域）
 func (c *DedupCache) Mark(address string, oid int64, direction string) {
-	key := c.dedupKey(address, oid, direction)
-	c.cache.Set(key, time.Now(), cache.DefaultExpiration)
+	c.MarkInScope(DefaultScope, address, oid, direction)
+}
+
+// IsSeenInScope 检查订单在指定作用域内是否已处理，通配标记对所有作用域生效
+func (c *DedupCache) IsSeenInScope(scope, address string, oid int64, direction string) bool {
+	seen := c.get(c.dedupKey(scope, address, oid, direction)) ||
+		(scope != AllScopes && c.get(c.dedupKey(AllScopes, address, oid, direction)))
+	if seen {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+	return seen
+}
+
+// MarkInScope 在指定作用域内标记订单为已处理
+func (c *DedupCache) MarkInScope(scope, address string, oid int64, direction string) {
+	c.set(c.dedupKey(scope, address, oid, direction))
 }
 
 // dedupKey 生成去重键
-// 格式: "address-oid-direction"
-func (c *DedupCache) dedupKey(address string, oid int64, direction string) string {
-	return fmt.Sprintf("%s-%d-%s", address, oid, direction)
+// 格式: "address-oid-direction"，非默认作用域为 "scope|address-oid-direction"
+func (c *DedupCache) dedupKey(scope, address string, oid int64, direction string) string {
+	if scope == DefaultScope {
+		return fmt.Sprintf("%s-%d-%s", address, oid, direction)
+	}
+	return fmt.Sprintf("%s|%s-%d-%s", scope, address, oid, direction)
+}
+
+func (c *DedupCache) shard(key string) *dedupShard {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return &c.shards[h.Sum32()%dedupShardCount]
+}
+
+// get 查询键是否存在且未过期，命中时移到 LRU 前端
+func (c *DedupCache) get(key string) bool {
+	s := c.shard(key)
+	s.mu.Lock()
+	el, ok := s.entries[key]
+	if !ok {
+		s.mu.Unlock()
+		return false
+	}
+	if c.now().Sub(el.Value.(*dedupEntry).marked) >= c.ttl {
+		s.removeLocked(el)
+		s.mu.Unlock()
+		monitor.AddDedupCacheEvicted(dedupEvictTTL, 1)
+		return false
+	}
+	s.lru.MoveToFront(el)
+	s.mu.Unlock()
+	return true
+}
+
+// set 写入或刷新键的标记时间，超出分片上限时淘汰最久未访问的条目
+func (c *DedupCache) set(key string) {
+	s := c.shard(key)
+	s.mu.Lock()
+	if el, ok := s.entries[key]; ok {
+		entry := el.Value.(*dedupEntry)
+		entry.marked = c.now()
+		s.lru.MoveToFront(el)
+		s.expiry.MoveToFront(entry.expiry)
+		s.mu.Unlock()
+		return
+	}
+	entry := &dedupEntry{key: key, marked: c.now()}
+	entry.expiry = s.expiry.PushFront(entry)
+	s.entries[key] = s.lru.PushFront(entry)
+	s.keyBytes += len(key)
+	evicted := s.evictOverflowLocked()
+	s.mu.Unlock()
+
+	if evicted > 0 {
+		monitor.AddDedupCacheEvicted(dedupEvictCapacity, evicted)
+	}
+}
+
+// sweepLoop 每次清理一个分片的过期条目并更新指标
+func (c *DedupCache) sweepLoop() {
+	ticker := time.NewTicker(dedupSweepInterval)
+	defer ticker.Stop()
+
+	next := 0
+	for {
+		select {
+		case <-ticker.C:
+			c.sweepShard(next)
+			next = (next + 1) % dedupShardCount
+			c.reportSize()
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// sweepShard 清理分片中的过期条目，从过期链表后端开始，遇到未过期的条目即停止
+func (c *DedupCache) sweepShard(i int) {
+	s := &c.shards[i]
+	deadline := c.now().Add(-c.ttl)
+
+	s.mu.Lock()
+	var expired int
+	for el := s.expiry.Back(); el != nil; el = s.expiry.Back() {
+		entry := el.Value.(*dedupEntry)
+		if entry.marked.After(deadline) {
+			break
+		}
+		s.removeLocked(s.entries[entry.key])
+		expired++
+	}
+	s.mu.Unlock()
+
+	if expired > 0 {
+		monitor.AddDedupCacheEvicted(dedupEvictTTL, expired)
+	}
+}
+
+// reportSize 更新条目数和内存估算指标
+func (c *DedupCache) reportSize() {
+	entries, bytes := c.size()
+	monitor.SetDedupCacheSize(entries, bytes)
+}
+
+// size 条目数及估算内存（字节）
+func (c *DedupCache) size() (entries, bytes int) {
+	for i := range c.shards {
+		s := &c.shards[i]
+		s.mu.Lock()
+		entries += len(s.entries)
+		bytes += s.keyBytes + len(s.entries)*dedupEntryOverhead
+		s.mu.Unlock()
+	}
+	return entries, bytes
+}
+
+// oldest 最早标记的条目的标记时间，无条目时为零值
+// 标记时间与 LRU 顺序无关（查询命中只调整顺序），取各分片过期链表的后端
+func (c *DedupCache) oldest() time.Time {
+	var oldest time.Time
+	for i := range c.shards {
+		s := &c.shards[i]
+		s.mu.Lock()
+		if el := s.expiry.Back(); el != nil {
+			if marked := el.Value.(*dedupEntry).marked; oldest.IsZero() || marked.Before(oldest) {
+				oldest = marked
+			}
+		}
+		s.mu.Unlock()
+	}
+	return oldest
+}
+
+// evictOverflowLocked 淘汰超出分片上限的最久未访问条目，返回淘汰数
+func (s *dedupShard) evictOverflowLocked() int {
+	var evicted int
+	for s.maxSize > 0 && len(s.entries) > s.maxSize {
+		s.removeLocked(s.lru.Back())
+		evicted++
+	}
+	return evicted
+}
+
+func (s *dedupShard) removeLocked(el *list.Element) {
+	entry := el.Value.(*dedupEntry)
+	s.lru.Remove(el)
+	s.expiry.Remove(entry.expiry)
+	delete(s.entries, entry.key)
+	s.keyBytes -= len(entry.key)
 }
 
 type OrderAggregationDAO interface {
@@ -69,9 +315,20 @@ func (c *DedupCache) LoadFromDB(daoObj interface{}) error {
 	}
 
 	count := 0
+	// 数据库中只记录订单是否已发送，恢复时对所有作用域生效
 	for _, order := range orders {
-		c.Mark(order.Address, order.Oid, order.Direction)
+		c.MarkInScope(AllScopes, order.Address, order.Oid, order.Direction)
 		count++
+
+		// 按 cloid 聚合的记录包含多个 oid 的成交
+		if order.Cloid == "" {
+			continue
+		}
+		for _, fill := range order.Fills {
+			if fill.Oid != order.Oid {
+				c.MarkInScope(AllScopes, order.Address, fill.Oid, order.Direction)
+			}
+		}
 	}
 
 	logger.Info().
@@ -84,8 +341,32 @@ func (c *DedupCache) LoadFromDB(daoObj interface{}) error {
 
 // Stats 获取统计信息
 func (c *DedupCache) Stats() map[string]interface{} {
+	entries, bytes := c.size()
+	hits, misses := c.hits.Load(), c.misses.Load()
 	return map[string]interface{}{
-		"item_count":  c.cache.ItemCount(),
-		"ttl_minutes": c.ttl.Minutes(),
+		"item_count":         entries,
+		"ttl_minutes":        c.ttl.Minutes(),
+		"max_entries":        c.maxEntries.Load(),
+		"memory_bytes":       bytes,
+		"hits":               hits,
+		"misses":             misses,
+		"hit_rate":           HitRate(hits, misses),
+		"oldest_age_seconds": AgeSeconds(c.oldest(), c.now()),
+	}
+}
+
+// HitRate 命中率，无查询时为 0
+func HitRate(hits, misses int64) float64 {
+	if total := hits + misses; total > 0 {
+		return float64(hits) / float64(total)
+	}
+	return 0
+}
+
+// AgeSeconds 从 since 到 now 的秒数，since 为零值（无条目）时为 0
+func AgeSeconds(since, now time.Time) float64 {
+	if since.IsZero() {
+		return 0
 	}
+	return now.Sub(since).Seconds()
 }
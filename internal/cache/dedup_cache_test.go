@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"sync"
 	"testing"
 	"time"
 
@@ -27,6 +28,42 @@ func TestDedupCache_IsSeen(t *testing.T) {
 	assert.False(t, cache.IsSeen("addr1", 456, "open"))
 }
 
+func TestDedupCache_Scope(t *testing.T) {
+	cache := NewDedupCache(30 * time.Second)
+
+	// 不同作用域互不影响
+	cache.MarkInScope("tenant-a", "addr1", 123, "open")
+	assert.True(t, cache.IsSeenInScope("tenant-a", "addr1", 123, "open"))
+	assert.False(t, cache.IsSeenInScope("tenant-b", "addr1", 123, "open"))
+	assert.False(t, cache.IsSeen("addr1", 123, "open"))
+
+	// 默认作用域与无作用域接口等价
+	cache.Mark("addr1", 456, "open")
+	assert.True(t, cache.IsSeenInScope(DefaultScope, "addr1", 456, "open"))
+	assert.False(t, cache.IsSeenInScope("tenant-a", "addr1", 456, "open"))
+
+	// 通配作用域对所有作用域生效
+	cache.MarkInScope(AllScopes, "addr1", 789, "close")
+	assert.True(t, cache.IsSeen("addr1", 789, "close"))
+	assert.True(t, cache.IsSeenInScope("tenant-a", "addr1", 789, "close"))
+	assert.True(t, cache.IsSeenInScope("tenant-b", "addr1", 789, "close"))
+}
+
+func TestAddressScopes(t *testing.T) {
+	var nilScopes *AddressScopes
+	assert.Equal(t, []string{DefaultScope}, nilScopes.Get("addr1"))
+
+	scopes := NewAddressScopes()
+	assert.Equal(t, []string{DefaultScope}, scopes.Get("addr1"))
+
+	scopes.Set("addr1", []string{"2", "1", "2"})
+	assert.Equal(t, []string{"1", "2"}, scopes.Get("addr1"))
+
+	scopes.Replace(map[string][]string{"addr2": {"3"}})
+	assert.Equal(t, []string{DefaultScope}, scopes.Get("addr1"))
+	assert.Equal(t, []string{"3"}, scopes.Get("addr2"))
+}
+
 func TestDedupCache_TTL(t *testing.T) {
 	cache := NewDedupCache(100 * time.Millisecond)
 
@@ -90,6 +127,41 @@ func TestDedupCache_Stats(t *testing.T) {
 	stats := cache.Stats()
 	assert.Equal(t, 3, stats["item_count"])
 	assert.Equal(t, 5.0, stats["ttl_minutes"])
+	assert.EqualValues(t, 0, stats["hits"])
+	assert.Equal(t, 0.0, stats["hit_rate"])
+}
+
+func TestDedupCache_StatsHitRateAndOldest(t *testing.T) {
+	now := time.Now()
+	cache := newDedupCache(time.Minute, func() time.Time { return now })
+	defer cache.Close()
+
+	stats := cache.Stats()
+	assert.Equal(t, 0.0, stats["oldest_age_seconds"], "无条目")
+
+	cache.Mark("addr1", 1, "open")
+	now = now.Add(20 * time.Second)
+	cache.Mark("addr2", 2, "open")
+	now = now.Add(10 * time.Second)
+
+	assert.True(t, cache.IsSeen("addr1", 1, "open"))
+	assert.True(t, cache.IsSeen("addr2", 2, "open"))
+	assert.True(t, cache.IsSeen("addr2", 2, "open"))
+	assert.False(t, cache.IsSeen("addr3", 3, "open"))
+
+	// 查询命中只调整 LRU 顺序，最早条目按标记时间计算
+	stats = cache.Stats()
+	assert.EqualValues(t, 3, stats["hits"])
+	assert.EqualValues(t, 1, stats["misses"])
+	assert.Equal(t, 0.75, stats["hit_rate"])
+	assert.Equal(t, 30.0, stats["oldest_age_seconds"])
+
+	// 过期条目计为未命中
+	now = now.Add(45 * time.Second)
+	assert.False(t, cache.IsSeen("addr1", 1, "open"))
+	stats = cache.Stats()
+	assert.EqualValues(t, 2, stats["misses"])
+	assert.Equal(t, 55.0, stats["oldest_age_seconds"])
 }
 
 // T044: 性能基准测试
@@ -125,3 +197,109 @@ func BenchmarkDedupCache_Concurrent(b *testing.B) {
 		}
 	})
 }
+
+func TestDedupCache_MaxEntries(t *testing.T) {
+	cache := NewDedupCache(30 * time.Minute)
+	defer cache.Close()
+	cache.SetMaxEntries(dedupShardCount * 2)
+
+	for i := 0; i < 1000; i++ {
+		cache.Mark("addr1", int64(i), "open")
+	}
+	entries, bytes := cache.size()
+	assert.LessOrEqual(t, entries, dedupShardCount*2)
+	assert.Greater(t, bytes, entries*dedupEntryOverhead)
+
+	// 最近标记的条目保留
+	assert.True(t, cache.IsSeen("addr1", 999, "open"))
+	assert.False(t, cache.IsSeen("addr1", 0, "open"))
+
+	// 查询命中后移到 LRU 前端，同分片的新条目优先淘汰其他条目
+	key := cache.dedupKey(DefaultScope, "addr1", 999, "open")
+	shard := cache.shard(key)
+	for i := 1000; i < 2000; i++ {
+		if cache.shard(cache.dedupKey(DefaultScope, "addr1", int64(i), "open")) == shard {
+			assert.True(t, cache.IsSeen("addr1", 999, "open"))
+			cache.Mark("addr1", int64(i), "open")
+		}
+	}
+	assert.True(t, cache.IsSeen("addr1", 999, "open"))
+
+	// 取消上限后不再淘汰
+	cache.SetMaxEntries(0)
+	for i := 2000; i < 3000; i++ {
+		cache.Mark("addr2", int64(i), "open")
+	}
+	entries, _ = cache.size()
+	assert.GreaterOrEqual(t, entries, 1000)
+	assert.EqualValues(t, 0, cache.Stats()["max_entries"])
+}
+
+func TestDedupCache_SweepShard(t *testing.T) {
+	var mu sync.Mutex
+	now := time.Now()
+	advance := func(d time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		now = now.Add(d)
+	}
+	cache := newDedupCache(time.Minute, func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	})
+	defer cache.Close()
+
+	for i := 0; i < 100; i++ {
+		cache.Mark("addr1", int64(i), "open")
+	}
+	advance(30 * time.Second)
+	cache.Mark("addr1", 1000, "open")
+
+	// 过期后逐个分片清理，未过期的条目保留
+	advance(40 * time.Second)
+	cache.sweepShard(0)
+	entries, _ := cache.size()
+	assert.Less(t, entries, 101)
+	assert.Greater(t, entries, 1)
+
+	for i := 1; i < dedupShardCount; i++ {
+		cache.sweepShard(i)
+	}
+	entries, bytes := cache.size()
+	assert.Equal(t, 1, entries)
+	assert.Equal(t, len(cache.dedupKey(DefaultScope, "addr1", 1000, "open"))+dedupEntryOverhead, bytes)
+	assert.True(t, cache.IsSeen("addr1", 1000, "open"))
+}
+
+// TestDedupCache_SweepExpiryOrder 测试清理按标记时间而非访问顺序：查询命中不延长过期，重新标记会延长
+func TestDedupCache_SweepExpiryOrder(t *testing.T) {
+	var mu sync.Mutex
+	now := time.Now()
+	advance := func(d time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		now = now.Add(d)
+	}
+	cache := newDedupCache(time.Minute, func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	})
+	defer cache.Close()
+
+	cache.Mark("addr1", 1, "open")
+	cache.Mark("addr1", 2, "open")
+	advance(30 * time.Second)
+	assert.True(t, cache.IsSeen("addr1", 1, "open"))
+	cache.Mark("addr1", 2, "open")
+
+	advance(40 * time.Second)
+	for i := 0; i < dedupShardCount; i++ {
+		cache.sweepShard(i)
+	}
+	entries, _ := cache.size()
+	assert.Equal(t, 1, entries)
+	assert.Equal(t, cache.now().Add(-40*time.Second), cache.oldest())
+	assert.True(t, cache.IsSeen("addr1", 2, "open"))
+}
@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"sync"
+
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+// DexRegistry 永续 dex 注册表（来自 perpDexs），只记录 builder 部署的 dex，主 dex 名称为空不登记
+type DexRegistry struct {
+	mu    sync.RWMutex
+	names []string              // 按 perpDexs 顺序排列的 dex 名称
+	dexes map[string]hl.PerpDex // dex 名称 -> dex 信息
+}
+
+// NewDexRegistry 创建 dex 注册表
+func NewDexRegistry() *DexRegistry {
+	return &DexRegistry{dexes: make(map[string]hl.PerpDex)}
+}
+
+// Set 整体替换注册表，忽略主 dex
+func (r *DexRegistry) Set(dexes []hl.PerpDex) {
+	names := make([]string, 0, len(dexes))
+	byName := make(map[string]hl.PerpDex, len(dexes))
+	for _, dex := range dexes {
+		if dex.Name == "" {
+			continue
+		}
+		if _, exists := byName[dex.Name]; !exists {
+			names = append(names, dex.Name)
+		}
+		byName[dex.Name] = dex
+	}
+
+	r.mu.Lock()
+	r.names = names
+	r.dexes = byName
+	r.mu.Unlock()
+}
+
+// Get 获取 dex 信息
+func (r *DexRegistry) Get(name string) (hl.PerpDex, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	dex, ok := r.dexes[name]
+	return dex, ok
+}
+
+// Names 返回全部 builder dex 名称（不含主 dex）
+func (r *DexRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]string(nil), r.names...)
+}
+
+// Len 返回已登记的 builder dex 数量
+func (r *DexRegistry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.names)
+}
+
+// Known 判断合约资产名所属 dex 是否已登记：主 dex 资产恒为 true，
+// 注册表为空（尚未加载成功）时不做限制
+func (r *DexRegistry) Known(coin string) bool {
+	dex, _ := hl.SplitDexCoin(coin)
+	if dex == "" {
+		return true
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if len(r.names) == 0 {
+		return true
+	}
+	_, ok := r.dexes[dex]
+	return ok
+}
+
+// Stats 获取统计信息
+func (r *DexRegistry) Stats() map[string]interface{} {
+	return map[string]interface{}{
+		"count": r.Len(),
+		"dexes": r.Names(),
+	}
+}
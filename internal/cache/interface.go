@@ -4,6 +4,8 @@ package cache
 type DedupCacheInterface interface {
 	IsSeen(address string, oid int64, direction string) bool
 	Mark(address string, oid int64, direction string)
+	IsSeenInScope(scope, address string, oid int64, direction string) bool
+	MarkInScope(scope, address string, oid int64, direction string)
 	LoadFromDB(dao interface{}) error
 	Stats() map[string]interface{}
 }
@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/pkg/concurrent"
+)
+
+// MarketCtx 合约资产的市场上下文（来自 metaAndAssetCtxs）
+type MarketCtx struct {
+	DayNtlVlm    float64   // 24 小时成交额(USD)
+	OpenInterest float64   // 未平仓量（币数量）
+	Funding      float64   // 当前资金费率
+	UpdatedAt    time.Time // 最近一次刷新时间
+}
+
+// MarketCtxCache 合约市场上下文缓存，按资产名（如 BTC）索引
+type MarketCtxCache struct {
+	ctxs concurrent.Map[string, MarketCtx]
+}
+
+// NewMarketCtxCache 创建市场上下文缓存
+func NewMarketCtxCache() *MarketCtxCache {
+	return &MarketCtxCache{}
+}
+
+// Get 获取资产的市场上下文
+func (c *MarketCtxCache) Get(coin string) (MarketCtx, bool) {
+	return c.ctxs.Load(coin)
+}
+
+// Set 设置资产的市场上下文
+func (c *MarketCtxCache) Set(coin string, ctx MarketCtx) {
+	c.ctxs.Store(coin, ctx)
+}
+
+// Stats 获取统计信息
+func (c *MarketCtxCache) Stats() map[string]interface{} {
+	return map[string]interface{}{
+		"count": c.ctxs.Len(),
+	}
+}
@@ -1,6 +1,10 @@
 package cache
 
 import (
+	"strings"
+	"sync/atomic"
+	"time"
+
 	"github.com/spf13/cast"
 	"github.com/utrading/utrading-hl-monitor/internal/models"
 	"github.com/utrading/utrading-hl-monitor/pkg/concurrent"
@@ -13,11 +17,43 @@ type PositionBalanceCache struct {
 	accountValues    concurrent.Map[string, float64]                  // address → AccountValue
 	spotBalances     concurrent.Map[string, *models.SpotBalancesData] // address → 现货持仓数据
 	futuresPositions concurrent.Map[string, *models.FuturesPositionsData] // address → 合约持仓数据
+	margins          concurrent.Map[string, MarginInfo]                   // address → 保证金数据
+	updatedAt        concurrent.Map[string, time.Time]                    // address → 最近一次 webData2 更新时间
+	leverages        concurrent.Map[string, models.LeverageItem]          // address|symbol → 最近一次已知的杠杆设置，仓位平掉后保留
+
+	staleTTL atomic.Int64 // 超过该时长未更新视为过期，0 表示不判断
+	now      func() time.Time
+}
+
+// MarginInfo 合约账户保证金数据（用于仓位比例的可选分母）
+type MarginInfo struct {
+	Withdrawable    float64 // 可提取金额
+	TotalMarginUsed float64 // 已占用初始保证金
 }
 
 // NewPositionBalanceCache 创建缓存实例
 func NewPositionBalanceCache() *PositionBalanceCache {
-	return &PositionBalanceCache{}
+	return &PositionBalanceCache{now: time.Now}
+}
+
+// SetStaleTTL 设置余额过期时长：超过该时长未收到 webData2 的地址视为过期，0 表示不判断
+func (c *PositionBalanceCache) SetStaleTTL(ttl time.Duration) {
+	c.staleTTL.Store(int64(ttl))
+}
+
+// IsStale 地址的余额是否过期（从未收到 webData2 或超过过期时长未更新）
+func (c *PositionBalanceCache) IsStale(address string) bool {
+	ttl := time.Duration(c.staleTTL.Load())
+	if ttl <= 0 {
+		return false
+	}
+	updatedAt, ok := c.updatedAt.Load(address)
+	return !ok || c.now().Sub(updatedAt) > ttl
+}
+
+// UpdatedAt 获取地址最近一次更新时间
+func (c *PositionBalanceCache) UpdatedAt(address string) (time.Time, bool) {
+	return c.updatedAt.Load(address)
 }
 
 // Set 更新总价值和持仓数据
@@ -26,6 +62,45 @@ func (c *PositionBalanceCache) Set(address string, spotTotal float64, accountVal
 	c.accountValues.Store(address, accountValue)
 	c.spotBalances.Store(address, spotBalances)
 	c.futuresPositions.Store(address, futuresPositions)
+	c.updatedAt.Store(address, c.now())
+	if futuresPositions != nil {
+		for _, position := range *futuresPositions {
+			if position.Leverage.Value > 0 {
+				c.leverages.Store(leverageKey(address, position.Coin), position.Leverage)
+			}
+		}
+	}
+}
+
+// SetLeverage 记录合约的杠杆设置（如通过 activeAssetData 查询的结果）
+func (c *PositionBalanceCache) SetLeverage(address string, symbol string, leverage models.LeverageItem) {
+	c.leverages.Store(leverageKey(address, symbol), leverage)
+}
+
+// GetLeverage 获取合约的杠杆设置：优先取当前持仓，无持仓时返回最近一次已知的设置
+func (c *PositionBalanceCache) GetLeverage(address string, symbol string) (models.LeverageItem, bool) {
+	if data, found := c.futuresPositions.Load(address); found {
+		for _, position := range *data {
+			if position.Coin == symbol && position.Leverage.Value > 0 {
+				return position.Leverage, true
+			}
+		}
+	}
+	return c.leverages.Load(leverageKey(address, symbol))
+}
+
+func leverageKey(address, symbol string) string {
+	return address + "|" + symbol
+}
+
+// SetMargin 更新保证金数据
+func (c *PositionBalanceCache) SetMargin(address string, margin MarginInfo) {
+	c.margins.Store(address, margin)
+}
+
+// GetMargin 获取保证金数据
+func (c *PositionBalanceCache) GetMargin(address string) (MarginInfo, bool) {
+	return c.margins.Load(address)
 }
 
 // GetSpotTotal 获取现货总价值
@@ -68,10 +143,34 @@ func (c *PositionBalanceCache) GetFuturesPosition(address string, coin string) (
 	return 0, false
 }
 
+// GetPositions 获取地址的全部现货持仓和合约持仓
+func (c *PositionBalanceCache) GetPositions(address string) (*models.SpotBalancesData, *models.FuturesPositionsData, bool) {
+	spot, spotFound := c.spotBalances.Load(address)
+	futures, futuresFound := c.futuresPositions.Load(address)
+	if !spotFound && !futuresFound {
+		return nil, nil, false
+	}
+	return spot, futures, true
+}
+
+// RangeFutures 遍历所有地址的合约持仓，fn 返回 false 时停止
+func (c *PositionBalanceCache) RangeFutures(fn func(address string, positions *models.FuturesPositionsData) bool) {
+	c.futuresPositions.Range(fn)
+}
+
 // Delete 删除缓存（取消订阅时使用）
 func (c *PositionBalanceCache) Delete(address string) {
 	c.spotTotals.Delete(address)
 	c.accountValues.Delete(address)
 	c.spotBalances.Delete(address)
 	c.futuresPositions.Delete(address)
+	c.margins.Delete(address)
+	c.updatedAt.Delete(address)
+	prefix := address + "|"
+	c.leverages.Range(func(key string, _ models.LeverageItem) bool {
+		if strings.HasPrefix(key, prefix) {
+			c.leverages.Delete(key)
+		}
+		return true
+	})
 }
@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/utrading/utrading-hl-monitor/internal/models"
 )
 
 func TestPositionBalanceCache_SetAndGet(t *testing.T) {
@@ -130,3 +133,62 @@ func TestPositionBalanceCache_ConcurrentReadWrite(t *testing.T) {
 	assert.GreaterOrEqual(t, spotTotal, 1000.0)
 	assert.Less(t, spotTotal, 1100.0)
 }
+
+func TestPositionBalanceCache_Stale(t *testing.T) {
+	cache := NewPositionBalanceCache()
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	// 未设置过期时长时不判断
+	assert.False(t, cache.IsStale("0x123"))
+
+	cache.SetStaleTTL(time.Minute)
+	assert.True(t, cache.IsStale("0x123"), "从未收到 webData2 视为过期")
+
+	cache.Set("0x123", 1000, 5000, nil, nil)
+	assert.False(t, cache.IsStale("0x123"))
+
+	now = now.Add(2 * time.Minute)
+	assert.True(t, cache.IsStale("0x123"))
+
+	cache.Set("0x123", 1000, 5000, nil, nil)
+	assert.False(t, cache.IsStale("0x123"))
+
+	cache.Delete("0x123")
+	_, ok := cache.UpdatedAt("0x123")
+	assert.False(t, ok)
+	assert.True(t, cache.IsStale("0x123"))
+}
+
+func TestPositionBalanceCache_Leverage(t *testing.T) {
+	cache := NewPositionBalanceCache()
+	cross := models.LeverageItem{Type: "cross", Value: 20}
+	cache.Set("0x123", 0, 1000, nil, &models.FuturesPositionsData{
+		{Coin: "BTCUSDC", Szi: "0.5", Leverage: cross},
+	})
+
+	leverage, ok := cache.GetLeverage("0x123", "BTCUSDC")
+	assert.True(t, ok)
+	assert.Equal(t, cross, leverage)
+	_, ok = cache.GetLeverage("0x123", "ETHUSDC")
+	assert.False(t, ok)
+
+	// 仓位平掉后保留最近一次已知的杠杆
+	cache.Set("0x123", 0, 1000, nil, &models.FuturesPositionsData{})
+	leverage, ok = cache.GetLeverage("0x123", "BTCUSDC")
+	assert.True(t, ok)
+	assert.Equal(t, cross, leverage)
+
+	// 当前持仓优先于记录的设置
+	cache.SetLeverage("0x123", "ETHUSDC", models.LeverageItem{Type: "cross", Value: 5})
+	isolated := models.LeverageItem{Type: "isolated", Value: 3}
+	cache.Set("0x123", 0, 1000, nil, &models.FuturesPositionsData{
+		{Coin: "ETHUSDC", Szi: "-2", Leverage: isolated},
+	})
+	leverage, _ = cache.GetLeverage("0x123", "ETHUSDC")
+	assert.Equal(t, isolated, leverage)
+
+	cache.Delete("0x123")
+	_, ok = cache.GetLeverage("0x123", "BTCUSDC")
+	assert.False(t, ok)
+}
@@ -10,6 +10,7 @@ type SymbolCache struct {
 	spotSymbolToName *concurrent.Map[string, string] // symbol -> assetName (@123)
 	perpNameToSymbol *concurrent.Map[string, string] // assetName (BTC) -> symbol
 	perpSymbolToName *concurrent.Map[string, string] // symbol -> assetName (BTC)
+	perpDelisted     *concurrent.Map[string, string] // 已下架的 assetName -> symbol
 }
 
 // NewSymbolCache 创建 Symbol 缓存
@@ -19,6 +20,7 @@ func NewSymbolCache() *SymbolCache {
 		spotSymbolToName: &concurrent.Map[string, string]{},
 		perpNameToSymbol: &concurrent.Map[string, string]{},
 		perpSymbolToName: &concurrent.Map[string, string]{},
+		perpDelisted:     &concurrent.Map[string, string]{},
 	}
 }
 
@@ -58,6 +60,41 @@ func (c *SymbolCache) SetPerpSymbol(assetName, symbol string) {
 	c.perpSymbolToName.Store(symbol, assetName)
 }
 
+// MarkPerpDelisted 标记合约资产已下架（meta 中 isDelisted），首次标记时返回 true
+// symbol 映射保留，下架前的成交和仓位仍可转换
+func (c *SymbolCache) MarkPerpDelisted(assetName, symbol string) bool {
+	_, loaded := c.perpDelisted.LoadOrStore(assetName, symbol)
+	return !loaded
+}
+
+// IsPerpDelisted 判断合约资产是否已下架
+// assetName: 如 "BTC"
+func (c *SymbolCache) IsPerpDelisted(assetName string) bool {
+	_, ok := c.perpDelisted.Load(assetName)
+	return ok
+}
+
+// RenamePerpSymbols 按 rename 改写已缓存的合约 symbol（未改名时 rename 原样返回），正向和反向索引同时更新
+func (c *SymbolCache) RenamePerpSymbols(rename func(symbol string) string) {
+	renameSymbols(c.perpNameToSymbol, c.perpSymbolToName, rename)
+}
+
+// RenameSpotSymbols 按 rename 改写已缓存的现货 symbol
+func (c *SymbolCache) RenameSpotSymbols(rename func(symbol string) string) {
+	renameSymbols(c.spotNameToSymbol, c.spotSymbolToName, rename)
+}
+
+func renameSymbols(nameToSymbol, symbolToName *concurrent.Map[string, string], rename func(string) string) {
+	nameToSymbol.Range(func(name, symbol string) bool {
+		if renamed := rename(symbol); renamed != symbol {
+			nameToSymbol.Store(name, renamed)
+			symbolToName.Delete(symbol)
+			symbolToName.Store(renamed, name)
+		}
+		return true
+	})
+}
+
 // Stats 获取统计信息
 func (c *SymbolCache) Stats() map[string]interface{} {
 	return map[string]interface{}{
@@ -65,5 +102,6 @@ func (c *SymbolCache) Stats() map[string]interface{} {
 		"spot_symbol_to_name_count": c.spotSymbolToName.Len(),
 		"perp_name_to_symbol_count": c.perpNameToSymbol.Len(),
 		"perp_symbol_to_name_count": c.perpSymbolToName.Len(),
+		"perp_delisted_count":       c.perpDelisted.Len(),
 	}
 }
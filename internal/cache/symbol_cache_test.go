@@ -127,3 +127,29 @@ func TestSymbolCache_Overwrite(t *testing.T) {
 	// 这是可以接受的，因为在实际使用中，coin 到 symbol 的映射是稳定的
 	_, _ = cache.GetSpotName("OLDUSDC") // 旧映射可能仍然存在
 }
+
+func TestSymbolCache_PerpDelisted(t *testing.T) {
+	cache := NewSymbolCache()
+	cache.SetPerpSymbol("OLD", "OLDUSDC")
+
+	if cache.IsPerpDelisted("OLD") {
+		t.Fatal("OLD should not be delisted before marking")
+	}
+	if !cache.MarkPerpDelisted("OLD", "OLDUSDC") {
+		t.Fatal("first mark should report newly delisted")
+	}
+	if cache.MarkPerpDelisted("OLD", "OLDUSDC") {
+		t.Fatal("second mark should not report again")
+	}
+	if !cache.IsPerpDelisted("OLD") {
+		t.Fatal("OLD should be delisted")
+	}
+
+	// symbol 映射保留
+	if symbol, ok := cache.GetPerpSymbol("OLD"); !ok || symbol != "OLDUSDC" {
+		t.Fatalf("mapping should be kept: got %s, %v", symbol, ok)
+	}
+	if count := cache.Stats()["perp_delisted_count"].(int64); count != 1 {
+		t.Fatalf("perp_delisted_count wrong: got %d", count)
+	}
+}
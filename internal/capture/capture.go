@@ -0,0 +1,308 @@
+package capture
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/internal/simulate"
+	"github.com/utrading/utrading-hl-monitor/internal/ws"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+const (
+	// allAddresses 消息不含 user 字段（如 orderUpdates）时使用的地址目录
+	allAddresses = "all"
+	// fileSuffix 录制文件后缀，simulate -input 按后缀识别 gzip
+	fileSuffix = ".ndjson.gz"
+	// uploadQueueSize 待存储文件队列长度
+	uploadQueueSize = 1024
+	// storeTimeout 单个文件的存储超时时间
+	storeTimeout = 10 * time.Minute
+)
+
+// entry 待写入的消息
+type entry struct {
+	ts  int64 // 接收时间（毫秒）
+	msg ws.WsMessage
+}
+
+// file 录制中的文件，缓冲达到 flush_size 时作为一个 gzip 成员追加写入 work_dir
+type file struct {
+	name string // 存储名称（<channel>/<address>/<窗口开始时间>.ndjson.gz）
+	path string // work_dir 中的路径
+	buf  bytes.Buffer
+}
+
+// Capturer 录制原始 WS 消息
+// 消息经非阻塞队列交给单个写入协程，按频道、地址分文件，每个文件由多个 gzip 成员组成（gzip.Reader 默认按多成员读取），
+// 按 rotate_interval 轮转，轮转后的文件由上传协程交给存储；录制时长到期后自动停止
+type Capturer struct {
+	cfg       config.Capture
+	storage   Storage
+	channels  map[ws.Channel]struct{} // 为空时录制全部频道
+	addresses map[string]struct{}     // 为空时录制全部地址
+
+	queue   chan entry
+	uploads chan *file
+	active  atomic.Bool
+	now     func() time.Time
+
+	// 以下字段只由写入协程访问
+	files       map[string]*file // channel/address -> 文件
+	windowStart time.Time
+	gz          *gzip.Writer
+
+	done     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+	uploadWg sync.WaitGroup
+}
+
+// NewCapturer 创建录制器
+func NewCapturer(cfg config.Capture, storage Storage) *Capturer {
+	c := &Capturer{
+		cfg:       cfg,
+		storage:   storage,
+		channels:  make(map[ws.Channel]struct{}, len(cfg.Channels)),
+		addresses: make(map[string]struct{}, len(cfg.Addresses)),
+		queue:     make(chan entry, max(cfg.QueueSize, 1)),
+		uploads:   make(chan *file, uploadQueueSize),
+		now:       time.Now,
+		files:     make(map[string]*file),
+		gz:        gzip.NewWriter(nil),
+		done:      make(chan struct{}),
+	}
+	for _, ch := range cfg.Channels {
+		c.channels[ws.Channel(ch)] = struct{}{}
+	}
+	for _, addr := range cfg.Addresses {
+		c.addresses[strings.ToLower(addr)] = struct{}{}
+	}
+	return c
+}
+
+// Start 开始录制
+func (c *Capturer) Start() error {
+	if err := os.MkdirAll(c.cfg.WorkDir, 0o755); err != nil {
+		return err
+	}
+	c.windowStart = c.now()
+	c.active.Store(true)
+	monitor.SetCaptureActive(true)
+
+	c.wg.Add(1)
+	goplus.Go(func() {
+		defer c.wg.Done()
+		c.run()
+	})
+	c.uploadWg.Add(1)
+	goplus.Go(func() {
+		defer c.uploadWg.Done()
+		c.uploadLoop()
+	})
+
+	logger.Info().Str("work_dir", c.cfg.WorkDir).Str("storage", c.cfg.Storage).
+		Dur("duration", c.cfg.Duration).Dur("rotate_interval", c.cfg.RotateInterval).
+		Msg("ws capture started")
+	return nil
+}
+
+// Stop 停止录制，写出剩余缓冲并等待已轮转的文件存储完成
+func (c *Capturer) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.done)
+	})
+	c.wg.Wait()
+	c.uploadWg.Wait()
+}
+
+// Active 是否录制中
+func (c *Capturer) Active() bool {
+	return c.active.Load()
+}
+
+// Capture 录制一条消息（PoolManager 消息旁路），非阻塞，队列满时丢弃
+func (c *Capturer) Capture(msg ws.WsMessage) {
+	if !c.active.Load() {
+		return
+	}
+	if len(c.channels) > 0 {
+		if _, ok := c.channels[msg.Channel]; !ok {
+			return
+		}
+	}
+
+	select {
+	case c.queue <- entry{ts: c.now().UnixMilli(), msg: msg}:
+	default:
+		monitor.IncCaptureDropped()
+	}
+}
+
+func (c *Capturer) run() {
+	ticker := time.NewTicker(c.cfg.RotateInterval)
+	defer ticker.Stop()
+
+	var deadline <-chan time.Time
+	if c.cfg.Duration > 0 {
+		timer := time.NewTimer(c.cfg.Duration)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	for {
+		select {
+		case e := <-c.queue:
+			c.write(e)
+		case <-ticker.C:
+			c.rotate()
+		case <-deadline:
+			logger.Info().Dur("duration", c.cfg.Duration).Msg("ws capture duration reached")
+			c.finish()
+			return
+		case <-c.done:
+			c.finish()
+			return
+		}
+	}
+}
+
+// finish 停止接收消息，写出队列中剩余的消息并轮转全部文件
+func (c *Capturer) finish() {
+	c.active.Store(false)
+	monitor.SetCaptureActive(false)
+	for {
+		select {
+		case e := <-c.queue:
+			c.write(e)
+		default:
+			c.rotate()
+			close(c.uploads)
+			logger.Info().Msg("ws capture stopped")
+			return
+		}
+	}
+}
+
+// write 追加一条消息到所属文件的缓冲
+func (c *Capturer) write(e entry) {
+	addr := strings.ToLower(gjson.GetBytes(e.msg.Data, "user").String())
+	if addr == "" {
+		addr = allAddresses
+	} else if len(c.addresses) > 0 {
+		if _, ok := c.addresses[addr]; !ok {
+			return
+		}
+	}
+	if !safeName(addr) {
+		addr = "invalid"
+	}
+
+	f := c.file(e.msg.Channel, addr)
+	line, err := json.Marshal(simulate.Record{Ts: e.ts, Channel: e.msg.Channel, Data: e.msg.Data})
+	if err != nil {
+		logger.Warn().Err(err).Str("channel", string(e.msg.Channel)).Msg("encode capture record failed")
+		return
+	}
+	f.buf.Write(line)
+	f.buf.WriteByte('\n')
+	monitor.IncCaptureRecord()
+
+	if f.buf.Len() >= c.cfg.FlushSize {
+		c.flush(f)
+	}
+}
+
+// file 获取或创建当前窗口内的文件
+func (c *Capturer) file(channel ws.Channel, addr string) *file {
+	key := string(channel) + "/" + addr
+	if f, ok := c.files[key]; ok {
+		return f
+	}
+	name := path.Join(string(channel), addr, c.windowStart.UTC().Format("20060102T150405Z")+fileSuffix)
+	f := &file{name: name, path: filepath.Join(c.cfg.WorkDir, filepath.FromSlash(name))}
+	c.files[key] = f
+	return f
+}
+
+// flush 将缓冲压缩为一个 gzip 成员追加到文件
+func (c *Capturer) flush(f *file) {
+	if f.buf.Len() == 0 {
+		return
+	}
+	defer f.buf.Reset()
+
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o755); err != nil {
+		logger.Error().Err(err).Str("path", f.path).Msg("create capture dir failed")
+		return
+	}
+	out, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		logger.Error().Err(err).Str("path", f.path).Msg("open capture file failed")
+		return
+	}
+	c.gz.Reset(out)
+	_, err = c.gz.Write(f.buf.Bytes())
+	if closeErr := c.gz.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		logger.Error().Err(err).Str("path", f.path).Msg("write capture file failed")
+	}
+}
+
+// rotate 写出全部缓冲，将当前窗口的文件交给上传协程并开始新窗口
+func (c *Capturer) rotate() {
+	for key, f := range c.files {
+		c.flush(f)
+		delete(c.files, key)
+		if _, err := os.Stat(f.path); err != nil {
+			continue
+		}
+		c.uploads <- f
+	}
+	c.windowStart = c.now()
+}
+
+// uploadLoop 依次存储轮转后的文件，失败时保留在 work_dir 中
+func (c *Capturer) uploadLoop() {
+	for f := range c.uploads {
+		ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+		err := c.storage.Store(ctx, f.name, f.path)
+		cancel()
+		if err != nil {
+			monitor.IncCaptureFile("failed")
+			logger.Error().Err(err).Str("name", f.name).Str("path", f.path).Msg("store capture file failed, kept in work_dir")
+			continue
+		}
+		monitor.IncCaptureFile("success")
+		_ = os.Remove(f.path)
+	}
+}
+
+// safeName 地址只允许字母和数字，避免用作路径时越出 work_dir
+func safeName(s string) bool {
+	for _, r := range s {
+		if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z') {
+			return false
+		}
+	}
+	return s != ""
+}
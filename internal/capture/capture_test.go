@@ -0,0 +1,143 @@
+package capture
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/simulate"
+	"github.com/utrading/utrading-hl-monitor/internal/ws"
+	"github.com/utrading/utrading-hl-monitor/pkg/secrets"
+)
+
+func testConfig(t *testing.T) config.Capture {
+	cfg := config.Default().Capture
+	cfg.Enabled = true
+	cfg.WorkDir = filepath.Join(t.TempDir(), "work")
+	cfg.Dir = filepath.Join(t.TempDir(), "store")
+	cfg.FlushSize = 64 // 每条消息都写出一个 gzip 成员
+	return cfg
+}
+
+func TestCapturer_RoundTrip(t *testing.T) {
+	cfg := testConfig(t)
+	cfg.Addresses = []string{"0xABC"}
+	c := NewCapturer(cfg, NewLocalStorage(cfg.Dir))
+	ts := int64(1000)
+	c.now = func() time.Time { ts += 100; return time.UnixMilli(ts) }
+	require.NoError(t, c.Start())
+
+	c.Capture(ws.WsMessage{Channel: ws.ChannelWebData2, Data: []byte(`{"user":"0xabc","serverTime":1}`)})
+	c.Capture(ws.WsMessage{Channel: ws.ChannelUserFills, Data: []byte(`{"user":"0xabc","fills":[]}`)})
+	c.Capture(ws.WsMessage{Channel: ws.ChannelUserFills, Data: []byte(`{"user":"0xdef","fills":[]}`)}) // 不在地址列表
+	c.Capture(ws.WsMessage{Channel: ws.ChannelOrderUpdates, Data: []byte(`[]`)})
+	c.Capture(ws.WsMessage{Channel: ws.ChannelUserFills, Data: []byte(`{"user":"0xabc","fills":[{"tid":1}]}`)})
+	c.Capture(ws.WsMessage{Channel: ws.ChannelUserFills, Data: []byte(`{"user":"../x","fills":[]}`)})
+	c.Stop()
+	assert.False(t, c.Active())
+
+	// 停止后不再录制
+	c.Capture(ws.WsMessage{Channel: ws.ChannelUserFills, Data: []byte(`{"user":"0xabc","fills":[]}`)})
+
+	files, err := filepath.Glob(filepath.Join(cfg.Dir, "*", "*", "*.ndjson.gz"))
+	require.NoError(t, err)
+	var names []string
+	for _, f := range files {
+		rel, _ := filepath.Rel(cfg.Dir, f)
+		names = append(names, filepath.Dir(filepath.ToSlash(rel)))
+	}
+	assert.ElementsMatch(t, []string{"webData2/0xabc", "userFills/0xabc", "orderUpdates/all"}, names)
+
+	// 工作目录中的文件已移走
+	left, _ := filepath.Glob(filepath.Join(cfg.WorkDir, "*", "*", "*"))
+	assert.Empty(t, left)
+
+	r, err := simulate.Open(cfg.Dir)
+	require.NoError(t, err)
+	defer r.Close()
+	var got []string
+	require.NoError(t, simulate.Scan(r, func(rec simulate.Record) error {
+		got = append(got, string(rec.Channel)+" "+string(rec.Data))
+		return nil
+	}))
+	assert.Equal(t, []string{
+		`webData2 {"user":"0xabc","serverTime":1}`,
+		`userFills {"user":"0xabc","fills":[]}`,
+		`orderUpdates []`,
+		`userFills {"user":"0xabc","fills":[{"tid":1}]}`,
+	}, got)
+}
+
+func TestCapturer_ChannelFilterAndDuration(t *testing.T) {
+	cfg := testConfig(t)
+	cfg.Channels = []string{string(ws.ChannelUserFills)}
+	cfg.Duration = 50 * time.Millisecond
+	c := NewCapturer(cfg, NewLocalStorage(cfg.Dir))
+	require.NoError(t, c.Start())
+
+	c.Capture(ws.WsMessage{Channel: ws.ChannelWebData2, Data: []byte(`{"user":"0xabc"}`)})
+	c.Capture(ws.WsMessage{Channel: ws.ChannelUserFills, Data: []byte(`{"user":"0xabc","fills":[]}`)})
+
+	// 到期后自动停止并存储
+	require.Eventually(t, func() bool { return !c.Active() }, time.Second, 10*time.Millisecond)
+	c.Stop()
+
+	files, err := filepath.Glob(filepath.Join(cfg.Dir, "*", "*", "*.ndjson.gz"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Contains(t, filepath.ToSlash(files[0]), "/userFills/0xabc/")
+}
+
+func TestS3Storage_Store(t *testing.T) {
+	var (
+		method, path, auth, payloadHash string
+		body                            []byte
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		auth = r.Header.Get("Authorization")
+		payloadHash = r.Header.Get("X-Amz-Content-Sha256")
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	src := filepath.Join(t.TempDir(), "f.ndjson.gz")
+	f, err := os.Create(src)
+	require.NoError(t, err)
+	zw := gzip.NewWriter(f)
+	_, _ = io.WriteString(zw, `{"ts":1,"channel":"userFills","data":{}}`+"\n")
+	require.NoError(t, zw.Close())
+	require.NoError(t, f.Close())
+
+	s := NewS3Storage("captures", "us-east-1", srv.URL, "hl-monitor/prod", secrets.AWSCredentials{AccessKeyID: "AKID", SecretAccessKey: "secret"})
+	require.NoError(t, s.Store(context.Background(), "userFills/0xabc/20260101T000000Z.ndjson.gz", src))
+
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "/captures/hl-monitor/prod/userFills/0xabc/20260101T000000Z.ndjson.gz", path)
+	assert.True(t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/"), auth)
+	assert.Contains(t, auth, "/us-east-1/s3/aws4_request")
+	assert.Contains(t, auth, "x-amz-content-sha256")
+	assert.Len(t, payloadHash, 64)
+
+	raw, err := os.ReadFile(src)
+	require.NoError(t, err)
+	assert.Equal(t, raw, body)
+
+	// 非 200 返回错误
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+	})
+	err = s.Store(context.Background(), "x.ndjson.gz", src)
+	assert.ErrorContains(t, err, "status 403")
+}
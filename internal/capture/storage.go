@@ -0,0 +1,161 @@
+package capture
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/pkg/secrets"
+)
+
+// Storage 轮转后的录制文件存储
+type Storage interface {
+	// Store 保存本地文件 src，name 为以 / 分隔的相对路径（<channel>/<address>/<时间>.ndjson.gz）
+	// 返回 nil 后 src 可删除
+	Store(ctx context.Context, name, src string) error
+}
+
+// LocalStorage 存储到本地目录
+type LocalStorage struct {
+	dir string
+}
+
+// NewLocalStorage 创建本地目录存储
+func NewLocalStorage(dir string) *LocalStorage {
+	return &LocalStorage{dir: dir}
+}
+
+// Store 移动文件到存储目录，跨文件系统时复制
+func (s *LocalStorage) Store(_ context.Context, name, src string) error {
+	dst := filepath.Join(s.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+	return copyFile(src, dst)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// S3Storage 上传到 S3 兼容的对象存储（PutObject，path-style 地址，SigV4 签名）
+type S3Storage struct {
+	bucket   string
+	region   string
+	endpoint string
+	prefix   string
+	creds    secrets.AWSCredentials
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewS3Storage 创建 S3 存储，endpoint 为空时使用区域默认地址
+func NewS3Storage(bucket, region, endpoint, prefix string, creds secrets.AWSCredentials) *S3Storage {
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+	}
+	return &S3Storage{
+		bucket:   bucket,
+		region:   region,
+		endpoint: strings.TrimRight(endpoint, "/"),
+		prefix:   prefix,
+		creds:    creds,
+		client:   &http.Client{Timeout: 5 * time.Minute},
+		now:      time.Now,
+	}
+}
+
+// Store 上传文件，对象键为 prefix + name
+func (s *S3Storage) Store(ctx context.Context, name, src string) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// 先计算载荷哈希再流式上传，避免整个文件读入内存
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	payloadHash := hex.EncodeToString(h.Sum(nil))
+
+	key := path.Join(s.prefix, name)
+	u, err := url.Parse(s.endpoint + "/" + s.bucket + "/" + key)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.String(), f)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/gzip")
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	secrets.SignV4(req, payloadHash, "s3", s.region, s.creds, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("capture: s3 put %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("capture: s3 put %s: status %d: %s", key, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// NewStorage 按配置创建存储
+func NewStorage(cfg config.Capture) (Storage, error) {
+	switch cfg.Storage {
+	case "local":
+		return NewLocalStorage(cfg.Dir), nil
+	case "s3":
+		s3 := cfg.S3
+		creds := secrets.AWSCredentialsFromEnv()
+		region := s3.Region
+		if region == "" {
+			region = os.Getenv("AWS_REGION")
+		}
+		if region == "" || creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+			return nil, errors.New("capture.s3: region and AWS_ACCESS_KEY_ID / AWS_SECRET_ACCESS_KEY are required")
+		}
+		return NewS3Storage(s3.Bucket, region, s3.Endpoint, s3.Prefix, creds), nil
+	default:
+		return nil, fmt.Errorf("capture.storage must be local or s3, got %q", cfg.Storage)
+	}
+}
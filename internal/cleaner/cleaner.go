@@ -14,6 +14,9 @@ type Cleaner struct {
 	db       *gorm.DB
 	interval time.Duration // 清理间隔
 	done     chan struct{} // 停止信号
+
+	fillRetention        time.Duration // 原始成交保留时长，0 表示不清理
+	aggregationRetention time.Duration // 订单聚合保留时长
 }
 
 // NewCleaner 创建清理器
@@ -22,6 +25,20 @@ func NewCleaner(db *gorm.DB) *Cleaner {
 		db:       db,
 		interval: 1 * time.Hour, // 固定 1 小时
 		done:     make(chan struct{}),
+
+		aggregationRetention: 2 * time.Hour,
+	}
+}
+
+// SetFillRetention 设置原始成交（hl_fills）保留时长（可选）
+func (c *Cleaner) SetFillRetention(retention time.Duration) {
+	c.fillRetention = retention
+}
+
+// SetAggregationRetention 设置订单聚合（hl_order_aggregation）保留时长（可选，成交对账需保留到对账完成）
+func (c *Cleaner) SetAggregationRetention(retention time.Duration) {
+	if retention > c.aggregationRetention {
+		c.aggregationRetention = retention
 	}
 }
 
@@ -57,7 +74,7 @@ func (c *Cleaner) Stop() {
 func (c *Cleaner) clean() {
 	logger.Debug().Msg("running cleanup task")
 
-	// 清理 OrderAggregation（保留 2 小时）
+	// 清理 OrderAggregation（默认保留 2 小时）
 	if err := c.cleanOrderAggregation(); err != nil {
 		logger.Error().Err(err).Msg("clean order aggregation failed")
 	}
@@ -66,11 +83,38 @@ func (c *Cleaner) clean() {
 	if err := c.cleanAddressSignals(); err != nil {
 		logger.Error().Err(err).Msg("clean address signals failed")
 	}
+
+	// 清理 HlFill（按配置保留时长）
+	if err := c.cleanFills(); err != nil {
+		logger.Error().Err(err).Msg("clean fills failed")
+	}
+}
+
+// cleanFills 清理超过保留时长的原始成交
+func (c *Cleaner) cleanFills() error {
+	if c.fillRetention <= 0 {
+		return nil
+	}
+
+	cutoff := time.Now().Add(-c.fillRetention)
+	deleted, err := dao.Fill().DeleteOld(cutoff)
+	if err != nil {
+		return err
+	}
+
+	if deleted > 0 {
+		logger.Info().
+			Int64("deleted", deleted).
+			Time("cutoff", cutoff).
+			Msg("cleaned old fills")
+	}
+
+	return nil
 }
 
-// cleanOrderAggregation 清理 2 小时前的订单聚合数据
+// cleanOrderAggregation 清理超过保留时长的订单聚合数据
 func (c *Cleaner) cleanOrderAggregation() error {
-	cutoff := time.Now().Add(-2 * time.Hour).Unix()
+	cutoff := time.Now().Add(-c.aggregationRetention).Unix()
 	deleted, err := dao.OrderAggregation().DeleteOld(cutoff)
 	if err != nil {
 		return err
@@ -87,7 +131,7 @@ func (c *Cleaner) cleanOrderAggregation() error {
 }
 
 // cleanAddressSignals 清理地址信号数据
-// 策略：时间优先（7天前），数量兜底（50万条限制）
+// 策略：时间优先（7天前），数量兜底（50万条限制），未发布的发件箱信号不清理
 func (c *Cleaner) cleanAddressSignals() error {
 	// 1. 时间清理：删除 7 天前的记录
 	cutoff := time.Now().AddDate(0, 0, -7)
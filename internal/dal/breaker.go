@@ -0,0 +1,200 @@
+package dal
+
+import (
+	"context"
+	sqldriver "database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"syscall"
+	"time"
+
+	proxymysql "github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// ErrDBUnavailable 熔断期间数据库操作直接返回该错误，不再等待连接超时
+var ErrDBUnavailable = errors.New("database unavailable: circuit breaker open")
+
+// MySQL 服务端返回的不可用类错误码
+var unavailableErrorCodes = map[uint16]struct{}{
+	1040: {}, // Too many connections
+	1053: {}, // Server shutdown in progress
+	1317: {}, // Query execution was interrupted
+}
+
+// IsUnavailable 判断错误是否由数据库不可达导致（连接失败、连接断开、熔断中），约束冲突等业务错误返回 false
+func IsUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrDBUnavailable) ||
+		errors.Is(err, sqldriver.ErrBadConn) ||
+		errors.Is(err, proxymysql.ErrInvalidConn) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	var mysqlErr *proxymysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		_, ok := unavailableErrorCodes[mysqlErr.Number]
+		return ok
+	}
+	return false
+}
+
+// Breaker 数据库熔断器：连续出现不可用错误达到阈值后熔断，冷却期结束后放行一次试探请求
+type Breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openedAt  time.Time
+	probing   bool
+}
+
+// NewBreaker 创建熔断器，threshold <= 0 时不熔断
+func NewBreaker(threshold int, cooldown time.Duration) *Breaker {
+	return &Breaker{threshold: threshold, cooldown: cooldown}
+}
+
+// Allow 检查是否允许执行数据库操作
+func (b *Breaker) Allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.threshold <= 0 || b.failures < b.threshold {
+		return true
+	}
+	// 熔断中：冷却期结束后只放行一个试探请求
+	if b.probing || now.Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// Success 记录成功（数据库有响应），关闭熔断
+func (b *Breaker) Success() {
+	b.mu.Lock()
+	wasOpen := b.isOpen()
+	b.failures = 0
+	b.probing = false
+	b.mu.Unlock()
+
+	if wasOpen {
+		monitor.SetDBBreakerOpen(false)
+		logger.Info().Msg("database recovered, circuit breaker closed")
+	}
+}
+
+// Failure 记录不可用错误，返回熔断器是否处于打开状态
+func (b *Breaker) Failure(now time.Time) bool {
+	b.mu.Lock()
+	wasOpen := b.isOpen()
+	b.failures++
+	b.probing = false
+	open := b.isOpen()
+	if open {
+		b.openedAt = now
+	}
+	b.mu.Unlock()
+
+	if open && !wasOpen {
+		monitor.SetDBBreakerOpen(true)
+		monitor.IncDBBreakerTrips()
+		logger.Error().Int("failures", b.threshold).Dur("cooldown", b.cooldown).
+			Msg("database unavailable, circuit breaker opened")
+	}
+	return open
+}
+
+// Open 熔断器是否打开
+func (b *Breaker) Open() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.isOpen()
+}
+
+func (b *Breaker) isOpen() bool {
+	return b.threshold > 0 && b.failures >= b.threshold
+}
+
+// breakerPlugin 将熔断器挂到 gorm 回调上，所有 DAO 读写共享同一熔断状态
+type breakerPlugin struct {
+	breaker *Breaker
+}
+
+func (p *breakerPlugin) Name() string {
+	return "hl_monitor:breaker"
+}
+
+func (p *breakerPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	befores := []func(string, func(*gorm.DB)) error{
+		cb.Create().Before("*").Register, cb.Query().Before("*").Register, cb.Update().Before("*").Register,
+		cb.Delete().Before("*").Register, cb.Row().Before("*").Register, cb.Raw().Before("*").Register,
+	}
+	afters := []func(string, func(*gorm.DB)) error{
+		cb.Create().After("*").Register, cb.Query().After("*").Register, cb.Update().After("*").Register,
+		cb.Delete().After("*").Register, cb.Row().After("*").Register, cb.Raw().After("*").Register,
+	}
+	for i := range befores {
+		if err := befores[i]("hl_monitor:breaker_before", p.before); err != nil {
+			return err
+		}
+		if err := afters[i]("hl_monitor:breaker_after", p.after); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// before 熔断中直接失败，gorm 后续回调看到错误后不再执行语句
+func (p *breakerPlugin) before(db *gorm.DB) {
+	if !p.breaker.Allow(time.Now()) {
+		_ = db.AddError(ErrDBUnavailable)
+	}
+}
+
+// after 根据执行结果更新熔断状态，业务错误说明数据库可达
+func (p *breakerPlugin) after(db *gorm.DB) {
+	switch err := db.Error; {
+	case errors.Is(err, ErrDBUnavailable):
+	case IsUnavailable(err):
+		p.breaker.Failure(time.Now())
+	default:
+		p.breaker.Success()
+	}
+}
+
+var dbBreaker *Breaker
+
+// EnableBreaker 为当前连接启用熔断，需在 InitMysqlDB/InitSQLiteDB 之后调用
+func EnableBreaker(cfg config.Storage) error {
+	if cfg.BreakerThreshold <= 0 || dbBreaker != nil {
+		return nil
+	}
+	b := NewBreaker(cfg.BreakerThreshold, cfg.BreakerCooldown)
+	if err := MySQL().Use(&breakerPlugin{breaker: b}); err != nil {
+		return fmt.Errorf("register db breaker failed: %w", err)
+	}
+	dbBreaker = b
+	return nil
+}
+
+// BreakerOpen 数据库熔断器是否打开，未启用时返回 false
+func BreakerOpen() bool {
+	return dbBreaker != nil && dbBreaker.Open()
+}
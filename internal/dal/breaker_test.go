@@ -0,0 +1,97 @@
+package dal
+
+import (
+	sqldriver "database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	proxymysql "github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+func TestIsUnavailable(t *testing.T) {
+	assert.False(t, IsUnavailable(nil))
+	assert.False(t, IsUnavailable(errors.New("duplicate entry")))
+	assert.False(t, IsUnavailable(gorm.ErrRecordNotFound))
+	assert.False(t, IsUnavailable(&proxymysql.MySQLError{Number: 1062, Message: "Duplicate entry"}))
+
+	assert.True(t, IsUnavailable(ErrDBUnavailable))
+	assert.True(t, IsUnavailable(fmt.Errorf("upsert: %w", sqldriver.ErrBadConn)))
+	assert.True(t, IsUnavailable(proxymysql.ErrInvalidConn))
+	assert.True(t, IsUnavailable(&net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}))
+	assert.True(t, IsUnavailable(&proxymysql.MySQLError{Number: 1040, Message: "Too many connections"}))
+}
+
+func TestBreaker(t *testing.T) {
+	b := NewBreaker(2, time.Minute)
+	now := time.Now()
+
+	assert.True(t, b.Allow(now))
+	assert.False(t, b.Failure(now))
+	assert.True(t, b.Allow(now))
+	assert.True(t, b.Failure(now))
+	assert.True(t, b.Open())
+
+	// 冷却期内拒绝，冷却后只放行一个试探请求
+	assert.False(t, b.Allow(now.Add(30*time.Second)))
+	assert.True(t, b.Allow(now.Add(time.Minute)))
+	assert.False(t, b.Allow(now.Add(time.Minute)))
+
+	// 试探失败重新计时
+	assert.True(t, b.Failure(now.Add(time.Minute)))
+	assert.False(t, b.Allow(now.Add(90*time.Second)))
+	assert.True(t, b.Allow(now.Add(2*time.Minute)))
+
+	// 试探成功关闭熔断
+	b.Success()
+	assert.False(t, b.Open())
+	assert.True(t, b.Allow(now.Add(2*time.Minute)))
+
+	// threshold <= 0 时不熔断
+	disabled := NewBreaker(0, time.Minute)
+	assert.False(t, disabled.Failure(now))
+	assert.True(t, disabled.Allow(now))
+}
+
+func TestBreakerPlugin(t *testing.T) {
+	db := openTestSQLite(t)
+	require.NoError(t, db.AutoMigrate(&models.HlFailedWrite{}))
+
+	b := NewBreaker(1, time.Hour)
+	require.NoError(t, db.Use(&breakerPlugin{breaker: b}))
+
+	down := true
+	require.NoError(t, db.Callback().Create().After("gorm:create").Register("test:conn_error", func(tx *gorm.DB) {
+		if down {
+			_ = tx.AddError(sqldriver.ErrBadConn)
+		}
+	}))
+
+	// 连接类错误触发熔断
+	err := db.Create(&models.HlFailedWrite{TargetTable: "t", DedupKey: "k1"}).Error
+	assert.ErrorIs(t, err, sqldriver.ErrBadConn)
+	assert.True(t, b.Open())
+
+	// 熔断期间直接失败，不访问数据库
+	down = false
+	err = db.Create(&models.HlFailedWrite{TargetTable: "t", DedupKey: "k2"}).Error
+	assert.ErrorIs(t, err, ErrDBUnavailable)
+	var count int64
+	b.Success()
+	require.NoError(t, db.Model(&models.HlFailedWrite{}).Where("dedup_key = ?", "k2").Count(&count).Error)
+	assert.Zero(t, count)
+
+	// 业务错误不触发熔断
+	b = NewBreaker(1, time.Hour)
+	db2 := openTestSQLite(t)
+	require.NoError(t, db2.Use(&breakerPlugin{breaker: b}))
+	assert.Error(t, db2.Exec("SELECT * FROM missing_table").Error)
+	assert.False(t, b.Open())
+}
@@ -2,8 +2,11 @@ package dal
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -12,6 +15,7 @@ import (
 	"github.com/utrading/utrading-hl-monitor/pkg/logger"
 	"golang.org/x/net/proxy"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	gormlogger "gorm.io/gorm/logger"
 	"gorm.io/plugin/dbresolver"
@@ -30,17 +34,39 @@ func (l GormLogger) Print(args ...any) {
 	log.Print(args...)
 }
 
+// 存储驱动
+const (
+	DriverMySQL  = "mysql"
+	DriverSQLite = "sqlite" // 单机/开发环境
+)
+
 var (
 	mysqlDB     *gorm.DB
 	mysqlDBOnce sync.Once
+	driver      = DriverMySQL
 )
 
 func InitMysqlDB(cfg config.MySQL) {
 	mysqlDBOnce.Do(func() {
+		// 表名需在解析模型前设置
+		models.SetTableNaming(cfg.TablePrefix, cfg.TableNames)
 		mysqlDB = connectMySQL(cfg)
 	})
 }
 
+// InitSQLiteDB 初始化 SQLite 数据库（单机/开发环境），连接同样通过 MySQL() 获取
+func InitSQLiteDB(cfg config.Storage) {
+	mysqlDBOnce.Do(func() {
+		driver = DriverSQLite
+		mysqlDB = connectSQLite(cfg.SQLitePath)
+	})
+}
+
+// Driver 返回当前存储驱动
+func Driver() string {
+	return driver
+}
+
 // registerProxyDialer 注册 SOCKS5 代理拨号器
 func registerProxyDialer(proxyAddr string) error {
 	dialer, err := proxy.SOCKS5("tcp", proxyAddr, nil, &net.Dialer{})
@@ -64,18 +90,9 @@ func connectMySQL(cfg config.MySQL) *gorm.DB {
 		logger.Infof("mysql proxy enabled: %s", cfg.ProxyAddr)
 	}
 
-	newLogger := gormlogger.New(
-		GormLogger{}, gormlogger.Config{
-			SlowThreshold:             200 * time.Millisecond,
-			LogLevel:                  gormlogger.Warn,
-			Colorful:                  true,
-			IgnoreRecordNotFoundError: true,
-		},
-	)
-
 	// 主库连接
 	db, err := gorm.Open(mysql.Open(cfg.DSN), &gorm.Config{
-		Logger:      newLogger,
+		Logger:      newGormLogger(),
 		PrepareStmt: true,
 	})
 	if err != nil {
@@ -136,10 +153,60 @@ func connectMySQL(cfg config.MySQL) *gorm.DB {
 	return db
 }
 
+// connectSQLite 连接 SQLite，WAL 模式 + 单连接写入，避免 database is locked
+func connectSQLite(path string) *gorm.DB {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			panic(fmt.Sprintf("create sqlite dir failed: %v", err))
+		}
+	}
+
+	dsn := path + "?_journal_mode=WAL&_busy_timeout=5000&_synchronous=NORMAL"
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		Logger: newGormLogger(),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("open sqlite failed: %v", err))
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		panic(fmt.Sprintf("get sql.DB failed: %v", err))
+	}
+	sqlDB.SetMaxOpenConns(1)
+
+	logger.Info().Str("path", path).Msg("sqlite opened")
+
+	return db
+}
+
+func newGormLogger() gormlogger.Interface {
+	return gormlogger.New(
+		GormLogger{}, gormlogger.Config{
+			SlowThreshold:             200 * time.Millisecond,
+			LogLevel:                  gormlogger.Warn,
+			Colorful:                  true,
+			IgnoreRecordNotFoundError: true,
+		},
+	)
+}
+
 func MySQL() *gorm.DB {
 	return mysqlDB
 }
 
+// Ping 检查数据库连接（主库），未初始化时返回错误
+func Ping(ctx context.Context) error {
+	if mysqlDB == nil {
+		return errors.New("db not initialized")
+	}
+	sqlDB, err := mysqlDB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
 func CloseMySQL() {
 	if mysqlDB == nil {
 		return
@@ -153,40 +220,5 @@ func CloseMySQL() {
 		logger.Error().Err(err)
 	}
 
-	logger.Infof("mysqlDB closed.")
-}
-
-// AutoMigrate 自动迁移数据库表结构
-// 失败时记录警告日志，不中断服务启动
-func AutoMigrate() {
-	db := MySQL()
-	if db == nil {
-		log.Error().Msg("database not initialized, skip auto migration")
-		return
-	}
-
-	modelList := []interface{}{
-		&models.HlWatchAddress{},
-		&models.HlPositionCache{},
-		&models.OrderAggregation{},
-		&models.HlAddressSignal{},
-	}
-
-	for _, model := range modelList {
-		if err := db.AutoMigrate(model); err != nil {
-			log.Warn().Err(err).
-				Str("table", getTableName(model)).
-				Msg("auto migrate failed, continuing anyway")
-		} else {
-			log.Info().Str("table", getTableName(model)).Msg("auto migrate success")
-		}
-	}
-}
-
-// getTableName 获取模型的表名
-func getTableName(model interface{}) string {
-	if t, ok := model.(interface{ TableName() string }); ok {
-		return t.TableName()
-	}
-	return "unknown"
+	logger.Infof("%s db closed.", driver)
 }
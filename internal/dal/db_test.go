@@ -0,0 +1,280 @@
+package dal
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+)
+
+// TestSQLiteMode 在 SQLite 上跑通建表和各 DAO 的 upsert / 清理语句
+func TestSQLiteMode(t *testing.T) {
+	InitSQLiteDB(config.Storage{SQLitePath: filepath.Join(t.TempDir(), "data", "hl_monitor.db")})
+	defer CloseMySQL()
+	require.Equal(t, DriverSQLite, Driver())
+
+	// 未迁移时启动检查失败，自动迁移后通过
+	require.Error(t, EnsureSchema(false))
+	require.NoError(t, EnsureSchema(true))
+	dao.InitDAO(MySQL())
+	for _, model := range []any{
+		&models.HlPositionCache{}, &models.OrderAggregation{}, &models.HlAddressSignal{},
+		&models.HlAddressActivity{}, &models.HlLeaderLease{}, &models.HlOpenOrder{}, &models.HlFill{}, &models.HlFillWatermark{}, &models.HlAddressPnlDaily{}, &models.PairConfig{},
+		&models.HlAddressGroup{}, &models.HlAddressGroupMember{}, &models.HlOrderStatusMark{}, &models.HlFillDiscrepancy{},
+		&models.HlSignalDailyStats{}, &models.HlSymbolRename{},
+	} {
+		assert.True(t, MySQL().Migrator().HasTable(model), "%T", model)
+	}
+
+	// 仓位缓存按 address 冲突更新
+	require.NoError(t, dao.Position().BatchUpsertPositionCache([]*models.HlPositionCache{
+		{Address: "0xa", AccountValue: "1", UpdatedAt: time.Now()},
+	}))
+	require.NoError(t, dao.Position().BatchUpsertPositionCache([]*models.HlPositionCache{
+		{Address: "0xa", AccountValue: "2", UpdatedAt: time.Now()},
+	}))
+	cache, err := dao.Position().GetPositionCache("0xa")
+	require.NoError(t, err)
+	assert.Equal(t, "2", cache.AccountValue)
+
+	// 订单聚合按 oid+address+direction 冲突更新
+	agg := func(size float64) []*models.OrderAggregation {
+		return []*models.OrderAggregation{{Oid: 1, Address: "0xa", Direction: "Open Long", Symbol: "BTCUSDC", TotalSize: size}}
+	}
+	require.NoError(t, dao.OrderAggregation().BatchUpsert(agg(1)))
+	require.NoError(t, dao.OrderAggregation().BatchUpsert(agg(3)))
+	aggs, err := gen.OrderAggregation.Find()
+	require.NoError(t, err)
+	require.Len(t, aggs, 1)
+	assert.Equal(t, 3.0, aggs[0].TotalSize)
+
+	// 地址活跃度
+	now := time.Now()
+	require.NoError(t, dao.AddressActivity().BatchUpsertLastActive(map[string]time.Time{"0xa": now}))
+	require.NoError(t, dao.AddressActivity().MarkDormant("0xa", now, now))
+	require.NoError(t, dao.AddressActivity().MarkActive("0xa", now))
+	activities, err := dao.AddressActivity().ListAll()
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.False(t, activities[0].Dormant)
+
+	// 租约：插入冲突时忽略
+	ok, err := dao.LeaderLease().TryAcquire("hl", "a", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = dao.LeaderLease().TryAcquire("hl", "b", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// 挂单镜像事务替换
+	require.NoError(t, dao.OpenOrder().ReplaceAddress("0xa", []*models.HlOpenOrder{{Address: "0xa", Oid: 1, UpdatedAt: now}}))
+	require.NoError(t, dao.OpenOrder().ReplaceAddress("0xa", []*models.HlOpenOrder{{Address: "0xa", Oid: 2, UpdatedAt: now}}))
+	count, err := gen.HlOpenOrder.Count()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// 原始成交按 tid+address 冲突忽略
+	fill := func(tid int64) *models.HlFill {
+		return &models.HlFill{Tid: tid, Address: "0xa", Oid: 1, Coin: "BTC", FillTime: now.UnixMilli(), Raw: hl.WsOrderFill{Tid: tid}}
+	}
+	require.NoError(t, dao.Fill().BatchInsert([]*models.HlFill{fill(1), fill(2)}))
+	require.NoError(t, dao.Fill().BatchInsert([]*models.HlFill{fill(2)}))
+	fills, err := dao.Fill().ListByOrder("0xa", 1)
+	require.NoError(t, err)
+	require.Len(t, fills, 2)
+	assert.Equal(t, int64(2), fills[1].Raw.Tid)
+
+	// 成交高水位按 address 冲突更新
+	require.NoError(t, dao.FillWatermark().BatchUpsert(map[string]int64{"0xa": 1, "0xb": 2}))
+	require.NoError(t, dao.FillWatermark().BatchUpsert(map[string]int64{"0xa": 3}))
+	marks, err := dao.FillWatermark().ListAll()
+	require.NoError(t, err)
+	require.Len(t, marks, 2)
+	for _, mark := range marks {
+		if mark.Address == "0xa" {
+			assert.Equal(t, int64(3), mark.LastFillTime)
+		}
+	}
+
+	// 每日盈亏：已实现增量累加，未实现覆盖，互不影响
+	require.NoError(t, dao.AddressPnl().AddRealized([]*models.HlAddressPnlDaily{{Address: "0xa", StatDate: "2026-01-02", RealizedPnl: 10, ClosedOrders: 1}}))
+	require.NoError(t, dao.AddressPnl().BatchUpsertUnrealized([]*models.HlAddressPnlDaily{{Address: "0xa", StatDate: "2026-01-02", UnrealizedPnl: -3}}))
+	require.NoError(t, dao.AddressPnl().AddRealized([]*models.HlAddressPnlDaily{{Address: "0xa", StatDate: "2026-01-02", RealizedPnl: -4, ClosedOrders: 1}}))
+	require.NoError(t, dao.AddressPnl().BatchUpsertUnrealized([]*models.HlAddressPnlDaily{{Address: "0xa", StatDate: "2026-01-02", UnrealizedPnl: 5}}))
+	pnls, err := dao.AddressPnl().ListRange("2026-01-01", "2026-01-31", "0xa")
+	require.NoError(t, err)
+	require.Len(t, pnls, 1)
+	assert.Equal(t, 6.0, pnls[0].RealizedPnl)
+	assert.Equal(t, 5.0, pnls[0].UnrealizedPnl)
+	assert.Equal(t, 2, pnls[0].ClosedOrders)
+
+	// 信号发件箱：聚合与待发布信号同事务写入，直接写入的信号视为已发布
+	require.NoError(t, dao.Signal().Create(&nats.HlAddressSignal{Address: "0xa", Symbol: "BTCUSDC"}))
+	outboxAgg := &models.OrderAggregation{Oid: 2, Address: "0xa", Direction: "Open Long", Symbol: "BTCUSDC", SignalSent: true}
+	require.NoError(t, dao.Signal().EnqueueOutbox(outboxAgg, []*nats.HlAddressSignal{{Address: "0xa", Symbol: "BTCUSDC", TraceID: "t1"}}))
+	sent, err := dao.OrderAggregation().IsSignalSent("0xa", 2)
+	require.NoError(t, err)
+	assert.True(t, sent)
+	unpublished, err := dao.Signal().ListUnpublished(10)
+	require.NoError(t, err)
+	require.Len(t, unpublished, 1)
+	assert.Equal(t, "t1", unpublished[0].TraceID)
+	assert.Contains(t, unpublished[0].Payload, `"trace_id":"t1"`)
+	require.NoError(t, dao.Signal().IncPublishAttempts(unpublished[0].ID))
+	require.NoError(t, dao.Signal().MarkPublished(unpublished[0].ID, time.Now()))
+	pendingCount, err := dao.Signal().CountUnpublished()
+	require.NoError(t, err)
+	assert.Zero(t, pendingCount)
+
+	// 成交对账：按作用域和幂等键查询信号，重复对账同一日期覆盖差异
+	scopes, err := dao.Signal().DistinctScopes(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []string{""}, scopes)
+	require.NoError(t, dao.Signal().Create(&nats.HlAddressSignal{Address: "0xa", Symbol: "BTCUSDC", IdempotencyKey: "k1"}))
+	keyed, err := dao.Signal().ListByIdempotencyKeys([]string{"k1", "k2"})
+	require.NoError(t, err)
+	require.Len(t, keyed, 1)
+	require.NoError(t, dao.FillDiscrepancy().ReplaceDate("2026-01-02", []*models.HlFillDiscrepancy{
+		{AuditDate: "2026-01-02", Address: "0xa", Oid: 1, Direction: "Open Long", Kind: "aggregation"},
+		{AuditDate: "2026-01-02", Address: "0xa", Oid: 2, Direction: "Open Long", Kind: "aggregation"},
+	}))
+	require.NoError(t, dao.FillDiscrepancy().ReplaceDate("2026-01-02", []*models.HlFillDiscrepancy{
+		{AuditDate: "2026-01-02", Address: "0xa", Oid: 2, Direction: "Open Long", Kind: "signal", FillSize: 1},
+	}))
+	discrepancies, err := dao.FillDiscrepancy().ListByDate("2026-01-02")
+	require.NoError(t, err)
+	require.Len(t, discrepancies, 1)
+	assert.Equal(t, "signal", discrepancies[0].Kind)
+
+	// 信号每日统计：按原始信号重算，重复汇总同一日期覆盖
+	require.NoError(t, dao.Signal().BatchCreate([]*nats.HlAddressSignal{
+		{Address: "0xs", Symbol: "ETHUSDC", AssetType: "futures", Direction: "open", Price: 2000, Size: 1, PositionRate: 0.1},
+		{Address: "0xs", Symbol: "ETHUSDC", AssetType: "futures", Direction: "close", Price: 2100, Size: 2, PositionRate: 0.3},
+	}))
+	statDay := time.Now().UTC().Truncate(24 * time.Hour)
+	for range 2 {
+		n, err := dao.SignalStats().Rollup("2026-01-03", statDay, statDay.Add(24*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 2, n) // 含上文 0xa 的 BTCUSDC 信号
+	}
+	stats, err := dao.SignalStats().ListRange("2026-01-01", "2026-01-31", "0xs")
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, 2, stats[0].SignalCount)
+	assert.Equal(t, 1, stats[0].OpenCount)
+	assert.Equal(t, 1, stats[0].CloseCount)
+	assert.InDelta(t, 3, stats[0].Volume, 1e-9)
+	assert.InDelta(t, 6200, stats[0].Notional, 1e-6)
+	assert.InDelta(t, 0.2, stats[0].AvgPositionRate, 1e-9)
+
+	// symbol 改名：只改写对应资产类型，待发布消息体同时改写，同日统计合并
+	require.NoError(t, MySQL().Create(&models.HlAddressSignal{
+		Address: "0xs", Symbol: "ETHUSDC", AssetType: "futures", Payload: `{"symbol":"ETHUSDC","size":1}`,
+	}).Error)
+	require.NoError(t, dao.OrderAggregation().BatchUpsert([]*models.OrderAggregation{
+		{Oid: 10, Address: "0xs", Direction: "Open Long", Symbol: "ETHUSDC"},
+		{Oid: 11, Address: "0xs", Direction: "Buy", Symbol: "ETHUSDC"},
+	}))
+	require.NoError(t, MySQL().Create(&models.HlSignalDailyStats{
+		StatDate: "2026-01-03", Address: "0xs", Symbol: "ETH2USDC", AssetType: "futures", SignalCount: 2, AvgPositionRate: 0.4,
+	}).Error)
+	counts, err := dao.SymbolRename().CountRows("futures", "ETHUSDC")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), counts["hl_address_signals"])
+	assert.Equal(t, int64(1), counts["hl_order_aggregation"])
+	counts, err = dao.SymbolRename().Apply("futures", "ETHUSDC", "ETH2USDC")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), counts["hl_address_signals"])
+	assert.Equal(t, int64(1), counts["hl_order_aggregation"])
+	assert.Equal(t, int64(1), counts["hl_signal_daily_stats"])
+	var spotAgg models.OrderAggregation
+	require.NoError(t, MySQL().Where("oid = ?", 11).Take(&spotAgg).Error)
+	assert.Equal(t, "ETHUSDC", spotAgg.Symbol, "spot aggregation untouched")
+	var pending models.HlAddressSignal
+	require.NoError(t, MySQL().Where("address = ? AND payload <> ''", "0xs").Take(&pending).Error)
+	assert.Equal(t, `{"symbol":"ETH2USDC","size":1}`, pending.Payload)
+	stats, err = dao.SignalStats().ListRange("2026-01-01", "2026-01-31", "0xs")
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, "ETH2USDC", stats[0].Symbol)
+	assert.Equal(t, 4, stats[0].SignalCount)
+	assert.InDelta(t, 0.3, stats[0].AvgPositionRate, 1e-9)
+	renames, err := dao.SymbolRename().ListAll()
+	require.NoError(t, err)
+	require.Len(t, renames, 1)
+	assert.Equal(t, int64(5), renames[0].RewrittenRows)
+	assert.NotNil(t, renames[0].AppliedAt)
+
+	// 监控地址导入：按 (player_id, address) 覆盖，已软删除的记录恢复
+	require.NoError(t, dao.WatchAddress().BatchUpsert([]*models.HlWatchAddress{
+		{PlayerID: 1, Address: "0xb", Tags: "whale"}, {PlayerID: 1, Address: "0xa"},
+	}))
+	require.NoError(t, MySQL().Where("address = ?", "0xb").Delete(&models.HlWatchAddress{}).Error)
+	require.NoError(t, dao.WatchAddress().BatchUpsert([]*models.HlWatchAddress{
+		{PlayerID: 1, Address: "0xb", Tags: "whale,fund", Channels: "webhook", Comment: "migrated"},
+	}))
+	watched, err := dao.WatchAddress().ListAll()
+	require.NoError(t, err)
+	require.Len(t, watched, 2)
+	assert.Equal(t, "0xa", watched[0].Address)
+	assert.Equal(t, "whale,fund", watched[1].Tags)
+	assert.Equal(t, "migrated", watched[1].Comment)
+
+	// 地址分组：只返回已启用的分组，成员按地址归类
+	require.NoError(t, MySQL().Create([]*models.HlAddressGroup{{Name: "whales"}, {Name: "retired"}}).Error)
+	require.NoError(t, MySQL().Model(&models.HlAddressGroup{}).Where("name = ?", "retired").Update("enabled", false).Error)
+	require.NoError(t, MySQL().Create([]*models.HlAddressGroupMember{
+		{GroupName: "whales", Address: "0xa"}, {GroupName: "retired", Address: "0xa"}, {GroupName: "whales", Address: "0xb"},
+	}).Error)
+	groups, err := dao.AddressGroup().ListEnabled()
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Equal(t, "whales", groups[0].Name)
+	members, err := dao.AddressGroup().ListMembers()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"0xa": {"retired", "whales"}, "0xb": {"whales"}}, members)
+
+	// 订单状态标记：同一订单覆盖，只加载未过期的标记
+	nowMs := time.Now().UnixMilli()
+	require.NoError(t, dao.OrderStatusMark().BatchUpsert([]*models.HlOrderStatusMark{
+		{Address: "0xa", Oid: 1, Status: "canceled", ExpiresAt: nowMs + 1000},
+		{Address: "0xa", Oid: 2, Status: "filled", ExpiresAt: nowMs - 1000},
+		{Address: "0xb", Oid: 3, Status: "filled", ExpiresAt: nowMs + 1000},
+	}))
+	require.NoError(t, dao.OrderStatusMark().BatchUpsert([]*models.HlOrderStatusMark{{Address: "0xa", Oid: 1, Status: "filled", ExpiresAt: nowMs + 2000}}))
+	statusMarks, err := dao.OrderStatusMark().ListActive(nowMs)
+	require.NoError(t, err)
+	require.Len(t, statusMarks, 2)
+	require.NoError(t, dao.OrderStatusMark().DeleteOrders(map[string][]int64{"0xb": {3}}))
+	statusMarks, err = dao.OrderStatusMark().ListActive(nowMs)
+	require.NoError(t, err)
+	require.Len(t, statusMarks, 1)
+	assert.Equal(t, "filled", statusMarks[0].Status)
+	expired, err := dao.OrderStatusMark().DeleteExpired(nowMs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), expired)
+
+	// 清理器使用的删除语句
+	for i := 0; i < 3; i++ {
+		require.NoError(t, dao.Signal().Create(&nats.HlAddressSignal{Address: "0xa", Symbol: "BTCUSDC"}))
+	}
+	deleted, err := dao.Signal().DeleteOldest(2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+	_, err = dao.Signal().DeleteOld(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = dao.OrderAggregation().DeleteOld(time.Now().Add(time.Hour).Unix())
+	require.NoError(t, err)
+	deleted, err = dao.Fill().DeleteOld(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+}
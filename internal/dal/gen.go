@@ -26,6 +26,19 @@ func GenExecute(outPath string, db *gorm.DB) {
 		models.HlAddressSignal{},
 		models.HlActiveAddress{},
 		models.PairConfig{},
+		models.HlAddressActivity{},
+		models.HlLeaderLease{},
+		models.HlFailedWrite{},
+		models.HlOpenOrder{},
+		models.HlFill{},
+		models.HlFillWatermark{},
+		models.HlFillDiscrepancy{},
+		models.HlAddressPnlDaily{},
+		models.HlAddressGroup{},
+		models.HlAddressGroupMember{},
+		models.HlOrderStatusMark{},
+		models.HlSignalDailyStats{},
+		models.HlSymbolRename{},
 	)
 
 	g.Execute()
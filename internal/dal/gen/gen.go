@@ -16,20 +16,46 @@ import (
 )
 
 var (
-	Q                = new(Query)
-	HlActiveAddress  *hlActiveAddress
-	HlAddressSignal  *hlAddressSignal
-	HlPositionCache  *hlPositionCache
-	HlWatchAddress   *hlWatchAddress
-	OrderAggregation *orderAggregation
-	PairConfig       *pairConfig
+	Q                    = new(Query)
+	HlActiveAddress      *hlActiveAddress
+	HlAddressActivity    *hlAddressActivity
+	HlAddressGroup       *hlAddressGroup
+	HlAddressGroupMember *hlAddressGroupMember
+	HlAddressPnlDaily    *hlAddressPnlDaily
+	HlAddressSignal      *hlAddressSignal
+	HlFailedWrite        *hlFailedWrite
+	HlFill               *hlFill
+	HlFillDiscrepancy    *hlFillDiscrepancy
+	HlFillWatermark      *hlFillWatermark
+	HlLeaderLease        *hlLeaderLease
+	HlOpenOrder          *hlOpenOrder
+	HlOrderStatusMark    *hlOrderStatusMark
+	HlPositionCache      *hlPositionCache
+	HlSignalDailyStats   *hlSignalDailyStats
+	HlSymbolRename       *hlSymbolRename
+	HlWatchAddress       *hlWatchAddress
+	OrderAggregation     *orderAggregation
+	PairConfig           *pairConfig
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
 	HlActiveAddress = &Q.HlActiveAddress
+	HlAddressActivity = &Q.HlAddressActivity
+	HlAddressGroup = &Q.HlAddressGroup
+	HlAddressGroupMember = &Q.HlAddressGroupMember
+	HlAddressPnlDaily = &Q.HlAddressPnlDaily
 	HlAddressSignal = &Q.HlAddressSignal
+	HlFailedWrite = &Q.HlFailedWrite
+	HlFill = &Q.HlFill
+	HlFillDiscrepancy = &Q.HlFillDiscrepancy
+	HlFillWatermark = &Q.HlFillWatermark
+	HlLeaderLease = &Q.HlLeaderLease
+	HlOpenOrder = &Q.HlOpenOrder
+	HlOrderStatusMark = &Q.HlOrderStatusMark
 	HlPositionCache = &Q.HlPositionCache
+	HlSignalDailyStats = &Q.HlSignalDailyStats
+	HlSymbolRename = &Q.HlSymbolRename
 	HlWatchAddress = &Q.HlWatchAddress
 	OrderAggregation = &Q.OrderAggregation
 	PairConfig = &Q.PairConfig
@@ -37,38 +63,77 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:               db,
-		HlActiveAddress:  newHlActiveAddress(db, opts...),
-		HlAddressSignal:  newHlAddressSignal(db, opts...),
-		HlPositionCache:  newHlPositionCache(db, opts...),
-		HlWatchAddress:   newHlWatchAddress(db, opts...),
-		OrderAggregation: newOrderAggregation(db, opts...),
-		PairConfig:       newPairConfig(db, opts...),
+		db:                   db,
+		HlActiveAddress:      newHlActiveAddress(db, opts...),
+		HlAddressActivity:    newHlAddressActivity(db, opts...),
+		HlAddressGroup:       newHlAddressGroup(db, opts...),
+		HlAddressGroupMember: newHlAddressGroupMember(db, opts...),
+		HlAddressPnlDaily:    newHlAddressPnlDaily(db, opts...),
+		HlAddressSignal:      newHlAddressSignal(db, opts...),
+		HlFailedWrite:        newHlFailedWrite(db, opts...),
+		HlFill:               newHlFill(db, opts...),
+		HlFillDiscrepancy:    newHlFillDiscrepancy(db, opts...),
+		HlFillWatermark:      newHlFillWatermark(db, opts...),
+		HlLeaderLease:        newHlLeaderLease(db, opts...),
+		HlOpenOrder:          newHlOpenOrder(db, opts...),
+		HlOrderStatusMark:    newHlOrderStatusMark(db, opts...),
+		HlPositionCache:      newHlPositionCache(db, opts...),
+		HlSignalDailyStats:   newHlSignalDailyStats(db, opts...),
+		HlSymbolRename:       newHlSymbolRename(db, opts...),
+		HlWatchAddress:       newHlWatchAddress(db, opts...),
+		OrderAggregation:     newOrderAggregation(db, opts...),
+		PairConfig:           newPairConfig(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	HlActiveAddress  hlActiveAddress
-	HlAddressSignal  hlAddressSignal
-	HlPositionCache  hlPositionCache
-	HlWatchAddress   hlWatchAddress
-	OrderAggregation orderAggregation
-	PairConfig       pairConfig
+	HlActiveAddress      hlActiveAddress
+	HlAddressActivity    hlAddressActivity
+	HlAddressGroup       hlAddressGroup
+	HlAddressGroupMember hlAddressGroupMember
+	HlAddressPnlDaily    hlAddressPnlDaily
+	HlAddressSignal      hlAddressSignal
+	HlFailedWrite        hlFailedWrite
+	HlFill               hlFill
+	HlFillDiscrepancy    hlFillDiscrepancy
+	HlFillWatermark      hlFillWatermark
+	HlLeaderLease        hlLeaderLease
+	HlOpenOrder          hlOpenOrder
+	HlOrderStatusMark    hlOrderStatusMark
+	HlPositionCache      hlPositionCache
+	HlSignalDailyStats   hlSignalDailyStats
+	HlSymbolRename       hlSymbolRename
+	HlWatchAddress       hlWatchAddress
+	OrderAggregation     orderAggregation
+	PairConfig           pairConfig
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:               db,
-		HlActiveAddress:  q.HlActiveAddress.clone(db),
-		HlAddressSignal:  q.HlAddressSignal.clone(db),
-		HlPositionCache:  q.HlPositionCache.clone(db),
-		HlWatchAddress:   q.HlWatchAddress.clone(db),
-		OrderAggregation: q.OrderAggregation.clone(db),
-		PairConfig:       q.PairConfig.clone(db),
+		db:                   db,
+		HlActiveAddress:      q.HlActiveAddress.clone(db),
+		HlAddressActivity:    q.HlAddressActivity.clone(db),
+		HlAddressGroup:       q.HlAddressGroup.clone(db),
+		HlAddressGroupMember: q.HlAddressGroupMember.clone(db),
+		HlAddressPnlDaily:    q.HlAddressPnlDaily.clone(db),
+		HlAddressSignal:      q.HlAddressSignal.clone(db),
+		HlFailedWrite:        q.HlFailedWrite.clone(db),
+		HlFill:               q.HlFill.clone(db),
+		HlFillDiscrepancy:    q.HlFillDiscrepancy.clone(db),
+		HlFillWatermark:      q.HlFillWatermark.clone(db),
+		HlLeaderLease:        q.HlLeaderLease.clone(db),
+		HlOpenOrder:          q.HlOpenOrder.clone(db),
+		HlOrderStatusMark:    q.HlOrderStatusMark.clone(db),
+		HlPositionCache:      q.HlPositionCache.clone(db),
+		HlSignalDailyStats:   q.HlSignalDailyStats.clone(db),
+		HlSymbolRename:       q.HlSymbolRename.clone(db),
+		HlWatchAddress:       q.HlWatchAddress.clone(db),
+		OrderAggregation:     q.OrderAggregation.clone(db),
+		PairConfig:           q.PairConfig.clone(db),
 	}
 }
 
@@ -82,33 +147,72 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:               db,
-		HlActiveAddress:  q.HlActiveAddress.replaceDB(db),
-		HlAddressSignal:  q.HlAddressSignal.replaceDB(db),
-		HlPositionCache:  q.HlPositionCache.replaceDB(db),
-		HlWatchAddress:   q.HlWatchAddress.replaceDB(db),
-		OrderAggregation: q.OrderAggregation.replaceDB(db),
-		PairConfig:       q.PairConfig.replaceDB(db),
+		db:                   db,
+		HlActiveAddress:      q.HlActiveAddress.replaceDB(db),
+		HlAddressActivity:    q.HlAddressActivity.replaceDB(db),
+		HlAddressGroup:       q.HlAddressGroup.replaceDB(db),
+		HlAddressGroupMember: q.HlAddressGroupMember.replaceDB(db),
+		HlAddressPnlDaily:    q.HlAddressPnlDaily.replaceDB(db),
+		HlAddressSignal:      q.HlAddressSignal.replaceDB(db),
+		HlFailedWrite:        q.HlFailedWrite.replaceDB(db),
+		HlFill:               q.HlFill.replaceDB(db),
+		HlFillDiscrepancy:    q.HlFillDiscrepancy.replaceDB(db),
+		HlFillWatermark:      q.HlFillWatermark.replaceDB(db),
+		HlLeaderLease:        q.HlLeaderLease.replaceDB(db),
+		HlOpenOrder:          q.HlOpenOrder.replaceDB(db),
+		HlOrderStatusMark:    q.HlOrderStatusMark.replaceDB(db),
+		HlPositionCache:      q.HlPositionCache.replaceDB(db),
+		HlSignalDailyStats:   q.HlSignalDailyStats.replaceDB(db),
+		HlSymbolRename:       q.HlSymbolRename.replaceDB(db),
+		HlWatchAddress:       q.HlWatchAddress.replaceDB(db),
+		OrderAggregation:     q.OrderAggregation.replaceDB(db),
+		PairConfig:           q.PairConfig.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	HlActiveAddress  IHlActiveAddressDo
-	HlAddressSignal  IHlAddressSignalDo
-	HlPositionCache  IHlPositionCacheDo
-	HlWatchAddress   IHlWatchAddressDo
-	OrderAggregation IOrderAggregationDo
-	PairConfig       IPairConfigDo
+	HlActiveAddress      IHlActiveAddressDo
+	HlAddressActivity    IHlAddressActivityDo
+	HlAddressGroup       IHlAddressGroupDo
+	HlAddressGroupMember IHlAddressGroupMemberDo
+	HlAddressPnlDaily    IHlAddressPnlDailyDo
+	HlAddressSignal      IHlAddressSignalDo
+	HlFailedWrite        IHlFailedWriteDo
+	HlFill               IHlFillDo
+	HlFillDiscrepancy    IHlFillDiscrepancyDo
+	HlFillWatermark      IHlFillWatermarkDo
+	HlLeaderLease        IHlLeaderLeaseDo
+	HlOpenOrder          IHlOpenOrderDo
+	HlOrderStatusMark    IHlOrderStatusMarkDo
+	HlPositionCache      IHlPositionCacheDo
+	HlSignalDailyStats   IHlSignalDailyStatsDo
+	HlSymbolRename       IHlSymbolRenameDo
+	HlWatchAddress       IHlWatchAddressDo
+	OrderAggregation     IOrderAggregationDo
+	PairConfig           IPairConfigDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		HlActiveAddress:  q.HlActiveAddress.WithContext(ctx),
-		HlAddressSignal:  q.HlAddressSignal.WithContext(ctx),
-		HlPositionCache:  q.HlPositionCache.WithContext(ctx),
-		HlWatchAddress:   q.HlWatchAddress.WithContext(ctx),
-		OrderAggregation: q.OrderAggregation.WithContext(ctx),
-		PairConfig:       q.PairConfig.WithContext(ctx),
+		HlActiveAddress:      q.HlActiveAddress.WithContext(ctx),
+		HlAddressActivity:    q.HlAddressActivity.WithContext(ctx),
+		HlAddressGroup:       q.HlAddressGroup.WithContext(ctx),
+		HlAddressGroupMember: q.HlAddressGroupMember.WithContext(ctx),
+		HlAddressPnlDaily:    q.HlAddressPnlDaily.WithContext(ctx),
+		HlAddressSignal:      q.HlAddressSignal.WithContext(ctx),
+		HlFailedWrite:        q.HlFailedWrite.WithContext(ctx),
+		HlFill:               q.HlFill.WithContext(ctx),
+		HlFillDiscrepancy:    q.HlFillDiscrepancy.WithContext(ctx),
+		HlFillWatermark:      q.HlFillWatermark.WithContext(ctx),
+		HlLeaderLease:        q.HlLeaderLease.WithContext(ctx),
+		HlOpenOrder:          q.HlOpenOrder.WithContext(ctx),
+		HlOrderStatusMark:    q.HlOrderStatusMark.WithContext(ctx),
+		HlPositionCache:      q.HlPositionCache.WithContext(ctx),
+		HlSignalDailyStats:   q.HlSignalDailyStats.WithContext(ctx),
+		HlSymbolRename:       q.HlSymbolRename.WithContext(ctx),
+		HlWatchAddress:       q.HlWatchAddress.WithContext(ctx),
+		OrderAggregation:     q.OrderAggregation.WithContext(ctx),
+		PairConfig:           q.PairConfig.WithContext(ctx),
 	}
 }
 
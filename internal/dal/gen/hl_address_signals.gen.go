@@ -39,6 +39,13 @@ func newHlAddressSignal(db *gorm.DB, opts ...gen.DOOption) hlAddressSignal {
 	_hlAddressSignal.Side = field.NewString(tableName, "side")
 	_hlAddressSignal.Price = field.NewFloat64(tableName, "price")
 	_hlAddressSignal.Size = field.NewFloat64(tableName, "size")
+	_hlAddressSignal.Scope = field.NewString(tableName, "scope")
+	_hlAddressSignal.IdempotencyKey = field.NewString(tableName, "idempotency_key")
+	_hlAddressSignal.TraceID = field.NewString(tableName, "trace_id")
+	_hlAddressSignal.Payload = field.NewString(tableName, "payload")
+	_hlAddressSignal.PublishedAt = field.NewTime(tableName, "published_at")
+	_hlAddressSignal.PublishAttempts = field.NewInt(tableName, "publish_attempts")
+	_hlAddressSignal.DeadLetteredAt = field.NewTime(tableName, "dead_lettered_at")
 	_hlAddressSignal.CreatedAt = field.NewTime(tableName, "created_at")
 	_hlAddressSignal.ExpiredAt = field.NewTime(tableName, "expired_at")
 
@@ -50,20 +57,27 @@ func newHlAddressSignal(db *gorm.DB, opts ...gen.DOOption) hlAddressSignal {
 type hlAddressSignal struct {
 	hlAddressSignalDo
 
-	ALL          field.Asterisk
-	ID           field.Uint
-	Address      field.String  // 监控地址
-	PositionRate field.Float64 // 仓位比例: 百分比，如 0.155 表示 15.5%
-	CloseRate    field.Float64 // 平仓比例: 平仓数量/当前仓位
-	Symbol       field.String  // 交易对
-	CoinType     field.String
-	AssetType    field.String  // 资产类型: spot/futures
-	Direction    field.String  // 仓位方向 open/close
-	Side         field.String  // 方向: LONG/SHORT
-	Price        field.Float64 // 价格
-	Size         field.Float64 // 数量
-	CreatedAt    field.Time    // 创建时间
-	ExpiredAt    field.Time    // 过期时间(7天后)
+	ALL             field.Asterisk
+	ID              field.Uint
+	Address         field.String  // 监控地址
+	PositionRate    field.Float64 // 仓位比例: 百分比，如 0.155 表示 15.5%
+	CloseRate       field.Float64 // 平仓比例: 平仓数量/当前仓位
+	Symbol          field.String  // 交易对
+	CoinType        field.String
+	AssetType       field.String  // 资产类型: spot/futures
+	Direction       field.String  // 仓位方向 open/close
+	Side            field.String  // 方向: LONG/SHORT
+	Price           field.Float64 // 价格
+	Size            field.Float64 // 数量
+	Scope           field.String  // 去重作用域（逻辑消费者）
+	IdempotencyKey  field.String  // 幂等键
+	TraceID         field.String  // 追踪 ID
+	Payload         field.String  // NATS 消息体，仅发件箱模式写入
+	PublishedAt     field.Time    // 发布到 NATS 的时间，为空表示待发布
+	PublishAttempts field.Int     // 发布失败次数
+	DeadLetteredAt  field.Time    // 转入死信的时间，不再发布
+	CreatedAt       field.Time    // 创建时间
+	ExpiredAt       field.Time    // 过期时间(7天后)
 
 	fieldMap map[string]field.Expr
 }
@@ -91,6 +105,13 @@ func (h *hlAddressSignal) updateTableName(table string) *hlAddressSignal {
 	h.Side = field.NewString(table, "side")
 	h.Price = field.NewFloat64(table, "price")
 	h.Size = field.NewFloat64(table, "size")
+	h.Scope = field.NewString(table, "scope")
+	h.IdempotencyKey = field.NewString(table, "idempotency_key")
+	h.TraceID = field.NewString(table, "trace_id")
+	h.Payload = field.NewString(table, "payload")
+	h.PublishedAt = field.NewTime(table, "published_at")
+	h.PublishAttempts = field.NewInt(table, "publish_attempts")
+	h.DeadLetteredAt = field.NewTime(table, "dead_lettered_at")
 	h.CreatedAt = field.NewTime(table, "created_at")
 	h.ExpiredAt = field.NewTime(table, "expired_at")
 
@@ -109,7 +130,7 @@ func (h *hlAddressSignal) GetFieldByName(fieldName string) (field.OrderExpr, boo
 }
 
 func (h *hlAddressSignal) fillFieldMap() {
-	h.fieldMap = make(map[string]field.Expr, 13)
+	h.fieldMap = make(map[string]field.Expr, 20)
 	h.fieldMap["id"] = h.ID
 	h.fieldMap["address"] = h.Address
 	h.fieldMap["position_rate"] = h.PositionRate
@@ -121,6 +142,13 @@ func (h *hlAddressSignal) fillFieldMap() {
 	h.fieldMap["side"] = h.Side
 	h.fieldMap["price"] = h.Price
 	h.fieldMap["size"] = h.Size
+	h.fieldMap["scope"] = h.Scope
+	h.fieldMap["idempotency_key"] = h.IdempotencyKey
+	h.fieldMap["trace_id"] = h.TraceID
+	h.fieldMap["payload"] = h.Payload
+	h.fieldMap["published_at"] = h.PublishedAt
+	h.fieldMap["publish_attempts"] = h.PublishAttempts
+	h.fieldMap["dead_lettered_at"] = h.DeadLetteredAt
 	h.fieldMap["created_at"] = h.CreatedAt
 	h.fieldMap["expired_at"] = h.ExpiredAt
 }
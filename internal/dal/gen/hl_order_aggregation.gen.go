@@ -33,9 +33,12 @@ func newOrderAggregation(db *gorm.DB, opts ...gen.DOOption) orderAggregation {
 	_orderAggregation.Address = field.NewString(tableName, "address")
 	_orderAggregation.Direction = field.NewString(tableName, "direction")
 	_orderAggregation.Symbol = field.NewString(tableName, "symbol")
+	_orderAggregation.Cloid = field.NewString(tableName, "cloid")
 	_orderAggregation.Fills = field.NewField(tableName, "fills")
 	_orderAggregation.TotalSize = field.NewFloat64(tableName, "total_size")
 	_orderAggregation.WeightedAvgPx = field.NewFloat64(tableName, "weighted_avg_px")
+	_orderAggregation.MidPx = field.NewFloat64(tableName, "mid_px")
+	_orderAggregation.SlippageBps = field.NewFloat64(tableName, "slippage_bps")
 	_orderAggregation.OrderStatus = field.NewString(tableName, "order_status")
 	_orderAggregation.LastFillTime = field.NewInt64(tableName, "last_fill_time")
 	_orderAggregation.SignalSent = field.NewBool(tableName, "signal_sent")
@@ -56,9 +59,12 @@ type orderAggregation struct {
 	Address       field.String
 	Direction     field.String
 	Symbol        field.String
+	Cloid         field.String // 客户端订单 ID，按 cloid 聚合时非空
 	Fills         field.Field
 	TotalSize     field.Float64
 	WeightedAvgPx field.Float64
+	MidPx         field.Float64 // 首笔成交时的中间价/标记价
+	SlippageBps   field.Float64 // 成交均价相对中间价的滑点(bp)，正数表示劣于中间价
 	OrderStatus   field.String
 	LastFillTime  field.Int64
 	SignalSent    field.Bool
@@ -85,9 +91,12 @@ func (o *orderAggregation) updateTableName(table string) *orderAggregation {
 	o.Address = field.NewString(table, "address")
 	o.Direction = field.NewString(table, "direction")
 	o.Symbol = field.NewString(table, "symbol")
+	o.Cloid = field.NewString(table, "cloid")
 	o.Fills = field.NewField(table, "fills")
 	o.TotalSize = field.NewFloat64(table, "total_size")
 	o.WeightedAvgPx = field.NewFloat64(table, "weighted_avg_px")
+	o.MidPx = field.NewFloat64(table, "mid_px")
+	o.SlippageBps = field.NewFloat64(table, "slippage_bps")
 	o.OrderStatus = field.NewString(table, "order_status")
 	o.LastFillTime = field.NewInt64(table, "last_fill_time")
 	o.SignalSent = field.NewBool(table, "signal_sent")
@@ -109,15 +118,18 @@ func (o *orderAggregation) GetFieldByName(fieldName string) (field.OrderExpr, bo
 }
 
 func (o *orderAggregation) fillFieldMap() {
-	o.fieldMap = make(map[string]field.Expr, 13)
+	o.fieldMap = make(map[string]field.Expr, 16)
 	o.fieldMap["id"] = o.ID
 	o.fieldMap["oid"] = o.Oid
 	o.fieldMap["address"] = o.Address
 	o.fieldMap["direction"] = o.Direction
 	o.fieldMap["symbol"] = o.Symbol
+	o.fieldMap["cloid"] = o.Cloid
 	o.fieldMap["fills"] = o.Fills
 	o.fieldMap["total_size"] = o.TotalSize
 	o.fieldMap["weighted_avg_px"] = o.WeightedAvgPx
+	o.fieldMap["mid_px"] = o.MidPx
+	o.fieldMap["slippage_bps"] = o.SlippageBps
 	o.fieldMap["order_status"] = o.OrderStatus
 	o.fieldMap["last_fill_time"] = o.LastFillTime
 	o.fieldMap["signal_sent"] = o.SignalSent
@@ -37,6 +37,9 @@ func newHlPositionCache(db *gorm.DB, opts ...gen.DOOption) hlPositionCache {
 	_hlPositionCache.TotalMarginUsed = field.NewString(tableName, "total_margin_used")
 	_hlPositionCache.TotalNtlPos = field.NewString(tableName, "total_ntl_pos")
 	_hlPositionCache.Withdrawable = field.NewString(tableName, "withdrawable")
+	_hlPositionCache.QuoteCurrency = field.NewString(tableName, "quote_currency")
+	_hlPositionCache.SpotTotalQuote = field.NewString(tableName, "spot_total_quote")
+	_hlPositionCache.AccountValueQuote = field.NewString(tableName, "account_value_quote")
 	_hlPositionCache.UpdatedAt = field.NewTime(tableName, "updated_at")
 
 	_hlPositionCache.fillFieldMap()
@@ -47,17 +50,20 @@ func newHlPositionCache(db *gorm.DB, opts ...gen.DOOption) hlPositionCache {
 type hlPositionCache struct {
 	hlPositionCacheDo
 
-	ALL              field.Asterisk
-	ID               field.Uint
-	Address          field.String // 链上地址
-	SpotBalances     field.String // 现货余额JSON
-	SpotTotalUSD     field.String // 现货总价值USD
-	FuturesPositions field.String // 合约仓位JSON
-	AccountValue     field.String // 账户总价值
-	TotalMarginUsed  field.String // 总保证金使用
-	TotalNtlPos      field.String // 总净仓位
-	Withdrawable     field.String // 可提取金额
-	UpdatedAt        field.Time   // 更新时间
+	ALL               field.Asterisk
+	ID                field.Uint
+	Address           field.String // 链上地址
+	SpotBalances      field.String // 现货余额JSON
+	SpotTotalUSD      field.String // 现货总价值USD
+	FuturesPositions  field.String // 合约仓位JSON
+	AccountValue      field.String // 账户总价值
+	TotalMarginUsed   field.String // 总保证金使用
+	TotalNtlPos       field.String // 总净仓位
+	Withdrawable      field.String // 可提取金额
+	QuoteCurrency     field.String // 计价货币
+	SpotTotalQuote    field.String // 现货总价值(计价货币)
+	AccountValueQuote field.String // 账户总价值(计价货币)
+	UpdatedAt         field.Time   // 更新时间
 
 	fieldMap map[string]field.Expr
 }
@@ -83,6 +89,9 @@ func (h *hlPositionCache) updateTableName(table string) *hlPositionCache {
 	h.TotalMarginUsed = field.NewString(table, "total_margin_used")
 	h.TotalNtlPos = field.NewString(table, "total_ntl_pos")
 	h.Withdrawable = field.NewString(table, "withdrawable")
+	h.QuoteCurrency = field.NewString(table, "quote_currency")
+	h.SpotTotalQuote = field.NewString(table, "spot_total_quote")
+	h.AccountValueQuote = field.NewString(table, "account_value_quote")
 	h.UpdatedAt = field.NewTime(table, "updated_at")
 
 	h.fillFieldMap()
@@ -100,7 +109,7 @@ func (h *hlPositionCache) GetFieldByName(fieldName string) (field.OrderExpr, boo
 }
 
 func (h *hlPositionCache) fillFieldMap() {
-	h.fieldMap = make(map[string]field.Expr, 10)
+	h.fieldMap = make(map[string]field.Expr, 13)
 	h.fieldMap["id"] = h.ID
 	h.fieldMap["address"] = h.Address
 	h.fieldMap["spot_balances"] = h.SpotBalances
@@ -110,6 +119,9 @@ func (h *hlPositionCache) fillFieldMap() {
 	h.fieldMap["total_margin_used"] = h.TotalMarginUsed
 	h.fieldMap["total_ntl_pos"] = h.TotalNtlPos
 	h.fieldMap["withdrawable"] = h.Withdrawable
+	h.fieldMap["quote_currency"] = h.QuoteCurrency
+	h.fieldMap["spot_total_quote"] = h.SpotTotalQuote
+	h.fieldMap["account_value_quote"] = h.AccountValueQuote
 	h.fieldMap["updated_at"] = h.UpdatedAt
 }
 
@@ -33,6 +33,11 @@ func newHlWatchAddress(db *gorm.DB, opts ...gen.DOOption) hlWatchAddress {
 	_hlWatchAddress.Address = field.NewString(tableName, "address")
 	_hlWatchAddress.Nickname = field.NewString(tableName, "nickname")
 	_hlWatchAddress.IsSystem = field.NewBool(tableName, "is_system")
+	_hlWatchAddress.Tags = field.NewString(tableName, "tags")
+	_hlWatchAddress.Channels = field.NewString(tableName, "channels")
+	_hlWatchAddress.Comment = field.NewString(tableName, "comment")
+	_hlWatchAddress.Aggregation = field.NewString(tableName, "aggregation")
+	_hlWatchAddress.SignalFilter = field.NewString(tableName, "signal_filter")
 	_hlWatchAddress.CreatedAt = field.NewTime(tableName, "created_at")
 	_hlWatchAddress.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_hlWatchAddress.DeletedAt = field.NewField(tableName, "deleted_at")
@@ -45,15 +50,20 @@ func newHlWatchAddress(db *gorm.DB, opts ...gen.DOOption) hlWatchAddress {
 type hlWatchAddress struct {
 	hlWatchAddressDo
 
-	ALL       field.Asterisk
-	ID        field.Uint
-	PlayerID  field.Uint   // 玩家ID
-	Address   field.String // 链上地址
-	Nickname  field.String // 自定义昵称
-	IsSystem  field.Bool   // 是否系统地址池
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	DeletedAt field.Field
+	ALL          field.Asterisk
+	ID           field.Uint
+	PlayerID     field.Uint   // 玩家ID
+	Address      field.String // 链上地址
+	Nickname     field.String // 自定义昵称
+	IsSystem     field.Bool   // 是否系统地址池
+	Tags         field.String // 标签，逗号分隔
+	Channels     field.String // 信号推送渠道偏好，逗号分隔
+	Comment      field.String // 备注
+	Aggregation  field.String // 订单聚合策略：空为默认，scalper / swing
+	SignalFilter field.String // 信号过滤：逗号分隔的 open / close / futures / spot，空为全部发送
+	CreatedAt    field.Time
+	UpdatedAt    field.Time
+	DeletedAt    field.Field
 
 	fieldMap map[string]field.Expr
 }
@@ -75,6 +85,11 @@ func (h *hlWatchAddress) updateTableName(table string) *hlWatchAddress {
 	h.Address = field.NewString(table, "address")
 	h.Nickname = field.NewString(table, "nickname")
 	h.IsSystem = field.NewBool(table, "is_system")
+	h.Tags = field.NewString(table, "tags")
+	h.Channels = field.NewString(table, "channels")
+	h.Comment = field.NewString(table, "comment")
+	h.Aggregation = field.NewString(table, "aggregation")
+	h.SignalFilter = field.NewString(table, "signal_filter")
 	h.CreatedAt = field.NewTime(table, "created_at")
 	h.UpdatedAt = field.NewTime(table, "updated_at")
 	h.DeletedAt = field.NewField(table, "deleted_at")
@@ -94,12 +109,17 @@ func (h *hlWatchAddress) GetFieldByName(fieldName string) (field.OrderExpr, bool
 }
 
 func (h *hlWatchAddress) fillFieldMap() {
-	h.fieldMap = make(map[string]field.Expr, 8)
+	h.fieldMap = make(map[string]field.Expr, 13)
 	h.fieldMap["id"] = h.ID
 	h.fieldMap["player_id"] = h.PlayerID
 	h.fieldMap["address"] = h.Address
 	h.fieldMap["nickname"] = h.Nickname
 	h.fieldMap["is_system"] = h.IsSystem
+	h.fieldMap["tags"] = h.Tags
+	h.fieldMap["channels"] = h.Channels
+	h.fieldMap["comment"] = h.Comment
+	h.fieldMap["aggregation"] = h.Aggregation
+	h.fieldMap["signal_filter"] = h.SignalFilter
 	h.fieldMap["created_at"] = h.CreatedAt
 	h.fieldMap["updated_at"] = h.UpdatedAt
 	h.fieldMap["deleted_at"] = h.DeletedAt
@@ -0,0 +1,391 @@
+package dal
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"gorm.io/gorm"
+
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// 迁移脚本按驱动分目录存放，文件名格式与 golang-migrate 一致：{version}_{name}.up.sql / .down.sql
+// 脚本中的表名写作 {{table "hl_fills"}}，执行时按 [mysql] table_prefix / table_names 替换
+//
+//go:embed migrations
+var migrationFS embed.FS
+
+// schemaMigrationsTable 版本表默认名，单行记录当前版本和 dirty 标记
+const schemaMigrationsTable = "hl_schema_migrations"
+
+// migrateLockTimeout MySQL 迁移锁等待时间（秒），避免多实例同时迁移
+const migrateLockTimeout = 30
+
+var migrationFileRe = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// ErrDirtySchema 上次迁移中途失败，需人工修复后通过 migrate force 标记版本
+var ErrDirtySchema = errors.New("database schema is dirty")
+
+// Migration 单个版本的迁移脚本
+type Migration struct {
+	Version uint
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Migrator 版本化迁移执行器
+type Migrator struct {
+	db         *gorm.DB
+	driver     string
+	table      string
+	migrations []Migration // 按版本升序
+}
+
+// NewMigrator 加载当前驱动的内嵌迁移脚本
+func NewMigrator(db *gorm.DB, driver string) (*Migrator, error) {
+	if db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	migrations, err := loadMigrations(migrationFS, path.Join("migrations", driver))
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{
+		db:         db,
+		driver:     driver,
+		table:      models.ResolveTableName(schemaMigrationsTable),
+		migrations: migrations,
+	}, nil
+}
+
+// loadMigrations 读取目录下的迁移脚本，版本号须唯一且每个版本都有 up 脚本
+func loadMigrations(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("read migrations %s failed: %w", dir, err)
+	}
+
+	byVersion := make(map[uint]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := migrationFileRe.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", entry.Name())
+		}
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("invalid migration version: %s", entry.Name())
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s failed: %w", entry.Name(), err)
+		}
+		sql, err := renderMigration(entry.Name(), string(content))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[uint(version)]
+		if !ok {
+			m = &Migration{Version: uint(version), Name: match[2]}
+			byVersion[uint(version)] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("duplicate migration version %d: %s, %s", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = sql
+		} else {
+			m.Down = sql
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up script", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// renderMigration 替换脚本中的表名占位符
+func renderMigration(name, content string) (string, error) {
+	tmpl, err := template.New(name).
+		Funcs(template.FuncMap{"table": models.ResolveTableName}).
+		Parse(content)
+	if err != nil {
+		return "", fmt.Errorf("parse migration %s failed: %w", name, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, nil); err != nil {
+		return "", fmt.Errorf("render migration %s failed: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+// splitStatements 按行尾分号拆分语句并去掉注释行，MySQL 默认不允许一次执行多条语句
+func splitStatements(sql string) []string {
+	var stmts []string
+	var cur strings.Builder
+	for _, line := range strings.Split(sql, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		cur.WriteString(line)
+		cur.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			if stmt := strings.TrimSuffix(strings.TrimSpace(cur.String()), ";"); stmt != "" {
+				stmts = append(stmts, stmt)
+			}
+			cur.Reset()
+		}
+	}
+	if stmt := strings.TrimSpace(cur.String()); stmt != "" {
+		stmts = append(stmts, stmt)
+	}
+	return stmts
+}
+
+// Migrations 返回已加载的迁移脚本
+func (m *Migrator) Migrations() []Migration {
+	return m.migrations
+}
+
+// Latest 返回内嵌脚本的最新版本，无脚本时为 0
+func (m *Migrator) Latest() uint {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version 返回数据库当前版本，未执行过迁移时为 0
+func (m *Migrator) Version() (version uint, dirty bool, err error) {
+	err = m.withLock(func(tx *gorm.DB) error {
+		version, dirty, err = m.readVersion(tx)
+		return err
+	})
+	return version, dirty, err
+}
+
+// Up 依次执行所有待执行的迁移，返回执行的版本数
+func (m *Migrator) Up() (int, error) {
+	applied := 0
+	err := m.withLock(func(tx *gorm.DB) error {
+		current, err := m.checkedVersion(tx)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if migration.Version <= current {
+				continue
+			}
+			if err := m.apply(tx, migration.Version, migration.Up, migration.Version); err != nil {
+				return fmt.Errorf("migrate up %d_%s failed: %w", migration.Version, migration.Name, err)
+			}
+			logger.Info().Uint("version", migration.Version).Str("name", migration.Name).Msg("migration applied")
+			applied++
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down 回滚最近的 steps 个版本，返回回滚的版本数
+func (m *Migrator) Down(steps int) (int, error) {
+	if steps <= 0 {
+		return 0, fmt.Errorf("steps must be positive")
+	}
+	reverted := 0
+	err := m.withLock(func(tx *gorm.DB) error {
+		current, err := m.checkedVersion(tx)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && reverted < steps; i-- {
+			migration := m.migrations[i]
+			if migration.Version > current {
+				continue
+			}
+			if migration.Down == "" {
+				return fmt.Errorf("migration %d_%s has no down script", migration.Version, migration.Name)
+			}
+			var prev uint
+			if i > 0 {
+				prev = m.migrations[i-1].Version
+			}
+			if err := m.apply(tx, prev, migration.Down, migration.Version); err != nil {
+				return fmt.Errorf("migrate down %d_%s failed: %w", migration.Version, migration.Name, err)
+			}
+			logger.Info().Uint("version", migration.Version).Str("name", migration.Name).Msg("migration reverted")
+			reverted++
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Force 将版本标记为 version 并清除 dirty，不执行任何脚本；用于人工修复失败的迁移后
+func (m *Migrator) Force(version uint) error {
+	if version != 0 && m.find(version) == nil {
+		return fmt.Errorf("unknown migration version %d", version)
+	}
+	return m.withLock(func(tx *gorm.DB) error {
+		if err := m.ensureVersionTable(tx); err != nil {
+			return err
+		}
+		return m.writeVersion(tx, version, false)
+	})
+}
+
+// Check 启动检查：版本落后或 dirty 时返回错误；数据库版本高于当前程序（回滚部署）只告警
+func (m *Migrator) Check() error {
+	version, dirty, err := m.Version()
+	if err != nil {
+		return err
+	}
+	if dirty {
+		return fmt.Errorf("%w at version %d, fix it manually then run `hl_monitor migrate force <version>`", ErrDirtySchema, version)
+	}
+	latest := m.Latest()
+	if version < latest {
+		return fmt.Errorf("database schema version %d is behind %d, run `hl_monitor migrate up`", version, latest)
+	}
+	if version > latest {
+		logger.Warn().Uint("version", version).Uint("latest", latest).
+			Msg("database schema is newer than this binary, continuing anyway")
+	}
+	return nil
+}
+
+// apply 执行一个版本的脚本：先以目标版本标记 dirty，成功后清除
+func (m *Migrator) apply(tx *gorm.DB, target uint, sql string, version uint) error {
+	if err := m.writeVersion(tx, target, true); err != nil {
+		return err
+	}
+	for _, stmt := range splitStatements(sql) {
+		if err := tx.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("version %d: %w", version, err)
+		}
+	}
+	return m.writeVersion(tx, target, false)
+}
+
+// find 按版本号查找迁移脚本
+func (m *Migrator) find(version uint) *Migration {
+	for i := range m.migrations {
+		if m.migrations[i].Version == version {
+			return &m.migrations[i]
+		}
+	}
+	return nil
+}
+
+// checkedVersion 读取当前版本，dirty 时拒绝继续迁移
+func (m *Migrator) checkedVersion(tx *gorm.DB) (uint, error) {
+	if err := m.ensureVersionTable(tx); err != nil {
+		return 0, err
+	}
+	version, dirty, err := m.readVersion(tx)
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("%w at version %d, fix it manually then run `hl_monitor migrate force <version>`", ErrDirtySchema, version)
+	}
+	return version, nil
+}
+
+func (m *Migrator) ensureVersionTable(tx *gorm.DB) error {
+	sql := fmt.Sprintf("CREATE TABLE IF NOT EXISTS `%s` (`version` bigint NOT NULL PRIMARY KEY, `dirty` boolean NOT NULL)", m.table)
+	if err := tx.Exec(sql).Error; err != nil {
+		return fmt.Errorf("create %s failed: %w", m.table, err)
+	}
+	return nil
+}
+
+// readVersion 读取版本行，版本表不存在或为空时版本为 0
+func (m *Migrator) readVersion(tx *gorm.DB) (uint, bool, error) {
+	if !tx.Migrator().HasTable(m.table) {
+		return 0, false, nil
+	}
+	var rows []struct {
+		Version uint
+		Dirty   bool
+	}
+	if err := tx.Raw(fmt.Sprintf("SELECT `version`, `dirty` FROM `%s` LIMIT 1", m.table)).Scan(&rows).Error; err != nil {
+		return 0, false, fmt.Errorf("read %s failed: %w", m.table, err)
+	}
+	if len(rows) == 0 {
+		return 0, false, nil
+	}
+	return rows[0].Version, rows[0].Dirty, nil
+}
+
+// writeVersion 覆盖版本行，版本 0 且非 dirty 时清空表示未迁移
+func (m *Migrator) writeVersion(tx *gorm.DB, version uint, dirty bool) error {
+	return tx.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(fmt.Sprintf("DELETE FROM `%s`", m.table)).Error; err != nil {
+			return fmt.Errorf("write %s failed: %w", m.table, err)
+		}
+		if version == 0 && !dirty {
+			return nil
+		}
+		if err := tx.Exec(fmt.Sprintf("INSERT INTO `%s` (`version`, `dirty`) VALUES (?, ?)", m.table), version, dirty).Error; err != nil {
+			return fmt.Errorf("write %s failed: %w", m.table, err)
+		}
+		return nil
+	})
+}
+
+// withLock 在同一连接上执行 fn；MySQL 通过 GET_LOCK 串行化多实例的迁移
+func (m *Migrator) withLock(fn func(tx *gorm.DB) error) error {
+	if m.driver != DriverMySQL {
+		return fn(m.db)
+	}
+
+	return m.db.Connection(func(tx *gorm.DB) error {
+		lockName := "hl_monitor_migrate:" + m.table
+		var locked int
+		if err := tx.Raw("SELECT GET_LOCK(?, ?)", lockName, migrateLockTimeout).Scan(&locked).Error; err != nil {
+			return fmt.Errorf("acquire migrate lock failed: %w", err)
+		}
+		if locked != 1 {
+			return fmt.Errorf("acquire migrate lock timeout after %ds", migrateLockTimeout)
+		}
+		defer tx.Exec("SELECT RELEASE_LOCK(?)", lockName)
+
+		return fn(tx)
+	})
+}
+
+// EnsureSchema 启动时检查数据库版本，auto 为 true 时先执行待执行的迁移
+func EnsureSchema(auto bool) error {
+	m, err := NewMigrator(MySQL(), driver)
+	if err != nil {
+		return err
+	}
+	if auto {
+		if _, err := m.Up(); err != nil {
+			return err
+		}
+	}
+	return m.Check()
+}
@@ -0,0 +1,162 @@
+package dal
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+// schemaModels 迁移脚本需覆盖的模型
+var schemaModels = []any{
+	&models.HlWatchAddress{}, &models.HlPositionCache{}, &models.OrderAggregation{}, &models.HlAddressSignal{},
+	&models.HlAddressActivity{}, &models.HlLeaderLease{}, &models.HlFailedWrite{}, &models.HlOpenOrder{},
+	&models.HlFill{}, &models.HlFillWatermark{}, &models.HlAddressPnlDaily{}, &models.PairConfig{},
+	&models.HlAddressGroup{}, &models.HlAddressGroupMember{}, &models.HlOrderStatusMark{}, &models.HlFillDiscrepancy{},
+	&models.HlSignalDailyStats{}, &models.HlSymbolRename{},
+}
+
+func openTestSQLite(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "migrate.db")), &gorm.Config{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+	return db
+}
+
+func TestLoadMigrations(t *testing.T) {
+	var versions [][]uint
+	for _, driver := range []string{DriverMySQL, DriverSQLite} {
+		m, err := NewMigrator(openTestSQLite(t), driver)
+		require.NoError(t, err, driver)
+		require.NotEmpty(t, m.Migrations(), driver)
+
+		var vs []uint
+		for _, migration := range m.Migrations() {
+			assert.NotEmpty(t, migration.Down, "%s %d_%s", driver, migration.Version, migration.Name)
+			assert.NotContains(t, migration.Up, "{{", "placeholders rendered")
+			vs = append(vs, migration.Version)
+		}
+		versions = append(versions, vs)
+	}
+	assert.Equal(t, versions[0], versions[1], "mysql and sqlite migrations share versions")
+}
+
+func TestSplitStatements(t *testing.T) {
+	stmts := splitStatements(`-- comment
+CREATE TABLE a (
+    id int, -- inline
+    name varchar(8) DEFAULT ';x'
+);
+
+CREATE INDEX idx ON a(id);
+UPDATE a SET name = 'b'`)
+	require.Len(t, stmts, 3)
+	assert.Contains(t, stmts[0], "DEFAULT ';x'")
+	assert.Equal(t, "CREATE INDEX idx ON a(id)", stmts[1])
+	assert.Equal(t, "UPDATE a SET name = 'b'", stmts[2])
+}
+
+func TestMigrator_UpDown(t *testing.T) {
+	db := openTestSQLite(t)
+	m, err := NewMigrator(db, DriverSQLite)
+	require.NoError(t, err)
+
+	version, dirty, err := m.Version()
+	require.NoError(t, err)
+	assert.Equal(t, uint(0), version)
+	assert.False(t, dirty)
+	assert.Error(t, m.Check())
+
+	applied, err := m.Up()
+	require.NoError(t, err)
+	assert.Equal(t, len(m.Migrations()), applied)
+	require.NoError(t, m.Check())
+
+	// 脚本建出的表结构需与模型一致
+	for _, model := range schemaModels {
+		stmt := &gorm.Statement{DB: db}
+		require.NoError(t, stmt.Parse(model))
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName != "" {
+				assert.True(t, db.Migrator().HasColumn(model, field.DBName), "%s.%s", stmt.Table, field.DBName)
+			}
+		}
+		for _, idx := range stmt.Schema.ParseIndexes() {
+			assert.True(t, db.Migrator().HasIndex(model, idx.Name), "%s.%s", stmt.Table, idx.Name)
+		}
+	}
+
+	// 重复执行无副作用
+	applied, err = m.Up()
+	require.NoError(t, err)
+	assert.Zero(t, applied)
+
+	reverted, err := m.Down(len(m.Migrations()) + 1)
+	require.NoError(t, err)
+	assert.Equal(t, len(m.Migrations()), reverted)
+	assert.False(t, db.Migrator().HasTable(&models.HlFill{}))
+	version, _, err = m.Version()
+	require.NoError(t, err)
+	assert.Equal(t, uint(0), version)
+}
+
+func TestMigrator_DirtyAndForce(t *testing.T) {
+	db := openTestSQLite(t)
+	m, err := NewMigrator(db, DriverSQLite)
+	require.NoError(t, err)
+
+	require.NoError(t, m.ensureVersionTable(db))
+	require.NoError(t, m.writeVersion(db, 1, true))
+
+	_, err = m.Up()
+	assert.ErrorIs(t, err, ErrDirtySchema)
+	assert.ErrorIs(t, m.Check(), ErrDirtySchema)
+
+	assert.Error(t, m.Force(9999), "unknown version")
+	require.NoError(t, m.Force(0))
+	version, dirty, err := m.Version()
+	require.NoError(t, err)
+	assert.Equal(t, uint(0), version)
+	assert.False(t, dirty)
+
+	_, err = m.Up()
+	require.NoError(t, err)
+}
+
+// TestMigrator_ExistingAutoMigrateSchema 引入迁移前由 AutoMigrate 建好的库可直接升级到基线版本
+func TestMigrator_ExistingAutoMigrateSchema(t *testing.T) {
+	db := openTestSQLite(t)
+	require.NoError(t, db.AutoMigrate(schemaModels...))
+
+	m, err := NewMigrator(db, DriverSQLite)
+	require.NoError(t, err)
+	// 当前模型已包含后续版本新增的列，只验证基线版本
+	m.migrations = m.migrations[:1]
+	_, err = m.Up()
+	require.NoError(t, err)
+	require.NoError(t, m.Check())
+}
+
+func TestMigrator_TableNaming(t *testing.T) {
+	models.SetTableNaming("t_", map[string]string{"hl_fills": "fills"})
+	defer models.SetTableNaming("", nil)
+
+	db := openTestSQLite(t)
+	m, err := NewMigrator(db, DriverSQLite)
+	require.NoError(t, err)
+	_, err = m.Up()
+	require.NoError(t, err)
+
+	assert.True(t, db.Migrator().HasTable("t_hl_schema_migrations"))
+	assert.True(t, db.Migrator().HasTable("t_hl_address_signals"))
+	assert.True(t, db.Migrator().HasTable("fills"))
+	assert.False(t, db.Migrator().HasTable("hl_fills"))
+}
@@ -0,0 +1,164 @@
+-- 初始表结构，与引入版本化迁移前 AutoMigrate 创建的表一致；已有部署执行时跳过已存在的表
+-- pair_configs 由外部系统维护，不在此创建
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_watch_addresses"}}` (
+    `id` bigint unsigned AUTO_INCREMENT,
+    `player_id` bigint unsigned NOT NULL COMMENT '玩家ID',
+    `address` varchar(42) NOT NULL COMMENT '链上地址',
+    `nickname` varchar(64) DEFAULT '' COMMENT '自定义昵称',
+    `is_system` tinyint(1) NOT NULL DEFAULT false COMMENT '是否系统地址池',
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    `deleted_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uidx_player_addr` (`player_id`,`address`),
+    INDEX `idx_player` (`player_id`),
+    INDEX `idx_hl_watch_addresses_deleted_at` (`deleted_at`)
+);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_position_cache"}}` (
+    `id` bigint unsigned AUTO_INCREMENT,
+    `address` varchar(42) NOT NULL COMMENT '链上地址',
+    `spot_balances` json COMMENT '现货余额JSON',
+    `spot_total_usd` varchar(32) NOT NULL DEFAULT '0' COMMENT '现货总价值USD',
+    `futures_positions` json COMMENT '合约仓位JSON',
+    `account_value` varchar(32) NOT NULL DEFAULT '0' COMMENT '账户总价值',
+    `total_margin_used` varchar(32) NOT NULL DEFAULT '0' COMMENT '总保证金使用',
+    `total_ntl_pos` varchar(32) NOT NULL DEFAULT '0' COMMENT '总净仓位',
+    `withdrawable` varchar(32) NOT NULL DEFAULT '0' COMMENT '可提取金额',
+    `quote_currency` varchar(16) NOT NULL DEFAULT 'USD' COMMENT '计价货币',
+    `spot_total_quote` varchar(32) NOT NULL DEFAULT '' COMMENT '现货总价值(计价货币)',
+    `account_value_quote` varchar(32) NOT NULL DEFAULT '' COMMENT '账户总价值(计价货币)',
+    `updated_at` datetime(3) NOT NULL COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uidx_address` (`address`),
+    INDEX `idx_updated` (`updated_at`)
+);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_order_aggregation"}}` (
+    `id` bigint AUTO_INCREMENT,
+    `oid` bigint NOT NULL,
+    `address` varchar(42) NOT NULL,
+    `direction` varchar(16) NOT NULL,
+    `symbol` varchar(24) NOT NULL,
+    `fills` json NOT NULL,
+    `total_size` double NOT NULL DEFAULT 0,
+    `weighted_avg_px` double NOT NULL DEFAULT 0,
+    `mid_px` double NOT NULL DEFAULT 0 COMMENT '首笔成交时的中间价/标记价',
+    `slippage_bps` double NOT NULL DEFAULT 0 COMMENT '成交均价相对中间价的滑点(bp)，正数表示劣于中间价',
+    `order_status` varchar(64) NOT NULL DEFAULT 'open',
+    `last_fill_time` bigint NOT NULL,
+    `signal_sent` boolean NOT NULL DEFAULT false,
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uidx_addr_oid_dir` (`oid`,`address`,`direction`),
+    INDEX `idx_hl_order_aggregation_last_fill_time` (`last_fill_time`),
+    INDEX `idx_signal_sent` (`signal_sent`)
+);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_address_signals"}}` (
+    `id` bigint unsigned AUTO_INCREMENT,
+    `address` varchar(42) NOT NULL COMMENT '监控地址',
+    `position_rate` decimal(18,3) NOT NULL COMMENT '仓位比例: 百分比，如 0.155 表示 15.5%',
+    `close_rate` decimal(18,3) NOT NULL DEFAULT 0 COMMENT '平仓比例: 平仓数量/当前仓位',
+    `symbol` varchar(24) NOT NULL COMMENT '交易对',
+    `coin_type` varchar(8) NOT NULL,
+    `asset_type` varchar(24) NOT NULL COMMENT '资产类型: spot/futures',
+    `direction` varchar(8) NOT NULL COMMENT '仓位方向 open/close',
+    `side` varchar(8) NOT NULL COMMENT '方向: LONG/SHORT',
+    `price` decimal(28,12) NOT NULL COMMENT '价格',
+    `size` decimal(18,8) NOT NULL COMMENT '数量',
+    `scope` varchar(32) NOT NULL DEFAULT '' COMMENT '去重作用域（逻辑消费者）',
+    `idempotency_key` varchar(32) NOT NULL DEFAULT '' COMMENT '幂等键',
+    `trace_id` varchar(36) NOT NULL DEFAULT '' COMMENT '追踪 ID',
+    `created_at` datetime(3) NULL COMMENT '创建时间',
+    `expired_at` datetime(3) NOT NULL COMMENT '过期时间(7天后)',
+    PRIMARY KEY (`id`),
+    INDEX `idx_address` (`address`),
+    INDEX `idx_hl_address_signals_symbol` (`symbol`),
+    INDEX `idx_hl_address_signals_asset_type` (`asset_type`),
+    INDEX `idx_hl_address_signals_idempotency_key` (`idempotency_key`),
+    INDEX `idx_hl_address_signals_trace_id` (`trace_id`),
+    INDEX `idx_created` (`created_at`),
+    INDEX `idx_hl_address_signals_expired_at` (`expired_at`)
+);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_address_activity"}}` (
+    `id` bigint AUTO_INCREMENT,
+    `address` varchar(64) NOT NULL COMMENT '链上地址',
+    `last_active_at` datetime(3) NOT NULL COMMENT '最近活跃时间（成交或仓位变化）',
+    `dormant` boolean NOT NULL DEFAULT false COMMENT '是否休眠',
+    `dormant_since` datetime(3) NULL COMMENT '进入休眠时间',
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uidx_activity_address` (`address`),
+    INDEX `idx_dormant` (`dormant`)
+);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_leader_lease"}}` (
+    `id` bigint AUTO_INCREMENT,
+    `name` varchar(64) NOT NULL COMMENT '租约名称',
+    `holder` varchar(128) NOT NULL COMMENT '持有者实例 ID',
+    `expires_at` datetime(3) NOT NULL COMMENT '租约过期时间',
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uidx_name` (`name`)
+);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_failed_writes"}}` (
+    `id` bigint AUTO_INCREMENT,
+    `target_table` varchar(64) NOT NULL COMMENT '目标表',
+    `dedup_key` varchar(255) NOT NULL COMMENT '写入项去重键',
+    `payload` mediumtext COMMENT '写入项 JSON',
+    `error` text COMMENT '最后一次写入错误',
+    `attempts` bigint NOT NULL DEFAULT 1 COMMENT '写入尝试次数',
+    `created_at` datetime(3) NOT NULL COMMENT '隔离时间',
+    PRIMARY KEY (`id`),
+    INDEX `idx_target_table` (`target_table`),
+    INDEX `idx_created_at` (`created_at`)
+);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_open_orders"}}` (
+    `id` bigint AUTO_INCREMENT,
+    `address` varchar(42) NOT NULL COMMENT '链上地址',
+    `oid` bigint NOT NULL COMMENT '订单 ID',
+    `cloid` varchar(66) NOT NULL DEFAULT '' COMMENT '客户端订单 ID',
+    `coin` varchar(64) NOT NULL COMMENT '原始资产名',
+    `symbol` varchar(64) NOT NULL DEFAULT '' COMMENT '交易对',
+    `side` varchar(4) NOT NULL COMMENT '方向 B/A',
+    `limit_px` varchar(32) NOT NULL COMMENT '限价',
+    `sz` varchar(32) NOT NULL COMMENT '剩余数量',
+    `orig_sz` varchar(32) NOT NULL DEFAULT '' COMMENT '原始数量',
+    `placed_at` bigint NOT NULL COMMENT '下单时间（毫秒）',
+    `updated_at` datetime(3) NOT NULL COMMENT '镜像更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uidx_address_oid` (`address`,`oid`)
+);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_fills"}}` (
+    `id` bigint AUTO_INCREMENT,
+    `tid` bigint NOT NULL COMMENT '成交 ID',
+    `address` varchar(42) NOT NULL COMMENT '链上地址',
+    `oid` bigint NOT NULL COMMENT '订单 ID',
+    `coin` varchar(64) NOT NULL COMMENT '原始资产名',
+    `dir` varchar(32) NOT NULL DEFAULT '' COMMENT '成交方向',
+    `fill_time` bigint NOT NULL COMMENT '成交时间（毫秒）',
+    `raw` json NOT NULL COMMENT '原始成交数据',
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uidx_fill_tid_address` (`tid`,`address`),
+    INDEX `idx_fill_address_time` (`address`,`fill_time`),
+    INDEX `idx_fill_oid` (`oid`),
+    INDEX `idx_fill_time` (`fill_time`)
+);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_fill_watermarks"}}` (
+    `id` bigint AUTO_INCREMENT,
+    `address` varchar(42) NOT NULL COMMENT '链上地址',
+    `last_fill_time` bigint NOT NULL COMMENT '已处理的最新成交时间（毫秒）',
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uidx_fill_watermark_address` (`address`)
+);
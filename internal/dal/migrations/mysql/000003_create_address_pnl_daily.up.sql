@@ -0,0 +1,13 @@
+-- 地址每日盈亏（[pnl] enabled = true 时维护）
+CREATE TABLE IF NOT EXISTS `{{table "hl_address_pnl_daily"}}` (
+    `id` bigint AUTO_INCREMENT,
+    `address` varchar(42) NOT NULL COMMENT '链上地址',
+    `stat_date` varchar(10) NOT NULL COMMENT '统计日期（UTC，YYYY-MM-DD）',
+    `realized_pnl` double NOT NULL DEFAULT 0 COMMENT '已实现盈亏（成交 closedPnl 之和）',
+    `unrealized_pnl` double NOT NULL DEFAULT 0 COMMENT '未实现盈亏（当日最近一次仓位快照）',
+    `closed_orders` bigint NOT NULL DEFAULT 0 COMMENT '产生已实现盈亏的订单聚合数',
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uidx_pnl_address_date` (`address`,`stat_date`),
+    INDEX `idx_pnl_date` (`stat_date`)
+);
@@ -0,0 +1,5 @@
+ALTER TABLE `{{table "hl_address_signals"}}`
+    DROP INDEX `idx_published`,
+    DROP COLUMN `publish_attempts`,
+    DROP COLUMN `published_at`,
+    DROP COLUMN `payload`;
@@ -0,0 +1,8 @@
+-- 信号发件箱：信号与订单聚合在同一事务中写入，由分发器发布到 NATS 后标记
+ALTER TABLE `{{table "hl_address_signals"}}`
+    ADD COLUMN `payload` text NULL COMMENT 'NATS 消息体，仅发件箱模式写入' AFTER `trace_id`,
+    ADD COLUMN `published_at` datetime(3) NULL COMMENT '发布到 NATS 的时间，为空表示待发布' AFTER `payload`,
+    ADD COLUMN `publish_attempts` bigint NOT NULL DEFAULT 0 COMMENT '发布失败次数' AFTER `published_at`,
+    ADD INDEX `idx_published` (`published_at`);
+-- 已有记录均为先发布后写入
+UPDATE `{{table "hl_address_signals"}}` SET `published_at` = `created_at`;
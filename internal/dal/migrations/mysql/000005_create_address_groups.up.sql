@@ -0,0 +1,22 @@
+-- 地址分组（[address_groups] enabled = true 时按分组主题额外发布信号）
+CREATE TABLE IF NOT EXISTS `{{table "hl_address_groups"}}` (
+    `id` bigint AUTO_INCREMENT,
+    `name` varchar(64) NOT NULL COMMENT '分组名称',
+    `subject_template` varchar(255) NOT NULL DEFAULT '' COMMENT 'NATS 主题模板，如 hl.signal.{group}.{symbol}，为空时使用默认模板',
+    `enabled` boolean NOT NULL DEFAULT true COMMENT '是否启用',
+    `remark` varchar(255) NOT NULL DEFAULT '' COMMENT '备注',
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uidx_group_name` (`name`)
+);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_address_group_members"}}` (
+    `id` bigint AUTO_INCREMENT,
+    `group_name` varchar(64) NOT NULL COMMENT '分组名称',
+    `address` varchar(42) NOT NULL COMMENT '链上地址',
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uidx_group_member` (`group_name`,`address`),
+    INDEX `idx_group_member_address` (`address`)
+);
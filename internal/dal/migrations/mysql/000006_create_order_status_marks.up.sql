@@ -0,0 +1,12 @@
+-- 订单终止状态预标记（[status_tracker] persist = true 时维护）
+CREATE TABLE IF NOT EXISTS `{{table "hl_order_status_marks"}}` (
+    `id` bigint AUTO_INCREMENT,
+    `address` varchar(42) NOT NULL COMMENT '链上地址',
+    `oid` bigint NOT NULL COMMENT '订单ID',
+    `status` varchar(32) NOT NULL COMMENT '终止状态（filled/canceled 等）',
+    `expires_at` bigint NOT NULL COMMENT '过期时间（毫秒）',
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uidx_status_mark_order` (`address`,`oid`),
+    INDEX `idx_status_mark_expires` (`expires_at`)
+);
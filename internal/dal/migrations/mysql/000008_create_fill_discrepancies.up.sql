@@ -0,0 +1,16 @@
+-- 成交对账差异（[fill_reconcile] enabled = true 时维护）
+CREATE TABLE IF NOT EXISTS `{{table "hl_fill_discrepancies"}}` (
+    `id` bigint AUTO_INCREMENT,
+    `audit_date` varchar(10) NOT NULL COMMENT '对账日期（UTC，YYYY-MM-DD）',
+    `address` varchar(42) NOT NULL COMMENT '链上地址',
+    `oid` bigint NOT NULL COMMENT '订单聚合 ID',
+    `direction` varchar(16) NOT NULL COMMENT '成交方向',
+    `kind` varchar(16) NOT NULL COMMENT '差异来源（aggregation/signal）',
+    `scope` varchar(32) NOT NULL DEFAULT '' COMMENT '信号去重作用域，kind=signal 时有效',
+    `fill_size` double NOT NULL DEFAULT 0 COMMENT 'hl_fills 成交数量之和',
+    `recorded_size` double NOT NULL DEFAULT 0 COMMENT '订单聚合或信号记录的数量',
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uidx_fill_discrepancy` (`audit_date`,`address`,`oid`,`direction`,`kind`,`scope`),
+    INDEX `idx_fill_discrepancy_date` (`audit_date`)
+);
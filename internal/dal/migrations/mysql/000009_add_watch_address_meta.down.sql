@@ -0,0 +1 @@
+ALTER TABLE `{{table "hl_watch_addresses"}}` DROP COLUMN `tags`, DROP COLUMN `channels`, DROP COLUMN `comment`;
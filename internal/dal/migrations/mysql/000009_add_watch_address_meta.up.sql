@@ -0,0 +1,5 @@
+-- 监控地址的标签、信号推送渠道偏好和备注，随 hl_monitor addresses export/import 在环境间迁移
+ALTER TABLE `{{table "hl_watch_addresses"}}`
+    ADD COLUMN `tags` varchar(255) NOT NULL DEFAULT '' COMMENT '标签，逗号分隔' AFTER `is_system`,
+    ADD COLUMN `channels` varchar(128) NOT NULL DEFAULT '' COMMENT '信号推送渠道偏好，逗号分隔' AFTER `tags`,
+    ADD COLUMN `comment` varchar(255) NOT NULL DEFAULT '' COMMENT '备注' AFTER `channels`;
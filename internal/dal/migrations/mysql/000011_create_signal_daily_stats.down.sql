@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `{{table "hl_signal_daily_stats"}}`;
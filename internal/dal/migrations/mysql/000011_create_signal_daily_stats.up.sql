@@ -0,0 +1,20 @@
+-- 信号每日统计（[signal_stats] enabled = true 时维护）
+CREATE TABLE IF NOT EXISTS `{{table "hl_signal_daily_stats"}}` (
+    `id` bigint AUTO_INCREMENT,
+    `stat_date` varchar(10) NOT NULL COMMENT '统计日期（UTC，YYYY-MM-DD）',
+    `address` varchar(42) NOT NULL COMMENT '链上地址',
+    `symbol` varchar(24) NOT NULL COMMENT '交易对',
+    `asset_type` varchar(24) NOT NULL COMMENT '资产类型: spot/futures',
+    `scope` varchar(32) NOT NULL DEFAULT '' COMMENT '去重作用域（逻辑消费者）',
+    `signal_count` bigint NOT NULL DEFAULT 0 COMMENT '信号数',
+    `open_count` bigint NOT NULL DEFAULT 0 COMMENT '开仓信号数',
+    `close_count` bigint NOT NULL DEFAULT 0 COMMENT '平仓信号数',
+    `volume` double NOT NULL DEFAULT 0 COMMENT '信号数量之和',
+    `notional` double NOT NULL DEFAULT 0 COMMENT '名义价值之和（价格×数量）',
+    `avg_position_rate` double NOT NULL DEFAULT 0 COMMENT '平均仓位比例',
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uidx_signal_daily_stats` (`stat_date`,`address`,`symbol`,`asset_type`,`scope`),
+    INDEX `idx_signal_daily_stats_date` (`stat_date`),
+    INDEX `idx_signal_daily_stats_address` (`address`)
+);
@@ -0,0 +1 @@
+ALTER TABLE `{{table "hl_watch_addresses"}}` DROP COLUMN `signal_filter`;
@@ -0,0 +1,3 @@
+-- 监控地址的信号过滤：逗号分隔的 open / close / futures / spot，空为全部发送
+ALTER TABLE `{{table "hl_watch_addresses"}}`
+    ADD COLUMN `signal_filter` varchar(64) NOT NULL DEFAULT '' COMMENT '信号过滤：逗号分隔的 open / close / futures / spot，空为全部发送' AFTER `aggregation`;
@@ -0,0 +1,12 @@
+-- symbol 改名映射（symbols rename 子命令写入）
+CREATE TABLE IF NOT EXISTS `{{table "hl_symbol_renames"}}` (
+    `id` bigint AUTO_INCREMENT,
+    `asset_type` varchar(24) NOT NULL COMMENT '资产类型: spot/futures',
+    `old_symbol` varchar(24) NOT NULL COMMENT '旧交易对',
+    `new_symbol` varchar(24) NOT NULL COMMENT '新交易对',
+    `rewritten_rows` bigint NOT NULL DEFAULT 0 COMMENT '改写的历史记录数',
+    `applied_at` datetime(3) NULL COMMENT '最近一次改写历史记录的时间',
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uidx_symbol_rename` (`asset_type`,`old_symbol`)
+);
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS `{{table "pair_configs"}}`;
+DROP TABLE IF EXISTS `{{table "hl_fill_watermarks"}}`;
+DROP TABLE IF EXISTS `{{table "hl_fills"}}`;
+DROP TABLE IF EXISTS `{{table "hl_open_orders"}}`;
+DROP TABLE IF EXISTS `{{table "hl_failed_writes"}}`;
+DROP TABLE IF EXISTS `{{table "hl_leader_lease"}}`;
+DROP TABLE IF EXISTS `{{table "hl_address_activity"}}`;
+DROP TABLE IF EXISTS `{{table "hl_address_signals"}}`;
+DROP TABLE IF EXISTS `{{table "hl_order_aggregation"}}`;
+DROP TABLE IF EXISTS `{{table "hl_position_cache"}}`;
+DROP TABLE IF EXISTS `{{table "hl_watch_addresses"}}`;
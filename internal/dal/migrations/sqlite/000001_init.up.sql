@@ -0,0 +1,161 @@
+-- 初始表结构，与引入版本化迁移前 AutoMigrate 创建的表一致；已有部署执行时跳过已存在的表
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_watch_addresses"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `player_id` integer NOT NULL,
+    `address` varchar(42) NOT NULL,
+    `nickname` varchar(64) DEFAULT '',
+    `is_system` tinyint(1) NOT NULL DEFAULT false,
+    `created_at` datetime,
+    `updated_at` datetime,
+    `deleted_at` datetime
+);
+CREATE INDEX IF NOT EXISTS `idx_hl_watch_addresses_deleted_at` ON `{{table "hl_watch_addresses"}}`(`deleted_at`);
+CREATE INDEX IF NOT EXISTS `idx_player` ON `{{table "hl_watch_addresses"}}`(`player_id`);
+CREATE UNIQUE INDEX IF NOT EXISTS `uidx_player_addr` ON `{{table "hl_watch_addresses"}}`(`player_id`,`address`);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_position_cache"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `address` varchar(42) NOT NULL,
+    `spot_balances` json,
+    `spot_total_usd` varchar(32) NOT NULL DEFAULT '0',
+    `futures_positions` json,
+    `account_value` varchar(32) NOT NULL DEFAULT '0',
+    `total_margin_used` varchar(32) NOT NULL DEFAULT '0',
+    `total_ntl_pos` varchar(32) NOT NULL DEFAULT '0',
+    `withdrawable` varchar(32) NOT NULL DEFAULT '0',
+    `quote_currency` varchar(16) NOT NULL DEFAULT 'USD',
+    `spot_total_quote` varchar(32) NOT NULL DEFAULT '',
+    `account_value_quote` varchar(32) NOT NULL DEFAULT '',
+    `updated_at` datetime NOT NULL
+);
+CREATE INDEX IF NOT EXISTS `idx_updated` ON `{{table "hl_position_cache"}}`(`updated_at`);
+CREATE UNIQUE INDEX IF NOT EXISTS `uidx_address` ON `{{table "hl_position_cache"}}`(`address`);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_order_aggregation"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `oid` integer NOT NULL,
+    `address` varchar(42) NOT NULL,
+    `direction` varchar(16) NOT NULL,
+    `symbol` varchar(24) NOT NULL,
+    `fills` json NOT NULL,
+    `total_size` real NOT NULL DEFAULT 0,
+    `weighted_avg_px` real NOT NULL DEFAULT 0,
+    `mid_px` real NOT NULL DEFAULT 0,
+    `slippage_bps` real NOT NULL DEFAULT 0,
+    `order_status` varchar(64) NOT NULL DEFAULT 'open',
+    `last_fill_time` integer NOT NULL,
+    `signal_sent` numeric NOT NULL DEFAULT false,
+    `created_at` datetime,
+    `updated_at` datetime
+);
+CREATE INDEX IF NOT EXISTS `idx_signal_sent` ON `{{table "hl_order_aggregation"}}`(`signal_sent`);
+CREATE INDEX IF NOT EXISTS `idx_hl_order_aggregation_last_fill_time` ON `{{table "hl_order_aggregation"}}`(`last_fill_time`);
+CREATE UNIQUE INDEX IF NOT EXISTS `uidx_addr_oid_dir` ON `{{table "hl_order_aggregation"}}`(`oid`,`address`,`direction`);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_address_signals"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `address` varchar(42) NOT NULL,
+    `position_rate` decimal(18,3) NOT NULL,
+    `close_rate` decimal(18,3) NOT NULL DEFAULT 0,
+    `symbol` varchar(24) NOT NULL,
+    `coin_type` varchar(8) NOT NULL,
+    `asset_type` varchar(24) NOT NULL,
+    `direction` varchar(8) NOT NULL,
+    `side` varchar(8) NOT NULL,
+    `price` decimal(28,12) NOT NULL,
+    `size` decimal(18,8) NOT NULL,
+    `scope` varchar(32) NOT NULL DEFAULT '',
+    `idempotency_key` varchar(32) NOT NULL DEFAULT '',
+    `trace_id` varchar(36) NOT NULL DEFAULT '',
+    `created_at` datetime,
+    `expired_at` datetime NOT NULL
+);
+CREATE INDEX IF NOT EXISTS `idx_hl_address_signals_expired_at` ON `{{table "hl_address_signals"}}`(`expired_at`);
+CREATE INDEX IF NOT EXISTS `idx_created` ON `{{table "hl_address_signals"}}`(`created_at`);
+CREATE INDEX IF NOT EXISTS `idx_hl_address_signals_trace_id` ON `{{table "hl_address_signals"}}`(`trace_id`);
+CREATE INDEX IF NOT EXISTS `idx_hl_address_signals_idempotency_key` ON `{{table "hl_address_signals"}}`(`idempotency_key`);
+CREATE INDEX IF NOT EXISTS `idx_hl_address_signals_asset_type` ON `{{table "hl_address_signals"}}`(`asset_type`);
+CREATE INDEX IF NOT EXISTS `idx_hl_address_signals_symbol` ON `{{table "hl_address_signals"}}`(`symbol`);
+CREATE INDEX IF NOT EXISTS `idx_address` ON `{{table "hl_address_signals"}}`(`address`);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_address_activity"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `address` varchar(64) NOT NULL,
+    `last_active_at` datetime NOT NULL,
+    `dormant` numeric NOT NULL DEFAULT false,
+    `dormant_since` datetime,
+    `created_at` datetime,
+    `updated_at` datetime
+);
+CREATE INDEX IF NOT EXISTS `idx_dormant` ON `{{table "hl_address_activity"}}`(`dormant`);
+CREATE UNIQUE INDEX IF NOT EXISTS `uidx_activity_address` ON `{{table "hl_address_activity"}}`(`address`);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_leader_lease"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `name` varchar(64) NOT NULL,
+    `holder` varchar(128) NOT NULL,
+    `expires_at` datetime NOT NULL,
+    `updated_at` datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `uidx_name` ON `{{table "hl_leader_lease"}}`(`name`);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_failed_writes"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `target_table` varchar(64) NOT NULL,
+    `dedup_key` varchar(255) NOT NULL,
+    `payload` mediumtext,
+    `error` text,
+    `attempts` integer NOT NULL DEFAULT 1,
+    `created_at` datetime NOT NULL
+);
+CREATE INDEX IF NOT EXISTS `idx_created_at` ON `{{table "hl_failed_writes"}}`(`created_at`);
+CREATE INDEX IF NOT EXISTS `idx_target_table` ON `{{table "hl_failed_writes"}}`(`target_table`);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_open_orders"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `address` varchar(42) NOT NULL,
+    `oid` integer NOT NULL,
+    `cloid` varchar(66) NOT NULL DEFAULT '',
+    `coin` varchar(64) NOT NULL,
+    `symbol` varchar(64) NOT NULL DEFAULT '',
+    `side` varchar(4) NOT NULL,
+    `limit_px` varchar(32) NOT NULL,
+    `sz` varchar(32) NOT NULL,
+    `orig_sz` varchar(32) NOT NULL DEFAULT '',
+    `placed_at` integer NOT NULL,
+    `updated_at` datetime NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `uidx_address_oid` ON `{{table "hl_open_orders"}}`(`address`,`oid`);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_fills"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `tid` integer NOT NULL,
+    `address` varchar(42) NOT NULL,
+    `oid` integer NOT NULL,
+    `coin` varchar(64) NOT NULL,
+    `dir` varchar(32) NOT NULL DEFAULT '',
+    `fill_time` integer NOT NULL,
+    `raw` json NOT NULL,
+    `created_at` datetime
+);
+CREATE INDEX IF NOT EXISTS `idx_fill_time` ON `{{table "hl_fills"}}`(`fill_time`);
+CREATE INDEX IF NOT EXISTS `idx_fill_oid` ON `{{table "hl_fills"}}`(`oid`);
+CREATE INDEX IF NOT EXISTS `idx_fill_address_time` ON `{{table "hl_fills"}}`(`address`,`fill_time`);
+CREATE UNIQUE INDEX IF NOT EXISTS `uidx_fill_tid_address` ON `{{table "hl_fills"}}`(`tid`,`address`);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_fill_watermarks"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `address` varchar(42) NOT NULL,
+    `last_fill_time` integer NOT NULL,
+    `updated_at` datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `uidx_fill_watermark_address` ON `{{table "hl_fill_watermarks"}}`(`address`);
+
+CREATE TABLE IF NOT EXISTS `{{table "pair_configs"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `symbol` text NOT NULL,
+    `platform` text,
+    `category` integer DEFAULT 4
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `symbol_idx` ON `{{table "pair_configs"}}`(`symbol`,`platform`);
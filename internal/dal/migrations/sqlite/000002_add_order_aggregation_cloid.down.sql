@@ -0,0 +1 @@
+ALTER TABLE `{{table "hl_order_aggregation"}}` DROP COLUMN `cloid`;
@@ -0,0 +1,2 @@
+-- 订单聚合按 cloid 分组，同一 cloid 的拆单/改单合并为一个信号
+ALTER TABLE `{{table "hl_order_aggregation"}}` ADD COLUMN `cloid` varchar(66) NOT NULL DEFAULT '';
@@ -0,0 +1,12 @@
+-- 地址每日盈亏（[pnl] enabled = true 时维护）
+CREATE TABLE IF NOT EXISTS `{{table "hl_address_pnl_daily"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `address` varchar(42) NOT NULL,
+    `stat_date` varchar(10) NOT NULL,
+    `realized_pnl` real NOT NULL DEFAULT 0,
+    `unrealized_pnl` real NOT NULL DEFAULT 0,
+    `closed_orders` integer NOT NULL DEFAULT 0,
+    `updated_at` datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `uidx_pnl_address_date` ON `{{table "hl_address_pnl_daily"}}`(`address`,`stat_date`);
+CREATE INDEX IF NOT EXISTS `idx_pnl_date` ON `{{table "hl_address_pnl_daily"}}`(`stat_date`);
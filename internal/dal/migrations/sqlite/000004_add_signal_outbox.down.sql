@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS `idx_published`;
+ALTER TABLE `{{table "hl_address_signals"}}` DROP COLUMN `publish_attempts`;
+ALTER TABLE `{{table "hl_address_signals"}}` DROP COLUMN `published_at`;
+ALTER TABLE `{{table "hl_address_signals"}}` DROP COLUMN `payload`;
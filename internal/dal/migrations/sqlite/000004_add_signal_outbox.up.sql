@@ -0,0 +1,7 @@
+-- 信号发件箱：信号与订单聚合在同一事务中写入，由分发器发布到 NATS 后标记
+ALTER TABLE `{{table "hl_address_signals"}}` ADD COLUMN `payload` text;
+ALTER TABLE `{{table "hl_address_signals"}}` ADD COLUMN `published_at` datetime;
+ALTER TABLE `{{table "hl_address_signals"}}` ADD COLUMN `publish_attempts` integer NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS `idx_published` ON `{{table "hl_address_signals"}}`(`published_at`);
+-- 已有记录均为先发布后写入
+UPDATE `{{table "hl_address_signals"}}` SET `published_at` = `created_at`;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `{{table "hl_address_group_members"}}`;
+DROP TABLE IF EXISTS `{{table "hl_address_groups"}}`;
@@ -0,0 +1,20 @@
+-- 地址分组（[address_groups] enabled = true 时按分组主题额外发布信号）
+CREATE TABLE IF NOT EXISTS `{{table "hl_address_groups"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `name` varchar(64) NOT NULL,
+    `subject_template` varchar(255) NOT NULL DEFAULT '',
+    `enabled` numeric NOT NULL DEFAULT true,
+    `remark` varchar(255) NOT NULL DEFAULT '',
+    `created_at` datetime,
+    `updated_at` datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `uidx_group_name` ON `{{table "hl_address_groups"}}`(`name`);
+
+CREATE TABLE IF NOT EXISTS `{{table "hl_address_group_members"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `group_name` varchar(64) NOT NULL,
+    `address` varchar(42) NOT NULL,
+    `created_at` datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `uidx_group_member` ON `{{table "hl_address_group_members"}}`(`group_name`,`address`);
+CREATE INDEX IF NOT EXISTS `idx_group_member_address` ON `{{table "hl_address_group_members"}}`(`address`);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `{{table "hl_order_status_marks"}}`;
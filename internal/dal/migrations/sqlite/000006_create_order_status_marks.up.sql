@@ -0,0 +1,11 @@
+-- 订单终止状态预标记（[status_tracker] persist = true 时维护）
+CREATE TABLE IF NOT EXISTS `{{table "hl_order_status_marks"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `address` varchar(42) NOT NULL,
+    `oid` integer NOT NULL,
+    `status` varchar(32) NOT NULL,
+    `expires_at` integer NOT NULL,
+    `updated_at` datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `uidx_status_mark_order` ON `{{table "hl_order_status_marks"}}`(`address`,`oid`);
+CREATE INDEX IF NOT EXISTS `idx_status_mark_expires` ON `{{table "hl_order_status_marks"}}`(`expires_at`);
@@ -0,0 +1 @@
+ALTER TABLE `{{table "hl_address_signals"}}` DROP COLUMN `dead_lettered_at`;
@@ -0,0 +1,2 @@
+-- 发件箱死信：载荷无法解析或发布失败次数超过上限的信号不再重试，分发继续处理后续信号
+ALTER TABLE `{{table "hl_address_signals"}}` ADD COLUMN `dead_lettered_at` datetime;
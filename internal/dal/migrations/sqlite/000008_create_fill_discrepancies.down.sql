@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `{{table "hl_fill_discrepancies"}}`;
@@ -0,0 +1,15 @@
+-- 成交对账差异（[fill_reconcile] enabled = true 时维护）
+CREATE TABLE IF NOT EXISTS `{{table "hl_fill_discrepancies"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `audit_date` varchar(10) NOT NULL,
+    `address` varchar(42) NOT NULL,
+    `oid` integer NOT NULL,
+    `direction` varchar(16) NOT NULL,
+    `kind` varchar(16) NOT NULL,
+    `scope` varchar(32) NOT NULL DEFAULT '',
+    `fill_size` real NOT NULL DEFAULT 0,
+    `recorded_size` real NOT NULL DEFAULT 0,
+    `created_at` datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `uidx_fill_discrepancy` ON `{{table "hl_fill_discrepancies"}}`(`audit_date`,`address`,`oid`,`direction`,`kind`,`scope`);
+CREATE INDEX IF NOT EXISTS `idx_fill_discrepancy_date` ON `{{table "hl_fill_discrepancies"}}`(`audit_date`);
@@ -0,0 +1,3 @@
+ALTER TABLE `{{table "hl_watch_addresses"}}` DROP COLUMN `comment`;
+ALTER TABLE `{{table "hl_watch_addresses"}}` DROP COLUMN `channels`;
+ALTER TABLE `{{table "hl_watch_addresses"}}` DROP COLUMN `tags`;
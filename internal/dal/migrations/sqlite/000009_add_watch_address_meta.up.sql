@@ -0,0 +1,4 @@
+-- 监控地址的标签、信号推送渠道偏好和备注，随 hl_monitor addresses export/import 在环境间迁移
+ALTER TABLE `{{table "hl_watch_addresses"}}` ADD COLUMN `tags` varchar(255) NOT NULL DEFAULT '';
+ALTER TABLE `{{table "hl_watch_addresses"}}` ADD COLUMN `channels` varchar(128) NOT NULL DEFAULT '';
+ALTER TABLE `{{table "hl_watch_addresses"}}` ADD COLUMN `comment` varchar(255) NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+-- 监控地址的订单聚合策略：空为默认聚合，scalper 逐笔成交立即发送，swing 使用更长的聚合窗口
+ALTER TABLE `{{table "hl_watch_addresses"}}` ADD COLUMN `aggregation` varchar(16) NOT NULL DEFAULT '';
@@ -0,0 +1,19 @@
+-- 信号每日统计（[signal_stats] enabled = true 时维护）
+CREATE TABLE IF NOT EXISTS `{{table "hl_signal_daily_stats"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `stat_date` varchar(10) NOT NULL,
+    `address` varchar(42) NOT NULL,
+    `symbol` varchar(24) NOT NULL,
+    `asset_type` varchar(24) NOT NULL,
+    `scope` varchar(32) NOT NULL DEFAULT '',
+    `signal_count` integer NOT NULL DEFAULT 0,
+    `open_count` integer NOT NULL DEFAULT 0,
+    `close_count` integer NOT NULL DEFAULT 0,
+    `volume` real NOT NULL DEFAULT 0,
+    `notional` real NOT NULL DEFAULT 0,
+    `avg_position_rate` real NOT NULL DEFAULT 0,
+    `updated_at` datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `uidx_signal_daily_stats` ON `{{table "hl_signal_daily_stats"}}`(`stat_date`,`address`,`symbol`,`asset_type`,`scope`);
+CREATE INDEX IF NOT EXISTS `idx_signal_daily_stats_date` ON `{{table "hl_signal_daily_stats"}}`(`stat_date`);
+CREATE INDEX IF NOT EXISTS `idx_signal_daily_stats_address` ON `{{table "hl_signal_daily_stats"}}`(`address`);
@@ -0,0 +1,11 @@
+-- symbol 改名映射（symbols rename 子命令写入）
+CREATE TABLE IF NOT EXISTS `{{table "hl_symbol_renames"}}` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `asset_type` varchar(24) NOT NULL,
+    `old_symbol` varchar(24) NOT NULL,
+    `new_symbol` varchar(24) NOT NULL,
+    `rewritten_rows` integer NOT NULL DEFAULT 0,
+    `applied_at` datetime,
+    `created_at` datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `uidx_symbol_rename` ON `{{table "hl_symbol_renames"}}`(`asset_type`,`old_symbol`);
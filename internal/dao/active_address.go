@@ -1,6 +1,8 @@
 package dao
 
 import (
+	"strconv"
+
 	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
 )
 
@@ -22,3 +24,23 @@ func (d *ActiveAddressDAO) ListDistinctAddresses() ([]string, error) {
 		Scan(&addresses)
 	return addresses, err
 }
+
+// ListAddressScopes 获取地址 → 服务实例ID 列表（作为去重作用域）
+func (d *ActiveAddressDAO) ListAddressScopes() (map[string][]string, error) {
+	var rows []struct {
+		Address  string
+		ServerID int
+	}
+	err := gen.HlActiveAddress.
+		Select(gen.HlActiveAddress.Address, gen.HlActiveAddress.ServerID).
+		Scan(&rows)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]string, len(rows))
+	for _, row := range rows {
+		result[row.Address] = append(result[row.Address], strconv.Itoa(row.ServerID))
+	}
+	return result, nil
+}
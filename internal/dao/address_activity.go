@@ -0,0 +1,72 @@
+package dao
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+type AddressActivityDAO struct{}
+
+var _addressActivity = &AddressActivityDAO{}
+
+// AddressActivity 获取 AddressActivityDAO 单例
+func AddressActivity() *AddressActivityDAO {
+	return _addressActivity
+}
+
+// ListAll 获取所有地址活跃度记录
+func (d *AddressActivityDAO) ListAll() ([]*models.HlAddressActivity, error) {
+	return gen.HlAddressActivity.Find()
+}
+
+// BatchUpsertLastActive 批量更新最近活跃时间（不修改休眠标记）
+func (d *AddressActivityDAO) BatchUpsertLastActive(lastActive map[string]time.Time) error {
+	if len(lastActive) == 0 {
+		return nil
+	}
+
+	rows := make([]*models.HlAddressActivity, 0, len(lastActive))
+	for addr, t := range lastActive {
+		rows = append(rows, &models.HlAddressActivity{
+			Address:      addr,
+			LastActiveAt: t,
+		})
+	}
+
+	db := gen.HlAddressActivity.UnderlyingDB()
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "address"}},
+		DoUpdates: clause.AssignmentColumns([]string{"last_active_at", "updated_at"}),
+	}).Create(rows).Error
+}
+
+// MarkDormant 标记地址进入休眠
+func (d *AddressActivityDAO) MarkDormant(address string, lastActive, since time.Time) error {
+	db := gen.HlAddressActivity.UnderlyingDB()
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "address"}},
+		DoUpdates: clause.AssignmentColumns([]string{"last_active_at", "dormant", "dormant_since", "updated_at"}),
+	}).Create(&models.HlAddressActivity{
+		Address:      address,
+		LastActiveAt: lastActive,
+		Dormant:      true,
+		DormantSince: &since,
+	}).Error
+}
+
+// MarkActive 标记地址恢复活跃
+func (d *AddressActivityDAO) MarkActive(address string, lastActive time.Time) error {
+	db := gen.HlAddressActivity.UnderlyingDB()
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "address"}},
+		DoUpdates: clause.AssignmentColumns([]string{"last_active_at", "dormant", "dormant_since", "updated_at"}),
+	}).Create(&models.HlAddressActivity{
+		Address:      address,
+		LastActiveAt: lastActive,
+		Dormant:      false,
+	}).Error
+}
@@ -0,0 +1,40 @@
+package dao
+
+import (
+	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+type AddressGroupDAO struct{}
+
+var _addressGroup = &AddressGroupDAO{}
+
+// AddressGroup 获取 AddressGroupDAO 单例
+func AddressGroup() *AddressGroupDAO {
+	return _addressGroup
+}
+
+// ListEnabled 获取已启用的分组
+func (d *AddressGroupDAO) ListEnabled() ([]*models.HlAddressGroup, error) {
+	return gen.HlAddressGroup.
+		Where(gen.HlAddressGroup.Enabled.Is(true)).
+		Order(gen.HlAddressGroup.Name).
+		Find()
+}
+
+// ListMembers 获取地址 → 分组名称列表
+func (d *AddressGroupDAO) ListMembers() (map[string][]string, error) {
+	rows, err := gen.HlAddressGroupMember.
+		Select(gen.HlAddressGroupMember.GroupName, gen.HlAddressGroupMember.Address).
+		Order(gen.HlAddressGroupMember.GroupName).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]string)
+	for _, row := range rows {
+		result[row.Address] = append(result[row.Address], row.GroupName)
+	}
+	return result, nil
+}
@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+type AddressPnlDAO struct{}
+
+var _addressPnl = &AddressPnlDAO{}
+
+// AddressPnl 获取 AddressPnlDAO 单例
+func AddressPnl() *AddressPnlDAO {
+	return _addressPnl
+}
+
+// AddRealized 累加每日已实现盈亏和平仓订单数（rows 中为增量），不修改未实现盈亏
+func (d *AddressPnlDAO) AddRealized(rows []*models.HlAddressPnlDaily) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	db := gen.HlAddressPnlDaily.UnderlyingDB()
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, row := range rows {
+			err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "address"}, {Name: "stat_date"}},
+				DoUpdates: clause.Assignments(map[string]any{
+					"realized_pnl":  gorm.Expr("realized_pnl + ?", row.RealizedPnl),
+					"closed_orders": gorm.Expr("closed_orders + ?", row.ClosedOrders),
+					"updated_at":    time.Now(),
+				}),
+			}).Create(row).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// BatchUpsertUnrealized 批量更新每日未实现盈亏，不修改已实现盈亏
+func (d *AddressPnlDAO) BatchUpsertUnrealized(rows []*models.HlAddressPnlDaily) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	db := gen.HlAddressPnlDaily.UnderlyingDB()
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "address"}, {Name: "stat_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"unrealized_pnl", "updated_at"}),
+	}).CreateInBatches(rows, 100).Error
+}
+
+// ListRange 查询日期范围内（含首尾，YYYY-MM-DD）的每日盈亏，address 为空时查询全部地址
+func (d *AddressPnlDAO) ListRange(from, to, address string) ([]*models.HlAddressPnlDaily, error) {
+	q := gen.HlAddressPnlDaily
+	do := q.Where(q.StatDate.Gte(from), q.StatDate.Lte(to))
+	if address != "" {
+		do = do.Where(q.Address.Eq(address))
+	}
+	return do.Order(q.Address, q.StatDate).Find()
+}
@@ -0,0 +1,23 @@
+package dao
+
+import (
+	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+type FailedWriteDAO struct{}
+
+var _failedWrite = &FailedWriteDAO{}
+
+// FailedWrite 获取 FailedWriteDAO 单例
+func FailedWrite() *FailedWriteDAO {
+	return _failedWrite
+}
+
+// BatchCreate 批量写入隔离记录
+func (d *FailedWriteDAO) BatchCreate(rows []*models.HlFailedWrite) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	return gen.HlFailedWrite.CreateInBatches(rows, 100)
+}
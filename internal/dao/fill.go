@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+type FillDAO struct{}
+
+var _fill = &FillDAO{}
+
+// Fill 获取 FillDAO 单例
+func Fill() *FillDAO {
+	return _fill
+}
+
+// BatchInsert 批量写入原始成交，tid+address 已存在时忽略（重连重放的成交不重复写入）
+func (d *FillDAO) BatchInsert(fills []*models.HlFill) error {
+	db := gen.HlFill.UnderlyingDB()
+	return db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "tid"},
+			{Name: "address"},
+		},
+		DoNothing: true,
+	}).CreateInBatches(fills, 100).Error
+}
+
+// ListByOrder 获取订单的全部原始成交（按成交时间排序）
+func (d *FillDAO) ListByOrder(address string, oid int64) ([]*models.HlFill, error) {
+	return gen.HlFill.Where(
+		gen.HlFill.Address.Eq(address),
+		gen.HlFill.Oid.Eq(oid),
+	).Order(gen.HlFill.FillTime, gen.HlFill.Tid).Find()
+}
+
+// ListByOrders 获取地址多个订单的全部原始成交（按成交时间排序）
+func (d *FillDAO) ListByOrders(address string, oids []int64) ([]*models.HlFill, error) {
+	if len(oids) == 0 {
+		return nil, nil
+	}
+	return gen.HlFill.Where(
+		gen.HlFill.Address.Eq(address),
+		gen.HlFill.Oid.In(oids...),
+	).Order(gen.HlFill.FillTime, gen.HlFill.Tid).Find()
+}
+
+// DeleteOld 清理成交时间早于指定时间的记录
+func (d *FillDAO) DeleteOld(before time.Time) (int64, error) {
+	result, err := gen.HlFill.Where(
+		gen.HlFill.FillTime.Lt(before.UnixMilli()),
+	).Delete()
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected, nil
+}
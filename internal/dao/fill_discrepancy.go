@@ -0,0 +1,35 @@
+package dao
+
+import (
+	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+type FillDiscrepancyDAO struct{}
+
+var _fillDiscrepancy = &FillDiscrepancyDAO{}
+
+// FillDiscrepancy 获取 FillDiscrepancyDAO 单例
+func FillDiscrepancy() *FillDiscrepancyDAO {
+	return _fillDiscrepancy
+}
+
+// ReplaceDate 覆盖对账日期的全部差异记录，重复对账同一日期时先删除上次结果
+func (d *FillDiscrepancyDAO) ReplaceDate(auditDate string, rows []*models.HlFillDiscrepancy) error {
+	return gen.Q.Transaction(func(tx *gen.Query) error {
+		if _, err := tx.HlFillDiscrepancy.Where(tx.HlFillDiscrepancy.AuditDate.Eq(auditDate)).Delete(); err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.HlFillDiscrepancy.CreateInBatches(rows, 100)
+	})
+}
+
+// ListByDate 获取对账日期的差异记录
+func (d *FillDiscrepancyDAO) ListByDate(auditDate string) ([]*models.HlFillDiscrepancy, error) {
+	return gen.HlFillDiscrepancy.Where(
+		gen.HlFillDiscrepancy.AuditDate.Eq(auditDate),
+	).Order(gen.HlFillDiscrepancy.Address, gen.HlFillDiscrepancy.Oid).Find()
+}
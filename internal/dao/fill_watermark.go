@@ -0,0 +1,43 @@
+package dao
+
+import (
+	"gorm.io/gorm/clause"
+
+	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+type FillWatermarkDAO struct{}
+
+var _fillWatermark = &FillWatermarkDAO{}
+
+// FillWatermark 获取 FillWatermarkDAO 单例
+func FillWatermark() *FillWatermarkDAO {
+	return _fillWatermark
+}
+
+// ListAll 获取所有地址的成交高水位
+func (d *FillWatermarkDAO) ListAll() ([]*models.HlFillWatermark, error) {
+	return gen.HlFillWatermark.Find()
+}
+
+// BatchUpsert 批量更新成交高水位（address -> 成交时间毫秒）
+func (d *FillWatermarkDAO) BatchUpsert(marks map[string]int64) error {
+	if len(marks) == 0 {
+		return nil
+	}
+
+	rows := make([]*models.HlFillWatermark, 0, len(marks))
+	for addr, t := range marks {
+		rows = append(rows, &models.HlFillWatermark{
+			Address:      addr,
+			LastFillTime: t,
+		})
+	}
+
+	db := gen.HlFillWatermark.UnderlyingDB()
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "address"}},
+		DoUpdates: clause.AssignmentColumns([]string{"last_fill_time", "updated_at"}),
+	}).CreateInBatches(rows, 100).Error
+}
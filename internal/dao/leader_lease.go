@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+type LeaderLeaseDAO struct{}
+
+var _leaderLease = &LeaderLeaseDAO{}
+
+// LeaderLease 获取 LeaderLeaseDAO 单例
+func LeaderLease() *LeaderLeaseDAO {
+	return _leaderLease
+}
+
+// TryAcquire 尝试获取或续约租约
+// 租约由自己持有或已过期时更新成功，记录不存在时插入
+func (d *LeaderLeaseDAO) TryAcquire(name, holder string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+
+	db := gen.HlLeaderLease.UnderlyingDB()
+	result := db.Model(&models.HlLeaderLease{}).
+		Where("name = ? AND (holder = ? OR expires_at < ?)", name, holder, now).
+		Updates(map[string]any{
+			"holder":     holder,
+			"expires_at": expiresAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return true, nil
+	}
+
+	// 记录不存在时插入，已存在（被其他实例持有）则忽略
+	result = db.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.HlLeaderLease{
+		Name:      name,
+		Holder:    holder,
+		ExpiresAt: expiresAt,
+	})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Release 释放自己持有的租约（立即过期）
+func (d *LeaderLeaseDAO) Release(name, holder string) error {
+	_, err := gen.HlLeaderLease.
+		Where(gen.HlLeaderLease.Name.Eq(name), gen.HlLeaderLease.Holder.Eq(holder)).
+		Update(gen.HlLeaderLease.ExpiresAt, time.Now().Add(-time.Second))
+	return err
+}
@@ -0,0 +1,37 @@
+package dao
+
+import (
+	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+type OpenOrderDAO struct{}
+
+var _openOrder = &OpenOrderDAO{}
+
+// OpenOrder 获取 OpenOrderDAO 单例
+func OpenOrder() *OpenOrderDAO {
+	return _openOrder
+}
+
+// ReplaceAddress 以事务整体替换指定地址的挂单镜像
+func (d *OpenOrderDAO) ReplaceAddress(address string, rows []*models.HlOpenOrder) error {
+	return gen.Q.Transaction(func(tx *gen.Query) error {
+		if _, err := tx.HlOpenOrder.Where(tx.HlOpenOrder.Address.Eq(address)).Delete(); err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.HlOpenOrder.CreateInBatches(rows, 100)
+	})
+}
+
+// DeleteAll 清空挂单镜像
+func (d *OpenOrderDAO) DeleteAll() (int64, error) {
+	result, err := gen.HlOpenOrder.Where(gen.HlOpenOrder.ID.Gt(0)).Delete()
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected, nil
+}
@@ -3,6 +3,7 @@ package dao
 import (
 	"time"
 
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
 	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
@@ -87,6 +88,30 @@ func (d *OrderAggregationDAO) DeleteOld(beforeTimestamp int64) (int64, error) {
 	return result.RowsAffected, nil
 }
 
+// IsSignalSent 检查地址的订单是否已发送信号并落库
+func (d *OrderAggregationDAO) IsSignalSent(address string, oid int64) (bool, error) {
+	count, err := gen.OrderAggregation.Where(
+		gen.OrderAggregation.Oid.Eq(oid),
+		gen.OrderAggregation.Address.Eq(address),
+		gen.OrderAggregation.SignalSent.Is(true),
+	).Count()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// DeleteByAddress 删除指定地址的全部订单聚合
+func (d *OrderAggregationDAO) DeleteByAddress(address string) (int64, error) {
+	result, err := gen.OrderAggregation.Where(
+		gen.OrderAggregation.Address.Eq(address),
+	).Delete()
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected, nil
+}
+
 // GetSentOrdersSince 获取指定时间之后已发送信号的订单
 func (d *OrderAggregationDAO) GetSentOrdersSince(since time.Time) ([]*models.OrderAggregation, error) {
 	return gen.OrderAggregation.Where(
@@ -95,10 +120,23 @@ func (d *OrderAggregationDAO) GetSentOrdersSince(since time.Time) ([]*models.Ord
 	).Find()
 }
 
+// ListSentBetween 获取最后成交时间在 [from, to) 内且已发送信号的订单聚合
+func (d *OrderAggregationDAO) ListSentBetween(from, to time.Time) ([]*models.OrderAggregation, error) {
+	return gen.OrderAggregation.Where(
+		gen.OrderAggregation.SignalSent.Is(true),
+		gen.OrderAggregation.LastFillTime.Gte(from.Unix()),
+		gen.OrderAggregation.LastFillTime.Lt(to.Unix()),
+	).Order(gen.OrderAggregation.Address, gen.OrderAggregation.ID).Find()
+}
+
 // BatchUpsert 批量 upsert 订单聚合
 // 按 Oid+Address+Direction 复合键冲突处理
 func (d *OrderAggregationDAO) BatchUpsert(aggs []*models.OrderAggregation) error {
-	db := gen.OrderAggregation.UnderlyingDB()
+	return upsertAggregations(gen.OrderAggregation.UnderlyingDB(), aggs)
+}
+
+// upsertAggregations 按 Oid+Address+Direction 复合键 upsert 订单聚合（可在事务中调用）
+func upsertAggregations(db *gorm.DB, aggs []*models.OrderAggregation) error {
 	return db.Clauses(clause.OnConflict{
 		Columns: []clause.Column{
 			{Name: "oid"},
@@ -107,7 +145,7 @@ func (d *OrderAggregationDAO) BatchUpsert(aggs []*models.OrderAggregation) error
 		},
 		DoUpdates: clause.AssignmentColumns([]string{
 			"symbol", "fills", "total_size", "weighted_avg_px",
-			"order_status", "last_fill_time", "updated_at", "signal_sent",
+			"mid_px", "slippage_bps", "order_status", "last_fill_time", "updated_at", "signal_sent",
 		}),
 	}).Create(aggs).Error
 }
@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"gorm.io/gorm/clause"
+
+	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+type OrderStatusMarkDAO struct{}
+
+var _orderStatusMark = &OrderStatusMarkDAO{}
+
+// OrderStatusMark 获取 OrderStatusMarkDAO 单例
+func OrderStatusMark() *OrderStatusMarkDAO {
+	return _orderStatusMark
+}
+
+// ListActive 获取未过期的订单状态标记（nowMs 毫秒）
+func (d *OrderStatusMarkDAO) ListActive(nowMs int64) ([]*models.HlOrderStatusMark, error) {
+	return gen.HlOrderStatusMark.Where(gen.HlOrderStatusMark.ExpiresAt.Gt(nowMs)).Find()
+}
+
+// BatchUpsert 批量写入订单状态标记，同一订单覆盖状态和过期时间
+func (d *OrderStatusMarkDAO) BatchUpsert(rows []*models.HlOrderStatusMark) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	db := gen.HlOrderStatusMark.UnderlyingDB()
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "address"}, {Name: "oid"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "expires_at", "updated_at"}),
+	}).CreateInBatches(rows, 100).Error
+}
+
+// DeleteOrders 删除订单状态标记（address -> oid 列表）
+func (d *OrderStatusMarkDAO) DeleteOrders(orders map[string][]int64) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	return gen.Q.Transaction(func(tx *gen.Query) error {
+		for address, oids := range orders {
+			if _, err := tx.HlOrderStatusMark.Where(
+				tx.HlOrderStatusMark.Address.Eq(address),
+				tx.HlOrderStatusMark.Oid.In(oids...),
+			).Delete(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteExpired 删除已过期的订单状态标记
+func (d *OrderStatusMarkDAO) DeleteExpired(nowMs int64) (int64, error) {
+	result, err := gen.HlOrderStatusMark.Where(gen.HlOrderStatusMark.ExpiresAt.Lte(nowMs)).Delete()
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected, nil
+}
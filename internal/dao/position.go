@@ -25,6 +25,7 @@ func (d *PositionDAO) UpsertPositionCache(cache *models.HlPositionCache) error {
 			"spot_balances", "spot_total_usd",
 			"futures_positions", "account_value",
 			"total_margin_used", "total_ntl_pos", "withdrawable",
+			"quote_currency", "spot_total_quote", "account_value_quote",
 			"updated_at",
 		}),
 	}).Create(cache).Error
@@ -38,7 +39,8 @@ func (d *PositionDAO) BatchUpsertPositionCache(caches []*models.HlPositionCache)
 		DoUpdates: clause.AssignmentColumns([]string{
 			"spot_balances", "spot_total_usd", "futures_positions",
 			"account_value", "total_margin_used", "total_ntl_pos",
-			"withdrawable", "updated_at",
+			"withdrawable", "quote_currency", "spot_total_quote",
+			"account_value_quote", "updated_at",
 		}),
 	}).Create(caches).Error
 }
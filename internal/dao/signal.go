@@ -3,6 +3,8 @@ package dao
 import (
 	"time"
 
+	"gorm.io/gorm"
+
 	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
 	"github.com/utrading/utrading-hl-monitor/internal/models"
 	"github.com/utrading/utrading-hl-monitor/internal/nats"
@@ -20,30 +22,134 @@ func Signal() *SignalDAO {
 // Create 保存信号到数据库
 // 将 NATS 的 HlAddressSignal 转换为数据库模型并保存
 func (d *SignalDAO) Create(natsSignal *nats.HlAddressSignal) error {
-	// 计算 7 天后过期时间
-	expiredAt := time.Now().AddDate(0, 0, 7)
-
-	dbSignal := &models.HlAddressSignal{
-		Address:      natsSignal.Address,
-		PositionRate: natsSignal.PositionRate,
-		CloseRate:    natsSignal.CloseRate,
-		Symbol:       natsSignal.Symbol,
-		AssetType:    natsSignal.AssetType,
-		Direction:    natsSignal.Direction,
-		Side:         natsSignal.Side,
-		Price:        natsSignal.Price,
-		Size:         natsSignal.Size,
-		CoinType:     natsSignal.CoinType,
-		ExpiredAt:    expiredAt,
+	return gen.HlAddressSignal.Create(toSignalModel(natsSignal))
+}
+
+// BatchCreate 批量保存信号（数据库恢复后补写缓冲的信号）
+func (d *SignalDAO) BatchCreate(natsSignals []*nats.HlAddressSignal) error {
+	if len(natsSignals) == 0 {
+		return nil
 	}
+	rows := make([]*models.HlAddressSignal, 0, len(natsSignals))
+	for _, s := range natsSignals {
+		rows = append(rows, toSignalModel(s))
+	}
+	return gen.HlAddressSignal.CreateInBatches(rows, 100)
+}
+
+// EnqueueOutbox 在同一事务中写入已发送状态的订单聚合和待发布信号（发件箱模式）
+// 事务提交后信号由分发器发布，事务失败时两者均未写入
+func (d *SignalDAO) EnqueueOutbox(agg *models.OrderAggregation, natsSignals []*nats.HlAddressSignal) error {
+	rows := make([]*models.HlAddressSignal, 0, len(natsSignals))
+	for _, s := range natsSignals {
+		payload, err := s.Marshal()
+		if err != nil {
+			return err
+		}
+		row := toSignalModel(s)
+		row.Payload = string(payload)
+		row.PublishedAt = nil
+		rows = append(rows, row)
+	}
+
+	db := gen.HlAddressSignal.UnderlyingDB().Session(&gorm.Session{NewDB: true})
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := upsertAggregations(tx, []*models.OrderAggregation{agg}); err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.Create(rows).Error
+	})
+}
+
+// ListUnpublished 按写入顺序获取待发布的发件箱信号（不含死信）
+func (d *SignalDAO) ListUnpublished(limit int) ([]*models.HlAddressSignal, error) {
+	return gen.HlAddressSignal.Where(
+		gen.HlAddressSignal.PublishedAt.IsNull(),
+		gen.HlAddressSignal.DeadLetteredAt.IsNull(),
+	).Order(gen.HlAddressSignal.ID).Limit(limit).Find()
+}
+
+// CountUnpublished 待发布的发件箱信号数（不含死信）
+func (d *SignalDAO) CountUnpublished() (int64, error) {
+	return gen.HlAddressSignal.Where(
+		gen.HlAddressSignal.PublishedAt.IsNull(),
+		gen.HlAddressSignal.DeadLetteredAt.IsNull(),
+	).Count()
+}
+
+// MarkPublished 标记发件箱信号已发布
+func (d *SignalDAO) MarkPublished(id uint, at time.Time) error {
+	_, err := gen.HlAddressSignal.Where(
+		gen.HlAddressSignal.ID.Eq(id),
+	).Update(gen.HlAddressSignal.PublishedAt, at)
+	return err
+}
+
+// IncPublishAttempts 增加发件箱信号的发布失败次数
+func (d *SignalDAO) IncPublishAttempts(id uint) error {
+	_, err := gen.HlAddressSignal.Where(
+		gen.HlAddressSignal.ID.Eq(id),
+	).UpdateSimple(gen.HlAddressSignal.PublishAttempts.Add(1))
+	return err
+}
 
-	return gen.HlAddressSignal.Create(dbSignal)
+// MarkDeadLettered 将发件箱信号转入死信，分发器不再发布
+func (d *SignalDAO) MarkDeadLettered(id uint, at time.Time) error {
+	_, err := gen.HlAddressSignal.Where(
+		gen.HlAddressSignal.ID.Eq(id),
+	).Update(gen.HlAddressSignal.DeadLetteredAt, at)
+	return err
+}
+
+// ListByIdempotencyKeys 按幂等键获取信号
+func (d *SignalDAO) ListByIdempotencyKeys(keys []string) ([]*models.HlAddressSignal, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	return gen.HlAddressSignal.Where(gen.HlAddressSignal.IdempotencyKey.In(keys...)).Find()
+}
+
+// DistinctScopes 获取指定时间之后写入的信号涉及的去重作用域
+func (d *SignalDAO) DistinctScopes(since time.Time) ([]string, error) {
+	var scopes []string
+	err := gen.HlAddressSignal.Where(
+		gen.HlAddressSignal.CreatedAt.Gte(since),
+	).Distinct(gen.HlAddressSignal.Scope).Pluck(gen.HlAddressSignal.Scope, &scopes)
+	return scopes, err
+}
+
+// toSignalModel 将 NATS 信号转换为数据库模型，7 天后过期
+// 非发件箱模式下信号先发布后写入，发布时间即写入时间
+func toSignalModel(natsSignal *nats.HlAddressSignal) *models.HlAddressSignal {
+	now := time.Now()
+	return &models.HlAddressSignal{
+		Address:        natsSignal.Address,
+		PositionRate:   natsSignal.PositionRate,
+		CloseRate:      natsSignal.CloseRate,
+		Symbol:         natsSignal.Symbol,
+		AssetType:      natsSignal.AssetType,
+		Direction:      natsSignal.Direction,
+		Side:           natsSignal.Side,
+		Price:          natsSignal.Price,
+		Size:           natsSignal.Size,
+		CoinType:       natsSignal.CoinType,
+		Scope:          natsSignal.Scope,
+		IdempotencyKey: natsSignal.IdempotencyKey,
+		TraceID:        natsSignal.TraceID,
+		PublishedAt:    &now,
+		ExpiredAt:      now.AddDate(0, 0, 7),
+	}
 }
 
 // DeleteOld 清理过期数据（早于指定时间的记录）
+// 未发布的发件箱信号（含死信）不清理
 func (d *SignalDAO) DeleteOld(before time.Time) (int64, error) {
 	result, err := gen.HlAddressSignal.Where(
 		gen.HlAddressSignal.CreatedAt.Lt(before),
+		gen.HlAddressSignal.PublishedAt.IsNotNull(),
 	).Delete()
 
 	if err != nil {
@@ -53,20 +159,33 @@ func (d *SignalDAO) DeleteOld(before time.Time) (int64, error) {
 	return result.RowsAffected, nil
 }
 
+// DeleteByAddress 删除指定地址的全部信号
+func (d *SignalDAO) DeleteByAddress(address string) (int64, error) {
+	result, err := gen.HlAddressSignal.Where(
+		gen.HlAddressSignal.Address.Eq(address),
+	).Delete()
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected, nil
+}
+
 // Count 统计信号总数
 func (d *SignalDAO) Count() (int64, error) {
 	return gen.HlAddressSignal.Count()
 }
 
-// DeleteOldest 删除最旧的 N 条记录
+// DeleteOldest 删除最旧的 N 条已发布记录，未发布的发件箱信号（含死信）不清理
 func (d *SignalDAO) DeleteOldest(limit int64) (int64, error) {
 	if limit <= 0 {
 		return 0, nil
 	}
+	published := gen.HlAddressSignal.PublishedAt.IsNotNull()
 
 	// 获取最旧记录的 ID 范围
 	var oldestID uint
-	err := gen.HlAddressSignal.Order(gen.HlAddressSignal.ID).
+	err := gen.HlAddressSignal.Where(published).
+		Order(gen.HlAddressSignal.ID).
 		Limit(1).
 		Select(gen.HlAddressSignal.ID).
 		Scan(&oldestID)
@@ -78,6 +197,7 @@ func (d *SignalDAO) DeleteOldest(limit int64) (int64, error) {
 	var cutoffID uint
 	err = gen.HlAddressSignal.Where(
 		gen.HlAddressSignal.ID.Gte(oldestID),
+		published,
 	).Order(gen.HlAddressSignal.ID).
 		Limit(1).
 		Offset(int(limit - 1)).
@@ -87,9 +207,10 @@ func (d *SignalDAO) DeleteOldest(limit int64) (int64, error) {
 		return 0, err
 	}
 
-	// 删除 ID <= cutoffID 的记录
+	// 删除 ID <= cutoffID 的已发布记录
 	result, err := gen.HlAddressSignal.Where(
 		gen.HlAddressSignal.ID.Lte(cutoffID),
+		published,
 	).Delete()
 	if err != nil {
 		return 0, err
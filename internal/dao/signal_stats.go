@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+type SignalStatsDAO struct{}
+
+var _signalStats = &SignalStatsDAO{}
+
+// SignalStats 获取 SignalStatsDAO 单例
+func SignalStats() *SignalStatsDAO {
+	return _signalStats
+}
+
+// Rollup 按 (address, symbol, asset_type, scope) 汇总 [from, to) 内写入的信号，覆盖 statDate 的统计，返回写入行数
+// 死信不计入；统计按原始信号重算，重复执行同一日期结果一致
+func (d *SignalStatsDAO) Rollup(statDate string, from, to time.Time) (int, error) {
+	var rows []*models.HlSignalDailyStats
+	err := gen.HlAddressSignal.UnderlyingDB().Model(&models.HlAddressSignal{}).
+		Select(`address, symbol, asset_type, scope,
+			COUNT(*) AS signal_count,
+			SUM(CASE WHEN direction = 'open' THEN 1 ELSE 0 END) AS open_count,
+			SUM(CASE WHEN direction = 'close' THEN 1 ELSE 0 END) AS close_count,
+			SUM(size) AS volume,
+			SUM(price * size) AS notional,
+			AVG(position_rate) AS avg_position_rate`).
+		Where("created_at >= ? AND created_at < ? AND dead_lettered_at IS NULL", from, to).
+		Group("address, symbol, asset_type, scope").
+		Scan(&rows).Error
+	if err != nil {
+		return 0, err
+	}
+	for _, row := range rows {
+		row.StatDate = statDate
+	}
+
+	return len(rows), d.ReplaceDate(statDate, rows)
+}
+
+// ReplaceDate 覆盖统计日期的全部记录
+func (d *SignalStatsDAO) ReplaceDate(statDate string, rows []*models.HlSignalDailyStats) error {
+	return gen.Q.Transaction(func(tx *gen.Query) error {
+		if _, err := tx.HlSignalDailyStats.Where(tx.HlSignalDailyStats.StatDate.Eq(statDate)).Delete(); err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.HlSignalDailyStats.CreateInBatches(rows, 100)
+	})
+}
+
+// ListRange 查询日期范围内（含首尾，YYYY-MM-DD）的统计，address 为空时查询全部地址
+func (d *SignalStatsDAO) ListRange(from, to, address string) ([]*models.HlSignalDailyStats, error) {
+	q := gen.HlSignalDailyStats
+	do := q.Where(q.StatDate.Gte(from), q.StatDate.Lte(to))
+	if address != "" {
+		do = do.Where(q.Address.Eq(address))
+	}
+	return do.Order(q.StatDate, q.Address, q.Symbol).Find()
+}
@@ -0,0 +1,191 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+// 资产类型（与信号 asset_type 一致）
+const (
+	AssetTypeFutures = "futures"
+	AssetTypeSpot    = "spot"
+)
+
+// spotDirections 现货订单聚合方向
+var spotDirections = []string{"Buy", "Sell"}
+
+type SymbolRenameDAO struct{}
+
+var _symbolRename = &SymbolRenameDAO{}
+
+// SymbolRename 获取 SymbolRenameDAO 单例
+func SymbolRename() *SymbolRenameDAO {
+	return _symbolRename
+}
+
+// ListAll 查询全部改名映射
+func (d *SymbolRenameDAO) ListAll() ([]*models.HlSymbolRename, error) {
+	q := gen.HlSymbolRename
+	return q.Order(q.ID).Find()
+}
+
+// CountRows 统计带旧 symbol 的历史记录数（按表名），不做改写
+func (d *SymbolRenameDAO) CountRows(assetType, oldSymbol string) (map[string]int64, error) {
+	counts := make(map[string]int64)
+	err := gen.Q.Transaction(func(tx *gen.Query) error {
+		return eachRenameScope(tx, assetType, oldSymbol, func(table string, db *gorm.DB) error {
+			var n int64
+			if err := db.Count(&n).Error; err != nil {
+				return err
+			}
+			counts[table] = n
+			return nil
+		})
+	})
+	return counts, err
+}
+
+// Apply 记录改名映射，并在同一事务中将历史记录的旧 symbol 改写为新 symbol，返回各表改写行数
+// 待发布信号的消息体同时改写；同一日期统计已有新 symbol 的记录时合并到新记录
+// 重复执行同一映射时只改写尚未改写的记录
+func (d *SymbolRenameDAO) Apply(assetType, oldSymbol, newSymbol string) (map[string]int64, error) {
+	counts := make(map[string]int64)
+	err := gen.Q.Transaction(func(tx *gen.Query) error {
+		s := tx.HlAddressSignal
+		// 消息体需在 symbol 改写前按旧 symbol 筛选
+		err := s.UnderlyingDB().Model(&models.HlAddressSignal{}).
+			Where("symbol = ? AND asset_type = ? AND published_at IS NULL AND payload <> ''", oldSymbol, assetType).
+			Update("payload", gorm.Expr("REPLACE(payload, ?, ?)", `"symbol":"`+oldSymbol+`"`, `"symbol":"`+newSymbol+`"`)).Error
+		if err != nil {
+			return err
+		}
+
+		err = eachRenameScope(tx, assetType, oldSymbol, func(table string, db *gorm.DB) error {
+			// 每日统计有含 symbol 的唯一索引，逐条合并
+			if table == (models.HlSignalDailyStats{}).TableName() {
+				n, err := mergeRenamedStats(tx, assetType, oldSymbol, newSymbol)
+				counts[table] = n
+				return err
+			}
+			result := db.Update("symbol", newSymbol)
+			counts[table] = result.RowsAffected
+			return result.Error
+		})
+		if err != nil {
+			return err
+		}
+
+		var total int64
+		for _, n := range counts {
+			total += n
+		}
+		return upsertRename(tx, assetType, oldSymbol, newSymbol, total)
+	})
+	return counts, err
+}
+
+// eachRenameScope 依次对各表中属于该资产类型且 symbol 为旧值的记录调用 fn
+// 订单聚合没有资产类型字段，按方向区分；挂单按原始资产名区分（现货为 @index 或 BASE/QUOTE）
+func eachRenameScope(tx *gen.Query, assetType, oldSymbol string, fn func(table string, db *gorm.DB) error) error {
+	spot := assetType == AssetTypeSpot
+
+	aggs := tx.OrderAggregation.UnderlyingDB().Model(&models.OrderAggregation{}).Where("symbol = ?", oldSymbol)
+	if spot {
+		aggs = aggs.Where("direction IN ?", spotDirections)
+	} else {
+		aggs = aggs.Where("direction NOT IN ?", spotDirections)
+	}
+
+	orders := tx.HlOpenOrder.UnderlyingDB().Model(&models.HlOpenOrder{}).Where("symbol = ?", oldSymbol)
+	if spot {
+		orders = orders.Where("(coin LIKE '@%' OR coin LIKE '%/%')")
+	} else {
+		orders = orders.Where("coin NOT LIKE '@%' AND coin NOT LIKE '%/%'")
+	}
+
+	scopes := []struct {
+		table string
+		db    *gorm.DB
+	}{
+		{models.HlAddressSignal{}.TableName(), tx.HlAddressSignal.UnderlyingDB().Model(&models.HlAddressSignal{}).
+			Where("symbol = ? AND asset_type = ?", oldSymbol, assetType)},
+		{models.OrderAggregation{}.TableName(), aggs},
+		{models.HlOpenOrder{}.TableName(), orders},
+		{models.HlSignalDailyStats{}.TableName(), tx.HlSignalDailyStats.UnderlyingDB().Model(&models.HlSignalDailyStats{}).
+			Where("symbol = ? AND asset_type = ?", oldSymbol, assetType)},
+	}
+	for _, scope := range scopes {
+		if err := fn(scope.table, scope.db); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeRenamedStats 改写每日统计的 symbol，同一 (日期, 地址, 作用域) 已有新 symbol 的记录时合并计数后删除旧记录
+func mergeRenamedStats(tx *gen.Query, assetType, oldSymbol, newSymbol string) (int64, error) {
+	q := tx.HlSignalDailyStats
+	rows, err := q.Where(q.Symbol.Eq(oldSymbol), q.AssetType.Eq(assetType)).Find()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, row := range rows {
+		target, err := q.Where(q.StatDate.Eq(row.StatDate), q.Address.Eq(row.Address), q.Symbol.Eq(newSymbol),
+			q.AssetType.Eq(assetType), q.Scope.Eq(row.Scope)).Take()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if _, err = q.Where(q.ID.Eq(row.ID)).UpdateSimple(q.Symbol.Value(newSymbol)); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		if count := target.SignalCount + row.SignalCount; count > 0 {
+			target.AvgPositionRate = (target.AvgPositionRate*float64(target.SignalCount) + row.AvgPositionRate*float64(row.SignalCount)) / float64(count)
+		}
+		target.SignalCount += row.SignalCount
+		target.OpenCount += row.OpenCount
+		target.CloseCount += row.CloseCount
+		target.Volume += row.Volume
+		target.Notional += row.Notional
+		if err = q.Save(target); err != nil {
+			return 0, err
+		}
+		if _, err = q.Where(q.ID.Eq(row.ID)).Delete(); err != nil {
+			return 0, err
+		}
+	}
+	return int64(len(rows)), nil
+}
+
+// upsertRename 写入或更新改名映射
+func upsertRename(tx *gen.Query, assetType, oldSymbol, newSymbol string, rewritten int64) error {
+	q := tx.HlSymbolRename
+	now := time.Now()
+	rename, err := q.Where(q.AssetType.Eq(assetType), q.OldSymbol.Eq(oldSymbol)).Take()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return q.Create(&models.HlSymbolRename{
+			AssetType:     assetType,
+			OldSymbol:     oldSymbol,
+			NewSymbol:     newSymbol,
+			RewrittenRows: rewritten,
+			AppliedAt:     &now,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	rename.NewSymbol = newSymbol
+	rename.RewrittenRows += rewritten
+	rename.AppliedAt = &now
+	return q.Save(rename)
+}
@@ -1,7 +1,12 @@
 package dao
 
 import (
+	"strings"
+
+	"gorm.io/gorm/clause"
+
 	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
 )
 
 type WatchAddressDAO struct{}
@@ -22,3 +27,63 @@ func (d *WatchAddressDAO) ListDistinctAddresses() ([]string, error) {
 		Scan(&addresses)
 	return addresses, err
 }
+
+// ListAggregations 获取设置了订单聚合策略的地址（小写地址 -> 策略名）
+// 同一地址被多个玩家设置了不同策略时，以最近更新的记录为准
+func (d *WatchAddressDAO) ListAggregations() (map[string]string, error) {
+	q := gen.HlWatchAddress
+	rows, err := q.Select(q.Address, q.Aggregation).
+		Where(q.Aggregation.Neq("")).
+		Order(q.UpdatedAt, q.ID).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(rows))
+	for _, row := range rows {
+		result[strings.ToLower(row.Address)] = row.Aggregation
+	}
+	return result, nil
+}
+
+// ListSignalFilters 获取设置了信号过滤的地址（小写地址 -> 过滤配置）
+// 同一地址被多个玩家设置了不同过滤时，以最近更新的记录为准
+func (d *WatchAddressDAO) ListSignalFilters() (map[string]string, error) {
+	q := gen.HlWatchAddress
+	rows, err := q.Select(q.Address, q.SignalFilter).
+		Where(q.SignalFilter.Neq("")).
+		Order(q.UpdatedAt, q.ID).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(rows))
+	for _, row := range rows {
+		result[strings.ToLower(row.Address)] = row.SignalFilter
+	}
+	return result, nil
+}
+
+// ListAll 获取全部监控地址（按玩家、地址排序，用于导出）
+func (d *WatchAddressDAO) ListAll() ([]*models.HlWatchAddress, error) {
+	q := gen.HlWatchAddress
+	return q.Order(q.PlayerID, q.Address).Find()
+}
+
+// BatchUpsert 按 (player_id, address) 写入监控地址，已存在（含已软删除）的记录覆盖昵称、标签、渠道偏好、备注、聚合策略和信号过滤并恢复
+func (d *WatchAddressDAO) BatchUpsert(rows []*models.HlWatchAddress) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	db := gen.HlWatchAddress.UnderlyingDB()
+	return db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "player_id"}, {Name: "address"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"nickname", "is_system", "tags", "channels", "comment", "aggregation", "signal_filter",
+			"updated_at", "deleted_at",
+		}),
+	}).CreateInBatches(rows, 100).Error
+}
@@ -0,0 +1,179 @@
+package leader
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// 实例角色
+const (
+	RoleLeader  = "leader"
+	RoleStandby = "standby"
+)
+
+// LeaseStore 租约存储接口
+type LeaseStore interface {
+	TryAcquire(name, holder string, ttl time.Duration) (bool, error)
+	Release(name, holder string) error
+}
+
+// Elector 主备选举器
+// 定期续约/抢占数据库租约，持有租约的实例为主实例，其余实例为热备
+type Elector struct {
+	store      LeaseStore
+	name       string
+	instanceID string
+	ttl        time.Duration
+	interval   time.Duration
+
+	leader    atomic.Bool
+	lastRenew time.Time // 仅在选举协程中访问
+
+	onChange func(isLeader bool)
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewElector 创建主备选举器
+func NewElector(cfg config.HA) *Elector {
+	instanceID := cfg.InstanceID
+	if instanceID == "" {
+		hostname, _ := os.Hostname()
+		instanceID = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
+
+	ttl := cfg.LeaseTTL
+	if ttl <= 0 {
+		ttl = 3 * time.Second
+	}
+	interval := cfg.RenewInterval
+	if interval <= 0 || interval >= ttl {
+		interval = ttl / 3
+	}
+
+	return &Elector{
+		store:      dao.LeaderLease(),
+		name:       cfg.LeaseName,
+		instanceID: instanceID,
+		ttl:        ttl,
+		interval:   interval,
+		done:       make(chan struct{}),
+	}
+}
+
+// SetStore 设置租约存储（可选，用于测试）
+func (e *Elector) SetStore(store LeaseStore) {
+	e.store = store
+}
+
+// OnChange 设置角色变更回调（需在 Start 前调用）
+func (e *Elector) OnChange(fn func(isLeader bool)) {
+	e.onChange = fn
+}
+
+// Start 启动选举循环
+func (e *Elector) Start() {
+	// 启动时立即尝试一次，主实例无需等待首个周期
+	e.tick(time.Now())
+
+	e.wg.Add(1)
+	goplus.Go(func() {
+		defer e.wg.Done()
+
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case now := <-ticker.C:
+				e.tick(now)
+			case <-e.done:
+				return
+			}
+		}
+	})
+
+	logger.Info().
+		Str("lease", e.name).
+		Str("instance_id", e.instanceID).
+		Dur("ttl", e.ttl).
+		Str("role", e.Role()).
+		Msg("leader elector started")
+}
+
+// Stop 停止选举，主实例主动释放租约以便备实例快速接管
+func (e *Elector) Stop() {
+	close(e.done)
+	e.wg.Wait()
+
+	if e.leader.Load() {
+		e.setLeader(false)
+		if err := e.store.Release(e.name, e.instanceID); err != nil {
+			logger.Error().Err(err).Str("lease", e.name).Msg("release leader lease failed")
+		}
+	}
+}
+
+// IsLeader 当前实例是否为主实例
+func (e *Elector) IsLeader() bool {
+	return e.leader.Load()
+}
+
+// Role 当前实例角色
+func (e *Elector) Role() string {
+	if e.IsLeader() {
+		return RoleLeader
+	}
+	return RoleStandby
+}
+
+// InstanceID 当前实例 ID
+func (e *Elector) InstanceID() string {
+	return e.instanceID
+}
+
+// tick 续约或抢占租约
+func (e *Elector) tick(now time.Time) {
+	acquired, err := e.store.TryAcquire(e.name, e.instanceID, e.ttl)
+	if err != nil {
+		logger.Warn().Err(err).Str("lease", e.name).Msg("renew leader lease failed")
+
+		// 无法确认租约时，超过有效期即主动降级，避免双主
+		if e.leader.Load() && now.Sub(e.lastRenew) >= e.ttl {
+			e.setLeader(false)
+		}
+		return
+	}
+
+	if acquired {
+		e.lastRenew = now
+	}
+	if acquired != e.leader.Load() {
+		e.setLeader(acquired)
+	}
+}
+
+func (e *Elector) setLeader(isLeader bool) {
+	e.leader.Store(isLeader)
+	monitor.SetLeader(isLeader)
+
+	logger.Info().
+		Str("lease", e.name).
+		Str("instance_id", e.instanceID).
+		Str("role", e.Role()).
+		Msg("leader role changed")
+
+	if e.onChange != nil {
+		e.onChange(isLeader)
+	}
+}
@@ -0,0 +1,109 @@
+package leader
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+)
+
+// mockLeaseStore 内存租约存储
+type mockLeaseStore struct {
+	mu        sync.Mutex
+	holder    string
+	expiresAt time.Time
+	err       error
+}
+
+func (s *mockLeaseStore) TryAcquire(_, holder string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return false, s.err
+	}
+	now := time.Now()
+	if s.holder == holder || s.holder == "" || now.After(s.expiresAt) {
+		s.holder = holder
+		s.expiresAt = now.Add(ttl)
+		return true, nil
+	}
+	return false, nil
+}
+
+func (s *mockLeaseStore) Release(_, holder string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.holder == holder {
+		s.expiresAt = time.Now().Add(-time.Second)
+	}
+	return nil
+}
+
+func newTestElector(store LeaseStore, id string) *Elector {
+	e := NewElector(config.HA{
+		LeaseName:     "test",
+		InstanceID:    id,
+		LeaseTTL:      time.Second,
+		RenewInterval: 50 * time.Millisecond,
+	})
+	e.SetStore(store)
+	return e
+}
+
+func TestElector_Failover(t *testing.T) {
+	store := &mockLeaseStore{}
+
+	primary := newTestElector(store, "primary")
+	primary.Start()
+	assert.True(t, primary.IsLeader())
+	assert.Equal(t, RoleLeader, primary.Role())
+
+	standby := newTestElector(store, "standby")
+	var (
+		changedMu sync.Mutex
+		changed   []bool
+	)
+	standby.OnChange(func(isLeader bool) {
+		changedMu.Lock()
+		changed = append(changed, isLeader)
+		changedMu.Unlock()
+	})
+	standby.Start()
+	defer standby.Stop()
+	assert.False(t, standby.IsLeader())
+	assert.Equal(t, RoleStandby, standby.Role())
+
+	// 主实例释放租约后备实例在一个续约周期内接管
+	primary.Stop()
+	assert.False(t, primary.IsLeader())
+	assert.Eventually(t, func() bool {
+		changedMu.Lock()
+		defer changedMu.Unlock()
+		return len(changed) == 1 && changed[0]
+	}, 500*time.Millisecond, 10*time.Millisecond)
+	assert.True(t, standby.IsLeader())
+}
+
+func TestElector_StepDownOnStoreError(t *testing.T) {
+	store := &mockLeaseStore{}
+	e := newTestElector(store, "primary")
+
+	now := time.Now()
+	e.tick(now)
+	assert.True(t, e.IsLeader())
+
+	// 存储不可用但租约未过期时保持主实例
+	store.err = errors.New("db down")
+	e.tick(now.Add(500 * time.Millisecond))
+	assert.True(t, e.IsLeader())
+
+	// 超过租约有效期后主动降级
+	e.tick(now.Add(time.Second))
+	assert.False(t, e.IsLeader())
+}
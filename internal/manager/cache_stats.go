@@ -0,0 +1,68 @@
+package manager
+
+import (
+	"sort"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// 缓存统计的名称
+const (
+	cacheStatsDeduper       = "deduper"
+	cacheStatsStatusTracker = "status_tracker"
+	cacheStatsPendingOrders = "pending_orders"
+)
+
+// CacheStats 获取去重器、订单状态追踪器和待处理订单缓存的统计信息
+// 包含条目数、命中率、最早条目年龄（秒）和内存估算，用于排查缓存增长和调整 TTL
+func (m *SubscriptionManager) CacheStats() map[string]map[string]any {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	stats := make(map[string]map[string]any, 3)
+	if m.deduper != nil {
+		stats[cacheStatsDeduper] = m.deduper.GetStats()
+	}
+	if m.orderProcessor != nil {
+		stats[cacheStatsPendingOrders], stats[cacheStatsStatusTracker] = m.orderProcessor.CacheStats()
+	}
+	return stats
+}
+
+// StartCacheStatsLog 按 interval 定期记录缓存统计（可选），Close/Shutdown 时停止
+func (m *SubscriptionManager) StartCacheStatsLog(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	goplus.Go(func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				m.logCacheStats()
+			case <-m.done:
+				return
+			}
+		}
+	})
+}
+
+// logCacheStats 每个缓存记录一条统计日志
+func (m *SubscriptionManager) logCacheStats() {
+	stats := m.CacheStats()
+	names := make([]string, 0, len(stats))
+	for name := range stats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		logger.Info().
+			Str("cache", name).
+			Fields(stats[name]).
+			Msg("cache stats")
+	}
+}
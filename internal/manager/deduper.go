@@ -12,10 +12,11 @@ import (
 )
 
 // OrderDeduper 订单去重器
-// 使用 cache.DedupCache 实现 TTL 自动过期
+// 使用 cache.DedupCache 实现分片增量过期和条目上限
 type OrderDeduper struct {
-	cache *cache.DedupCache
-	ttl   time.Duration
+	cache  *cache.DedupCache
+	ttl    time.Duration
+	scopes *cache.AddressScopes // 地址去重作用域（可选）
 }
 
 // NewOrderDeduper 创建订单去重器
@@ -35,14 +36,41 @@ func (d *OrderDeduper) dedupKey(address string, oid int64, direction string) str
 	return fmt.Sprintf("%s-%d-%s", address, oid, direction)
 }
 
-// IsSeen 检查订单是否已处理
+// SetMaxEntries 设置去重条目上限（0 表示不限制），超出时淘汰最久未访问的条目
+func (d *OrderDeduper) SetMaxEntries(maxEntries int) {
+	d.cache.SetMaxEntries(maxEntries)
+}
+
+// SetAddressScopes 设置地址去重作用域（可选）
+func (d *OrderDeduper) SetAddressScopes(scopes *cache.AddressScopes) {
+	d.scopes = scopes
+}
+
+// IsSeen 检查订单是否已处理，地址的所有作用域都已处理才返回 true
 func (d *OrderDeduper) IsSeen(address string, oid int64, direction string) bool {
-	return d.cache.IsSeen(address, oid, direction)
+	for _, scope := range d.scopes.Get(address) {
+		if !d.cache.IsSeenInScope(scope, address, oid, direction) {
+			return false
+		}
+	}
+	return true
 }
 
-// Mark 标记订单为已处理
+// Mark 在地址的所有作用域内标记订单为已处理
 func (d *OrderDeduper) Mark(address string, oid int64, direction string) {
-	d.cache.Mark(address, oid, direction)
+	for _, scope := range d.scopes.Get(address) {
+		d.cache.MarkInScope(scope, address, oid, direction)
+	}
+}
+
+// IsSeenInScope 检查订单在指定作用域内是否已处理
+func (d *OrderDeduper) IsSeenInScope(scope, address string, oid int64, direction string) bool {
+	return d.cache.IsSeenInScope(scope, address, oid, direction)
+}
+
+// MarkInScope 在指定作用域内标记订单为已处理
+func (d *OrderDeduper) MarkInScope(scope, address string, oid int64, direction string) {
+	d.cache.MarkInScope(scope, address, oid, direction)
 }
 
 // MarkFromAggregation 从 OrderAggregation 记录标记为已处理
@@ -61,10 +89,10 @@ func (d *OrderDeduper) LoadFromDB(daoOrder interface{}) error {
 	return d.cache.LoadFromDB(daoOrder)
 }
 
-// Close 关闭去重器
-// go-cache 不需要显式关闭，此方法为兼容性保留
+// Close 关闭去重器，停止后台过期清理
 func (d *OrderDeduper) Close() error {
-	logger.Debug().Msg("order deduper closed (no-op for go-cache)")
+	d.cache.Close()
+	logger.Debug().Msg("order deduper closed")
 	return nil
 }
 
@@ -72,8 +100,14 @@ func (d *OrderDeduper) Close() error {
 func (d *OrderDeduper) GetStats() map[string]any {
 	stats := d.cache.Stats()
 	return map[string]any{
-		"entries":     stats["item_count"],
-		"ttl_minutes": cast.ToInt64(d.ttl.Minutes()),
+		"entries":            stats["item_count"],
+		"ttl_minutes":        cast.ToInt64(d.ttl.Minutes()),
+		"max_entries":        stats["max_entries"],
+		"memory_bytes":       stats["memory_bytes"],
+		"hits":               stats["hits"],
+		"misses":             stats["misses"],
+		"hit_rate":           stats["hit_rate"],
+		"oldest_age_seconds": stats["oldest_age_seconds"],
 	}
 }
 
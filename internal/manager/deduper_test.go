@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
 	"github.com/utrading/utrading-hl-monitor/internal/models"
 )
 
@@ -28,6 +29,34 @@ func TestOrderDeduper_IsSeen(t *testing.T) {
 	assert.True(t, deduper.IsSeen(address, oid, direction))
 }
 
+// TestOrderDeduper_Scopes 测试多作用域去重
+func TestOrderDeduper_Scopes(t *testing.T) {
+	deduper := NewOrderDeduper(30 * time.Minute)
+	defer deduper.Close()
+
+	scopes := cache.NewAddressScopes()
+	scopes.Set("0xtest", []string{"1", "2"})
+	deduper.SetAddressScopes(scopes)
+
+	// 仅作用域 1 已发送，不能抑制作用域 2
+	deduper.MarkInScope("1", "0xtest", 123, "Open Long")
+	assert.False(t, deduper.IsSeen("0xtest", 123, "Open Long"))
+	assert.False(t, deduper.IsSeenInScope("2", "0xtest", 123, "Open Long"))
+
+	// 所有作用域均已发送
+	deduper.MarkInScope("2", "0xtest", 123, "Open Long")
+	assert.True(t, deduper.IsSeen("0xtest", 123, "Open Long"))
+
+	// Mark 标记地址的全部作用域
+	deduper.Mark("0xtest", 456, "Close Long")
+	assert.True(t, deduper.IsSeenInScope("1", "0xtest", 456, "Close Long"))
+	assert.True(t, deduper.IsSeenInScope("2", "0xtest", 456, "Close Long"))
+
+	// 未配置作用域的地址使用默认作用域
+	deduper.Mark("0xother", 1, "Open Long")
+	assert.True(t, deduper.IsSeen("0xother", 1, "Open Long"))
+}
+
 // TestOrderDeduper_MarkFromAggregation 测试从聚合记录标记
 func TestOrderDeduper_MarkFromAggregation(t *testing.T) {
 	deduper := NewOrderDeduper(30 * time.Minute)
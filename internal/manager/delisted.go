@@ -0,0 +1,63 @@
+package manager
+
+import (
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/internal/symbol"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// DelistedPublisher 资产下架通知发布接口
+type DelistedPublisher interface {
+	PublishAssetDelisted(event *nats.HlAssetDelisted) error
+}
+
+// SetDelistedPublisher 设置资产下架通知发布器（可选），资产下架时发布 hl.asset.delisted 通知
+func (m *SubscriptionManager) SetDelistedPublisher(publisher DelistedPublisher) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.delistedPublisher = publisher
+}
+
+// HandleAssetsDelisted 处理新下架的合约资产（symbol.Manager 的下架回调）
+// 下架资产不会再有后续成交，立即发送其待聚合订单，并发布一次下架通知（备实例只发送订单不发布通知）
+func (m *SubscriptionManager) HandleAssetsDelisted(assets []symbol.DelistedAsset) {
+	m.mu.RLock()
+	publisher := m.delistedPublisher
+	leader := m.leader
+	m.mu.RUnlock()
+
+	for _, asset := range assets {
+		flushed := m.orderProcessor.FlushSymbol(asset.Symbol)
+		logger.Info().
+			Str("coin", asset.Coin).
+			Str("symbol", asset.Symbol).
+			Int("flushed_orders", flushed).
+			Msg("flushed pending orders of delisted asset")
+
+		if publisher == nil {
+			continue
+		}
+		if leader != nil && !leader.IsLeader() {
+			monitor.IncSignalSuppressed()
+			continue
+		}
+
+		event := &nats.HlAssetDelisted{
+			AssetType:     "futures",
+			Coin:          asset.Coin,
+			Symbol:        asset.Symbol,
+			FlushedOrders: flushed,
+			Timestamp:     time.Now().UnixMilli(),
+			TraceID:       nats.NewTraceID(),
+		}
+		if err := publisher.PublishAssetDelisted(event); err != nil {
+			logger.Error().Err(err).Str("coin", asset.Coin).Msg("publish asset delisted event failed")
+			continue
+		}
+		logger.Info().Str("coin", asset.Coin).Str("symbol", asset.Symbol).
+			Str("trace_id", event.TraceID).Msg("asset delisted event published")
+	}
+}
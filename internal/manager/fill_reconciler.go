@@ -0,0 +1,309 @@
+package manager
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/spf13/cast"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// 成交对账差异来源
+const (
+	FillDiscrepancyAggregation = "aggregation" // 成交数量之和与订单聚合 TotalSize 不一致
+	FillDiscrepancySignal      = "signal"      // 成交数量之和与已发布信号 Size 不一致
+)
+
+// errFillReconcileStopped 对账器停止，本次对账未完成
+var errFillReconcileStopped = errors.New("fill reconciler stopped")
+
+// fillSignalKeyBatch 按幂等键查询信号的批大小
+const fillSignalKeyBatch = 500
+
+// FillReconcileStore 成交对账查询及差异写入接口
+type FillReconcileStore interface {
+	ListSentAggregations(from, to time.Time) ([]*models.OrderAggregation, error)
+	ListFills(address string, oids []int64) ([]*models.HlFill, error)
+	SignalScopes(since time.Time) ([]string, error)
+	ListSignals(idempotencyKeys []string) ([]*models.HlAddressSignal, error)
+	ReplaceDiscrepancies(auditDate string, rows []*models.HlFillDiscrepancy) error
+}
+
+// daoFillReconcileStore 基于 DAO 的成交对账存储
+type daoFillReconcileStore struct{}
+
+func (daoFillReconcileStore) ListSentAggregations(from, to time.Time) ([]*models.OrderAggregation, error) {
+	return dao.OrderAggregation().ListSentBetween(from, to)
+}
+
+func (daoFillReconcileStore) ListFills(address string, oids []int64) ([]*models.HlFill, error) {
+	return dao.Fill().ListByOrders(address, oids)
+}
+
+func (daoFillReconcileStore) SignalScopes(since time.Time) ([]string, error) {
+	return dao.Signal().DistinctScopes(since)
+}
+
+func (daoFillReconcileStore) ListSignals(idempotencyKeys []string) ([]*models.HlAddressSignal, error) {
+	return dao.Signal().ListByIdempotencyKeys(idempotencyKeys)
+}
+
+func (daoFillReconcileStore) ReplaceDiscrepancies(auditDate string, rows []*models.HlFillDiscrepancy) error {
+	return dao.FillDiscrepancy().ReplaceDate(auditDate, rows)
+}
+
+// FillReconcileResult 一次成交对账的结果
+type FillReconcileResult struct {
+	AuditDate     string
+	Checked       int // 核对的已发送订单聚合数
+	Discrepancies []*models.HlFillDiscrepancy
+}
+
+// fillSizeKey 订单 + 方向（反手成交拆分后的方向）
+type fillSizeKey struct {
+	oid       int64
+	direction string
+}
+
+// signalRef 幂等键对应的订单聚合及作用域
+type signalRef struct {
+	agg      *models.OrderAggregation
+	fillSize float64
+	scope    string
+}
+
+// FillReconciler 成交对账器（复式核对）
+// 每日 run_hour（UTC）核对前一自然日已发送信号的订单聚合：按 (address, oid, direction) 汇总 hl_fills 的成交数量，
+// 反手成交按与订阅管理器相同的规则拆分为平仓和开仓两部分，分别与聚合 TotalSize 和各作用域已发布信号的 Size 比较，
+// 差异写入 hl_fill_discrepancies 并计入指标
+type FillReconciler struct {
+	store FillReconcileStore
+	cfg   config.FillReconcile
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewFillReconciler 创建成交对账器
+func NewFillReconciler(cfg config.FillReconcile) *FillReconciler {
+	return &FillReconciler{
+		store: daoFillReconcileStore{},
+		cfg:   cfg,
+		done:  make(chan struct{}),
+	}
+}
+
+// SetStore 设置存储（可选，用于测试）
+func (r *FillReconciler) SetStore(store FillReconcileStore) {
+	r.store = store
+}
+
+// Start 启动每日对账
+func (r *FillReconciler) Start() {
+	r.wg.Add(1)
+	goplus.Go(func() {
+		defer r.wg.Done()
+
+		for {
+			next := r.nextRun(time.Now())
+			timer := time.NewTimer(time.Until(next))
+			select {
+			case <-timer.C:
+				r.runScheduled(next)
+			case <-r.done:
+				timer.Stop()
+				return
+			}
+		}
+	})
+
+	logger.Info().
+		Int("run_hour", r.cfg.RunHour).
+		Float64("tolerance", r.cfg.Tolerance).
+		Time("next_run", r.nextRun(time.Now())).
+		Msg("fill reconciler started")
+}
+
+// Stop 停止对账，正在执行的对账完成当前地址后退出
+func (r *FillReconciler) Stop() {
+	close(r.done)
+	r.wg.Wait()
+}
+
+// nextRun 下一次执行时间（UTC run_hour 整点）
+func (r *FillReconciler) nextRun(now time.Time) time.Time {
+	now = now.UTC()
+	next := time.Date(now.Year(), now.Month(), now.Day(), r.cfg.RunHour, 0, 0, 0, time.UTC)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// runScheduled 执行 runAt 前一自然日的对账
+func (r *FillReconciler) runScheduled(runAt time.Time) {
+	result, err := r.Reconcile(runAt.UTC().AddDate(0, 0, -1))
+	if err != nil {
+		logger.Error().Err(err).Str("audit_date", result.AuditDate).Msg("fill reconcile failed")
+		return
+	}
+
+	event := logger.Info()
+	if len(result.Discrepancies) > 0 {
+		event = logger.Warn()
+	}
+	event.Str("audit_date", result.AuditDate).
+		Int("checked", result.Checked).
+		Int("discrepancies", len(result.Discrepancies)).
+		Msg("fill reconcile finished")
+}
+
+// Reconcile 核对 date 所在 UTC 自然日（按聚合最后成交时间）已发送信号的订单聚合，并覆盖写入该日的差异
+func (r *FillReconciler) Reconcile(date time.Time) (FillReconcileResult, error) {
+	date = date.UTC()
+	from := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	result := FillReconcileResult{AuditDate: from.Format(pnlDateLayout)}
+
+	aggs, err := r.store.ListSentAggregations(from, from.AddDate(0, 0, 1))
+	if err != nil {
+		return result, err
+	}
+	scopes, err := r.store.SignalScopes(from)
+	if err != nil {
+		return result, err
+	}
+
+	// 按地址批量查询成交，与聚合 TotalSize 比较
+	byAddress := make(map[string][]*models.OrderAggregation)
+	var addresses []string
+	for _, agg := range aggs {
+		if _, ok := byAddress[agg.Address]; !ok {
+			addresses = append(addresses, agg.Address)
+		}
+		byAddress[agg.Address] = append(byAddress[agg.Address], agg)
+	}
+
+	refs := make(map[string]signalRef)
+	for _, address := range addresses {
+		select {
+		case <-r.done:
+			return result, errFillReconcileStopped
+		default:
+		}
+
+		addrAggs := byAddress[address]
+		var oids []int64
+		for _, agg := range addrAggs {
+			oids = append(oids, aggregationOids(agg)...)
+		}
+		fills, err := r.store.ListFills(address, oids)
+		if err != nil {
+			return result, err
+		}
+		sizes := fillSizes(fills)
+
+		for _, agg := range addrAggs {
+			result.Checked++
+			var fillSize float64
+			for _, oid := range aggregationOids(agg) {
+				fillSize += sizes[fillSizeKey{oid: oid, direction: agg.Direction}]
+			}
+			if r.mismatch(fillSize, agg.TotalSize) {
+				result.Discrepancies = append(result.Discrepancies,
+					newFillDiscrepancy(result.AuditDate, agg, FillDiscrepancyAggregation, "", fillSize, agg.TotalSize))
+			}
+			for _, scope := range scopes {
+				key := nats.IdempotencyKey(agg.Address, agg.Oid, agg.Direction, scope)
+				refs[key] = signalRef{agg: agg, fillSize: fillSize, scope: scope}
+			}
+		}
+	}
+
+	// 按幂等键查询各作用域已发布的信号，与成交数量比较
+	keys := make([]string, 0, len(refs))
+	for key := range refs {
+		keys = append(keys, key)
+	}
+	reported := make(map[string]bool) // 同一幂等键重复写入的信号只记录一次
+	for start := 0; start < len(keys); start += fillSignalKeyBatch {
+		signals, err := r.store.ListSignals(keys[start:min(start+fillSignalKeyBatch, len(keys))])
+		if err != nil {
+			return result, err
+		}
+		for _, signal := range signals {
+			ref, ok := refs[signal.IdempotencyKey]
+			if !ok || reported[signal.IdempotencyKey] || !r.mismatch(ref.fillSize, signal.Size) {
+				continue
+			}
+			reported[signal.IdempotencyKey] = true
+			result.Discrepancies = append(result.Discrepancies,
+				newFillDiscrepancy(result.AuditDate, ref.agg, FillDiscrepancySignal, ref.scope, ref.fillSize, signal.Size))
+		}
+	}
+
+	if err := r.store.ReplaceDiscrepancies(result.AuditDate, result.Discrepancies); err != nil {
+		return result, err
+	}
+
+	counts := make(map[string]int)
+	for _, d := range result.Discrepancies {
+		counts[d.Kind]++
+	}
+	monitor.RecordFillReconcile(result.Checked, counts)
+	return result, nil
+}
+
+// mismatch 成交数量与记录数量的差超过容忍度
+func (r *FillReconciler) mismatch(fillSize, recorded float64) bool {
+	return math.Abs(fillSize-recorded) > r.cfg.Tolerance
+}
+
+// aggregationOids 订单聚合涉及的全部 oid（按 cloid 聚合时包含多个订单）
+func aggregationOids(agg *models.OrderAggregation) []int64 {
+	oids := []int64{agg.Oid}
+	seen := map[int64]struct{}{agg.Oid: {}}
+	for _, fill := range agg.Fills {
+		if _, ok := seen[fill.Oid]; ok {
+			continue
+		}
+		seen[fill.Oid] = struct{}{}
+		oids = append(oids, fill.Oid)
+	}
+	return oids
+}
+
+// fillSizes 按订单和方向汇总原始成交数量，反手成交拆分为平仓和开仓两部分
+func fillSizes(fills []*models.HlFill) map[fillSizeKey]float64 {
+	sizes := make(map[fillSizeKey]float64)
+	for _, fill := range fills {
+		for dir, parts := range splitReversedOrder([]hl.WsOrderFill{fill.Raw}) {
+			for _, part := range parts {
+				sizes[fillSizeKey{oid: fill.Oid, direction: dir}] += cast.ToFloat64(part.Sz)
+			}
+		}
+	}
+	return sizes
+}
+
+func newFillDiscrepancy(auditDate string, agg *models.OrderAggregation, kind, scope string, fillSize, recorded float64) *models.HlFillDiscrepancy {
+	return &models.HlFillDiscrepancy{
+		AuditDate:    auditDate,
+		Address:      agg.Address,
+		Oid:          agg.Oid,
+		Direction:    agg.Direction,
+		Kind:         kind,
+		Scope:        scope,
+		FillSize:     fillSize,
+		RecordedSize: recorded,
+	}
+}
@@ -0,0 +1,133 @@
+package manager
+
+import (
+	"testing"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+)
+
+type mockFillReconcileStore struct {
+	aggs     []*models.OrderAggregation
+	fills    map[string][]*models.HlFill
+	scopes   []string
+	signals  []*models.HlAddressSignal
+	from, to time.Time
+	date     string
+	rows     []*models.HlFillDiscrepancy
+}
+
+func (s *mockFillReconcileStore) ListSentAggregations(from, to time.Time) ([]*models.OrderAggregation, error) {
+	s.from, s.to = from, to
+	return s.aggs, nil
+}
+
+func (s *mockFillReconcileStore) ListFills(address string, oids []int64) ([]*models.HlFill, error) {
+	var fills []*models.HlFill
+	for _, f := range s.fills[address] {
+		for _, oid := range oids {
+			if f.Oid == oid {
+				fills = append(fills, f)
+				break
+			}
+		}
+	}
+	return fills, nil
+}
+
+func (s *mockFillReconcileStore) SignalScopes(since time.Time) ([]string, error) {
+	return s.scopes, nil
+}
+
+func (s *mockFillReconcileStore) ListSignals(keys []string) ([]*models.HlAddressSignal, error) {
+	var signals []*models.HlAddressSignal
+	for _, signal := range s.signals {
+		for _, key := range keys {
+			if signal.IdempotencyKey == key {
+				signals = append(signals, signal)
+				break
+			}
+		}
+	}
+	return signals, nil
+}
+
+func (s *mockFillReconcileStore) ReplaceDiscrepancies(auditDate string, rows []*models.HlFillDiscrepancy) error {
+	s.date, s.rows = auditDate, rows
+	return nil
+}
+
+func reconcileFill(tid, oid int64, dir, sz, startPos string) *models.HlFill {
+	return &models.HlFill{Tid: tid, Oid: oid, Dir: dir, Raw: hl.WsOrderFill{Tid: tid, Oid: oid, Dir: dir, Sz: sz, StartPosition: startPos}}
+}
+
+func TestFillReconciler_Reconcile(t *testing.T) {
+	store := &mockFillReconcileStore{
+		aggs: []*models.OrderAggregation{
+			// 一致
+			{Address: "0xa", Oid: 1, Direction: "Open Long", TotalSize: 1.5},
+			// 信号发送后迟到的成交
+			{Address: "0xa", Oid: 2, Direction: "Close Long", TotalSize: 1},
+			// 反手成交拆分：平仓 2（开仓前仓位），开仓 3
+			{Address: "0xb", Oid: 3, Direction: "Close Long", TotalSize: 2},
+			{Address: "0xb", Oid: 3, Direction: "Open Short", TotalSize: 3},
+			// 按 cloid 聚合的多个订单
+			{Address: "0xb", Oid: 4, Direction: "Buy", TotalSize: 5, Fills: []hl.WsOrderFill{{Oid: 4}, {Oid: 5}}},
+		},
+		fills: map[string][]*models.HlFill{
+			"0xa": {
+				reconcileFill(1, 1, "Open Long", "1", "0"),
+				reconcileFill(2, 1, "Open Long", "0.5", "1"),
+				reconcileFill(3, 2, "Close Long", "1", "1.5"),
+				reconcileFill(4, 2, "Close Long", "0.5", "0.5"),
+			},
+			"0xb": {
+				reconcileFill(5, 3, "Long > Short", "5", "2"),
+				reconcileFill(6, 4, "Buy", "2", "0"),
+				reconcileFill(7, 5, "Buy", "3", "2"),
+			},
+		},
+		scopes: []string{"", "server-a"},
+		signals: []*models.HlAddressSignal{
+			{IdempotencyKey: nats.IdempotencyKey("0xa", 1, "Open Long", ""), Size: 1.5},
+			{IdempotencyKey: nats.IdempotencyKey("0xa", 1, "Open Long", "server-a"), Size: 1},
+			{IdempotencyKey: nats.IdempotencyKey("0xa", 1, "Open Long", "server-a"), Size: 1},
+			{IdempotencyKey: nats.IdempotencyKey("0xb", 3, "Open Short", ""), Size: 3},
+		},
+	}
+	r := NewFillReconciler(config.FillReconcile{Tolerance: 1e-6})
+	r.SetStore(store)
+
+	result, err := r.Reconcile(time.Date(2026, 3, 1, 15, 0, 0, 0, time.FixedZone("UTC+8", 8*3600)))
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03-01", result.AuditDate)
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), store.from)
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), store.to)
+	assert.Equal(t, 5, result.Checked)
+
+	assert.Equal(t, "2026-03-01", store.date)
+	require.Len(t, store.rows, 2)
+	assert.Equal(t, &models.HlFillDiscrepancy{
+		AuditDate: "2026-03-01", Address: "0xa", Oid: 2, Direction: "Close Long",
+		Kind: FillDiscrepancyAggregation, FillSize: 1.5, RecordedSize: 1,
+	}, store.rows[0])
+	assert.Equal(t, &models.HlFillDiscrepancy{
+		AuditDate: "2026-03-01", Address: "0xa", Oid: 1, Direction: "Open Long",
+		Kind: FillDiscrepancySignal, Scope: "server-a", FillSize: 1.5, RecordedSize: 1,
+	}, store.rows[1])
+}
+
+func TestFillReconciler_NextRun(t *testing.T) {
+	r := NewFillReconciler(config.FillReconcile{RunHour: 1})
+
+	assert.Equal(t, time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC),
+		r.nextRun(time.Date(2026, 3, 1, 1, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2026, 3, 1, 1, 0, 0, 0, time.UTC),
+		r.nextRun(time.Date(2026, 3, 1, 0, 59, 0, 0, time.UTC)))
+}
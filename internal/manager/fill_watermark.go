@@ -0,0 +1,166 @@
+package manager
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// WatermarkStore 成交高水位持久化接口
+type WatermarkStore interface {
+	ListAll() ([]*models.HlFillWatermark, error)
+	BatchUpsert(marks map[string]int64) error
+}
+
+// fillWatermark 单个地址的成交高水位
+type fillWatermark struct {
+	fillTime int64 // 已处理的最新成交时间（毫秒）
+	dirty    bool  // 是否待持久化
+}
+
+// FillWatermarks 地址成交高水位
+// 记录每个地址已处理的最新成交时间并定期持久化；重启后订阅快照中早于高水位的成交视为重放直接跳过，
+// 与去重器从数据库加载的已发送订单互为补充（后者只覆盖已发送信号的订单）
+type FillWatermarks struct {
+	store    WatermarkStore
+	interval time.Duration
+
+	marks map[string]*fillWatermark
+	mu    sync.Mutex
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewFillWatermarks 创建成交高水位
+func NewFillWatermarks(cfg config.FillWatermark) *FillWatermarks {
+	interval := cfg.FlushInterval
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &FillWatermarks{
+		store:    dao.FillWatermark(),
+		interval: interval,
+		marks:    make(map[string]*fillWatermark),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// SetStore 设置持久化存储（可选，用于测试）
+func (w *FillWatermarks) SetStore(store WatermarkStore) {
+	w.store = store
+}
+
+// Start 从数据库加载高水位并启动定期持久化，需在地址加载器启动前调用
+func (w *FillWatermarks) Start() error {
+	rows, err := w.store.ListAll()
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	for _, row := range rows {
+		w.marks[row.Address] = &fillWatermark{fillTime: row.LastFillTime}
+	}
+	w.mu.Unlock()
+
+	w.wg.Add(1)
+	goplus.Go(func() {
+		defer w.wg.Done()
+		w.run()
+	})
+
+	logger.Info().Int("loaded", len(rows)).Dur("flush_interval", w.interval).Msg("fill watermarks started")
+	return nil
+}
+
+// Stop 停止定期持久化并写入剩余变更
+func (w *FillWatermarks) Stop() {
+	w.cancel()
+	w.wg.Wait()
+	w.flush()
+}
+
+func (w *FillWatermarks) run() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+			w.flush()
+		}
+	}
+}
+
+// Replayed 成交是否早于地址的高水位（已处理过的重放成交），未设置时返回 false
+func (w *FillWatermarks) Replayed(address string, fillTime int64) bool {
+	if w == nil {
+		return false
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	mark, ok := w.marks[address]
+	return ok && fillTime < mark.fillTime
+}
+
+// Advance 推进地址的高水位，早于当前高水位的成交不生效
+func (w *FillWatermarks) Advance(address string, fillTime int64) {
+	if w == nil {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	mark, ok := w.marks[address]
+	if !ok {
+		w.marks[address] = &fillWatermark{fillTime: fillTime, dirty: true}
+		return
+	}
+	if fillTime > mark.fillTime {
+		mark.fillTime = fillTime
+		mark.dirty = true
+	}
+}
+
+// flush 持久化变更的高水位，失败时下次重试
+func (w *FillWatermarks) flush() {
+	pending := make(map[string]int64)
+
+	w.mu.Lock()
+	for addr, mark := range w.marks {
+		if mark.dirty {
+			pending[addr] = mark.fillTime
+			mark.dirty = false
+		}
+	}
+	w.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	if err := w.store.BatchUpsert(pending); err != nil {
+		logger.Error().Err(err).Int("count", len(pending)).Msg("persist fill watermarks failed")
+
+		w.mu.Lock()
+		for addr := range pending {
+			if mark, ok := w.marks[addr]; ok {
+				mark.dirty = true
+			}
+		}
+		w.mu.Unlock()
+	}
+}
@@ -0,0 +1,91 @@
+package manager
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/processor"
+)
+
+type mockWatermarkStore struct {
+	rows   []*models.HlFillWatermark
+	writes []map[string]int64
+	err    error
+}
+
+func (s *mockWatermarkStore) ListAll() ([]*models.HlFillWatermark, error) { return s.rows, nil }
+
+func (s *mockWatermarkStore) BatchUpsert(marks map[string]int64) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.writes = append(s.writes, marks)
+	return nil
+}
+
+func TestFillWatermarks(t *testing.T) {
+	store := &mockWatermarkStore{rows: []*models.HlFillWatermark{{Address: "0xa", LastFillTime: 1000}}}
+	w := NewFillWatermarks(config.FillWatermark{FlushInterval: time.Hour})
+	w.SetStore(store)
+	require.NoError(t, w.Start())
+
+	assert.True(t, w.Replayed("0xa", 999))
+	assert.False(t, w.Replayed("0xa", 1000))
+	assert.False(t, w.Replayed("0xb", 1))
+
+	// 只向前推进
+	w.Advance("0xa", 900)
+	w.Advance("0xa", 1500)
+	w.Advance("0xb", 200)
+	assert.True(t, w.Replayed("0xa", 1400))
+
+	// 写入失败时下次重试
+	store.err = errors.New("db down")
+	w.flush()
+	assert.Empty(t, store.writes)
+
+	store.err = nil
+	w.Stop()
+	require.Len(t, store.writes, 1)
+	assert.Equal(t, map[string]int64{"0xa": 1500, "0xb": 200}, store.writes[0])
+
+	// 未设置时不跳过任何成交
+	var none *FillWatermarks
+	assert.False(t, none.Replayed("0xa", 1))
+	none.Advance("0xa", 1)
+}
+
+func TestSubscriptionManager_SkipsReplayedSnapshotFills(t *testing.T) {
+	now := time.Now().UnixMilli()
+	w := NewFillWatermarks(config.FillWatermark{})
+	w.SetStore(&mockWatermarkStore{rows: []*models.HlFillWatermark{{Address: "0xa", LastFillTime: now - 1000}}})
+	require.NoError(t, w.Start())
+	defer w.Stop()
+
+	queue := &recordingQueue{}
+	m := &SubscriptionManager{messageQueue: queue, deduper: NewOrderDeduper(time.Minute)}
+	m.SetFillWatermarks(w)
+
+	fill := func(oid, t int64) hl.WsOrderFill {
+		return hl.WsOrderFill{Coin: "BTC", Oid: oid, Tid: oid, Sz: "1", Px: "100", Dir: DirOpenLong, Time: t}
+	}
+
+	// 快照中早于高水位的成交跳过
+	m.handleWsOrderFills(hl.WsOrderFills{IsSnapshot: true, User: "0xa", Fills: []hl.WsOrderFill{
+		fill(1, now-2000), fill(2, now-500),
+	}})
+	require.Len(t, queue.messages, 1)
+	assert.Equal(t, int64(2), queue.messages[0].(processor.OrderFillMessage).Fill.(hl.WsOrderFill).Oid)
+
+	// 实时推送不受高水位影响
+	m.handleWsOrderFills(hl.WsOrderFills{User: "0xa", Fills: []hl.WsOrderFill{fill(3, now-3000)}})
+	assert.Len(t, queue.messages, 2)
+	assert.True(t, w.Replayed("0xa", now-600))
+}
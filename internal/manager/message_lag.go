@@ -0,0 +1,31 @@
+package manager
+
+import (
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/internal/ws"
+)
+
+// observeFillsLag 记录最新一笔成交时间到本地接收的延迟（上游推送延迟）
+// 订阅快照为历史成交，离线注入/回放的消息没有接收时间，均不记录
+func observeFillsLag(fills hl.WsOrderFills, receivedAt time.Time) {
+	if receivedAt.IsZero() || fills.IsSnapshot || len(fills.Fills) == 0 {
+		return
+	}
+	var latest int64
+	for _, fill := range fills.Fills {
+		latest = max(latest, fill.Time)
+	}
+	monitor.ObserveMessageLag(string(ws.ChannelUserFills), fills.User, receivedAt.Sub(time.UnixMilli(latest)))
+}
+
+// observeWebData2Lag 记录 webData2 serverTime 到本地接收的延迟
+func observeWebData2Lag(webdata2 hl.WebData2, receivedAt time.Time) {
+	if receivedAt.IsZero() || webdata2.ServerTime <= 0 {
+		return
+	}
+	monitor.ObserveMessageLag(string(ws.ChannelWebData2), webdata2.User, receivedAt.Sub(time.UnixMilli(webdata2.ServerTime)))
+}
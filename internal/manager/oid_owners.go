@@ -0,0 +1,156 @@
+package manager
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+)
+
+// Oid 到地址映射的默认限制
+const (
+	defaultOidOwnerTTL     = time.Hour
+	defaultOidOwnerMaxSize = 100000
+)
+
+// 淘汰原因（指标标签）
+const (
+	oidEvictTTL      = "ttl"
+	oidEvictCapacity = "capacity"
+)
+
+// oidOwners 成交订单 oid 到地址的映射（用于 orderUpdates 地址隔离）
+// 正常情况下收到终止状态后删除；未收到终止状态的条目（订阅启动前的订单、漏掉的推送）
+// 在最近访问超过 ttl 后过期，条目数超过 maxSize 时淘汰最久未访问的条目。零值可用（不限制）
+type oidOwners struct {
+	mu      sync.Mutex
+	ttl     time.Duration // 0 表示不过期
+	maxSize int           // 0 表示不限制
+	entries map[int64]*list.Element
+	lru     list.List // 前端为最近访问
+	now     func() time.Time
+}
+
+type oidOwnerEntry struct {
+	oid      int64
+	address  string
+	accessed time.Time
+}
+
+func (o *oidOwners) clock() time.Time {
+	if o.now != nil {
+		return o.now()
+	}
+	return time.Now()
+}
+
+// SetLimits 设置过期时间和条目上限，立即按新限制淘汰
+func (o *oidOwners) SetLimits(ttl time.Duration, maxSize int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.ttl = ttl
+	o.maxSize = maxSize
+	o.evictLocked()
+}
+
+// Store 记录 oid 所属地址
+func (o *oidOwners) Store(oid int64, address string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if el, ok := o.entries[oid]; ok {
+		entry := el.Value.(*oidOwnerEntry)
+		entry.address = address
+		entry.accessed = o.clock()
+		o.lru.MoveToFront(el)
+	} else {
+		if o.entries == nil {
+			o.entries = make(map[int64]*list.Element)
+		}
+		o.entries[oid] = o.lru.PushFront(&oidOwnerEntry{oid: oid, address: address, accessed: o.clock()})
+	}
+	o.evictLocked()
+}
+
+// Load 查询 oid 所属地址，命中时刷新访问时间
+func (o *oidOwners) Load(oid int64) (string, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	el, ok := o.entries[oid]
+	if !ok {
+		return "", false
+	}
+	entry := el.Value.(*oidOwnerEntry)
+	now := o.clock()
+	if o.ttl > 0 && now.Sub(entry.accessed) > o.ttl {
+		o.removeLocked(el)
+		monitor.AddOidOwnersEvicted(oidEvictTTL, 1)
+		monitor.SetOidOwnersSize(len(o.entries))
+		return "", false
+	}
+	entry.accessed = now
+	o.lru.MoveToFront(el)
+	return entry.address, true
+}
+
+// Delete 删除 oid（收到终止状态）
+func (o *oidOwners) Delete(oid int64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if el, ok := o.entries[oid]; ok {
+		o.removeLocked(el)
+		monitor.SetOidOwnersSize(len(o.entries))
+	}
+}
+
+// RemoveAddress 删除地址的全部 oid（取消订阅）
+func (o *oidOwners) RemoveAddress(address string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for el := o.lru.Front(); el != nil; {
+		next := el.Next()
+		if el.Value.(*oidOwnerEntry).address == address {
+			o.removeLocked(el)
+		}
+		el = next
+	}
+	monitor.SetOidOwnersSize(len(o.entries))
+}
+
+// Len 条目数
+func (o *oidOwners) Len() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.entries)
+}
+
+// evictLocked 从最久未访问的一端淘汰过期和超出上限的条目
+func (o *oidOwners) evictLocked() {
+	var expired, overflow int
+	if o.ttl > 0 {
+		deadline := o.clock().Add(-o.ttl)
+		for el := o.lru.Back(); el != nil && el.Value.(*oidOwnerEntry).accessed.Before(deadline); el = o.lru.Back() {
+			o.removeLocked(el)
+			expired++
+		}
+	}
+	for o.maxSize > 0 && len(o.entries) > o.maxSize {
+		o.removeLocked(o.lru.Back())
+		overflow++
+	}
+
+	if expired > 0 {
+		monitor.AddOidOwnersEvicted(oidEvictTTL, expired)
+	}
+	if overflow > 0 {
+		monitor.AddOidOwnersEvicted(oidEvictCapacity, overflow)
+	}
+	monitor.SetOidOwnersSize(len(o.entries))
+}
+
+func (o *oidOwners) removeLocked(el *list.Element) {
+	o.lru.Remove(el)
+	delete(o.entries, el.Value.(*oidOwnerEntry).oid)
+}
@@ -0,0 +1,72 @@
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOidOwners_TTL(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	owners := &oidOwners{ttl: time.Minute}
+	owners.now = func() time.Time { return now }
+
+	owners.Store(1, "0xa")
+	owners.Store(2, "0xa")
+
+	// 访问刷新过期时间
+	now = now.Add(40 * time.Second)
+	addr, ok := owners.Load(1)
+	assert.True(t, ok)
+	assert.Equal(t, "0xa", addr)
+
+	// 2 已过期，Load 时淘汰
+	now = now.Add(30 * time.Second)
+	_, ok = owners.Load(2)
+	assert.False(t, ok)
+	assert.Equal(t, 1, owners.Len())
+
+	// 新写入时淘汰过期条目
+	now = now.Add(2 * time.Minute)
+	owners.Store(3, "0xb")
+	assert.Equal(t, 1, owners.Len())
+	_, ok = owners.Load(1)
+	assert.False(t, ok)
+}
+
+func TestOidOwners_Capacity(t *testing.T) {
+	owners := &oidOwners{maxSize: 2}
+
+	owners.Store(1, "0xa")
+	owners.Store(2, "0xa")
+	owners.Load(1) // 1 变为最近访问
+	owners.Store(3, "0xb")
+
+	assert.Equal(t, 2, owners.Len())
+	_, ok := owners.Load(2)
+	assert.False(t, ok, "least recently used entry evicted")
+	_, ok = owners.Load(1)
+	assert.True(t, ok)
+
+	// 缩小上限立即淘汰
+	owners.SetLimits(0, 1)
+	assert.Equal(t, 1, owners.Len())
+	_, ok = owners.Load(1)
+	assert.True(t, ok)
+}
+
+func TestOidOwners_RemoveAddress(t *testing.T) {
+	owners := &oidOwners{}
+	owners.Store(1, "0xa")
+	owners.Store(2, "0xb")
+	owners.Store(3, "0xa")
+	owners.Delete(2)
+	owners.Store(4, "0xc")
+
+	owners.RemoveAddress("0xa")
+	assert.Equal(t, 1, owners.Len())
+	addr, ok := owners.Load(4)
+	assert.True(t, ok)
+	assert.Equal(t, "0xc", addr)
+}
@@ -0,0 +1,34 @@
+package manager
+
+import (
+	"sync/atomic"
+
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// pauseSwitch 暂停开关
+// 暂停期间保留 WS 订阅，但丢弃收到的消息，用于下游消费者迁移等维护窗口
+type pauseSwitch struct {
+	name   string // 管理器名称（日志和指标标签）
+	paused atomic.Bool
+}
+
+// set 切换暂停状态，状态变化时返回 true
+func (s *pauseSwitch) set(paused bool) bool {
+	if s.paused.Swap(paused) == paused {
+		return false
+	}
+	monitor.SetManagerPaused(s.name, paused)
+	logger.Info().Str("manager", s.name).Bool("paused", paused).Msg("manager pause state changed")
+	return true
+}
+
+// drop 暂停期间丢弃消息并计数
+func (s *pauseSwitch) drop() bool {
+	if !s.paused.Load() {
+		return false
+	}
+	monitor.IncPausedMessagesDropped(s.name)
+	return true
+}
@@ -0,0 +1,324 @@
+package manager
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/spf13/cast"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// pnlDateLayout 每日盈亏的统计日期格式（UTC）
+const pnlDateLayout = "2006-01-02"
+
+// pnlSeenTTL 已记录成交和平仓订单的保留时长，覆盖重连快照中重复推送的成交（管理器只处理 30 分钟内的成交）
+const pnlSeenTTL = time.Hour
+
+// PnLStore 每日盈亏持久化接口
+type PnLStore interface {
+	AddRealized(rows []*models.HlAddressPnlDaily) error
+	BatchUpsertUnrealized(rows []*models.HlAddressPnlDaily) error
+	ListRange(from, to, address string) ([]*models.HlAddressPnlDaily, error)
+}
+
+// pnlKey 地址 + 统计日期
+type pnlKey struct {
+	address string
+	date    string
+}
+
+// pnlFillKey 地址 + 成交 tid（同一笔撮合的双方 tid 相同）
+type pnlFillKey struct {
+	address string
+	tid     int64
+}
+
+// pnlOrderKey 地址 + 订单 oid
+type pnlOrderKey struct {
+	address string
+	oid     int64
+}
+
+// realizedDelta 待写入的已实现盈亏增量
+type realizedDelta struct {
+	pnl    float64
+	orders int
+}
+
+// PnLTracker 地址每日盈亏统计
+// 每笔成交到达时按成交时间（UTC 日期）累计 closedPnl 为已实现盈亏，与订单聚合和信号发送无关；
+// 每隔 flush_interval 从仓位缓存汇总合约未实现盈亏作为当日值，与已实现盈亏增量一起写入 hl_address_pnl_daily
+type PnLTracker struct {
+	store     PnLStore
+	positions *cache.PositionBalanceCache
+	interval  time.Duration
+
+	mu             sync.Mutex
+	realized       map[pnlKey]*realizedDelta
+	seenFills      map[pnlFillKey]time.Time  // 已记录的成交，tid 去重
+	closedOrders   map[pnlOrderKey]time.Time // 已计数的平仓订单
+	unrealized     map[string]float64        // 当日已写入的未实现盈亏，未变化时不重复写入
+	unrealizedDate string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPnLTracker 创建每日盈亏统计
+func NewPnLTracker(cfg config.PnL, positions *cache.PositionBalanceCache) *PnLTracker {
+	interval := cfg.FlushInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &PnLTracker{
+		store:        dao.AddressPnl(),
+		positions:    positions,
+		interval:     interval,
+		realized:     make(map[pnlKey]*realizedDelta),
+		seenFills:    make(map[pnlFillKey]time.Time),
+		closedOrders: make(map[pnlOrderKey]time.Time),
+		unrealized:   make(map[string]float64),
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+}
+
+// SetStore 设置持久化存储（可选，用于测试）
+func (t *PnLTracker) SetStore(store PnLStore) {
+	t.store = store
+}
+
+// Start 启动定期采样和持久化
+func (t *PnLTracker) Start() error {
+	t.wg.Add(1)
+	goplus.Go(func() {
+		defer t.wg.Done()
+		t.run()
+	})
+
+	logger.Info().Dur("flush_interval", t.interval).Msg("pnl tracker started")
+	return nil
+}
+
+// Stop 停止定期采样并写入剩余增量
+func (t *PnLTracker) Stop() {
+	t.cancel()
+	t.wg.Wait()
+	t.flush()
+}
+
+func (t *PnLTracker) run() {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-ticker.C:
+			t.flush()
+		}
+	}
+}
+
+// RecordFill 累计一笔成交的已实现盈亏，同一地址的 tid 只记录一次
+// closedPnl 归入成交时间所在日期；订单的首笔平仓成交将该订单计入当日平仓订单数，开仓成交（无 closedPnl）忽略
+func (t *PnLTracker) RecordFill(address string, fill hl.WsOrderFill) {
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	fillKey := pnlFillKey{address: address, tid: fill.Tid}
+	if _, ok := t.seenFills[fillKey]; ok {
+		return
+	}
+	t.seenFills[fillKey] = now
+
+	pnl := cast.ToFloat64(fill.ClosedPnl)
+	if pnl == 0 && !strings.HasPrefix(fill.Dir, "Close") {
+		return
+	}
+
+	d := t.delta(address, pnlDate(fill.Time))
+	d.pnl += pnl
+	orderKey := pnlOrderKey{address: address, oid: fill.Oid}
+	if _, ok := t.closedOrders[orderKey]; !ok {
+		t.closedOrders[orderKey] = now
+		d.orders++
+	}
+}
+
+func (t *PnLTracker) delta(address, date string) *realizedDelta {
+	key := pnlKey{address: address, date: date}
+	d, ok := t.realized[key]
+	if !ok {
+		d = &realizedDelta{}
+		t.realized[key] = d
+	}
+	return d
+}
+
+// flush 写入已实现盈亏增量和当日未实现盈亏，失败时下次重试
+func (t *PnLTracker) flush() {
+	now := time.Now()
+	t.flushRealized()
+	t.flushUnrealized(now)
+	t.expireSeen(now)
+}
+
+// expireSeen 清理超过 pnlSeenTTL 的已记录成交和平仓订单
+func (t *PnLTracker) expireSeen(now time.Time) {
+	cutoff := now.Add(-pnlSeenTTL)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key, at := range t.seenFills {
+		if at.Before(cutoff) {
+			delete(t.seenFills, key)
+		}
+	}
+	for key, at := range t.closedOrders {
+		if at.Before(cutoff) {
+			delete(t.closedOrders, key)
+		}
+	}
+}
+
+func (t *PnLTracker) flushRealized() {
+	t.mu.Lock()
+	pending := t.realized
+	t.realized = make(map[pnlKey]*realizedDelta)
+	t.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	rows := make([]*models.HlAddressPnlDaily, 0, len(pending))
+	for key, d := range pending {
+		rows = append(rows, &models.HlAddressPnlDaily{
+			Address:      key.address,
+			StatDate:     key.date,
+			RealizedPnl:  d.pnl,
+			ClosedOrders: d.orders,
+		})
+	}
+
+	if err := t.store.AddRealized(rows); err != nil {
+		logger.Error().Err(err).Int("count", len(rows)).Msg("persist realized pnl failed")
+
+		// 增量合并回待写入，下次重试
+		t.mu.Lock()
+		for key, d := range pending {
+			merged := t.delta(key.address, key.date)
+			merged.pnl += d.pnl
+			merged.orders += d.orders
+		}
+		t.mu.Unlock()
+	}
+}
+
+func (t *PnLTracker) flushUnrealized(now time.Time) {
+	if t.positions == nil {
+		return
+	}
+
+	today := now.UTC().Format(pnlDateLayout)
+	if t.unrealizedDate != today {
+		t.unrealizedDate = today
+		t.unrealized = make(map[string]float64)
+	}
+
+	current := make(map[string]float64)
+	var rows []*models.HlAddressPnlDaily
+	t.positions.RangeFutures(func(address string, positions *models.FuturesPositionsData) bool {
+		var pnl float64
+		if positions != nil {
+			for _, pos := range *positions {
+				pnl += cast.ToFloat64(pos.UnrealizedPnl)
+			}
+		}
+		// 未变化或当日从未持仓时不写入
+		if last, ok := t.unrealized[address]; (ok && last == pnl) || (!ok && pnl == 0) {
+			return true
+		}
+		current[address] = pnl
+		rows = append(rows, &models.HlAddressPnlDaily{
+			Address:       address,
+			StatDate:      today,
+			UnrealizedPnl: pnl,
+		})
+		return true
+	})
+
+	if len(rows) == 0 {
+		return
+	}
+	if err := t.store.BatchUpsertUnrealized(rows); err != nil {
+		logger.Error().Err(err).Int("count", len(rows)).Msg("persist unrealized pnl failed")
+		return
+	}
+	for address, pnl := range current {
+		t.unrealized[address] = pnl
+	}
+}
+
+// PnLSummary 汇总日期范围内（含首尾）的盈亏，address 为空时汇总全部地址
+// 已实现盈亏为范围内之和，未实现盈亏取各地址范围内最后一天的值；尚未写库的增量（最多 flush_interval）不计入
+func (t *PnLTracker) PnLSummary(from, to, address string) (monitor.PnLSummary, error) {
+	rows, err := t.store.ListRange(from, to, address)
+	if err != nil {
+		return monitor.PnLSummary{}, err
+	}
+
+	type lastUnrealized struct {
+		date string
+		pnl  float64
+	}
+	byAddress := make(map[string]*monitor.AddressPnL)
+	latest := make(map[string]lastUnrealized)
+	for _, row := range rows {
+		a, ok := byAddress[row.Address]
+		if !ok {
+			a = &monitor.AddressPnL{Address: row.Address}
+			byAddress[row.Address] = a
+		}
+		a.RealizedPnl += row.RealizedPnl
+		a.ClosedOrders += row.ClosedOrders
+		if row.StatDate >= latest[row.Address].date {
+			latest[row.Address] = lastUnrealized{date: row.StatDate, pnl: row.UnrealizedPnl}
+		}
+	}
+
+	summary := monitor.PnLSummary{From: from, To: to, Addresses: make([]monitor.AddressPnL, 0, len(byAddress))}
+	for addr, a := range byAddress {
+		a.UnrealizedPnl = latest[addr].pnl
+		summary.RealizedPnl += a.RealizedPnl
+		summary.UnrealizedPnl += a.UnrealizedPnl
+		summary.ClosedOrders += a.ClosedOrders
+		summary.Addresses = append(summary.Addresses, *a)
+	}
+	sort.Slice(summary.Addresses, func(i, j int) bool {
+		return summary.Addresses[i].Address < summary.Addresses[j].Address
+	})
+	return summary, nil
+}
+
+// pnlDate 成交时间（毫秒）对应的 UTC 日期
+func pnlDate(ms int64) string {
+	return time.UnixMilli(ms).UTC().Format(pnlDateLayout)
+}
@@ -0,0 +1,146 @@
+package manager
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+type mockPnLStore struct {
+	realized   []*models.HlAddressPnlDaily
+	unrealized []*models.HlAddressPnlDaily
+	rows       []*models.HlAddressPnlDaily
+	err        error
+}
+
+func (s *mockPnLStore) AddRealized(rows []*models.HlAddressPnlDaily) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.realized = append(s.realized, rows...)
+	return nil
+}
+
+func (s *mockPnLStore) BatchUpsertUnrealized(rows []*models.HlAddressPnlDaily) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.unrealized = append(s.unrealized, rows...)
+	return nil
+}
+
+func (s *mockPnLStore) ListRange(from, to, address string) ([]*models.HlAddressPnlDaily, error) {
+	return s.rows, nil
+}
+
+func TestPnLTracker_Realized(t *testing.T) {
+	store := &mockPnLStore{}
+	tracker := NewPnLTracker(config.PnL{FlushInterval: time.Hour}, nil)
+	tracker.SetStore(store)
+
+	day1 := time.Date(2026, 1, 1, 23, 59, 0, 0, time.UTC).UnixMilli()
+	day2 := time.Date(2026, 1, 2, 0, 1, 0, 0, time.UTC).UnixMilli()
+
+	// 开仓成交不计入
+	tracker.RecordFill("0xa", hl.WsOrderFill{Oid: 1, Tid: 1, Dir: "Open Long", ClosedPnl: "0.0", Time: day1})
+	// 跨日的平仓订单按成交日期拆分盈亏，订单数计入首笔平仓成交的日期；重复推送的成交只记录一次
+	tracker.RecordFill("0xa", hl.WsOrderFill{Oid: 2, Tid: 2, Dir: "Close Long", ClosedPnl: "12.5", Time: day1})
+	tracker.RecordFill("0xa", hl.WsOrderFill{Oid: 2, Tid: 2, Dir: "Close Long", ClosedPnl: "12.5", Time: day1})
+	tracker.RecordFill("0xa", hl.WsOrderFill{Oid: 2, Tid: 3, Dir: "Close Long", ClosedPnl: "-2.5", Time: day2})
+	// 同一笔撮合的对手方 tid 相同，按地址分别记录
+	tracker.RecordFill("0xb", hl.WsOrderFill{Oid: 9, Tid: 3, Dir: "Close Short", ClosedPnl: "4", Time: day2})
+
+	// 写入失败时增量保留，下次重试
+	store.err = errors.New("db down")
+	tracker.flushRealized()
+	tracker.RecordFill("0xa", hl.WsOrderFill{Oid: 3, Tid: 4, Dir: "Close Short", ClosedPnl: "1", Time: day2})
+	store.err = nil
+	tracker.flushRealized()
+
+	got := make(map[string]models.HlAddressPnlDaily)
+	for _, row := range store.realized {
+		got[row.Address+"/"+row.StatDate] = *row
+	}
+	require.Len(t, got, 3)
+	assert.Equal(t, 12.5, got["0xa/2026-01-01"].RealizedPnl)
+	assert.Equal(t, 1, got["0xa/2026-01-01"].ClosedOrders)
+	assert.Equal(t, -1.5, got["0xa/2026-01-02"].RealizedPnl)
+	assert.Equal(t, 1, got["0xa/2026-01-02"].ClosedOrders)
+	assert.Equal(t, 4.0, got["0xb/2026-01-02"].RealizedPnl)
+	assert.Equal(t, 1, got["0xb/2026-01-02"].ClosedOrders)
+
+	// 过期后清理去重记录
+	tracker.expireSeen(time.Now().Add(2 * pnlSeenTTL))
+	assert.Empty(t, tracker.seenFills)
+	assert.Empty(t, tracker.closedOrders)
+
+	store.realized = nil
+	tracker.flushRealized()
+	assert.Empty(t, store.realized)
+}
+
+func TestPnLTracker_Unrealized(t *testing.T) {
+	store := &mockPnLStore{}
+	positions := cache.NewPositionBalanceCache()
+	tracker := NewPnLTracker(config.PnL{FlushInterval: time.Hour}, positions)
+	tracker.SetStore(store)
+
+	set := func(addr string, pnls ...string) {
+		futures := models.FuturesPositionsData{}
+		for _, p := range pnls {
+			futures = append(futures, models.PositionItem{UnrealizedPnl: p})
+		}
+		positions.Set(addr, 0, 0, &models.SpotBalancesData{}, &futures)
+	}
+	now := time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)
+
+	set("0xa", "10", "-4")
+	set("0xb") // 无持仓不写入
+	tracker.flushUnrealized(now)
+	require.Len(t, store.unrealized, 1)
+	assert.Equal(t, "0xa", store.unrealized[0].Address)
+	assert.Equal(t, "2026-01-02", store.unrealized[0].StatDate)
+	assert.Equal(t, 6.0, store.unrealized[0].UnrealizedPnl)
+
+	// 未变化不重复写入；平仓后写入 0
+	tracker.flushUnrealized(now.Add(time.Minute))
+	require.Len(t, store.unrealized, 1)
+	set("0xa")
+	tracker.flushUnrealized(now.Add(2 * time.Minute))
+	require.Len(t, store.unrealized, 2)
+	assert.Zero(t, store.unrealized[1].UnrealizedPnl)
+
+	// 跨日后重新写入当日值
+	set("0xa", "3")
+	tracker.flushUnrealized(now.Add(24 * time.Hour))
+	require.Len(t, store.unrealized, 3)
+	assert.Equal(t, "2026-01-03", store.unrealized[2].StatDate)
+}
+
+func TestPnLTracker_Summary(t *testing.T) {
+	store := &mockPnLStore{rows: []*models.HlAddressPnlDaily{
+		{Address: "0xa", StatDate: "2026-01-01", RealizedPnl: 10, UnrealizedPnl: 5, ClosedOrders: 1},
+		{Address: "0xa", StatDate: "2026-01-02", RealizedPnl: -3, UnrealizedPnl: 7, ClosedOrders: 2},
+		{Address: "0xb", StatDate: "2026-01-01", RealizedPnl: 1, UnrealizedPnl: -2, ClosedOrders: 1},
+	}}
+	tracker := NewPnLTracker(config.PnL{}, nil)
+	tracker.SetStore(store)
+
+	summary, err := tracker.PnLSummary("2026-01-01", "2026-01-02", "")
+	require.NoError(t, err)
+	assert.Equal(t, 8.0, summary.RealizedPnl)
+	assert.Equal(t, 5.0, summary.UnrealizedPnl)
+	assert.Equal(t, 4, summary.ClosedOrders)
+	require.Len(t, summary.Addresses, 2)
+	assert.Equal(t, "0xa", summary.Addresses[0].Address)
+	assert.Equal(t, 7.0, summary.Addresses[0].RealizedPnl)
+	assert.Equal(t, 7.0, summary.Addresses[0].UnrealizedPnl)
+}
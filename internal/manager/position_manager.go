@@ -1,18 +1,22 @@
 package manager
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
 	hl "github.com/sonirico/go-hyperliquid"
 	"github.com/spf13/cast"
+	"github.com/utrading/utrading-hl-monitor/config"
 	"github.com/utrading/utrading-hl-monitor/internal/address"
 	"github.com/utrading/utrading-hl-monitor/internal/cache"
 	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
 	"github.com/utrading/utrading-hl-monitor/internal/processor"
+	"github.com/utrading/utrading-hl-monitor/internal/symbol"
+	"github.com/utrading/utrading-hl-monitor/internal/valuation"
 	"github.com/utrading/utrading-hl-monitor/internal/ws"
 	"github.com/utrading/utrading-hl-monitor/pkg/logger"
 )
@@ -24,15 +28,38 @@ type PositionManager struct {
 	poolManager          *ws.PoolManager
 	addresses            map[string]bool
 	subs                 map[string]*ws.SubscriptionHandle
-	priceCache           *cache.PriceCache           // 价格缓存引用
-	symbolCache          *cache.SymbolCache          // Symbol 转换缓存
-	positionBalanceCache *cache.PositionBalanceCache // 仓位余额缓存
-	messageQueue         *processor.MessageQueue     // 消息队列
-	messagesReceived     map[string]int64            // 每个地址接收的消息计数
-	messagesFiltered     int64                       // 过滤掉的消息计数
+	priceCache           *cache.PriceCache            // 价格缓存引用
+	symbolCache          *cache.SymbolCache           // Symbol 转换缓存
+	positionBalanceCache *cache.PositionBalanceCache  // 仓位余额缓存
+	messageQueue         *processor.MessageQueue      // 消息队列
+	positionProcessor    *processor.PositionProcessor // 仓位处理器
+	messagesReceived     map[string]int64             // 每个地址接收的消息计数
+	messagesFiltered     int64                        // 过滤掉的消息计数
+	states               *hl.UserStateDiffer          // 每个地址最近一次账户快照（用于检测仓位变化）
+	activity             ActivityRecorder             // 地址活跃度记录（可选）
+	dust                 config.SpotDust              // 现货粉尘过滤阈值
+	valuer               *valuation.Valuer            // 估值器（稳定币篮子与计价货币）
+	openOrders           OpenOrderTracker             // 挂单归属记录（可选）
+	normalizer           SymbolNormalizer             // 合约资产名规范化
+	throttle             *SubscribeThrottle           // 订阅限速（可选）
+	pause                pauseSwitch                  // 暂停开关
 	mu                   sync.RWMutex
 }
 
+// OpenOrderTracker 挂单归属记录接口（由 SubscriptionManager 实现）
+type OpenOrderTracker interface {
+	TrackOpenOrders(addr string, orders []hl.WsBasicOrder)
+}
+
+// SymbolNormalizer symbol 规范化接口（由 symbol.Normalizer 实现）
+type SymbolNormalizer interface {
+	Perp(name string) (coin, symbol string, ok bool)
+	Accepts(coin string) bool
+}
+
+// defaultSymbolNormalizer 内置规则（去掉 builder dex 前缀、追加 USDC）
+var defaultSymbolNormalizer SymbolNormalizer = symbol.DefaultNormalizer()
+
 // NewPositionManager 创建仓位管理器
 func NewPositionManager(
 	poolManager *ws.PoolManager,
@@ -60,10 +87,78 @@ func NewPositionManager(
 		symbolCache:          symbolCache,
 		positionBalanceCache: cache.NewPositionBalanceCache(),
 		messageQueue:         messageQueue,
+		positionProcessor:    positonProcessor,
 		messagesReceived:     make(map[string]int64),
+		states:               hl.NewUserStateDiffer(),
+		valuer:               valuation.NewValuer(config.Valuation{}, priceCache, symbolCache),
+		normalizer:           defaultSymbolNormalizer,
+		pause:                pauseSwitch{name: "position_manager"},
 	}
 }
 
+// SetProcessLanes 设置消息队列的串行通道数，需在订阅地址前调用
+// 同一地址固定路由到同一通道，不同地址并行处理
+func (m *PositionManager) SetProcessLanes(lanes int) {
+	queue := processor.NewMessageQueueWithLanes(1000, lanes, m.positionProcessor)
+	queue.Start()
+
+	m.mu.Lock()
+	old := m.messageQueue
+	m.messageQueue = queue
+	m.mu.Unlock()
+
+	old.Stop()
+}
+
+// SetActivityRecorder 设置地址活跃度记录器（可选）
+func (m *PositionManager) SetActivityRecorder(recorder ActivityRecorder) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.activity = recorder
+}
+
+// SetOpenOrderTracker 设置挂单归属记录（可选），webData2 中的挂单将同步给订阅管理器
+func (m *PositionManager) SetOpenOrderTracker(tracker OpenOrderTracker) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.openOrders = tracker
+}
+
+// SetValuation 设置估值配置（计价货币与稳定币篮子），需在订阅地址前调用
+func (m *PositionManager) SetValuation(cfg config.Valuation) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.valuer = valuation.NewValuer(cfg, m.priceCache, m.symbolCache)
+}
+
+// SetSymbolNormalizer 设置 symbol 规范化规则（默认内置规则），需在订阅地址前调用
+func (m *PositionManager) SetSymbolNormalizer(normalizer SymbolNormalizer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.normalizer = normalizer
+}
+
+func (m *PositionManager) symbolNormalizer() SymbolNormalizer {
+	if m.normalizer == nil {
+		return defaultSymbolNormalizer
+	}
+	return m.normalizer
+}
+
+// SetSubscribeThrottle 设置订阅限速器（可选），需在订阅地址前调用
+func (m *PositionManager) SetSubscribeThrottle(throttle *SubscribeThrottle) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.throttle = throttle
+}
+
+// SetDustThresholds 设置现货粉尘过滤阈值（默认不过滤）
+func (m *PositionManager) SetDustThresholds(dust config.SpotDust) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.dust = dust
+}
+
 // SubscribeAddress 订阅地址的仓位数据
 func (m *PositionManager) SubscribeAddress(addr string) error {
 	m.mu.Lock()
@@ -76,6 +171,34 @@ func (m *PositionManager) SubscribeAddress(addr string) error {
 	return m.subscribeAddress(addr)
 }
 
+// Pause 暂停处理：保留订阅但丢弃仓位数据，仓位缓存停止更新
+func (m *PositionManager) Pause() {
+	m.pause.set(true)
+}
+
+// Resume 恢复处理
+func (m *PositionManager) Resume() {
+	m.pause.set(false)
+}
+
+// Paused 是否处于暂停状态
+func (m *PositionManager) Paused() bool {
+	return m.pause.paused.Load()
+}
+
+// UnsubscribeAll 取消全部地址的订阅，返回取消的地址数
+func (m *PositionManager) UnsubscribeAll() int {
+	count := 0
+	for _, addr := range m.Addresses() {
+		if err := m.UnsubscribeAddress(addr); err != nil {
+			logger.Error().Err(err).Str("address", addr).Msg("unsubscribe address failed")
+			continue
+		}
+		count++
+	}
+	return count
+}
+
 // UnsubscribeAddress 取消订阅地址
 func (m *PositionManager) UnsubscribeAddress(addr string) error {
 	m.mu.Lock()
@@ -86,6 +209,7 @@ func (m *PositionManager) UnsubscribeAddress(addr string) error {
 	delete(m.addresses, addr)
 	m.mu.Unlock()
 
+	monitor.DeleteMessageLag(string(ws.ChannelWebData2), addr)
 	return m.unsubscribeAddress(addr)
 }
 
@@ -102,11 +226,9 @@ func (m *PositionManager) subscribeAddress(addr string) error {
 	}
 
 	// 订阅并设置回调
-	handle, err := m.poolManager.Subscribe(sub, func(msg ws.WsMessage) error {
-		// 解析 WebData2 消息
-		var webdata2 hl.WebData2
-		if err := json.Unmarshal(msg.Data, &webdata2); err != nil {
-			logger.Error().Err(err).Str("address", addr).Msg("failed to unmarshal webdata2")
+	m.throttle.Wait()
+	handle, err := ws.SubscribeReceived(m.poolManager, sub, func(webdata2 hl.WebData2, receivedAt time.Time) error {
+		if m.pause.drop() {
 			return nil
 		}
 
@@ -127,6 +249,7 @@ func (m *PositionManager) subscribeAddress(addr string) error {
 		m.messagesReceived[addr]++
 		m.mu.Unlock()
 
+		observeWebData2Lag(webdata2, receivedAt)
 		m.handleWebData2(webdata2)
 		return nil
 	})
@@ -159,141 +282,177 @@ func (m *PositionManager) handleWebData2(webdata2 hl.WebData2) {
 		}
 	}
 
+	// 处理合约价格（assetCtxs 与 meta.universe 按下标对应）
+	if webdata2.Meta != nil && len(webdata2.AssetCtxs) > 0 {
+		for i, assetCtx := range webdata2.AssetCtxs {
+			if i >= len(webdata2.Meta.Universe) {
+				break
+			}
+			priceStr := assetCtx.MarkPx
+			if assetCtx.MidPx != "" {
+				priceStr = assetCtx.MidPx
+			}
+
+			m.priceCache.SetPerpPrice(webdata2.Meta.Universe[i].Name, cast.ToFloat64(priceStr))
+		}
+	}
+
+	// 同步挂单归属
+	m.mu.RLock()
+	tracker := m.openOrders
+	m.mu.RUnlock()
+	if tracker != nil && webdata2.User != "" && webdata2.OpenOrders != nil {
+		tracker.TrackOpenOrders(webdata2.User, webdata2.OpenOrders)
+	}
+
 	// 处理仓位缓存（现有逻辑）
 	m.processPositionCache(webdata2.User, &webdata2)
 }
 
+// positionSnapshot 解析后的地址仓位快照
+type positionSnapshot struct {
+	spotBalances  models.SpotBalancesData
+	spotTotalUSD  float64 // 现货总价值 = Σ(币种数量 × 价格)
+	dustFiltered  int
+	futures       models.FuturesPositionsData
+	marginSummary *hl.MarginSummary
+	withdrawable  string
+}
+
 func (m *PositionManager) processPositionCache(addr string, webdata2 *hl.WebData2) {
-	// 现货总价值 = Σ(币种数量 × 价格)
-	spotTotalUSD := 0.0
+	snap := m.parseSnapshot(webdata2)
+
+	logger.Debug().
+		Str("address", addr).
+		Int("futures_count", len(snap.futures)).
+		Msg("serialized futures positions")
+
+	logger.Debug().
+		Str("address", addr).
+		Float64("spot_total_usd", snap.spotTotalUSD).
+		Int("spot_count", len(snap.spotBalances)).
+		Int("dust_filtered", snap.dustFiltered).
+		Msg("calculated spot total value")
+
+	m.detectPositionChange(addr, webdata2)
+
+	m.applySnapshot(addr, snap)
+}
+
+// parseSnapshot 将 webData2 解析为统一格式的仓位快照
+func (m *PositionManager) parseSnapshot(webdata2 *hl.WebData2) *positionSnapshot {
+	snap := &positionSnapshot{marginSummary: &hl.MarginSummary{}}
 
 	// 解析现货余额
-	var spotBalances models.SpotBalancesData
 	if webdata2.SpotState != nil {
-		spotBalances = make(models.SpotBalancesData, 0, len(webdata2.SpotState.Balances))
+		snap.spotBalances = make(models.SpotBalancesData, 0, len(webdata2.SpotState.Balances))
 		for _, balance := range webdata2.SpotState.Balances {
-			if cast.ToFloat64(balance.Total) == 0 {
+			total := cast.ToFloat64(balance.Total)
+			if total == 0 {
 				continue
 			}
 			coin := hl.MainnetToAlias(balance.Coin)
-			spotBalances = append(spotBalances, models.SpotBalanceItem{
+
+			// 无价格时无法判断粉尘，保留余额但不计入总价值
+			valueUSD, priced := m.spotValueUSD(coin, total)
+			if priced && m.isDust(coin, valueUSD) {
+				snap.dustFiltered++
+				continue
+			}
+
+			snap.spotBalances = append(snap.spotBalances, models.SpotBalanceItem{
 				Coin:     coin, // BTC
 				Total:    balance.Total,
 				Hold:     balance.Hold,
 				EntryNtl: balance.EntryNtl,
 			})
-
-			total := cast.ToFloat64(balance.Total)
-
-			if isStableCoin(coin) {
-				// 稳定币默认价格为 1
-				spotTotalUSD += total * 1.0
-				continue
-			}
-
-			for _, base := range []string{"USDC", "USDT", "USDH"} {
-				symbol := coin + base
-				assetName, exists := m.symbolCache.GetSpotName(symbol)
-				if !exists {
-					logger.Error().Str("symbol", symbol).Msg("symbol not found in cache, skip position cache")
-					continue
-				}
-				midPx, ok := m.priceCache.GetSpotPrice(assetName)
-				if ok {
-					spotTotalUSD += total * midPx
-				}
-				break
-			}
+			snap.spotTotalUSD += valueUSD
 		}
 	}
-	spotBalancesJSON, _ := json.Marshal(spotBalances)
 
 	// 解析合约仓位
-	var futuresPositions models.FuturesPositionsData
-
 	state := webdata2.ClearinghouseState
+	if state == nil {
+		return snap
+	}
 
-	marginSummary := &hl.MarginSummary{}
 	if state.CrossMarginSummary != nil {
-		marginSummary = state.CrossMarginSummary
+		snap.marginSummary = state.CrossMarginSummary
 	} else if state.MarginSummary != nil {
-		marginSummary = state.MarginSummary
+		snap.marginSummary = state.MarginSummary
 	}
+	snap.withdrawable = state.Withdrawable
 
-	if webdata2.ClearinghouseState != nil {
-		futuresPositions = make(models.FuturesPositionsData, 0, len(state.AssetPositions))
-		for _, assetPos := range state.AssetPositions {
-			if assetPos.Position.Coin == "" {
-				continue
-			}
-
-			var entryPx *string
-			if assetPos.Position.EntryPx != nil {
-				entryPx = assetPos.Position.EntryPx
-			}
-
-			// 转换合约 coin 为统一格式 (BTC -> BTCUSDC)
-			coin := assetPos.Position.Coin
-			if strings.Contains(coin, ":") {
-				parts := strings.Split(coin, ":")
-				if len(parts) != 2 || parts[0] != "xyz" {
-					continue
-				}
-				coin = parts[1]
-			}
-
-			coin = hl.MainnetToAlias(coin)
+	snap.futures = make(models.FuturesPositionsData, 0, len(state.AssetPositions))
+	for _, assetPos := range state.AssetPositions {
+		if assetPos.Position.Coin == "" {
+			continue
+		}
 
-			if m.symbolCache != nil {
-				if converted, ok := m.symbolCache.GetPerpSymbol(coin); ok {
-					coin = converted
-				}
-			} else {
-				coin = coin + "USDC"
-			}
+		var entryPx *string
+		if assetPos.Position.EntryPx != nil {
+			entryPx = assetPos.Position.EntryPx
+		}
 
-			position := models.PositionItem{
-				Coin:          coin,
-				Szi:           assetPos.Position.Szi,
-				EntryPx:       entryPx,
-				UnrealizedPnl: assetPos.Position.UnrealizedPnl,
-				Leverage: models.LeverageItem{
-					Type:  assetPos.Position.Leverage.Type,
-					Value: assetPos.Position.Leverage.Value,
-				},
-				MarginUsed:     assetPos.Position.MarginUsed,
-				PositionValue:  assetPos.Position.PositionValue,
-				ReturnOnEquity: assetPos.Position.ReturnOnEquity,
+		// 转换合约 coin 为统一格式 (BTC -> BTCUSDC)，未命中规范化规则的 dex 资产忽略
+		_, coin, ok := m.symbolNormalizer().Perp(assetPos.Position.Coin)
+		if !ok {
+			continue
+		}
+		if m.symbolCache != nil {
+			if converted, ok := m.symbolCache.GetPerpSymbol(assetPos.Position.Coin); ok {
+				coin = converted
 			}
+		}
 
-			futuresPositions = append(futuresPositions, position)
+		position := models.PositionItem{
+			Coin:          coin,
+			Szi:           assetPos.Position.Szi,
+			EntryPx:       entryPx,
+			UnrealizedPnl: assetPos.Position.UnrealizedPnl,
+			Leverage: models.LeverageItem{
+				Type:  assetPos.Position.Leverage.Type,
+				Value: assetPos.Position.Leverage.Value,
+			},
+			MarginUsed:     assetPos.Position.MarginUsed,
+			PositionValue:  assetPos.Position.PositionValue,
+			ReturnOnEquity: assetPos.Position.ReturnOnEquity,
 		}
+
+		snap.futures = append(snap.futures, position)
 	}
-	futuresPositionsJSON, _ := json.Marshal(futuresPositions)
 
-	logger.Debug().
-		Str("address", addr).
-		Int("futures_count", len(futuresPositions)).
-		Msg("serialized futures positions")
+	return snap
+}
 
-	logger.Debug().
-		Str("address", addr).
-		Float64("spot_total_usd", spotTotalUSD).
-		Int("spot_count", len(spotBalances)).
-		Msg("calculated spot total value")
+// applySnapshot 将仓位快照写入数据库队列和内存缓存
+func (m *PositionManager) applySnapshot(addr string, snap *positionSnapshot) {
+	spotBalancesJSON, _ := json.Marshal(snap.spotBalances)
+	futuresPositionsJSON, _ := json.Marshal(snap.futures)
+
+	accountValue := cast.ToFloat64(snap.marginSummary.AccountValue)
 
 	// 写入数据库队列
-	message := processor.NewPositionCacheMessage(addr, &models.HlPositionCache{
+	positionCache := &models.HlPositionCache{
 		Address:          addr,
 		SpotBalances:     string(spotBalancesJSON),
-		SpotTotalUSD:     fmt.Sprintf("%.6f", spotTotalUSD),
+		SpotTotalUSD:     fmt.Sprintf("%.6f", snap.spotTotalUSD),
 		FuturesPositions: string(futuresPositionsJSON),
-		AccountValue:     marginSummary.AccountValue,
-		TotalMarginUsed:  marginSummary.TotalMarginUsed,
-		TotalNtlPos:      marginSummary.TotalNtlPos,
-		Withdrawable:     state.Withdrawable,
+		AccountValue:     snap.marginSummary.AccountValue,
+		TotalMarginUsed:  snap.marginSummary.TotalMarginUsed,
+		TotalNtlPos:      snap.marginSummary.TotalNtlPos,
+		Withdrawable:     snap.withdrawable,
+		QuoteCurrency:    m.valuer.Quote(),
 		UpdatedAt:        time.Now(),
-	})
+	}
+	// 计价货币价值（计价货币无价格时留空）
+	if spotQuote, ok := m.valuer.ToQuote(snap.spotTotalUSD); ok {
+		positionCache.SpotTotalQuote = fmt.Sprintf("%.8f", spotQuote)
+	}
+	if accountQuote, ok := m.valuer.ToQuote(accountValue); ok {
+		positionCache.AccountValueQuote = fmt.Sprintf("%.8f", accountQuote)
+	}
+	message := processor.NewPositionCacheMessage(addr, positionCache)
 	if err := m.messageQueue.Enqueue(message); err != nil {
 		logger.Error().Err(err).
 			Str("address", addr).
@@ -301,8 +460,59 @@ func (m *PositionManager) processPositionCache(addr string, webdata2 *hl.WebData
 	}
 
 	// 同时更新内存缓存（包括持仓数据）
-	accountValue := cast.ToFloat64(marginSummary.AccountValue)
-	m.positionBalanceCache.Set(addr, spotTotalUSD, accountValue, &spotBalances, &futuresPositions)
+	m.positionBalanceCache.Set(addr, snap.spotTotalUSD, accountValue, &snap.spotBalances, &snap.futures)
+	m.positionBalanceCache.SetMargin(addr, cache.MarginInfo{
+		Withdrawable:    cast.ToFloat64(snap.withdrawable),
+		TotalMarginUsed: cast.ToFloat64(snap.marginSummary.TotalMarginUsed),
+	})
+}
+
+// spotValueUSD 计算现货余额的 USD 价值，无价格时返回 false
+func (m *PositionManager) spotValueUSD(coin string, total float64) (float64, bool) {
+	px, ok := m.valuer.SpotPriceUSD(coin)
+	if !ok {
+		logger.Debug().Str("coin", coin).Msg("spot price not found, skip valuation")
+		return 0, false
+	}
+	return total * px, true
+}
+
+// isDust 判断现货余额是否低于粉尘阈值（币种阈值优先）
+func (m *PositionManager) isDust(coin string, valueUSD float64) bool {
+	m.mu.RLock()
+	threshold, ok := m.dust.CoinMinUSD[coin]
+	if !ok {
+		threshold = m.dust.MinUSD
+	}
+	m.mu.RUnlock()
+
+	return threshold > 0 && valueUSD < threshold
+}
+
+// detectPositionChange 仓位或现货余额变化时记录地址活跃（仅保证金随标记价格变化不计）
+func (m *PositionManager) detectPositionChange(addr string, webdata2 *hl.WebData2) {
+	// 首次消息仅建立基线
+	diff, ok := m.states.Update(addr, hl.SnapshotFromWebData2(webdata2))
+	if !ok || !diff.HoldingsChanged() {
+		return
+	}
+
+	for _, c := range diff.Positions {
+		logger.Debug().
+			Str("address", addr).
+			Str("coin", c.Coin).
+			Str("kind", string(c.Kind)).
+			Float64("prev_szi", c.PrevSzi).
+			Float64("szi", c.Szi).
+			Msg("position changed")
+	}
+
+	m.mu.RLock()
+	activity := m.activity
+	m.mu.RUnlock()
+	if activity != nil {
+		activity.Touch(addr)
+	}
 }
 
 func (m *PositionManager) unsubscribeAddress(addr string) error {
@@ -314,6 +524,7 @@ func (m *PositionManager) unsubscribeAddress(addr string) error {
 	}
 	delete(m.subs, addr)
 	m.mu.Unlock()
+	m.states.Forget(addr)
 
 	if handle != nil {
 		_ = handle.Unsubscribe()
@@ -366,7 +577,21 @@ func (m *PositionManager) Close() error {
 	if m.messageQueue != nil {
 		m.messageQueue.Stop()
 	}
+	m.closeSubscriptions()
+	return nil
+}
 
+// Shutdown 优雅关闭：在 ctx 截止前排空消息队列，并记录排空结果
+func (m *PositionManager) Shutdown(ctx context.Context) error {
+	if m.messageQueue != nil {
+		processor.ReportDrain("position_queue", m.messageQueue.StopAndDrain(ctx))
+	}
+	m.closeSubscriptions()
+	return nil
+}
+
+// closeSubscriptions 取消所有订阅
+func (m *PositionManager) closeSubscriptions() {
 	m.mu.Lock()
 	// 取消所有订阅
 	for _, handle := range m.subs {
@@ -376,11 +601,4 @@ func (m *PositionManager) Close() error {
 	m.mu.Unlock()
 
 	// 不关闭 poolManager，因为它由外部管理
-
-	return nil
-}
-
-// isStableCoin 判断是否为稳定币
-func isStableCoin(coin string) bool {
-	return coin == "USDC" || coin == "USDH" || coin == "USDT"
 }
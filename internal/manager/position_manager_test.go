@@ -0,0 +1,118 @@
+package manager
+
+import (
+	"testing"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
+	"github.com/utrading/utrading-hl-monitor/internal/symbol"
+	"github.com/utrading/utrading-hl-monitor/internal/valuation"
+)
+
+func TestPositionManager_SpotDust(t *testing.T) {
+	symbolCache := cache.NewSymbolCache()
+	symbolCache.SetSpotSymbol("@107", "HYPEUSDC")
+	priceCache := cache.NewPriceCache()
+	priceCache.SetSpotPrice("@107", 40)
+
+	m := &PositionManager{
+		symbolCache: symbolCache,
+		priceCache:  priceCache,
+		valuer:      valuation.NewValuer(config.Valuation{}, priceCache, symbolCache),
+	}
+
+	// 稳定币价格为 1
+	value, ok := m.spotValueUSD("USDC", 0.5)
+	assert.True(t, ok)
+	assert.Equal(t, 0.5, value)
+
+	value, ok = m.spotValueUSD("HYPE", 0.1)
+	assert.True(t, ok)
+	assert.Equal(t, 4.0, value)
+
+	// 无价格的币种无法估值
+	_, ok = m.spotValueUSD("UNKNOWN", 1)
+	assert.False(t, ok)
+
+	// 未设置阈值时不过滤
+	assert.False(t, m.isDust("USDC", 0.001))
+
+	m.SetDustThresholds(config.SpotDust{
+		MinUSD:     1,
+		CoinMinUSD: map[string]float64{"HYPE": 5},
+	})
+	assert.True(t, m.isDust("USDC", 0.5))
+	assert.False(t, m.isDust("USDC", 1))
+	assert.True(t, m.isDust("HYPE", 4), "coin threshold overrides default")
+	assert.False(t, m.isDust("HYPE", 5))
+}
+
+func TestPositionManager_SymbolRules(t *testing.T) {
+	webdata2 := &hl.WebData2{
+		ClearinghouseState: &hl.ClearinghouseState{
+			AssetPositions: []hl.AssetPosition{
+				{Position: hl.Position{Coin: "BTC", Szi: "0.5"}},
+				{Position: hl.Position{Coin: "xyz:TSLA", Szi: "2"}},
+				{Position: hl.Position{Coin: "flx:ETH-USD", Szi: "1"}},
+			},
+		},
+	}
+
+	coins := func(m *PositionManager) []string {
+		var out []string
+		for _, p := range m.parseSnapshot(webdata2).futures {
+			out = append(out, p.Coin)
+		}
+		return out
+	}
+
+	// 内置规则：任意 builder dex 资产均去掉前缀映射
+	m := newTestPositionManager()
+	assert.Equal(t, []string{"BTCUSDC", "TSLAUSDC", "ETH-USDUSDC"}, coins(m))
+
+	// 设置 dex 注册表后未登记 dex 的资产被忽略
+	registered := symbol.DefaultNormalizer()
+	dexes := cache.NewDexRegistry()
+	dexes.Set([]hl.PerpDex{{}, {Name: "xyz"}})
+	registered.SetDexRegistry(dexes)
+	m.SetSymbolNormalizer(registered)
+	assert.Equal(t, []string{"BTCUSDC", "TSLAUSDC"}, coins(m))
+
+	normalizer, err := symbol.NewNormalizer(config.Symbol{
+		PerpRules: []config.SymbolRule{
+			{Match: `^flx:([A-Z0-9]+)-([A-Z]+)$`, Coin: "$1", Symbol: "{coin}$2"},
+			{Match: `^([^:]+)$`, Symbol: "{coin}USDC"},
+		},
+	})
+	require.NoError(t, err)
+	m.SetSymbolNormalizer(normalizer)
+	assert.Equal(t, []string{"BTCUSDC", "ETHUSD"}, coins(m))
+}
+
+type touchRecorder struct{ touched []string }
+
+func (r *touchRecorder) Touch(addr string) { r.touched = append(r.touched, addr) }
+
+func TestPositionManager_DetectPositionChange(t *testing.T) {
+	recorder := &touchRecorder{}
+	m := &PositionManager{states: hl.NewUserStateDiffer(), activity: recorder}
+
+	state := func(szi, accountValue string) *hl.WebData2 {
+		return &hl.WebData2{ClearinghouseState: &hl.ClearinghouseState{
+			CrossMarginSummary: &hl.MarginSummary{AccountValue: accountValue},
+			AssetPositions:     []hl.AssetPosition{{Position: hl.Position{Coin: "BTC", Szi: szi}}},
+		}}
+	}
+
+	// 首次消息仅建立基线，只有账户价值变化不计为活跃
+	m.detectPositionChange("0xabc", state("1", "1000"))
+	m.detectPositionChange("0xabc", state("1", "1100"))
+	assert.Empty(t, recorder.touched)
+
+	m.detectPositionChange("0xabc", state("2", "1100"))
+	assert.Equal(t, []string{"0xabc"}, recorder.touched)
+}
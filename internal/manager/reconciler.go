@@ -0,0 +1,355 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// 对账差异来源
+const (
+	ReconcileSourceCache = "cache" // PositionBalanceCache
+	ReconcileSourceDB    = "db"    // hl_position_cache
+)
+
+// StateFetcher 通过 REST 获取地址权威状态（由 hyperliquid.Info 实现）
+type StateFetcher interface {
+	UserState(ctx context.Context, address, dex string) (*hl.UserState, error)
+	SpotUserState(ctx context.Context, address string) (*hl.SpotUserState, error)
+}
+
+// DexLister 永续 dex 列表（由 cache.DexRegistry 实现）
+type DexLister interface {
+	Names() []string
+}
+
+// PositionStore 仓位缓存持久化查询接口
+type PositionStore interface {
+	GetPositionCache(address string) (*models.HlPositionCache, error)
+}
+
+// Discrepancy 对账差异
+type Discrepancy struct {
+	Source string  // cache/db
+	Field  string  // spot/futures/account_value/row
+	Coin   string  // 差异币种（account_value/row 为空）
+	Local  float64 // 本地值
+	Remote float64 // REST 权威值
+}
+
+// Reconciler 仓位对账器
+// 定期抽样监控地址，通过 REST 获取权威状态，与内存缓存和 hl_position_cache 对比，
+// 记录差异指标，并可选择使用 REST 数据修复缓存
+type Reconciler struct {
+	positions *PositionManager
+	fetcher   StateFetcher
+	store     PositionStore
+	dexes     DexLister // 永续 dex 注册表（可选），未设置时只查询主 dex
+	cfg       config.Reconcile
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewReconciler 创建仓位对账器
+func NewReconciler(positions *PositionManager, fetcher StateFetcher, cfg config.Reconcile) *Reconciler {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 10 * time.Minute
+	}
+	return &Reconciler{
+		positions: positions,
+		fetcher:   fetcher,
+		store:     dao.Position(),
+		cfg:       cfg,
+		done:      make(chan struct{}),
+	}
+}
+
+// SetStore 设置持久化查询（可选，用于测试）
+func (r *Reconciler) SetStore(store PositionStore) {
+	r.store = store
+}
+
+// SetDexRegistry 设置永续 dex 注册表，对账时逐个查询各 builder dex 的仓位
+func (r *Reconciler) SetDexRegistry(dexes DexLister) {
+	r.dexes = dexes
+}
+
+// perpDexes 对账时查询的永续 dex，主 dex 在前
+func (r *Reconciler) perpDexes() []string {
+	if r.dexes == nil {
+		return []string{""}
+	}
+	return append([]string{""}, r.dexes.Names()...)
+}
+
+// Start 启动对账循环
+func (r *Reconciler) Start() {
+	r.wg.Add(1)
+	goplus.Go(func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				r.reconcileOnce()
+			case <-r.done:
+				return
+			}
+		}
+	})
+
+	logger.Info().
+		Dur("interval", r.cfg.Interval).
+		Int("sample_size", r.cfg.SampleSize).
+		Bool("self_heal", r.cfg.SelfHeal).
+		Msg("position reconciler started")
+}
+
+// Stop 停止对账
+func (r *Reconciler) Stop() {
+	close(r.done)
+	r.wg.Wait()
+}
+
+// reconcileOnce 执行一轮抽样对账
+func (r *Reconciler) reconcileOnce() {
+	addrs := sampleAddresses(r.positions.Addresses(), r.cfg.SampleSize)
+
+	mismatched := 0
+	for _, addr := range addrs {
+		select {
+		case <-r.done:
+			return
+		default:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		diffs, err := r.reconcileAddress(ctx, addr)
+		cancel()
+		if err != nil {
+			logger.Warn().Err(err).Str("address", addr).Msg("reconcile address failed")
+			continue
+		}
+		if len(diffs) > 0 {
+			mismatched++
+		}
+	}
+
+	logger.Info().
+		Int("checked", len(addrs)).
+		Int("mismatched", mismatched).
+		Msg("position reconcile finished")
+}
+
+// reconcileAddress 对账单个地址，返回发现的差异
+func (r *Reconciler) reconcileAddress(ctx context.Context, addr string) ([]Discrepancy, error) {
+	webdata2, err := r.fetchState(ctx, addr)
+	if err != nil {
+		monitor.IncReconcileCheck("error")
+		return nil, err
+	}
+
+	snap := r.positions.parseSnapshot(webdata2)
+
+	diffs := r.diffCache(addr, snap)
+	diffs = append(diffs, r.diffStore(addr, snap)...)
+
+	if len(diffs) == 0 {
+		monitor.IncReconcileCheck("ok")
+		return nil, nil
+	}
+
+	monitor.IncReconcileCheck("mismatch")
+	for _, d := range diffs {
+		monitor.IncReconcileDiscrepancy(d.Source, d.Field)
+		logger.Warn().
+			Str("address", addr).
+			Str("source", d.Source).
+			Str("field", d.Field).
+			Str("coin", d.Coin).
+			Float64("local", d.Local).
+			Float64("remote", d.Remote).
+			Msg("position discrepancy detected")
+	}
+
+	if r.cfg.SelfHeal {
+		r.positions.applySnapshot(addr, snap)
+		monitor.IncReconcileHealed()
+		logger.Info().Str("address", addr).Int("discrepancies", len(diffs)).Msg("position cache healed from REST")
+	}
+
+	return diffs, nil
+}
+
+// fetchState 通过 REST 获取地址状态并组装为 webData2 格式
+func (r *Reconciler) fetchState(ctx context.Context, addr string) (*hl.WebData2, error) {
+	spot, err := r.fetcher.SpotUserState(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &hl.ClearinghouseState{}
+	for _, dex := range r.perpDexes() {
+		us, err := r.fetcher.UserState(ctx, addr, dex)
+		if err != nil {
+			return nil, err
+		}
+		// 账户价值以主 dex 为准
+		if dex == "" {
+			state.MarginSummary = &us.MarginSummary
+			state.CrossMarginSummary = &us.CrossMarginSummary
+			state.Withdrawable = us.Withdrawable
+		}
+		state.AssetPositions = append(state.AssetPositions, us.AssetPositions...)
+	}
+
+	return &hl.WebData2{
+		User:               addr,
+		ClearinghouseState: state,
+		SpotState:          &hl.SpotState{Balances: spot.Balances},
+	}, nil
+}
+
+// diffCache 对比内存缓存
+func (r *Reconciler) diffCache(addr string, snap *positionSnapshot) []Discrepancy {
+	balanceCache := r.positions.PositionBalanceCache()
+
+	var spot models.SpotBalancesData
+	var futures models.FuturesPositionsData
+	if s, f, ok := balanceCache.GetPositions(addr); ok {
+		if s != nil {
+			spot = *s
+		}
+		if f != nil {
+			futures = *f
+		}
+	}
+	accountValue, _ := balanceCache.GetAccountValue(addr)
+
+	return r.diffPositions(ReconcileSourceCache, snap, spot, futures, accountValue)
+}
+
+// diffStore 对比 hl_position_cache
+func (r *Reconciler) diffStore(addr string, snap *positionSnapshot) []Discrepancy {
+	if r.store == nil {
+		return nil
+	}
+
+	row, err := r.store.GetPositionCache(addr)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []Discrepancy{{Source: ReconcileSourceDB, Field: "row"}}
+	}
+	if err != nil {
+		logger.Warn().Err(err).Str("address", addr).Msg("load position cache for reconcile failed")
+		return nil
+	}
+
+	var spot models.SpotBalancesData
+	if row.SpotBalances != "" {
+		_ = json.Unmarshal([]byte(row.SpotBalances), &spot)
+	}
+	var futures models.FuturesPositionsData
+	if row.FuturesPositions != "" {
+		_ = json.Unmarshal([]byte(row.FuturesPositions), &futures)
+	}
+
+	return r.diffPositions(ReconcileSourceDB, snap, spot, futures, cast.ToFloat64(row.AccountValue))
+}
+
+func (r *Reconciler) diffPositions(
+	source string,
+	snap *positionSnapshot,
+	spot models.SpotBalancesData,
+	futures models.FuturesPositionsData,
+	accountValue float64,
+) []Discrepancy {
+	var diffs []Discrepancy
+
+	diffs = append(diffs, diffAmounts(source, "spot", spotAmounts(spot), spotAmounts(snap.spotBalances))...)
+	diffs = append(diffs, diffAmounts(source, "futures", futuresSizes(futures), futuresSizes(snap.futures))...)
+
+	// 账户价值随价格波动，按相对误差比较
+	remoteValue := cast.ToFloat64(snap.marginSummary.AccountValue)
+	if !withinTolerance(accountValue, remoteValue, r.cfg.Tolerance) {
+		diffs = append(diffs, Discrepancy{
+			Source: source,
+			Field:  "account_value",
+			Local:  accountValue,
+			Remote: remoteValue,
+		})
+	}
+
+	return diffs
+}
+
+// diffAmounts 对比币种数量，缺失的币种按 0 处理
+func diffAmounts(source, field string, local, remote map[string]float64) []Discrepancy {
+	var diffs []Discrepancy
+	for coin, remoteAmount := range remote {
+		if !withinTolerance(local[coin], remoteAmount, 1e-9) {
+			diffs = append(diffs, Discrepancy{Source: source, Field: field, Coin: coin, Local: local[coin], Remote: remoteAmount})
+		}
+	}
+	for coin, localAmount := range local {
+		if _, ok := remote[coin]; !ok && localAmount != 0 {
+			diffs = append(diffs, Discrepancy{Source: source, Field: field, Coin: coin, Local: localAmount})
+		}
+	}
+	return diffs
+}
+
+func spotAmounts(data models.SpotBalancesData) map[string]float64 {
+	result := make(map[string]float64, len(data))
+	for _, b := range data {
+		result[b.Coin] = cast.ToFloat64(b.Total)
+	}
+	return result
+}
+
+func futuresSizes(data models.FuturesPositionsData) map[string]float64 {
+	result := make(map[string]float64, len(data))
+	for _, p := range data {
+		result[p.Coin] = cast.ToFloat64(p.Szi)
+	}
+	return result
+}
+
+// withinTolerance 判断两个值的相对误差是否在容忍范围内
+func withinTolerance(a, b, tolerance float64) bool {
+	diff := math.Abs(a - b)
+	if diff == 0 {
+		return true
+	}
+	scale := math.Max(math.Abs(a), math.Abs(b))
+	return diff <= tolerance*scale
+}
+
+// sampleAddresses 随机抽样地址，n <= 0 时返回全部
+func sampleAddresses(addrs []string, n int) []string {
+	if n <= 0 || n >= len(addrs) {
+		return addrs
+	}
+	rand.Shuffle(len(addrs), func(i, j int) {
+		addrs[i], addrs[j] = addrs[j], addrs[i]
+	})
+	return addrs[:n]
+}
@@ -0,0 +1,166 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/processor"
+	"github.com/utrading/utrading-hl-monitor/internal/valuation"
+)
+
+type mockStateFetcher struct {
+	state    *hl.UserState
+	dexState map[string]*hl.UserState
+	spot     *hl.SpotUserState
+}
+
+func (f *mockStateFetcher) UserState(_ context.Context, _, dex string) (*hl.UserState, error) {
+	if dex != "" {
+		if state, ok := f.dexState[dex]; ok {
+			return state, nil
+		}
+		return &hl.UserState{}, nil
+	}
+	return f.state, nil
+}
+
+func (f *mockStateFetcher) SpotUserState(_ context.Context, _ string) (*hl.SpotUserState, error) {
+	return f.spot, nil
+}
+
+type mockPositionStore struct {
+	row *models.HlPositionCache
+}
+
+func (s *mockPositionStore) GetPositionCache(_ string) (*models.HlPositionCache, error) {
+	if s.row == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return s.row, nil
+}
+
+func newTestPositionManager() *PositionManager {
+	return &PositionManager{
+		positionBalanceCache: cache.NewPositionBalanceCache(),
+		messageQueue:         processor.NewMessageQueue(10, nil),
+		states:               hl.NewUserStateDiffer(),
+		valuer:               valuation.NewValuer(config.Valuation{}, nil, nil),
+	}
+}
+
+func TestReconciler_ReconcileAddress(t *testing.T) {
+	const addr = "0xabc"
+
+	fetcher := &mockStateFetcher{
+		state: &hl.UserState{
+			AssetPositions: []hl.AssetPosition{
+				{Position: hl.Position{Coin: "BTC", Szi: "0.5"}},
+			},
+			CrossMarginSummary: hl.MarginSummary{AccountValue: "1000"},
+		},
+		spot: &hl.SpotUserState{
+			Balances: []hl.SpotBalance{{Coin: "USDC", Total: "200"}},
+		},
+	}
+
+	positions := newTestPositionManager()
+	store := &mockPositionStore{
+		row: &models.HlPositionCache{
+			Address:          addr,
+			SpotBalances:     `[{"coin":"USDC","total":"200"}]`,
+			FuturesPositions: `[{"coin":"BTCUSDC","szi":"0.5"}]`,
+			AccountValue:     "1000",
+		},
+	}
+
+	r := NewReconciler(positions, fetcher, config.Reconcile{Tolerance: 0.01})
+	r.SetStore(store)
+
+	// 缓存中合约仓位与 REST 不一致
+	spot := models.SpotBalancesData{{Coin: "USDC", Total: "200"}}
+	futures := models.FuturesPositionsData{{Coin: "BTCUSDC", Szi: "0.3"}, {Coin: "ETHUSDC", Szi: "1"}}
+	positions.positionBalanceCache.Set(addr, 200, 1005, &spot, &futures)
+
+	diffs, err := r.reconcileAddress(context.Background(), addr)
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+	for _, d := range diffs {
+		assert.Equal(t, ReconcileSourceCache, d.Source)
+		assert.Equal(t, "futures", d.Field)
+	}
+
+	// 未开启自动修复时缓存保持不变
+	size, _ := positions.positionBalanceCache.GetFuturesPosition(addr, "BTCUSDC")
+	assert.Equal(t, 0.3, size)
+
+	// 开启自动修复后缓存与 REST 一致
+	r.cfg.SelfHeal = true
+	_, err = r.reconcileAddress(context.Background(), addr)
+	require.NoError(t, err)
+
+	diffs, err = r.reconcileAddress(context.Background(), addr)
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+
+	// 数据库缺少记录
+	store.row = nil
+	diffs, err = r.reconcileAddress(context.Background(), addr)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	assert.Equal(t, ReconcileSourceDB, diffs[0].Source)
+	assert.Equal(t, "row", diffs[0].Field)
+}
+
+func TestReconciler_FetchStateDexes(t *testing.T) {
+	fetcher := &mockStateFetcher{
+		state: &hl.UserState{
+			AssetPositions:     []hl.AssetPosition{{Position: hl.Position{Coin: "BTC", Szi: "0.5"}}},
+			CrossMarginSummary: hl.MarginSummary{AccountValue: "1000"},
+		},
+		dexState: map[string]*hl.UserState{
+			"xyz": {AssetPositions: []hl.AssetPosition{{Position: hl.Position{Coin: "xyz:TSLA", Szi: "2"}}}},
+			"flx": {AssetPositions: []hl.AssetPosition{{Position: hl.Position{Coin: "flx:GOLD", Szi: "1"}}}},
+		},
+		spot: &hl.SpotUserState{},
+	}
+	r := NewReconciler(newTestPositionManager(), fetcher, config.Reconcile{})
+
+	state, err := r.fetchState(context.Background(), "0xabc")
+	require.NoError(t, err)
+	require.Len(t, state.ClearinghouseState.AssetPositions, 1, "only the main dex without a registry")
+
+	dexes := cache.NewDexRegistry()
+	dexes.Set([]hl.PerpDex{{}, {Name: "xyz"}, {Name: "flx"}})
+	r.SetDexRegistry(dexes)
+
+	state, err = r.fetchState(context.Background(), "0xabc")
+	require.NoError(t, err)
+	coins := make([]string, 0, 3)
+	for _, pos := range state.ClearinghouseState.AssetPositions {
+		coins = append(coins, pos.Position.Coin)
+	}
+	assert.Equal(t, []string{"BTC", "xyz:TSLA", "flx:GOLD"}, coins)
+	assert.Equal(t, "1000", state.ClearinghouseState.CrossMarginSummary.AccountValue)
+}
+
+func TestWithinTolerance(t *testing.T) {
+	assert.True(t, withinTolerance(0, 0, 0))
+	assert.True(t, withinTolerance(1000, 1005, 0.01))
+	assert.False(t, withinTolerance(1000, 1020, 0.01))
+	assert.False(t, withinTolerance(0, 1, 0.01))
+}
+
+func TestSampleAddresses(t *testing.T) {
+	addrs := []string{"a", "b", "c", "d"}
+	assert.Len(t, sampleAddresses(addrs, 0), 4)
+	assert.Len(t, sampleAddresses(addrs, 10), 4)
+	assert.Len(t, sampleAddresses(addrs, 2), 2)
+}
@@ -0,0 +1,108 @@
+package manager
+
+import (
+	"sync"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// signalStatsDateLayout 信号每日统计的统计日期格式（UTC）
+const signalStatsDateLayout = "2006-01-02"
+
+// SignalStatsStore 信号每日统计汇总接口
+type SignalStatsStore interface {
+	// Rollup 汇总 [from, to) 内写入的信号，覆盖 statDate 的统计，返回写入行数
+	Rollup(statDate string, from, to time.Time) (int, error)
+}
+
+// daoSignalStatsStore 基于 DAO 的信号统计存储
+type daoSignalStatsStore struct{}
+
+func (daoSignalStatsStore) Rollup(statDate string, from, to time.Time) (int, error) {
+	return dao.SignalStats().Rollup(statDate, from, to)
+}
+
+// SignalStatsRollup 信号每日统计汇总
+// 启动时及每隔 interval 从 hl_address_signals 重算当日及前 lookback_days 日（UTC）的统计，覆盖写入 hl_signal_daily_stats；
+// 按原始信号重算而非累加，重启、多实例同时执行或重复执行结果一致
+type SignalStatsRollup struct {
+	store SignalStatsStore
+	cfg   config.SignalStats
+	now   func() time.Time
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewSignalStatsRollup 创建信号每日统计汇总
+func NewSignalStatsRollup(cfg config.SignalStats) *SignalStatsRollup {
+	return &SignalStatsRollup{
+		store: daoSignalStatsStore{},
+		cfg:   cfg,
+		now:   time.Now,
+		done:  make(chan struct{}),
+	}
+}
+
+// SetStore 设置存储（可选，用于测试）
+func (r *SignalStatsRollup) SetStore(store SignalStatsStore) {
+	r.store = store
+}
+
+// Start 启动定期汇总
+func (r *SignalStatsRollup) Start() {
+	r.wg.Add(1)
+	goplus.Go(func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.cfg.Interval)
+		defer ticker.Stop()
+
+		r.Run()
+		for {
+			select {
+			case <-ticker.C:
+				r.Run()
+			case <-r.done:
+				return
+			}
+		}
+	})
+
+	logger.Info().
+		Dur("interval", r.cfg.Interval).
+		Int("lookback_days", r.cfg.LookbackDays).
+		Msg("signal stats rollup started")
+}
+
+// Stop 停止汇总
+func (r *SignalStatsRollup) Stop() {
+	close(r.done)
+	r.wg.Wait()
+}
+
+// Run 汇总一次，从最早的日期开始，单个日期失败不影响其他日期
+func (r *SignalStatsRollup) Run() {
+	now := r.now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	for i := r.cfg.LookbackDays; i >= 0; i-- {
+		from := today.AddDate(0, 0, -i)
+		statDate := from.Format(signalStatsDateLayout)
+		start := time.Now()
+		rows, err := r.store.Rollup(statDate, from, from.AddDate(0, 0, 1))
+		if err != nil {
+			logger.Error().Err(err).Str("stat_date", statDate).Msg("rollup signal stats failed")
+			continue
+		}
+		logger.Debug().
+			Str("stat_date", statDate).
+			Int("rows", rows).
+			Dur("elapsed", time.Since(start)).
+			Msg("signal stats rolled up")
+	}
+}
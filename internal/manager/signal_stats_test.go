@@ -0,0 +1,45 @@
+package manager
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+)
+
+type rollupCall struct {
+	date     string
+	from, to time.Time
+}
+
+type mockSignalStatsStore struct {
+	calls []rollupCall
+	fail  string
+}
+
+func (s *mockSignalStatsStore) Rollup(statDate string, from, to time.Time) (int, error) {
+	s.calls = append(s.calls, rollupCall{date: statDate, from: from, to: to})
+	if statDate == s.fail {
+		return 0, errors.New("db down")
+	}
+	return 1, nil
+}
+
+func TestSignalStatsRollup_Run(t *testing.T) {
+	store := &mockSignalStatsStore{fail: "2026-03-01"}
+	r := NewSignalStatsRollup(config.SignalStats{Interval: time.Minute, LookbackDays: 2})
+	r.SetStore(store)
+	r.now = func() time.Time { return time.Date(2026, 3, 3, 8, 30, 0, 0, time.FixedZone("UTC+9", 9*3600)) }
+
+	r.Run()
+
+	// 当地时间 3 月 3 日 08:30 为 UTC 3 月 2 日 23:30；失败的日期不影响后续日期
+	assert.Equal(t, []rollupCall{
+		{"2026-02-28", time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"2026-03-01", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)},
+		{"2026-03-02", time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)},
+	}, store.calls)
+}
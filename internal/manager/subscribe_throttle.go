@@ -0,0 +1,60 @@
+package manager
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+)
+
+// SubscribeThrottle WS 订阅限速器
+// 订阅管理器和仓位管理器共用，按固定间隔依次放行订阅请求并叠加随机抖动，
+// 避免启动时批量加载地址触发 Hyperliquid 订阅频率限制
+type SubscribeThrottle struct {
+	interval time.Duration // 相邻两次订阅的最小间隔
+	jitter   time.Duration // 随机抖动上限
+
+	mu   sync.Mutex
+	next time.Time // 下一个可用的放行时间
+}
+
+// NewSubscribeThrottle 创建订阅限速器，Rate <= 0 时仅叠加抖动
+func NewSubscribeThrottle(cfg config.SubscribeThrottle) *SubscribeThrottle {
+	t := &SubscribeThrottle{jitter: cfg.Jitter}
+	if cfg.Rate > 0 {
+		t.interval = time.Duration(float64(time.Second) / cfg.Rate)
+	}
+	return t
+}
+
+// Wait 阻塞直到轮到本次订阅，未设置限速器时立即返回
+func (t *SubscribeThrottle) Wait() {
+	if t == nil || (t.interval <= 0 && t.jitter <= 0) {
+		return
+	}
+
+	monitor.AddSubscribeWarmupPending(1)
+	defer monitor.AddSubscribeWarmupPending(-1)
+
+	// 预约放行时间，排队的请求依次顺延一个间隔
+	t.mu.Lock()
+	now := time.Now()
+	slot := t.next
+	if slot.Before(now) {
+		slot = now
+	}
+	t.next = slot.Add(t.interval)
+	t.mu.Unlock()
+
+	delay := slot.Sub(now)
+	if t.jitter > 0 {
+		delay += time.Duration(rand.Int63n(int64(t.jitter)))
+	}
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+
+	monitor.IncSubscribeWarmupCompleted()
+}
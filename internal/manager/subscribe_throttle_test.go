@@ -0,0 +1,47 @@
+package manager
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+)
+
+func TestSubscribeThrottle_Paces(t *testing.T) {
+	throttle := NewSubscribeThrottle(config.SubscribeThrottle{Rate: 100})
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			throttle.Wait()
+		}()
+	}
+	wg.Wait()
+
+	// 6 个请求按 10ms 间隔放行，最后一个至少等待 50ms
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	// 空闲后不积累额度，也不额外等待
+	time.Sleep(20 * time.Millisecond)
+	start = time.Now()
+	throttle.Wait()
+	assert.Less(t, time.Since(start), 10*time.Millisecond)
+}
+
+func TestSubscribeThrottle_Disabled(t *testing.T) {
+	var nilThrottle *SubscribeThrottle
+	throttle := NewSubscribeThrottle(config.SubscribeThrottle{})
+
+	start := time.Now()
+	for i := 0; i < 100; i++ {
+		nilThrottle.Wait()
+		throttle.Wait()
+	}
+	assert.Less(t, time.Since(start), 10*time.Millisecond)
+}
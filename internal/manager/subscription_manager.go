@@ -1,7 +1,7 @@
 package manager
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"math"
 	"strings"
@@ -39,12 +39,23 @@ type SubscriptionManager struct {
 	publisher            Publisher
 	addresses            concurrent.Map[string, struct{}]
 	subs                 map[string]*ws.SubscriptionHandle // fills 和 updates 订阅句柄
-	messageQueue         *processor.MessageQueue           // 消息队列
+	messageQueue         processor.Queue                   // 消息队列（进程内或外部代理）
 	orderProcessor       *processor.OrderProcessor         // 订单处理器
 	deduper              *OrderDeduper                     // 订单去重器
 	positionBalanceCache *cache.PositionBalanceCache       // 仓位余额缓存
-	oidToAddress         concurrent.Map[int64, string]     // Oid 到地址的映射（用于 OrderUpdates 地址隔离）
+	oidToAddress         oidOwners                         // Oid 到地址的映射（用于 OrderUpdates 地址隔离），带过期和条目上限
+	openOrderOwners      concurrent.Map[int64, string]     // 挂单 Oid 到地址的映射（来自 webData2，用于未成交订单的撤销事件）
+	cancelPublisher      CancelPublisher                   // 撤单事件发布器（可选）
+	delistedPublisher    DelistedPublisher                 // 资产下架通知发布器（可选）
+	openOrderMirror      OpenOrderMirror                   // 挂单镜像（可选）
+	leader               processor.LeaderChecker           // 主备角色（可选），备实例不发送事件
 	symbolCache          *cache.SymbolCache                // Symbol 缓存
+	normalizer           SymbolNormalizer                  // 合约资产名规范化（过滤未配置规则的 dex 资产）
+	throttle             *SubscribeThrottle                // 订阅限速（可选）
+	activity             ActivityRecorder                  // 地址活跃度记录（可选）
+	pause                pauseSwitch                       // 暂停开关
+	watermarks           *FillWatermarks                   // 成交高水位（可选），跳过重启后快照中的重放成交
+	pnl                  PnLRecorder                       // 已实现盈亏记录（可选）
 	mu                   sync.RWMutex
 	done                 chan struct{}
 }
@@ -56,6 +67,28 @@ type Publisher interface {
 	PublishAddressSignal(signal *nats.HlAddressSignal) error
 }
 
+// CancelPublisher 订单撤销/拒绝事件发布接口
+type CancelPublisher interface {
+	PublishOrderCancelled(event *nats.HlOrderCancelled) error
+}
+
+// OpenOrderMirror 挂单镜像接口（由 openorders.Mirror 实现）
+type OpenOrderMirror interface {
+	Snapshot(addr string, orders []hl.WsBasicOrder)
+	Update(addr string, wsOrder hl.WsOrder)
+	RemoveAddress(addr string)
+}
+
+// ActivityRecorder 地址活跃度记录接口（成交或仓位变化时调用）
+type ActivityRecorder interface {
+	Touch(addr string)
+}
+
+// PnLRecorder 已实现盈亏记录接口（由 PnLTracker 实现）
+type PnLRecorder interface {
+	RecordFill(address string, fill hl.WsOrderFill)
+}
+
 // NewSubscriptionManager 创建订阅管理器
 func NewSubscriptionManager(
 	poolManager *ws.PoolManager,
@@ -88,8 +121,10 @@ func NewSubscriptionManager(
 		orderProcessor:       orderProcessor,
 		deduper:              deduper,
 		positionBalanceCache: positionBalanceCache,
-		oidToAddress:         concurrent.Map[int64, string]{},
+		oidToAddress:         oidOwners{ttl: defaultOidOwnerTTL, maxSize: defaultOidOwnerMaxSize},
 		symbolCache:          symbolCache,
+		normalizer:           defaultSymbolNormalizer,
+		pause:                pauseSwitch{name: "subscription_manager"},
 		done:                 make(chan struct{}),
 	}
 
@@ -103,6 +138,252 @@ func (m *SubscriptionManager) SetDeduper(deduper *OrderDeduper) {
 	m.deduper = deduper
 }
 
+// SetDedupMaxEntries 设置订单去重缓存的条目上限（0 表示不限制）
+func (m *SubscriptionManager) SetDedupMaxEntries(maxEntries int) {
+	m.deduper.SetMaxEntries(maxEntries)
+}
+
+// SetActivityRecorder 设置地址活跃度记录器（可选）
+func (m *SubscriptionManager) SetActivityRecorder(recorder ActivityRecorder) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.activity = recorder
+}
+
+// SetAddressScopes 设置地址去重作用域（可选），同一地址的不同作用域独立去重
+func (m *SubscriptionManager) SetAddressScopes(scopes *cache.AddressScopes) {
+	m.deduper.SetAddressScopes(scopes)
+	m.orderProcessor.SetAddressScopes(scopes)
+}
+
+// SetLeaderChecker 设置主备角色查询（热备模式），备实例不发送信号
+func (m *SubscriptionManager) SetLeaderChecker(leader processor.LeaderChecker) {
+	m.mu.Lock()
+	m.leader = leader
+	m.mu.Unlock()
+	m.orderProcessor.SetLeaderChecker(leader)
+}
+
+// SetCancelPublisher 设置撤单事件发布器（可选），未成交即撤销/拒绝的订单将发布 hl.order.cancelled 事件
+func (m *SubscriptionManager) SetCancelPublisher(publisher CancelPublisher) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cancelPublisher = publisher
+}
+
+// SetOpenOrderMirror 设置挂单镜像（可选），webData2 挂单快照和 orderUpdates 同步到镜像
+func (m *SubscriptionManager) SetOpenOrderMirror(mirror OpenOrderMirror) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.openOrderMirror = mirror
+}
+
+// TrackOpenOrders 记录地址当前挂单（由 webData2 驱动），orderUpdates 不含地址，
+// 未成交订单只能通过挂单列表确定归属
+func (m *SubscriptionManager) TrackOpenOrders(addr string, orders []hl.WsBasicOrder) {
+	for _, order := range orders {
+		m.openOrderOwners.Store(order.Oid, addr)
+		m.orderProcessor.RecordOrigSize(addr, order.Oid, cast.ToFloat64(order.OrigSz))
+	}
+
+	m.mu.RLock()
+	mirror := m.openOrderMirror
+	m.mu.RUnlock()
+	if mirror != nil {
+		mirror.Snapshot(addr, orders)
+	}
+}
+
+// updateOpenOrderMirror 将订单状态变化同步到挂单镜像
+// 仅处理归属已知的订单，新挂单由下一次 webData2 快照补齐
+func (m *SubscriptionManager) updateOpenOrderMirror(user string, wsOrder hl.WsOrder) {
+	m.mu.RLock()
+	mirror := m.openOrderMirror
+	m.mu.RUnlock()
+	if mirror == nil {
+		return
+	}
+
+	owner, ok := m.openOrderOwners.Load(wsOrder.Order.Oid)
+	if !ok {
+		owner, ok = m.oidToAddress.Load(wsOrder.Order.Oid)
+	}
+	if !ok || owner != user {
+		return
+	}
+	mirror.Update(owner, wsOrder)
+}
+
+// SetPriceCache 设置价格缓存，用于记录成交时的中间价和滑点
+func (m *SubscriptionManager) SetPriceCache(priceCache *cache.PriceCache) {
+	m.orderProcessor.SetPriceCache(priceCache)
+}
+
+// SetMarketCtxCache 设置合约市场上下文缓存（可选），合约信号附带流动性信息
+func (m *SubscriptionManager) SetMarketCtxCache(marketCtxs *cache.MarketCtxCache) {
+	m.orderProcessor.SetMarketCtxCache(marketCtxs)
+}
+
+// SetLeverageSource 设置杠杆查询（可选），仓位缓存缺少合约杠杆时通过 activeAssetData 查询
+func (m *SubscriptionManager) SetLeverageSource(source processor.LeverageSource) {
+	m.orderProcessor.SetLeverageSource(source)
+}
+
+// SetAddressLabeler 设置地址标签查询（可选），信号附带地址标签
+func (m *SubscriptionManager) SetAddressLabeler(labeler processor.AddressLabeler) {
+	m.orderProcessor.SetAddressLabeler(labeler)
+}
+
+// SetSymbolNormalizer 设置 symbol 规范化规则（默认内置规则），需在订阅地址前调用
+// 未命中合约规则的 dex 资产成交和订单更新将被忽略
+func (m *SubscriptionManager) SetSymbolNormalizer(normalizer SymbolNormalizer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.normalizer = normalizer
+}
+
+func (m *SubscriptionManager) symbolNormalizer() SymbolNormalizer {
+	if m.normalizer == nil {
+		return defaultSymbolNormalizer
+	}
+	return m.normalizer
+}
+
+// SetValuer 设置估值器（可选），信号附带计价货币价值
+func (m *SubscriptionManager) SetValuer(valuer processor.Valuer) {
+	m.orderProcessor.SetValuer(valuer)
+}
+
+// SetPositionRateStrategy 设置仓位比例分母策略（可选），不同作用域可使用不同分母
+func (m *SubscriptionManager) SetPositionRateStrategy(strategy *processor.PositionRateStrategy) {
+	m.orderProcessor.SetPositionRateStrategy(strategy)
+}
+
+// SetSeverityClassifier 设置信号级别分类器（可选）
+func (m *SubscriptionManager) SetSeverityClassifier(classifier *processor.SeverityClassifier) {
+	m.orderProcessor.SetSeverityClassifier(classifier)
+}
+
+// SetSubscribeThrottle 设置订阅限速器（可选），需在订阅地址前调用
+func (m *SubscriptionManager) SetSubscribeThrottle(throttle *SubscribeThrottle) {
+	m.throttle = throttle
+}
+
+// SetPersistFills 设置是否保存原始成交到 hl_fills（可选）
+func (m *SubscriptionManager) SetPersistFills(enabled bool) {
+	m.orderProcessor.SetPersistFills(enabled)
+}
+
+// SetPnLRecorder 设置已实现盈亏记录（可选）
+// 每笔成交在去重和信号发送之前记录，与是否发送信号无关，备实例不记录
+func (m *SubscriptionManager) SetPnLRecorder(recorder PnLRecorder) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pnl = recorder
+}
+
+// AddSignalHook 注册信号钩子（可选），发布前修改、补充或丢弃信号
+func (m *SubscriptionManager) AddSignalHook(name string, hook processor.SignalHook) {
+	m.orderProcessor.AddSignalHook(name, hook)
+}
+
+// SetMaxPending 设置聚合中的订单上限（可选），超出时最早的订单提前发送
+func (m *SubscriptionManager) SetMaxPending(maxPending int) {
+	m.orderProcessor.SetMaxPending(maxPending)
+}
+
+// SetCloidGrouping 设置按 cloid 聚合订单（可选）
+func (m *SubscriptionManager) SetCloidGrouping(enabled bool, replaceWindow time.Duration) {
+	m.orderProcessor.SetCloidGrouping(enabled, replaceWindow)
+}
+
+// SetAggregationStrategies 设置按地址选择的订单聚合策略（可选）
+func (m *SubscriptionManager) SetAggregationStrategies(strategies *processor.AggregationStrategies) {
+	m.orderProcessor.SetAggregationStrategies(strategies)
+}
+
+// SetSignalFilters 设置按地址的信号过滤（可选）
+func (m *SubscriptionManager) SetSignalFilters(filters *processor.SignalFilters) {
+	m.orderProcessor.SetSignalFilters(filters)
+}
+
+// SetOidOwnerLimits 设置 Oid 到地址映射的过期时间和条目上限（0 表示不限制）
+func (m *SubscriptionManager) SetOidOwnerLimits(ttl time.Duration, maxSize int) {
+	m.oidToAddress.SetLimits(ttl, maxSize)
+}
+
+// SetStatusTracker 替换订单状态追踪器（可选）
+func (m *SubscriptionManager) SetStatusTracker(tracker processor.OrderStatusTracker) {
+	m.orderProcessor.SetStatusTracker(tracker)
+}
+
+// SetSignalOutbox 设置信号发件箱（可选），信号与订单聚合同事务写库后由发件箱发布
+func (m *SubscriptionManager) SetSignalOutbox(outbox processor.SignalEnqueuer) {
+	m.orderProcessor.SetSignalOutbox(outbox)
+}
+
+// SetProgressThresholds 设置成交进度阈值（可选，百分比），聚合完成前累计成交越过阈值时发送进度信号
+func (m *SubscriptionManager) SetProgressThresholds(thresholds []float64) {
+	m.orderProcessor.SetProgressThresholds(thresholds)
+}
+
+// SetSpotSellMode 设置现货卖出的方向映射模式（close/detect）
+func (m *SubscriptionManager) SetSpotSellMode(mode string) {
+	m.orderProcessor.SetSpotSellMode(mode)
+}
+
+// SetFillWatermarks 设置成交高水位（可选）
+func (m *SubscriptionManager) SetFillWatermarks(watermarks *FillWatermarks) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.watermarks = watermarks
+}
+
+// SetQueue 替换消息队列（外部队列模式），需在订阅地址前调用，原进程内队列将被停止
+func (m *SubscriptionManager) SetQueue(queue processor.Queue) {
+	m.mu.Lock()
+	old := m.messageQueue
+	m.messageQueue = queue
+	m.mu.Unlock()
+
+	if old != nil {
+		old.Stop()
+	}
+}
+
+// SetProcessLanes 设置进程内队列的串行通道数，需在订阅地址前调用
+// 同一地址固定路由到同一通道，不同地址并行处理
+func (m *SubscriptionManager) SetProcessLanes(lanes int) {
+	queue := processor.NewMessageQueueWithLanes(10000, lanes, m.orderProcessor)
+	queue.Start()
+	m.SetQueue(queue)
+}
+
+// OrderProcessor 获取订单处理器（外部队列处理层作为消息处理器）
+func (m *SubscriptionManager) OrderProcessor() *processor.OrderProcessor {
+	return m.orderProcessor
+}
+
+// InjectMessage 向消息队列注入消息，与真实成交走同一处理链路（用于自检心跳）
+func (m *SubscriptionManager) InjectMessage(msg processor.Message) error {
+	m.mu.RLock()
+	queue := m.messageQueue
+	m.mu.RUnlock()
+	return queue.Enqueue(msg)
+}
+
+// QueueDepth 返回进程内消息队列的积压条数，外部队列（由代理缓存消息）返回 0
+func (m *SubscriptionManager) QueueDepth() int {
+	m.mu.RLock()
+	queue := m.messageQueue
+	m.mu.RUnlock()
+
+	if q, ok := queue.(*processor.MessageQueue); ok {
+		return q.Size()
+	}
+	return 0
+}
+
 // GetDeduper 获取去重器
 func (m *SubscriptionManager) GetDeduper() *OrderDeduper {
 	m.mu.RLock()
@@ -120,6 +401,38 @@ func (m *SubscriptionManager) SubscribeAddress(addr string) error {
 	return m.subscribeAddress(addr)
 }
 
+// Pause 暂停处理：保留订阅但丢弃成交和订单更新，并暂停信号发送
+func (m *SubscriptionManager) Pause() {
+	if m.pause.set(true) {
+		m.orderProcessor.SetPaused(true)
+	}
+}
+
+// Resume 恢复处理，暂停前已聚合的订单在下一次超时扫描时发送
+func (m *SubscriptionManager) Resume() {
+	if m.pause.set(false) {
+		m.orderProcessor.SetPaused(false)
+	}
+}
+
+// Paused 是否处于暂停状态
+func (m *SubscriptionManager) Paused() bool {
+	return m.pause.paused.Load()
+}
+
+// UnsubscribeAll 取消全部地址的订阅，返回取消的地址数
+func (m *SubscriptionManager) UnsubscribeAll() int {
+	count := 0
+	for _, addr := range m.Addresses() {
+		if err := m.UnsubscribeAddress(addr); err != nil {
+			logger.Error().Err(err).Str("address", addr).Msg("unsubscribe address failed")
+			continue
+		}
+		count++
+	}
+	return count
+}
+
 // UnsubscribeAddress 取消订阅地址
 func (m *SubscriptionManager) UnsubscribeAddress(addr string) error {
 	if _, exists := m.addresses.LoadAndDelete(addr); !exists {
@@ -140,11 +453,13 @@ func (m *SubscriptionManager) UnsubscribeAddress(addr string) error {
 	}
 
 	// 清理该地址的 Oid 映射
-	m.oidToAddress.Range(func(oid int64, addr string) bool {
-		m.oidToAddress.Delete(oid)
-		return true
-	})
+	m.oidToAddress.RemoveAddress(addr)
 
+	if m.openOrderMirror != nil {
+		m.openOrderMirror.RemoveAddress(addr)
+	}
+
+	monitor.DeleteMessageLag(string(ws.ChannelUserFills), addr)
 	logger.Info().Str("address", addr).Msg("unsubscribed order fills and updates")
 
 	monitor.GetMetrics().SetAddressesCount(m.AddressCount())
@@ -162,11 +477,9 @@ func (m *SubscriptionManager) subscribeAddress(addr string) error {
 		User:    addr,
 	}
 
-	fillsHandle, err := m.poolManager.Subscribe(fillsSub, func(msg ws.WsMessage) error {
-		// 解析 order fills 消息
-		var fills hl.WsOrderFills
-		if err := json.Unmarshal(msg.Data, &fills); err != nil {
-			logger.Error().Err(err).Str("address", addr).Msg("failed to unmarshal order fills")
+	m.throttle.Wait()
+	fillsHandle, err := ws.SubscribeReceived(m.poolManager, fillsSub, func(fills hl.WsOrderFills, receivedAt time.Time) error {
+		if m.pause.drop() {
 			return nil
 		}
 
@@ -177,7 +490,7 @@ func (m *SubscriptionManager) subscribeAddress(addr string) error {
 			return nil
 		}
 
-		// 转换为 hyperliquid.WsOrderFills 格式（复用现有逻辑）
+		observeFillsLag(fills, receivedAt)
 		m.handleWsOrderFills(fills)
 		return nil
 	})
@@ -191,11 +504,9 @@ func (m *SubscriptionManager) subscribeAddress(addr string) error {
 		User:    addr,
 	}
 
-	updatesHandle, err := m.poolManager.Subscribe(updatesSub, func(msg ws.WsMessage) error {
-		// 解析 order updates 消息（数组格式）
-		var orders []hl.WsOrder
-		if err := json.Unmarshal(msg.Data, &orders); err != nil {
-			logger.Error().Err(err).Str("address", addr).Msg("failed to unmarshal order updates")
+	m.throttle.Wait()
+	updatesHandle, err := ws.Subscribe(m.poolManager, updatesSub, func(orders []hl.WsOrder) error {
+		if m.pause.drop() {
 			return nil
 		}
 
@@ -229,21 +540,26 @@ func (m *SubscriptionManager) handleWsOrderUpdates(user string, orders []hl.WsOr
 	for _, wsOrder := range orders {
 		order := wsOrder.Order
 
-		if strings.Contains(order.Coin, ":") {
-			parts := strings.Split(order.Coin, ":")
-			if parts[0] != "xyz" {
-				logger.Debug().
-					Str("address", user).
-					Str("coin", order.Coin).
-					Int64("order_id", order.Oid).
-					Msg("skipped order")
-				continue
-			}
+		if !m.symbolNormalizer().Accepts(order.Coin) {
+			logger.Debug().
+				Str("address", user).
+				Str("coin", order.Coin).
+				Int64("order_id", order.Oid).
+				Msg("skipped order")
+			continue
 		}
 
+		m.updateOpenOrderMirror(user, wsOrder)
+
 		// 通过 Oid 查找地址（从 OrderFills 中建立的映射）
 		addr, ok := m.oidToAddress.Load(order.Oid)
 		if !ok {
+			// 无成交的挂单：终止时发布撤单事件
+			if m.handleUnfilledOrderUpdate(user, wsOrder) {
+				processedCount++
+				continue
+			}
+
 			// 未找到映射，可能是订阅启动前的订单，跳过
 			logger.Debug().
 				Int64("oid", order.Oid).
@@ -262,6 +578,11 @@ func (m *SubscriptionManager) handleWsOrderUpdates(user string, orders []hl.WsOr
 			continue
 		}
 
+		// orderUpdates 为广播分发，在解析出地址后记录订阅活跃时间
+		if m.poolManager != nil {
+			m.poolManager.MarkSeen(ws.Subscription{Channel: ws.ChannelOrderUpdates, User: addr})
+		}
+
 		logger.Info().Str("address", addr).
 			Str("user", user).
 			Int64("oid", order.Oid).
@@ -269,10 +590,12 @@ func (m *SubscriptionManager) handleWsOrderUpdates(user string, orders []hl.WsOr
 			Msg("order update: processing order")
 
 		if wsOrder.Status == "open" || wsOrder.Status == "triggered" {
+			// 非终止状态仅记录原始数量，成交量达到原始数量时提前 flush
+			m.enqueueOrderUpdate(addr, wsOrder)
 			logger.Debug().
 				Int64("oid", order.Oid).
 				Str("status", string(wsOrder.Status)).
-				Msg("order update: status not terminal, skipping")
+				Msg("order update: status not terminal, orig size recorded")
 			skippedCount++
 			continue
 		}
@@ -293,19 +616,9 @@ func (m *SubscriptionManager) handleWsOrderUpdates(user string, orders []hl.WsOr
 		// 注意：orderUpdates 不包含 direction 信息，暂时使用空字符串
 		// 实际业务中，反手订单的两个方向会共享同一个状态
 		// 将状态更新放入消息队列，保证与 fills 按顺序处理
-		msg := processor.OrderUpdateMessage{
-			Address:   addr,
-			Oid:       order.Oid,
-			Status:    string(wsOrder.Status),
-			Direction: "",
-		}
-		if err := m.messageQueue.Enqueue(msg); err != nil {
-			logger.Error().Err(err).
-				Str("address", addr).
-				Int64("oid", order.Oid).
-				Msg("failed to enqueue order update")
-		}
+		m.enqueueOrderUpdate(addr, wsOrder)
 		m.oidToAddress.Delete(order.Oid)
+		m.openOrderOwners.Delete(order.Oid)
 		processedCount++
 	}
 
@@ -317,6 +630,114 @@ func (m *SubscriptionManager) handleWsOrderUpdates(user string, orders []hl.WsOr
 	}
 }
 
+// enqueueOrderUpdate 将订单状态和原始数量放入消息队列，保证与 fills 按顺序处理
+// orderUpdates 不包含 direction 信息，反手订单的两个方向共享同一个状态
+func (m *SubscriptionManager) enqueueOrderUpdate(addr string, wsOrder hl.WsOrder) {
+	msg := processor.OrderUpdateMessage{
+		Address:   addr,
+		Oid:       wsOrder.Order.Oid,
+		Status:    string(wsOrder.Status),
+		Direction: "",
+		OrigSz:    wsOrder.Order.OrigSz,
+	}
+	if err := m.messageQueue.Enqueue(msg); err != nil {
+		logger.Error().Err(err).
+			Str("address", addr).
+			Int64("oid", wsOrder.Order.Oid).
+			Msg("failed to enqueue order update")
+	}
+}
+
+// handleUnfilledOrderUpdate 处理无成交记录的订单状态更新
+// 订单属于 user 且未成交即撤销/拒绝时发布撤单事件，返回是否已处理
+func (m *SubscriptionManager) handleUnfilledOrderUpdate(user string, wsOrder hl.WsOrder) bool {
+	order := wsOrder.Order
+	owner, ok := m.openOrderOwners.Load(order.Oid)
+	if !ok || owner != user {
+		return false
+	}
+
+	// 成交可能晚于订单状态到达：记录原始数量和终止状态，供后续成交使用
+	m.enqueueOrderUpdate(user, wsOrder)
+
+	status := string(wsOrder.Status)
+	if wsOrder.Status == hl.OrderStatusValueOpen || wsOrder.Status == hl.OrderStatusValueTriggered {
+		return true
+	}
+	m.openOrderOwners.Delete(order.Oid)
+
+	// 部分成交后撤单走正常成交信号流程
+	if !isCancelStatus(status) || cast.ToFloat64(order.Sz) != cast.ToFloat64(order.OrigSz) {
+		return true
+	}
+
+	m.mu.RLock()
+	publisher := m.cancelPublisher
+	leader := m.leader
+	m.mu.RUnlock()
+	if publisher == nil {
+		return true
+	}
+	if leader != nil && !leader.IsLeader() {
+		monitor.IncSignalSuppressed()
+		return true
+	}
+
+	event := m.buildCancelEvent(user, wsOrder)
+	if err := publisher.PublishOrderCancelled(event); err != nil {
+		logger.Error().Err(err).Str("address", user).Int64("oid", order.Oid).
+			Str("status", status).Msg("publish order cancelled event failed")
+		return true
+	}
+
+	logger.Info().Str("address", user).Int64("oid", order.Oid).Str("coin", order.Coin).
+		Str("status", status).Str("trace_id", event.TraceID).Msg("order cancelled event published")
+	return true
+}
+
+// buildCancelEvent 构建撤单事件
+func (m *SubscriptionManager) buildCancelEvent(user string, wsOrder hl.WsOrder) *nats.HlOrderCancelled {
+	order := wsOrder.Order
+	event := &nats.HlOrderCancelled{
+		Address:   user,
+		AssetType: "futures",
+		Coin:      order.Coin,
+		Oid:       order.Oid,
+		Side:      order.Side,
+		LimitPx:   order.LimitPx,
+		OrigSz:    order.OrigSz,
+		Status:    string(wsOrder.Status),
+		Timestamp: wsOrder.StatusTimestamp,
+		TraceID:   nats.NewTraceID(),
+	}
+	if order.Cloid != nil {
+		event.Cloid = *order.Cloid
+	}
+
+	var err error
+	if isSpotCoin(order.Coin) {
+		event.AssetType = "spot"
+		event.Symbol, err = m.getSpotSymbol(order.Coin)
+	} else {
+		event.Symbol, err = m.getPerpSymbol(order.Coin)
+	}
+	if err != nil {
+		logger.Debug().Err(err).Str("coin", order.Coin).Msg("resolve cancelled order symbol failed")
+	}
+	return event
+}
+
+// isCancelStatus 判断订单终止状态是否为撤销或拒绝（canceled/rejected/marginCanceled/tickRejected 等）
+func isCancelStatus(status string) bool {
+	s := strings.ToLower(status)
+	return strings.Contains(s, "cancel") || strings.Contains(s, "reject")
+}
+
+// isSpotCoin 判断币种是否为现货（@107 或 PURR/USDC 格式）
+func isSpotCoin(coin string) bool {
+	return strings.HasPrefix(coin, "@") || strings.Contains(coin, "/")
+}
+
 // handleWsOrderFills 处理 ws 格式的订单成交
 func (m *SubscriptionManager) handleWsOrderFills(orders hl.WsOrderFills) {
 	user := orders.User
@@ -326,9 +747,31 @@ func (m *SubscriptionManager) handleWsOrderFills(orders hl.WsOrderFills) {
 
 	now := time.Now().UnixMilli()
 
+	m.mu.RLock()
+	watermarks := m.watermarks
+	pnl := m.pnl
+	leader := m.leader
+	m.mu.RUnlock()
+
+	// 快照中的成交可能在重启前已记录过盈亏，只有启用高水位（已跳过重放成交）时才记录
+	if pnl != nil && ((leader != nil && !leader.IsLeader()) || (orders.IsSnapshot && watermarks == nil)) {
+		pnl = nil
+	}
+
 	// 按 Oid 分组 fills
 	orderGroups := make(map[int64][]hl.WsOrderFill)
 	for _, fill := range orders.Fills {
+		// 订阅快照中早于高水位的成交已在重启前处理过
+		if orders.IsSnapshot && watermarks.Replayed(user, fill.Time) {
+			monitor.IncFillsReplaySkipped()
+			logger.Debug().
+				Str("address", user).
+				Int64("order_id", fill.Oid).
+				Int64("tid", fill.Tid).
+				Msg("replayed fill older than watermark, skipping")
+			continue
+		}
+
 		// 超过 30分钟就不处理了
 		if now-fill.Time > 30*60*1000 {
 			logger.Info().
@@ -337,19 +780,29 @@ func (m *SubscriptionManager) handleWsOrderFills(orders hl.WsOrderFills) {
 				Msg("timeout skipping fill")
 			continue
 		}
-		if strings.Contains(fill.Coin, ":") {
-			parts := strings.Split(fill.Coin, ":")
-			if parts[0] != "xyz" {
-				logger.Debug().
-					Str("address", user).
-					Str("coin", fill.Coin).
-					Int64("order_id", fill.Oid).
-					Msg("skipping fill")
-				continue
-			}
+		if !m.symbolNormalizer().Accepts(fill.Coin) {
+			logger.Debug().
+				Str("address", user).
+				Str("coin", fill.Coin).
+				Int64("order_id", fill.Oid).
+				Msg("skipping fill")
+			continue
 		}
 
+		if pnl != nil {
+			pnl.RecordFill(user, fill)
+		}
 		orderGroups[fill.Oid] = append(orderGroups[fill.Oid], fill)
+		watermarks.Advance(user, fill.Time)
+	}
+
+	if len(orderGroups) > 0 {
+		m.mu.RLock()
+		activity := m.activity
+		m.mu.RUnlock()
+		if activity != nil {
+			activity.Touch(user)
+		}
 	}
 
 	// 处理每个订单组
@@ -358,7 +811,8 @@ func (m *SubscriptionManager) handleWsOrderFills(orders hl.WsOrderFills) {
 		m.oidToAddress.Store(oid, user)
 
 		// 拆分反手订单
-		splitOrders := m.splitReversedOrder(fills)
+		splitOrders := splitReversedOrder(fills)
+		reversed := reversedFills(fills)
 
 		// 为每个方向调用 AddFill
 		for dir, dirFills := range splitOrders {
@@ -377,6 +831,9 @@ func (m *SubscriptionManager) handleWsOrderFills(orders hl.WsOrderFills) {
 				// 转换为 processor.OrderFillMessage 格式
 				// 由于 processor 期望 hyperliquid.WsOrderFill，我们需要适配
 				msg := m.convertToOrderFillMessage(user, fill, dir)
+				if orig, ok := reversed[fill.Tid]; ok {
+					msg.Reversed = orig // 原始成交按拆分前的数据落库
+				}
 
 				if err := m.messageQueue.Enqueue(msg); err != nil {
 					logger.Error().Err(err).
@@ -426,6 +883,9 @@ func (m *SubscriptionManager) GetStats() map[string]any {
 	stats := map[string]any{
 		"address_count": m.AddressCount(),
 	}
+	if m.orderProcessor != nil {
+		stats["pending_orders"] = m.orderProcessor.ActiveCount()
+	}
 
 	// 添加去重器统计
 	if m.deduper != nil {
@@ -440,30 +900,85 @@ func (m *SubscriptionManager) GetStats() map[string]any {
 	return stats
 }
 
-// splitReversedOrder 拆分反手订单（ws 版本）
-func (m *SubscriptionManager) splitReversedOrder(
+// flipSizeEpsilon 判断成交量超过开仓前仓位的相对容差（字符串转浮点的误差）
+const flipSizeEpsilon = 1e-9
+
+// splitReversedOrder 拆分反手订单：平仓部分为开仓前仓位，剩余为反向开仓
+func splitReversedOrder(
 	fills []hl.WsOrderFill,
 ) map[string][]hl.WsOrderFill {
 	grouped := make(map[string][]hl.WsOrderFill)
 
 	for _, fill := range fills {
-		switch fill.Dir {
-		case DirLongToShort, DirShortToLong:
-			m.addReversedFills(grouped, fill)
-		default:
+		reverseDir := reversedDir(fill)
+		if reverseDir == "" {
 			grouped[fill.Dir] = append(grouped[fill.Dir], fill)
+			continue
 		}
+		if reverseDir != fill.Dir {
+			logger.Warn().
+				Str("coin", fill.Coin).
+				Int64("oid", fill.Oid).
+				Int64("tid", fill.Tid).
+				Str("dir", fill.Dir).
+				Str("start_position", fill.StartPosition).
+				Str("sz", fill.Sz).
+				Str("detected", reverseDir).
+				Msg("position flip detected from start position, splitting fill")
+		}
+		addReversedFills(grouped, fill, reverseDir)
 	}
 
 	return grouped
 }
 
-// addReversedFills 添加反手订单的平仓和开仓部分（ws 版本）
-func (m *SubscriptionManager) addReversedFills(
+// reversedDir 返回反手成交的方向（Long > Short / Short > Long），非反手成交返回空
+// dir 未标明反手时按开仓前仓位与成交数量判断：多仓卖出或空仓买入的数量超过原仓位即为反手（现货方向不判断）
+func reversedDir(fill hl.WsOrderFill) string {
+	switch fill.Dir {
+	case DirLongToShort, DirShortToLong:
+		return fill.Dir
+	case DirBuy, DirSell:
+		return ""
+	}
+
+	startPos := cast.ToFloat64(fill.StartPosition)
+	sz := cast.ToFloat64(fill.Sz)
+	if startPos == 0 || sz <= math.Abs(startPos)*(1+flipSizeEpsilon) {
+		return ""
+	}
+	switch {
+	case startPos > 0 && fill.Side == string(hl.SideAsk):
+		return DirLongToShort
+	case startPos < 0 && fill.Side == string(hl.SideBid):
+		return DirShortToLong
+	default:
+		return ""
+	}
+}
+
+// reversedFills 按 tid 索引反手成交拆分前的原始数据，无反手成交时返回 nil
+func reversedFills(fills []hl.WsOrderFill) map[int64]hl.WsOrderFill {
+	var reversed map[int64]hl.WsOrderFill
+	for _, fill := range fills {
+		if reversedDir(fill) == "" {
+			continue
+		}
+		if reversed == nil {
+			reversed = make(map[int64]hl.WsOrderFill)
+		}
+		reversed[fill.Tid] = fill
+	}
+	return reversed
+}
+
+// addReversedFills 添加反手订单的平仓和开仓部分，reverseDir 为反手方向
+func addReversedFills(
 	grouped map[string][]hl.WsOrderFill,
 	fill hl.WsOrderFill,
+	reverseDir string,
 ) {
-	closeDir, openDir := m.getReverseDirections(fill.Dir)
+	closeDir, openDir := getReverseDirections(reverseDir)
 
 	sz := cast.ToFloat64(fill.Sz)
 	startPos := cast.ToFloat64(fill.StartPosition)
@@ -471,13 +986,13 @@ func (m *SubscriptionManager) addReversedFills(
 	openSize := math.Max(sz-closeSize, 0)
 
 	grouped[closeDir] = append(grouped[closeDir],
-		m.cloneFillWithDirection(fill, closeDir, cast.ToString(closeSize)))
+		cloneFillWithDirection(fill, closeDir, cast.ToString(closeSize)))
 	grouped[openDir] = append(grouped[openDir],
-		m.cloneFillWithDirection(fill, openDir, cast.ToString(openSize)))
+		cloneFillWithDirection(fill, openDir, cast.ToString(openSize)))
 }
 
-// cloneFillWithDirection 克隆订单成交并修改方向和数量（ws 版本）
-func (m *SubscriptionManager) cloneFillWithDirection(
+// cloneFillWithDirection 克隆订单成交并修改方向和数量
+func cloneFillWithDirection(
 	fill hl.WsOrderFill,
 	dir string,
 	sz string,
@@ -489,7 +1004,7 @@ func (m *SubscriptionManager) cloneFillWithDirection(
 }
 
 // getReverseDirections 获取反手订单的平仓和开仓方向
-func (m *SubscriptionManager) getReverseDirections(
+func getReverseDirections(
 	dir string,
 ) (closeDir, openDir string) {
 	switch dir {
@@ -518,18 +1033,10 @@ func (m *SubscriptionManager) getSpotSymbol(coin string) (string, error) {
 
 // getPerpSymbol 获取合约 symbol
 func (m *SubscriptionManager) getPerpSymbol(coin string) (string, error) {
-	// 处理 xyz:BTC 格式
-	cleanCoin := coin
-	if strings.Contains(coin, ":") {
-		parts := strings.Split(coin, ":")
-		if len(parts) == 2 && parts[0] == "xyz" {
-			cleanCoin = parts[1]
-		}
-	}
-
-	symbol, ok := m.symbolCache.GetPerpSymbol(cleanCoin)
+	// 缓存同时以原始资产名（如 xyz:BTC）建立映射
+	symbol, ok := m.symbolCache.GetPerpSymbol(coin)
 	if !ok {
-		return "", fmt.Errorf("perp coin not found: %s", cleanCoin)
+		return "", fmt.Errorf("perp coin not found: %s", coin)
 	}
 	return symbol, nil
 }
@@ -548,6 +1055,28 @@ func (m *SubscriptionManager) Close() error {
 		m.orderProcessor.Stop()
 	}
 
+	m.closeSubscriptions()
+	return nil
+}
+
+// Shutdown 优雅关闭：在 ctx 截止前排空消息队列和订单发送队列，并记录排空结果
+func (m *SubscriptionManager) Shutdown(ctx context.Context) error {
+	close(m.done)
+
+	// 先排空消息队列，其中的成交可能产生新的发送请求
+	if m.messageQueue != nil {
+		processor.ReportDrain("subscription_queue", m.messageQueue.StopAndDrain(ctx))
+	}
+	if m.orderProcessor != nil {
+		processor.ReportDrain("order_flush", m.orderProcessor.StopAndDrain(ctx))
+	}
+
+	m.closeSubscriptions()
+	return nil
+}
+
+// closeSubscriptions 关闭去重器并取消所有订阅
+func (m *SubscriptionManager) closeSubscriptions() {
 	m.deduper.Close() // 关闭去重器
 
 	m.mu.Lock()
@@ -559,6 +1088,4 @@ func (m *SubscriptionManager) Close() error {
 	m.mu.Unlock()
 
 	// 不关闭 poolManager，因为它由外部管理
-
-	return nil
 }
@@ -0,0 +1,287 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/internal/processor"
+	"github.com/utrading/utrading-hl-monitor/internal/symbol"
+	"github.com/utrading/utrading-hl-monitor/internal/ws"
+)
+
+// mockCancelPublisher 记录撤单事件和资产下架通知
+type mockCancelPublisher struct {
+	events   []*nats.HlOrderCancelled
+	delisted []*nats.HlAssetDelisted
+}
+
+func (p *mockCancelPublisher) PublishOrderCancelled(event *nats.HlOrderCancelled) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *mockCancelPublisher) PublishAssetDelisted(event *nats.HlAssetDelisted) error {
+	p.delisted = append(p.delisted, event)
+	return nil
+}
+
+type mockLeader struct{ leader bool }
+
+// recordingQueue 记录入队消息
+type recordingQueue struct {
+	messages []processor.Message
+}
+
+func (q *recordingQueue) Enqueue(msg processor.Message) error {
+	q.messages = append(q.messages, msg)
+	return nil
+}
+
+func (q *recordingQueue) Stop() {}
+
+func (q *recordingQueue) StopAndDrain(context.Context) processor.DrainStats {
+	return processor.DrainStats{}
+}
+
+func (l mockLeader) IsLeader() bool { return l.leader }
+
+func TestSubscriptionManager_CancelledEvent(t *testing.T) {
+	symbolCache := cache.NewSymbolCache()
+	symbolCache.SetPerpSymbol("BTC", "BTCUSDT")
+
+	publisher := &mockCancelPublisher{}
+	queue := &recordingQueue{}
+	m := &SubscriptionManager{
+		symbolCache:    symbolCache,
+		messageQueue:   queue,
+		orderProcessor: processor.NewOrderProcessor(nil, nil, nil, symbolCache, nil, nil),
+	}
+	defer m.orderProcessor.Stop()
+	m.SetCancelPublisher(publisher)
+
+	cloid := "0x01"
+	m.TrackOpenOrders("0xa", []hl.WsBasicOrder{
+		{Coin: "BTC", Oid: 1, Sz: "1", OrigSz: "1", Side: "B", LimitPx: "100", Cloid: &cloid},
+		{Coin: "BTC", Oid: 2, Sz: "0.4", OrigSz: "1", Side: "A"},
+		{Coin: "BTC", Oid: 3, Sz: "1", OrigSz: "1", Side: "A"},
+	})
+
+	update := func(user string, oid int64, sz string, status hl.OrderStatusValue) {
+		m.handleWsOrderUpdates(user, []hl.WsOrder{{
+			Order:           hl.WsBasicOrder{Coin: "BTC", Oid: oid, Sz: sz, OrigSz: "1", Side: "B", LimitPx: "100", Cloid: &cloid},
+			Status:          status,
+			StatusTimestamp: 1700000000000,
+		}})
+	}
+
+	// 广播到其他地址的回调不处理
+	update("0xb", 1, "1", hl.OrderStatusValueCanceled)
+	assert.Empty(t, publisher.events)
+	assert.Empty(t, queue.messages)
+
+	// 未成交即撤单
+	update("0xa", 1, "1", hl.OrderStatusValueMarginCanceled)
+	require.Len(t, publisher.events, 1)
+	event := publisher.events[0]
+	assert.Equal(t, "0xa", event.Address)
+	assert.Equal(t, "BTCUSDT", event.Symbol)
+	assert.Equal(t, "futures", event.AssetType)
+	assert.Equal(t, "marginCanceled", event.Status)
+	assert.Equal(t, cloid, event.Cloid)
+	assert.NotEmpty(t, event.TraceID)
+
+	// 状态和原始数量同时入队，供晚到的成交使用
+	require.Len(t, queue.messages, 1)
+	assert.Equal(t, processor.OrderUpdateMessage{Address: "0xa", Oid: 1, Status: "marginCanceled", OrigSz: "1"}, queue.messages[0])
+
+	// 同一订单只发布一次
+	update("0xa", 1, "1", hl.OrderStatusValueCanceled)
+	assert.Len(t, publisher.events, 1)
+
+	// 部分成交后撤单走正常信号流程
+	update("0xa", 2, "0.4", hl.OrderStatusValueCanceled)
+	assert.Len(t, publisher.events, 1)
+
+	// 备实例不发布
+	m.leader = mockLeader{leader: false}
+	update("0xa", 3, "1", hl.OrderStatusValueRejected)
+	assert.Len(t, publisher.events, 1)
+}
+
+// recordingMirror 记录挂单镜像调用
+type recordingMirror struct {
+	snapshots map[string]int
+	updates   []hl.WsOrder
+	removed   []string
+}
+
+func (r *recordingMirror) Snapshot(addr string, orders []hl.WsBasicOrder) {
+	r.snapshots[addr] = len(orders)
+}
+
+func (r *recordingMirror) Update(addr string, wsOrder hl.WsOrder) {
+	r.updates = append(r.updates, wsOrder)
+}
+
+func (r *recordingMirror) RemoveAddress(addr string) {
+	r.removed = append(r.removed, addr)
+}
+
+func TestSubscriptionManager_OpenOrderMirror(t *testing.T) {
+	symbolCache := cache.NewSymbolCache()
+	m := &SubscriptionManager{
+		symbolCache:    symbolCache,
+		messageQueue:   &recordingQueue{},
+		orderProcessor: processor.NewOrderProcessor(nil, nil, nil, symbolCache, nil, nil),
+		subs:           make(map[string]*ws.SubscriptionHandle),
+	}
+	defer m.orderProcessor.Stop()
+
+	mirror := &recordingMirror{snapshots: make(map[string]int)}
+	m.SetOpenOrderMirror(mirror)
+
+	m.TrackOpenOrders("0xa", []hl.WsBasicOrder{{Coin: "BTC", Oid: 1, Sz: "1", OrigSz: "1"}})
+	m.TrackOpenOrders("0xb", []hl.WsBasicOrder{})
+	assert.Equal(t, map[string]int{"0xa": 1, "0xb": 0}, mirror.snapshots)
+
+	update := func(user string, oid int64, status hl.OrderStatusValue) {
+		m.handleWsOrderUpdates(user, []hl.WsOrder{{
+			Order:  hl.WsBasicOrder{Coin: "BTC", Oid: oid, Sz: "1", OrigSz: "1"},
+			Status: status,
+		}})
+	}
+
+	// 广播到其他地址、归属未知的订单不更新镜像
+	update("0xb", 1, hl.OrderStatusValueCanceled)
+	update("0xa", 2, hl.OrderStatusValueOpen)
+	assert.Empty(t, mirror.updates)
+
+	update("0xa", 1, hl.OrderStatusValueCanceled)
+	require.Len(t, mirror.updates, 1)
+	assert.Equal(t, int64(1), mirror.updates[0].Order.Oid)
+
+	m.addresses.Store("0xa", struct{}{})
+	require.NoError(t, m.UnsubscribeAddress("0xa"))
+	assert.Equal(t, []string{"0xa"}, mirror.removed)
+}
+
+func TestIsCancelStatus(t *testing.T) {
+	assert.True(t, isCancelStatus("canceled"))
+	assert.True(t, isCancelStatus("marginCanceled"))
+	assert.True(t, isCancelStatus("tickRejected"))
+	assert.True(t, isCancelStatus("scheduledCancel"))
+	assert.False(t, isCancelStatus("filled"))
+	assert.False(t, isCancelStatus("open"))
+}
+
+func TestSplitReversedOrder(t *testing.T) {
+	fills := []hl.WsOrderFill{
+		// dir 标明反手
+		{Tid: 1, Dir: DirLongToShort, Side: "A", Sz: "3", StartPosition: "1"},
+		// dir 未标明反手，按开仓前仓位识别
+		{Tid: 2, Dir: DirCloseShort, Side: "B", Sz: "5", StartPosition: "-2"},
+		{Tid: 3, Dir: DirOpenShort, Side: "A", Sz: "1.5", StartPosition: "0.5"},
+		// 普通平仓与现货成交不拆分
+		{Tid: 4, Dir: DirCloseLong, Side: "A", Sz: "1", StartPosition: "1"},
+		{Tid: 5, Dir: DirSell, Side: "A", Sz: "10", StartPosition: "2"},
+	}
+
+	grouped := splitReversedOrder(fills)
+	sizes := func(dir string) map[int64]string {
+		out := make(map[int64]string)
+		for _, f := range grouped[dir] {
+			assert.Equal(t, dir, f.Dir)
+			out[f.Tid] = f.Sz
+		}
+		return out
+	}
+	assert.Equal(t, map[int64]string{1: "1", 3: "0.5", 4: "1"}, sizes(DirCloseLong))
+	assert.Equal(t, map[int64]string{1: "2", 3: "1"}, sizes(DirOpenShort))
+	assert.Equal(t, map[int64]string{2: "2"}, sizes(DirCloseShort))
+	assert.Equal(t, map[int64]string{2: "3"}, sizes(DirOpenLong))
+	assert.Equal(t, map[int64]string{5: "10"}, sizes(DirSell))
+
+	// 原始成交按拆分前的数据保留
+	reversed := reversedFills(fills)
+	assert.Len(t, reversed, 3)
+	assert.Equal(t, DirCloseShort, reversed[2].Dir)
+	assert.Equal(t, "5", reversed[2].Sz)
+}
+
+// recordingPnL 记录盈亏成交
+type recordingPnL struct {
+	tids []int64
+}
+
+func (r *recordingPnL) RecordFill(address string, fill hl.WsOrderFill) {
+	r.tids = append(r.tids, fill.Tid)
+}
+
+func TestSubscriptionManager_PnLRecordedPerFill(t *testing.T) {
+	queue := &recordingQueue{}
+	recorder := &recordingPnL{}
+	m := &SubscriptionManager{
+		messageQueue: queue,
+		deduper:      NewOrderDeduper(time.Minute),
+		oidToAddress: oidOwners{ttl: defaultOidOwnerTTL, maxSize: defaultOidOwnerMaxSize},
+	}
+	m.SetPnLRecorder(recorder)
+
+	now := time.Now().UnixMilli()
+	fill := func(oid, tid int64) hl.WsOrderFill {
+		return hl.WsOrderFill{Coin: "BTC", Oid: oid, Tid: tid, Dir: "Close Long", ClosedPnl: "1", Sz: "1", Px: "100", Time: now}
+	}
+
+	// 已发送信号的订单不再入队，但成交仍计入盈亏
+	m.deduper.Mark("0xa", 1, "Close Long")
+	m.handleWsOrderFills(hl.WsOrderFills{User: "0xa", Fills: []hl.WsOrderFill{fill(1, 10), fill(2, 11)}})
+	assert.Equal(t, []int64{10, 11}, recorder.tids)
+	assert.Len(t, queue.messages, 1)
+
+	// 未启用高水位时无法区分快照中的成交是否已记录，不计入
+	m.handleWsOrderFills(hl.WsOrderFills{User: "0xa", IsSnapshot: true, Fills: []hl.WsOrderFill{fill(3, 12)}})
+	assert.Len(t, recorder.tids, 2)
+
+	// 备实例不记录
+	m.leader = mockLeader{leader: false}
+	m.handleWsOrderFills(hl.WsOrderFills{User: "0xa", Fills: []hl.WsOrderFill{fill(4, 13)}})
+	assert.Len(t, recorder.tids, 2)
+}
+
+func TestSubscriptionManager_HandleAssetsDelisted(t *testing.T) {
+	symbolCache := cache.NewSymbolCache()
+	publisher := &mockCancelPublisher{}
+	m := &SubscriptionManager{
+		symbolCache:    symbolCache,
+		orderProcessor: processor.NewOrderProcessor(nil, nil, nil, symbolCache, nil, nil),
+	}
+	defer m.orderProcessor.Stop()
+
+	assets := []symbol.DelistedAsset{{Coin: "OLD", Symbol: "OLDUSDC"}}
+
+	// 未设置发布器时只发送待聚合订单
+	m.HandleAssetsDelisted(assets)
+	assert.Empty(t, publisher.delisted)
+
+	m.SetDelistedPublisher(publisher)
+	m.HandleAssetsDelisted(assets)
+	require.Len(t, publisher.delisted, 1)
+	event := publisher.delisted[0]
+	assert.Equal(t, "futures", event.AssetType)
+	assert.Equal(t, "OLD", event.Coin)
+	assert.Equal(t, "OLDUSDC", event.Symbol)
+	assert.Zero(t, event.FlushedOrders)
+	assert.NotEmpty(t, event.TraceID)
+
+	// 备实例不发布通知
+	m.SetLeaderChecker(&mockLeader{leader: false})
+	m.HandleAssetsDelisted(assets)
+	assert.Len(t, publisher.delisted, 1)
+}
@@ -0,0 +1,250 @@
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	hl "github.com/sonirico/go-hyperliquid"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/address"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/internal/processor"
+	"github.com/utrading/utrading-hl-monitor/internal/ws"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// TransferPublisher 余额变动事件发布接口
+type TransferPublisher interface {
+	PublishBalanceTransfer(event *nats.HlBalanceTransfer) error
+}
+
+// TransferMonitor 充值/提现/转账监控
+// 订阅监控地址的 userNonFundingLedgerUpdates，将非交易的余额变动发布为 hl.balance.transfer 事件；
+// 订阅时推送的快照为历史记录，只计入增量推送
+type TransferMonitor struct {
+	poolManager *ws.PoolManager
+	publisher   TransferPublisher
+	minUSD      float64
+
+	throttle *SubscribeThrottle       // 订阅限速（可选）
+	leader   processor.LeaderChecker  // 主备角色（可选），备实例不发送事件
+	labeler  processor.AddressLabeler // 地址标签（可选）
+
+	mu   sync.RWMutex
+	subs map[string]*ws.SubscriptionHandle
+}
+
+var _ address.AddressSubscriber = (*TransferMonitor)(nil)
+
+// NewTransferMonitor 创建余额变动监控
+func NewTransferMonitor(poolManager *ws.PoolManager, publisher TransferPublisher, cfg config.Transfers) *TransferMonitor {
+	return &TransferMonitor{
+		poolManager: poolManager,
+		publisher:   publisher,
+		minUSD:      cfg.MinUSD,
+		subs:        make(map[string]*ws.SubscriptionHandle),
+	}
+}
+
+// SetSubscribeThrottle 设置订阅限速器（可选）
+func (m *TransferMonitor) SetSubscribeThrottle(throttle *SubscribeThrottle) {
+	m.throttle = throttle
+}
+
+// SetLeaderChecker 设置主备角色（可选）
+func (m *TransferMonitor) SetLeaderChecker(leader processor.LeaderChecker) {
+	m.mu.Lock()
+	m.leader = leader
+	m.mu.Unlock()
+}
+
+// SetAddressLabeler 设置地址标签来源（可选）
+func (m *TransferMonitor) SetAddressLabeler(labeler processor.AddressLabeler) {
+	m.mu.Lock()
+	m.labeler = labeler
+	m.mu.Unlock()
+}
+
+// SubscribeAddress 订阅地址的账本变动
+func (m *TransferMonitor) SubscribeAddress(addr string) error {
+	m.mu.RLock()
+	_, exists := m.subs[addr]
+	m.mu.RUnlock()
+	if exists {
+		return nil
+	}
+	if m.poolManager == nil {
+		return fmt.Errorf("pool manager is nil")
+	}
+
+	sub := ws.Subscription{
+		Channel: ws.ChannelUserNonFundingLedgerUpdates,
+		User:    addr,
+	}
+
+	m.throttle.Wait()
+	handle, err := ws.Subscribe(m.poolManager, sub, func(updates hl.WsUserNonFundingLedgerUpdates) error {
+		if !strings.EqualFold(addr, updates.User) {
+			return nil
+		}
+
+		m.handleLedgerUpdates(addr, updates)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to subscribe userNonFundingLedgerUpdates: %w", err)
+	}
+
+	m.mu.Lock()
+	m.subs[addr] = handle
+	m.mu.Unlock()
+
+	logger.Info().Str("address", addr).Msg("subscribed ledger updates")
+	return nil
+}
+
+// UnsubscribeAddress 取消地址的账本变动订阅
+func (m *TransferMonitor) UnsubscribeAddress(addr string) error {
+	m.mu.Lock()
+	handle, ok := m.subs[addr]
+	delete(m.subs, addr)
+	m.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	return handle.Unsubscribe()
+}
+
+// AddressCount 已订阅的地址数
+func (m *TransferMonitor) AddressCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.subs)
+}
+
+// Close 取消全部订阅
+func (m *TransferMonitor) Close() error {
+	m.mu.Lock()
+	subs := m.subs
+	m.subs = make(map[string]*ws.SubscriptionHandle)
+	m.mu.Unlock()
+
+	for _, handle := range subs {
+		_ = handle.Unsubscribe()
+	}
+	return nil
+}
+
+// handleLedgerUpdates 处理账本增量推送
+func (m *TransferMonitor) handleLedgerUpdates(addr string, updates hl.WsUserNonFundingLedgerUpdates) {
+	if updates.IsSnapshot {
+		return
+	}
+
+	m.mu.RLock()
+	leader := m.leader
+	labeler := m.labeler
+	m.mu.RUnlock()
+
+	for _, update := range updates.Updates {
+		event, ok := buildTransferEvent(addr, update)
+		if !ok {
+			logger.Debug().Str("address", addr).Str("type", update.Delta.Type).Msg("skip unsupported ledger update")
+			continue
+		}
+		monitor.IncBalanceTransfers(event.Type, event.Direction)
+
+		if m.publisher == nil || event.USDValue < m.minUSD {
+			continue
+		}
+		if leader != nil && !leader.IsLeader() {
+			monitor.IncSignalSuppressed()
+			continue
+		}
+		if labeler != nil {
+			event.Label = labeler.Label(addr)
+		}
+
+		if err := m.publisher.PublishBalanceTransfer(event); err != nil {
+			logger.Error().Err(err).Str("address", addr).Str("type", event.Type).
+				Str("hash", event.Hash).Msg("publish balance transfer event failed")
+			continue
+		}
+
+		logger.Info().Str("address", addr).Str("type", event.Type).Str("direction", event.Direction).
+			Float64("usd_value", event.USDValue).Str("trace_id", event.TraceID).Msg("balance transfer event published")
+	}
+}
+
+// buildTransferEvent 按账本类型构建余额变动事件，资金方向相对监控地址；不支持的类型返回 false
+func buildTransferEvent(addr string, update hl.WsLedgerUpdate) (*nats.HlBalanceTransfer, bool) {
+	delta := update.Delta
+	event := &nats.HlBalanceTransfer{
+		Address:   addr,
+		Type:      delta.Type,
+		Token:     "USDC",
+		Amount:    delta.Usdc.String(),
+		Fee:       delta.Fee.String(),
+		Hash:      update.Hash,
+		Timestamp: update.Time,
+		TraceID:   nats.NewTraceID(),
+	}
+
+	switch delta.Type {
+	case "deposit":
+		event.Direction = nats.TransferDirectionIn
+	case "withdraw":
+		event.Direction = nats.TransferDirectionOut
+	case "accountClassTransfer":
+		event.Direction = nats.TransferDirectionInternal
+	case "internalTransfer", "subAccountTransfer":
+		event.Direction, event.Counterparty = transferSide(addr, delta)
+	case "spotTransfer", "send":
+		event.Direction, event.Counterparty = transferSide(addr, delta)
+		event.Token = delta.Token
+		event.Amount = delta.Amount.String()
+		event.USDValue = parseAbs(delta.UsdcValue)
+		return event, true
+	case "vaultCreate", "vaultDeposit":
+		event.Direction = nats.TransferDirectionOut
+		event.Counterparty = delta.Vault
+	case "vaultWithdraw":
+		event.Direction = nats.TransferDirectionIn
+		event.Counterparty = delta.Vault
+		if delta.NetWithdrawnUsd != "" {
+			event.Amount = delta.NetWithdrawnUsd.String()
+		}
+	case "vaultDistribution", "vaultLeaderCommission":
+		event.Direction = nats.TransferDirectionIn
+		event.Counterparty = delta.Vault
+	default:
+		return nil, false
+	}
+
+	event.USDValue = parseAbs(json.Number(event.Amount))
+	return event, true
+}
+
+// transferSide 判断转账方向：监控地址为发送方时转出，否则转入
+func transferSide(addr string, delta hl.WsLedgerDelta) (direction, counterparty string) {
+	if strings.EqualFold(delta.User, addr) {
+		return nats.TransferDirectionOut, delta.Destination
+	}
+	return nats.TransferDirectionIn, delta.User
+}
+
+// parseAbs 解析金额绝对值，无法解析时为 0
+func parseAbs(n json.Number) float64 {
+	v, err := strconv.ParseFloat(n.String(), 64)
+	if err != nil {
+		return 0
+	}
+	return math.Abs(v)
+}
@@ -0,0 +1,106 @@
+package manager
+
+import (
+	"testing"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+)
+
+// mockTransferPublisher 记录余额变动事件
+type mockTransferPublisher struct {
+	events []*nats.HlBalanceTransfer
+}
+
+func (p *mockTransferPublisher) PublishBalanceTransfer(event *nats.HlBalanceTransfer) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func TestBuildTransferEvent(t *testing.T) {
+	const addr = "0xabc"
+
+	cases := []struct {
+		name         string
+		delta        hl.WsLedgerDelta
+		direction    string
+		token        string
+		usd          float64
+		counterparty string
+	}{
+		{name: "deposit", delta: hl.WsLedgerDelta{Type: "deposit", Usdc: "100"}, direction: "in", token: "USDC", usd: 100},
+		{name: "withdraw", delta: hl.WsLedgerDelta{Type: "withdraw", Usdc: "2500.5", Fee: "1"}, direction: "out", token: "USDC", usd: 2500.5},
+		{
+			name:      "transfer out",
+			delta:     hl.WsLedgerDelta{Type: "internalTransfer", Usdc: "10", User: "0xABC", Destination: "0xdef"},
+			direction: "out", token: "USDC", usd: 10, counterparty: "0xdef",
+		},
+		{
+			name:      "transfer in",
+			delta:     hl.WsLedgerDelta{Type: "subAccountTransfer", Usdc: "10", User: "0xdef", Destination: addr},
+			direction: "in", token: "USDC", usd: 10, counterparty: "0xdef",
+		},
+		{
+			name:      "spot transfer",
+			delta:     hl.WsLedgerDelta{Type: "spotTransfer", Token: "HYPE", Amount: "3", UsdcValue: "75", User: addr, Destination: "0xdef"},
+			direction: "out", token: "HYPE", usd: 75, counterparty: "0xdef",
+		},
+		{name: "class transfer", delta: hl.WsLedgerDelta{Type: "accountClassTransfer", Usdc: "5", ToPerp: true}, direction: "internal", token: "USDC", usd: 5},
+		{
+			name:      "vault withdraw",
+			delta:     hl.WsLedgerDelta{Type: "vaultWithdraw", Vault: "0xvault", NetWithdrawnUsd: "42"},
+			direction: "in", token: "USDC", usd: 42, counterparty: "0xvault",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			event, ok := buildTransferEvent(addr, hl.WsLedgerUpdate{Time: 1, Hash: "0x01", Delta: tc.delta})
+			require.True(t, ok)
+			assert.Equal(t, tc.direction, event.Direction)
+			assert.Equal(t, tc.token, event.Token)
+			assert.Equal(t, tc.usd, event.USDValue)
+			assert.Equal(t, tc.counterparty, event.Counterparty)
+			assert.Equal(t, "0x01", event.Hash)
+		})
+	}
+
+	_, ok := buildTransferEvent(addr, hl.WsLedgerUpdate{Delta: hl.WsLedgerDelta{Type: "liquidation"}})
+	assert.False(t, ok)
+}
+
+func TestTransferMonitor_HandleLedgerUpdates(t *testing.T) {
+	const addr = "0xabc"
+
+	publisher := &mockTransferPublisher{}
+	m := NewTransferMonitor(nil, publisher, config.Transfers{Enabled: true, MinUSD: 50})
+
+	updates := hl.WsUserNonFundingLedgerUpdates{
+		User: addr,
+		Updates: []hl.WsLedgerUpdate{
+			{Time: 1, Hash: "0x01", Delta: hl.WsLedgerDelta{Type: "withdraw", Usdc: "1000"}},
+			{Time: 2, Hash: "0x02", Delta: hl.WsLedgerDelta{Type: "deposit", Usdc: "10"}}, // 低于 min_usd
+		},
+	}
+
+	// 快照为历史记录，不发布
+	snapshot := updates
+	snapshot.IsSnapshot = true
+	m.handleLedgerUpdates(addr, snapshot)
+	assert.Empty(t, publisher.events)
+
+	m.handleLedgerUpdates(addr, updates)
+	require.Len(t, publisher.events, 1)
+	assert.Equal(t, "withdraw", publisher.events[0].Type)
+	assert.Equal(t, nats.TransferDirectionOut, publisher.events[0].Direction)
+	assert.NotEmpty(t, publisher.events[0].TraceID)
+
+	// 备实例不发送
+	m.SetLeaderChecker(&mockLeader{leader: false})
+	m.handleLedgerUpdates(addr, updates)
+	assert.Len(t, publisher.events, 1)
+}
@@ -12,5 +12,5 @@ type HlActiveAddress struct {
 }
 
 func (HlActiveAddress) TableName() string {
-	return "hl_active_addresses"
+	return tableName("hl_active_addresses")
 }
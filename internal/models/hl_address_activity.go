@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// HlAddressActivity 地址活跃度（休眠策略使用）
+type HlAddressActivity struct {
+	ID           int64      `gorm:"column:id;primaryKey" json:"id"`
+	Address      string     `gorm:"column:address;type:varchar(64);not null;uniqueIndex:uidx_activity_address;comment:链上地址" json:"address"`
+	LastActiveAt time.Time  `gorm:"column:last_active_at;not null;comment:最近活跃时间（成交或仓位变化）" json:"last_active_at"`
+	Dormant      bool       `gorm:"column:dormant;not null;default:false;index:idx_dormant;comment:是否休眠" json:"dormant"`
+	DormantSince *time.Time `gorm:"column:dormant_since;comment:进入休眠时间" json:"dormant_since"`
+	CreatedAt    time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (HlAddressActivity) TableName() string {
+	return tableName("hl_address_activity")
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// HlAddressGroup 地址分组（如 whales、smart-money），分组内地址的信号额外发布到分组主题
+type HlAddressGroup struct {
+	ID              int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Name            string    `gorm:"column:name;type:varchar(64);not null;uniqueIndex:uidx_group_name;comment:分组名称" json:"name"`
+	SubjectTemplate string    `gorm:"column:subject_template;type:varchar(255);not null;default:'';comment:NATS 主题模板，如 hl.signal.{group}.{symbol}，为空时使用默认模板" json:"subject_template"`
+	Enabled         bool      `gorm:"column:enabled;not null;default:true;comment:是否启用" json:"enabled"`
+	Remark          string    `gorm:"column:remark;type:varchar(255);not null;default:'';comment:备注" json:"remark"`
+	CreatedAt       time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (HlAddressGroup) TableName() string {
+	return tableName("hl_address_groups")
+}
+
+// HlAddressGroupMember 地址分组成员，同一地址可属于多个分组
+type HlAddressGroupMember struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	GroupName string    `gorm:"column:group_name;type:varchar(64);not null;uniqueIndex:uidx_group_member;comment:分组名称" json:"group_name"`
+	Address   string    `gorm:"column:address;type:varchar(42);not null;uniqueIndex:uidx_group_member;index:idx_group_member_address;comment:链上地址" json:"address"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (HlAddressGroupMember) TableName() string {
+	return tableName("hl_address_group_members")
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// HlAddressPnlDaily 地址每日盈亏（按 UTC 日期）
+// 已实现盈亏为当日订单聚合完成时其成交 closedPnl 之和（不含手续费），未实现盈亏为当日最近一次仓位快照的合约未实现盈亏之和
+type HlAddressPnlDaily struct {
+	ID            int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Address       string    `gorm:"column:address;type:varchar(42);not null;uniqueIndex:uidx_pnl_address_date;comment:链上地址" json:"address"`
+	StatDate      string    `gorm:"column:stat_date;type:varchar(10);not null;uniqueIndex:uidx_pnl_address_date;index:idx_pnl_date;comment:统计日期（UTC，YYYY-MM-DD）" json:"stat_date"`
+	RealizedPnl   float64   `gorm:"column:realized_pnl;not null;default:0;comment:已实现盈亏（成交 closedPnl 之和）" json:"realized_pnl"`
+	UnrealizedPnl float64   `gorm:"column:unrealized_pnl;not null;default:0;comment:未实现盈亏（当日最近一次仓位快照）" json:"unrealized_pnl"`
+	ClosedOrders  int       `gorm:"column:closed_orders;not null;default:0;comment:产生已实现盈亏的订单聚合数" json:"closed_orders"`
+	UpdatedAt     time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (HlAddressPnlDaily) TableName() string {
+	return tableName("hl_address_pnl_daily")
+}
@@ -19,6 +19,17 @@ type HlAddressSignal struct {
 	Side      string  `gorm:"type:varchar(8);not null;comment:方向: LONG/SHORT" json:"side"`
 	Price     float64 `gorm:"type:decimal(28,12);not null;comment:价格" json:"price"`
 	Size      float64 `gorm:"type:decimal(18,8);not null;comment:数量" json:"size"`
+	Scope     string  `gorm:"type:varchar(32);not null;default:'';comment:去重作用域（逻辑消费者）" json:"scope"`
+
+	// 追踪字段
+	IdempotencyKey string `gorm:"type:varchar(32);not null;default:'';index;comment:幂等键" json:"idempotency_key"`
+	TraceID        string `gorm:"type:varchar(36);not null;default:'';index;comment:追踪 ID" json:"trace_id"`
+
+	// 发件箱字段（[outbox] enabled = true 时先写入再由分发器发布）
+	Payload         string     `gorm:"type:text;comment:NATS 消息体，仅发件箱模式写入" json:"-"`
+	PublishedAt     *time.Time `gorm:"index:idx_published;comment:发布到 NATS 的时间，为空表示待发布" json:"published_at"`
+	PublishAttempts int        `gorm:"not null;default:0;comment:发布失败次数" json:"publish_attempts"`
+	DeadLetteredAt  *time.Time `gorm:"comment:转入死信的时间，不再发布" json:"dead_lettered_at"`
 
 	// 时间字段
 	CreatedAt time.Time `gorm:"autoCreateTime;index:idx_created;comment:创建时间" json:"created_at"`
@@ -27,5 +38,5 @@ type HlAddressSignal struct {
 
 // TableName 指定表名
 func (HlAddressSignal) TableName() string {
-	return "hl_address_signals"
+	return tableName("hl_address_signals")
 }
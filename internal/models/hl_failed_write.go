@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// HlFailedWrite 批量写入失败的隔离记录（毒数据行）
+type HlFailedWrite struct {
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	TargetTable string    `gorm:"column:target_table;type:varchar(64);not null;index:idx_target_table;comment:目标表" json:"target_table"`
+	DedupKey    string    `gorm:"column:dedup_key;type:varchar(255);not null;comment:写入项去重键" json:"dedup_key"`
+	Payload     string    `gorm:"column:payload;type:mediumtext;comment:写入项 JSON" json:"payload"`
+	Error       string    `gorm:"column:error;type:text;comment:最后一次写入错误" json:"error"`
+	Attempts    int       `gorm:"column:attempts;not null;default:1;comment:写入尝试次数" json:"attempts"`
+	CreatedAt   time.Time `gorm:"column:created_at;not null;index:idx_created_at;comment:隔离时间" json:"created_at"`
+}
+
+// TableName 指定表名
+func (HlFailedWrite) TableName() string {
+	return tableName("hl_failed_writes")
+}
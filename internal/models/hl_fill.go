@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/sonirico/go-hyperliquid"
+)
+
+// HlFill 监控地址的原始成交记录（orderFills 推送原样保存，用于事后分析和争议核对）
+type HlFill struct {
+	ID        int64                   `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Tid       int64                   `gorm:"column:tid;not null;uniqueIndex:uidx_fill_tid_address,priority:1;comment:成交 ID" json:"tid"`
+	Address   string                  `gorm:"column:address;type:varchar(42);not null;uniqueIndex:uidx_fill_tid_address,priority:2;index:idx_fill_address_time,priority:1;comment:链上地址" json:"address"`
+	Oid       int64                   `gorm:"column:oid;not null;index:idx_fill_oid;comment:订单 ID" json:"oid"`
+	Coin      string                  `gorm:"column:coin;type:varchar(64);not null;comment:原始资产名" json:"coin"`
+	Dir       string                  `gorm:"column:dir;type:varchar(32);not null;default:'';comment:成交方向" json:"dir"`
+	FillTime  int64                   `gorm:"column:fill_time;not null;index:idx_fill_address_time,priority:2;index:idx_fill_time;comment:成交时间（毫秒）" json:"fill_time"`
+	Raw       hyperliquid.WsOrderFill `gorm:"column:raw;type:json;not null;serializer:json;comment:原始成交数据" json:"raw"`
+	CreatedAt time.Time               `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (HlFill) TableName() string {
+	return tableName("hl_fills")
+}
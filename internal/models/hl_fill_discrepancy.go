@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// HlFillDiscrepancy 成交对账差异：订单在 hl_fills 中的成交数量之和与订单聚合 TotalSize 或已发布信号 Size 不一致
+// 同一对账日期重复执行时按唯一键覆盖
+type HlFillDiscrepancy struct {
+	ID           int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	AuditDate    string    `gorm:"column:audit_date;type:varchar(10);not null;uniqueIndex:uidx_fill_discrepancy;index:idx_fill_discrepancy_date;comment:对账日期（UTC，YYYY-MM-DD）" json:"audit_date"`
+	Address      string    `gorm:"column:address;type:varchar(42);not null;uniqueIndex:uidx_fill_discrepancy;comment:链上地址" json:"address"`
+	Oid          int64     `gorm:"column:oid;not null;uniqueIndex:uidx_fill_discrepancy;comment:订单聚合 ID" json:"oid"`
+	Direction    string    `gorm:"column:direction;type:varchar(16);not null;uniqueIndex:uidx_fill_discrepancy;comment:成交方向" json:"direction"`
+	Kind         string    `gorm:"column:kind;type:varchar(16);not null;uniqueIndex:uidx_fill_discrepancy;comment:差异来源（aggregation/signal）" json:"kind"`
+	Scope        string    `gorm:"column:scope;type:varchar(32);not null;default:'';uniqueIndex:uidx_fill_discrepancy;comment:信号去重作用域，kind=signal 时有效" json:"scope"`
+	FillSize     float64   `gorm:"column:fill_size;not null;default:0;comment:hl_fills 成交数量之和" json:"fill_size"`
+	RecordedSize float64   `gorm:"column:recorded_size;not null;default:0;comment:订单聚合或信号记录的数量" json:"recorded_size"`
+	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (HlFillDiscrepancy) TableName() string {
+	return tableName("hl_fill_discrepancies")
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// HlFillWatermark 地址成交高水位（已处理的最新成交时间），重启后跳过订阅快照中重放的旧成交
+type HlFillWatermark struct {
+	ID           int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Address      string    `gorm:"column:address;type:varchar(42);not null;uniqueIndex:uidx_fill_watermark_address;comment:链上地址" json:"address"`
+	LastFillTime int64     `gorm:"column:last_fill_time;not null;comment:已处理的最新成交时间（毫秒）" json:"last_fill_time"`
+	UpdatedAt    time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (HlFillWatermark) TableName() string {
+	return tableName("hl_fill_watermarks")
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// HlLeaderLease 主备选举租约（热备模式使用）
+type HlLeaderLease struct {
+	ID        int64     `gorm:"column:id;primaryKey" json:"id"`
+	Name      string    `gorm:"column:name;type:varchar(64);not null;uniqueIndex:uidx_name;comment:租约名称" json:"name"`
+	Holder    string    `gorm:"column:holder;type:varchar(128);not null;comment:持有者实例 ID" json:"holder"`
+	ExpiresAt time.Time `gorm:"column:expires_at;not null;comment:租约过期时间" json:"expires_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (HlLeaderLease) TableName() string {
+	return tableName("hl_leader_lease")
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// HlOpenOrder 监控地址当前挂单镜像（由 webData2 快照和 orderUpdates 增量维护）
+type HlOpenOrder struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Address   string    `gorm:"column:address;type:varchar(42);not null;uniqueIndex:uidx_address_oid,priority:1;comment:链上地址" json:"address"`
+	Oid       int64     `gorm:"column:oid;not null;uniqueIndex:uidx_address_oid,priority:2;comment:订单 ID" json:"oid"`
+	Cloid     string    `gorm:"column:cloid;type:varchar(66);not null;default:'';comment:客户端订单 ID" json:"cloid"`
+	Coin      string    `gorm:"column:coin;type:varchar(64);not null;comment:原始资产名" json:"coin"`
+	Symbol    string    `gorm:"column:symbol;type:varchar(64);not null;default:'';comment:交易对" json:"symbol"`
+	Side      string    `gorm:"column:side;type:varchar(4);not null;comment:方向 B/A" json:"side"`
+	LimitPx   string    `gorm:"column:limit_px;type:varchar(32);not null;comment:限价" json:"limit_px"`
+	Sz        string    `gorm:"column:sz;type:varchar(32);not null;comment:剩余数量" json:"sz"`
+	OrigSz    string    `gorm:"column:orig_sz;type:varchar(32);not null;default:'';comment:原始数量" json:"orig_sz"`
+	PlacedAt  int64     `gorm:"column:placed_at;not null;comment:下单时间（毫秒）" json:"placed_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null;comment:镜像更新时间" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (HlOpenOrder) TableName() string {
+	return tableName("hl_open_orders")
+}
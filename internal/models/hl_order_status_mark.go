@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// HlOrderStatusMark 已收到终止状态但尚未匹配到成交聚合的订单状态，重启后恢复，避免迟到的成交等待聚合超时
+type HlOrderStatusMark struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Address   string    `gorm:"column:address;type:varchar(42);not null;uniqueIndex:uidx_status_mark_order;comment:链上地址" json:"address"`
+	Oid       int64     `gorm:"column:oid;not null;uniqueIndex:uidx_status_mark_order;comment:订单ID" json:"oid"`
+	Status    string    `gorm:"column:status;type:varchar(32);not null;comment:终止状态（filled/canceled 等）" json:"status"`
+	ExpiresAt int64     `gorm:"column:expires_at;not null;index:idx_status_mark_expires;comment:过期时间（毫秒）" json:"expires_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (HlOrderStatusMark) TableName() string {
+	return tableName("hl_order_status_marks")
+}
@@ -22,12 +22,17 @@ type HlPositionCache struct {
 	TotalNtlPos      string `gorm:"type:varchar(32);not null;default:'0';comment:总净仓位" json:"total_ntl_pos"`
 	Withdrawable     string `gorm:"type:varchar(32);not null;default:'0';comment:可提取金额" json:"withdrawable"`
 
+	// 计价货币价值（[valuation] quote，默认 USD）
+	QuoteCurrency     string `gorm:"type:varchar(16);not null;default:'USD';comment:计价货币" json:"quote_currency"`
+	SpotTotalQuote    string `gorm:"type:varchar(32);not null;default:'';comment:现货总价值(计价货币)" json:"spot_total_quote"`
+	AccountValueQuote string `gorm:"type:varchar(32);not null;default:'';comment:账户总价值(计价货币)" json:"account_value_quote"`
+
 	// 缓存控制
 	UpdatedAt time.Time `gorm:"not null;index:idx_updated;comment:更新时间" json:"updated_at"`
 }
 
 func (HlPositionCache) TableName() string {
-	return "hl_position_cache"
+	return tableName("hl_position_cache")
 }
 
 // SpotBalancesData 现货余额数据结构
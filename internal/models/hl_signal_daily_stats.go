@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// HlSignalDailyStats 信号每日统计（按 UTC 日期），由汇总任务定期从 hl_address_signals 重算覆盖
+// 供看板查询，避免扫描原始信号表；原始信号清理后历史日期的统计保留
+type HlSignalDailyStats struct {
+	ID              int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	StatDate        string    `gorm:"column:stat_date;type:varchar(10);not null;uniqueIndex:uidx_signal_daily_stats;index:idx_signal_daily_stats_date;comment:统计日期（UTC，YYYY-MM-DD）" json:"stat_date"`
+	Address         string    `gorm:"column:address;type:varchar(42);not null;uniqueIndex:uidx_signal_daily_stats;index:idx_signal_daily_stats_address;comment:链上地址" json:"address"`
+	Symbol          string    `gorm:"column:symbol;type:varchar(24);not null;uniqueIndex:uidx_signal_daily_stats;comment:交易对" json:"symbol"`
+	AssetType       string    `gorm:"column:asset_type;type:varchar(24);not null;uniqueIndex:uidx_signal_daily_stats;comment:资产类型: spot/futures" json:"asset_type"`
+	Scope           string    `gorm:"column:scope;type:varchar(32);not null;default:'';uniqueIndex:uidx_signal_daily_stats;comment:去重作用域（逻辑消费者）" json:"scope"`
+	SignalCount     int       `gorm:"column:signal_count;not null;default:0;comment:信号数" json:"signal_count"`
+	OpenCount       int       `gorm:"column:open_count;not null;default:0;comment:开仓信号数" json:"open_count"`
+	CloseCount      int       `gorm:"column:close_count;not null;default:0;comment:平仓信号数" json:"close_count"`
+	Volume          float64   `gorm:"column:volume;not null;default:0;comment:信号数量之和" json:"volume"`
+	Notional        float64   `gorm:"column:notional;not null;default:0;comment:名义价值之和（价格×数量）" json:"notional"`
+	AvgPositionRate float64   `gorm:"column:avg_position_rate;not null;default:0;comment:平均仓位比例" json:"avg_position_rate"`
+	UpdatedAt       time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (HlSignalDailyStats) TableName() string {
+	return tableName("hl_signal_daily_stats")
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// HlSymbolRename symbol 改名映射（Hyperliquid 资产改名或别名调整后，历史记录中的旧 symbol 改写为新 symbol）
+// 由 symbols rename 子命令写入并改写历史记录，[symbol] apply_renames 开启时实时 symbol 同样按映射改名
+type HlSymbolRename struct {
+	ID            int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	AssetType     string     `gorm:"column:asset_type;type:varchar(24);not null;uniqueIndex:uidx_symbol_rename;comment:资产类型: spot/futures" json:"asset_type"`
+	OldSymbol     string     `gorm:"column:old_symbol;type:varchar(24);not null;uniqueIndex:uidx_symbol_rename;comment:旧交易对" json:"old_symbol"`
+	NewSymbol     string     `gorm:"column:new_symbol;type:varchar(24);not null;comment:新交易对" json:"new_symbol"`
+	RewrittenRows int64      `gorm:"column:rewritten_rows;not null;default:0;comment:改写的历史记录数" json:"rewritten_rows"`
+	AppliedAt     *time.Time `gorm:"column:applied_at;comment:最近一次改写历史记录的时间" json:"applied_at"`
+	CreatedAt     time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (HlSymbolRename) TableName() string {
+	return tableName("hl_symbol_renames")
+}
@@ -13,6 +13,15 @@ type HlWatchAddress struct {
 	// 用户自定义信息
 	Nickname string `gorm:"type:varchar(64);default:'';comment:自定义昵称" json:"nickname"`
 	IsSystem bool   `gorm:"type:tinyint(1);not null;default:0;comment:是否系统地址池" json:"is_system"`
+	Tags     string `gorm:"type:varchar(255);not null;default:'';comment:标签，逗号分隔" json:"tags"`
+	Channels string `gorm:"type:varchar(128);not null;default:'';comment:信号推送渠道偏好，逗号分隔" json:"channels"`
+	Comment  string `gorm:"type:varchar(255);not null;default:'';comment:备注" json:"comment"`
+
+	// 订单聚合策略：空为默认，scalper 逐笔成交立即发送，swing 使用更长的聚合窗口
+	Aggregation string `gorm:"type:varchar(16);not null;default:'';comment:订单聚合策略：空为默认，scalper / swing" json:"aggregation"`
+
+	// 信号过滤：逗号分隔的 open / close / futures / spot，同类取并集、不同类取交集，空为全部发送
+	SignalFilter string `gorm:"type:varchar(64);not null;default:'';comment:信号过滤：逗号分隔的 open / close / futures / spot，空为全部发送" json:"signal_filter"`
 
 	CreatedAt time.Time      `gorm:"autoCreateTime" json:"created_at"`
 	UpdatedAt time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
@@ -20,5 +29,5 @@ type HlWatchAddress struct {
 }
 
 func (HlWatchAddress) TableName() string {
-	return "hl_watch_addresses"
+	return tableName("hl_watch_addresses")
 }
@@ -13,12 +13,17 @@ type OrderAggregation struct {
 	Address   string `gorm:"column:address;type:varchar(42);not null;uniqueIndex:uidx_addr_oid_dir" json:"address"`
 	Direction string `gorm:"column:direction;type:varchar(16);not null;uniqueIndex:uidx_addr_oid_dir;" json:"direction"` // 订单方向
 	Symbol    string `gorm:"column:symbol;type:varchar(24);not null" json:"symbol"`
+	Cloid     string `gorm:"column:cloid;type:varchar(66);not null;default:'';comment:客户端订单 ID，按 cloid 聚合时非空" json:"cloid"`
 
 	// 聚合数据
 	Fills         []hyperliquid.WsOrderFill `gorm:"column:fills;type:json;not null;serializer:json" json:"fills"`
 	TotalSize     float64                   `gorm:"column:total_size;not null;default:0" json:"total_size"`
 	WeightedAvgPx float64                   `gorm:"column:weighted_avg_px;not null;default:0" json:"weighted_avg_px"`
 
+	// 执行质量
+	MidPx       float64 `gorm:"column:mid_px;not null;default:0;comment:首笔成交时的中间价/标记价" json:"mid_px"`
+	SlippageBps float64 `gorm:"column:slippage_bps;not null;default:0;comment:成交均价相对中间价的滑点(bp)，正数表示劣于中间价" json:"slippage_bps"`
+
 	// 状态控制
 	OrderStatus  string `gorm:"column:order_status;type:varchar(64);not null;default:open" json:"order_status"`
 	LastFillTime int64  `gorm:"column:last_fill_time;not null;index" json:"last_fill_time"`
@@ -33,5 +38,5 @@ type OrderAggregation struct {
 
 // TableName 指定表名
 func (OrderAggregation) TableName() string {
-	return "hl_order_aggregation"
+	return tableName("hl_order_aggregation")
 }
@@ -11,5 +11,5 @@ type PairConfig struct {
 
 // TableName 指定表名
 func (PairConfig) TableName() string {
-	return "pair_configs"
+	return tableName("pair_configs")
 }
@@ -0,0 +1,40 @@
+package models
+
+import "sync"
+
+var (
+	tablePrefix string
+	tableNames  map[string]string
+	tableMu     sync.RWMutex
+)
+
+// SetTableNaming 设置表名前缀和自定义表名，需在数据库初始化前调用
+// names 的键为默认表名（如 hl_address_signals），值为自定义表名，自定义表名不再附加前缀
+func SetTableNaming(prefix string, names map[string]string) {
+	tableMu.Lock()
+	defer tableMu.Unlock()
+
+	tablePrefix = prefix
+	tableNames = make(map[string]string, len(names))
+	for k, v := range names {
+		if v != "" {
+			tableNames[k] = v
+		}
+	}
+}
+
+// tableName 返回应用自定义名称或前缀后的实际表名
+func tableName(name string) string {
+	tableMu.RLock()
+	defer tableMu.RUnlock()
+
+	if custom, ok := tableNames[name]; ok {
+		return custom
+	}
+	return tablePrefix + name
+}
+
+// ResolveTableName 返回默认表名对应的实际表名，供迁移脚本等原生 SQL 使用
+func ResolveTableName(name string) string {
+	return tableName(name)
+}
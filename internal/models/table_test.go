@@ -0,0 +1,21 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetTableNaming(t *testing.T) {
+	defer SetTableNaming("", nil)
+
+	assert.Equal(t, "hl_address_signals", HlAddressSignal{}.TableName())
+
+	SetTableNaming("prod_", map[string]string{
+		"hl_order_aggregation": "orders_agg",
+		"hl_position_cache":    "",
+	})
+	assert.Equal(t, "prod_hl_address_signals", HlAddressSignal{}.TableName())
+	assert.Equal(t, "prod_hl_position_cache", HlPositionCache{}.TableName(), "empty custom name falls back to prefix")
+	assert.Equal(t, "orders_agg", OrderAggregation{}.TableName())
+}
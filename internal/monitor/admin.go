@@ -0,0 +1,244 @@
+package monitor
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// PausableRef 可暂停组件引用接口（订阅管理器、仓位管理器）
+type PausableRef interface {
+	Pause()
+	Resume()
+	Paused() bool
+}
+
+// SubscriptionControlRef 地址订阅控制引用接口（由 address.AddressLoader 实现）
+type SubscriptionControlRef interface {
+	UnsubscribeAll() int
+	Resubscribe() error
+	Suspended() bool
+}
+
+// AdminState 管理接口返回的运行状态
+type AdminState struct {
+	Paused    map[string]bool `json:"paused"`
+	Suspended bool            `json:"subscriptions_suspended"`
+	LogLevels LogLevels       `json:"log_levels"`
+}
+
+// LogLevels 日志级别：全局级别及按模块（包名，如 processor、ws）覆盖的级别
+type LogLevels struct {
+	Level   string            `json:"level,omitempty"`
+	Modules map[string]string `json:"modules,omitempty"` // 修改时级别为空表示删除该模块的设置
+}
+
+// SetAdminToken 设置管理接口令牌，为空时不注册 /admin 端点
+func (h *HealthServer) SetAdminToken(token string) {
+	h.mu.Lock()
+	h.adminToken = token
+	h.mu.Unlock()
+}
+
+// AddPausable 注册可暂停组件（可选，用于 /admin/pause 与 /admin/resume）
+func (h *HealthServer) AddPausable(name string, p PausableRef) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.pausables == nil {
+		h.pausables = make(map[string]PausableRef)
+	}
+	h.pausables[name] = p
+}
+
+// SetSubscriptionControl 设置地址订阅控制（可选，用于 /admin/unsubscribe-all 与 /admin/resubscribe）
+func (h *HealthServer) SetSubscriptionControl(control SubscriptionControlRef) {
+	h.mu.Lock()
+	h.subControl = control
+	h.mu.Unlock()
+}
+
+// registerAdmin 注册管理端点，所有请求需携带 Authorization: Bearer <token>
+func (h *HealthServer) registerAdmin(mux *http.ServeMux) {
+	h.mu.RLock()
+	token := h.adminToken
+	h.mu.RUnlock()
+	if token == "" {
+		return
+	}
+
+	mux.HandleFunc("/admin/state", h.adminAuth(token, http.MethodGet, h.adminStateHandler))
+	mux.HandleFunc("/admin/pause", h.adminAuth(token, http.MethodPost, h.adminPauseHandler(true)))
+	mux.HandleFunc("/admin/resume", h.adminAuth(token, http.MethodPost, h.adminPauseHandler(false)))
+	mux.HandleFunc("/admin/unsubscribe-all", h.adminAuth(token, http.MethodPost, h.adminUnsubscribeAllHandler))
+	mux.HandleFunc("/admin/resubscribe", h.adminAuth(token, http.MethodPost, h.adminResubscribeHandler))
+	mux.HandleFunc("/admin/loglevel", h.adminAuth(token, http.MethodPut, h.adminLogLevelHandler))
+}
+
+// adminAuth 校验请求方法和令牌
+func (h *HealthServer) adminAuth(token, method string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		got := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// adminStateHandler 返回各组件暂停状态与订阅是否已全部取消
+func (h *HealthServer) adminStateHandler(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, h.adminState())
+}
+
+// adminPauseHandler 暂停或恢复组件，可选参数 target 指定组件名称，默认全部
+func (h *HealthServer) adminPauseHandler(pause bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.mu.RLock()
+		targets := make(map[string]PausableRef, len(h.pausables))
+		for name, p := range h.pausables {
+			targets[name] = p
+		}
+		h.mu.RUnlock()
+
+		if name := r.URL.Query().Get("target"); name != "" {
+			p, ok := targets[name]
+			if !ok {
+				http.Error(w, "unknown target", http.StatusNotFound)
+				return
+			}
+			targets = map[string]PausableRef{name: p}
+		}
+
+		names := make([]string, 0, len(targets))
+		for name, p := range targets {
+			if pause {
+				p.Pause()
+			} else {
+				p.Resume()
+			}
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		logger.Warn().Strs("targets", names).Bool("paused", pause).Str("remote", r.RemoteAddr).Msg("admin pause state changed")
+
+		writeJSON(w, h.adminState())
+	}
+}
+
+// adminUnsubscribeAllHandler 取消全部地址订阅，定期同步暂停直到 /admin/resubscribe
+func (h *HealthServer) adminUnsubscribeAllHandler(w http.ResponseWriter, r *http.Request) {
+	h.mu.RLock()
+	control := h.subControl
+	h.mu.RUnlock()
+	if control == nil {
+		http.Error(w, "subscription control not available", http.StatusNotFound)
+		return
+	}
+
+	count := control.UnsubscribeAll()
+	logger.Warn().Int("addresses", count).Str("remote", r.RemoteAddr).Msg("admin unsubscribed all addresses")
+	writeJSON(w, map[string]int{"unsubscribed": count})
+}
+
+// adminResubscribeHandler 恢复定期同步并重新订阅全部地址
+func (h *HealthServer) adminResubscribeHandler(w http.ResponseWriter, r *http.Request) {
+	h.mu.RLock()
+	control := h.subControl
+	h.mu.RUnlock()
+	if control == nil {
+		http.Error(w, "subscription control not available", http.StatusNotFound)
+		return
+	}
+
+	if err := control.Resubscribe(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	logger.Warn().Str("remote", r.RemoteAddr).Msg("admin resubscribed all addresses")
+	writeJSON(w, h.adminState())
+}
+
+// adminLogLevelHandler 运行时修改日志级别，无需重启（重启会丢失内存中的聚合状态）
+// 请求体 {"level":"debug","modules":{"processor":"debug","ws":""}}，全部校验通过后才生效，返回修改后的级别
+func (h *HealthServer) adminLogLevelHandler(w http.ResponseWriter, r *http.Request) {
+	var req LogLevels
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.Level == "" && len(req.Modules) == 0 {
+		http.Error(w, "level or modules required", http.StatusBadRequest)
+		return
+	}
+
+	// 先校验全部级别，避免部分生效
+	if req.Level != "" {
+		if err := logger.ValidateLevel(req.Level); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	for module, level := range req.Modules {
+		if module == "" {
+			http.Error(w, "empty module name", http.StatusBadRequest)
+			return
+		}
+		if level == "" {
+			continue
+		}
+		if err := logger.ValidateLevel(level); err != nil {
+			http.Error(w, err.Error()+" for module "+module, http.StatusBadRequest)
+			return
+		}
+	}
+
+	if req.Level != "" {
+		if err := logger.SetLevel(req.Level); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	for module, level := range req.Modules {
+		if err := logger.SetModuleLevel(module, level); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	state := currentLogLevels()
+	logger.Warn().Str("level", state.Level).Interface("modules", state.Modules).
+		Str("remote", r.RemoteAddr).Msg("admin log level changed")
+	writeJSON(w, state)
+}
+
+func currentLogLevels() LogLevels {
+	return LogLevels{Level: logger.GetLevel(), Modules: logger.ModuleLevels()}
+}
+
+func (h *HealthServer) adminState() AdminState {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	state := AdminState{Paused: make(map[string]bool, len(h.pausables)), LogLevels: currentLogLevels()}
+	for name, p := range h.pausables {
+		state.Paused[name] = p.Paused()
+	}
+	if h.subControl != nil {
+		state.Suspended = h.subControl.Suspended()
+	}
+	return state
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,129 @@
+package monitor
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+type mockPausable struct{ paused bool }
+
+func (m *mockPausable) Pause()       { m.paused = true }
+func (m *mockPausable) Resume()      { m.paused = false }
+func (m *mockPausable) Paused() bool { return m.paused }
+
+type mockSubscriptionControl struct{ suspended bool }
+
+func (m *mockSubscriptionControl) UnsubscribeAll() int { m.suspended = true; return 3 }
+func (m *mockSubscriptionControl) Resubscribe() error  { m.suspended = false; return nil }
+func (m *mockSubscriptionControl) Suspended() bool     { return m.suspended }
+
+func TestAdminHandlers(t *testing.T) {
+	subs, positions := &mockPausable{}, &mockPausable{}
+	control := &mockSubscriptionControl{}
+
+	h := NewHealthServer(":0", nil, nil, nil)
+	h.AddPausable("subscription_manager", subs)
+	h.AddPausable("position_manager", positions)
+	h.SetSubscriptionControl(control)
+
+	// 未配置令牌时不注册管理端点
+	mux := http.NewServeMux()
+	h.registerAdmin(mux)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/pause", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	h.SetAdminToken("secret")
+	mux = http.NewServeMux()
+	h.registerAdmin(mux)
+
+	do := func(method, url, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, url, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, do(http.MethodPost, "/admin/pause", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, do(http.MethodPost, "/admin/pause", "wrong").Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, do(http.MethodGet, "/admin/pause", "secret").Code)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodPost, "/admin/pause?target=unknown", "secret").Code)
+
+	// 仅暂停指定组件
+	rec = do(http.MethodPost, "/admin/pause?target=subscription_manager", "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var state AdminState
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &state))
+	assert.Equal(t, map[string]bool{"subscription_manager": true, "position_manager": false}, state.Paused)
+
+	// 默认作用于全部组件
+	require.Equal(t, http.StatusOK, do(http.MethodPost, "/admin/pause", "secret").Code)
+	assert.True(t, positions.paused)
+	require.Equal(t, http.StatusOK, do(http.MethodPost, "/admin/resume", "secret").Code)
+	assert.False(t, subs.paused)
+	assert.False(t, positions.paused)
+
+	rec = do(http.MethodPost, "/admin/unsubscribe-all", "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"unsubscribed":3}`, rec.Body.String())
+
+	rec = do(http.MethodGet, "/admin/state", "secret")
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &state))
+	assert.True(t, state.Suspended)
+
+	require.Equal(t, http.StatusOK, do(http.MethodPost, "/admin/resubscribe", "secret").Code)
+	assert.False(t, control.suspended)
+}
+
+func TestAdminLogLevel(t *testing.T) {
+	t.Cleanup(func() {
+		_ = logger.SetLevel("info")
+		_ = logger.SetModuleLevel("processor", "")
+	})
+
+	h := NewHealthServer(":0", nil, nil, nil)
+	h.SetAdminToken("secret")
+	mux := http.NewServeMux()
+	h.registerAdmin(mux)
+
+	put := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/admin/loglevel", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer secret")
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := put(`{"level":"warn","modules":{"processor":"debug"}}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"level":"warn","modules":{"processor":"debug"}}`, rec.Body.String())
+	assert.Equal(t, "warn", logger.GetLevel())
+
+	// 任一级别不合法时整体不生效
+	assert.Equal(t, http.StatusBadRequest, put(`{"level":"info","modules":{"ws":"verbose"}}`).Code)
+	assert.Equal(t, "warn", logger.GetLevel())
+	assert.Equal(t, http.StatusBadRequest, put(`{}`).Code)
+	assert.Equal(t, http.StatusBadRequest, put(`not json`).Code)
+
+	// 空级别删除模块设置
+	rec = put(`{"modules":{"processor":""}}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"level":"warn"}`, rec.Body.String())
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/loglevel", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
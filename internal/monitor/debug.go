@@ -0,0 +1,168 @@
+package monitor
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/internal/ws"
+)
+
+// SubscriptionStatesRef 订阅状态引用接口
+type SubscriptionStatesRef interface {
+	SubscriptionStates() []ws.SubscriptionState
+}
+
+// CacheStatsRef 缓存统计引用接口
+type CacheStatsRef interface {
+	CacheStats() map[string]map[string]any
+}
+
+// AddressLabelerRef 地址标签引用接口
+type AddressLabelerRef interface {
+	Label(address string) string
+}
+
+// AddressSubscriptionStatus 单个地址的订阅状态
+type AddressSubscriptionStatus struct {
+	Label            string     `json:"label,omitempty"`
+	FillsLastSeen    *time.Time `json:"fills_last_seen"`
+	UpdatesLastSeen  *time.Time `json:"updates_last_seen"`
+	WebData2LastSeen *time.Time `json:"webdata2_last_seen"`
+	ConnectionIndex  int        `json:"connection_index"`
+
+	hasFills    bool
+	hasWebData2 bool
+}
+
+// SetSubscriptions 设置订阅状态来源（可选，用于 /debug/subscriptions）
+func (h *HealthServer) SetSubscriptions(subs SubscriptionStatesRef) {
+	h.mu.Lock()
+	h.subscriptions = subs
+	h.mu.Unlock()
+}
+
+// SetCacheStats 设置缓存统计来源（可选，用于 /debug/caches）
+func (h *HealthServer) SetCacheStats(caches CacheStatsRef) {
+	h.mu.Lock()
+	h.caches = caches
+	h.mu.Unlock()
+}
+
+// SetAddressLabeler 设置地址标签来源（可选，/debug/subscriptions 附带地址标签）
+func (h *HealthServer) SetAddressLabeler(labeler AddressLabelerRef) {
+	h.mu.Lock()
+	h.labeler = labeler
+	h.mu.Unlock()
+}
+
+// subscriptionsHandler 按地址列出各订阅最后收到消息的时间
+// 可选参数 stale（如 10m）：只返回存在超过该时长未收到消息（或从未收到）的订阅的地址
+func (h *HealthServer) subscriptionsHandler(w http.ResponseWriter, r *http.Request) {
+	h.mu.RLock()
+	subs := h.subscriptions
+	labeler := h.labeler
+	h.mu.RUnlock()
+
+	if subs == nil {
+		http.Error(w, "subscription tracking not available", http.StatusNotFound)
+		return
+	}
+
+	var stale time.Duration
+	if v := r.URL.Query().Get("stale"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			http.Error(w, "invalid stale duration", http.StatusBadRequest)
+			return
+		}
+		stale = d
+	}
+
+	result := buildAddressSubscriptionStatus(subs.SubscriptionStates())
+	if stale > 0 {
+		cutoff := time.Now().Add(-stale)
+		for addr, st := range result {
+			if !st.staleSince(cutoff) {
+				delete(result, addr)
+			}
+		}
+	}
+
+	if labeler != nil {
+		for addr, st := range result {
+			st.Label = labeler.Label(addr)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// cachesHandler 返回去重器、订单状态追踪器和待处理订单缓存的条目数、命中率、最早条目年龄和内存估算
+func (h *HealthServer) cachesHandler(w http.ResponseWriter, r *http.Request) {
+	h.mu.RLock()
+	caches := h.caches
+	h.mu.RUnlock()
+
+	if caches == nil {
+		http.Error(w, "cache stats not available", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(caches.CacheStats())
+}
+
+// buildAddressSubscriptionStatus 将订阅状态按地址聚合
+func buildAddressSubscriptionStatus(states []ws.SubscriptionState) map[string]*AddressSubscriptionStatus {
+	result := make(map[string]*AddressSubscriptionStatus)
+	for _, state := range states {
+		user := state.Subscription.User
+		if user == "" {
+			continue
+		}
+
+		st, ok := result[user]
+		if !ok {
+			st = &AddressSubscriptionStatus{ConnectionIndex: -1}
+			result[user] = st
+		}
+
+		var lastSeen *time.Time
+		if !state.LastSeen.IsZero() {
+			t := state.LastSeen
+			lastSeen = &t
+		}
+
+		switch state.Subscription.Channel {
+		case ws.ChannelUserFills:
+			st.FillsLastSeen = lastSeen
+			st.hasFills = true
+			// 以成交订阅所在连接为准
+			st.ConnectionIndex = state.ConnectionIndex
+		case ws.ChannelOrderUpdates:
+			st.UpdatesLastSeen = lastSeen
+		case ws.ChannelWebData2:
+			st.WebData2LastSeen = lastSeen
+			st.hasWebData2 = true
+		default:
+			continue
+		}
+
+		if st.ConnectionIndex < 0 {
+			st.ConnectionIndex = state.ConnectionIndex
+		}
+	}
+	return result
+}
+
+// staleSince 检查地址的订阅是否疑似静默失效
+// webData2 持续推送，超过 cutoff 未收到即视为失效；userFills 订阅后会推送快照，从未收到视为失效；
+// orderUpdates 仅在订单状态变化时推送，不参与判断
+func (s *AddressSubscriptionStatus) staleSince(cutoff time.Time) bool {
+	if s.hasWebData2 && (s.WebData2LastSeen == nil || s.WebData2LastSeen.Before(cutoff)) {
+		return true
+	}
+	return s.hasFills && s.FillsLastSeen == nil
+}
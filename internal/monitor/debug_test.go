@@ -0,0 +1,90 @@
+package monitor
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/internal/ws"
+)
+
+type mockSubscriptionStates []ws.SubscriptionState
+
+func (m mockSubscriptionStates) SubscriptionStates() []ws.SubscriptionState { return m }
+
+type mockCacheStats map[string]map[string]any
+
+func (m mockCacheStats) CacheStats() map[string]map[string]any { return m }
+
+func TestCachesHandler(t *testing.T) {
+	h := NewHealthServer(":0", nil, nil, nil)
+
+	rec := httptest.NewRecorder()
+	h.cachesHandler(rec, httptest.NewRequest(http.MethodGet, "/debug/caches", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code, "未设置缓存统计来源")
+
+	h.SetCacheStats(mockCacheStats{
+		"deduper":        {"entries": 10, "hit_rate": 0.5},
+		"pending_orders": {"entries": 2, "oldest_age_seconds": 12.5},
+	})
+
+	rec = httptest.NewRecorder()
+	h.cachesHandler(rec, httptest.NewRequest(http.MethodGet, "/debug/caches", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body map[string]map[string]float64
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, 10.0, body["deduper"]["entries"])
+	assert.Equal(t, 12.5, body["pending_orders"]["oldest_age_seconds"])
+}
+
+func TestSubscriptionsHandler(t *testing.T) {
+	now := time.Now()
+	states := mockSubscriptionStates{
+		{Subscription: ws.Subscription{Channel: ws.ChannelUserFills, User: "0xa"}, LastSeen: now, ConnectionIndex: 1},
+		{Subscription: ws.Subscription{Channel: ws.ChannelOrderUpdates, User: "0xa"}, ConnectionIndex: 1},
+		{Subscription: ws.Subscription{Channel: ws.ChannelWebData2, User: "0xa"}, LastSeen: now, ConnectionIndex: 0},
+		{Subscription: ws.Subscription{Channel: ws.ChannelUserFills, User: "0xb"}, LastSeen: now, ConnectionIndex: 0},
+		{Subscription: ws.Subscription{Channel: ws.ChannelWebData2, User: "0xb"}, LastSeen: now.Add(-time.Hour), ConnectionIndex: 0},
+		{Subscription: ws.Subscription{Channel: ws.ChannelAllMids}, LastSeen: now},
+	}
+
+	h := NewHealthServer(":0", nil, nil, nil)
+
+	get := func(url string) (int, map[string]AddressSubscriptionStatus) {
+		rec := httptest.NewRecorder()
+		h.subscriptionsHandler(rec, httptest.NewRequest(http.MethodGet, url, nil))
+		var body map[string]AddressSubscriptionStatus
+		if rec.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		}
+		return rec.Code, body
+	}
+
+	code, _ := get("/debug/subscriptions")
+	assert.Equal(t, http.StatusNotFound, code, "未设置订阅来源")
+
+	h.SetSubscriptions(states)
+
+	code, body := get("/debug/subscriptions")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, body, 2)
+	a := body["0xa"]
+	assert.NotNil(t, a.FillsLastSeen)
+	assert.Nil(t, a.UpdatesLastSeen)
+	assert.NotNil(t, a.WebData2LastSeen)
+	assert.Equal(t, 1, a.ConnectionIndex)
+
+	// 只返回疑似失效的订阅
+	code, body = get("/debug/subscriptions?stale=10m")
+	require.Equal(t, http.StatusOK, code)
+	assert.Len(t, body, 1)
+	assert.Contains(t, body, "0xb")
+
+	code, _ = get("/debug/subscriptions?stale=abc")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
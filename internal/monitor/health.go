@@ -3,6 +3,7 @@ package monitor
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"sync"
@@ -10,22 +11,38 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/utrading/utrading-hl-monitor/internal/ws"
 	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
 	"github.com/utrading/utrading-hl-monitor/pkg/logger"
 )
 
 // HealthServer HTTP 健康检查和指标服务器
 type HealthServer struct {
-	addr         string
-	subManager   SubscriptionManagerRef
-	pool         PoolRef
-	publisher    PublisherRef
-	server       *http.Server
-	mu           sync.RWMutex
-	healthy      bool
-	healthySince time.Time
-	startTime    time.Time
-	metrics      *Metrics
+	addr          string
+	subManager    SubscriptionManagerRef
+	pool          PoolRef
+	publisher     PublisherRef
+	upstream      UpstreamRef
+	leader        LeaderRef
+	subscriptions SubscriptionStatesRef
+	labeler       AddressLabelerRef
+	caches        CacheStatsRef
+	pausables     map[string]PausableRef
+	subControl    SubscriptionControlRef
+	adminToken    string
+	security      ServerSecurity
+	stream        *SignalStream
+	pnl           PnLStatsRef
+	readiness     ReadinessChecks
+	database      DatabaseRef
+	writer        BacklogRef
+	queue         QueueDepthRef
+	server        *http.Server
+	mu            sync.RWMutex
+	healthy       bool
+	healthySince  time.Time
+	startTime     time.Time
+	metrics       *Metrics
 }
 
 // PoolRef WebSocket连接池引用接口
@@ -35,11 +52,32 @@ type PoolRef interface {
 	GetStats() map[string]any
 }
 
+// ConnectionStatsRef 按连接提供状态的连接池（可选），/status 据此展示每条连接
+type ConnectionStatsRef interface {
+	ConnectionStats() []ws.ConnectionStats
+}
+
 // PublisherRef NATS发布器引用接口
 type PublisherRef interface {
 	IsConnected() bool
 }
 
+// NATSEndpointsRef 支持多集群发布的发布器（可选），/status 据此展示每个集群
+type NATSEndpointsRef interface {
+	Strategy() string
+	EndpointStatus() []NATSEndpointStatus
+}
+
+// UpstreamRef 上游 API 探测器引用接口
+type UpstreamRef interface {
+	Status() UpstreamStatus
+}
+
+// LeaderRef 主备选举器引用接口
+type LeaderRef interface {
+	Role() string
+}
+
 // SubscriptionManagerRef 订阅管理器引用接口
 type SubscriptionManagerRef interface {
 	AddressCount() int
@@ -60,6 +98,20 @@ func NewHealthServer(addr string, subManager SubscriptionManagerRef, pool PoolRe
 	}
 }
 
+// SetUpstream 设置上游 API 探测器（可选）
+func (h *HealthServer) SetUpstream(upstream UpstreamRef) {
+	h.mu.Lock()
+	h.upstream = upstream
+	h.mu.Unlock()
+}
+
+// SetLeader 设置主备选举器（可选）
+func (h *HealthServer) SetLeader(leader LeaderRef) {
+	h.mu.Lock()
+	h.leader = leader
+	h.mu.Unlock()
+}
+
 // Start 启动HTTP服务器
 func (h *HealthServer) Start(ctx context.Context) error {
 	mux := http.NewServeMux()
@@ -70,14 +122,40 @@ func (h *HealthServer) Start(ctx context.Context) error {
 	mux.HandleFunc("/health/live", h.liveHandler)
 
 	// Prometheus指标端点
-	mux.Handle("/metrics", promhttp.Handler())
+	// 开启 OpenMetrics 协商，以暴露 exemplar（如发布失败的 trace_id）
+	mux.Handle("/metrics", promhttp.InstrumentMetricHandler(
+		h.metrics.Registerer(),
+		promhttp.HandlerFor(h.metrics.Gatherer(), promhttp.HandlerOpts{EnableOpenMetrics: true}),
+	))
 
 	// 服务状态端点
 	mux.HandleFunc("/status", h.statusHandler)
 
+	// 调试端点
+	mux.HandleFunc("/debug/subscriptions", h.subscriptionsHandler)
+	mux.HandleFunc("/debug/caches", h.cachesHandler)
+
+	// 信号 SSE 推送
+	mux.HandleFunc("/stream/signals", h.streamSignalsHandler)
+
+	// 统计端点
+	mux.HandleFunc("/stats/pnl", h.pnlStatsHandler)
+
+	// 管理端点（配置令牌后启用）
+	h.registerAdmin(mux)
+
+	h.mu.RLock()
+	security := h.security
+	h.mu.RUnlock()
+	tlsConfig, err := security.tlsConfig()
+	if err != nil {
+		return fmt.Errorf("health server tls: %w", err)
+	}
+
 	h.server = &http.Server{
 		Addr:         h.addr,
-		Handler:      mux,
+		Handler:      h.authMiddleware(mux),
+		TLSConfig:    tlsConfig,
 		ReadTimeout:  10 * time.Second,
 		WriteTimeout: 10 * time.Second,
 	}
@@ -85,12 +163,23 @@ func (h *HealthServer) Start(ctx context.Context) error {
 	logger.Info().Str("addr", h.addr).Msg("health server starting")
 
 	goplus.Go(func() {
-		if err := h.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if tlsConfig != nil {
+			err = h.server.ListenAndServeTLS("", "")
+		} else {
+			err = h.server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			logger.Error().Err(err).Msg("health server error")
 		}
 	})
 
-	logger.Info().Str("addr", h.addr).Msg("health server started")
+	logger.Info().
+		Str("addr", h.addr).
+		Bool("tls", tlsConfig != nil).
+		Bool("mtls", security.ClientCAFile != "").
+		Bool("auth", security.AuthToken != "").
+		Msg("health server started")
 
 	return nil
 }
@@ -99,8 +188,16 @@ func (h *HealthServer) Start(ctx context.Context) error {
 func (h *HealthServer) Stop(ctx context.Context) error {
 	h.mu.Lock()
 	h.healthy = false
+	stream := h.stream
 	h.mu.Unlock()
 
+	// 先断开 SSE 长连接，否则 Shutdown 会一直等待其结束
+	if stream != nil {
+		stream.Close()
+	}
+	if h.server == nil {
+		return nil
+	}
 	return h.server.Shutdown(ctx)
 }
 
@@ -117,17 +214,6 @@ func (h *HealthServer) healthHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(status)
 }
 
-// readyHandler 就绪检查处理器
-func (h *HealthServer) readyHandler(w http.ResponseWriter, r *http.Request) {
-	ready := h.isReady()
-	if !ready {
-		http.Error(w, "not ready", http.StatusServiceUnavailable)
-		return
-	}
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte("ok"))
-}
-
 // liveHandler 存活检查处理器
 func (h *HealthServer) liveHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
@@ -141,41 +227,35 @@ func (h *HealthServer) statusHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(status)
 }
 
-// isReady 检查服务是否就绪
-func (h *HealthServer) isReady() bool {
-	h.mu.RLock()
-	healthy := h.healthy
-	h.mu.RUnlock()
-
-	if !healthy {
-		return false
-	}
-
-	// 检查WebSocket连接
-	if h.pool != nil && !h.pool.IsConnected() {
-		return false
-	}
-
-	return true
-}
-
 // getHealthStatus 获取健康状态
 func (h *HealthServer) getHealthStatus() HealthStatus {
 	h.mu.RLock()
 	healthy := h.healthy
 	healthySince := h.healthySince
+	upstream := h.upstream
+	leader := h.leader
 	h.mu.RUnlock()
 
 	wsConnected := false
 	wsReconnecting := false
+	var wsConnections []ws.ConnectionStats
 	if h.pool != nil {
 		wsConnected = h.pool.IsConnected()
 		wsReconnecting = h.pool.IsReconnecting()
+		if ref, ok := h.pool.(ConnectionStatsRef); ok {
+			wsConnections = ref.ConnectionStats()
+		}
 	}
 
-	natsConnected := false
+	natsStatus := NATSStatus{}
 	if h.publisher != nil {
-		natsConnected = h.publisher.IsConnected()
+		natsStatus.Connected = h.publisher.IsConnected()
+		if ref, ok := h.publisher.(NATSEndpointsRef); ok {
+			if endpoints := ref.EndpointStatus(); len(endpoints) > 1 {
+				natsStatus.Strategy = ref.Strategy()
+				natsStatus.Endpoints = endpoints
+			}
+		}
 	}
 
 	addressCount := 0
@@ -183,6 +263,17 @@ func (h *HealthServer) getHealthStatus() HealthStatus {
 		addressCount = h.subManager.AddressCount()
 	}
 
+	role := ""
+	if leader != nil {
+		role = leader.Role()
+	}
+
+	var upstreamStatus *UpstreamStatus
+	if upstream != nil {
+		st := upstream.Status()
+		upstreamStatus = &st
+	}
+
 	return HealthStatus{
 		Healthy:      healthy,
 		HealthySince: healthySince.Format(time.RFC3339),
@@ -190,13 +281,14 @@ func (h *HealthServer) getHealthStatus() HealthStatus {
 		WebSocket: WebSocketStatus{
 			Connected:    wsConnected,
 			Reconnecting: wsReconnecting,
+			Connections:  wsConnections,
 		},
-		NATS: NATSStatus{
-			Connected: natsConnected,
-		},
+		NATS: natsStatus,
 		Addresses: AddressStatus{
 			Count: addressCount,
 		},
+		Upstream: upstreamStatus,
+		Role:     role,
 	}
 }
 
@@ -208,17 +300,29 @@ type HealthStatus struct {
 	WebSocket    WebSocketStatus `json:"websocket"`
 	NATS         NATSStatus      `json:"nats"`
 	Addresses    AddressStatus   `json:"addresses"`
+	Upstream     *UpstreamStatus `json:"upstream,omitempty"`
+	Role         string          `json:"role,omitempty"` // 热备模式下的实例角色: leader/standby
 }
 
 // WebSocketStatus WebSocket连接状态
 type WebSocketStatus struct {
-	Connected    bool `json:"connected"`
-	Reconnecting bool `json:"reconnecting"`
+	Connected    bool                 `json:"connected"`
+	Reconnecting bool                 `json:"reconnecting"`
+	Connections  []ws.ConnectionStats `json:"connections,omitempty"` // 每条连接的状态
 }
 
 // NATSStatus NATS连接状态
 type NATSStatus struct {
-	Connected bool `json:"connected"`
+	Connected bool                 `json:"connected"`
+	Strategy  string               `json:"strategy,omitempty"`  // 多集群发布策略: failover/fanout
+	Endpoints []NATSEndpointStatus `json:"endpoints,omitempty"` // 配置备用集群时展示每个集群
+}
+
+// NATSEndpointStatus 单个 NATS 集群状态
+type NATSEndpointStatus struct {
+	Name      string `json:"name"`
+	Connected bool   `json:"connected"`
+	Active    bool   `json:"active,omitempty"` // failover 策略当前使用的集群
 }
 
 // AddressStatus 地址状态
@@ -230,9 +334,22 @@ type AddressStatus struct {
 type Metrics struct {
 	signalsPublished   *prometheus.CounterVec
 	signalErrors       *prometheus.CounterVec
+	signalErrorStreak  prometheus.Gauge
+	// 信号 SSE 推送相关
+	signalStreamClients prometheus.Gauge
+	signalStreamDropped prometheus.Counter
+	// 信号钩子相关
+	signalHookResults *prometheus.CounterVec
+	// 地址分组主题相关
+	signalGroupPublished *prometheus.CounterVec
 	addressesCount     prometheus.Gauge
 	websocketConnected prometheus.Gauge
 	natsConnected      prometheus.Gauge
+	// NATS 多集群相关
+	natsEndpointConnected *prometheus.GaugeVec
+	natsFailovers         *prometheus.CounterVec
+	natsPublishBatchSize  prometheus.Histogram
+	natsPublishBatchSecs  *prometheus.HistogramVec
 	tradeDeduped       prometheus.Counter
 	tradeProcessed     *prometheus.CounterVec
 	positionsTotal     prometheus.Gauge
@@ -241,7 +358,9 @@ type Metrics struct {
 	// 订单聚合相关
 	orderAggregationActive prometheus.Gauge
 	orderFlushTotal        *prometheus.CounterVec
+	orderEvictedTotal      prometheus.Counter
 	orderFillsPerOrder     prometheus.Histogram
+	orderFlushLatency      *prometheus.HistogramVec
 	orderUpdatesReceived   prometheus.Counter
 	// 连接池管理相关
 	poolManagerConnectionCount prometheus.Gauge
@@ -251,15 +370,111 @@ type Metrics struct {
 	// 消息队列相关 (T042)
 	messageQueueSize      prometheus.Gauge
 	messageQueueFullTotal prometheus.Counter
+	shutdownDrained       *prometheus.GaugeVec
+	shutdownRemaining     *prometheus.GaugeVec
 	// 批量写入器相关 (T043)
 	batchWriteSize         prometheus.Histogram
 	batchWriteDurationSecs prometheus.Histogram
 	batchDedupCacheHit     *prometheus.CounterVec
+	batchWriteQuarantined  *prometheus.CounterVec
+	batchWriteBuffered     prometheus.Gauge
+	batchWriteDropped      *prometheus.CounterVec
+	batchWriteTargetSize   prometheus.Gauge
+	batchWriteInterval     prometheus.Gauge
+	// 数据库熔断相关
+	dbBreakerOpen  prometheus.Gauge
+	dbBreakerTrips prometheus.Counter
+	// 上游（Hyperliquid API）健康相关
+	upstreamHealthy        prometheus.Gauge
+	upstreamLatencySeconds prometheus.Histogram
+	// 休眠地址相关
+	addressesDormant prometheus.Gauge
+	// 对账相关
+	reconcileChecksTotal        *prometheus.CounterVec
+	reconcileDiscrepanciesTotal *prometheus.CounterVec
+	reconcileHealedTotal        prometheus.Counter
+	// 热备相关
+	leader                 prometheus.Gauge
+	signalsSuppressedTotal prometheus.Counter
+	// 仓位比例相关
+	signalStaleBalanceTotal *prometheus.CounterVec
+	// 地址信号过滤相关
+	fillsFilteredTotal *prometheus.CounterVec
+	// 看门狗相关
+	componentHeartbeatAge *prometheus.GaugeVec
+	watchdogStallsTotal   *prometheus.CounterVec
+	watchdogRestartsTotal *prometheus.CounterVec
+	// 信号级别相关
+	signalSeverityTotal          *prometheus.CounterVec
+	signalCriticalPublishedTotal *prometheus.CounterVec
+	// webhook 相关
+	webhookDeliveriesTotal *prometheus.CounterVec
+	webhookBreakerOpen     *prometheus.GaugeVec
+	// REST 请求额度相关
+	rateLimitUsed  prometheus.Gauge
+	rateLimitCap   prometheus.Gauge
+	rateLimitRatio prometheus.Gauge
+	// WebSocket 流量相关
+	wsReceivedBytesTotal *prometheus.CounterVec
+	wsSchemaDriftTotal   *prometheus.CounterVec
+	wsMessageLagSeconds  *prometheus.HistogramVec
+	wsClockSkewTotal     *prometheus.CounterVec
+	// WS 录制相关
+	captureActive       prometheus.Gauge
+	captureRecordsTotal prometheus.Counter
+	captureDroppedTotal prometheus.Counter
+	captureFilesTotal   *prometheus.CounterVec
+	// 自检心跳相关
+	selfTestLastSuccess   prometheus.Gauge
+	selfTestLatency       prometheus.Gauge
+	selfTestFailuresTotal *prometheus.CounterVec
+	// 挂单镜像相关
+	openOrdersMirrored    prometheus.Gauge
+	openOrderFlushErrors  prometheus.Counter
+	// 订阅预热限速相关
+	subscribeWarmupPending   prometheus.Gauge
+	subscribeWarmupCompleted prometheus.Counter
+	// 暂停控制相关
+	managerPaused         *prometheus.GaugeVec
+	pausedMessagesDropped *prometheus.CounterVec
+	// 成交高水位相关
+	fillsReplaySkipped prometheus.Counter
+	// 余额变动相关
+	balanceTransfers *prometheus.CounterVec
+	// 订单归属映射相关
+	oidOwnersSize    prometheus.Gauge
+	oidOwnersEvicted *prometheus.CounterVec
+	// 订单去重缓存相关
+	dedupCacheEntries     prometheus.Gauge
+	dedupCacheMemoryBytes prometheus.Gauge
+	dedupCacheEvicted     *prometheus.CounterVec
+	// 信号发件箱相关
+	signalOutboxPending         prometheus.Gauge
+	signalOutboxEnqueueFailures prometheus.Counter
+	signalOutboxDeadLetters     *prometheus.CounterVec
+	// 成交对账相关
+	fillReconcileChecked       prometheus.Counter
+	fillReconcileDiscrepancies *prometheus.CounterVec
+	fillReconcileLastRun       prometheus.Gauge
+
+	registerer prometheus.Registerer
+	gatherer   prometheus.Gatherer
 }
 
-// NewMetrics 创建指标收集器
-func NewMetrics(namespace string) *Metrics {
+// NewMetrics 创建指标收集器并注册到 reg，reg 为空时使用默认注册表
+// 注册表中已有同名指标时复用已有指标，重复创建（测试、嵌入）不会 panic
+// reg 同时实现 prometheus.Gatherer（如 prometheus.NewRegistry()）时 /metrics 从 reg 采集，否则从默认注册表采集
+func NewMetrics(namespace string, reg prometheus.Registerer) *Metrics {
+	var gatherer prometheus.Gatherer = prometheus.DefaultGatherer
+	if reg == nil {
+		reg = prometheus.DefaultRegisterer
+	} else if g, ok := reg.(prometheus.Gatherer); ok {
+		gatherer = g
+	}
+
 	m := &Metrics{
+		registerer: reg,
+		gatherer:   gatherer,
 		signalsPublished: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Namespace: namespace,
@@ -272,9 +487,46 @@ func NewMetrics(namespace string) *Metrics {
 			prometheus.CounterOpts{
 				Namespace: namespace,
 				Name:      "signal_errors_total",
-				Help:      "Total number of signal publish errors",
+				Help:      "Total number of signal publish errors by error code (exemplar: trace_id)",
 			},
-			[]string{"type"},
+			[]string{"code"},
+		),
+		signalErrorStreak: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "signal_publish_failure_streak",
+				Help:      "信号连续发布失败次数，发布成功后归零",
+			},
+		),
+		signalStreamClients: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "signal_stream_clients",
+				Help:      "/stream/signals 当前 SSE 连接数",
+			},
+		),
+		signalStreamDropped: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "signal_stream_dropped_total",
+				Help:      "SSE 订阅者消费过慢被丢弃的信号数",
+			},
+		),
+		signalHookResults: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "signal_hook_results_total",
+				Help:      "信号钩子丢弃信号或执行失败的次数（result: dropped/error）",
+			},
+			[]string{"hook", "result"},
+		),
+		signalGroupPublished: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "signal_group_published_total",
+				Help:      "按地址分组主题发布的信号数（result: success/failed）",
+			},
+			[]string{"group", "result"},
 		),
 		addressesCount: prometheus.NewGauge(
 			prometheus.GaugeOpts{
@@ -297,6 +549,39 @@ func NewMetrics(namespace string) *Metrics {
 				Help:      "NATS connection status (1=connected, 0=disconnected)",
 			},
 		),
+		natsEndpointConnected: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "nats_endpoint_connected",
+				Help:      "各 NATS 集群连接状态（1=已连接，0=断开）",
+			},
+			[]string{"endpoint"},
+		),
+		natsFailovers: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "nats_failovers_total",
+				Help:      "failover 策略下发布切换集群的次数",
+			},
+			[]string{"from", "to"},
+		),
+		natsPublishBatchSize: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "nats_publish_batch_size",
+				Help:      "信号批量发布每批的信号数分布",
+				Buckets:   []float64{1, 2, 5, 10, 20, 50, 100, 200, 500},
+			},
+		),
+		natsPublishBatchSecs: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "nats_publish_batch_duration_seconds",
+				Help:      "信号批量发布每批从发布到确认的耗时（按确认方式）",
+				Buckets:   []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 5},
+			},
+			[]string{"ack"},
+		),
 		tradeDeduped: prometheus.NewCounter(
 			prometheus.CounterOpts{
 				Namespace: namespace,
@@ -350,6 +635,13 @@ func NewMetrics(namespace string) *Metrics {
 			},
 			[]string{"trigger"},
 		),
+		orderEvictedTotal: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "order_aggregation_evicted_total",
+				Help:      "聚合数量超过上限时被提前发送的订单总数",
+			},
+		),
 		orderFillsPerOrder: prometheus.NewHistogram(
 			prometheus.HistogramOpts{
 				Namespace: namespace,
@@ -358,6 +650,15 @@ func NewMetrics(namespace string) *Metrics {
 				Buckets:   []float64{1, 2, 3, 5, 10, 20, 50},
 			},
 		),
+		orderFlushLatency: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "order_flush_latency_seconds",
+				Help:      "订单从首笔成交到发送信号的耗时（按触发原因）",
+				Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600},
+			},
+			[]string{"trigger"},
+		),
 		orderUpdatesReceived: prometheus.NewCounter(
 			prometheus.CounterOpts{
 				Namespace: namespace,
@@ -404,6 +705,22 @@ func NewMetrics(namespace string) *Metrics {
 				Help:      "消息队列满事件总数",
 			},
 		),
+		shutdownDrained: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "shutdown_drained_items",
+				Help:      "关闭时各队列排空处理的条数",
+			},
+			[]string{"component"},
+		),
+		shutdownRemaining: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "shutdown_remaining_items",
+				Help:      "关闭结束时各队列未处理（丢弃）的条数",
+			},
+			[]string{"component"},
+		),
 		// 批量写入器相关 (T043)
 		batchWriteSize: prometheus.NewHistogram(
 			prometheus.HistogramOpts{
@@ -429,34 +746,554 @@ func NewMetrics(namespace string) *Metrics {
 			},
 			[]string{"table"},
 		),
+		batchWriteQuarantined: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "batch_write_quarantined_total",
+				Help:      "批量写入失败被隔离到 hl_failed_writes 的行数",
+			},
+			[]string{"table"},
+		),
+		batchWriteBuffered: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "batch_write_buffered",
+				Help:      "批量写入器内存中待写入的条数（数据库不可用时持续增长）",
+			},
+		),
+		batchWriteDropped: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "batch_write_dropped_total",
+				Help:      "批量写入缓冲已满被丢弃的条数",
+			},
+			[]string{"table", "reason"},
+		),
+		batchWriteTargetSize: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "batch_write_target_size",
+				Help:      "批量写入器当前单批条数（自适应模式下随写入耗时调整）",
+			},
+		),
+		batchWriteInterval: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "batch_write_flush_interval_seconds",
+				Help:      "批量写入器当前定时刷新间隔（自适应模式下随写入耗时调整）",
+			},
+		),
+		dbBreakerOpen: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "db_breaker_open",
+				Help:      "数据库熔断器是否打开 (1=熔断中, 0=正常)",
+			},
+		),
+		dbBreakerTrips: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "db_breaker_trips_total",
+				Help:      "数据库熔断器打开次数",
+			},
+		),
+		upstreamHealthy: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "upstream_healthy",
+				Help:      "Hyperliquid API 探测状态（1=正常, 0=异常）",
+			},
+		),
+		upstreamLatencySeconds: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "upstream_latency_seconds",
+				Help:      "Hyperliquid API 探测延迟分布（秒）",
+				Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2, 5, 10},
+			},
+		),
+		addressesDormant: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "addresses_dormant",
+				Help:      "当前处于休眠状态的地址数量",
+			},
+		),
+		reconcileChecksTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "reconcile_checks_total",
+				Help:      "仓位对账检查次数（result: ok/mismatch/error）",
+			},
+			[]string{"result"},
+		),
+		reconcileDiscrepanciesTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "reconcile_discrepancies_total",
+				Help:      "仓位对账差异数量（source: cache/db, field: spot/futures/account_value）",
+			},
+			[]string{"source", "field"},
+		),
+		reconcileHealedTotal: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "reconcile_healed_total",
+				Help:      "对账后自动修复的地址数量",
+			},
+		),
+		leader: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "leader",
+				Help:      "当前实例是否为主实例（1=主, 0=备）",
+			},
+		),
+		signalsSuppressedTotal: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "signals_suppressed_total",
+				Help:      "备实例抑制发送的信号数量",
+			},
+		),
+		signalStaleBalanceTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "signal_stale_balance_total",
+				Help:      "余额缓存过期时生成的信号数量（按处理方式）",
+			},
+			[]string{"mode"},
+		),
+		fillsFilteredTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "fills_filtered_total",
+				Help:      "被地址信号过滤（hl_watch_addresses.signal_filter）拦截、不进入聚合的成交数量（按原因）",
+			},
+			[]string{"reason"},
+		),
+		componentHeartbeatAge: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "component_heartbeat_age_seconds",
+				Help:      "内部组件后台循环距上次心跳的秒数（按组件）",
+			},
+			[]string{"component"},
+		),
+		watchdogStallsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "watchdog_stalls_total",
+				Help:      "看门狗检测到组件心跳停滞的次数（按组件）",
+			},
+			[]string{"component"},
+		),
+		watchdogRestartsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "watchdog_restarts_total",
+				Help:      "看门狗重启组件的次数（按组件）",
+			},
+			[]string{"component"},
+		),
+		signalSeverityTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "signal_severity_total",
+				Help:      "生成的信号数量（按级别）",
+			},
+			[]string{"severity"},
+		),
+		signalCriticalPublishedTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "signal_critical_published_total",
+				Help:      "critical 信号发布到告警主题的次数",
+			},
+			[]string{"result"},
+		),
+		webhookDeliveriesTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "webhook_deliveries_total",
+				Help:      "webhook 信号投递次数（按端点和结果）",
+			},
+			[]string{"endpoint", "result"},
+		),
+		webhookBreakerOpen: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "webhook_breaker_open",
+				Help:      "webhook 端点是否处于熔断状态（1=熔断, 0=正常）",
+			},
+			[]string{"endpoint"},
+		),
+		rateLimitUsed: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "rate_limit_requests_used",
+				Help:      "监控账户已使用的 REST 请求数",
+			},
+		),
+		rateLimitCap: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "rate_limit_requests_cap",
+				Help:      "监控账户 REST 请求额度上限（含盈余）",
+			},
+		),
+		rateLimitRatio: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "rate_limit_used_ratio",
+				Help:      "监控账户 REST 请求额度使用比例",
+			},
+		),
+		wsReceivedBytesTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "ws_received_bytes_total",
+				Help:      "WebSocket 接收字节数（wire=线上压缩后, payload=解压后）",
+			},
+			[]string{"kind"},
+		),
+		wsSchemaDriftTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "ws_schema_drift_total",
+				Help:      "抽样检查中 WebSocket 消息的字段差异次数（unknown=未登记字段, missing=缺失字段, invalid=非 JSON 对象）",
+			},
+			[]string{"channel", "kind", "field"},
+		),
+		wsMessageLagSeconds: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "ws_message_lag_seconds",
+				Help:      "交易所时间戳（userFills=成交时间, webData2=serverTime）到本地接收的延迟分布（秒）",
+				Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2, 5, 10, 30},
+			},
+			[]string{"channel", "address"},
+		),
+		wsClockSkewTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "ws_clock_skew_total",
+				Help:      "交易所时间戳晚于本地接收时间的消息数（本地时钟落后）",
+			},
+			[]string{"channel"},
+		),
+		captureActive: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "capture_active",
+				Help:      "WebSocket 原始消息录制是否进行中（1=录制中）",
+			},
+		),
+		captureRecordsTotal: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "capture_records_total",
+				Help:      "录制的 WebSocket 消息数",
+			},
+		),
+		captureDroppedTotal: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "capture_dropped_total",
+				Help:      "录制队列已满而丢弃的 WebSocket 消息数",
+			},
+		),
+		captureFilesTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "capture_files_total",
+				Help:      "轮转后交给存储的录制文件数（result=success/failed）",
+			},
+			[]string{"result"},
+		),
+		selfTestLastSuccess: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "selftest_last_success_timestamp_seconds",
+				Help:      "自检心跳最近一次端到端成功（队列→处理器→NATS→数据库）的时间（Unix 秒）",
+			},
+		),
+		selfTestLatency: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "selftest_latency_seconds",
+				Help:      "最近一次自检心跳从注入到 NATS 收到的耗时",
+			},
+		),
+		selfTestFailuresTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "selftest_failures_total",
+				Help:      "自检心跳失败次数（stage=inject/nats/db）",
+			},
+			[]string{"stage"},
+		),
+		openOrdersMirrored: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "open_orders_mirrored",
+				Help:      "挂单镜像中的挂单总数",
+			},
+		),
+		openOrderFlushErrors: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "open_order_flush_errors_total",
+				Help:      "挂单镜像写库失败次数（失败地址下次重试）",
+			},
+		),
+		subscribeWarmupPending: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "subscribe_warmup_pending",
+				Help:      "等待限速放行的 WS 订阅请求数（大批量地址预热进度）",
+			},
+		),
+		subscribeWarmupCompleted: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "subscribe_warmup_completed_total",
+				Help:      "经限速放行的 WS 订阅请求数",
+			},
+		),
+		managerPaused: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "manager_paused",
+				Help:      "管理器是否处于暂停状态（1=暂停，保留订阅但不处理消息）",
+			},
+			[]string{"manager"},
+		),
+		pausedMessagesDropped: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "paused_messages_dropped_total",
+				Help:      "暂停期间丢弃的 WS 消息数",
+			},
+			[]string{"manager"},
+		),
+		fillsReplaySkipped: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "fills_replay_skipped_total",
+				Help:      "订阅快照中早于成交高水位而跳过的重放成交数",
+			},
+		),
+		balanceTransfers: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "balance_transfers_total",
+				Help:      "监控地址的充值/提现/转账等非交易余额变动次数",
+			},
+			[]string{"type", "direction"},
+		),
+		oidOwnersSize: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "oid_owners_size",
+				Help:      "成交订单 oid 到地址映射的条目数（用于 orderUpdates 地址归属）",
+			},
+		),
+		oidOwnersEvicted: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "oid_owners_evicted_total",
+				Help:      "oid 到地址映射中未收到终止状态而被淘汰的条目数（ttl：过期；capacity：超出上限淘汰最久未访问）",
+			},
+			[]string{"reason"},
+		),
+		dedupCacheEntries: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "dedup_cache_entries",
+				Help:      "订单去重缓存的条目数",
+			},
+		),
+		dedupCacheMemoryBytes: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "dedup_cache_memory_bytes",
+				Help:      "订单去重缓存的估算内存占用（字节）",
+			},
+		),
+		dedupCacheEvicted: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "dedup_cache_evicted_total",
+				Help:      "订单去重缓存淘汰的条目数（ttl：超过去重窗口；capacity：超出条目上限淘汰最久未访问）",
+			},
+			[]string{"reason"},
+		),
+		signalOutboxPending: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "signal_outbox_pending",
+				Help:      "发件箱中待发布到 NATS 的信号数",
+			},
+		),
+		signalOutboxEnqueueFailures: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "signal_outbox_enqueue_failures_total",
+				Help:      "订单聚合与信号写入发件箱的事务失败次数（订单保留在内存中等待重试）",
+			},
+		),
+		signalOutboxDeadLetters: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "signal_outbox_dead_letters_total",
+				Help:      "发件箱中转入死信、不再发布的信号数（decode：载荷无法解析；max_attempts：发布失败次数达到上限）",
+			},
+			[]string{"reason"},
+		),
+		fillReconcileChecked: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "fill_reconcile_checked_orders_total",
+				Help:      "成交对账核对的已发送订单聚合数",
+			},
+		),
+		fillReconcileDiscrepancies: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "fill_reconcile_discrepancies_total",
+				Help:      "成交对账发现的数量差异（aggregation：成交之和与聚合 total_size 不一致；signal：与已发布信号 size 不一致）",
+			},
+			[]string{"kind"},
+		),
+		fillReconcileLastRun: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "fill_reconcile_last_run_timestamp_seconds",
+				Help:      "最近一次成交对账完成的时间（Unix 秒）",
+			},
+		),
 	}
 
-	prometheus.MustRegister(
-		m.signalsPublished,
-		m.signalErrors,
-		m.addressesCount,
-		m.websocketConnected,
-		m.natsConnected,
-		m.tradeDeduped,
-		m.tradeProcessed,
-		m.positionsTotal,
-		m.positionUpdates,
-		m.marginUpdates,
-		m.orderAggregationActive,
-		m.orderFlushTotal,
-		m.orderFillsPerOrder,
-		m.orderUpdatesReceived,
-		m.poolManagerConnectionCount,
+	mustRegister(reg,
+		&m.signalsPublished,
+		&m.signalErrors,
+		&m.signalErrorStreak,
+		&m.signalStreamClients,
+		&m.signalStreamDropped,
+		&m.signalHookResults,
+		&m.signalGroupPublished,
+		&m.addressesCount,
+		&m.websocketConnected,
+		&m.natsConnected,
+		&m.natsEndpointConnected,
+		&m.natsFailovers,
+		&m.natsPublishBatchSize,
+		&m.natsPublishBatchSecs,
+		&m.tradeDeduped,
+		&m.tradeProcessed,
+		&m.positionsTotal,
+		&m.positionUpdates,
+		&m.marginUpdates,
+		&m.orderAggregationActive,
+		&m.orderFlushTotal,
+		&m.orderEvictedTotal,
+		&m.orderFillsPerOrder,
+		&m.orderFlushLatency,
+		&m.orderUpdatesReceived,
+		&m.poolManagerConnectionCount,
 		// 缓存相关 (T041)
-		m.cacheHitTotal,
-		m.cacheMissTotal,
+		&m.cacheHitTotal,
+		&m.cacheMissTotal,
 		// 消息队列相关 (T042)
-		m.messageQueueSize,
-		m.messageQueueFullTotal,
+		&m.messageQueueSize,
+		&m.messageQueueFullTotal,
+		&m.shutdownDrained,
+		&m.shutdownRemaining,
 		// 批量写入器相关 (T043)
-		m.batchWriteSize,
-		m.batchWriteDurationSecs,
-		m.batchDedupCacheHit,
+		&m.batchWriteSize,
+		&m.batchWriteDurationSecs,
+		&m.batchDedupCacheHit,
+		&m.batchWriteQuarantined,
+		&m.batchWriteBuffered,
+		&m.batchWriteDropped,
+		&m.batchWriteTargetSize,
+		&m.batchWriteInterval,
+		// 数据库熔断相关
+		&m.dbBreakerOpen,
+		&m.dbBreakerTrips,
+		// 上游健康相关
+		&m.upstreamHealthy,
+		&m.upstreamLatencySeconds,
+		// 休眠地址相关
+		&m.addressesDormant,
+		// 对账相关
+		&m.reconcileChecksTotal,
+		&m.reconcileDiscrepanciesTotal,
+		&m.reconcileHealedTotal,
+		// 热备相关
+		&m.leader,
+		&m.signalsSuppressedTotal,
+		// 仓位比例相关
+		&m.signalStaleBalanceTotal,
+		// 地址信号过滤相关
+		&m.fillsFilteredTotal,
+		// 看门狗相关
+		&m.componentHeartbeatAge,
+		&m.watchdogStallsTotal,
+		&m.watchdogRestartsTotal,
+		// 信号级别相关
+		&m.signalSeverityTotal,
+		&m.signalCriticalPublishedTotal,
+		// webhook 相关
+		&m.webhookDeliveriesTotal,
+		&m.webhookBreakerOpen,
+		// REST 请求额度相关
+		&m.rateLimitUsed,
+		&m.rateLimitCap,
+		&m.rateLimitRatio,
+		// WebSocket 流量相关
+		&m.wsReceivedBytesTotal,
+		&m.wsSchemaDriftTotal,
+		&m.wsMessageLagSeconds,
+		&m.wsClockSkewTotal,
+		// WS 录制相关
+		&m.captureActive,
+		&m.captureRecordsTotal,
+		&m.captureDroppedTotal,
+		&m.captureFilesTotal,
+		// 自检心跳相关
+		&m.selfTestLastSuccess,
+		&m.selfTestLatency,
+		&m.selfTestFailuresTotal,
+		// 挂单镜像相关
+		&m.openOrdersMirrored,
+		&m.openOrderFlushErrors,
+		// 订阅预热限速相关
+		&m.subscribeWarmupPending,
+		&m.subscribeWarmupCompleted,
+		// 暂停控制相关
+		&m.managerPaused,
+		&m.pausedMessagesDropped,
+		// 成交高水位相关
+		&m.fillsReplaySkipped,
+		// 余额变动相关
+		&m.balanceTransfers,
+		// 订单归属映射相关
+		&m.oidOwnersSize,
+		&m.oidOwnersEvicted,
+		// 订单去重缓存相关
+		&m.dedupCacheEntries,
+		&m.dedupCacheMemoryBytes,
+		&m.dedupCacheEvicted,
+		// 信号发件箱相关
+		&m.signalOutboxPending,
+		&m.signalOutboxEnqueueFailures,
+		&m.signalOutboxDeadLetters,
+		// 成交对账相关
+		&m.fillReconcileChecked,
+		&m.fillReconcileDiscrepancies,
+		&m.fillReconcileLastRun,
 	)
 
 	return m
@@ -485,14 +1322,64 @@ func (m *Metrics) SetNATSConnected(connected bool) {
 	}
 }
 
+// SetNATSEndpointConnected 设置单个 NATS 集群连接状态
+func (m *Metrics) SetNATSEndpointConnected(endpoint string, connected bool) {
+	if connected {
+		m.natsEndpointConnected.WithLabelValues(endpoint).Set(1)
+	} else {
+		m.natsEndpointConnected.WithLabelValues(endpoint).Set(0)
+	}
+}
+
+// IncNATSFailover 增加发布切换集群计数
+func (m *Metrics) IncNATSFailover(from, to string) {
+	m.natsFailovers.WithLabelValues(from, to).Inc()
+}
+
+// ObserveNATSPublishBatch 记录一批信号的数量和发布确认耗时
+func (m *Metrics) ObserveNATSPublishBatch(ack string, size int, d time.Duration) {
+	m.natsPublishBatchSize.Observe(float64(size))
+	m.natsPublishBatchSecs.WithLabelValues(ack).Observe(d.Seconds())
+}
+
 // IncSignalsPublished 增加发布的信号计数
 func (m *Metrics) IncSignalsPublished(side, symbol string) {
 	m.signalsPublished.WithLabelValues(side, symbol).Inc()
 }
 
-// IncSignalErrors 增加信号错误计数
-func (m *Metrics) IncSignalErrors(errType string) {
-	m.signalErrors.WithLabelValues(errType).Inc()
+// IncSignalErrors 增加信号发布失败计数，traceID 作为 exemplar 关联到具体信号
+func (m *Metrics) IncSignalErrors(code, traceID string) {
+	counter := m.signalErrors.WithLabelValues(code)
+	if adder, ok := counter.(prometheus.ExemplarAdder); ok && traceID != "" {
+		adder.AddWithExemplar(1, prometheus.Labels{"trace_id": traceID})
+		return
+	}
+	counter.Inc()
+}
+
+// SetSignalErrorStreak 设置信号连续发布失败次数
+func (m *Metrics) SetSignalErrorStreak(streak int64) {
+	m.signalErrorStreak.Set(float64(streak))
+}
+
+// SetSignalStreamClients 设置 SSE 连接数
+func (m *Metrics) SetSignalStreamClients(count int) {
+	m.signalStreamClients.Set(float64(count))
+}
+
+// IncSignalStreamDropped 增加 SSE 丢弃信号计数
+func (m *Metrics) IncSignalStreamDropped() {
+	m.signalStreamDropped.Inc()
+}
+
+// IncSignalHookResult 增加信号钩子结果计数
+func (m *Metrics) IncSignalHookResult(hook, result string) {
+	m.signalHookResults.WithLabelValues(hook, result).Inc()
+}
+
+// IncSignalGroupPublished 增加分组主题发布计数
+func (m *Metrics) IncSignalGroupPublished(group, result string) {
+	m.signalGroupPublished.WithLabelValues(group, result).Inc()
 }
 
 // IncTradeDeduped 增加去重交易计数
@@ -530,11 +1417,21 @@ func (m *Metrics) IncOrderFlush(trigger string) {
 	m.orderFlushTotal.WithLabelValues(trigger).Inc()
 }
 
+// IncOrderEvicted 增加聚合超过上限被提前发送的订单计数
+func (m *Metrics) IncOrderEvicted() {
+	m.orderEvictedTotal.Inc()
+}
+
 // ObserveFillsPerOrder 观察 fill 数量
 func (m *Metrics) ObserveFillsPerOrder(count int) {
 	m.orderFillsPerOrder.Observe(float64(count))
 }
 
+// ObserveOrderFlushLatency 观察订单从首笔成交到发送的耗时（秒）
+func (m *Metrics) ObserveOrderFlushLatency(trigger string, seconds float64) {
+	m.orderFlushLatency.WithLabelValues(trigger).Observe(seconds)
+}
+
 // IncOrderUpdates 增加订单更新计数
 func (m *Metrics) IncOrderUpdates(count float64) {
 	m.orderUpdatesReceived.Add(count)
@@ -565,6 +1462,12 @@ func (m *Metrics) IncMessageQueueFull() {
 	m.messageQueueFullTotal.Inc()
 }
 
+// SetShutdownDrain 记录关闭时队列的排空条数与剩余条数
+func (m *Metrics) SetShutdownDrain(component string, drained, remaining int) {
+	m.shutdownDrained.WithLabelValues(component).Set(float64(drained))
+	m.shutdownRemaining.WithLabelValues(component).Set(float64(remaining))
+}
+
 // ObserveBatchWriteSize 观察批量写入大小 (T043)
 func (m *Metrics) ObserveBatchWriteSize(size int) {
 	m.batchWriteSize.Observe(float64(size))
@@ -580,20 +1483,364 @@ func (m *Metrics) IncBatchDedupCacheHit(table string) {
 	m.batchDedupCacheHit.WithLabelValues(table).Inc()
 }
 
-var globalMetrics *Metrics
-var metricsMu sync.Once
+// AddBatchWriteQuarantined 增加批量写入隔离行数
+func (m *Metrics) AddBatchWriteQuarantined(table string, count int) {
+	m.batchWriteQuarantined.WithLabelValues(table).Add(float64(count))
+}
+
+// SetBatchWriteBuffered 设置批量写入器缓冲条数
+func (m *Metrics) SetBatchWriteBuffered(count int) {
+	m.batchWriteBuffered.Set(float64(count))
+}
+
+// IncBatchWriteDropped 增加批量写入缓冲溢出丢弃计数
+func (m *Metrics) IncBatchWriteDropped(table, reason string) {
+	m.batchWriteDropped.WithLabelValues(table, reason).Inc()
+}
+
+// SetBatchWriteTuning 设置批量写入器当前单批条数和刷新间隔
+func (m *Metrics) SetBatchWriteTuning(batchSize int, interval time.Duration) {
+	m.batchWriteTargetSize.Set(float64(batchSize))
+	m.batchWriteInterval.Set(interval.Seconds())
+}
+
+// SetDBBreakerOpen 设置数据库熔断器状态
+func (m *Metrics) SetDBBreakerOpen(open bool) {
+	if open {
+		m.dbBreakerOpen.Set(1)
+	} else {
+		m.dbBreakerOpen.Set(0)
+	}
+}
 
-// GetMetrics 获取全局指标收集器
+// IncDBBreakerTrips 增加数据库熔断次数
+func (m *Metrics) IncDBBreakerTrips() {
+	m.dbBreakerTrips.Inc()
+}
+
+// SetUpstreamHealthy 设置上游 API 健康状态
+func (m *Metrics) SetUpstreamHealthy(healthy bool) {
+	if healthy {
+		m.upstreamHealthy.Set(1)
+	} else {
+		m.upstreamHealthy.Set(0)
+	}
+}
+
+// ObserveUpstreamLatency 观察上游 API 探测延迟（秒）
+func (m *Metrics) ObserveUpstreamLatency(seconds float64) {
+	m.upstreamLatencySeconds.Observe(seconds)
+}
+
+// SetAddressesDormant 设置休眠地址数量
+func (m *Metrics) SetAddressesDormant(count int) {
+	m.addressesDormant.Set(float64(count))
+}
+
+// IncReconcileCheck 增加仓位对账检查计数
+func (m *Metrics) IncReconcileCheck(result string) {
+	m.reconcileChecksTotal.WithLabelValues(result).Inc()
+}
+
+// IncReconcileDiscrepancy 增加仓位对账差异计数
+func (m *Metrics) IncReconcileDiscrepancy(source, field string) {
+	m.reconcileDiscrepanciesTotal.WithLabelValues(source, field).Inc()
+}
+
+// IncReconcileHealed 增加对账自动修复计数
+func (m *Metrics) IncReconcileHealed() {
+	m.reconcileHealedTotal.Inc()
+}
+
+// SetLeader 设置当前实例主备角色
+func (m *Metrics) SetLeader(isLeader bool) {
+	if isLeader {
+		m.leader.Set(1)
+	} else {
+		m.leader.Set(0)
+	}
+}
+
+// IncSignalSuppressed 增加备实例抑制信号计数
+func (m *Metrics) IncSignalSuppressed() {
+	m.signalsSuppressedTotal.Inc()
+}
+
+// IncSignalStaleBalance 增加余额过期信号计数
+func (m *Metrics) IncSignalStaleBalance(mode string) {
+	m.signalStaleBalanceTotal.WithLabelValues(mode).Inc()
+}
+
+// IncFillsFiltered 增加地址信号过滤拦截的成交计数
+func (m *Metrics) IncFillsFiltered(reason string) {
+	m.fillsFilteredTotal.WithLabelValues(reason).Inc()
+}
+
+// SetComponentHeartbeatAge 设置组件距上次心跳的时长
+func (m *Metrics) SetComponentHeartbeatAge(component string, age time.Duration) {
+	m.componentHeartbeatAge.WithLabelValues(component).Set(age.Seconds())
+}
+
+// IncWatchdogStall 增加组件心跳停滞计数
+func (m *Metrics) IncWatchdogStall(component string) {
+	m.watchdogStallsTotal.WithLabelValues(component).Inc()
+}
+
+// IncWatchdogRestart 增加看门狗重启组件计数
+func (m *Metrics) IncWatchdogRestart(component string) {
+	m.watchdogRestartsTotal.WithLabelValues(component).Inc()
+}
+
+// IncSignalSeverity 增加信号级别计数
+func (m *Metrics) IncSignalSeverity(severity string) {
+	m.signalSeverityTotal.WithLabelValues(severity).Inc()
+}
+
+// IncSignalCriticalPublished 增加 critical 信号告警主题发布计数
+func (m *Metrics) IncSignalCriticalPublished(result string) {
+	m.signalCriticalPublishedTotal.WithLabelValues(result).Inc()
+}
+
+// IncWebhookDelivery 增加 webhook 投递计数
+func (m *Metrics) IncWebhookDelivery(endpoint, result string) {
+	m.webhookDeliveriesTotal.WithLabelValues(endpoint, result).Inc()
+}
+
+// SetWebhookBreakerOpen 设置 webhook 端点熔断状态
+func (m *Metrics) SetWebhookBreakerOpen(endpoint string, open bool) {
+	if open {
+		m.webhookBreakerOpen.WithLabelValues(endpoint).Set(1)
+	} else {
+		m.webhookBreakerOpen.WithLabelValues(endpoint).Set(0)
+	}
+}
+
+// SetRateLimit 设置监控账户 REST 请求额度
+func (m *Metrics) SetRateLimit(used, capacity int64, ratio float64) {
+	m.rateLimitUsed.Set(float64(used))
+	m.rateLimitCap.Set(float64(capacity))
+	m.rateLimitRatio.Set(ratio)
+}
+
+// AddWSReceivedBytes 增加 WebSocket 接收字节数
+func (m *Metrics) AddWSReceivedBytes(wire, payload int) {
+	if wire > 0 {
+		m.wsReceivedBytesTotal.WithLabelValues("wire").Add(float64(wire))
+	}
+	if payload > 0 {
+		m.wsReceivedBytesTotal.WithLabelValues("payload").Add(float64(payload))
+	}
+}
+
+// IncWSSchemaDrift 增加 WebSocket 消息字段差异计数
+func (m *Metrics) IncWSSchemaDrift(channel, kind, field string) {
+	m.wsSchemaDriftTotal.WithLabelValues(channel, kind, field).Inc()
+}
+
+// ObserveMessageLag 观察地址消息从交易所时间戳到本地接收的延迟
+// 延迟为负（本地时钟落后于交易所）时计入时钟偏差计数并按 0 记录
+func (m *Metrics) ObserveMessageLag(channel, address string, lag time.Duration) {
+	if lag < 0 {
+		m.wsClockSkewTotal.WithLabelValues(channel).Inc()
+		lag = 0
+	}
+	m.wsMessageLagSeconds.WithLabelValues(channel, address).Observe(lag.Seconds())
+}
+
+// DeleteMessageLag 删除地址的延迟序列（取消订阅时调用，避免序列无限增长）
+func (m *Metrics) DeleteMessageLag(channel, address string) {
+	m.wsMessageLagSeconds.DeleteLabelValues(channel, address)
+}
+
+// SetCaptureActive 设置 WS 录制状态
+func (m *Metrics) SetCaptureActive(active bool) {
+	if active {
+		m.captureActive.Set(1)
+	} else {
+		m.captureActive.Set(0)
+	}
+}
+
+// IncCaptureRecord 增加录制消息计数
+func (m *Metrics) IncCaptureRecord() {
+	m.captureRecordsTotal.Inc()
+}
+
+// IncCaptureDropped 增加录制丢弃消息计数
+func (m *Metrics) IncCaptureDropped() {
+	m.captureDroppedTotal.Inc()
+}
+
+// IncCaptureFile 增加录制文件存储计数
+func (m *Metrics) IncCaptureFile(result string) {
+	m.captureFilesTotal.WithLabelValues(result).Inc()
+}
+
+// SetSelfTestSuccess 记录自检心跳成功时间和耗时
+func (m *Metrics) SetSelfTestSuccess(at time.Time, latency time.Duration) {
+	m.selfTestLastSuccess.Set(float64(at.Unix()))
+	m.selfTestLatency.Set(latency.Seconds())
+}
+
+// IncSelfTestFailure 增加自检心跳失败计数
+func (m *Metrics) IncSelfTestFailure(stage string) {
+	m.selfTestFailuresTotal.WithLabelValues(stage).Inc()
+}
+
+// SetOpenOrdersMirrored 设置挂单镜像中的挂单总数
+func (m *Metrics) SetOpenOrdersMirrored(count int) {
+	m.openOrdersMirrored.Set(float64(count))
+}
+
+// IncOpenOrderFlushError 增加挂单镜像写库失败计数
+func (m *Metrics) IncOpenOrderFlushError() {
+	m.openOrderFlushErrors.Inc()
+}
+
+// AddSubscribeWarmupPending 调整等待限速放行的订阅请求数
+func (m *Metrics) AddSubscribeWarmupPending(delta int) {
+	m.subscribeWarmupPending.Add(float64(delta))
+}
+
+// IncSubscribeWarmupCompleted 增加经限速放行的订阅请求计数
+func (m *Metrics) IncSubscribeWarmupCompleted() {
+	m.subscribeWarmupCompleted.Inc()
+}
+
+// SetManagerPaused 设置管理器暂停状态
+func (m *Metrics) SetManagerPaused(manager string, paused bool) {
+	if paused {
+		m.managerPaused.WithLabelValues(manager).Set(1)
+	} else {
+		m.managerPaused.WithLabelValues(manager).Set(0)
+	}
+}
+
+// IncPausedMessagesDropped 增加暂停期间丢弃的消息计数
+func (m *Metrics) IncPausedMessagesDropped(manager string) {
+	m.pausedMessagesDropped.WithLabelValues(manager).Inc()
+}
+
+// IncFillsReplaySkipped 增加早于成交高水位而跳过的成交计数
+func (m *Metrics) IncFillsReplaySkipped() {
+	m.fillsReplaySkipped.Inc()
+}
+
+// SetOidOwnersSize 设置 oid 到地址映射的条目数
+func (m *Metrics) SetOidOwnersSize(size int) {
+	m.oidOwnersSize.Set(float64(size))
+}
+
+// AddOidOwnersEvicted 增加 oid 到地址映射的淘汰计数
+func (m *Metrics) AddOidOwnersEvicted(reason string, count int) {
+	m.oidOwnersEvicted.WithLabelValues(reason).Add(float64(count))
+}
+
+// SetDedupCacheSize 设置订单去重缓存的条目数和估算内存
+func (m *Metrics) SetDedupCacheSize(entries, bytes int) {
+	m.dedupCacheEntries.Set(float64(entries))
+	m.dedupCacheMemoryBytes.Set(float64(bytes))
+}
+
+// AddDedupCacheEvicted 增加订单去重缓存的淘汰计数
+func (m *Metrics) AddDedupCacheEvicted(reason string, count int) {
+	m.dedupCacheEvicted.WithLabelValues(reason).Add(float64(count))
+}
+
+// SetSignalOutboxPending 设置发件箱中待发布的信号数
+func (m *Metrics) SetSignalOutboxPending(count int64) {
+	m.signalOutboxPending.Set(float64(count))
+}
+
+// IncSignalOutboxEnqueueFailures 增加发件箱写入失败计数
+func (m *Metrics) IncSignalOutboxEnqueueFailures() {
+	m.signalOutboxEnqueueFailures.Inc()
+}
+
+// IncSignalOutboxDeadLetters 增加发件箱死信计数
+func (m *Metrics) IncSignalOutboxDeadLetters(reason string) {
+	m.signalOutboxDeadLetters.WithLabelValues(reason).Inc()
+}
+
+// RecordFillReconcile 记录一次成交对账结果（kind -> 差异数）
+func (m *Metrics) RecordFillReconcile(checked int, discrepancies map[string]int) {
+	m.fillReconcileChecked.Add(float64(checked))
+	for kind, count := range discrepancies {
+		m.fillReconcileDiscrepancies.WithLabelValues(kind).Add(float64(count))
+	}
+	m.fillReconcileLastRun.SetToCurrentTime()
+}
+
+// IncBalanceTransfers 增加余额变动计数
+func (m *Metrics) IncBalanceTransfers(typ, direction string) {
+	m.balanceTransfers.WithLabelValues(typ, direction).Inc()
+}
+
+// Registerer 返回指标注册的注册表
+func (m *Metrics) Registerer() prometheus.Registerer {
+	return m.registerer
+}
+
+// Gatherer 返回 /metrics 和 Pushgateway 采集指标的注册表
+func (m *Metrics) Gatherer() prometheus.Gatherer {
+	return m.gatherer
+}
+
+// mustRegister 注册指标字段（字段指针），已注册的同名指标替换为已有实例
+func mustRegister(reg prometheus.Registerer, fields ...any) {
+	for _, field := range fields {
+		switch c := field.(type) {
+		case *prometheus.Counter:
+			registerCollector(reg, c)
+		case *prometheus.Gauge:
+			registerCollector(reg, c)
+		case *prometheus.Histogram:
+			registerCollector(reg, c)
+		case **prometheus.CounterVec:
+			registerCollector(reg, c)
+		case **prometheus.GaugeVec:
+			registerCollector(reg, c)
+		case **prometheus.HistogramVec:
+			registerCollector(reg, c)
+		default:
+			panic(fmt.Sprintf("unsupported metric field %T", field))
+		}
+	}
+}
+
+// registerCollector 注册指标，注册表中已有相同描述的指标时复用已有实例，其他错误 panic
+func registerCollector[T prometheus.Collector](reg prometheus.Registerer, c *T) {
+	err := reg.Register(*c)
+	if err == nil {
+		return
+	}
+	var already prometheus.AlreadyRegisteredError
+	if errors.As(err, &already) {
+		if existing, ok := already.ExistingCollector.(T); ok {
+			*c = existing
+			return
+		}
+	}
+	panic(fmt.Errorf("register metric: %w", err))
+}
+
+var (
+	globalMetrics *Metrics
+	metricsOnce   sync.Once
+)
+
+// GetMetrics 获取全局指标收集器，未初始化时注册到默认注册表
 func GetMetrics() *Metrics {
-	metricsMu.Do(func() {
-		globalMetrics = NewMetrics("hl_monitor")
-	})
-	return globalMetrics
+	return InitMetrics(nil)
 }
 
-// InitMetrics 初始化指标收集器（供main使用）
-func InitMetrics() {
-	GetMetrics()
+// InitMetrics 初始化全局指标收集器（供 main 或嵌入方使用），reg 为空时使用默认注册表
+// 只有首次调用生效，需在其他组件使用指标前调用
+func InitMetrics(reg prometheus.Registerer) *Metrics {
+	metricsOnce.Do(func() {
+		globalMetrics = NewMetrics("hl_monitor", reg)
+	})
+	return globalMetrics
 }
 
 // PoolWrapper WebSocket连接池包装器
@@ -0,0 +1,171 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/internal/ws"
+)
+
+type mockPool struct {
+	conns []ws.ConnectionStats
+}
+
+func (m *mockPool) IsConnected() bool                     { return true }
+func (m *mockPool) IsReconnecting() bool                  { return false }
+func (m *mockPool) GetStats() map[string]any              { return nil }
+func (m *mockPool) ConnectionStats() []ws.ConnectionStats { return m.conns }
+
+func TestStatusHandler_Connections(t *testing.T) {
+	pool := &mockPool{conns: []ws.ConnectionStats{
+		{Index: 0, Connected: true, SubscriptionCount: 2, KeysSample: []string{"userFills:0xa", "webData2:0xa"}, Uptime: "1m0s"},
+		{Index: 1, Connected: false, LastError: "read error: EOF"},
+	}}
+	h := NewHealthServer(":0", nil, pool, nil)
+
+	rec := httptest.NewRecorder()
+	h.statusHandler(rec, httptest.NewRequest(http.MethodGet, "/status", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var status HealthStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	require.Len(t, status.WebSocket.Connections, 2)
+	assert.Equal(t, 2, status.WebSocket.Connections[0].SubscriptionCount)
+	assert.Equal(t, "read error: EOF", status.WebSocket.Connections[1].LastError)
+	assert.False(t, status.WebSocket.Connections[1].Connected)
+}
+
+type readyPool struct{ connected bool }
+
+func (p *readyPool) IsConnected() bool        { return p.connected }
+func (p *readyPool) IsReconnecting() bool     { return false }
+func (p *readyPool) GetStats() map[string]any { return nil }
+
+type readyPublisher struct{ connected bool }
+
+func (p *readyPublisher) IsConnected() bool { return p.connected }
+
+type readyBacklog int
+
+func (b readyBacklog) Backlog() int    { return int(b) }
+func (b readyBacklog) QueueDepth() int { return int(b) }
+
+func TestReadyHandler_Checks(t *testing.T) {
+	pool := &readyPool{connected: true}
+	publisher := &readyPublisher{}
+	h := NewHealthServer(":0", nil, pool, publisher)
+
+	ready := func() (int, string) {
+		rec := httptest.NewRecorder()
+		h.readyHandler(rec, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+		return rec.Code, strings.TrimSpace(rec.Body.String())
+	}
+
+	// 未开启附加检查时只看 WebSocket
+	code, _ := ready()
+	assert.Equal(t, http.StatusOK, code)
+
+	dbErr := errors.New("connection refused")
+	h.SetReadinessChecks(ReadinessChecks{MySQL: true, NATS: true, MaxWriteBacklog: 100, MaxQueueDepth: 10},
+		PingFunc(func(context.Context) error { return dbErr }), readyBacklog(101), readyBacklog(10))
+	code, body := ready()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "not ready: mysql,nats,batch_writer", body)
+
+	dbErr = nil
+	publisher.connected = true
+	pool.connected = false
+	h.SetReadinessChecks(ReadinessChecks{MySQL: true, NATS: true, MaxWriteBacklog: 100, MaxQueueDepth: 10},
+		PingFunc(func(context.Context) error { return dbErr }), readyBacklog(100), readyBacklog(11))
+	code, body = ready()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "not ready: websocket,message_queue", body)
+
+	pool.connected = true
+	h.SetReadinessChecks(ReadinessChecks{MySQL: true, NATS: true, MaxWriteBacklog: 100},
+		PingFunc(func(context.Context) error { return nil }), readyBacklog(100), readyBacklog(11))
+	code, body = ready()
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok", body)
+
+	require.NoError(t, h.Stop(context.Background()))
+	_, body = ready()
+	assert.Equal(t, "not ready: shutting_down", body)
+}
+
+func TestNewMetrics_CustomRegistry(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := NewMetrics("test", reg)
+	assert.Same(t, reg, m.Gatherer())
+
+	// 重复注册复用已有指标
+	again := NewMetrics("test", reg)
+	m.IncOrderFlush("size")
+	again.IncOrderFlush("size")
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	var flushes float64
+	for _, family := range families {
+		if family.GetName() == "test_order_flush_total" {
+			flushes = family.GetMetric()[0].GetCounter().GetValue()
+		}
+	}
+	assert.Equal(t, 2.0, flushes)
+
+	// 未指定注册表时使用默认注册表
+	assert.Equal(t, prometheus.DefaultGatherer, NewMetrics("hl_monitor", nil).Gatherer())
+}
+
+func TestMetrics_ObserveMessageLag(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := NewMetrics("lag", reg)
+
+	m.ObserveMessageLag("userFills", "0xabc", 300*time.Millisecond)
+	m.ObserveMessageLag("userFills", "0xabc", -2*time.Second)
+	m.ObserveMessageLag("webData2", "0xabc", time.Second)
+
+	gather := func() map[string][]*dto.Metric {
+		families, err := reg.Gather()
+		require.NoError(t, err)
+		out := make(map[string][]*dto.Metric)
+		for _, family := range families {
+			out[family.GetName()] = family.GetMetric()
+		}
+		return out
+	}
+
+	metrics := gather()
+	require.Len(t, metrics["lag_ws_message_lag_seconds"], 2)
+	for _, metric := range metrics["lag_ws_message_lag_seconds"] {
+		labels := map[string]string{}
+		for _, label := range metric.GetLabel() {
+			labels[label.GetName()] = label.GetValue()
+		}
+		assert.Equal(t, "0xabc", labels["address"])
+		switch labels["channel"] {
+		case "userFills":
+			// 负延迟按 0 记录
+			assert.Equal(t, uint64(2), metric.GetHistogram().GetSampleCount())
+			assert.InDelta(t, 0.3, metric.GetHistogram().GetSampleSum(), 1e-9)
+		case "webData2":
+			assert.Equal(t, uint64(1), metric.GetHistogram().GetSampleCount())
+		}
+	}
+	require.Len(t, metrics["lag_ws_clock_skew_total"], 1)
+	assert.Equal(t, 1.0, metrics["lag_ws_clock_skew_total"][0].GetCounter().GetValue())
+
+	m.DeleteMessageLag("userFills", "0xabc")
+	assert.Len(t, gather()["lag_ws_message_lag_seconds"], 1)
+}
@@ -1,5 +1,7 @@
 package monitor
 
+import "time"
+
 // 便捷函数供外部调用，无需访问 Metrics 实例
 
 // SetOrderAggregationActive 设置聚合中的订单数量
@@ -12,11 +14,21 @@ func IncOrderFlush(trigger string) {
 	GetMetrics().IncOrderFlush(trigger)
 }
 
+// IncOrderEvicted 增加聚合超过上限被提前发送的订单计数
+func IncOrderEvicted() {
+	GetMetrics().IncOrderEvicted()
+}
+
 // ObserveFillsPerOrder 观察 fill 数量
 func ObserveFillsPerOrder(count int) {
 	GetMetrics().ObserveFillsPerOrder(count)
 }
 
+// ObserveOrderFlushLatency 观察订单从首笔成交到发送的耗时（秒）
+func ObserveOrderFlushLatency(trigger string, seconds float64) {
+	GetMetrics().ObserveOrderFlushLatency(trigger, seconds)
+}
+
 // SetPoolManagerConnectionCount 设置连接池管理器的连接数
 func SetPoolManagerConnectionCount(count int) {
 	GetMetrics().SetPoolManagerConnectionCount(count)
@@ -42,6 +54,11 @@ func IncMessageQueueFull() {
 	GetMetrics().IncMessageQueueFull()
 }
 
+// SetShutdownDrain 记录关闭时队列的排空条数与剩余条数
+func SetShutdownDrain(component string, drained, remaining int) {
+	GetMetrics().SetShutdownDrain(component, drained, remaining)
+}
+
 // ObserveBatchWriteSize 观察批量写入大小 (T043)
 func ObserveBatchWriteSize(size int) {
 	GetMetrics().ObserveBatchWriteSize(size)
@@ -56,3 +73,288 @@ func ObserveBatchWriteDuration(duration float64) {
 func IncBatchDedupCacheHit(table string) {
 	GetMetrics().IncBatchDedupCacheHit(table)
 }
+
+// AddBatchWriteQuarantined 增加批量写入隔离行数
+func AddBatchWriteQuarantined(table string, count int) {
+	GetMetrics().AddBatchWriteQuarantined(table, count)
+}
+
+// SetBatchWriteBuffered 设置批量写入器缓冲条数
+func SetBatchWriteBuffered(count int) {
+	GetMetrics().SetBatchWriteBuffered(count)
+}
+
+// SetBatchWriteTuning 设置批量写入器当前单批条数和刷新间隔
+func SetBatchWriteTuning(batchSize int, interval time.Duration) {
+	GetMetrics().SetBatchWriteTuning(batchSize, interval)
+}
+
+// IncBatchWriteDropped 增加批量写入缓冲溢出丢弃计数
+func IncBatchWriteDropped(table, reason string) {
+	GetMetrics().IncBatchWriteDropped(table, reason)
+}
+
+// SetDBBreakerOpen 设置数据库熔断器状态
+func SetDBBreakerOpen(open bool) {
+	GetMetrics().SetDBBreakerOpen(open)
+}
+
+// IncDBBreakerTrips 增加数据库熔断次数
+func IncDBBreakerTrips() {
+	GetMetrics().IncDBBreakerTrips()
+}
+
+// SetUpstreamHealthy 设置上游 API 健康状态
+func SetUpstreamHealthy(healthy bool) {
+	GetMetrics().SetUpstreamHealthy(healthy)
+}
+
+// ObserveUpstreamLatency 观察上游 API 探测延迟（秒）
+func ObserveUpstreamLatency(seconds float64) {
+	GetMetrics().ObserveUpstreamLatency(seconds)
+}
+
+// SetAddressesDormant 设置休眠地址数量
+func SetAddressesDormant(count int) {
+	GetMetrics().SetAddressesDormant(count)
+}
+
+// IncReconcileCheck 增加仓位对账检查计数
+func IncReconcileCheck(result string) {
+	GetMetrics().IncReconcileCheck(result)
+}
+
+// IncReconcileDiscrepancy 增加仓位对账差异计数
+func IncReconcileDiscrepancy(source, field string) {
+	GetMetrics().IncReconcileDiscrepancy(source, field)
+}
+
+// IncReconcileHealed 增加对账自动修复计数
+func IncReconcileHealed() {
+	GetMetrics().IncReconcileHealed()
+}
+
+// SetLeader 设置当前实例主备角色
+func SetLeader(isLeader bool) {
+	GetMetrics().SetLeader(isLeader)
+}
+
+// IncSignalErrors 增加信号发布失败计数（code 为固定错误码，traceID 作为 exemplar）
+func IncSignalErrors(code, traceID string) {
+	GetMetrics().IncSignalErrors(code, traceID)
+}
+
+// IncSignalHookResult 增加信号钩子结果计数
+func IncSignalHookResult(hook, result string) {
+	GetMetrics().IncSignalHookResult(hook, result)
+}
+
+// IncSignalGroupPublished 增加分组主题发布计数
+func IncSignalGroupPublished(group, result string) {
+	GetMetrics().IncSignalGroupPublished(group, result)
+}
+
+// SetSignalErrorStreak 设置信号连续发布失败次数
+func SetSignalErrorStreak(streak int64) {
+	GetMetrics().SetSignalErrorStreak(streak)
+}
+
+// IncSignalSuppressed 增加备实例抑制信号计数
+func IncSignalSuppressed() {
+	GetMetrics().IncSignalSuppressed()
+}
+
+// IncSignalStaleBalance 增加余额过期信号计数
+func IncSignalStaleBalance(mode string) {
+	GetMetrics().IncSignalStaleBalance(mode)
+}
+
+// IncFillsFiltered 增加地址信号过滤拦截的成交计数
+func IncFillsFiltered(reason string) {
+	GetMetrics().IncFillsFiltered(reason)
+}
+
+// SetComponentHeartbeatAge 设置组件距上次心跳的时长
+func SetComponentHeartbeatAge(component string, age time.Duration) {
+	GetMetrics().SetComponentHeartbeatAge(component, age)
+}
+
+// IncWatchdogStall 增加组件心跳停滞计数
+func IncWatchdogStall(component string) {
+	GetMetrics().IncWatchdogStall(component)
+}
+
+// IncWatchdogRestart 增加看门狗重启组件计数
+func IncWatchdogRestart(component string) {
+	GetMetrics().IncWatchdogRestart(component)
+}
+
+// IncSignalSeverity 增加信号级别计数
+func IncSignalSeverity(severity string) {
+	GetMetrics().IncSignalSeverity(severity)
+}
+
+// IncSignalCriticalPublished 增加 critical 信号告警主题发布计数
+func IncSignalCriticalPublished(result string) {
+	GetMetrics().IncSignalCriticalPublished(result)
+}
+
+// IncWebhookDelivery 增加 webhook 投递计数
+func IncWebhookDelivery(endpoint, result string) {
+	GetMetrics().IncWebhookDelivery(endpoint, result)
+}
+
+// SetWebhookBreakerOpen 设置 webhook 端点熔断状态
+func SetWebhookBreakerOpen(endpoint string, open bool) {
+	GetMetrics().SetWebhookBreakerOpen(endpoint, open)
+}
+
+// SetRateLimit 设置监控账户 REST 请求额度
+func SetRateLimit(used, capacity int64, ratio float64) {
+	GetMetrics().SetRateLimit(used, capacity, ratio)
+}
+
+// AddWSReceivedBytes 增加 WebSocket 接收字节数
+func AddWSReceivedBytes(wire, payload int) {
+	GetMetrics().AddWSReceivedBytes(wire, payload)
+}
+
+// IncWSSchemaDrift 增加 WebSocket 消息字段差异计数
+func IncWSSchemaDrift(channel, kind, field string) {
+	GetMetrics().IncWSSchemaDrift(channel, kind, field)
+}
+
+// ObserveMessageLag 观察地址消息从交易所时间戳到本地接收的延迟
+func ObserveMessageLag(channel, address string, lag time.Duration) {
+	GetMetrics().ObserveMessageLag(channel, address, lag)
+}
+
+// DeleteMessageLag 删除地址的延迟序列
+func DeleteMessageLag(channel, address string) {
+	GetMetrics().DeleteMessageLag(channel, address)
+}
+
+// SetCaptureActive 设置 WS 录制状态
+func SetCaptureActive(active bool) {
+	GetMetrics().SetCaptureActive(active)
+}
+
+// IncCaptureRecord 增加录制消息计数
+func IncCaptureRecord() {
+	GetMetrics().IncCaptureRecord()
+}
+
+// IncCaptureDropped 增加录制丢弃消息计数
+func IncCaptureDropped() {
+	GetMetrics().IncCaptureDropped()
+}
+
+// IncCaptureFile 增加录制文件存储计数
+func IncCaptureFile(result string) {
+	GetMetrics().IncCaptureFile(result)
+}
+
+// SetSelfTestSuccess 记录自检心跳成功时间和耗时
+func SetSelfTestSuccess(at time.Time, latency time.Duration) {
+	GetMetrics().SetSelfTestSuccess(at, latency)
+}
+
+// IncSelfTestFailure 增加自检心跳失败计数
+func IncSelfTestFailure(stage string) {
+	GetMetrics().IncSelfTestFailure(stage)
+}
+
+// SetOpenOrdersMirrored 设置挂单镜像中的挂单总数
+func SetOpenOrdersMirrored(count int) {
+	GetMetrics().SetOpenOrdersMirrored(count)
+}
+
+// IncOpenOrderFlushError 增加挂单镜像写库失败计数
+func IncOpenOrderFlushError() {
+	GetMetrics().IncOpenOrderFlushError()
+}
+
+// AddSubscribeWarmupPending 调整等待限速放行的订阅请求数
+func AddSubscribeWarmupPending(delta int) {
+	GetMetrics().AddSubscribeWarmupPending(delta)
+}
+
+// IncSubscribeWarmupCompleted 增加经限速放行的订阅请求计数
+func IncSubscribeWarmupCompleted() {
+	GetMetrics().IncSubscribeWarmupCompleted()
+}
+
+// SetManagerPaused 设置管理器暂停状态
+func SetManagerPaused(manager string, paused bool) {
+	GetMetrics().SetManagerPaused(manager, paused)
+}
+
+// IncPausedMessagesDropped 增加暂停期间丢弃的消息计数
+func IncPausedMessagesDropped(manager string) {
+	GetMetrics().IncPausedMessagesDropped(manager)
+}
+
+// IncFillsReplaySkipped 增加早于成交高水位而跳过的成交计数
+func IncFillsReplaySkipped() {
+	GetMetrics().IncFillsReplaySkipped()
+}
+
+// SetOidOwnersSize 设置 oid 到地址映射的条目数
+func SetOidOwnersSize(size int) {
+	GetMetrics().SetOidOwnersSize(size)
+}
+
+// AddOidOwnersEvicted 增加 oid 到地址映射的淘汰计数
+func AddOidOwnersEvicted(reason string, count int) {
+	GetMetrics().AddOidOwnersEvicted(reason, count)
+}
+
+// SetDedupCacheSize 设置订单去重缓存的条目数和估算内存
+func SetDedupCacheSize(entries, bytes int) {
+	GetMetrics().SetDedupCacheSize(entries, bytes)
+}
+
+// AddDedupCacheEvicted 增加订单去重缓存的淘汰计数
+func AddDedupCacheEvicted(reason string, count int) {
+	GetMetrics().AddDedupCacheEvicted(reason, count)
+}
+
+// SetSignalOutboxPending 设置发件箱中待发布的信号数
+func SetSignalOutboxPending(count int64) {
+	GetMetrics().SetSignalOutboxPending(count)
+}
+
+// IncSignalOutboxEnqueueFailures 增加发件箱写入失败计数
+func IncSignalOutboxEnqueueFailures() {
+	GetMetrics().IncSignalOutboxEnqueueFailures()
+}
+
+// IncSignalOutboxDeadLetters 增加发件箱死信计数
+func IncSignalOutboxDeadLetters(reason string) {
+	GetMetrics().IncSignalOutboxDeadLetters(reason)
+}
+
+// RecordFillReconcile 记录一次成交对账结果
+func RecordFillReconcile(checked int, discrepancies map[string]int) {
+	GetMetrics().RecordFillReconcile(checked, discrepancies)
+}
+
+// IncBalanceTransfers 增加余额变动计数
+func IncBalanceTransfers(typ, direction string) {
+	GetMetrics().IncBalanceTransfers(typ, direction)
+}
+
+// SetNATSEndpointConnected 设置单个 NATS 集群连接状态
+func SetNATSEndpointConnected(endpoint string, connected bool) {
+	GetMetrics().SetNATSEndpointConnected(endpoint, connected)
+}
+
+// IncNATSFailover 增加发布切换集群计数
+func IncNATSFailover(from, to string) {
+	GetMetrics().IncNATSFailover(from, to)
+}
+
+// ObserveNATSPublishBatch 记录一批信号的数量和发布确认耗时
+func ObserveNATSPublishBatch(ack string, size int, d time.Duration) {
+	GetMetrics().ObserveNATSPublishBatch(ack, size, d)
+}
@@ -0,0 +1,89 @@
+package monitor
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// pnlDateLayout 盈亏统计日期格式（UTC）
+const pnlDateLayout = "2006-01-02"
+
+// PnLStatsRef 地址盈亏统计引用接口（由 manager.PnLTracker 实现）
+type PnLStatsRef interface {
+	PnLSummary(from, to, address string) (PnLSummary, error)
+}
+
+// PnLSummary 日期范围内的盈亏汇总
+type PnLSummary struct {
+	From          string       `json:"from"`
+	To            string       `json:"to"`
+	RealizedPnl   float64      `json:"realized_pnl"`   // 范围内已实现盈亏之和
+	UnrealizedPnl float64      `json:"unrealized_pnl"` // 各地址范围内最后一天的未实现盈亏之和
+	ClosedOrders  int          `json:"closed_orders"`
+	Addresses     []AddressPnL `json:"addresses"`
+}
+
+// AddressPnL 单个地址的盈亏汇总
+type AddressPnL struct {
+	Address       string  `json:"address"`
+	Label         string  `json:"label,omitempty"`
+	RealizedPnl   float64 `json:"realized_pnl"`
+	UnrealizedPnl float64 `json:"unrealized_pnl"`
+	ClosedOrders  int     `json:"closed_orders"`
+}
+
+// SetPnLStats 设置盈亏统计（可选，用于 /stats/pnl）
+func (h *HealthServer) SetPnLStats(stats PnLStatsRef) {
+	h.mu.Lock()
+	h.pnl = stats
+	h.mu.Unlock()
+}
+
+// pnlStatsHandler 按日期范围（from/to，UTC YYYY-MM-DD，默认当天）和地址查询盈亏汇总
+func (h *HealthServer) pnlStatsHandler(w http.ResponseWriter, r *http.Request) {
+	h.mu.RLock()
+	stats := h.pnl
+	labeler := h.labeler
+	h.mu.RUnlock()
+
+	if stats == nil {
+		http.Error(w, "pnl stats not available", http.StatusNotFound)
+		return
+	}
+
+	q := r.URL.Query()
+	today := time.Now().UTC().Format(pnlDateLayout)
+	from, to := q.Get("from"), q.Get("to")
+	if from == "" {
+		from = today
+	}
+	if to == "" {
+		to = today
+	}
+	for _, d := range []string{from, to} {
+		if _, err := time.Parse(pnlDateLayout, d); err != nil {
+			http.Error(w, "invalid date, expected YYYY-MM-DD", http.StatusBadRequest)
+			return
+		}
+	}
+	if from > to {
+		http.Error(w, "from must not be after to", http.StatusBadRequest)
+		return
+	}
+
+	summary, err := stats.PnLSummary(from, to, strings.TrimSpace(q.Get("address")))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	if labeler != nil {
+		for i := range summary.Addresses {
+			summary.Addresses[i].Label = labeler.Label(summary.Addresses[i].Address)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(summary)
+}
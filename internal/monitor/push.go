@@ -0,0 +1,13 @@
+package monitor
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus/push"
+)
+
+// PushMetrics 将当前全部指标推送到 Pushgateway
+// 用于关闭时保留最终指标（健康检查服务已停止，Prometheus 无法再抓取）
+func PushMetrics(ctx context.Context, url, job string) error {
+	return push.New(url, job).Gatherer(GetMetrics().Gatherer()).PushContext(ctx)
+}
@@ -0,0 +1,109 @@
+package monitor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// RateLimitFetcher 请求额度查询接口（由 hyperliquid.Info 实现）
+type RateLimitFetcher interface {
+	UserRateLimit(ctx context.Context, address string) (*hl.UserRateLimit, error)
+}
+
+// RateLimitCollector 定时采集监控账户的 REST 请求额度并写入指标
+type RateLimitCollector struct {
+	fetcher  RateLimitFetcher
+	address  string
+	interval time.Duration
+
+	mu   sync.RWMutex
+	last *hl.UserRateLimit
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewRateLimitCollector 创建请求额度采集器
+func NewRateLimitCollector(fetcher RateLimitFetcher, address string, interval time.Duration) *RateLimitCollector {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &RateLimitCollector{
+		fetcher:  fetcher,
+		address:  address,
+		interval: interval,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start 启动采集循环
+func (c *RateLimitCollector) Start() {
+	c.wg.Add(1)
+	goplus.Go(func() {
+		defer c.wg.Done()
+
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		c.collect()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.collect()
+			case <-c.done:
+				return
+			}
+		}
+	})
+
+	logger.Info().Str("address", c.address).Dur("interval", c.interval).Msg("rate limit collector started")
+}
+
+// Stop 停止采集
+func (c *RateLimitCollector) Stop() {
+	close(c.done)
+	c.wg.Wait()
+}
+
+// Last 返回最近一次采集结果
+func (c *RateLimitCollector) Last() (hl.UserRateLimit, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.last == nil {
+		return hl.UserRateLimit{}, false
+	}
+	return *c.last, true
+}
+
+// collect 采集一次请求额度
+func (c *RateLimitCollector) collect() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	limit, err := c.fetcher.UserRateLimit(ctx, c.address)
+	if err != nil {
+		logger.Warn().Err(err).Str("address", c.address).Msg("fetch user rate limit failed")
+		return
+	}
+
+	c.mu.Lock()
+	c.last = limit
+	c.mu.Unlock()
+
+	ratio := limit.UsedRatio()
+	SetRateLimit(limit.NRequestsUsed, limit.NRequestsCap+limit.NRequestsSurplus, ratio)
+
+	if ratio >= 0.9 {
+		logger.Warn().Str("address", c.address).
+			Int64("used", limit.NRequestsUsed).
+			Int64("remaining", limit.Remaining()).
+			Msg("user rate limit nearly exhausted")
+	}
+}
@@ -0,0 +1,39 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRateLimitFetcher struct {
+	limit *hl.UserRateLimit
+	err   error
+}
+
+func (m *mockRateLimitFetcher) UserRateLimit(context.Context, string) (*hl.UserRateLimit, error) {
+	return m.limit, m.err
+}
+
+func TestRateLimitCollector_Collect(t *testing.T) {
+	fetcher := &mockRateLimitFetcher{err: errors.New("timeout")}
+	c := NewRateLimitCollector(fetcher, "0xabc", time.Minute)
+
+	c.collect()
+	_, ok := c.Last()
+	assert.False(t, ok)
+
+	fetcher.err = nil
+	fetcher.limit = &hl.UserRateLimit{NRequestsUsed: 90, NRequestsCap: 100}
+	c.collect()
+
+	last, ok := c.Last()
+	require.True(t, ok)
+	assert.Equal(t, int64(10), last.Remaining())
+	assert.Equal(t, 0.9, last.UsedRatio())
+}
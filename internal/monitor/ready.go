@@ -0,0 +1,118 @@
+package monitor
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// readyPingTimeout 就绪检查中数据库 Ping 的超时
+const readyPingTimeout = 2 * time.Second
+
+// 就绪检查项（/health/ready 返回的失败原因）
+const (
+	readyCheckHealthy     = "shutting_down"
+	readyCheckWebSocket   = "websocket"
+	readyCheckMySQL       = "mysql"
+	readyCheckNATS        = "nats"
+	readyCheckBatchWriter = "batch_writer"
+	readyCheckQueue       = "message_queue"
+)
+
+// ReadinessChecks 就绪检查的附加项，各项独立开关
+type ReadinessChecks struct {
+	MySQL           bool // 数据库 Ping 失败时未就绪
+	NATS            bool // NATS 断开时未就绪
+	MaxWriteBacklog int  // 批量写入积压超过该条数时未就绪，0 不检查
+	MaxQueueDepth   int  // 消息队列积压超过该条数时未就绪，0 不检查
+}
+
+// DatabaseRef 数据库连接检查接口
+type DatabaseRef interface {
+	Ping(ctx context.Context) error
+}
+
+// PingFunc 将 Ping 函数适配为 DatabaseRef
+type PingFunc func(ctx context.Context) error
+
+// Ping 调用 f(ctx)
+func (f PingFunc) Ping(ctx context.Context) error {
+	return f(ctx)
+}
+
+// BacklogRef 批量写入器引用接口
+type BacklogRef interface {
+	Backlog() int
+}
+
+// QueueDepthRef 消息队列引用接口
+type QueueDepthRef interface {
+	QueueDepth() int
+}
+
+// SetReadinessChecks 设置就绪检查的附加项（可选），默认只检查运行状态和 WebSocket 连接
+func (h *HealthServer) SetReadinessChecks(checks ReadinessChecks, db DatabaseRef, writer BacklogRef, queue QueueDepthRef) {
+	h.mu.Lock()
+	h.readiness = checks
+	h.database = db
+	h.writer = writer
+	h.queue = queue
+	h.mu.Unlock()
+}
+
+// readyHandler 就绪检查处理器，未就绪时返回 503 及失败的检查项
+func (h *HealthServer) readyHandler(w http.ResponseWriter, r *http.Request) {
+	if failed := h.readinessFailures(r.Context()); len(failed) > 0 {
+		http.Error(w, "not ready: "+strings.Join(failed, ","), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
+
+// readinessFailures 返回未通过的检查项，全部通过时返回空
+func (h *HealthServer) readinessFailures(ctx context.Context) []string {
+	h.mu.RLock()
+	healthy := h.healthy
+	checks := h.readiness
+	db := h.database
+	writer := h.writer
+	queue := h.queue
+	h.mu.RUnlock()
+
+	// 关闭中直接返回，不再检查依赖
+	if !healthy {
+		return []string{readyCheckHealthy}
+	}
+
+	var failed []string
+
+	// 检查WebSocket连接
+	if h.pool != nil && !h.pool.IsConnected() {
+		failed = append(failed, readyCheckWebSocket)
+	}
+
+	if checks.MySQL && db != nil {
+		pingCtx, cancel := context.WithTimeout(ctx, readyPingTimeout)
+		err := db.Ping(pingCtx)
+		cancel()
+		if err != nil {
+			failed = append(failed, readyCheckMySQL)
+		}
+	}
+
+	if checks.NATS && h.publisher != nil && !h.publisher.IsConnected() {
+		failed = append(failed, readyCheckNATS)
+	}
+
+	if checks.MaxWriteBacklog > 0 && writer != nil && writer.Backlog() > checks.MaxWriteBacklog {
+		failed = append(failed, readyCheckBatchWriter)
+	}
+
+	if checks.MaxQueueDepth > 0 && queue != nil && queue.QueueDepth() > checks.MaxQueueDepth {
+		failed = append(failed, readyCheckQueue)
+	}
+
+	return failed
+}
@@ -0,0 +1,119 @@
+package monitor
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+)
+
+// DefaultAuthExempt 默认免认证路径（存活/就绪探针）
+var DefaultAuthExempt = []string{"/health/live", "/health/ready"}
+
+// ServerSecurity 健康检查、指标及管理端点的传输安全与认证
+type ServerSecurity struct {
+	TLSCertFile  string   // 服务端证书，与 TLSKeyFile 同时配置时启用 HTTPS
+	TLSKeyFile   string   // 服务端私钥
+	ClientCAFile string   // 客户端 CA，配置后要求并校验客户端证书（mTLS）
+	AuthToken    string   // 全部端点的 Bearer 令牌，为空时不校验
+	AuthExempt   []string // 免令牌校验的路径，为 nil 时使用 DefaultAuthExempt
+}
+
+// TLSEnabled 是否启用 HTTPS
+func (s ServerSecurity) TLSEnabled() bool {
+	return s.TLSCertFile != "" && s.TLSKeyFile != ""
+}
+
+// Validate 校验配置组合
+func (s ServerSecurity) Validate() error {
+	if (s.TLSCertFile == "") != (s.TLSKeyFile == "") {
+		return fmt.Errorf("tls_cert_file and tls_key_file must be set together")
+	}
+	if s.ClientCAFile != "" && !s.TLSEnabled() {
+		return fmt.Errorf("client_ca_file requires tls_cert_file and tls_key_file")
+	}
+	return nil
+}
+
+// tlsConfig 加载证书构建 TLS 配置，未启用 TLS 时返回 nil
+func (s ServerSecurity) tlsConfig() (*tls.Config, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	if !s.TLSEnabled() {
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(s.TLSCertFile, s.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load tls key pair: %w", err)
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if s.ClientCAFile != "" {
+		pem, err := os.ReadFile(s.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read client ca: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in client ca %s", s.ClientCAFile)
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg, nil
+}
+
+// SetSecurity 设置 TLS 与认证（可选），需在 Start 之前调用
+func (h *HealthServer) SetSecurity(security ServerSecurity) {
+	h.mu.Lock()
+	h.security = security
+	h.mu.Unlock()
+}
+
+// authMiddleware 校验全局令牌：Authorization: Bearer <token> 或查询参数 token（EventSource 无法设置请求头）
+// 管理令牌同样放行，/admin 端点仍由 adminAuth 单独校验
+func (h *HealthServer) authMiddleware(next http.Handler) http.Handler {
+	h.mu.RLock()
+	token := h.security.AuthToken
+	adminToken := h.adminToken
+	exempt := h.security.AuthExempt
+	h.mu.RUnlock()
+
+	if token == "" {
+		return next
+	}
+	if exempt == nil {
+		exempt = DefaultAuthExempt
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if slices.Contains(exempt, r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		got := r.URL.Query().Get("token")
+		if got == "" {
+			got = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		}
+		if !tokenMatches(got, token) && !(adminToken != "" && tokenMatches(got, adminToken)) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// tokenMatches 常量时间比较令牌
+func tokenMatches(got, want string) bool {
+	return subtle.ConstantTimeCompare([]byte(got), []byte(want)) == 1
+}
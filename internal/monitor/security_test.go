@@ -0,0 +1,155 @@
+package monitor
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	h := NewHealthServer(":0", nil, nil, nil)
+	h.SetAdminToken("admin")
+	h.SetSecurity(ServerSecurity{AuthToken: "secret"})
+
+	handler := h.authMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	do := func(url, token string) int {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, do("/metrics", ""))
+	assert.Equal(t, http.StatusUnauthorized, do("/metrics", "wrong"))
+	assert.Equal(t, http.StatusOK, do("/metrics", "secret"))
+	assert.Equal(t, http.StatusOK, do("/metrics", "admin"))
+	assert.Equal(t, http.StatusOK, do("/stream/signals?token=secret", ""))
+
+	// 探针默认免认证
+	assert.Equal(t, http.StatusOK, do("/health/live", ""))
+	assert.Equal(t, http.StatusOK, do("/health/ready", ""))
+	assert.Equal(t, http.StatusUnauthorized, do("/health", ""))
+
+	// 未配置令牌时不校验
+	h.SetSecurity(ServerSecurity{})
+	handler = h.authMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	assert.Equal(t, http.StatusOK, do("/metrics", ""))
+}
+
+func TestServerSecurity_Validate(t *testing.T) {
+	assert.NoError(t, ServerSecurity{}.Validate())
+	assert.Error(t, ServerSecurity{TLSCertFile: "a.crt"}.Validate())
+	assert.Error(t, ServerSecurity{ClientCAFile: "ca.crt"}.Validate())
+	assert.NoError(t, ServerSecurity{TLSCertFile: "a.crt", TLSKeyFile: "a.key", ClientCAFile: "ca.crt"}.Validate())
+}
+
+func TestServerSecurity_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	caCert, caKey := newTestCert(t, nil, nil, "test-ca")
+	serverCert, serverKey := newTestCert(t, caCert, caKey, "127.0.0.1")
+	clientCert, clientKey := newTestCert(t, caCert, caKey, "client")
+
+	writePEM(t, filepath.Join(dir, "ca.crt"), "CERTIFICATE", caCert.Raw)
+	writePEM(t, filepath.Join(dir, "server.crt"), "CERTIFICATE", serverCert.Raw)
+	writeKey(t, filepath.Join(dir, "server.key"), serverKey)
+
+	tlsConfig, err := ServerSecurity{
+		TLSCertFile:  filepath.Join(dir, "server.crt"),
+		TLSKeyFile:   filepath.Join(dir, "server.key"),
+		ClientCAFile: filepath.Join(dir, "ca.crt"),
+	}.tlsConfig()
+	require.NoError(t, err)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	srv.TLS = tlsConfig
+	srv.StartTLS()
+	defer srv.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+	newClient := func(certs ...tls.Certificate) *http.Client {
+		return &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: roots, Certificates: certs},
+		}}
+	}
+
+	// 无客户端证书时握手失败
+	_, err = newClient().Get(srv.URL)
+	assert.Error(t, err)
+
+	resp, err := newClient(tls.Certificate{Certificate: [][]byte{clientCert.Raw}, PrivateKey: clientKey}).Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// 未启用 TLS 时不返回配置
+	tlsConfig, err = ServerSecurity{}.tlsConfig()
+	require.NoError(t, err)
+	assert.Nil(t, tlsConfig)
+}
+
+// newTestCert 生成测试证书，parent 为 nil 时生成自签名 CA
+func newTestCert(t *testing.T, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, name string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if ip := net.ParseIP(name); ip != nil {
+		tmpl.IPAddresses = []net.IP{ip}
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent, parentKey
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func writePEM(t *testing.T, path, typ string, der []byte) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600))
+}
+
+func writeKey(t *testing.T, path string, key *ecdsa.PrivateKey) {
+	t.Helper()
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	writePEM(t, path, "EC PRIVATE KEY", der)
+}
@@ -0,0 +1,247 @@
+package monitor
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// streamKeepAlive SSE 心跳间隔，防止代理因空闲断开连接
+const streamKeepAlive = 15 * time.Second
+
+// streamBufferSize 每个订阅者的待发送缓冲，消费过慢时丢弃新信号
+const streamBufferSize = 256
+
+// StreamSignal 推送给 SSE 订阅者的信号，Data 为已序列化的 JSON
+type StreamSignal struct {
+	ID        string // trace_id，作为 SSE 事件 id
+	Address   string
+	Label     string // 地址标签，对应查询参数 tag
+	Symbol    string
+	Direction string
+	AssetType string
+	Data      []byte
+}
+
+// SignalStream 将已发布的信号广播给 /stream/signals 的订阅者
+type SignalStream struct {
+	mu         sync.RWMutex
+	subs       map[*streamSubscriber]struct{}
+	maxClients int
+	closed     bool
+}
+
+type streamSubscriber struct {
+	filter streamFilter
+	ch     chan StreamSignal
+	done   chan struct{}
+}
+
+// NewSignalStream 创建信号广播，maxClients 为最大同时连接数
+func NewSignalStream(maxClients int) *SignalStream {
+	return &SignalStream{
+		subs:       make(map[*streamSubscriber]struct{}),
+		maxClients: maxClients,
+	}
+}
+
+// Publish 广播信号，不阻塞发布流程
+func (s *SignalStream) Publish(sig StreamSignal) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for sub := range s.subs {
+		if !sub.filter.match(sig) {
+			continue
+		}
+		select {
+		case sub.ch <- sig:
+		default:
+			GetMetrics().IncSignalStreamDropped()
+		}
+	}
+}
+
+// Clients 当前订阅者数量
+func (s *SignalStream) Clients() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.subs)
+}
+
+// Close 断开所有订阅者，服务器关闭前调用，避免长连接阻塞 Shutdown
+func (s *SignalStream) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	for sub := range s.subs {
+		close(sub.done)
+		delete(s.subs, sub)
+	}
+	GetMetrics().SetSignalStreamClients(0)
+}
+
+func (s *SignalStream) subscribe(filter streamFilter) (*streamSubscriber, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, fmt.Errorf("stream closed")
+	}
+	if len(s.subs) >= s.maxClients {
+		return nil, fmt.Errorf("too many stream clients (max %d)", s.maxClients)
+	}
+
+	sub := &streamSubscriber{
+		filter: filter,
+		ch:     make(chan StreamSignal, streamBufferSize),
+		done:   make(chan struct{}),
+	}
+	s.subs[sub] = struct{}{}
+	GetMetrics().SetSignalStreamClients(len(s.subs))
+	return sub, nil
+}
+
+func (s *SignalStream) unsubscribe(sub *streamSubscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subs[sub]; ok {
+		delete(s.subs, sub)
+		close(sub.done)
+	}
+	GetMetrics().SetSignalStreamClients(len(s.subs))
+}
+
+// streamFilter 订阅过滤条件，同一字段多个取值为或，不同字段为与
+type streamFilter struct {
+	addresses  map[string]struct{}
+	tags       map[string]struct{}
+	symbols    map[string]struct{}
+	directions map[string]struct{}
+	assetTypes map[string]struct{}
+}
+
+// parseStreamFilter 解析查询参数：address、tag、symbol、direction、asset_type，逗号分隔，不区分大小写
+func parseStreamFilter(r *http.Request) streamFilter {
+	q := r.URL.Query()
+	return streamFilter{
+		addresses:  parseFilterValues(q["address"]),
+		tags:       parseFilterValues(q["tag"]),
+		symbols:    parseFilterValues(q["symbol"]),
+		directions: parseFilterValues(q["direction"]),
+		assetTypes: parseFilterValues(q["asset_type"]),
+	}
+}
+
+func parseFilterValues(values []string) map[string]struct{} {
+	var set map[string]struct{}
+	for _, v := range values {
+		for _, part := range strings.Split(v, ",") {
+			part = strings.ToLower(strings.TrimSpace(part))
+			if part == "" {
+				continue
+			}
+			if set == nil {
+				set = make(map[string]struct{})
+			}
+			set[part] = struct{}{}
+		}
+	}
+	return set
+}
+
+func (f streamFilter) match(sig StreamSignal) bool {
+	return matchFilterValue(f.addresses, sig.Address) &&
+		matchFilterValue(f.tags, sig.Label) &&
+		matchFilterValue(f.symbols, sig.Symbol) &&
+		matchFilterValue(f.directions, sig.Direction) &&
+		matchFilterValue(f.assetTypes, sig.AssetType)
+}
+
+func matchFilterValue(set map[string]struct{}, value string) bool {
+	if set == nil {
+		return true
+	}
+	_, ok := set[strings.ToLower(value)]
+	return ok
+}
+
+// SetSignalStream 设置信号广播（可选，用于 /stream/signals）
+func (h *HealthServer) SetSignalStream(stream *SignalStream) {
+	h.mu.Lock()
+	h.stream = stream
+	h.mu.Unlock()
+}
+
+// streamSignalsHandler 以 Server-Sent Events 推送已发布的信号
+// 配置管理令牌时需携带 Authorization: Bearer <token> 或查询参数 token（EventSource 无法设置请求头）
+func (h *HealthServer) streamSignalsHandler(w http.ResponseWriter, r *http.Request) {
+	h.mu.RLock()
+	stream := h.stream
+	token := h.adminToken
+	h.mu.RUnlock()
+
+	if stream == nil {
+		http.Error(w, "signal stream not available", http.StatusNotFound)
+		return
+	}
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if token != "" {
+		got := r.URL.Query().Get("token")
+		if got == "" {
+			got = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		}
+		if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	sub, err := stream.subscribe(parseStreamFilter(r))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	defer stream.unsubscribe(sub)
+
+	// 长连接不受服务器 WriteTimeout 限制
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, ": connected\n\n")
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case sig := <-sub.ch:
+			fmt.Fprintf(w, "event: signal\nid: %s\ndata: %s\n\n", sig.ID, sig.Data)
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": ping\n\n")
+		case <-sub.done:
+			return
+		case <-r.Context().Done():
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package monitor
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamFilter(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/stream/signals?symbol=BTCUSDC,ethusdc&tag=Binance&direction=open", nil)
+	f := parseStreamFilter(req)
+
+	assert.True(t, f.match(StreamSignal{Symbol: "BTCUSDC", Label: "binance", Direction: "open"}))
+	assert.True(t, f.match(StreamSignal{Symbol: "ETHUSDC", Label: "Binance", Direction: "open"}))
+	assert.False(t, f.match(StreamSignal{Symbol: "SOLUSDC", Label: "Binance", Direction: "open"}))
+	assert.False(t, f.match(StreamSignal{Symbol: "BTCUSDC", Label: "", Direction: "open"}))
+	assert.False(t, f.match(StreamSignal{Symbol: "BTCUSDC", Label: "Binance", Direction: "close"}))
+
+	// 无过滤条件时全部匹配
+	assert.True(t, parseStreamFilter(httptest.NewRequest(http.MethodGet, "/stream/signals", nil)).match(StreamSignal{}))
+}
+
+func TestStreamSignalsHandler(t *testing.T) {
+	h := NewHealthServer(":0", nil, nil, nil)
+	rec := httptest.NewRecorder()
+	h.streamSignalsHandler(rec, httptest.NewRequest(http.MethodGet, "/stream/signals", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	stream := NewSignalStream(1)
+	h.SetSignalStream(stream)
+	h.SetAdminToken("secret")
+	srv := httptest.NewServer(http.HandlerFunc(h.streamSignalsHandler))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "?symbol=BTCUSDC")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, err = http.Get(srv.URL + "?symbol=BTCUSDC&token=secret")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, ": connected\n", line)
+	require.Eventually(t, func() bool { return stream.Clients() == 1 }, time.Second, 10*time.Millisecond)
+
+	// 超过最大连接数
+	busy, err := http.Get(srv.URL + "?token=secret")
+	require.NoError(t, err)
+	busy.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, busy.StatusCode)
+
+	stream.Publish(StreamSignal{ID: "t1", Symbol: "ETHUSDC", Data: []byte(`{"symbol":"ETHUSDC"}`)})
+	stream.Publish(StreamSignal{ID: "t2", Symbol: "BTCUSDC", Data: []byte(`{"symbol":"BTCUSDC"}`)})
+
+	var event []string
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		if line == "\n" && len(event) > 0 {
+			break
+		}
+		if line != "\n" {
+			event = append(event, strings.TrimSuffix(line, "\n"))
+		}
+	}
+	assert.Equal(t, []string{"event: signal", "id: t2", `data: {"symbol":"BTCUSDC"}`}, event)
+
+	// 关闭后断开连接
+	stream.Close()
+	_, err = reader.ReadString('\n')
+	assert.Error(t, err)
+	assert.Zero(t, stream.Clients())
+}
@@ -0,0 +1,130 @@
+package monitor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// Pinger 上游 API 探测接口（由 hyperliquid.Info 实现）
+type Pinger interface {
+	Ping(ctx context.Context) (time.Duration, error)
+}
+
+// UpstreamStatus 上游 API 状态
+type UpstreamStatus struct {
+	Healthy   bool   `json:"healthy"`
+	LatencyMs int64  `json:"latency_ms"`
+	LastCheck string `json:"last_check,omitempty"`
+	LastError string `json:"last_error,omitempty"`
+	Failures  int    `json:"consecutive_failures"`
+}
+
+// UpstreamProbe 定时探测 Hyperliquid API，用于区分"上游慢"与"自身管道慢"
+type UpstreamProbe struct {
+	pinger   Pinger
+	interval time.Duration
+	timeout  time.Duration
+
+	mu        sync.RWMutex
+	status    UpstreamStatus
+	lastCheck time.Time
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewUpstreamProbe 创建上游探测器
+func NewUpstreamProbe(pinger Pinger, interval time.Duration) *UpstreamProbe {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	timeout := 10 * time.Second
+	if interval < timeout {
+		timeout = interval
+	}
+
+	return &UpstreamProbe{
+		pinger:   pinger,
+		interval: interval,
+		timeout:  timeout,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start 启动探测循环
+func (p *UpstreamProbe) Start() {
+	p.wg.Add(1)
+	goplus.Go(func() {
+		defer p.wg.Done()
+
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		// 启动时立即探测一次
+		p.probe()
+
+		for {
+			select {
+			case <-ticker.C:
+				p.probe()
+			case <-p.done:
+				return
+			}
+		}
+	})
+
+	logger.Info().Dur("interval", p.interval).Msg("upstream probe started")
+}
+
+// Stop 停止探测
+func (p *UpstreamProbe) Stop() {
+	close(p.done)
+	p.wg.Wait()
+}
+
+// Status 返回最近一次探测结果
+func (p *UpstreamProbe) Status() UpstreamStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	st := p.status
+	if !p.lastCheck.IsZero() {
+		st.LastCheck = p.lastCheck.Format(time.RFC3339)
+	}
+	return st
+}
+
+// probe 执行一次探测并更新指标
+func (p *UpstreamProbe) probe() {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	latency, err := p.pinger.Ping(ctx)
+
+	p.mu.Lock()
+	p.lastCheck = time.Now()
+	p.status.LatencyMs = latency.Milliseconds()
+	if err != nil {
+		p.status.Healthy = false
+		p.status.LastError = err.Error()
+		p.status.Failures++
+	} else {
+		p.status.Healthy = true
+		p.status.LastError = ""
+		p.status.Failures = 0
+	}
+	healthy := p.status.Healthy
+	p.mu.Unlock()
+
+	SetUpstreamHealthy(healthy)
+	ObserveUpstreamLatency(latency.Seconds())
+
+	if err != nil {
+		logger.Warn().Err(err).Dur("latency", latency).Msg("upstream probe failed")
+	}
+}
@@ -0,0 +1,55 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockPinger struct {
+	latency time.Duration
+	err     error
+}
+
+func (m *mockPinger) Ping(ctx context.Context) (time.Duration, error) {
+	return m.latency, m.err
+}
+
+func TestUpstreamProbe_Probe(t *testing.T) {
+	pinger := &mockPinger{latency: 120 * time.Millisecond}
+	p := NewUpstreamProbe(pinger, time.Minute)
+
+	p.probe()
+	st := p.Status()
+	assert.True(t, st.Healthy)
+	assert.Equal(t, int64(120), st.LatencyMs)
+	assert.Equal(t, 0, st.Failures)
+	assert.NotEmpty(t, st.LastCheck)
+
+	pinger.err = errors.New("timeout")
+	p.probe()
+	p.probe()
+	st = p.Status()
+	assert.False(t, st.Healthy)
+	assert.Equal(t, "timeout", st.LastError)
+	assert.Equal(t, 2, st.Failures)
+
+	pinger.err = nil
+	p.probe()
+	st = p.Status()
+	assert.True(t, st.Healthy)
+	assert.Empty(t, st.LastError)
+	assert.Equal(t, 0, st.Failures)
+}
+
+func TestUpstreamProbe_StartStop(t *testing.T) {
+	p := NewUpstreamProbe(&mockPinger{latency: time.Millisecond}, 10*time.Millisecond)
+	p.Start()
+	time.Sleep(30 * time.Millisecond)
+	p.Stop()
+
+	assert.True(t, p.Status().Healthy)
+}
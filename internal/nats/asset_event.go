@@ -0,0 +1,23 @@
+package nats
+
+import (
+	"encoding/json"
+)
+
+const TopicHLAssetDelisted = "hl.asset.delisted"
+
+// HlAssetDelisted 合约资产下架通知（元数据重载发现 isDelisted 时发布一次），
+// 供下游停止该交易对的跟单并处理残留仓位
+type HlAssetDelisted struct {
+	AssetType     string `json:"asset_type"`     // futures
+	Coin          string `json:"coin"`           // 原始资产名
+	Symbol        string `json:"symbol"`         // 交易对
+	FlushedOrders int    `json:"flushed_orders"` // 下架时立即发送的待聚合订单数
+	Timestamp     int64  `json:"timestamp"`      // 发现下架的时间（毫秒）
+	TraceID       string `json:"trace_id"`       // 追踪 ID
+}
+
+// Marshal 序列化事件
+func (e *HlAssetDelisted) Marshal() ([]byte, error) {
+	return json.Marshal(e)
+}
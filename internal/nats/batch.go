@@ -0,0 +1,241 @@
+package nats
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+)
+
+// 批量发布确认方式
+const (
+	BatchAckNone      = "none"      // 不确认，与逐条发布一致
+	BatchAckFlush     = "flush"     // 整批发布后 flush 一次，确认服务端已收到
+	BatchAckJetStream = "jetstream" // 异步发布到 JetStream，等待整批 PubAck（主题需被 stream 捕获）
+)
+
+// batchItem 等待批量发布的一条消息，done 接收发布结果
+type batchItem struct {
+	subject string
+	data    []byte
+	done    chan error
+}
+
+// batcher 信号批量发布
+// 调用方阻塞等待自己那条消息的结果；首条消息到达后最多等待 linger 凑满 max_size，
+// 整批发布后只等待一次确认（flush 一个往返，或并发等待 JetStream PubAck），降低突发时的逐条往返开销
+type batcher struct {
+	p   *Publisher
+	cfg config.NATSBatch
+	js  nats.JetStreamContext // ack=jetstream 时使用主集群
+
+	mu     sync.RWMutex
+	closed bool
+	queue  chan *batchItem
+	wg     sync.WaitGroup
+}
+
+func newBatcher(p *Publisher, cfg config.NATSBatch) (*batcher, error) {
+	b := &batcher{
+		p:     p,
+		cfg:   cfg,
+		queue: make(chan *batchItem, max(cfg.MaxSize, 1)),
+	}
+	b.cfg.MaxSize = max(cfg.MaxSize, 1)
+	if b.cfg.AckTimeout <= 0 {
+		b.cfg.AckTimeout = 5 * time.Second
+	}
+
+	switch cfg.Ack {
+	case BatchAckNone, BatchAckFlush:
+	case BatchAckJetStream:
+		js, err := p.Conn.JetStream(nats.PublishAsyncMaxPending(max(cfg.MaxInFlight, 1)))
+		if err != nil {
+			return nil, fmt.Errorf("init jetstream for batch publishing: %w", err)
+		}
+		b.js = js
+	default:
+		return nil, fmt.Errorf("nats.batch.ack must be none, flush or jetstream, got %q", cfg.Ack)
+	}
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		b.run()
+	}()
+	return b, nil
+}
+
+// publish 加入当前批次并等待该消息的发布结果
+func (b *batcher) publish(subject string, data []byte) error {
+	item := &batchItem{subject: subject, data: data, done: make(chan error, 1)}
+
+	b.mu.RLock()
+	if b.closed {
+		b.mu.RUnlock()
+		return nats.ErrConnectionClosed
+	}
+	b.queue <- item
+	b.mu.RUnlock()
+
+	return <-item.done
+}
+
+// stop 停止接收新消息，发布队列中剩余的消息后返回
+func (b *batcher) stop() {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+	b.closed = true
+	close(b.queue)
+	b.mu.Unlock()
+	b.wg.Wait()
+}
+
+func (b *batcher) run() {
+	batch := make([]*batchItem, 0, b.cfg.MaxSize)
+	for item := range b.queue {
+		batch = append(batch[:0], item)
+		batch = b.collect(batch)
+		b.flush(batch)
+	}
+}
+
+// collect 在 linger 内继续收集消息直到批次满，linger 为 0 时只取已在队列中的消息
+func (b *batcher) collect(batch []*batchItem) []*batchItem {
+	if b.cfg.Linger <= 0 {
+		for len(batch) < b.cfg.MaxSize {
+			select {
+			case item, ok := <-b.queue:
+				if !ok {
+					return batch
+				}
+				batch = append(batch, item)
+			default:
+				return batch
+			}
+		}
+		return batch
+	}
+
+	timer := time.NewTimer(b.cfg.Linger)
+	defer timer.Stop()
+	for len(batch) < b.cfg.MaxSize {
+		select {
+		case item, ok := <-b.queue:
+			if !ok {
+				return batch
+			}
+			batch = append(batch, item)
+		case <-timer.C:
+			return batch
+		}
+	}
+	return batch
+}
+
+// flush 发布一批消息并分发结果
+func (b *batcher) flush(batch []*batchItem) {
+	start := time.Now()
+	var errs []error
+	if b.cfg.Ack == BatchAckJetStream {
+		errs = b.publishJetStream(batch)
+	} else {
+		errs = b.publishCore(batch)
+	}
+	monitor.ObserveNATSPublishBatch(b.cfg.Ack, len(batch), time.Since(start))
+
+	for i, item := range batch {
+		item.done <- errs[i]
+	}
+}
+
+// publishCore 逐条按多集群策略发布，ack=flush 时整批只 flush 一次
+func (b *batcher) publishCore(batch []*batchItem) []error {
+	errs := make([]error, len(batch))
+	sent := 0
+	for i, item := range batch {
+		if errs[i] = b.p.Publish(item.subject, item.data); errs[i] == nil {
+			sent++
+		}
+	}
+	if b.cfg.Ack != BatchAckFlush || sent == 0 {
+		return errs
+	}
+
+	if err := b.p.flush(b.cfg.AckTimeout); err != nil {
+		for i := range errs {
+			if errs[i] == nil {
+				errs[i] = err
+			}
+		}
+	}
+	return errs
+}
+
+// publishJetStream 异步发布整批消息（未确认数受 max_in_flight 限制），在 ack_timeout 内等待全部 PubAck
+func (b *batcher) publishJetStream(batch []*batchItem) []error {
+	errs := make([]error, len(batch))
+	futures := make([]nats.PubAckFuture, len(batch))
+	for i, item := range batch {
+		futures[i], errs[i] = b.js.PublishAsync(item.subject, item.data)
+	}
+
+	timeout := time.NewTimer(b.cfg.AckTimeout)
+	defer timeout.Stop()
+	for i, f := range futures {
+		if f == nil {
+			continue
+		}
+		select {
+		case <-f.Ok():
+		case err := <-f.Err():
+			errs[i] = err
+		case <-timeout.C:
+			// 剩余尚未确认的消息视为超时
+			for j := i; j < len(futures); j++ {
+				if futures[j] != nil {
+					errs[j] = ackResult(futures[j])
+				}
+			}
+			return errs
+		}
+	}
+	return errs
+}
+
+// ackResult 不等待地读取 PubAck 结果，尚未返回时为超时
+func ackResult(f nats.PubAckFuture) error {
+	select {
+	case <-f.Ok():
+		return nil
+	case err := <-f.Err():
+		return err
+	default:
+		return fmt.Errorf("wait pub ack: %w", nats.ErrTimeout)
+	}
+}
+
+// flush 等待已连接集群确认收到缓冲中的消息，任一集群确认即成功
+// 所有集群均未连接时消息在主集群重连缓冲中，与 Publish 一致视为成功
+func (p *Publisher) flush(timeout time.Duration) error {
+	var errs []error
+	for _, ep := range p.endpoints {
+		if !ep.conn.IsConnected() {
+			continue
+		}
+		if err := ep.conn.FlushTimeout(timeout); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ep.name, err))
+			continue
+		}
+		return nil
+	}
+	return errors.Join(errs...)
+}
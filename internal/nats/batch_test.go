@@ -0,0 +1,75 @@
+package nats
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/utrading/utrading-hl-monitor/config"
+)
+
+func TestBatcher_Collect(t *testing.T) {
+	b := &batcher{
+		cfg:   config.NATSBatch{MaxSize: 3, Linger: 10 * time.Millisecond},
+		queue: make(chan *batchItem, 10),
+	}
+	for range 4 {
+		b.queue <- &batchItem{}
+	}
+
+	// 批次满即返回
+	batch := b.collect([]*batchItem{<-b.queue})
+	assert.Len(t, batch, 3)
+
+	// 未满时等待 linger
+	start := time.Now()
+	batch = b.collect([]*batchItem{<-b.queue})
+	assert.Len(t, batch, 1)
+	assert.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+
+	// linger 为 0 时只取已在队列中的消息
+	b.cfg.Linger = 0
+	b.queue <- &batchItem{}
+	b.queue <- &batchItem{}
+	batch = b.collect([]*batchItem{{}})
+	assert.Len(t, batch, 3)
+	batch = b.collect([]*batchItem{{}})
+	assert.Len(t, batch, 1)
+}
+
+func TestPublisher_SignalBatch(t *testing.T) {
+	p, err := NewPublisher(config.NATS{
+		Endpoint:       "nats://127.0.0.1:1",
+		ReconnectWait:  time.Hour,
+		ConnectTimeout: 100 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer p.Close()
+
+	assert.Error(t, p.enableSignalBatch(config.NATSBatch{MaxSize: 8, Ack: "kafka"}))
+	require.NoError(t, p.enableSignalBatch(config.NATSBatch{
+		MaxSize: 8, Linger: 20 * time.Millisecond, Ack: BatchAckFlush, AckTimeout: time.Second,
+	}))
+
+	// 集群不可用时写入重连缓冲，与逐条发布一致视为成功
+	var wg sync.WaitGroup
+	errs := make([]error, 20)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = p.PublishAddressSignal(&HlAddressSignal{Address: "0xabc", Symbol: "BTCUSDC"})
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+
+	// 关闭后批量队列不再接收
+	require.NoError(t, p.Close())
+	err = p.PublishAddressSignal(&HlAddressSignal{Address: "0xabc"})
+	assert.Equal(t, ErrCodeClosed, ErrorCode(err))
+}
@@ -0,0 +1,104 @@
+package nats
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// JetStreamBroker 基于 NATS JetStream 工作队列的消息代理
+// 每个分区对应一个 subject 和一个持久化消费者，MaxAckPending=1 保证分区内顺序处理
+type JetStreamBroker struct {
+	js      nats.JetStreamContext
+	stream  string
+	subject string
+
+	mu   sync.Mutex
+	subs []*nats.Subscription
+}
+
+// NewJetStreamBroker 创建 JetStream 消息代理，stream 不存在时自动创建
+func NewJetStreamBroker(conn *nats.Conn, cfg config.Queue) (*JetStreamBroker, error) {
+	js, err := conn.JetStream()
+	if err != nil {
+		return nil, fmt.Errorf("init jetstream failed: %w", err)
+	}
+
+	subject := strings.TrimSuffix(cfg.Subject, ".")
+	if _, err = js.StreamInfo(cfg.Stream); err != nil {
+		_, err = js.AddStream(&nats.StreamConfig{
+			Name:      cfg.Stream,
+			Subjects:  []string{subject + ".*"},
+			Retention: nats.WorkQueuePolicy,
+			Storage:   nats.FileStorage,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create stream %s failed: %w", cfg.Stream, err)
+		}
+		logger.Info().Str("stream", cfg.Stream).Str("subject", subject).Msg("jetstream stream created")
+	}
+
+	return &JetStreamBroker{
+		js:      js,
+		stream:  cfg.Stream,
+		subject: subject,
+	}, nil
+}
+
+// Publish 发布消息到分区
+func (b *JetStreamBroker) Publish(partition int, data []byte) error {
+	_, err := b.js.Publish(b.partitionSubject(partition), data)
+	return err
+}
+
+// Subscribe 订阅分区，handler 返回错误时消息重新投递
+func (b *JetStreamBroker) Subscribe(partitions []int, handler func(data []byte) error) error {
+	for _, partition := range partitions {
+		sub, err := b.js.Subscribe(
+			b.partitionSubject(partition),
+			func(msg *nats.Msg) {
+				if err := handler(msg.Data); err != nil {
+					logger.Warn().Err(err).Str("subject", msg.Subject).Msg("handle queue message failed, redelivering")
+					_ = msg.Nak()
+					return
+				}
+				_ = msg.Ack()
+			},
+			nats.Durable(fmt.Sprintf("%s_p%d", b.stream, partition)),
+			nats.BindStream(b.stream),
+			nats.ManualAck(),
+			nats.AckExplicit(),
+			nats.MaxAckPending(1),
+			nats.DeliverAll(),
+		)
+		if err != nil {
+			return fmt.Errorf("subscribe partition %d failed: %w", partition, err)
+		}
+
+		b.mu.Lock()
+		b.subs = append(b.subs, sub)
+		b.mu.Unlock()
+	}
+	return nil
+}
+
+// Close 取消所有分区订阅（保留持久化消费者，重启后继续消费）
+func (b *JetStreamBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, sub := range b.subs {
+		_ = sub.Drain()
+	}
+	b.subs = nil
+	return nil
+}
+
+func (b *JetStreamBroker) partitionSubject(partition int) string {
+	return fmt.Sprintf("%s.%d", b.subject, partition)
+}
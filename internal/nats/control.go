@@ -0,0 +1,215 @@
+package nats
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// DefaultControlSubject 控制面默认主题
+const DefaultControlSubject = "hl.monitor.control"
+
+// 控制命令
+const (
+	ControlAddAddress    = "add_address"    // 添加监控地址并立即订阅
+	ControlRemoveAddress = "remove_address" // 移除监控地址并立即取消订阅
+	ControlStats         = "stats"          // 运行统计
+	ControlFlushOrder    = "flush_order"    // 立即发送地址（可指定 oid）的待处理聚合
+)
+
+var addressPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+// ControlRequest 控制请求
+type ControlRequest struct {
+	Command string `json:"command"`
+	Address string `json:"address,omitempty"` // add_address / remove_address / flush_order
+	Oid     int64  `json:"oid,omitempty"`     // flush_order 可选，为 0 时发送该地址全部聚合
+	Token   string `json:"token,omitempty"`   // 配置 control.token 时必填
+}
+
+// ControlResponse 控制应答
+type ControlResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+	Data  any    `json:"data,omitempty"`
+}
+
+// AddressControl 运行时地址管理（由 address.AddressLoader 实现）
+type AddressControl interface {
+	AddAddress(addr string) error
+	RemoveAddress(addr string) (bool, error)
+}
+
+// OrderFlusher 手动发送待处理聚合（由 processor.OrderProcessor 实现）
+type OrderFlusher interface {
+	FlushOrders(address string, oid int64) int
+}
+
+// StatsProvider 运行统计提供者
+type StatsProvider interface {
+	GetStats() map[string]any
+}
+
+// ControlServer NATS 请求-应答控制面
+// 运维和其他服务通过消息总线管理监控实例，无需访问 HTTP 管理接口
+type ControlServer struct {
+	conn *nats.Conn
+	cfg  config.Control
+
+	mu        sync.RWMutex
+	addresses AddressControl
+	flusher   OrderFlusher
+	stats     map[string]StatsProvider
+	sub       *nats.Subscription
+}
+
+// NewControlServer 创建控制面服务
+func NewControlServer(conn *nats.Conn, cfg config.Control) *ControlServer {
+	if cfg.Subject == "" {
+		cfg.Subject = DefaultControlSubject
+	}
+	return &ControlServer{
+		conn:  conn,
+		cfg:   cfg,
+		stats: make(map[string]StatsProvider),
+	}
+}
+
+// SetAddressControl 设置地址管理（可选，用于 add_address / remove_address）
+func (s *ControlServer) SetAddressControl(addresses AddressControl) {
+	s.mu.Lock()
+	s.addresses = addresses
+	s.mu.Unlock()
+}
+
+// SetOrderFlusher 设置聚合发送（可选，用于 flush_order）
+func (s *ControlServer) SetOrderFlusher(flusher OrderFlusher) {
+	s.mu.Lock()
+	s.flusher = flusher
+	s.mu.Unlock()
+}
+
+// AddStats 注册统计提供者，stats 命令按名称返回各组件统计
+func (s *ControlServer) AddStats(name string, provider StatsProvider) {
+	s.mu.Lock()
+	s.stats[name] = provider
+	s.mu.Unlock()
+}
+
+// Start 订阅控制主题，配置队列组时同组实例中只有一个处理同一请求
+func (s *ControlServer) Start() error {
+	sub, err := s.conn.QueueSubscribe(s.cfg.Subject, s.cfg.QueueGroup, s.handle)
+	if err != nil {
+		return fmt.Errorf("subscribe control subject %s: %w", s.cfg.Subject, err)
+	}
+
+	s.mu.Lock()
+	s.sub = sub
+	s.mu.Unlock()
+
+	logger.Info().Str("subject", s.cfg.Subject).Str("queue_group", s.cfg.QueueGroup).Msg("nats control plane started")
+	return nil
+}
+
+// Stop 取消订阅
+func (s *ControlServer) Stop() {
+	s.mu.Lock()
+	sub := s.sub
+	s.sub = nil
+	s.mu.Unlock()
+
+	if sub != nil {
+		_ = sub.Unsubscribe()
+	}
+}
+
+// handle 解析请求并应答，无应答主题（普通发布）的请求同样执行
+func (s *ControlServer) handle(msg *nats.Msg) {
+	var req ControlRequest
+	var resp ControlResponse
+	if err := json.Unmarshal(msg.Data, &req); err != nil {
+		resp = ControlResponse{Error: "invalid request: " + err.Error()}
+	} else {
+		resp = s.dispatch(req)
+	}
+
+	if msg.Reply == "" {
+		return
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		logger.Error().Err(err).Str("command", req.Command).Msg("marshal control response failed")
+		return
+	}
+	if err := msg.Respond(data); err != nil {
+		logger.Warn().Err(err).Str("command", req.Command).Msg("respond control request failed")
+	}
+}
+
+// dispatch 校验令牌并执行命令
+func (s *ControlServer) dispatch(req ControlRequest) ControlResponse {
+	if s.cfg.Token != "" && subtle.ConstantTimeCompare([]byte(req.Token), []byte(s.cfg.Token)) != 1 {
+		return ControlResponse{Error: "unauthorized"}
+	}
+
+	data, err := s.execute(req)
+	if err != nil {
+		logger.Warn().Err(err).Str("command", req.Command).Str("address", req.Address).Msg("control command failed")
+		return ControlResponse{Error: err.Error()}
+	}
+	if req.Command != ControlStats {
+		logger.Warn().Str("command", req.Command).Str("address", req.Address).Int64("oid", req.Oid).Msg("control command executed")
+	}
+	return ControlResponse{OK: true, Data: data}
+}
+
+func (s *ControlServer) execute(req ControlRequest) (any, error) {
+	s.mu.RLock()
+	addresses := s.addresses
+	flusher := s.flusher
+	s.mu.RUnlock()
+
+	switch req.Command {
+	case ControlAddAddress, ControlRemoveAddress:
+		if addresses == nil {
+			return nil, errors.New("address control not available")
+		}
+		if !addressPattern.MatchString(req.Address) {
+			return nil, fmt.Errorf("invalid address %q", req.Address)
+		}
+		if req.Command == ControlAddAddress {
+			return map[string]string{"address": req.Address}, addresses.AddAddress(req.Address)
+		}
+		removed, err := addresses.RemoveAddress(req.Address)
+		return map[string]any{"address": req.Address, "removed": removed}, err
+
+	case ControlFlushOrder:
+		if flusher == nil {
+			return nil, errors.New("order flusher not available")
+		}
+		if !addressPattern.MatchString(req.Address) {
+			return nil, fmt.Errorf("invalid address %q", req.Address)
+		}
+		return map[string]int{"flushed": flusher.FlushOrders(req.Address, req.Oid)}, nil
+
+	case ControlStats:
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		stats := make(map[string]map[string]any, len(s.stats))
+		for name, provider := range s.stats {
+			stats[name] = provider.GetStats()
+		}
+		return stats, nil
+
+	default:
+		return nil, fmt.Errorf("unknown command %q", req.Command)
+	}
+}
@@ -0,0 +1,98 @@
+package nats
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+)
+
+const testControlAddr = "0x1234567890abcdef1234567890abcdef12345678"
+
+type mockAddressControl struct {
+	added, removed []string
+	err            error
+}
+
+func (m *mockAddressControl) AddAddress(addr string) error {
+	m.added = append(m.added, addr)
+	return m.err
+}
+
+func (m *mockAddressControl) RemoveAddress(addr string) (bool, error) {
+	m.removed = append(m.removed, addr)
+	return true, m.err
+}
+
+type mockOrderFlusher struct {
+	address string
+	oid     int64
+}
+
+func (m *mockOrderFlusher) FlushOrders(address string, oid int64) int {
+	m.address, m.oid = address, oid
+	return 2
+}
+
+type mockStats map[string]any
+
+func (m mockStats) GetStats() map[string]any { return m }
+
+func TestControlServer_Dispatch(t *testing.T) {
+	s := NewControlServer(nil, config.Control{Token: "secret"})
+	addresses := &mockAddressControl{}
+	flusher := &mockOrderFlusher{}
+	s.SetAddressControl(addresses)
+	s.SetOrderFlusher(flusher)
+	s.AddStats("subscriptions", mockStats{"address_count": 3})
+
+	resp := s.dispatch(ControlRequest{Command: ControlStats})
+	assert.False(t, resp.OK)
+	assert.Equal(t, "unauthorized", resp.Error)
+
+	resp = s.dispatch(ControlRequest{Command: ControlAddAddress, Address: testControlAddr, Token: "secret"})
+	require.True(t, resp.OK, resp.Error)
+	assert.Equal(t, []string{testControlAddr}, addresses.added)
+
+	resp = s.dispatch(ControlRequest{Command: ControlRemoveAddress, Address: testControlAddr, Token: "secret"})
+	require.True(t, resp.OK, resp.Error)
+	assert.Equal(t, map[string]any{"address": testControlAddr, "removed": true}, resp.Data)
+
+	resp = s.dispatch(ControlRequest{Command: ControlFlushOrder, Address: testControlAddr, Oid: 42, Token: "secret"})
+	require.True(t, resp.OK, resp.Error)
+	assert.Equal(t, map[string]int{"flushed": 2}, resp.Data)
+	assert.Equal(t, int64(42), flusher.oid)
+
+	resp = s.dispatch(ControlRequest{Command: ControlStats, Token: "secret"})
+	require.True(t, resp.OK, resp.Error)
+	assert.Equal(t, map[string]map[string]any{"subscriptions": {"address_count": 3}}, resp.Data)
+
+	resp = s.dispatch(ControlRequest{Command: ControlAddAddress, Address: "0xabc", Token: "secret"})
+	assert.False(t, resp.OK)
+	assert.Contains(t, resp.Error, "invalid address")
+
+	addresses.err = errors.New("address sync suspended")
+	resp = s.dispatch(ControlRequest{Command: ControlAddAddress, Address: testControlAddr, Token: "secret"})
+	assert.False(t, resp.OK)
+	assert.Equal(t, "address sync suspended", resp.Error)
+
+	resp = s.dispatch(ControlRequest{Command: "reboot", Token: "secret"})
+	assert.False(t, resp.OK)
+	assert.Contains(t, resp.Error, "unknown command")
+}
+
+func TestControlServer_Unavailable(t *testing.T) {
+	s := NewControlServer(nil, config.Control{})
+	assert.Equal(t, DefaultControlSubject, s.cfg.Subject)
+
+	resp := s.dispatch(ControlRequest{Command: ControlAddAddress, Address: testControlAddr})
+	assert.False(t, resp.OK)
+	assert.Equal(t, "address control not available", resp.Error)
+
+	resp = s.dispatch(ControlRequest{Command: ControlFlushOrder, Address: testControlAddr})
+	assert.False(t, resp.OK)
+	assert.Equal(t, "order flusher not available", resp.Error)
+}
@@ -0,0 +1,45 @@
+package nats
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nats-io/nats.go"
+)
+
+// 发布失败错误码（指标标签），取值固定便于告警规则匹配
+const (
+	ErrCodeMarshal         = "marshal_error"         // 信号序列化失败
+	ErrCodeTimeout         = "nats_timeout"          // 发布/刷新超时
+	ErrCodeNoResponders    = "nats_no_responders"    // 无订阅者响应（请求-响应模式）
+	ErrCodeClosed          = "nats_closed"           // 连接已关闭或正在 drain
+	ErrCodeMaxPayload      = "nats_max_payload"      // 消息超过服务端 max_payload
+	ErrCodeReconnectBuffer = "nats_reconnect_buffer" // 重连期间缓冲区已满
+	ErrCodeBadSubject      = "nats_bad_subject"      // 主题非法
+	ErrCodeOther           = "nats_other"            // 其他 NATS 错误
+)
+
+// ErrMarshal 信号序列化失败
+var ErrMarshal = errors.New("marshal failed")
+
+// ErrorCode 将发布错误归类为固定错误码
+func ErrorCode(err error) string {
+	switch {
+	case errors.Is(err, ErrMarshal):
+		return ErrCodeMarshal
+	case errors.Is(err, nats.ErrTimeout), errors.Is(err, context.DeadlineExceeded):
+		return ErrCodeTimeout
+	case errors.Is(err, nats.ErrNoResponders):
+		return ErrCodeNoResponders
+	case errors.Is(err, nats.ErrConnectionClosed), errors.Is(err, nats.ErrConnectionDraining):
+		return ErrCodeClosed
+	case errors.Is(err, nats.ErrMaxPayload):
+		return ErrCodeMaxPayload
+	case errors.Is(err, nats.ErrReconnectBufExceeded):
+		return ErrCodeReconnectBuffer
+	case errors.Is(err, nats.ErrBadSubject):
+		return ErrCodeBadSubject
+	default:
+		return ErrCodeOther
+	}
+}
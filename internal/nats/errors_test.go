@@ -0,0 +1,42 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorCode(t *testing.T) {
+	cases := map[error]string{
+		fmt.Errorf("%w: bad float", ErrMarshal):           ErrCodeMarshal,
+		nats.ErrTimeout:                                   ErrCodeTimeout,
+		fmt.Errorf("flush: %w", context.DeadlineExceeded): ErrCodeTimeout,
+		nats.ErrNoResponders:                              ErrCodeNoResponders,
+		nats.ErrConnectionClosed:                          ErrCodeClosed,
+		nats.ErrConnectionDraining:                        ErrCodeClosed,
+		nats.ErrMaxPayload:                                ErrCodeMaxPayload,
+		nats.ErrReconnectBufExceeded:                      ErrCodeReconnectBuffer,
+		nats.ErrBadSubject:                                ErrCodeBadSubject,
+		errors.New("boom"):                                ErrCodeOther,
+	}
+	for err, code := range cases {
+		assert.Equal(t, code, ErrorCode(err), err.Error())
+	}
+}
+
+func TestPublisher_FailureStreak(t *testing.T) {
+	p := &Publisher{}
+	signal := &HlAddressSignal{Address: "0xabc", TraceID: "trace-1"}
+
+	// 未建立连接时发布失败
+	assert.Error(t, p.PublishAddressSignal(signal))
+	assert.Error(t, p.PublishAddressSignal(signal))
+	assert.Equal(t, int64(2), p.failureStreak.Load())
+
+	p.recordSignalResult("trace-2", nil)
+	assert.Zero(t, p.failureStreak.Load())
+}
@@ -0,0 +1,28 @@
+package nats
+
+import (
+	"encoding/json"
+)
+
+const TopicHLOrderCancelled = "hl.order.cancelled"
+
+// HlOrderCancelled 订单未成交即终止（撤单/拒绝）事件，供跟单系统丢弃过期的挂单意图
+type HlOrderCancelled struct {
+	Address   string `json:"address"`         // 监控地址
+	AssetType string `json:"asset_type"`      // spot/futures
+	Symbol    string `json:"symbol"`          // 交易对，无法解析时为空
+	Coin      string `json:"coin"`            // 原始币种
+	Oid       int64  `json:"oid"`             // 订单 ID
+	Cloid     string `json:"cloid,omitempty"` // 客户端订单 ID
+	Side      string `json:"side"`            // B/A
+	LimitPx   string `json:"limit_px"`        // 限价
+	OrigSz    string `json:"orig_sz"`         // 原始数量
+	Status    string `json:"status"`          // 终止原因: canceled/rejected/marginCanceled 等
+	Timestamp int64  `json:"timestamp"`       // 状态变更时间（毫秒）
+	TraceID   string `json:"trace_id"`        // 追踪 ID
+}
+
+// Marshal 序列化事件
+func (e *HlOrderCancelled) Marshal() ([]byte, error) {
+	return json.Marshal(e)
+}
@@ -1,7 +1,10 @@
 package nats
 
 import (
+	"errors"
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/nats-io/nats.go"
@@ -10,15 +13,100 @@ import (
 	"github.com/utrading/utrading-hl-monitor/pkg/logger"
 )
 
+// 多集群发布策略
+const (
+	StrategyFailover = "failover" // 按配置顺序使用第一个可用集群，主集群恢复后自动切回
+	StrategyFanout   = "fanout"   // 同时发布到所有可用集群，任一成功即视为成功
+)
+
+// primaryEndpoint 主集群名称
+const primaryEndpoint = "primary"
+
 // Publisher NATS 发布器
+// 嵌入的 Conn 为主集群连接（JetStream 队列等仍使用主集群），发布经 Publish 按策略路由到各集群
 type Publisher struct {
 	*nats.Conn
 	mu     sync.RWMutex
 	closed bool
+
+	endpoints []*endpointConn // [0] 为主集群
+	strategy  string
+	active    atomic.Int32 // failover 策略当前使用的集群下标
+
+	failureStreak atomic.Int64 // 信号连续发布失败次数
+
+	stream atomic.Pointer[monitor.SignalStream] // 发布成功的信号同时推送给 /stream/signals（可选）
+	router atomic.Pointer[subjectRouterHolder]  // 地址分组主题路由（可选）
+
+	criticalSubject atomic.Pointer[string]  // critical 信号额外发布的主题（可选）
+	batch           atomic.Pointer[batcher] // 信号批量发布（可选）
+}
+
+// GroupSubject 信号需要额外发布到的分组主题
+type GroupSubject struct {
+	Group   string
+	Subject string
+}
+
+// SubjectRouter 按信号所属地址分组计算额外发布的主题
+type SubjectRouter interface {
+	Subjects(signal *HlAddressSignal) []GroupSubject
+}
+
+type subjectRouterHolder struct {
+	SubjectRouter
+}
+
+// endpointConn 单个 NATS 集群连接
+type endpointConn struct {
+	name string
+	url  string
+	conn *nats.Conn
 }
 
-// NewPublisher 创建 NATS 发布器（带自动重连）
+// NewPublisher 创建 NATS 发布器（带自动重连），配置备用集群时按 strategy 发布，开启 batch 时信号批量发布
 func NewPublisher(cfg config.NATS) (*Publisher, error) {
+	strategy := cfg.Strategy
+	if strategy == "" {
+		strategy = StrategyFailover
+	}
+
+	p := &Publisher{strategy: strategy}
+	p.endpoints = append(p.endpoints, &endpointConn{name: primaryEndpoint, url: cfg.Endpoint})
+	for _, sb := range cfg.Standby {
+		p.endpoints = append(p.endpoints, &endpointConn{name: sb.Name, url: sb.Endpoint})
+	}
+
+	for _, ep := range p.endpoints {
+		conn, err := nats.Connect(ep.url, p.connectOptions(cfg, ep)...)
+		if err != nil {
+			p.Close()
+			return nil, fmt.Errorf("connect nats %s: %w", ep.name, err)
+		}
+		p.mu.Lock()
+		ep.conn = conn
+		p.mu.Unlock()
+		monitor.SetNATSEndpointConnected(ep.name, conn.IsConnected())
+		logger.Info().Str("endpoint", ep.name).Str("url", ep.url).Bool("connected", conn.IsConnected()).Msg("nats connected")
+	}
+	p.Conn = p.endpoints[0].conn
+	p.refreshConnected()
+
+	if len(p.endpoints) > 1 {
+		logger.Info().Str("strategy", strategy).Int("endpoints", len(p.endpoints)).Msg("nats multi-cluster publishing enabled")
+	}
+	if cfg.Batch.Enabled {
+		if err := p.enableSignalBatch(cfg.Batch); err != nil {
+			p.Close()
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// connectOptions 单个集群的连接选项，连接状态变化时更新该集群和整体的连接指标
+func (p *Publisher) connectOptions(cfg config.NATS, ep *endpointConn) []nats.Option {
 	reconnectWait := cfg.ReconnectWait
 	if reconnectWait <= 0 {
 		reconnectWait = 2 * time.Second
@@ -44,66 +132,341 @@ func NewPublisher(cfg config.NATS) (*Publisher, error) {
 		nats.Timeout(connectTimeout),
 		nats.PingInterval(pingInterval),
 		nats.MaxPingsOutstanding(5),
+		nats.ConnectHandler(func(nc *nats.Conn) {
+			// RetryOnFailedConnect 下首次连接成功（启动时集群不可用）
+			logger.Info().Str("endpoint", ep.name).Str("url", nc.ConnectedUrl()).Msg("nats connected")
+			monitor.SetNATSEndpointConnected(ep.name, true)
+			p.refreshConnected()
+		}),
 		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
-			logger.Error().Err(err).Msg("nats disconnected, reconnecting...")
-			monitor.GetMetrics().SetNATSConnected(false)
+			logger.Error().Err(err).Str("endpoint", ep.name).Msg("nats disconnected, reconnecting...")
+			monitor.SetNATSEndpointConnected(ep.name, false)
+			p.refreshConnected()
 		}),
 		nats.ReconnectHandler(func(nc *nats.Conn) {
-			logger.Info().Str("url", nc.ConnectedUrl()).Msg("nats reconnected")
-			monitor.GetMetrics().SetNATSConnected(true)
+			logger.Info().Str("endpoint", ep.name).Str("url", nc.ConnectedUrl()).Msg("nats reconnected")
+			monitor.SetNATSEndpointConnected(ep.name, true)
+			p.refreshConnected()
 		}),
 		nats.ClosedHandler(func(nc *nats.Conn) {
-			logger.Error().Str("url", nc.ConnectedUrl()).Msg("nats connection closed permanently")
-			monitor.GetMetrics().SetNATSConnected(false)
+			logger.Error().Str("endpoint", ep.name).Str("url", nc.ConnectedUrl()).Msg("nats connection closed permanently")
+			monitor.SetNATSEndpointConnected(ep.name, false)
+			p.refreshConnected()
 		}),
 	}
 
-	conn, err := nats.Connect(cfg.Endpoint, opts...)
-	if err != nil {
-		return nil, err
+	if cfg.User != "" {
+		opts = append(opts, nats.UserInfo(cfg.User, cfg.Password))
 	}
+	if cfg.Token != "" {
+		opts = append(opts, nats.Token(cfg.Token))
+	}
+	return opts
+}
 
-	p := &Publisher{
-		Conn: conn,
+// refreshConnected 更新整体连接指标：任一集群可用即视为已连接
+func (p *Publisher) refreshConnected() {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	connected := false
+	for _, ep := range p.endpoints {
+		if ep.conn != nil && ep.conn.IsConnected() {
+			connected = true
+			break
+		}
 	}
+	monitor.GetMetrics().SetNATSConnected(!p.closed && connected)
+}
 
-	// 更新指标
-	monitor.GetMetrics().SetNATSConnected(true)
+// Publish 按策略发布消息（覆盖嵌入 Conn 的 Publish）
+// 所有集群均不可用时写入主集群的重连缓冲，恢复后发送（与单集群行为一致）
+func (p *Publisher) Publish(subject string, data []byte) error {
+	if len(p.endpoints) <= 1 {
+		return p.Conn.Publish(subject, data)
+	}
+	if p.strategy == StrategyFanout {
+		return p.publishFanout(subject, data)
+	}
+	return p.publishFailover(subject, data)
+}
 
-	logger.Info().Str("url", cfg.Endpoint).Msg("nats connected")
+// publishFailover 按顺序发布到第一个可用集群，失败时尝试下一个
+func (p *Publisher) publishFailover(subject string, data []byte) error {
+	var errs []error
+	for i, ep := range p.endpoints {
+		if !ep.conn.IsConnected() {
+			continue
+		}
+		if err := ep.conn.Publish(subject, data); err != nil {
+			logger.Warn().Err(err).Str("endpoint", ep.name).Str("subject", subject).Msg("nats publish failed, trying next endpoint")
+			errs = append(errs, fmt.Errorf("%s: %w", ep.name, err))
+			continue
+		}
+		p.setActive(i)
+		return nil
+	}
 
-	return p, nil
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return p.Conn.Publish(subject, data)
+}
+
+// publishFanout 发布到所有可用集群，任一成功即返回成功
+func (p *Publisher) publishFanout(subject string, data []byte) error {
+	var errs []error
+	sent := 0
+	for _, ep := range p.endpoints {
+		if !ep.conn.IsConnected() {
+			continue
+		}
+		if err := ep.conn.Publish(subject, data); err != nil {
+			logger.Warn().Err(err).Str("endpoint", ep.name).Str("subject", subject).Msg("nats fanout publish failed")
+			errs = append(errs, fmt.Errorf("%s: %w", ep.name, err))
+			continue
+		}
+		sent++
+	}
+
+	switch {
+	case sent > 0:
+		return nil
+	case len(errs) > 0:
+		return errors.Join(errs...)
+	default:
+		return p.Conn.Publish(subject, data)
+	}
+}
+
+// setActive 记录 failover 策略当前使用的集群，切换时记录日志和指标
+func (p *Publisher) setActive(i int) {
+	prev := int(p.active.Swap(int32(i)))
+	if prev == i {
+		return
+	}
+	from, to := p.endpoints[prev].name, p.endpoints[i].name
+	monitor.IncNATSFailover(from, to)
+	logger.Warn().Str("from", from).Str("to", to).Msg("nats publishing switched endpoint")
+}
+
+// Strategy 多集群发布策略
+func (p *Publisher) Strategy() string {
+	return p.strategy
+}
+
+// EndpointStatus 各集群连接状态（/status 展示）
+func (p *Publisher) EndpointStatus() []monitor.NATSEndpointStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	active := int(p.active.Load())
+	status := make([]monitor.NATSEndpointStatus, 0, len(p.endpoints))
+	for i, ep := range p.endpoints {
+		status = append(status, monitor.NATSEndpointStatus{
+			Name:      ep.name,
+			Connected: ep.conn != nil && ep.conn.IsConnected(),
+			Active:    p.strategy == StrategyFailover && i == active,
+		})
+	}
+	return status
 }
 
-// PublishAddressSignal 发布地址信号
+// PublishAddressSignal 发布地址信号，失败时按错误码记录指标
 func (p *Publisher) PublishAddressSignal(signal *HlAddressSignal) error {
 	data, err := signal.Marshal()
 	if err != nil {
 		logger.Error().Err(err).Msg("marshal signal failed")
+		err = fmt.Errorf("%w: %v", ErrMarshal, err)
+	} else if b := p.batch.Load(); b != nil {
+		err = b.publish(TopicHLAddressSignal, data)
+	} else {
+		err = p.Publish(TopicHLAddressSignal, data)
+	}
+
+	p.recordSignalResult(signal.TraceID, err)
+	if err == nil {
+		if stream := p.stream.Load(); stream != nil {
+			stream.Publish(monitor.StreamSignal{
+				ID:        signal.TraceID,
+				Address:   signal.Address,
+				Label:     signal.AddressLabel,
+				Symbol:    signal.Symbol,
+				Direction: signal.Direction,
+				AssetType: signal.AssetType,
+				Data:      data,
+			})
+		}
+		p.publishGroups(signal, data)
+		p.publishCritical(signal, data)
+	}
+	return err
+}
+
+// publishCritical critical 信号在默认主题发布成功后额外发布到告警主题，失败只记录日志和指标
+func (p *Publisher) publishCritical(signal *HlAddressSignal, data []byte) {
+	subject := p.criticalSubject.Load()
+	if subject == nil || signal.Severity != SeverityCritical {
+		return
+	}
+	if err := p.Publish(*subject, data); err != nil {
+		logger.Warn().Err(err).Str("subject", *subject).Str("trace_id", signal.TraceID).
+			Msg("publish critical signal failed")
+		monitor.IncSignalCriticalPublished("failed")
+		return
+	}
+	monitor.IncSignalCriticalPublished("success")
+}
+
+// publishGroups 默认主题发布成功后按地址分组主题再发布一次
+// 分组主题发布失败只记录日志和指标，不影响信号的发布结果（避免发件箱重复发布默认主题）
+func (p *Publisher) publishGroups(signal *HlAddressSignal, data []byte) {
+	holder := p.router.Load()
+	if holder == nil {
+		return
+	}
+	for _, gs := range holder.Subjects(signal) {
+		if err := p.Publish(gs.Subject, data); err != nil {
+			logger.Warn().Err(err).Str("group", gs.Group).Str("subject", gs.Subject).
+				Str("trace_id", signal.TraceID).Msg("publish group signal failed")
+			monitor.IncSignalGroupPublished(gs.Group, "failed")
+			continue
+		}
+		monitor.IncSignalGroupPublished(gs.Group, "success")
+	}
+}
+
+// SetSignalStream 设置信号 SSE 广播（可选）
+func (p *Publisher) SetSignalStream(stream *monitor.SignalStream) {
+	p.stream.Store(stream)
+}
+
+// SetSubjectRouter 设置地址分组主题路由（可选），信号同时发布到所属分组的主题
+func (p *Publisher) SetSubjectRouter(router SubjectRouter) {
+	if router == nil {
+		p.router.Store(nil)
+		return
+	}
+	p.router.Store(&subjectRouterHolder{router})
+}
+
+// SetCriticalSubject 设置 critical 信号额外发布的主题（可选），为空时不发布
+func (p *Publisher) SetCriticalSubject(subject string) {
+	if subject == "" {
+		p.criticalSubject.Store(nil)
+		return
+	}
+	p.criticalSubject.Store(&subject)
+}
+
+// enableSignalBatch 开启信号批量发布，只作用于 hl_address_signal 主题，分组和告警主题仍逐条发布
+func (p *Publisher) enableSignalBatch(cfg config.NATSBatch) error {
+	b, err := newBatcher(p, cfg)
+	if err != nil {
 		return err
 	}
+	if prev := p.batch.Swap(b); prev != nil {
+		prev.stop()
+	}
+	logger.Info().Int("max_size", cfg.MaxSize).Dur("linger", cfg.Linger).Str("ack", cfg.Ack).
+		Msg("nats signal batch publishing enabled")
+	return nil
+}
 
-	return p.Publish(TopicHLAddressSignal, data)
+// recordSignalResult 记录发布结果：失败按错误码计数并累加连续失败次数，成功时归零
+func (p *Publisher) recordSignalResult(traceID string, err error) {
+	if err == nil {
+		if p.failureStreak.Swap(0) != 0 {
+			monitor.SetSignalErrorStreak(0)
+		}
+		return
+	}
+
+	streak := p.failureStreak.Add(1)
+	monitor.IncSignalErrors(ErrorCode(err), traceID)
+	monitor.SetSignalErrorStreak(streak)
 }
 
-// IsConnected 检查发布器是否已连接
+// PublishOrderCancelled 发布订单撤销/拒绝事件
+func (p *Publisher) PublishOrderCancelled(event *HlOrderCancelled) error {
+	data, err := event.Marshal()
+	if err != nil {
+		logger.Error().Err(err).Msg("marshal order cancelled event failed")
+		return err
+	}
+
+	return p.Publish(TopicHLOrderCancelled, data)
+}
+
+// PublishBalanceTransfer 发布余额变动事件
+func (p *Publisher) PublishBalanceTransfer(event *HlBalanceTransfer) error {
+	data, err := event.Marshal()
+	if err != nil {
+		logger.Error().Err(err).Msg("marshal balance transfer event failed")
+		return err
+	}
+
+	return p.Publish(TopicHLBalanceTransfer, data)
+}
+
+// PublishSymbolRenamed 发布 symbol 改名通知
+func (p *Publisher) PublishSymbolRenamed(event *HlSymbolRenamed) error {
+	data, err := event.Marshal()
+	if err != nil {
+		logger.Error().Err(err).Msg("marshal symbol renamed event failed")
+		return err
+	}
+
+	return p.Publish(TopicHLSymbolRenamed, data)
+}
+
+// PublishAssetDelisted 发布资产下架通知
+func (p *Publisher) PublishAssetDelisted(event *HlAssetDelisted) error {
+	data, err := event.Marshal()
+	if err != nil {
+		logger.Error().Err(err).Msg("marshal asset delisted event failed")
+		return err
+	}
+
+	return p.Publish(TopicHLAssetDelisted, data)
+}
+
+// IsConnected 检查发布器是否已连接（任一集群连接未关闭）
 func (p *Publisher) IsConnected() bool {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	return !p.closed && p.Conn != nil && !p.Conn.IsClosed()
+	if p.closed {
+		return false
+	}
+	for _, ep := range p.endpoints {
+		if ep.conn != nil && !ep.conn.IsClosed() {
+			return true
+		}
+	}
+	return false
 }
 
-// Close 关闭连接
+// Close 发布批量队列中剩余的信号后关闭所有集群连接
 func (p *Publisher) Close() error {
+	if b := p.batch.Load(); b != nil {
+		b.stop()
+	}
+
 	p.mu.Lock()
-	defer p.mu.Unlock()
 	p.closed = true
+	var conns []*nats.Conn
+	for _, ep := range p.endpoints {
+		if ep.conn != nil {
+			conns = append(conns, ep.conn)
+		}
+	}
+	p.mu.Unlock()
 
 	// 更新指标
 	monitor.GetMetrics().SetNATSConnected(false)
 
-	if p.Conn != nil {
-		p.Conn.Close()
+	for _, conn := range conns {
+		conn.Close()
 	}
 	return nil
 }
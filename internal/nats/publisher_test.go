@@ -0,0 +1,42 @@
+package nats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/utrading/utrading-hl-monitor/config"
+)
+
+func TestPublisher_MultiEndpointUnavailable(t *testing.T) {
+	for _, strategy := range []string{StrategyFailover, StrategyFanout} {
+		t.Run(strategy, func(t *testing.T) {
+			p, err := NewPublisher(config.NATS{
+				Endpoint:       "nats://127.0.0.1:1",
+				Strategy:       strategy,
+				Standby:        []config.NATSEndpoint{{Name: "backup", Endpoint: "nats://127.0.0.1:2"}},
+				ReconnectWait:  time.Hour,
+				ConnectTimeout: 100 * time.Millisecond,
+			})
+			require.NoError(t, err)
+			defer p.Close()
+
+			assert.Equal(t, strategy, p.Strategy())
+			status := p.EndpointStatus()
+			require.Len(t, status, 2)
+			assert.Equal(t, "primary", status[0].Name)
+			assert.Equal(t, "backup", status[1].Name)
+			assert.False(t, status[0].Connected)
+			assert.False(t, status[1].Connected)
+			assert.True(t, p.IsConnected())
+
+			// 所有集群不可用时写入主集群重连缓冲
+			assert.NoError(t, p.Publish(TopicHLAddressSignal, []byte(`{}`)))
+
+			p.Close()
+			assert.False(t, p.IsConnected())
+			assert.Error(t, p.Publish(TopicHLAddressSignal, []byte(`{}`)))
+		})
+	}
+}
@@ -1,26 +1,132 @@
 package nats
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"strconv"
+
+	"github.com/google/uuid"
 
 	"github.com/utrading/utrading-hl-monitor/pkg/logger"
 )
 
 const TopicHLAddressSignal = "hl_address_signal"
 
+// 信号级别
+const (
+	SeverityInfo     = "info"
+	SeverityNotable  = "notable"
+	SeverityCritical = "critical"
+)
+
+// SeverityRank 信号级别的排序值，为空或未知时与 info 相同
+func SeverityRank(severity string) int {
+	switch severity {
+	case SeverityNotable:
+		return 1
+	case SeverityCritical:
+		return 2
+	}
+	return 0
+}
+
 // HlAddressSignal 地址信号消息
 type HlAddressSignal struct {
-	Address      string  `json:"address"`       // 监控地址
-	AssetType    string  `json:"asset_type"`    // spot/futures
-	Symbol       string  `json:"symbol"`        // 交易对
-	CoinType     string  `json:"coin_type"`     // 币种类型: A/B/C/D
-	Direction    string  `json:"direction"`     // open/close
-	Side         string  `json:"side"`          // LONG/SHORT
-	PositionRate float64 `json:"position_rate"` // 仓位比例: 百分比，如 15.50%
-	CloseRate    float64 `json:"close_rate"`    // 平仓比例: 平仓数量/当前仓位
-	Size         float64 `json:"size"`          // 数量
-	Price        float64 `json:"price"`         // 价格
-	Timestamp    int64   `json:"timestamp"`     // 时间戳
+	Address      string  `json:"address"`         // 监控地址
+	AssetType    string  `json:"asset_type"`      // spot/futures
+	Symbol       string  `json:"symbol"`          // 交易对
+	Dex          string  `json:"dex,omitempty"`   // 合约所属 builder dex（如 xyz），主 dex 与现货为空
+	CoinType     string  `json:"coin_type"`       // 币种类型: A/B/C/D
+	Direction    string  `json:"direction"`       // open/close
+	Side         string  `json:"side"`            // LONG/SHORT
+	RawDir       string  `json:"raw_dir"`         // Hyperliquid 原始成交方向: Open Long/Close Short/Buy/Sell 等
+	PositionRate float64 `json:"position_rate"`   // 仓位比例: 百分比，如 15.50%
+	CloseRate    float64 `json:"close_rate"`      // 平仓比例: 平仓数量/当前仓位
+	Size         float64 `json:"size"`            // 数量
+	Price        float64 `json:"price"`           // 价格
+	Timestamp    int64   `json:"timestamp"`       // 时间戳
+	Scope        string  `json:"scope,omitempty"` // 去重作用域（逻辑消费者），默认为空
+
+	MidPx       float64 `json:"mid_px,omitempty"`       // 首笔成交时的中间价/标记价，无价格时为 0
+	SlippageBps float64 `json:"slippage_bps,omitempty"` // 成交均价相对中间价的滑点(bp)，正数表示劣于中间价
+
+	NotionalUSD   float64 `json:"notional_usd,omitempty"`   // 成交名义价值(USD)
+	QuoteCurrency string  `json:"quote_currency,omitempty"` // 计价货币，未配置估值时为空
+	NotionalQuote float64 `json:"notional_quote,omitempty"` // 成交名义价值(计价货币)，计价货币无价格时为 0
+
+	AddressLabel string `json:"address_label,omitempty"` // 地址标签（交易所钱包、金库名称等），未知地址为空
+
+	DayNtlVlm    float64 `json:"day_ntl_vlm,omitempty"`   // 合约 24 小时成交额(USD)，无市场上下文时为 0
+	OpenInterest float64 `json:"open_interest,omitempty"` // 合约未平仓量（币数量）
+	Funding      float64 `json:"funding,omitempty"`       // 合约当前资金费率
+
+	Leverage   int    `json:"leverage,omitempty"`    // 交易者在该合约上的杠杆倍数，未知时为 0（现货不附带）
+	MarginMode string `json:"margin_mode,omitempty"` // 保证金模式: cross/isolated，未知时为空
+
+	PositionRateBasis       string  `json:"position_rate_basis,omitempty"`       // 仓位比例分母: account_value/withdrawable/free_collateral/margin_used/spot_total
+	PositionRateDenominator float64 `json:"position_rate_denominator,omitempty"` // 分母金额(USD)，余额缓存缺失时为 0（此时仓位比例为 100）
+	BalanceStale            bool    `json:"balance_stale,omitempty"`             // 余额超过 stale_ttl 未更新：比例基于过期数据，或 stale_mode=skip 时未计算（置 0）
+
+	Severity string `json:"severity,omitempty"` // 信号级别: info/notable/critical，未开启 [severity] 时为空
+
+	Cloid string  `json:"cloid,omitempty"` // 客户端订单 ID，按 cloid 聚合时非空
+	Oids  []int64 `json:"oids,omitempty"`  // 按 cloid 聚合时包含的全部订单 ID（拆单/改单）
+
+	IsPartial   bool    `json:"is_partial,omitempty"`   // 进度信号：聚合完成前累计成交越过 progress_thresholds，size/price 为截至此时的累计成交，完成后仍发送完整信号
+	FillPercent float64 `json:"fill_percent,omitempty"` // 进度信号的累计成交占订单原始数量的百分比
+
+	Extra map[string]string `json:"extra,omitempty"` // 扩展字段，由信号钩子附加的业务标注
+
+	IdempotencyKey string `json:"idempotency_key"` // 幂等键: hash(address/oid/direction/scope)，重复投递时不变
+	TraceID        string `json:"trace_id"`        // 追踪 ID，关联 NATS 消息、数据库记录与日志
+}
+
+// IdempotencyKey 生成确定性的信号幂等键
+// 默认作用域不参与计算，保证未启用作用域时与历史键一致
+func IdempotencyKey(address string, oid int64, direction, scope string) string {
+	raw := address + "-" + strconv.FormatInt(oid, 10) + "-" + direction
+	if scope != "" {
+		raw = scope + "|" + raw
+	}
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:16])
+}
+
+// FillIdempotencyKey 生成逐笔成交信号（scalper 聚合策略）的幂等键，在订单幂等键的基础上加入成交 tid
+func FillIdempotencyKey(address string, oid, tid int64, direction, scope string) string {
+	raw := address + "-" + strconv.FormatInt(oid, 10) + "-" + direction + "-t:" + strconv.FormatInt(tid, 10)
+	if scope != "" {
+		raw = scope + "|" + raw
+	}
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:16])
+}
+
+// ProgressIdempotencyKey 生成进度信号的幂等键，在订单幂等键的基础上加入越过的进度阈值
+func ProgressIdempotencyKey(address string, oid int64, direction, scope string, threshold float64) string {
+	raw := address + "-" + strconv.FormatInt(oid, 10) + "-" + direction + "-p:" + strconv.FormatFloat(threshold, 'f', -1, 64)
+	if scope != "" {
+		raw = scope + "|" + raw
+	}
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:16])
+}
+
+// EvictedIdempotencyKey 生成提前淘汰发送的部分聚合的幂等键，在订单幂等键的基础上加入部分聚合的首笔成交 tid
+// 订单之后的成交开始新的聚合，完成时使用订单幂等键，与已发送的部分互不冲突
+func EvictedIdempotencyKey(address string, oid, firstTid int64, direction, scope string) string {
+	raw := address + "-" + strconv.FormatInt(oid, 10) + "-" + direction + "-e:" + strconv.FormatInt(firstTid, 10)
+	if scope != "" {
+		raw = scope + "|" + raw
+	}
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:16])
+}
+
+// NewTraceID 生成新的追踪 ID
+func NewTraceID() string {
+	return uuid.NewString()
 }
 
 // Marshal 序列化信号
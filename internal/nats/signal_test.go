@@ -0,0 +1,33 @@
+package nats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	key := IdempotencyKey("0xabc", 123, "Open Long", "")
+
+	// 相同输入生成相同的键
+	assert.Equal(t, key, IdempotencyKey("0xabc", 123, "Open Long", ""))
+	assert.Len(t, key, 32)
+
+	// 任一字段变化生成不同的键
+	assert.NotEqual(t, key, IdempotencyKey("0xabc", 124, "Open Long", ""))
+	assert.NotEqual(t, key, IdempotencyKey("0xabc", 123, "Close Long", ""))
+	assert.NotEqual(t, key, IdempotencyKey("0xabc", 123, "Open Long", "1"))
+	assert.NotEqual(t,
+		IdempotencyKey("0xabc", 123, "Open Long", "1"),
+		IdempotencyKey("0xabc", 123, "Open Long", "2"))
+
+	// 提前淘汰的部分聚合与订单完成时的键不同
+	evicted := EvictedIdempotencyKey("0xabc", 123, 7, "Open Long", "")
+	assert.NotEqual(t, key, evicted)
+	assert.NotEqual(t, evicted, EvictedIdempotencyKey("0xabc", 123, 8, "Open Long", ""))
+	assert.NotEqual(t, evicted, FillIdempotencyKey("0xabc", 123, 7, "Open Long", ""))
+}
+
+func TestNewTraceID(t *testing.T) {
+	assert.NotEqual(t, NewTraceID(), NewTraceID())
+}
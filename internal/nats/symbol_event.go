@@ -0,0 +1,23 @@
+package nats
+
+import (
+	"encoding/json"
+)
+
+const TopicHLSymbolRenamed = "hl.symbol.renamed"
+
+// HlSymbolRenamed symbol 改名通知（Hyperliquid 资产改名或别名调整后由 symbols rename 子命令发布），
+// 供下游同步持仓、跟单配置中的交易对
+type HlSymbolRenamed struct {
+	AssetType     string           `json:"asset_type"`     // spot/futures
+	OldSymbol     string           `json:"old_symbol"`     // 旧交易对
+	NewSymbol     string           `json:"new_symbol"`     // 新交易对
+	RewrittenRows map[string]int64 `json:"rewritten_rows"` // 各表改写的历史记录数
+	Timestamp     int64            `json:"timestamp"`      // 改写完成时间（毫秒）
+	TraceID       string           `json:"trace_id"`       // 追踪 ID
+}
+
+// Marshal 序列化事件
+func (e *HlSymbolRenamed) Marshal() ([]byte, error) {
+	return json.Marshal(e)
+}
@@ -0,0 +1,36 @@
+package nats
+
+import (
+	"encoding/json"
+)
+
+const TopicHLBalanceTransfer = "hl.balance.transfer"
+
+// 资金流向（相对监控地址）
+const (
+	TransferDirectionIn       = "in"       // 转入
+	TransferDirectionOut      = "out"      // 转出
+	TransferDirectionInternal = "internal" // 账户内部划转（如合约与现货之间）
+)
+
+// HlBalanceTransfer 监控地址的非交易余额变动事件（充值/提现/转账），
+// 大额提现通常是跟单风险信号，供下游暂停跟单或告警
+type HlBalanceTransfer struct {
+	Address      string  `json:"address"`                // 监控地址
+	Label        string  `json:"label,omitempty"`        // 地址标签
+	Type         string  `json:"type"`                   // 账本类型: deposit/withdraw/internalTransfer/spotTransfer 等
+	Direction    string  `json:"direction"`              // in/out/internal
+	Token        string  `json:"token"`                  // 币种，USDC 类变动为 USDC
+	Amount       string  `json:"amount"`                 // 数量（币种单位）
+	USDValue     float64 `json:"usd_value"`              // 折合 USD
+	Fee          string  `json:"fee,omitempty"`          // 手续费
+	Counterparty string  `json:"counterparty,omitempty"` // 对手地址或金库地址
+	Hash         string  `json:"hash"`                   // L1 交易哈希
+	Timestamp    int64   `json:"timestamp"`              // 账本时间（毫秒）
+	TraceID      string  `json:"trace_id"`               // 追踪 ID
+}
+
+// Marshal 序列化事件
+func (e *HlBalanceTransfer) Marshal() ([]byte, error) {
+	return json.Marshal(e)
+}
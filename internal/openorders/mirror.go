@@ -0,0 +1,266 @@
+package openorders
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// Store 挂单镜像持久化
+type Store interface {
+	ReplaceAddress(address string, rows []*models.HlOpenOrder) error
+	DeleteAll() (int64, error)
+}
+
+// SymbolResolver symbol 查询接口（由 cache.SymbolCache 实现）
+type SymbolResolver interface {
+	GetPerpSymbol(assetName string) (string, bool)
+	GetSpotSymbol(assetName string) (string, bool)
+}
+
+// Mirror 监控地址挂单镜像
+// webData2 挂单快照整体替换地址挂单，orderUpdates 增量更新，
+// 变更的地址按 FlushInterval 合并后整体写入 hl_open_orders
+type Mirror struct {
+	cfg     config.OpenOrders
+	symbols SymbolResolver
+	store   Store
+
+	mu     sync.Mutex
+	orders map[string]map[int64]hl.WsBasicOrder // address -> oid -> 挂单
+	dirty  map[string]struct{}                  // 待写库的地址
+	total  int
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewMirror 创建挂单镜像
+func NewMirror(cfg config.OpenOrders, symbols SymbolResolver) *Mirror {
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = time.Second
+	}
+
+	return &Mirror{
+		cfg:     cfg,
+		symbols: symbols,
+		store:   dao.OpenOrder(),
+		orders:  make(map[string]map[int64]hl.WsBasicOrder),
+		dirty:   make(map[string]struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// SetStore 设置持久化（可选，默认使用 DAO）
+func (m *Mirror) SetStore(store Store) {
+	m.store = store
+}
+
+// Start 清空上次运行残留的镜像并启动写库协程，镜像由后续 webData2 快照重建
+func (m *Mirror) Start() error {
+	if _, err := m.store.DeleteAll(); err != nil {
+		return err
+	}
+
+	m.wg.Add(1)
+	go m.flushLoop()
+	return nil
+}
+
+// Stop 停止写库协程并写入剩余变更
+func (m *Mirror) Stop() {
+	close(m.done)
+	m.wg.Wait()
+	m.flush()
+}
+
+// Snapshot 以 webData2 挂单快照整体替换地址挂单，内容未变化时不写库
+func (m *Mirror) Snapshot(addr string, orders []hl.WsBasicOrder) {
+	next := make(map[int64]hl.WsBasicOrder, len(orders))
+	for _, order := range orders {
+		next[order.Oid] = order
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if sameOrders(m.orders[addr], next) {
+		return
+	}
+	m.total += len(next) - len(m.orders[addr])
+	m.orders[addr] = next
+	m.dirty[addr] = struct{}{}
+}
+
+// Update 按 orderUpdates 增量更新：open/triggered 写入挂单，其余状态（成交、撤单、拒绝）移除
+func (m *Mirror) Update(addr string, wsOrder hl.WsOrder) {
+	order := wsOrder.Order
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current := m.orders[addr]
+	old, exists := current[order.Oid]
+
+	if wsOrder.Status == hl.OrderStatusValueOpen || wsOrder.Status == hl.OrderStatusValueTriggered {
+		if exists && sameOrder(old, order) {
+			return
+		}
+		if current == nil {
+			current = make(map[int64]hl.WsBasicOrder)
+			m.orders[addr] = current
+		}
+		if !exists {
+			m.total++
+		}
+		current[order.Oid] = order
+		m.dirty[addr] = struct{}{}
+		return
+	}
+
+	if !exists {
+		return
+	}
+	delete(current, order.Oid)
+	m.total--
+	m.dirty[addr] = struct{}{}
+}
+
+// RemoveAddress 移除地址的全部挂单（取消监控时调用）
+func (m *Mirror) RemoveAddress(addr string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current, ok := m.orders[addr]
+	if !ok {
+		return
+	}
+	m.total -= len(current)
+	delete(m.orders, addr)
+	m.dirty[addr] = struct{}{}
+}
+
+// Orders 返回地址当前挂单（按下单时间排序）
+func (m *Mirror) Orders(addr string) []hl.WsBasicOrder {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	orders := make([]hl.WsBasicOrder, 0, len(m.orders[addr]))
+	for _, order := range m.orders[addr] {
+		orders = append(orders, order)
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		if orders[i].Timestamp != orders[j].Timestamp {
+			return orders[i].Timestamp < orders[j].Timestamp
+		}
+		return orders[i].Oid < orders[j].Oid
+	})
+	return orders
+}
+
+func (m *Mirror) flushLoop() {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			m.flush()
+		case <-m.done:
+			return
+		}
+	}
+}
+
+// flush 将变更地址的当前挂单整体写库，失败的地址留待下次重试
+func (m *Mirror) flush() {
+	m.mu.Lock()
+	if len(m.dirty) == 0 {
+		m.mu.Unlock()
+		return
+	}
+	dirty := m.dirty
+	m.dirty = make(map[string]struct{})
+	total := m.total
+	m.mu.Unlock()
+
+	monitor.SetOpenOrdersMirrored(total)
+
+	now := time.Now()
+	for addr := range dirty {
+		rows := m.buildRows(addr, m.Orders(addr), now)
+		if err := m.store.ReplaceAddress(addr, rows); err != nil {
+			logger.Error().Err(err).Str("address", addr).Msg("write open orders mirror failed")
+			monitor.IncOpenOrderFlushError()
+
+			m.mu.Lock()
+			m.dirty[addr] = struct{}{}
+			m.mu.Unlock()
+		}
+	}
+}
+
+func (m *Mirror) buildRows(addr string, orders []hl.WsBasicOrder, now time.Time) []*models.HlOpenOrder {
+	rows := make([]*models.HlOpenOrder, 0, len(orders))
+	for _, order := range orders {
+		row := &models.HlOpenOrder{
+			Address:   addr,
+			Oid:       order.Oid,
+			Coin:      order.Coin,
+			Symbol:    m.symbol(order.Coin),
+			Side:      order.Side,
+			LimitPx:   order.LimitPx,
+			Sz:        order.Sz,
+			OrigSz:    order.OrigSz,
+			PlacedAt:  order.Timestamp,
+			UpdatedAt: now,
+		}
+		if order.Cloid != nil {
+			row.Cloid = *order.Cloid
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// symbol 转换为下游 symbol，合约优先，其次现货（@107、PURR/USDC），均未命中时为空
+func (m *Mirror) symbol(coin string) string {
+	if m.symbols == nil {
+		return ""
+	}
+	if symbol, ok := m.symbols.GetPerpSymbol(coin); ok {
+		return symbol
+	}
+	if symbol, ok := m.symbols.GetSpotSymbol(coin); ok {
+		return symbol
+	}
+	return ""
+}
+
+func sameOrders(a, b map[int64]hl.WsBasicOrder) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for oid, order := range b {
+		old, ok := a[oid]
+		if !ok || !sameOrder(old, order) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameOrder(a, b hl.WsBasicOrder) bool {
+	return a.Sz == b.Sz && a.LimitPx == b.LimitPx && a.OrigSz == b.OrigSz &&
+		a.Side == b.Side && a.Coin == b.Coin && a.Timestamp == b.Timestamp
+}
@@ -0,0 +1,137 @@
+package openorders
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
+	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+type mockStore struct {
+	mu     sync.Mutex
+	rows   map[string][]*models.HlOpenOrder
+	writes int
+	err    error
+}
+
+func newMockStore() *mockStore {
+	return &mockStore{rows: make(map[string][]*models.HlOpenOrder)}
+}
+
+func (s *mockStore) ReplaceAddress(address string, rows []*models.HlOpenOrder) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writes++
+	if s.err != nil {
+		return s.err
+	}
+	s.rows[address] = rows
+	return nil
+}
+
+func (s *mockStore) DeleteAll() (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rows = make(map[string][]*models.HlOpenOrder)
+	return 0, nil
+}
+
+func basicOrder(oid int64, sz string) hl.WsBasicOrder {
+	return hl.WsBasicOrder{Coin: "BTC", Side: "B", LimitPx: "50000", Sz: sz, OrigSz: "1", Oid: oid, Timestamp: oid}
+}
+
+func TestMirror_SnapshotAndUpdates(t *testing.T) {
+	const addr = "0xabc"
+
+	symbols := cache.NewSymbolCache()
+	symbols.SetPerpSymbol("BTC", "BTCUSDC")
+
+	store := newMockStore()
+	m := NewMirror(config.OpenOrders{}, symbols)
+	m.SetStore(store)
+
+	m.Snapshot(addr, []hl.WsBasicOrder{basicOrder(1, "1"), basicOrder(2, "1")})
+	m.flush()
+	require.Len(t, store.rows[addr], 2)
+	assert.Equal(t, "BTCUSDC", store.rows[addr][0].Symbol)
+	assert.Equal(t, int64(1), store.rows[addr][0].Oid)
+
+	// 内容未变化的快照不写库
+	m.Snapshot(addr, []hl.WsBasicOrder{basicOrder(2, "1"), basicOrder(1, "1")})
+	m.flush()
+	assert.Equal(t, 1, store.writes)
+
+	// 部分成交更新剩余数量，撤单移除
+	m.Update(addr, hl.WsOrder{Order: basicOrder(1, "0.4"), Status: hl.OrderStatusValueOpen})
+	m.Update(addr, hl.WsOrder{Order: basicOrder(2, "1"), Status: hl.OrderStatusValueCanceled})
+	m.Update(addr, hl.WsOrder{Order: basicOrder(3, "1"), Status: hl.OrderStatusValueFilled})
+	m.flush()
+	assert.Equal(t, 2, store.writes)
+	require.Len(t, store.rows[addr], 1)
+	assert.Equal(t, "0.4", store.rows[addr][0].Sz)
+
+	m.RemoveAddress(addr)
+	m.flush()
+	assert.Empty(t, store.rows[addr])
+	assert.Empty(t, m.Orders(addr))
+}
+
+func TestMirror_RetryFailedFlush(t *testing.T) {
+	store := newMockStore()
+	store.err = errors.New("db down")
+
+	m := NewMirror(config.OpenOrders{}, nil)
+	m.SetStore(store)
+
+	m.Snapshot("0xabc", []hl.WsBasicOrder{basicOrder(1, "1")})
+	m.flush()
+	assert.Empty(t, store.rows)
+
+	store.err = nil
+	m.flush()
+	require.Len(t, store.rows["0xabc"], 1)
+	assert.Empty(t, store.rows["0xabc"][0].Symbol)
+}
+
+func TestMirror_DAOStore(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file:openorders?mode=memory&cache=shared"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.HlOpenOrder{}))
+	gen.SetDefault(db)
+
+	m := NewMirror(config.OpenOrders{}, nil)
+	require.NoError(t, m.Start())
+
+	cloid := "0x00000000000000000000000000000001"
+	order := basicOrder(1, "1")
+	order.Cloid = &cloid
+	m.Snapshot("0xabc", []hl.WsBasicOrder{order, basicOrder(2, "1")})
+	m.Snapshot("0xdef", []hl.WsBasicOrder{basicOrder(3, "1")})
+	m.Update("0xabc", hl.WsOrder{Order: basicOrder(2, "1"), Status: hl.OrderStatusValueFilled})
+	m.Stop()
+
+	rows, err := gen.HlOpenOrder.Order(gen.HlOpenOrder.Oid).Find()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "0xabc", rows[0].Address)
+	assert.Equal(t, cloid, rows[0].Cloid)
+	assert.Equal(t, "0xdef", rows[1].Address)
+
+	// 重启时清空残留镜像
+	_, err = dao.OpenOrder().DeleteAll()
+	require.NoError(t, err)
+	count, err := gen.HlOpenOrder.Count()
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
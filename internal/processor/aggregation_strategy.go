@@ -0,0 +1,127 @@
+package processor
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// 内置订单聚合策略，对应 hl_watch_addresses.aggregation
+const (
+	AggregationDefault = "default" // 按订单聚合，终止状态、成交量达到原始数量或超时时发送（列为空时使用）
+	AggregationScalper = "scalper" // 不聚合，每笔成交立即发送
+	AggregationSwing   = "swing"   // 按订单聚合，使用更长的聚合超时
+)
+
+// AggregationStrategy 订单聚合策略，按地址选择
+type AggregationStrategy interface {
+	// Name 策略名，与 hl_watch_addresses.aggregation 的取值一致
+	Name() string
+	// PerFill 每笔成交立即发送信号，聚合只用于持久化和去重，完成时不再发送汇总信号
+	PerFill() bool
+	// Timeout 聚合超时，def 为处理器默认超时
+	Timeout(def time.Duration) time.Duration
+}
+
+type defaultAggregation struct{}
+
+func (defaultAggregation) Name() string                            { return AggregationDefault }
+func (defaultAggregation) PerFill() bool                           { return false }
+func (defaultAggregation) Timeout(def time.Duration) time.Duration { return def }
+
+type scalperAggregation struct{}
+
+func (scalperAggregation) Name() string                            { return AggregationScalper }
+func (scalperAggregation) PerFill() bool                           { return true }
+func (scalperAggregation) Timeout(def time.Duration) time.Duration { return def }
+
+type swingAggregation struct {
+	window time.Duration
+}
+
+func (swingAggregation) Name() string  { return AggregationSwing }
+func (swingAggregation) PerFill() bool { return false }
+
+// Timeout 波段窗口短于默认超时时使用默认超时
+func (s swingAggregation) Timeout(def time.Duration) time.Duration {
+	return max(s.window, def)
+}
+
+// restoredAggregation 启动时从数据库恢复的聚合使用的策略
+// 恢复早于策略表的设置和地址的首次加载，每次使用时按地址当前的分配选择
+type restoredAggregation struct {
+	processor *OrderProcessor
+	address   string
+}
+
+func (r restoredAggregation) current() AggregationStrategy {
+	return r.processor.strategies.For(r.address)
+}
+
+func (r restoredAggregation) Name() string  { return r.current().Name() }
+func (r restoredAggregation) PerFill() bool { return r.current().PerFill() }
+func (r restoredAggregation) Timeout(def time.Duration) time.Duration {
+	return r.current().Timeout(def)
+}
+
+// AggregationStrategies 按地址选择订单聚合策略
+// 策略在启动前注册，地址分配由地址加载器定期从 hl_watch_addresses 刷新；未分配的地址使用默认策略
+type AggregationStrategies struct {
+	mu         sync.RWMutex
+	strategies map[string]AggregationStrategy                 // 策略名 -> 策略
+	addresses  atomic.Pointer[map[string]AggregationStrategy] // 小写地址 -> 策略
+}
+
+// NewAggregationStrategies 创建策略表，注册内置的 default、scalper 和 swing（聚合超时为 swingWindow）
+func NewAggregationStrategies(swingWindow time.Duration) *AggregationStrategies {
+	s := &AggregationStrategies{strategies: make(map[string]AggregationStrategy)}
+	s.Register(defaultAggregation{})
+	s.Register(scalperAggregation{})
+	s.Register(swingAggregation{window: swingWindow})
+	return s
+}
+
+// Register 注册策略，同名策略被替换，已分配的地址在下一次 Replace 后生效
+func (s *AggregationStrategies) Register(strategy AggregationStrategy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.strategies[strategy.Name()] = strategy
+}
+
+// Replace 替换地址分配（地址 -> 策略名），策略名为空或未注册的地址使用默认策略
+func (s *AggregationStrategies) Replace(assignments map[string]string) {
+	s.mu.RLock()
+	addresses := make(map[string]AggregationStrategy, len(assignments))
+	for addr, name := range assignments {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || name == AggregationDefault {
+			continue
+		}
+		strategy, ok := s.strategies[name]
+		if !ok {
+			logger.Warn().Str("address", addr).Str("aggregation", name).
+				Msg("unknown aggregation strategy, using default")
+			continue
+		}
+		addresses[strings.ToLower(addr)] = strategy
+	}
+	s.mu.RUnlock()
+
+	s.addresses.Store(&addresses)
+}
+
+// For 获取地址使用的策略
+func (s *AggregationStrategies) For(address string) AggregationStrategy {
+	if s == nil {
+		return defaultAggregation{}
+	}
+	if addresses := s.addresses.Load(); addresses != nil {
+		if strategy, ok := (*addresses)[strings.ToLower(address)]; ok {
+			return strategy
+		}
+	}
+	return defaultAggregation{}
+}
@@ -0,0 +1,39 @@
+package processor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fixedWindowAggregation struct{}
+
+func (fixedWindowAggregation) Name() string                        { return "fixed" }
+func (fixedWindowAggregation) PerFill() bool                       { return false }
+func (fixedWindowAggregation) Timeout(time.Duration) time.Duration { return time.Second }
+
+func TestAggregationStrategies(t *testing.T) {
+	s := NewAggregationStrategies(time.Hour)
+	s.Register(fixedWindowAggregation{})
+	s.Replace(map[string]string{
+		"0xAAA": "Scalper",
+		"0xbbb": AggregationSwing,
+		"0xccc": "fixed",
+		"0xddd": "unknown",
+		"0xeee": "",
+	})
+
+	assert.True(t, s.For("0xaaa").PerFill())
+	assert.Equal(t, time.Hour, s.For("0xbbb").Timeout(5*time.Minute))
+	assert.Equal(t, 2*time.Hour, s.For("0xbbb").Timeout(2*time.Hour))
+	assert.Equal(t, time.Second, s.For("0xccc").Timeout(5*time.Minute))
+	for _, addr := range []string{"0xddd", "0xeee", "0xfff"} {
+		assert.Equal(t, AggregationDefault, s.For(addr).Name())
+		assert.Equal(t, 5*time.Minute, s.For(addr).Timeout(5*time.Minute))
+	}
+
+	// 未设置策略表时全部使用默认策略
+	var none *AggregationStrategies
+	assert.Equal(t, AggregationDefault, none.For("0xaaa").Name())
+}
@@ -0,0 +1,60 @@
+package processor
+
+import (
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// buffer 写入缓冲区，超过容量时按溢出策略丢弃
+// 同 dedupKey 覆盖不占用新容量
+func (w *BatchWriter) buffer(item BatchItem) {
+	key := item.DedupKey()
+	if _, ok := w.buffers.Load(key); ok || w.buffers.Len() < int64(w.config.MaxBufferSize) {
+		w.buffers.Store(key, item) // 直接覆盖，Len() 自动维护
+		w.overflowing.Store(false)
+		monitor.SetBatchWriteBuffered(int(w.buffers.Len()))
+		return
+	}
+
+	if !w.overflowing.Swap(true) {
+		logger.Error().Int("max_buffer_size", w.config.MaxBufferSize).Str("policy", w.config.OverflowPolicy).
+			Msg("batch writer buffer full, dropping writes")
+	}
+
+	if w.config.OverflowPolicy == OverflowDropNonCritical && isCriticalItem(item) {
+		if evicted, ok := w.evictNonCritical(); ok {
+			monitor.IncBatchWriteDropped(evicted.TableName(), "evicted")
+			w.buffers.Store(key, item)
+			return
+		}
+	}
+	monitor.IncBatchWriteDropped(item.TableName(), "buffer_full")
+}
+
+// evictNonCritical 淘汰一条非关键数据
+func (w *BatchWriter) evictNonCritical() (BatchItem, bool) {
+	var evictedKey string
+	var evicted BatchItem
+	w.buffers.Range(func(key string, item BatchItem) bool {
+		if isCriticalItem(item) {
+			return true
+		}
+		evictedKey, evicted = key, item
+		return false
+	})
+	if evicted == nil {
+		return nil, false
+	}
+	w.buffers.Delete(evictedKey)
+	return evicted, true
+}
+
+// isCriticalItem 订单聚合和信号记录丢失后无法重建，仓位缓存和原始成交可由快照/重连重放恢复
+func isCriticalItem(item BatchItem) bool {
+	switch item.(type) {
+	case OrderAggregationItem, SignalItem:
+		return true
+	default:
+		return false
+	}
+}
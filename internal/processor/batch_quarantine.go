@@ -0,0 +1,165 @@
+package processor
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/internal/dal"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// failedItem 写入失败的数据行
+type failedItem struct {
+	item BatchItem
+	err  error
+}
+
+// writeItems 批量写入，失败时二分重试以隔离毒数据行
+// 部分行写入成功说明失败由个别行导致，失败行直接隔离；
+// 全部行均失败时视为数据库不可用，返回需重试的行，超过重试次数后隔离；
+// 连接类错误（含熔断中）确定是数据库不可用，整批留在缓冲区，不计重试次数
+func (w *BatchWriter) writeItems(table string, items []BatchItem) []BatchItem {
+	err := w.upsert(table, items)
+	if err == nil {
+		logger.Debug().Str("table", table).Int("count", len(items)).Msg("batch upsert success")
+		w.clearRetries(items)
+		if w.unavailable.CompareAndSwap(true, false) {
+			logger.Info().Str("table", table).Int64("buffered", w.buffers.Len()).Msg("database available, flushing buffered writes")
+		}
+		return nil
+	}
+
+	if dal.IsUnavailable(err) {
+		if !w.unavailable.Swap(true) {
+			logger.Error().Err(err).Str("table", table).Msg("database unavailable, buffering writes in memory")
+		}
+		return items
+	}
+	w.unavailable.Store(false)
+
+	logger.Error().Err(err).Str("table", table).Int("count", len(items)).Msg("batch upsert failed, bisecting")
+	failed, succeeded := w.bisect(table, items, err)
+	w.clearSucceededRetries(items, failed)
+
+	var poison []failedItem
+	var retry []BatchItem
+	if succeeded > 0 {
+		poison = failed
+	} else {
+		for _, f := range failed {
+			key := f.item.DedupKey()
+			w.retries[key]++
+			if w.retries[key] >= w.config.MaxRetries {
+				poison = append(poison, f)
+				continue
+			}
+			retry = append(retry, f.item)
+		}
+	}
+
+	w.quarantine(table, poison)
+	if len(retry) > 0 {
+		logger.Warn().Str("table", table).Int("count", len(retry)).Msg("batch upsert failed for all rows, will retry")
+	}
+	return retry
+}
+
+// bisect 将失败的批次拆成两半分别重试，返回最终失败的单行和成功写入的行数
+func (w *BatchWriter) bisect(table string, items []BatchItem, err error) ([]failedItem, int) {
+	if len(items) == 1 {
+		return []failedItem{{item: items[0], err: err}}, 0
+	}
+
+	mid := len(items) / 2
+	var failed []failedItem
+	succeeded := 0
+	for _, half := range [][]BatchItem{items[:mid], items[mid:]} {
+		if err := w.upsert(table, half); err != nil {
+			f, s := w.bisect(table, half, err)
+			failed = append(failed, f...)
+			succeeded += s
+			continue
+		}
+		succeeded += len(half)
+	}
+
+	return failed, succeeded
+}
+
+// clearRetries 清除写入成功行的重试计数
+func (w *BatchWriter) clearRetries(items []BatchItem) {
+	for _, item := range items {
+		delete(w.retries, item.DedupKey())
+	}
+}
+
+// clearSucceededRetries 清除二分后写入成功行的重试计数
+func (w *BatchWriter) clearSucceededRetries(items []BatchItem, failed []failedItem) {
+	failedKeys := make(map[string]struct{}, len(failed))
+	for _, f := range failed {
+		failedKeys[f.item.DedupKey()] = struct{}{}
+	}
+	for _, item := range items {
+		if _, ok := failedKeys[item.DedupKey()]; !ok {
+			delete(w.retries, item.DedupKey())
+		}
+	}
+}
+
+// quarantine 将毒数据行写入 hl_failed_writes
+func (w *BatchWriter) quarantine(table string, failed []failedItem) {
+	if len(failed) == 0 {
+		return
+	}
+
+	now := time.Now()
+	rows := make([]*models.HlFailedWrite, 0, len(failed))
+	for _, f := range failed {
+		key := f.item.DedupKey()
+		row := &models.HlFailedWrite{
+			TargetTable: table,
+			DedupKey:    key,
+			Payload:     marshalBatchItem(f.item),
+			Attempts:    w.retries[key] + 1,
+			CreatedAt:   now,
+		}
+		if f.err != nil {
+			row.Error = f.err.Error()
+		}
+		rows = append(rows, row)
+		delete(w.retries, key)
+
+		logger.Warn().Err(f.err).Str("table", table).Str("key", key).Msg("batch write row quarantined")
+	}
+
+	monitor.AddBatchWriteQuarantined(table, len(rows))
+	if err := dao.FailedWrite().BatchCreate(rows); err != nil {
+		for _, row := range rows {
+			logger.Error().Err(err).Str("table", table).Str("key", row.DedupKey).
+				Str("payload", row.Payload).Msg("quarantine failed write failed")
+		}
+	}
+}
+
+// marshalBatchItem 序列化写入项，便于排查和人工回放
+func marshalBatchItem(item BatchItem) string {
+	var v any = item
+	if pos, ok := item.(PositionCacheItem); ok && pos.Cache != nil {
+		// HlPositionCache 的 JSON 字段在 json tag 中被忽略，需单独携带
+		v = positionCachePayload{
+			Address:          pos.Address,
+			Cache:            pos.Cache,
+			SpotBalances:     pos.Cache.SpotBalances,
+			FuturesPositions: pos.Cache.FuturesPositions,
+		}
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
@@ -0,0 +1,82 @@
+package processor
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// tuningSteps 加性调整的步数：每次加性调整移动上下限区间的 1/tuningSteps
+const tuningSteps = 20
+
+// batchTuner 按写入耗时和积压自适应调整批量大小和刷新间隔（AIMD）
+// 单批写入耗时超过目标：批量减半、间隔加倍，降低数据库压力；
+// 耗时在目标内且积压超过一批：批量加一步、间隔减一步，加快排空；
+// 积压不足一批时保持不变
+type batchTuner struct {
+	target      time.Duration
+	minSize     int
+	maxSize     int
+	minInterval time.Duration
+	maxInterval time.Duration
+
+	sizeStep     int
+	intervalStep time.Duration
+
+	size     atomic.Int64
+	interval atomic.Int64
+}
+
+func newBatchTuner(config *BatchWriterConfig) *batchTuner {
+	t := &batchTuner{
+		target:       config.TargetLatency,
+		minSize:      config.MinBatchSize,
+		maxSize:      config.MaxBatchSize,
+		minInterval:  config.MinFlushInterval,
+		maxInterval:  config.MaxFlushInterval,
+		sizeStep:     max(1, (config.MaxBatchSize-config.MinBatchSize)/tuningSteps),
+		intervalStep: max(time.Millisecond, (config.MaxFlushInterval-config.MinFlushInterval)/tuningSteps),
+	}
+	t.size.Store(int64(min(max(config.BatchSize, t.minSize), t.maxSize)))
+	t.interval.Store(int64(min(max(config.FlushInterval, t.minInterval), t.maxInterval)))
+	return t
+}
+
+// BatchSize 当前单批条数
+func (t *batchTuner) BatchSize() int {
+	return int(t.size.Load())
+}
+
+// FlushInterval 当前定时刷新间隔
+func (t *batchTuner) FlushInterval() time.Duration {
+	return time.Duration(t.interval.Load())
+}
+
+// observe 根据一次 flush 中最慢的分块耗时和本次观察到的积压条数调整参数（flushMu 内调用）
+func (t *batchTuner) observe(latency time.Duration, backlog int) {
+	size, interval := t.BatchSize(), t.FlushInterval()
+	newSize, newInterval := size, interval
+
+	switch {
+	case latency > t.target:
+		newSize = max(t.minSize, size/2)
+		newInterval = min(t.maxInterval, interval*2)
+	case backlog > size:
+		newSize = min(t.maxSize, size+t.sizeStep)
+		newInterval = max(t.minInterval, interval-t.intervalStep)
+	default:
+		return
+	}
+	if newSize == size && newInterval == interval {
+		return
+	}
+
+	t.size.Store(int64(newSize))
+	t.interval.Store(int64(newInterval))
+	monitor.SetBatchWriteTuning(newSize, newInterval)
+	logger.Debug().Dur("latency", latency).Int("backlog", backlog).
+		Int("batch_size", newSize).Dur("flush_interval", newInterval).
+		Msg("batch writer tuned")
+}
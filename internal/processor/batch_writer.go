@@ -1,14 +1,20 @@
 package processor
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/utrading/utrading-hl-monitor/internal/dao"
 	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/internal/watchdog"
 	"github.com/utrading/utrading-hl-monitor/pkg/concurrent"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
 	"github.com/utrading/utrading-hl-monitor/pkg/logger"
 )
 
@@ -49,11 +55,54 @@ func (i OrderAggregationItem) DedupKey() string {
 		i.Aggregation.Direction)
 }
 
+// FillItem 原始成交项
+type FillItem struct {
+	Fill *models.HlFill
+}
+
+func (i FillItem) TableName() string {
+	return "hl_fills"
+}
+
+func (i FillItem) DedupKey() string {
+	return fmt.Sprintf("fl:%d:%s", i.Fill.Tid, i.Fill.Address)
+}
+
+// SignalItem 信号记录项，仅在数据库不可用时由 OrderProcessor 转入缓冲，恢复后补写
+type SignalItem struct {
+	Signal *nats.HlAddressSignal
+}
+
+func (i SignalItem) TableName() string {
+	return "hl_address_signals"
+}
+
+func (i SignalItem) DedupKey() string {
+	return fmt.Sprintf("sg:%s:%s:%s", i.Signal.Scope, i.Signal.IdempotencyKey, i.Signal.TraceID)
+}
+
+// 缓冲区满时的处理策略
+const (
+	OverflowDropNew         = "drop_new"          // 丢弃新写入项
+	OverflowDropNonCritical = "drop_non_critical" // 优先淘汰可重建的数据（仓位缓存、原始成交），保留订单聚合和信号
+)
+
 // BatchWriterConfig 批量写入配置
 type BatchWriterConfig struct {
-	BatchSize     int           // 批量大小（默认 100）
-	FlushInterval time.Duration // 刷新间隔（默认 100ms）
-	MaxQueueSize  int           // 最大队列大小（默认 10000）
+	BatchSize      int           // 批量大小（默认 100）
+	FlushInterval  time.Duration // 刷新间隔（默认 100ms）
+	MaxQueueSize   int           // 最大队列大小（默认 10000）
+	MaxRetries     int           // 整批逐行均失败（疑似数据库不可用）时的最大重试次数，超过后隔离（默认 3）
+	MaxBufferSize  int           // 数据库不可用时内存缓冲的最大条数（默认 50000）
+	OverflowPolicy string        // 缓冲区满时的处理策略（默认 drop_non_critical）
+
+	// 自适应模式：按单批写入耗时和积压在上下限内调整 BatchSize 和 FlushInterval（二者作为初始值）
+	Adaptive         bool
+	TargetLatency    time.Duration // 单批写入目标耗时（默认 200ms）
+	MinBatchSize     int           // 默认 20
+	MaxBatchSize     int           // 默认 1000
+	MinFlushInterval time.Duration // 默认 200ms
+	MaxFlushInterval time.Duration // 默认 5s
 }
 
 // BatchWriter 批量写入器
@@ -65,6 +114,19 @@ type BatchWriter struct {
 	flushTick *time.Ticker
 	done      chan struct{}
 	wg        sync.WaitGroup
+
+	loopMu    sync.Mutex          // 保护 flushQuit
+	flushQuit chan struct{}       // 当前刷新协程的退出信号，看门狗重启时替换
+	flushBeat *watchdog.Heartbeat // 刷新协程心跳
+
+	flushMu sync.Mutex                                  // 串行化 flush（接收协程和定时协程都会触发）
+	upsert  func(table string, items []BatchItem) error // 批量写入实现（测试可替换）
+	retries map[string]int                              // 整批失败的重试次数，key: dedupKey
+
+	unavailable atomic.Bool // 最近一次写入因数据库不可用失败，期间只按定时刷新试探
+	overflowing atomic.Bool // 缓冲区已满，用于只在进入溢出时打印日志
+
+	tuner *batchTuner // 自适应调整（未开启时为 nil）
 }
 
 // NewBatchWriter 创建批量写入器
@@ -82,26 +144,100 @@ func NewBatchWriter(config *BatchWriterConfig) *BatchWriter {
 	if config.MaxQueueSize <= 0 {
 		config.MaxQueueSize = 10000
 	}
+	if config.MaxRetries <= 0 {
+		config.MaxRetries = 3
+	}
+	if config.MaxBufferSize <= 0 {
+		config.MaxBufferSize = 50000
+	}
+	if config.OverflowPolicy == "" {
+		config.OverflowPolicy = OverflowDropNonCritical
+	}
+	if config.Adaptive {
+		if config.TargetLatency <= 0 {
+			config.TargetLatency = 200 * time.Millisecond
+		}
+		if config.MinBatchSize <= 0 {
+			config.MinBatchSize = 20
+		}
+		if config.MaxBatchSize < config.MinBatchSize {
+			config.MaxBatchSize = max(1000, config.MinBatchSize)
+		}
+		if config.MinFlushInterval <= 0 {
+			config.MinFlushInterval = 200 * time.Millisecond
+		}
+		if config.MaxFlushInterval < config.MinFlushInterval {
+			config.MaxFlushInterval = max(5*time.Second, config.MinFlushInterval)
+		}
+	}
+
+	w := &BatchWriter{
+		config:    config,
+		queue:     make(chan BatchItem, config.MaxQueueSize),
+		buffers:   concurrent.Map[string, BatchItem]{},
+		done:      make(chan struct{}),
+		retries:   make(map[string]int),
+		flushBeat: watchdog.NewHeartbeat(),
+	}
+	w.upsert = w.batchUpsert
+	if config.Adaptive {
+		w.tuner = newBatchTuner(config)
+	}
+	monitor.SetBatchWriteTuning(w.batchSize(), w.flushInterval())
+	return w
+}
 
-	return &BatchWriter{
-		config:  config,
-		queue:   make(chan BatchItem, config.MaxQueueSize),
-		buffers: concurrent.Map[string, BatchItem]{},
-		done:    make(chan struct{}),
+// batchSize 当前单批条数
+func (w *BatchWriter) batchSize() int {
+	if w.tuner != nil {
+		return w.tuner.BatchSize()
 	}
+	return w.config.BatchSize
+}
+
+// flushInterval 当前定时刷新间隔
+func (w *BatchWriter) flushInterval() time.Duration {
+	if w.tuner != nil {
+		return w.tuner.FlushInterval()
+	}
+	return w.config.FlushInterval
 }
 
 // Start 启动批量写入器
 func (w *BatchWriter) Start() {
-	w.flushTick = time.NewTicker(w.config.FlushInterval)
+	w.flushTick = time.NewTicker(w.flushInterval())
 
 	// 启动接收协程
 	w.wg.Add(1)
 	go w.receiveLoop()
 
 	// 启动刷新协程
+	w.RestartFlushLoop()
+}
+
+// RestartFlushLoop 启动新的刷新协程，已有的刷新协程收到退出信号后退出
+// 看门狗发现刷新协程心跳停滞（阻塞或 panic 退出）时调用
+func (w *BatchWriter) RestartFlushLoop() {
+	w.loopMu.Lock()
+	defer w.loopMu.Unlock()
+
+	if w.flushQuit != nil {
+		close(w.flushQuit)
+	}
+	quit := make(chan struct{})
+	w.flushQuit = quit
+
 	w.wg.Add(1)
-	go w.flushLoop()
+	goplus.Go(func() {
+		w.flushLoop(quit)
+	})
+}
+
+// WatchdogComponents 返回由看门狗监控的后台循环
+func (w *BatchWriter) WatchdogComponents() []watchdog.Component {
+	return []watchdog.Component{
+		{Name: "batch_writer", Heartbeat: w.flushBeat, Restart: w.RestartFlushLoop},
+	}
 }
 
 func (w *BatchWriter) receiveLoop() {
@@ -109,31 +245,39 @@ func (w *BatchWriter) receiveLoop() {
 	for {
 		select {
 		case item := <-w.queue:
-			key := item.DedupKey()
-			w.buffers.Store(key, item) // 直接覆盖，Len() 自动维护
+			w.buffer(item)
 
-			// 检查是否达到批量大小
-			if w.buffers.Len() >= int64(w.config.BatchSize) {
+			// 检查是否达到批量大小，数据库不可用期间只由定时刷新试探
+			if !w.unavailable.Load() && w.buffers.Len() >= int64(w.batchSize()) {
 				w.flushAll()
 			}
 		case <-w.done:
 			// 处理队列中剩余的数据
 			for len(w.queue) > 0 {
-				item := <-w.queue
-				key := item.DedupKey()
-				w.buffers.Store(key, item)
+				w.buffer(<-w.queue)
 			}
 			return
 		}
 	}
 }
 
-func (w *BatchWriter) flushLoop() {
+// flushLoop 定时刷新，每次刷新后记录心跳，quit 关闭时退出（由新的刷新协程接替）
+func (w *BatchWriter) flushLoop(quit <-chan struct{}) {
 	defer w.wg.Done()
+	interval := w.flushInterval()
+	w.flushBeat.Beat()
 	for {
 		select {
 		case <-w.flushTick.C:
 			w.flushAll()
+			w.flushBeat.Beat()
+			// 自适应模式下刷新间隔可能已调整
+			if d := w.flushInterval(); d != interval {
+				interval = d
+				w.flushTick.Reset(d)
+			}
+		case <-quit:
+			return
 		case <-w.done:
 			w.flushAll()
 			return
@@ -147,6 +291,9 @@ func (w *BatchWriter) flush(tables ...string) {
 		return
 	}
 
+	w.flushMu.Lock()
+	defer w.flushMu.Unlock()
+
 	// 按 table 分组收集数据
 	grouped := make(map[string][]BatchItem)
 	var keysToDelete []string
@@ -165,19 +312,48 @@ func (w *BatchWriter) flush(tables ...string) {
 		return true
 	})
 
-	// 执行批量 upsert
+	// 按批量大小分块执行 upsert，失败时二分定位毒数据行
+	// 数据库不可用时剩余分块不再尝试，直接留在缓冲区
+	var retry []BatchItem
+	down := false
+	batchSize := w.batchSize()
+	total := 0
+	var slowest time.Duration
 	for table, items := range grouped {
-		if err := w.batchUpsert(table, items); err != nil {
-			logger.Error().Err(err).Str("table", table).Int("count", len(items)).Msg("batch upsert failed")
-		} else {
-			logger.Debug().Str("table", table).Int("count", len(items)).Msg("batch upsert success")
+		total += len(items)
+		for start := 0; start < len(items); start += batchSize {
+			chunk := items[start:min(start+batchSize, len(items))]
+			if down {
+				retry = append(retry, chunk...)
+				continue
+			}
+			began := time.Now()
+			retry = append(retry, w.writeItems(table, chunk)...)
+			down = w.unavailable.Load()
+			if !down {
+				elapsed := time.Since(began)
+				slowest = max(slowest, elapsed)
+				monitor.ObserveBatchWriteSize(len(chunk))
+				monitor.ObserveBatchWriteDuration(elapsed.Seconds())
+			}
 		}
 	}
 
+	// 数据库不可用时不调整，避免熔断期间的快速失败被当作低耗时
+	if w.tuner != nil && total > 0 && !down {
+		w.tuner.observe(slowest, total+len(w.queue))
+	}
+
 	// 删除已刷新的数据
 	for _, key := range keysToDelete {
 		w.buffers.Delete(key)
 	}
+
+	// 疑似数据库不可用的数据放回缓冲区，下次 flush 重试（不覆盖期间写入的新数据）
+	for _, item := range retry {
+		w.buffers.LoadOrStore(item.DedupKey(), item)
+	}
+	monitor.SetBatchWriteBuffered(int(w.buffers.Len()))
 }
 
 // flushAll 刷新所有表
@@ -196,6 +372,8 @@ func (w *BatchWriter) flushAll() {
 	tableList := []string{
 		"hl_position_cache",
 		"hl_order_aggregation",
+		"hl_fills",
+		"hl_address_signals",
 	}
 
 	w.flush(tableList...)
@@ -208,6 +386,10 @@ func (w *BatchWriter) batchUpsert(table string, items []BatchItem) error {
 		return w.batchUpsertPositions(items)
 	case "hl_order_aggregation":
 		return w.batchUpsertOrderAggregations(items)
+	case "hl_fills":
+		return w.batchInsertFills(items)
+	case "hl_address_signals":
+		return w.batchCreateSignals(items)
 	default:
 		logger.Warn().Str("table", table).Msg("unsupported table for batch upsert")
 		return nil // 不阻塞未知表
@@ -248,6 +430,39 @@ func (w *BatchWriter) batchUpsertOrderAggregations(items []BatchItem) error {
 	return dao.OrderAggregation().BatchUpsert(aggs)
 }
 
+// batchInsertFills 批量写入原始成交
+func (w *BatchWriter) batchInsertFills(items []BatchItem) error {
+	fills := make([]*models.HlFill, 0, len(items))
+	for _, item := range items {
+		if fill, ok := item.(FillItem); ok {
+			fills = append(fills, fill.Fill)
+		}
+	}
+
+	if len(fills) == 0 {
+		return nil
+	}
+
+	return dao.Fill().BatchInsert(fills)
+}
+
+// batchCreateSignals 批量补写信号记录
+func (w *BatchWriter) batchCreateSignals(items []BatchItem) error {
+	signals := make([]*nats.HlAddressSignal, 0, len(items))
+	for _, item := range items {
+		if sig, ok := item.(SignalItem); ok {
+			signals = append(signals, sig.Signal)
+		}
+	}
+
+	return dao.Signal().BatchCreate(signals)
+}
+
+// Backlog 返回尚未写入数据库的条数（队列 + 缓冲）
+func (w *BatchWriter) Backlog() int {
+	return len(w.queue) + int(w.buffers.Len())
+}
+
 // Add 添加写入项
 func (w *BatchWriter) Add(item BatchItem) error {
 	select {
@@ -268,6 +483,9 @@ func (w *BatchWriter) Stop() {
 
 	// 3. 刷新所有缓冲数据
 	w.flushAll()
+	if n := w.buffers.Len(); n > 0 {
+		logger.Error().Int64("items", n).Msg("batch writer stopped with unflushed items, database unavailable")
+	}
 
 	// 4. 停止定时器
 	if w.flushTick != nil {
@@ -275,6 +493,28 @@ func (w *BatchWriter) Stop() {
 	}
 }
 
+// StopAndDrain 停止写入器并写入队列与缓冲中的剩余数据，ctx 截止时不再等待
+// 缓冲按去重键合并，Drained 为关闭前待写入条数与剩余条数之差
+func (w *BatchWriter) StopAndDrain(ctx context.Context) DrainStats {
+	pending := len(w.queue) + int(w.buffers.Len())
+
+	done := make(chan struct{})
+	go func() {
+		w.Stop()
+		close(done)
+	}()
+
+	stats := DrainStats{}
+	select {
+	case <-done:
+	case <-ctx.Done():
+		stats.TimedOut = true
+	}
+	stats.Remaining = len(w.queue) + int(w.buffers.Len())
+	stats.Drained = max(pending-stats.Remaining, 0)
+	return stats
+}
+
 // GracefulShutdown 优雅关闭，带超时控制
 func (w *BatchWriter) GracefulShutdown(timeout time.Duration) error {
 	done := make(chan struct{})
@@ -10,9 +10,11 @@ import (
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 
-	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/dal"
 	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
 	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
 )
 
 func setupTestDB(t *testing.T) *gorm.DB {
@@ -424,3 +426,231 @@ func TestBatchWriter_FlushInterval(t *testing.T) {
 		t.Errorf("expected buffer to be flushed, got size %d", writer.buffers.Len())
 	}
 }
+
+func TestBatchWriter_QuarantinePoisonRow(t *testing.T) {
+	db := setupTestDB(t)
+	assert.NoError(t, db.AutoMigrate(&models.HlFailedWrite{}))
+	dao.InitDAO(db)
+
+	w := NewBatchWriter(&BatchWriterConfig{MaxRetries: 2})
+	poison := "0xquarantine_poison"
+	w.upsert = func(table string, items []BatchItem) error {
+		for _, item := range items {
+			if pos, ok := item.(PositionCacheItem); ok && pos.Address == poison {
+				return fmt.Errorf("bad row %s", pos.Address)
+			}
+		}
+		return w.batchUpsert(table, items)
+	}
+
+	for _, addr := range []string{"0xquarantine_a", poison, "0xquarantine_b", "0xquarantine_c"} {
+		w.buffers.Store("pc:"+addr, PositionCacheItem{
+			Address: addr,
+			Cache:   &models.HlPositionCache{Address: addr, SpotBalances: "[]", FuturesPositions: "[]"},
+		})
+	}
+	w.flushAll()
+
+	// 正常行写入成功
+	for _, addr := range []string{"0xquarantine_a", "0xquarantine_b", "0xquarantine_c"} {
+		_, err := dao.Position().GetPositionCache(addr)
+		assert.NoError(t, err, addr)
+	}
+
+	// 只有毒数据行被隔离，缓冲区已清空
+	var rows []models.HlFailedWrite
+	assert.NoError(t, db.Where("dedup_key LIKE ?", "pc:0xquarantine%").Find(&rows).Error)
+	if assert.Len(t, rows, 1) {
+		assert.Equal(t, "pc:"+poison, rows[0].DedupKey)
+		assert.Equal(t, "hl_position_cache", rows[0].TargetTable)
+		assert.Contains(t, rows[0].Payload, poison)
+		assert.Contains(t, rows[0].Error, "bad row")
+	}
+	assert.Equal(t, int64(0), w.buffers.Len())
+}
+
+func TestBatchWriter_RetryWhenAllRowsFail(t *testing.T) {
+	db := setupTestDB(t)
+	assert.NoError(t, db.AutoMigrate(&models.HlFailedWrite{}))
+	dao.InitDAO(db)
+
+	w := NewBatchWriter(&BatchWriterConfig{MaxRetries: 2})
+	down := true
+	w.upsert = func(table string, items []BatchItem) error {
+		if down {
+			return fmt.Errorf("connection refused")
+		}
+		return w.batchUpsert(table, items)
+	}
+
+	addr := "0xretry_transient"
+	w.buffers.Store("pc:"+addr, PositionCacheItem{
+		Address: addr,
+		Cache:   &models.HlPositionCache{Address: addr, SpotBalances: "[]", FuturesPositions: "[]"},
+	})
+
+	// 首次失败：数据保留在缓冲区等待重试
+	w.flushAll()
+	assert.Equal(t, int64(1), w.buffers.Len())
+
+	// 数据库恢复后写入成功
+	down = false
+	w.flushAll()
+	assert.Equal(t, int64(0), w.buffers.Len())
+	_, err := dao.Position().GetPositionCache(addr)
+	assert.NoError(t, err)
+
+	var count int64
+	assert.NoError(t, db.Model(&models.HlFailedWrite{}).Where("dedup_key = ?", "pc:"+addr).Count(&count).Error)
+	assert.Equal(t, int64(0), count)
+	assert.Empty(t, w.retries)
+
+	// 持续失败超过重试次数后隔离
+	down = true
+	addr2 := "0xretry_exhausted"
+	w.buffers.Store("pc:"+addr2, PositionCacheItem{
+		Address: addr2,
+		Cache:   &models.HlPositionCache{Address: addr2, SpotBalances: "[]", FuturesPositions: "[]"},
+	})
+	w.flushAll()
+	w.flushAll()
+	assert.Equal(t, int64(0), w.buffers.Len())
+	assert.NoError(t, db.Model(&models.HlFailedWrite{}).Where("dedup_key = ?", "pc:"+addr2).Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestBatchWriter_BufferWhileDBUnavailable(t *testing.T) {
+	db := setupTestDB(t)
+	assert.NoError(t, db.AutoMigrate(&models.HlFailedWrite{}, &models.HlAddressSignal{}))
+	dao.InitDAO(db)
+
+	w := NewBatchWriter(&BatchWriterConfig{MaxRetries: 1, BatchSize: 2})
+	down := true
+	calls := 0
+	w.upsert = func(table string, items []BatchItem) error {
+		calls++
+		if down {
+			return fmt.Errorf("upsert: %w", dal.ErrDBUnavailable)
+		}
+		return w.batchUpsert(table, items)
+	}
+
+	for i := 0; i < 5; i++ {
+		w.buffer(SignalItem{Signal: &nats.HlAddressSignal{
+			Address:        "0xbuffer_signal",
+			IdempotencyKey: fmt.Sprintf("idem-%d", i),
+			TraceID:        fmt.Sprintf("trace-%d", i),
+		}})
+	}
+
+	// 不可用期间不二分、不计重试、不隔离，剩余分块不再尝试
+	for i := 0; i < 3; i++ {
+		w.flushAll()
+	}
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, int64(5), w.buffers.Len())
+	assert.Empty(t, w.retries)
+	assert.True(t, w.unavailable.Load())
+	var count int64
+	assert.NoError(t, db.Model(&models.HlFailedWrite{}).Where("dedup_key LIKE ?", "sg:%").Count(&count).Error)
+	assert.Zero(t, count)
+
+	// 恢复后全部补写
+	down = false
+	w.flushAll()
+	assert.Equal(t, int64(0), w.buffers.Len())
+	assert.False(t, w.unavailable.Load())
+	assert.NoError(t, db.Model(&models.HlAddressSignal{}).Where("address = ?", "0xbuffer_signal").Count(&count).Error)
+	assert.Equal(t, int64(5), count)
+}
+
+func TestBatchWriter_BufferOverflow(t *testing.T) {
+	position := func(addr string) PositionCacheItem {
+		return PositionCacheItem{Address: addr, Cache: &models.HlPositionCache{Address: addr}}
+	}
+	order := func(oid int64) OrderAggregationItem {
+		return OrderAggregationItem{&models.OrderAggregation{Oid: oid, Address: "0xoverflow", Direction: "Open Long"}}
+	}
+
+	// drop_new：缓冲满后丢弃新数据，已有 key 仍可覆盖
+	w := NewBatchWriter(&BatchWriterConfig{MaxBufferSize: 2, OverflowPolicy: OverflowDropNew})
+	w.buffer(position("0xa"))
+	w.buffer(position("0xb"))
+	w.buffer(order(1))
+	w.buffer(position("0xa"))
+	assert.Equal(t, int64(2), w.buffers.Len())
+	_, ok := w.buffers.Load(order(1).DedupKey())
+	assert.False(t, ok)
+
+	// drop_non_critical：关键数据淘汰可重建数据，全部为关键数据时丢弃新数据
+	w = NewBatchWriter(&BatchWriterConfig{MaxBufferSize: 2})
+	w.buffer(position("0xa"))
+	w.buffer(order(1))
+	w.buffer(order(2))
+	w.buffer(position("0xb"))
+	w.buffer(order(3))
+	assert.Equal(t, int64(2), w.buffers.Len())
+	for _, item := range []BatchItem{order(1), order(2)} {
+		_, ok := w.buffers.Load(item.DedupKey())
+		assert.True(t, ok, item.DedupKey())
+	}
+}
+
+func TestBatchWriter_AdaptiveTuning(t *testing.T) {
+	w := NewBatchWriter(&BatchWriterConfig{
+		BatchSize:        100,
+		FlushInterval:    time.Second,
+		Adaptive:         true,
+		TargetLatency:    20 * time.Millisecond,
+		MinBatchSize:     10,
+		MaxBatchSize:     210,
+		MinFlushInterval: 100 * time.Millisecond,
+		MaxFlushInterval: 2100 * time.Millisecond,
+	})
+	var delay time.Duration
+	var chunks []int
+	w.upsert = func(table string, items []BatchItem) error {
+		chunks = append(chunks, len(items))
+		time.Sleep(delay)
+		return nil
+	}
+	fill := func(n int) {
+		for i := 0; i < n; i++ {
+			addr := fmt.Sprintf("0xadaptive_%d", i)
+			w.buffer(PositionCacheItem{Address: addr, Cache: &models.HlPositionCache{Address: addr}})
+		}
+	}
+
+	// 积压超过一批且耗时在目标内：加性增
+	fill(150)
+	w.flushAll()
+	assert.Equal(t, []int{100, 50}, chunks)
+	assert.Equal(t, 110, w.batchSize())
+	assert.Equal(t, 900*time.Millisecond, w.flushInterval())
+
+	// 积压不足一批：保持不变
+	fill(50)
+	w.flushAll()
+	assert.Equal(t, 110, w.batchSize())
+
+	// 超过目标耗时：乘性减
+	delay = 30 * time.Millisecond
+	fill(50)
+	w.flushAll()
+	assert.Equal(t, 55, w.batchSize())
+	assert.Equal(t, 1800*time.Millisecond, w.flushInterval())
+
+	// 不低于下限、不超过上限
+	for i := 0; i < 5; i++ {
+		fill(50)
+		w.flushAll()
+	}
+	assert.Equal(t, 10, w.batchSize())
+	assert.Equal(t, 2100*time.Millisecond, w.flushInterval())
+
+	// 未开启自适应时使用固定参数
+	w = NewBatchWriter(&BatchWriterConfig{BatchSize: 100, FlushInterval: time.Second})
+	assert.Nil(t, w.tuner)
+	assert.Equal(t, 100, w.batchSize())
+	assert.Equal(t, time.Second, w.flushInterval())
+}
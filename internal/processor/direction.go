@@ -0,0 +1,62 @@
+package processor
+
+import (
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+// 现货卖出的方向映射模式
+const (
+	SpotSellModeClose  = "close"  // 现货卖出一律视为平多（默认）
+	SpotSellModeDetect = "detect" // 按成交前余额判断：无余额时卖出为开空（杠杆现货），负余额时买入为平空
+)
+
+// SetSpotSellMode 设置现货卖出的方向映射模式（可选，默认 close）
+func (p *OrderProcessor) SetSpotSellMode(mode string) {
+	p.spotSellMode = mode
+}
+
+// mapDirection 将 Hyperliquid 成交方向映射为信号的 direction/side/asset_type
+func (p *OrderProcessor) mapDirection(agg *models.OrderAggregation) (direction, side, assetType string, ok bool) {
+	switch agg.Direction {
+	case "Open Long":
+		return "open", "LONG", "futures", true
+	case "Open Short":
+		return "open", "SHORT", "futures", true
+	case "Close Long":
+		return "close", "LONG", "futures", true
+	case "Close Short":
+		return "close", "SHORT", "futures", true
+	case "Buy":
+		if p.spotSellMode == SpotSellModeDetect {
+			if balance, known := p.spotStartBalance(agg); known && balance < 0 {
+				return "close", "SHORT", "spot", true
+			}
+		}
+		return "open", "LONG", "spot", true
+	case "Sell":
+		if p.spotSellMode == SpotSellModeDetect {
+			if balance, known := p.spotStartBalance(agg); known && balance <= 0 {
+				return "open", "SHORT", "spot", true
+			}
+		}
+		return "close", "LONG", "spot", true
+	default:
+		return "", "", "", false
+	}
+}
+
+// spotStartBalance 订单成交前的现货余额
+// 优先取首笔成交的 startPosition，缺失时取余额缓存（可能已包含本次成交）
+func (p *OrderProcessor) spotStartBalance(agg *models.OrderAggregation) (float64, bool) {
+	if len(agg.Fills) > 0 && agg.Fills[0].StartPosition != "" {
+		return cast.ToFloat64(agg.Fills[0].StartPosition), true
+	}
+	if p.positionBalanceCache == nil {
+		return 0, false
+	}
+	return p.positionBalanceCache.GetSpotBalance(agg.Address, strings.TrimSuffix(agg.Symbol, "USDC"))
+}
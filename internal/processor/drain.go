@@ -0,0 +1,29 @@
+package processor
+
+import (
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// DrainStats 关闭时排空队列的统计
+type DrainStats struct {
+	Drained   int  // 关闭期间处理完的条数
+	Remaining int  // 关闭结束时仍未处理的条数（已丢弃）
+	TimedOut  bool // 是否因排空截止时间到达而放弃
+}
+
+// ReportDrain 记录排空结果：日志及 shutdown 指标，有数据未处理时以错误级别记录
+func ReportDrain(component string, stats DrainStats) {
+	monitor.SetShutdownDrain(component, stats.Drained, stats.Remaining)
+
+	event := logger.Info()
+	if stats.Remaining > 0 {
+		event = logger.Error()
+	}
+	event.
+		Str("component", component).
+		Int("drained", stats.Drained).
+		Int("remaining", stats.Remaining).
+		Bool("timed_out", stats.TimedOut).
+		Msg("queue drained on shutdown")
+}
@@ -0,0 +1,234 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	hl "github.com/sonirico/go-hyperliquid"
+
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// 队列模式与部署角色
+const (
+	QueueModeMemory = "memory" // 进程内队列
+	QueueModeNATS   = "nats"   // NATS JetStream 工作队列
+
+	QueueRoleAll     = "all"     // 接入 + 处理
+	QueueRoleIngest  = "ingest"  // 仅接入 WS 并写入队列
+	QueueRoleProcess = "process" // 仅消费队列并处理
+)
+
+// Queue 消息队列接口（进程内 MessageQueue 或外部代理 ExternalQueue）
+type Queue interface {
+	Enqueue(msg Message) error
+	Stop()
+	StopAndDrain(ctx context.Context) DrainStats
+}
+
+var (
+	_ Queue = (*MessageQueue)(nil)
+	_ Queue = (*ExternalQueue)(nil)
+)
+
+// Broker 外部消息代理接口（NATS JetStream 工作队列、Kafka topic 等）
+// 同一分区内的消息需按发布顺序投递，handler 返回错误时消息应重新投递
+type Broker interface {
+	Publish(partition int, data []byte) error
+	Subscribe(partitions []int, handler func(data []byte) error) error
+	Close() error
+}
+
+// ExternalQueue 外部代理支撑的消息队列
+// 接入层（WS）将消息按地址哈希写入分区，处理层消费指定分区，
+// 同一地址的消息固定路由到同一分区，保证按地址有序
+type ExternalQueue struct {
+	broker     Broker
+	partitions int
+	handler    MessageHandler
+}
+
+// NewExternalQueue 创建外部消息队列
+func NewExternalQueue(broker Broker, partitions int) *ExternalQueue {
+	if partitions <= 0 {
+		partitions = 1
+	}
+	return &ExternalQueue{
+		broker:     broker,
+		partitions: partitions,
+	}
+}
+
+// SetHandler 设置消息处理器（处理层使用）
+func (q *ExternalQueue) SetHandler(handler MessageHandler) {
+	q.handler = handler
+}
+
+// Start 开始消费指定分区，partitions 为空时消费全部分区
+func (q *ExternalQueue) Start(partitions []int) error {
+	if q.handler == nil {
+		return fmt.Errorf("external queue handler not set")
+	}
+	if len(partitions) == 0 {
+		partitions = make([]int, q.partitions)
+		for i := range partitions {
+			partitions[i] = i
+		}
+	}
+
+	logger.Info().Ints("partitions", partitions).Msg("external queue consuming")
+	return q.broker.Subscribe(partitions, q.handle)
+}
+
+// Enqueue 序列化消息并写入地址对应的分区
+func (q *ExternalQueue) Enqueue(msg Message) error {
+	data, err := EncodeMessage(msg)
+	if err != nil {
+		return err
+	}
+	return q.broker.Publish(q.Partition(messageAddress(msg)), data)
+}
+
+// Partition 计算地址所在分区
+func (q *ExternalQueue) Partition(address string) int {
+	return partitionOf(address, q.partitions)
+}
+
+// Stop 关闭代理连接
+func (q *ExternalQueue) Stop() {
+	if err := q.broker.Close(); err != nil {
+		logger.Error().Err(err).Msg("close external queue broker failed")
+	}
+}
+
+// StopAndDrain 关闭代理连接，未确认的消息由代理重新投递，无需在本地排空
+func (q *ExternalQueue) StopAndDrain(context.Context) DrainStats {
+	q.Stop()
+	return DrainStats{}
+}
+
+// handle 反序列化并处理消息，无法解析的消息直接丢弃，避免阻塞分区
+func (q *ExternalQueue) handle(data []byte) error {
+	msg, err := DecodeMessage(data)
+	if err != nil {
+		logger.Error().Err(err).Int("size", len(data)).Msg("decode queue message failed, dropped")
+		return nil
+	}
+	return q.handler.HandleMessage(msg)
+}
+
+// queueEnvelope 外部队列消息封装
+type queueEnvelope struct {
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// orderFillPayload OrderFillMessage 的序列化格式（Fill 需要具体类型）
+type orderFillPayload struct {
+	Address   string          `json:"address"`
+	Fill      hl.WsOrderFill  `json:"fill"`
+	Direction string          `json:"direction"`
+	Reversed  *hl.WsOrderFill `json:"reversed,omitempty"`
+}
+
+// positionCachePayload PositionUpdateMessage 的序列化格式
+// HlPositionCache 的 JSON 字段在 json tag 中被忽略，需单独携带
+type positionCachePayload struct {
+	Address          string                  `json:"address"`
+	Cache            *models.HlPositionCache `json:"cache"`
+	SpotBalances     string                  `json:"spot_balances"`
+	FuturesPositions string                  `json:"futures_positions"`
+}
+
+// EncodeMessage 序列化队列消息
+func EncodeMessage(msg Message) ([]byte, error) {
+	var payload any
+	switch m := msg.(type) {
+	case OrderFillMessage:
+		fill, ok := m.Fill.(hl.WsOrderFill)
+		if !ok {
+			return nil, fmt.Errorf("invalid fill type %T", m.Fill)
+		}
+		p := orderFillPayload{Address: m.Address, Fill: fill, Direction: m.Direction}
+		if reversed, ok := m.Reversed.(hl.WsOrderFill); ok {
+			p.Reversed = &reversed
+		}
+		payload = p
+	case OrderUpdateMessage:
+		payload = m
+	case PositionUpdateMessage:
+		data, ok := m.Data.(*PositionCacheData)
+		if !ok {
+			return nil, fmt.Errorf("invalid position data type %T", m.Data)
+		}
+		p := positionCachePayload{Address: m.Address, Cache: data.Cache}
+		if data.Cache != nil {
+			p.SpotBalances = data.Cache.SpotBalances
+			p.FuturesPositions = data.Cache.FuturesPositions
+		}
+		payload = p
+	default:
+		return nil, fmt.Errorf("unsupported message type %s", msg.Type())
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(queueEnvelope{Type: msg.Type(), Payload: raw})
+}
+
+// DecodeMessage 反序列化队列消息
+func DecodeMessage(data []byte) (Message, error) {
+	var env queueEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, err
+	}
+
+	switch env.Type {
+	case OrderFillMessage{}.Type():
+		var p orderFillPayload
+		if err := json.Unmarshal(env.Payload, &p); err != nil {
+			return nil, err
+		}
+		msg := OrderFillMessage{Address: p.Address, Fill: p.Fill, Direction: p.Direction}
+		if p.Reversed != nil {
+			msg.Reversed = *p.Reversed
+		}
+		return msg, nil
+	case OrderUpdateMessage{}.Type():
+		var m OrderUpdateMessage
+		if err := json.Unmarshal(env.Payload, &m); err != nil {
+			return nil, err
+		}
+		return m, nil
+	case PositionUpdateMessage{}.Type():
+		var p positionCachePayload
+		if err := json.Unmarshal(env.Payload, &p); err != nil {
+			return nil, err
+		}
+		if p.Cache != nil {
+			p.Cache.SpotBalances = p.SpotBalances
+			p.Cache.FuturesPositions = p.FuturesPositions
+		}
+		return NewPositionCacheMessage(p.Address, p.Cache), nil
+	default:
+		return nil, fmt.Errorf("unsupported message type %s", env.Type)
+	}
+}
+
+// messageAddress 获取消息的分区键（地址）
+func messageAddress(msg Message) string {
+	switch m := msg.(type) {
+	case OrderFillMessage:
+		return m.Address
+	case OrderUpdateMessage:
+		return m.Address
+	case PositionUpdateMessage:
+		return m.Address
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,156 @@
+package processor
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+// memoryBroker 内存消息代理，按分区顺序同步投递
+type memoryBroker struct {
+	mu         sync.Mutex
+	handler    func([]byte) error
+	partitions map[int]bool
+	published  map[int][][]byte
+	closed     bool
+}
+
+func newMemoryBroker() *memoryBroker {
+	return &memoryBroker{
+		partitions: make(map[int]bool),
+		published:  make(map[int][][]byte),
+	}
+}
+
+func (b *memoryBroker) Publish(partition int, data []byte) error {
+	b.mu.Lock()
+	b.published[partition] = append(b.published[partition], data)
+	handler, consumed := b.handler, b.partitions[partition]
+	b.mu.Unlock()
+
+	if handler != nil && consumed {
+		return handler(data)
+	}
+	return nil
+}
+
+func (b *memoryBroker) Subscribe(partitions []int, handler func([]byte) error) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, p := range partitions {
+		b.partitions[p] = true
+	}
+	b.handler = handler
+	return nil
+}
+
+func (b *memoryBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	return nil
+}
+
+// failingHandler 总是返回错误的处理器
+type failingHandler struct{}
+
+func (failingHandler) HandleMessage(Message) error { return errors.New("fail") }
+
+func TestEncodeDecodeMessage(t *testing.T) {
+	t.Run("OrderFill", func(t *testing.T) {
+		msg := OrderFillMessage{
+			Address:   "0xabc",
+			Fill:      hl.WsOrderFill{Coin: "BTC", Px: "100", Sz: "1", Side: "B", Oid: 42, Tid: 7},
+			Direction: "Open Long",
+			Reversed:  hl.WsOrderFill{Coin: "BTC", Px: "100", Sz: "3", Side: "B", Oid: 42, Tid: 7, Dir: "Short > Long"},
+		}
+		data, err := EncodeMessage(msg)
+		require.NoError(t, err)
+
+		got, err := DecodeMessage(data)
+		require.NoError(t, err)
+		assert.Equal(t, msg, got)
+	})
+
+	t.Run("OrderUpdate", func(t *testing.T) {
+		msg := OrderUpdateMessage{Address: "0xabc", Oid: 42, Status: "filled"}
+		data, err := EncodeMessage(msg)
+		require.NoError(t, err)
+
+		got, err := DecodeMessage(data)
+		require.NoError(t, err)
+		assert.Equal(t, msg, got)
+	})
+
+	t.Run("PositionCache", func(t *testing.T) {
+		cache := &models.HlPositionCache{
+			Address:          "0xabc",
+			SpotBalances:     `[{"coin":"USDC","total":"10"}]`,
+			FuturesPositions: `[{"coin":"BTC","szi":"1"}]`,
+			AccountValue:     "100",
+		}
+		data, err := EncodeMessage(NewPositionCacheMessage("0xabc", cache))
+		require.NoError(t, err)
+
+		got, err := DecodeMessage(data)
+		require.NoError(t, err)
+		pm, ok := got.(PositionUpdateMessage)
+		require.True(t, ok)
+		decoded := pm.Data.(*PositionCacheData).Cache
+		assert.Equal(t, cache.SpotBalances, decoded.SpotBalances)
+		assert.Equal(t, cache.FuturesPositions, decoded.FuturesPositions)
+		assert.Equal(t, cache.AccountValue, decoded.AccountValue)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		_, err := EncodeMessage(errorMessage{})
+		assert.Error(t, err)
+
+		_, err = DecodeMessage([]byte(`{"type":"unknown","payload":{}}`))
+		assert.Error(t, err)
+	})
+}
+
+func TestExternalQueue_Partition(t *testing.T) {
+	q := NewExternalQueue(newMemoryBroker(), 16)
+
+	p := q.Partition("0xabc")
+	assert.Equal(t, p, q.Partition("0xabc"), "同一地址固定分区")
+	assert.GreaterOrEqual(t, p, 0)
+	assert.Less(t, p, 16)
+}
+
+func TestExternalQueue_EnqueueAndConsume(t *testing.T) {
+	broker := newMemoryBroker()
+	q := NewExternalQueue(broker, 4)
+
+	// 未设置 handler 时不能启动消费
+	assert.Error(t, q.Start(nil))
+
+	handler := newMockHandler()
+	q.SetHandler(handler)
+	require.NoError(t, q.Start(nil))
+
+	msg := OrderUpdateMessage{Address: "0xabc", Oid: 1, Status: "filled"}
+	require.NoError(t, q.Enqueue(msg))
+	assert.Len(t, broker.published[q.Partition("0xabc")], 1)
+
+	require.Equal(t, 1, handler.CallCount())
+	assert.Equal(t, msg, handler.calls[0])
+
+	// 无法解析的消息被丢弃，不阻塞分区
+	assert.NoError(t, q.handle([]byte("not json")))
+
+	// handler 错误需返回给代理以便重新投递
+	q.SetHandler(failingHandler{})
+	assert.Error(t, q.Enqueue(msg))
+
+	q.Stop()
+	assert.True(t, broker.closed)
+}
@@ -0,0 +1,74 @@
+package processor
+
+import (
+	"context"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// 杠杆查询的超时和失败后的冷却时间，冷却期内同一地址合约不再查询
+const (
+	leverageLookupTimeout  = 2 * time.Second
+	leverageLookupCooldown = time.Minute
+)
+
+// LeverageSource 查询交易者在合约上的杠杆设置（由 hl.Info 实现）
+type LeverageSource interface {
+	UserActiveAssetData(ctx context.Context, address string, coin string) (*hl.UserActiveAssetData, error)
+}
+
+// SetLeverageSource 设置杠杆查询（可选）
+// 仓位缓存中没有该合约的杠杆（首次开仓且 webData2 尚未更新）时通过 activeAssetData 查询，
+// 会增加信号发送延迟并占用 REST 请求额度
+func (p *OrderProcessor) SetLeverageSource(source LeverageSource) {
+	p.leverageSource = source
+}
+
+// applyLeverage 合约信号附带交易者的杠杆倍数和保证金模式，未知时不附带
+func (p *OrderProcessor) applyLeverage(signal *nats.HlAddressSignal, coin string) {
+	if p.positionBalanceCache == nil {
+		return
+	}
+	leverage, ok := p.positionBalanceCache.GetLeverage(signal.Address, signal.Symbol)
+	if !ok {
+		leverage, ok = p.lookupLeverage(signal.Address, signal.Symbol, coin)
+	}
+	if !ok {
+		return
+	}
+	signal.Leverage = leverage.Value
+	signal.MarginMode = leverage.Type
+}
+
+// lookupLeverage 通过 activeAssetData 查询杠杆设置，成功时写入仓位缓存
+func (p *OrderProcessor) lookupLeverage(address, symbol, coin string) (models.LeverageItem, bool) {
+	if p.leverageSource == nil {
+		return models.LeverageItem{}, false
+	}
+	key := address + "|" + symbol
+	if failedAt, ok := p.leverageFailures.Load(key); ok && time.Since(failedAt) < leverageLookupCooldown {
+		return models.LeverageItem{}, false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), leverageLookupTimeout)
+	defer cancel()
+	data, err := p.leverageSource.UserActiveAssetData(ctx, address, coin)
+	if err != nil || data.Leverage.Value <= 0 {
+		p.leverageFailures.Store(key, time.Now())
+		logger.Debug().Err(err).
+			Str("address", address).
+			Str("coin", coin).
+			Msg("lookup leverage failed")
+		return models.LeverageItem{}, false
+	}
+	p.leverageFailures.Delete(key)
+
+	leverage := models.LeverageItem{Type: data.Leverage.Type, Value: data.Leverage.Value}
+	p.positionBalanceCache.SetLeverage(address, symbol, leverage)
+	return leverage, true
+}
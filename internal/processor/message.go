@@ -10,6 +10,7 @@ type OrderFillMessage struct {
 	Address   string
 	Fill      interface{} // hl.WsOrderFill
 	Direction string      // "Open Long", "Close Short" 等
+	Reversed  interface{} // 反手成交拆分前的原始成交（hl.WsOrderFill），仅拆分出的成交设置，hl_fills 按它落库
 }
 
 func (m OrderFillMessage) Type() string { return "order_fill" }
@@ -20,6 +21,7 @@ type OrderUpdateMessage struct {
 	Oid       int64
 	Status    string
 	Direction string // 可选，为空时遍历所有方向
+	OrigSz    string // 订单原始数量（可选），用于判断是否已完全成交
 }
 
 func (m OrderUpdateMessage) Type() string { return "order_update" }
@@ -1,85 +1,174 @@
 package processor
 
 import (
+	"context"
+	"errors"
+	"hash/fnv"
 	"sync"
+	"sync/atomic"
 
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
 	"github.com/utrading/utrading-hl-monitor/pkg/logger"
 )
 
+// ErrQueueStopped 队列已停止
+var ErrQueueStopped = errors.New("message queue stopped")
+
 // MessageHandler 消息处理器接口
 type MessageHandler interface {
 	HandleMessage(msg Message) error
 }
 
 // MessageQueue 异步消息队列
+// 消息按地址哈希分配到串行通道（lane），每个通道一个工作协程：
+// 不同地址并行处理，同一地址的成交和状态更新严格按入队顺序处理
 type MessageQueue struct {
-	queue   chan Message
-	wg      sync.WaitGroup
-	handler MessageHandler
-	done    chan struct{}
+	lanes    []chan Message
+	wg       sync.WaitGroup
+	handler  MessageHandler
+	done     chan struct{}
+	drainCtx context.Context // 非空时停止后继续处理通道内剩余消息，直到截止
+	drained  atomic.Int64
 }
 
-// NewMessageQueue 创建消息队列
+// NewMessageQueue 创建单通道消息队列
 func NewMessageQueue(size int, handler MessageHandler) *MessageQueue {
+	return NewMessageQueueWithLanes(size, 1, handler)
+}
+
+// NewMessageQueueWithLanes 创建多通道消息队列，size 为各通道容量之和
+func NewMessageQueueWithLanes(size, lanes int, handler MessageHandler) *MessageQueue {
 	if size <= 0 {
 		size = 10000
 	}
-	return &MessageQueue{
-		queue:   make(chan Message, size),
+	if lanes <= 0 {
+		lanes = 1
+	}
+	perLane := size / lanes
+	if perLane < 1 {
+		perLane = 1
+	}
+
+	q := &MessageQueue{
+		lanes:   make([]chan Message, lanes),
 		handler: handler,
 		done:    make(chan struct{}),
 	}
+	for i := range q.lanes {
+		q.lanes[i] = make(chan Message, perLane)
+	}
+	return q
 }
 
-// Start 启动工作协程
+// Start 启动工作协程，每个通道一个
 func (q *MessageQueue) Start() {
-	q.wg.Add(1)
-	go q.worker()
+	for _, lane := range q.lanes {
+		q.wg.Add(1)
+		go q.worker(lane)
+	}
 }
 
-func (q *MessageQueue) worker() {
+func (q *MessageQueue) worker(lane chan Message) {
 	defer q.wg.Done()
 	for {
 		select {
-		case msg := <-q.queue:
+		case msg := <-lane:
 			if err := q.handler.HandleMessage(msg); err != nil {
 				logger.Error().Err(err).Str("type", msg.Type()).Msg("handle message failed")
 			}
 		case <-q.done:
+			q.drainLane(lane)
+			return
+		}
+	}
+}
+
+// drainLane 停止后处理通道内剩余消息，截止时间到达时放弃
+func (q *MessageQueue) drainLane(lane chan Message) {
+	if q.drainCtx == nil {
+		return
+	}
+	for q.drainCtx.Err() == nil {
+		select {
+		case msg := <-lane:
+			if err := q.handler.HandleMessage(msg); err != nil {
+				logger.Error().Err(err).Str("type", msg.Type()).Msg("handle message failed")
+			}
+			q.drained.Add(1)
+		default:
 			return
 		}
 	}
 }
 
 // Enqueue 发送消息（带背压策略）
+// 通道满时阻塞等待而不是同步处理，避免同一地址的消息乱序
 func (q *MessageQueue) Enqueue(msg Message) error {
+	lane := q.lanes[q.Lane(messageAddress(msg))]
 	select {
-	case q.queue <- msg:
+	case lane <- msg:
 		return nil
 	default:
-		// 队列满，启用同步降级策略
-		logger.Warn().
-			Str("type", msg.Type()).
-			Int("queue_size", len(q.queue)).
-			Msg("message queue full, falling back to sync processing")
-
-		// 同步处理消息（阻塞调用）
-		return q.handler.HandleMessage(msg)
+	}
+
+	monitor.IncMessageQueueFull()
+	logger.Warn().
+		Str("type", msg.Type()).
+		Int("lane_size", len(lane)).
+		Msg("message queue lane full, waiting")
+
+	select {
+	case lane <- msg:
+		return nil
+	case <-q.done:
+		return ErrQueueStopped
 	}
 }
 
-// Stop 停止队列
+// Lane 计算地址所在通道
+func (q *MessageQueue) Lane(address string) int {
+	return partitionOf(address, len(q.lanes))
+}
+
+// Stop 停止队列，通道内剩余消息被丢弃
 func (q *MessageQueue) Stop() {
 	close(q.done)
 	q.wg.Wait()
 }
 
+// StopAndDrain 停止接收新消息，并在 ctx 截止前处理完通道内剩余消息
+func (q *MessageQueue) StopAndDrain(ctx context.Context) DrainStats {
+	q.drainCtx = ctx
+	close(q.done)
+	q.wg.Wait()
+
+	return DrainStats{
+		Drained:   int(q.drained.Load()),
+		Remaining: q.Size(),
+		TimedOut:  ctx.Err() != nil,
+	}
+}
+
 // SetHandler 设置消息处理器
 func (q *MessageQueue) SetHandler(handler MessageHandler) {
 	q.handler = handler
 }
 
-// Size 返回当前队列大小
+// Size 返回当前队列大小（各通道之和）
 func (q *MessageQueue) Size() int {
-	return len(q.queue)
+	size := 0
+	for _, lane := range q.lanes {
+		size += len(lane)
+	}
+	return size
+}
+
+// partitionOf 按地址哈希选择分区或通道
+func partitionOf(address string, n int) int {
+	if n <= 1 {
+		return 0
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(address))
+	return int(h.Sum32() % uint32(n))
 }
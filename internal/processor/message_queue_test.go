@@ -1,6 +1,7 @@
 package processor
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"testing"
@@ -65,6 +66,40 @@ func TestMessageQueue_Enqueue(t *testing.T) {
 	assert.Equal(t, 1, handler.CallCount())
 }
 
+func TestMessageQueue_StopAndDrain(t *testing.T) {
+	handler := newMockHandler()
+	handler.delays["position_update"] = 10
+	q := NewMessageQueue(10, handler)
+	q.Start()
+
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, q.Enqueue(PositionUpdateMessage{Address: "test"}))
+	}
+
+	stats := q.StopAndDrain(context.Background())
+	assert.Equal(t, 5, handler.CallCount())
+	assert.Equal(t, 0, stats.Remaining)
+	assert.False(t, stats.TimedOut)
+}
+
+func TestMessageQueue_StopAndDrainDeadline(t *testing.T) {
+	handler := newMockHandler()
+	handler.delays["position_update"] = 50
+	q := NewMessageQueue(10, handler)
+	q.Start()
+
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, q.Enqueue(PositionUpdateMessage{Address: "test"}))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	stats := q.StopAndDrain(ctx)
+	assert.True(t, stats.TimedOut)
+	assert.Greater(t, stats.Remaining, 0)
+	assert.Equal(t, 5, handler.CallCount()+stats.Remaining)
+}
+
 func TestMessageQueue_Backpressure(t *testing.T) {
 	handler := newMockHandler()
 	handler.delays["order_fill"] = 100 // 慢处理
@@ -78,7 +113,7 @@ func TestMessageQueue_Backpressure(t *testing.T) {
 		_ = q.Enqueue(msg)
 	}
 
-	// 队列应该已处理（因为队列满时降级为同步处理）
+	// 队列满时阻塞等待，消息最终都会被处理
 	assert.GreaterOrEqual(t, handler.CallCount(), 1)
 }
 
@@ -96,6 +131,81 @@ func TestMessageQueue_ErrorHandling(t *testing.T) {
 	assert.GreaterOrEqual(t, handler.CallCount(), 1)
 }
 
+// laneHandler 记录每个地址的处理顺序和最大并发数
+type laneHandler struct {
+	mu      sync.Mutex
+	seq     map[string][]int64
+	running map[string]bool
+	active  int
+	peak    int
+	raced   bool
+}
+
+func (h *laneHandler) HandleMessage(msg Message) error {
+	m := msg.(OrderUpdateMessage)
+
+	h.mu.Lock()
+	if h.running[m.Address] {
+		h.raced = true
+	}
+	h.running[m.Address] = true
+	h.active++
+	if h.active > h.peak {
+		h.peak = h.active
+	}
+	h.mu.Unlock()
+
+	time.Sleep(time.Millisecond)
+
+	h.mu.Lock()
+	h.seq[m.Address] = append(h.seq[m.Address], m.Oid)
+	h.running[m.Address] = false
+	h.active--
+	h.mu.Unlock()
+	return nil
+}
+
+func TestMessageQueue_Lanes(t *testing.T) {
+	handler := &laneHandler{seq: make(map[string][]int64), running: make(map[string]bool)}
+	q := NewMessageQueueWithLanes(8, 4, handler) // 小容量，覆盖通道满时阻塞等待
+	q.Start()
+
+	addresses := []string{"0xa", "0xb", "0xc", "0xd", "0xe", "0xf"}
+	for i := int64(0); i < 20; i++ {
+		for _, addr := range addresses {
+			assert.NoError(t, q.Enqueue(OrderUpdateMessage{Address: addr, Oid: i}))
+		}
+	}
+
+	assert.Eventually(t, func() bool {
+		handler.mu.Lock()
+		defer handler.mu.Unlock()
+		total := 0
+		for _, seq := range handler.seq {
+			total += len(seq)
+		}
+		return total == 20*len(addresses)
+	}, 5*time.Second, 10*time.Millisecond)
+	q.Stop()
+
+	// 同一地址串行且有序，不同地址并行
+	assert.False(t, handler.raced)
+	assert.Greater(t, handler.peak, 1)
+	for _, addr := range addresses {
+		seq := handler.seq[addr]
+		for i := range seq {
+			assert.Equal(t, int64(i), seq[i], addr)
+		}
+	}
+	assert.Equal(t, q.Lane("0xa"), q.Lane("0xa"))
+
+	// 停止后通道满时返回错误而不是阻塞
+	for i := 0; i < 8; i++ {
+		_ = q.Enqueue(OrderUpdateMessage{Address: "0xa"})
+	}
+	assert.ErrorIs(t, q.Enqueue(OrderUpdateMessage{Address: "0xa"}), ErrQueueStopped)
+}
+
 // T045: 消息队列性能基准测试
 
 func BenchmarkMessageQueue_Enqueue(b *testing.B) {
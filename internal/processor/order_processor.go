@@ -1,28 +1,62 @@
 package processor
 
 import (
+	"context"
 	"fmt"
+	"math"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/panjf2000/ants/v2"
 	hl "github.com/sonirico/go-hyperliquid"
 	"github.com/spf13/cast"
 	"github.com/utrading/utrading-hl-monitor/internal/cache"
+	"github.com/utrading/utrading-hl-monitor/internal/dal"
 	"github.com/utrading/utrading-hl-monitor/internal/dao"
 	"github.com/utrading/utrading-hl-monitor/internal/models"
 	"github.com/utrading/utrading-hl-monitor/internal/monitor"
 	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/internal/watchdog"
 	"github.com/utrading/utrading-hl-monitor/pkg/concurrent"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
 	"github.com/utrading/utrading-hl-monitor/pkg/logger"
 )
 
+// flushHeartbeatInterval 发送循环空闲时的心跳间隔
+const flushHeartbeatInterval = 10 * time.Second
+
 // Publisher NATS 发布接口
 type Publisher interface {
 	PublishAddressSignal(signal *nats.HlAddressSignal) error
 }
 
+// allDirections 订单聚合的全部方向
+var allDirections = []string{"Open Long", "Open Short", "Close Long", "Close Short", "Buy", "Sell"}
+
+// LeaderChecker 主备角色查询接口（热备模式下由 leader.Elector 实现）
+type LeaderChecker interface {
+	IsLeader() bool
+}
+
+// Valuer 估值接口（由 valuation.Valuer 实现）
+type Valuer interface {
+	Quote() string
+	ToQuote(usd float64) (float64, bool)
+}
+
+// AddressLabeler 地址标签查询接口（由 addressmeta.Resolver 实现）
+type AddressLabeler interface {
+	Label(address string) string
+}
+
+// SignalEnqueuer 信号发件箱写入接口（由 SignalOutbox 实现）
+type SignalEnqueuer interface {
+	Enqueue(agg *models.OrderAggregation, signals []*nats.HlAddressSignal) error
+}
+
 // PendingOrderCache 待处理订单缓存
 // 使用 concurrent.Map 实现线程安全的短期暂存
 type PendingOrderCache struct {
@@ -69,25 +103,81 @@ func (c *PendingOrderCache) Clear() {
 	c.orders.Clear()
 }
 
+// 待处理订单的内存估算：聚合记录、去重 map 等固定开销，以及每笔成交（含字符串字段与 tid 去重项）
+const (
+	pendingOrderOverhead = 1024
+	pendingFillBytes     = 512
+)
+
+// GetStats 获取统计信息（订单数、成交数、最早订单、内存估算）
+func (c *PendingOrderCache) GetStats() map[string]any {
+	now := time.Now()
+	var orders, fills int64
+	var oldest time.Time
+	c.orders.Range(func(_ string, order *PendingOrder) bool {
+		orders++
+		fills += order.seenTids.Len()
+		if oldest.IsZero() || order.FirstFillTime.Before(oldest) {
+			oldest = order.FirstFillTime
+		}
+		return true
+	})
+	return map[string]any{
+		"entries":            orders,
+		"fills":              fills,
+		"memory_bytes":       orders*pendingOrderOverhead + fills*pendingFillBytes,
+		"oldest_age_seconds": cache.AgeSeconds(oldest, now),
+	}
+}
+
 // PendingOrder 待处理订单
 type PendingOrder struct {
 	seenTids             concurrent.Map[int64, struct{}] // tid 去重
+	oids                 concurrent.Map[int64, struct{}] // 聚合包含的订单 ID，按 cloid 聚合时可能有多个
+	lastFill             atomic.Int64                    // 最近一次成交的处理时间（纳秒）
+	evicting             atomic.Bool                     // 已因超过聚合上限触发提前发送，等待 flush
+	strategy             AggregationStrategy             // 创建聚合时按地址选择的聚合策略
+	fillMu               sync.Mutex                      // 保护 unsent 及聚合的成交累计（Fills、TotalSize 等），供发送协程读取快照
+	unsent               []*fillSignal                   // 逐笔发送策略下尚未发送的成交
+	sendMu               sync.Mutex                      // 同一聚合的发送串行执行，逐笔发送按成交顺序进行
+	completing           atomic.Bool                     // 已开始完成聚合的发送，之后不再发送进度信号
+	progressSent         atomic.Int32                    // 已发送进度信号的阈值数量
+	progressScopes       map[float64]map[string]struct{} // 进度阈值 -> 已发送的作用域，部分作用域发送失败重试时跳过（受 sendMu 保护）
 	Aggregation          *models.OrderAggregation
 	FirstFillTime        time.Time
 	SymbolCache          *cache.SymbolCache
 	PositionBalanceCache *cache.PositionBalanceCache
 }
 
+// flushTriggerShutdown 关闭时排空发送队列触发的发送
+const flushTriggerShutdown = "shutdown"
+
+// flushTriggerEvicted 聚合中的订单超过上限时提前发送
+const flushTriggerEvicted = "evicted"
+
+// orderStatusEvicted 提前淘汰发送的聚合记录的状态，订单可能仍未完成，之后的成交开始新的聚合
+const orderStatusEvicted = "evicted"
+
+// flushTriggerFill 逐笔发送策略下成交到达触发的发送，只发送成交信号，不完成聚合
+const flushTriggerFill = "fill"
+
+// fillSignal 逐笔发送策略下待发送的成交
+type fillSignal struct {
+	fill       hl.WsOrderFill
+	receivedAt time.Time
+	sent       map[string]struct{} // 已发送的作用域，部分作用域发送失败重试时跳过
+}
+
 // flushKey 发送键
 type flushKey struct {
 	key     string
-	trigger string // "status", "timeout", "manual"
+	trigger string // "status", "size", "timeout", "manual", "evicted", "shutdown", "fill", "progress"
 	status  string // order status "filled"
 }
 
 // OrderProcessor 订单处理器
 type OrderProcessor struct {
-	pendingOrders        *PendingOrderCache // key: "address-oid-direction"
+	pendingOrders        *PendingOrderCache // key: "address-oid-direction"，按 cloid 聚合时为 "address-c:cloid-direction"
 	publisher            Publisher
 	batchWriter          *BatchWriter
 	deduper              cache.DedupCacheInterface
@@ -95,12 +185,41 @@ type OrderProcessor struct {
 	positionBalanceCache *cache.PositionBalanceCache
 	pairCategoryCache    *cache.PairCategoryCache
 	timeout              time.Duration
+	maxPending           int // 聚合中的订单上限，0 表示不限制
 	flushChan            chan flushKey
 	done                 chan struct{}
 	wg                   sync.WaitGroup
-	pool                 *ants.Pool         // 协程池
-	statusTracker        OrderStatusTracker // 状态追踪器
-	mu                   sync.RWMutex       // 保留，待后续任务移除
+	pool                 *ants.Pool                        // 协程池
+	statusTracker        OrderStatusTracker                // 状态追踪器
+	origSizes            *origSizeTracker                  // 订单原始数量（用于成交完成即 flush）
+	scopes               *cache.AddressScopes              // 地址去重作用域（可选）
+	leader               LeaderChecker                     // 主备角色（可选），备实例不发送信号
+	priceCache           *cache.PriceCache                 // 价格缓存（可选），用于计算成交滑点
+	marketCtxs           *cache.MarketCtxCache             // 合约市场上下文（可选），合约信号附带流动性信息
+	leverageSource       LeverageSource                    // 杠杆查询（可选），仓位缓存缺少杠杆时使用
+	leverageFailures     concurrent.Map[string, time.Time] // "address|symbol" -> 最近一次杠杆查询失败时间
+	valuer               Valuer                            // 估值器（可选），信号附带计价货币价值
+	labeler              AddressLabeler                    // 地址标签（可选）
+	positionRates        *PositionRateStrategy             // 仓位比例分母策略（可选），默认使用账户价值
+	severity             *SeverityClassifier               // 信号级别分类器（可选）
+	strategies           *AggregationStrategies            // 按地址选择的聚合策略（可选），默认全部按订单聚合
+	signalFilters        *SignalFilters                    // 按地址过滤信号方向和资产类型（可选），默认全部发送
+	persistFills         bool                              // 是否保存原始成交到 hl_fills
+	groupByCloid         bool                              // 成交带 cloid 时按 address+cloid 聚合
+	cloidWindow          time.Duration                     // cloid 分组中订单撤销后等待续单的时间
+	oidCloids            concurrent.Map[string, string]    // "address-oid" -> cloid
+	paused               atomic.Bool                       // 暂停发送，聚合中的订单保留到恢复后由超时扫描发送
+	hooks                []NamedSignalHook                 // 信号发布前的扩展钩子（可选）
+	outbox               SignalEnqueuer                    // 信号发件箱（可选），信号与聚合同事务写库后由分发器发布
+	spotSellMode         string                            // 现货卖出的方向映射模式，默认 close
+	progressThresholds   []float64                         // 成交进度阈值（百分比，升序），为空时不发送进度信号
+	flushDrained         atomic.Int64                      // 停止时从发送队列排空的请求数
+	loopMu               sync.Mutex                        // 保护 flushQuit / scanQuit
+	flushQuit            chan struct{}                     // 当前发送循环的退出信号，看门狗重启时替换
+	scanQuit             chan struct{}                     // 当前超时扫描的退出信号，看门狗重启时替换
+	flushBeat            *watchdog.Heartbeat               // 发送循环心跳
+	scanBeat             *watchdog.Heartbeat               // 超时扫描心跳
+	mu                   sync.RWMutex                      // 保留，待后续任务移除
 }
 
 // NewOrderProcessor 创建订单处理器
@@ -135,16 +254,148 @@ func NewOrderProcessor(
 		done:                 make(chan struct{}),
 		pool:                 pool,
 		statusTracker:        NewOrderStatusTracker(10 * time.Minute),
+		origSizes:            newOrigSizeTracker(10 * time.Minute),
+		flushBeat:            watchdog.NewHeartbeat(),
+		scanBeat:             watchdog.NewHeartbeat(),
 	}
 
 	// 启动后台协程
-	op.wg.Add(2)
-	go op.flushProcessor()
-	go op.timeoutScanner()
+	op.RestartFlushProcessor()
+	op.RestartTimeoutScanner()
 
 	return op
 }
 
+// RestartFlushProcessor 启动新的发送循环，已有的发送循环收到退出信号后退出
+// 看门狗发现发送循环心跳停滞（阻塞或 panic 退出）时调用
+func (p *OrderProcessor) RestartFlushProcessor() {
+	p.loopMu.Lock()
+	defer p.loopMu.Unlock()
+
+	if p.flushQuit != nil {
+		close(p.flushQuit)
+	}
+	quit := make(chan struct{})
+	p.flushQuit = quit
+
+	p.wg.Add(1)
+	goplus.Go(func() {
+		p.flushProcessor(quit)
+	})
+}
+
+// RestartTimeoutScanner 启动新的超时扫描，已有的扫描收到退出信号后退出
+func (p *OrderProcessor) RestartTimeoutScanner() {
+	p.loopMu.Lock()
+	defer p.loopMu.Unlock()
+
+	if p.scanQuit != nil {
+		close(p.scanQuit)
+	}
+	quit := make(chan struct{})
+	p.scanQuit = quit
+
+	p.wg.Add(1)
+	goplus.Go(func() {
+		p.timeoutScanner(quit)
+	})
+}
+
+// WatchdogComponents 返回由看门狗监控的后台循环
+func (p *OrderProcessor) WatchdogComponents() []watchdog.Component {
+	return []watchdog.Component{
+		{Name: "order_flush", Heartbeat: p.flushBeat, Restart: p.RestartFlushProcessor},
+		{Name: "timeout_scanner", Heartbeat: p.scanBeat, Restart: p.RestartTimeoutScanner},
+	}
+}
+
+// SetAddressScopes 设置地址去重作用域（可选），每个作用域独立发送信号
+func (p *OrderProcessor) SetAddressScopes(scopes *cache.AddressScopes) {
+	p.scopes = scopes
+}
+
+// SetPaused 暂停或恢复信号发送
+// 暂停期间订单照常聚合但不发送，恢复后超过聚合超时的订单在下一次扫描时发送
+func (p *OrderProcessor) SetPaused(paused bool) {
+	p.paused.Store(paused)
+}
+
+// SetLeaderChecker 设置主备角色查询（可选）
+// 备实例照常聚合订单并标记去重，但不发送和持久化信号
+func (p *OrderProcessor) SetLeaderChecker(leader LeaderChecker) {
+	p.leader = leader
+}
+
+// SetPriceCache 设置价格缓存（可选），记录成交时的中间价并计算滑点
+func (p *OrderProcessor) SetPriceCache(priceCache *cache.PriceCache) {
+	p.priceCache = priceCache
+}
+
+// SetMarketCtxCache 设置合约市场上下文缓存（可选），合约信号附带 24h 成交额、未平仓量和资金费率
+func (p *OrderProcessor) SetMarketCtxCache(marketCtxs *cache.MarketCtxCache) {
+	p.marketCtxs = marketCtxs
+}
+
+// SetAddressLabeler 设置地址标签查询（可选），信号附带地址标签
+func (p *OrderProcessor) SetAddressLabeler(labeler AddressLabeler) {
+	p.labeler = labeler
+}
+
+// SetValuer 设置估值器（可选），信号同时携带 USD 与计价货币的名义价值
+func (p *OrderProcessor) SetValuer(valuer Valuer) {
+	p.valuer = valuer
+}
+
+// SetPositionRateStrategy 设置仓位比例分母策略（可选），不同作用域可使用不同分母
+func (p *OrderProcessor) SetPositionRateStrategy(strategy *PositionRateStrategy) {
+	p.positionRates = strategy
+}
+
+// SetSeverityClassifier 设置信号级别分类器（可选），信号附带 info/notable/critical 级别
+func (p *OrderProcessor) SetSeverityClassifier(classifier *SeverityClassifier) {
+	p.severity = classifier
+}
+
+// SetAggregationStrategies 设置按地址选择的聚合策略（可选）
+// 策略在地址的新聚合创建时确定，已在聚合中的订单沿用原策略
+func (p *OrderProcessor) SetAggregationStrategies(strategies *AggregationStrategies) {
+	p.strategies = strategies
+}
+
+// SetSignalFilters 设置按地址的信号过滤（可选），被过滤的成交不进入聚合
+func (p *OrderProcessor) SetSignalFilters(filters *SignalFilters) {
+	p.signalFilters = filters
+}
+
+// SetPersistFills 设置是否保存原始成交到 hl_fills（可选，默认关闭）
+func (p *OrderProcessor) SetPersistFills(enabled bool) {
+	p.persistFills = enabled
+}
+
+// SetSignalOutbox 设置信号发件箱（可选）
+// 设置后信号不再直接发布，而是与已发送状态的订单聚合在同一事务中写入 hl_address_signals，由发件箱分发
+func (p *OrderProcessor) SetSignalOutbox(outbox SignalEnqueuer) {
+	p.outbox = outbox
+}
+
+// SetStatusTracker 替换订单状态追踪器（可选），默认为 10 分钟过期的内存追踪器
+func (p *OrderProcessor) SetStatusTracker(tracker OrderStatusTracker) {
+	p.statusTracker = tracker
+}
+
+// SetCloidGrouping 设置按 cloid 聚合（可选，默认关闭）
+// 开启后同一 cloid 的拆单/改单（新 oid）合并为一个信号；分组中的订单撤销后等待 replaceWindow，期间无新成交才发送
+func (p *OrderProcessor) SetCloidGrouping(enabled bool, replaceWindow time.Duration) {
+	p.groupByCloid = enabled
+	p.cloidWindow = replaceWindow
+}
+
+// SetMaxPending 设置聚合中的订单上限（可选，默认不限制）
+// 超出时按首笔成交时间从早到晚提前发送，防止单个地址大量挂单同时成交时占满内存
+func (p *OrderProcessor) SetMaxPending(maxPending int) {
+	p.maxPending = maxPending
+}
+
 // SetTimeout 设置超时时间
 func (p *OrderProcessor) SetTimeout(timeout time.Duration) {
 	p.timeout = timeout
@@ -156,6 +407,9 @@ func (p *OrderProcessor) HandleMessage(msg Message) error {
 	case OrderFillMessage:
 		return p.handleOrderFill(m)
 	case OrderUpdateMessage:
+		if origSz := cast.ToFloat64(m.OrigSz); origSz > 0 {
+			p.RecordOrigSize(m.Address, m.Oid, origSz)
+		}
 		p.UpdateStatus(m.Address, m.Oid, m.Status, m.Direction)
 		return nil
 	case PositionUpdateMessage:
@@ -173,7 +427,13 @@ func (p *OrderProcessor) handleOrderFill(msg OrderFillMessage) error {
 		return fmt.Errorf("invalid fill type")
 	}
 
-	key := p.orderKey(msg.Address, fill.Oid, msg.Direction)
+	// 原始成交在去重之前落库，与是否发送信号无关（tid 冲突时忽略）
+	// 反手成交拆分出的两部分 tid 相同，按拆分前的原始成交落库
+	if reversed, ok := msg.Reversed.(hl.WsOrderFill); ok {
+		p.persistFill(msg.Address, reversed)
+	} else {
+		p.persistFill(msg.Address, fill)
+	}
 
 	// 1. 检查去重缓存（已发送信号）
 	if p.deduper != nil {
@@ -186,6 +446,36 @@ func (p *OrderProcessor) handleOrderFill(msg OrderFillMessage) error {
 		}
 	}
 
+	// 转换 symbol
+	symbol, err := p.convertSymbol(fill.Coin, fill.Dir)
+	if err != nil && p.symbolCache.IsPerpDelisted(fill.Coin) {
+		// 已下架资产的残留成交（如强平）不再告警
+		logger.Debug().
+			Str("coin", fill.Coin).
+			Str("dir", fill.Dir).
+			Msg("symbol convert failed for delisted asset, using raw coin")
+		symbol = fill.Coin
+	} else if err != nil {
+		logger.Warn().
+			Str("coin", fill.Coin).
+			Str("dir", fill.Dir).
+			Err(err).
+			Msg("symbol convert failed, using raw coin")
+		symbol = fill.Coin
+	}
+
+	// 按地址过滤信号方向和资产类型，被过滤的成交不聚合、不发送
+	if !p.allowSignal(msg.Address, symbol, msg.Direction, fill) {
+		return nil
+	}
+
+	key := p.orderKey(msg.Address, fill.Oid, msg.Direction)
+	cloid := p.fillCloid(fill)
+	if cloid != "" {
+		p.oidCloids.Store(oidRefKey(msg.Address, fill.Oid), cloid)
+		key = p.cloidKey(msg.Address, cloid, msg.Direction)
+	}
+
 	// 2. 检查状态追踪器（是否已记录终止状态）
 	shouldFlushImmediately := false
 	preMarkedStatus := ""
@@ -199,23 +489,13 @@ func (p *OrderProcessor) handleOrderFill(msg OrderFillMessage) error {
 		preMarkedStatus = status
 	}
 
-	// 转换 symbol
-	symbol, err := p.convertSymbol(fill.Coin, fill.Dir)
-	if err != nil {
-		logger.Warn().
-			Str("coin", fill.Coin).
-			Str("dir", fill.Dir).
-			Err(err).
-			Msg("symbol convert failed, using raw coin")
-		symbol = fill.Coin
-	}
-
 	// 使用 LoadOrStore 原子操作获取或创建订单
 	pending, loaded := p.pendingOrders.LoadOrStore(key, &PendingOrder{
 		Aggregation: &models.OrderAggregation{
 			Oid:           fill.Oid,
 			Address:       msg.Address,
 			Symbol:        symbol,
+			Cloid:         cloid,
 			Direction:     msg.Direction,
 			OrderStatus:   "open",
 			LastFillTime:  time.Now().Unix(),
@@ -224,19 +504,28 @@ func (p *OrderProcessor) handleOrderFill(msg OrderFillMessage) error {
 			Fills:         []hl.WsOrderFill{fill},
 			TotalSize:     cast.ToFloat64(fill.Sz),
 			WeightedAvgPx: cast.ToFloat64(fill.Px),
+			MidPx:         p.midPrice(fill.Coin, fill.Dir),
 		},
 		FirstFillTime:        time.Now(),
 		SymbolCache:          p.symbolCache,
 		PositionBalanceCache: p.positionBalanceCache,
+		strategy:             p.strategies.For(msg.Address),
 	})
 
 	if !loaded {
+		pending.seenTids.Store(fill.Tid, struct{}{})
+		pending.oids.Store(fill.Oid, struct{}{})
+		pending.lastFill.Store(time.Now().UnixNano())
+		pending.Aggregation.SlippageBps = slippageBps(fill.Side, pending.Aggregation.WeightedAvgPx, pending.Aggregation.MidPx)
+
 		// 新订单，更新监控指标
 		monitor.SetOrderAggregationActive(int(p.pendingOrders.Len()))
 		logger.Debug().
 			Int64("oid", fill.Oid).
 			Str("direction", msg.Direction).
 			Msg("new order aggregation created")
+
+		p.evictOverflow()
 	} else {
 		// 检查订单是否已发送
 		if pending.Aggregation.SignalSent {
@@ -261,29 +550,122 @@ func (p *OrderProcessor) handleOrderFill(msg OrderFillMessage) error {
 		}
 
 		// 追加 fill
+		pending.oids.Store(fill.Oid, struct{}{})
+		pending.lastFill.Store(time.Now().UnixNano())
+		pending.fillMu.Lock()
 		pending.Aggregation.Fills = append(pending.Aggregation.Fills, fill)
 		pending.Aggregation.TotalSize, pending.Aggregation.WeightedAvgPx = p.calculateWeightedAvg(pending.Aggregation.Fills)
+		pending.Aggregation.SlippageBps = slippageBps(fill.Side, pending.Aggregation.WeightedAvgPx, pending.Aggregation.MidPx)
 		pending.Aggregation.LastFillTime = time.Now().Unix()
 		pending.Aggregation.UpdatedAt = time.Now()
+		fills := len(pending.Aggregation.Fills)
+		pending.fillMu.Unlock()
 
 		// 记录 fill 数量
-		monitor.ObserveFillsPerOrder(len(pending.Aggregation.Fills))
+		monitor.ObserveFillsPerOrder(fills)
 	}
 
 	// 持久化到数据库
 	p.persistOrder(pending.Aggregation)
 
+	// 逐笔发送策略：成交立即发送，聚合继续累计直到完成
+	if pending.perFill() {
+		pending.fillMu.Lock()
+		pending.unsent = append(pending.unsent, &fillSignal{fill: fill, receivedAt: time.Now(), sent: make(map[string]struct{})})
+		pending.fillMu.Unlock()
+		p.triggerFlush(key, flushTriggerFill, "")
+	}
+
 	// 3. 如果状态追踪器有记录，立即 flush
 	if shouldFlushImmediately && preMarkedStatus != "" {
 		if !pending.Aggregation.SignalSent {
-			p.triggerFlush(key, "status", preMarkedStatus)
+			p.flushOnStatus(key, pending, preMarkedStatus)
 			p.statusTracker.Remove(msg.Address, fill.Oid)
 		}
+		return nil
 	}
 
+	// 4. 累计成交量达到原始数量时立即 flush，不等待 filled 状态；未达到时检查进度阈值
+	p.flushIfSizeFilled(msg.Address, fill.Oid)
+	p.checkProgress(msg.Address, fill.Oid)
+
 	return nil
 }
 
+// allowSignal 检查地址的信号过滤，方向按首笔成交映射（与生成信号时一致）
+func (p *OrderProcessor) allowSignal(address, symbol, direction string, fill hl.WsOrderFill) bool {
+	filter := p.signalFilters.For(address)
+	if filter.IsZero() {
+		return true
+	}
+	signalDir, _, assetType, ok := p.mapDirection(&models.OrderAggregation{
+		Address:   address,
+		Symbol:    symbol,
+		Direction: direction,
+		Fills:     []hl.WsOrderFill{fill},
+	})
+	if !ok {
+		return true // 未知方向交由生成信号时处理
+	}
+	allowed, reason := filter.Check(signalDir, assetType)
+	if !allowed {
+		monitor.IncFillsFiltered(reason)
+		logger.Debug().
+			Str("address", address).
+			Int64("oid", fill.Oid).
+			Str("direction", direction).
+			Str("reason", reason).
+			Msg("fill filtered by address signal filter")
+	}
+	return allowed
+}
+
+// RecordOrigSize 记录订单原始数量（来自 orderUpdates 或挂单列表）
+// 已聚合的成交量达到原始数量时立即 flush
+func (p *OrderProcessor) RecordOrigSize(address string, oid int64, origSz float64) {
+	if origSz <= 0 {
+		return
+	}
+	p.origSizes.Set(address, oid, origSz)
+	p.flushIfSizeFilled(address, oid)
+	p.checkProgress(address, oid)
+}
+
+// flushIfSizeFilled 所有方向累计成交量达到原始数量时 flush（反手订单的两个方向共享原始数量）
+func (p *OrderProcessor) flushIfSizeFilled(address string, oid int64) {
+	origSz, ok := p.origSizes.Get(address, oid)
+	if !ok {
+		return
+	}
+
+	var total float64
+	var keys []string
+	for _, dir := range allDirections {
+		key := p.aggregationKey(address, oid, dir)
+		pending, exists := p.pendingOrders.Get(key)
+		if !exists || pending.Aggregation.SignalSent {
+			continue
+		}
+		total += pending.filledSize(oid)
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 || !sizeFilled(total, origSz) {
+		return
+	}
+
+	logger.Info().
+		Str("address", address).
+		Int64("oid", oid).
+		Float64("total_size", total).
+		Float64("orig_size", origSz).
+		Msg("order fully filled by size, flush immediately")
+
+	for _, key := range keys {
+		p.triggerFlush(key, "size", "filled")
+	}
+	p.origSizes.Remove(address, oid)
+}
+
 // convertSymbol 转换 coin 为标准 symbol 格式
 func (p *OrderProcessor) convertSymbol(coin string, dir string) (string, error) {
 	// 判断现货/合约
@@ -295,18 +677,10 @@ func (p *OrderProcessor) convertSymbol(coin string, dir string) (string, error)
 		return symbol, nil
 	}
 
-	// 合约处理
-	cleanCoin := coin
-	if strings.Contains(coin, ":") {
-		parts := strings.Split(coin, ":")
-		if len(parts) == 2 && parts[0] == "xyz" {
-			cleanCoin = parts[1]
-		}
-	}
-
-	symbol, ok := p.symbolCache.GetPerpSymbol(cleanCoin)
+	// 合约处理：缓存按 symbol 规范化规则以原始资产名（如 xyz:BTC、flx:GOLD）建立映射
+	symbol, ok := p.symbolCache.GetPerpSymbol(coin)
 	if !ok {
-		return "", fmt.Errorf("perp coin not found: %s", cleanCoin)
+		return "", fmt.Errorf("perp coin not found: %s", coin)
 	}
 	return symbol, nil
 }
@@ -327,28 +701,127 @@ func (p *OrderProcessor) UpdateStatus(address string, oid int64, status string,
 
 	if direction == "" {
 		// 查找所有方向的订单（通过遍历）
-		for _, dir := range []string{"Open Long", "Open Short", "Close Long", "Close Short", "Buy", "Sell"} {
-			key := p.orderKey(address, oid, dir)
-			if _, exist := p.pendingOrders.Get(key); !exist {
+		for _, dir := range allDirections {
+			key := p.aggregationKey(address, oid, dir)
+			pending, exist := p.pendingOrders.Get(key)
+			if !exist {
 				continue
 			}
-			p.triggerFlush(key, "status", status)
+			p.flushOnStatus(key, pending, status)
 			p.statusTracker.Remove(address, oid) // 从 tracker 移除
 		}
 	} else {
-		key := p.orderKey(address, oid, direction)
-		if _, exists := p.pendingOrders.Get(key); exists {
-			p.triggerFlush(key, "status", status)
+		key := p.aggregationKey(address, oid, direction)
+		if pending, exists := p.pendingOrders.Get(key); exists {
+			p.flushOnStatus(key, pending, status)
 			p.statusTracker.Remove(address, oid) // 从 tracker 移除
 		}
 	}
 }
 
+// flushOnStatus 订单进入终止状态时发送
+// 按 cloid 聚合的订单被撤销（非 filled）时可能是改单，等待同 cloid 的新订单成交，窗口内无新成交才发送
+func (p *OrderProcessor) flushOnStatus(key string, pending *PendingOrder, status string) {
+	if pending.Aggregation.Cloid == "" || status == "filled" || p.cloidWindow <= 0 {
+		p.triggerFlush(key, "status", status)
+		return
+	}
+
+	endedAt := time.Now().UnixNano()
+	time.AfterFunc(p.cloidWindow, func() {
+		if pending.lastFill.Load() > endedAt {
+			return
+		}
+		p.triggerFlush(key, "status", status)
+	})
+}
+
+// midPrice 获取成交时的中间价（webData2 推送的 mid/mark 价格），无价格时返回 0
+func (p *OrderProcessor) midPrice(coin, dir string) float64 {
+	if p.priceCache == nil {
+		return 0
+	}
+
+	var px float64
+	if p.isSpotDir(dir) {
+		px, _ = p.priceCache.GetSpotPrice(coin)
+	} else {
+		px, _ = p.priceCache.GetPerpPrice(coin)
+	}
+	return px
+}
+
+// slippageBps 计算成交均价相对中间价的滑点（bp），买入高于中间价或卖出低于中间价为正
+func slippageBps(side string, avgPx, midPx float64) float64 {
+	if midPx <= 0 || avgPx <= 0 {
+		return 0
+	}
+
+	bps := (avgPx - midPx) / midPx * 10000
+	if side == "A" {
+		bps = -bps
+	}
+	return bps
+}
+
 // orderKey 生成订单键
 func (p *OrderProcessor) orderKey(address string, oid int64, direction string) string {
 	return fmt.Sprintf("%s-%d-%s", address, oid, direction)
 }
 
+// cloidKey 生成按 cloid 聚合的订单键
+func (p *OrderProcessor) cloidKey(address, cloid, direction string) string {
+	return fmt.Sprintf("%s-c:%s-%s", address, cloid, direction)
+}
+
+// aggregationKey 返回 oid 所在聚合的键：已按 cloid 聚合时为 cloid 键，否则为 oid 键
+func (p *OrderProcessor) aggregationKey(address string, oid int64, direction string) string {
+	if cloid, ok := p.oidCloids.Load(oidRefKey(address, oid)); ok {
+		return p.cloidKey(address, cloid, direction)
+	}
+	return p.orderKey(address, oid, direction)
+}
+
+// fillCloid 返回用于聚合的 cloid，未开启按 cloid 聚合或成交不带 cloid 时为空
+func (p *OrderProcessor) fillCloid(fill hl.WsOrderFill) string {
+	if !p.groupByCloid || fill.Cloid == nil {
+		return ""
+	}
+	return *fill.Cloid
+}
+
+// oidRefKey 生成 oid 到 cloid 映射的键
+func oidRefKey(address string, oid int64) string {
+	return fmt.Sprintf("%s-%d", address, oid)
+}
+
+// filledSize 指定 oid 的累计成交量，按 cloid 聚合时只统计该 oid 的成交
+func (o *PendingOrder) filledSize(oid int64) float64 {
+	if o.Aggregation.Cloid == "" {
+		return o.Aggregation.TotalSize
+	}
+	var total float64
+	for _, f := range o.Aggregation.Fills {
+		if f.Oid == oid {
+			total += cast.ToFloat64(f.Sz)
+		}
+	}
+	return total
+}
+
+// perFill 是否按逐笔发送策略处理
+func (o *PendingOrder) perFill() bool {
+	return o.strategy != nil && o.strategy.PerFill()
+}
+
+// timeout 聚合超时，按创建聚合时选择的策略
+func (o *PendingOrder) timeout(def time.Duration) time.Duration {
+	if o.strategy == nil {
+		return def
+	}
+	return o.strategy.Timeout(def)
+}
+
 // calculateWeightedAvg 计算加权平均价
 func (p *OrderProcessor) calculateWeightedAvg(fills []hl.WsOrderFill) (totalSize, avgPx float64) {
 	var totalValue float64
@@ -381,6 +854,30 @@ func (p *OrderProcessor) persistOrder(agg *models.OrderAggregation) {
 	}
 }
 
+// persistFill 保存原始成交
+func (p *OrderProcessor) persistFill(address string, fill hl.WsOrderFill) {
+	if !p.persistFills || p.batchWriter == nil {
+		return
+	}
+
+	item := FillItem{&models.HlFill{
+		Tid:      fill.Tid,
+		Address:  address,
+		Oid:      fill.Oid,
+		Coin:     fill.Coin,
+		Dir:      fill.Dir,
+		FillTime: fill.Time,
+		Raw:      fill,
+	}}
+	if err := p.batchWriter.Add(item); err != nil {
+		logger.Error().Err(err).
+			Str("addr", address).
+			Int64("oid", fill.Oid).
+			Int64("tid", fill.Tid).
+			Msg("failed to persist fill")
+	}
+}
+
 // triggerFlush 触发发送
 func (p *OrderProcessor) triggerFlush(key string, trigger, status string) {
 	select {
@@ -390,9 +887,13 @@ func (p *OrderProcessor) triggerFlush(key string, trigger, status string) {
 	}
 }
 
-// flushProcessor 处理发送队列
-func (p *OrderProcessor) flushProcessor() {
+// flushProcessor 处理发送队列，quit 关闭时退出（由新的发送循环接替）
+func (p *OrderProcessor) flushProcessor(quit <-chan struct{}) {
 	defer p.wg.Done()
+
+	heartbeat := time.NewTicker(flushHeartbeatInterval)
+	defer heartbeat.Stop()
+
 	for {
 		select {
 		case req := <-p.flushChan:
@@ -403,16 +904,21 @@ func (p *OrderProcessor) flushProcessor() {
 			_ = p.pool.Submit(func() {
 				p.flushOrder(key, trigger, status)
 			})
+			p.flushBeat.Beat()
+		case <-heartbeat.C:
+			p.flushBeat.Beat()
+		case <-quit:
+			return
 		case <-p.done:
-			// 处理剩余消息
+			// 处理剩余消息，按关闭排空发送计入指标
 			for len(p.flushChan) > 0 {
 				req := <-p.flushChan
 				key := req.key
-				trigger := req.trigger
 				status := req.status
 				_ = p.pool.Submit(func() {
-					p.flushOrder(key, trigger, status)
+					p.flushOrder(key, flushTriggerShutdown, status)
 				})
+				p.flushDrained.Add(1)
 			}
 			return
 		}
@@ -426,9 +932,36 @@ func (p *OrderProcessor) flushOrder(key string, trigger, status string) {
 		return
 	}
 
+	// 进度信号不完成聚合，不影响提前发送标记；暂停期间跳过，由之后越过阈值的成交补发
+	if trigger == flushTriggerProgress {
+		if !p.paused.Load() {
+			p.flushProgress(pending)
+		}
+		return
+	}
+	// 发送失败或暂停时保留订单，之后可再次被淘汰
+	defer pending.evicting.Store(false)
+
+	// 暂停期间不发送，恢复后由超时扫描补发
+	if p.paused.Load() {
+		return
+	}
+
+	// 数量触发与状态触发可能同时在协程池中执行，持锁后再次检查，只由先执行的一次完成发送
+	pending.sendMu.Lock()
+	defer pending.sendMu.Unlock()
 	if pending.Aggregation.SignalSent {
 		return
 	}
+	// 完成发送开始后不再发送进度信号，避免进度信号晚于完成信号到达
+	if trigger != flushTriggerFill {
+		pending.completing.Store(true)
+	}
+
+	if pending.perFill() {
+		p.flushFills(key, pending, trigger, status)
+		return
+	}
 
 	// 构建信号
 	signal := p.buildSignal(pending.Aggregation)
@@ -436,49 +969,350 @@ func (p *OrderProcessor) flushOrder(key string, trigger, status string) {
 		return
 	}
 
-	// 1. 发布到 NATS
-	if err := p.publisher.PublishAddressSignal(signal); err != nil {
-		logger.Error().Err(err).Int64("oid", pending.Aggregation.Oid).Msg("publish signal failed")
+	agg := pending.Aggregation
+	standby := p.leader != nil && !p.leader.IsLeader()
+
+	// 扩展钩子可修改或丢弃信号，丢弃时按已发送处理
+	if !standby && p.runSignalHooks(signal, agg) {
+		for _, scope := range p.scopes.Get(agg.Address) {
+			p.markFlushed(scope, pending, status)
+		}
+		p.completeOrder(key, pending, status)
+		p.recordFlush(trigger, pending)
 		return
 	}
 
-	// 2. 标记已发送
+	// 1. 按去重作用域发布到 NATS，已发送的作用域跳过；发件箱模式下只收集信号
+	var published, queued []*nats.HlAddressSignal
+	for _, scope := range p.scopes.Get(agg.Address) {
+		if p.deduper != nil && p.deduper.IsSeenInScope(scope, agg.Address, agg.Oid, agg.Direction) {
+			continue
+		}
+
+		// 备实例仅标记去重，接管后不重复发送主实例已发送的信号
+		if standby {
+			p.markFlushed(scope, pending, status)
+			monitor.IncSignalSuppressed()
+			continue
+		}
+
+		scoped := *signal
+		scoped.Scope = scope
+		p.applyPositionRate(&scoped, scope)
+		scoped.IdempotencyKey = nats.IdempotencyKey(agg.Address, agg.Oid, agg.Direction, scope)
+		if status == orderStatusEvicted {
+			scoped.IdempotencyKey = nats.EvictedIdempotencyKey(agg.Address, agg.Oid, agg.Fills[0].Tid, agg.Direction, scope)
+		}
+		if p.outbox != nil {
+			queued = append(queued, &scoped)
+			continue
+		}
+		if err := p.publisher.PublishAddressSignal(&scoped); err != nil {
+			logger.Error().Err(err).Int64("oid", agg.Oid).Str("scope", scope).
+				Str("error_code", nats.ErrorCode(err)).
+				Str("trace_id", signal.TraceID).Msg("publish signal failed")
+			p.persistSignals(agg.Oid, published)
+			return
+		}
+
+		p.markFlushed(scope, pending, status)
+		published = append(published, &scoped)
+	}
+
+	// 2. 标记已发送、持久化并从待处理列表移除
+	if len(queued) > 0 {
+		// 发件箱写入失败时保留待处理订单，由超时扫描重试，不会丢失或重复生成信号
+		if err := p.enqueueOutbox(pending, queued, status); err != nil {
+			logger.Error().Err(err).Int64("oid", agg.Oid).
+				Str("trace_id", signal.TraceID).Msg("enqueue signals to outbox failed")
+			return
+		}
+		for _, s := range queued {
+			p.markFlushed(s.Scope, pending, status)
+		}
+		p.releaseOrder(key, pending)
+	} else {
+		p.completeOrder(key, pending, status)
+	}
+
+	// 3. 记录发送指标
+	p.recordFlush(trigger, pending)
+
+	p.persistSignals(pending.Aggregation.Oid, published)
+
+	logger.Info().
+		Int64("oid", pending.Aggregation.Oid).
+		Str("symbol", signal.Symbol).
+		Float64("size", signal.Size).
+		Int("scopes", len(published)+len(queued)).
+		Bool("standby", standby).
+		Str("trace_id", signal.TraceID).
+		Str("trigger", trigger).
+		Msg("order signal sent")
+}
+
+// flushFills 逐笔发送策略：按成交顺序发送尚未发送的成交
+// trigger 为 fill 时只发送成交；其他触发（终止状态、超时等）在全部成交发送后完成聚合，不再发送汇总信号
+// 调用方需持有 pending.sendMu
+func (p *OrderProcessor) flushFills(key string, pending *PendingOrder, trigger, status string) {
+	standby := p.leader != nil && !p.leader.IsLeader()
+	scopes := p.scopes.Get(pending.Aggregation.Address)
+	for {
+		pending.fillMu.Lock()
+		if len(pending.unsent) == 0 {
+			pending.fillMu.Unlock()
+			break
+		}
+		unit := pending.unsent[0]
+		pending.fillMu.Unlock()
+
+		// 发送失败时保留，由下一笔成交或超时扫描重试
+		if !p.publishFill(pending, unit, scopes, standby) {
+			return
+		}
+		pending.fillMu.Lock()
+		pending.unsent = pending.unsent[1:]
+		pending.fillMu.Unlock()
+	}
+	if trigger == flushTriggerFill {
+		return
+	}
+
+	for _, scope := range scopes {
+		p.markFlushed(scope, pending, status)
+	}
+	p.completeOrder(key, pending, status)
+	p.recordFlush(trigger, pending)
+
+	logger.Info().
+		Int64("oid", pending.Aggregation.Oid).
+		Int("fills", len(pending.Aggregation.Fills)).
+		Str("trigger", trigger).
+		Msg("per-fill order aggregation completed")
+}
+
+// publishFill 按作用域发送单笔成交的信号，返回 false 表示发送失败需重试
+// 幂等键包含成交 tid，同一订单的多笔成交互不冲突；备实例不发送
+func (p *OrderProcessor) publishFill(pending *PendingOrder, unit *fillSignal, scopes []string, standby bool) bool {
+	if standby {
+		monitor.IncSignalSuppressed()
+		return true
+	}
+
+	agg := pending.Aggregation
+	fill := unit.fill
+	single := *agg
+	single.Oid = fill.Oid
+	single.Fills = []hl.WsOrderFill{fill}
+	single.TotalSize = cast.ToFloat64(fill.Sz)
+	single.WeightedAvgPx = cast.ToFloat64(fill.Px)
+	single.SlippageBps = slippageBps(fill.Side, single.WeightedAvgPx, single.MidPx)
+
+	signal := p.buildSignal(&single)
+	if signal == nil || p.runSignalHooks(signal, &single) {
+		return true
+	}
+
+	var published, queued []*nats.HlAddressSignal
+	for _, scope := range scopes {
+		if _, ok := unit.sent[scope]; ok {
+			continue
+		}
+		scoped := *signal
+		scoped.Scope = scope
+		p.applyPositionRate(&scoped, scope)
+		scoped.IdempotencyKey = nats.FillIdempotencyKey(agg.Address, fill.Oid, fill.Tid, agg.Direction, scope)
+		if p.outbox != nil {
+			queued = append(queued, &scoped)
+			continue
+		}
+		if err := p.publisher.PublishAddressSignal(&scoped); err != nil {
+			logger.Error().Err(err).Int64("oid", fill.Oid).Int64("tid", fill.Tid).Str("scope", scope).
+				Str("error_code", nats.ErrorCode(err)).
+				Str("trace_id", signal.TraceID).Msg("publish fill signal failed")
+			p.persistSignals(fill.Oid, published)
+			return false
+		}
+		unit.sent[scope] = struct{}{}
+		published = append(published, &scoped)
+	}
+
+	// 发件箱模式下信号与未完成的聚合在同一事务中写入
+	if len(queued) > 0 {
+		if err := p.outbox.Enqueue(agg, queued); err != nil {
+			logger.Error().Err(err).Int64("oid", fill.Oid).Int64("tid", fill.Tid).
+				Str("trace_id", signal.TraceID).Msg("enqueue fill signals to outbox failed")
+			return false
+		}
+		for _, s := range queued {
+			unit.sent[s.Scope] = struct{}{}
+		}
+	}
+
+	monitor.IncOrderFlush(flushTriggerFill)
+	monitor.ObserveOrderFlushLatency(flushTriggerFill, time.Since(unit.receivedAt).Seconds())
+	p.persistSignals(fill.Oid, published)
+
+	logger.Info().
+		Int64("oid", fill.Oid).
+		Int64("tid", fill.Tid).
+		Str("symbol", signal.Symbol).
+		Float64("size", signal.Size).
+		Int("scopes", len(published)+len(queued)).
+		Str("trace_id", signal.TraceID).
+		Msg("fill signal sent")
+	return true
+}
+
+// recordFlush 记录发送次数及首笔成交到发送的耗时
+func (p *OrderProcessor) recordFlush(trigger string, pending *PendingOrder) {
+	monitor.IncOrderFlush(trigger)
+	monitor.ObserveOrderFlushLatency(trigger, time.Since(pending.FirstFillTime).Seconds())
+}
+
+// evictOverflow 聚合中的订单超过上限时，按首笔成交时间从早到晚提前发送超出的部分
+// 已触发提前发送、尚未 flush 的订单计入待淘汰数量，避免重复触发
+func (p *OrderProcessor) evictOverflow() {
+	if p.maxPending <= 0 {
+		return
+	}
+	over := int(p.pendingOrders.Len()) - p.maxPending
+	if over <= 0 {
+		return
+	}
+
+	type candidate struct {
+		key     string
+		pending *PendingOrder
+	}
+	var candidates []candidate
+	p.pendingOrders.Range(func(key string, pending *PendingOrder) bool {
+		if pending.Aggregation.SignalSent {
+			return true
+		}
+		if pending.evicting.Load() {
+			over--
+			return true
+		}
+		candidates = append(candidates, candidate{key: key, pending: pending})
+		return true
+	})
+	if over <= 0 {
+		return
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].pending.FirstFillTime.Before(candidates[j].pending.FirstFillTime)
+	})
+	for _, c := range candidates[:min(over, len(candidates))] {
+		if !c.pending.evicting.CompareAndSwap(false, true) {
+			continue
+		}
+		logger.Warn().
+			Str("key", c.key).
+			Int("max_pending", p.maxPending).
+			Msg("pending orders exceed limit, flush oldest early")
+		monitor.IncOrderEvicted()
+		p.triggerFlush(c.key, flushTriggerEvicted, orderStatusEvicted)
+	}
+}
+
+// completeOrder 标记聚合已发送，持久化后从待处理列表移除
+func (p *OrderProcessor) completeOrder(key string, pending *PendingOrder, status string) {
 	pending.Aggregation.SignalSent = true
 	pending.Aggregation.OrderStatus = status
 	pending.Aggregation.UpdatedAt = time.Now()
 
-	// 3. 标记到去重器
-	if p.deduper != nil {
-		p.deduper.Mark(pending.Aggregation.Address, pending.Aggregation.Oid, pending.Aggregation.Direction)
-	}
-
 	// 持久化到数据库
 	p.persistOrder(pending.Aggregation)
+	p.releaseOrder(key, pending)
+}
+
+// enqueueOutbox 发件箱模式下将已发送状态的聚合与信号在同一事务中写入，失败时恢复为未发送
+func (p *OrderProcessor) enqueueOutbox(pending *PendingOrder, signals []*nats.HlAddressSignal, status string) error {
+	agg := pending.Aggregation
+	agg.SignalSent = true
+	agg.OrderStatus = status
+	agg.UpdatedAt = time.Now()
+
+	if err := p.outbox.Enqueue(agg, signals); err != nil {
+		agg.SignalSent = false
+		return err
+	}
+	return nil
+}
 
-	// 4. 从待处理列表移除
+// releaseOrder 已发送的聚合从待处理列表移除
+func (p *OrderProcessor) releaseOrder(key string, pending *PendingOrder) {
+	// 从待处理列表移除
 	p.pendingOrders.Delete(key)
+	p.releaseCloid(pending)
 	monitor.SetOrderAggregationActive(int(p.pendingOrders.Len()))
 
-	// 5. 清理 seenTids（防止内存泄漏）
+	// 清理 seenTids（防止内存泄漏）
 	pending.seenTids.Clear()
+}
 
-	// 6. 记录发送指标
-	monitor.IncOrderFlush(trigger)
+// markSent 在作用域内将聚合包含的所有 oid 标记为已发送
+func (p *OrderProcessor) markSent(scope string, pending *PendingOrder) {
+	if p.deduper == nil {
+		return
+	}
+	agg := pending.Aggregation
+	p.deduper.MarkInScope(scope, agg.Address, agg.Oid, agg.Direction)
+	pending.oids.Range(func(oid int64, _ struct{}) bool {
+		if oid != agg.Oid {
+			p.deduper.MarkInScope(scope, agg.Address, oid, agg.Direction)
+		}
+		return true
+	})
+}
+
+// markFlushed 聚合发送后标记去重；提前淘汰的聚合不标记，订单之后的成交开始新的聚合而不是被去重丢弃
+func (p *OrderProcessor) markFlushed(scope string, pending *PendingOrder, status string) {
+	if status == orderStatusEvicted {
+		return
+	}
+	p.markSent(scope, pending)
+}
+
+// releaseCloid 同一 cloid 的所有方向都已发送后，清理 oid 到 cloid 的映射
+func (p *OrderProcessor) releaseCloid(pending *PendingOrder) {
+	agg := pending.Aggregation
+	if agg.Cloid == "" {
+		return
+	}
+	for _, dir := range allDirections {
+		if _, exists := p.pendingOrders.Get(p.cloidKey(agg.Address, agg.Cloid, dir)); exists {
+			return
+		}
+	}
+	pending.oids.Range(func(oid int64, _ struct{}) bool {
+		p.oidCloids.Delete(oidRefKey(agg.Address, oid))
+		return true
+	})
+}
 
-	if err := dao.Signal().Create(signal); err != nil {
+// persistSignals 保存已发送的信号到 hl_address_signals
+func (p *OrderProcessor) persistSignals(oid int64, signals []*nats.HlAddressSignal) {
+	for _, signal := range signals {
+		err := dao.Signal().Create(signal)
+		if err == nil {
+			continue
+		}
+		// 数据库不可用时转入批量写入器缓冲，恢复后补写
+		if dal.IsUnavailable(err) && p.batchWriter != nil {
+			if err = p.batchWriter.Add(SignalItem{Signal: signal}); err == nil {
+				continue
+			}
+		}
 		logger.Error().
 			Err(err).
-			Int64("oid", pending.Aggregation.Oid).
+			Int64("oid", oid).
+			Str("trace_id", signal.TraceID).
 			Msg("persist signal to hl_address_signals failed")
-		// 信号持久化失败不阻塞主流程，订单已发送到 NATSƒ
+		// 信号持久化失败不阻塞主流程，订单已发送到 NATS
 	}
-
-	logger.Info().
-		Int64("oid", pending.Aggregation.Oid).
-		Str("symbol", signal.Symbol).
-		Float64("size", signal.Size).
-		Str("trigger", trigger).
-		Msg("order signal sent")
 }
 
 // buildSignal 构建信号
@@ -490,76 +1324,140 @@ func (p *OrderProcessor) buildSignal(agg *models.OrderAggregation) *nats.HlAddre
 	firstFill := agg.Fills[0]
 
 	// 方向映射
-	var direction, side, assetType string
-	switch agg.Direction {
-	case "Open Long":
-		direction, side, assetType = "open", "LONG", "futures"
-	case "Open Short":
-		direction, side, assetType = "open", "SHORT", "futures"
-	case "Close Long":
-		direction, side, assetType = "close", "LONG", "futures"
-	case "Close Short":
-		direction, side, assetType = "close", "SHORT", "futures"
-	case "Buy":
-		direction, side, assetType = "open", "LONG", "spot"
-	case "Sell":
-		direction, side, assetType = "close", "LONG", "spot"
-	default:
+	direction, side, assetType, ok := p.mapDirection(agg)
+	if !ok {
 		logger.Warn().Str("dir", agg.Direction).Msg("unknown order direction, skip signal")
 		return nil
 	}
 
-	// 计算 PositionRate
-	positionRate := p.calculatePositionRate(agg.Address, assetType, agg.WeightedAvgPx, agg.TotalSize)
+	// 余额过期时比例可能失真（如地址长时间未推送 webData2），按配置标记或跳过
+	stale := p.positionBalanceCache != nil && p.positionBalanceCache.IsStale(agg.Address)
 
 	// 计算 CloseRate（平仓比例）
-	closeRate := p.calculateCloseRate(direction, assetType, agg.Address, agg.Symbol, agg.TotalSize)
+	var closeRate float64
+	if !stale || !p.positionRates.SkipStale() {
+		closeRate = p.calculateCloseRate(direction, assetType, agg.Address, agg.Symbol, agg.TotalSize)
+	}
 
 	// 计算 CoinType
 	coinType := p.pairCategoryCache.GetCoinType(agg.Symbol)
 
-	return &nats.HlAddressSignal{
-		Address:      agg.Address,
-		Symbol:       agg.Symbol,
-		CoinType:     coinType,
-		AssetType:    assetType,
-		Direction:    direction,
-		Side:         side,
-		PositionRate: positionRate,
-		CloseRate:    closeRate,
-		Size:         agg.TotalSize,
-		Price:        agg.WeightedAvgPx,
-		Timestamp:    firstFill.Time,
+	signal := &nats.HlAddressSignal{
+		Address:     agg.Address,
+		Symbol:      agg.Symbol,
+		CoinType:    coinType,
+		AssetType:   assetType,
+		Direction:   direction,
+		Side:        side,
+		RawDir:      agg.Direction,
+		CloseRate:   closeRate,
+		Size:        agg.TotalSize,
+		Price:       agg.WeightedAvgPx,
+		Timestamp:   firstFill.Time,
+		MidPx:       agg.MidPx,
+		SlippageBps: agg.SlippageBps,
+		NotionalUSD: agg.TotalSize * agg.WeightedAvgPx,
+		TraceID:     nats.NewTraceID(),
+	}
+	if stale {
+		signal.BalanceStale = true
+		mode := PositionRateStaleAnnotate
+		if p.positionRates.SkipStale() {
+			mode = PositionRateStaleSkip
+		}
+		monitor.IncSignalStaleBalance(mode)
+	}
+	if agg.Cloid != "" {
+		signal.Cloid = agg.Cloid
+		signal.Oids = fillOids(agg.Fills)
+	}
+	p.applyPositionRate(signal, cache.DefaultScope)
+
+	if p.labeler != nil {
+		signal.AddressLabel = p.labeler.Label(agg.Address)
+	}
+
+	// 合约所属 dex，不同 dex 的同名资产规范化后 symbol 相同，由 dex 区分；
+	// 附带杠杆和保证金模式，供跟单方按相同杠杆下单
+	if assetType != "spot" {
+		signal.Dex, _ = hl.SplitDexCoin(firstFill.Coin)
+		p.applyLeverage(signal, firstFill.Coin)
+	}
+
+	// 合约市场上下文，供消费方按流动性调整跟单规模
+	if p.marketCtxs != nil && assetType != "spot" {
+		if marketCtx, ok := p.marketCtxs.Get(firstFill.Coin); ok {
+			signal.DayNtlVlm = marketCtx.DayNtlVlm
+			signal.OpenInterest = marketCtx.OpenInterest
+			signal.Funding = marketCtx.Funding
+		}
+	}
+
+	// 计价货币价值
+	if p.valuer != nil {
+		signal.QuoteCurrency = p.valuer.Quote()
+		if notional, ok := p.valuer.ToQuote(signal.NotionalUSD); ok {
+			signal.NotionalQuote = notional
+		}
 	}
+
+	// 信号级别按默认作用域的仓位比例计算，各作用域的信号级别相同
+	if p.severity != nil {
+		signal.Severity = p.severity.Classify(signal)
+		monitor.IncSignalSeverity(signal.Severity)
+	}
+
+	return signal
 }
 
-// calculatePositionRate 计算仓位比例
-func (p *OrderProcessor) calculatePositionRate(address, assetType string, price, size float64) float64 {
-	if p.positionBalanceCache == nil {
-		return 100.00
+// fillOids 按首次成交顺序返回成交涉及的订单 ID
+func fillOids(fills []hl.WsOrderFill) []int64 {
+	var oids []int64
+	seen := make(map[int64]struct{}, len(fills))
+	for _, f := range fills {
+		if _, ok := seen[f.Oid]; ok {
+			continue
+		}
+		seen[f.Oid] = struct{}{}
+		oids = append(oids, f.Oid)
 	}
+	return oids
+}
 
-	var totalBalance float64
-	var ok bool
+// applyPositionRate 按作用域的分母策略计算仓位比例，并记录所用分母及金额
+func (p *OrderProcessor) applyPositionRate(signal *nats.HlAddressSignal, scope string) {
+	basis := PositionRateBasisSpotTotal
+	if signal.AssetType != "spot" {
+		basis = p.positionRates.Basis(scope)
+	}
+	signal.PositionRateBasis = basis
+	if signal.BalanceStale && p.positionRates.SkipStale() {
+		signal.PositionRate, signal.PositionRateDenominator = 0, 0
+		return
+	}
+	signal.PositionRate, signal.PositionRateDenominator = p.calculatePositionRate(signal.Address, basis, signal.Price, signal.Size)
+}
 
-	if assetType == "spot" {
-		totalBalance, ok = p.positionBalanceCache.GetSpotTotal(address)
-	} else {
-		totalBalance, ok = p.positionBalanceCache.GetAccountValue(address)
+// calculatePositionRate 计算仓位比例，返回比例和分母金额（分母不可用时为 0）
+func (p *OrderProcessor) calculatePositionRate(address, basis string, price, size float64) (float64, float64) {
+	if p.positionBalanceCache == nil {
+		return 100.00, 0
 	}
 
+	totalBalance, ok := positionRateDenominator(p.positionBalanceCache, address, basis)
+
 	// 错误处理：返回 100%
 	if !ok || totalBalance <= 0 {
 		logger.Debug().
 			Str("address", address).
-			Str("asset_type", assetType).
+			Str("basis", basis).
 			Msg("balance cache not found or invalid, using 100%")
-		return 100.00
+		return 100.00, 0
 	}
 
 	// 计算比例: (price × size) / totalBalance × 100
 	rate := (price * size / totalBalance) * 100
-	return rate
+	return rate, totalBalance
 }
 
 // calculateCloseRate 计算平仓比例
@@ -581,6 +1479,7 @@ func (p *OrderProcessor) calculateCloseRate(direction string, assetType string,
 		// 去掉 symbol 尾部的 USDC，获取原始 coin 名称
 		coin := strings.TrimSuffix(symbol, "USDC")
 		currentPosition, ok = p.positionBalanceCache.GetSpotBalance(address, coin)
+		currentPosition = math.Abs(currentPosition) // 杠杆现货空头余额为负
 	} else { // futures
 		currentPosition, ok = p.positionBalanceCache.GetFuturesPosition(address, symbol)
 	}
@@ -599,16 +1498,20 @@ func (p *OrderProcessor) calculateCloseRate(direction string, assetType string,
 	return size / currentPosition
 }
 
-// timeoutScanner 超时扫描器
-func (p *OrderProcessor) timeoutScanner() {
+// timeoutScanner 超时扫描器，quit 关闭时退出（由新的扫描接替）
+func (p *OrderProcessor) timeoutScanner(quit <-chan struct{}) {
 	defer p.wg.Done()
 	ticker := time.NewTicker(30 * time.Second)
 	defer ticker.Stop()
 
+	p.scanBeat.Beat()
 	for {
 		select {
 		case <-ticker.C:
 			p.scanTimeoutOrders()
+			p.scanBeat.Beat()
+		case <-quit:
+			return
 		case <-p.done:
 			return
 		}
@@ -617,15 +1520,18 @@ func (p *OrderProcessor) timeoutScanner() {
 
 // scanTimeoutOrders 扫描超时订单
 func (p *OrderProcessor) scanTimeoutOrders() {
+	if p.paused.Load() {
+		return
+	}
+
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
 	now := time.Now()
-	timeoutThreshold := now.Add(-p.timeout)
 
 	p.pendingOrders.Range(func(key string, pending *PendingOrder) bool {
-		// 未发送且超时
-		if !pending.Aggregation.SignalSent && pending.FirstFillTime.Before(timeoutThreshold) {
+		// 未发送且超过所属策略的聚合超时
+		if !pending.Aggregation.SignalSent && pending.FirstFillTime.Before(now.Add(-pending.timeout(p.timeout))) {
 			p.triggerFlush(key, "timeout", "filled")
 		}
 		return true
@@ -639,7 +1545,146 @@ func (p *OrderProcessor) Stop() {
 	p.pool.Release()
 }
 
+// StopAndDrain 停止处理器，等待发送队列中的请求在 ctx 截止前执行完
+// 未发送的聚合已持久化，重启后由 RestorePending 恢复，只记录数量
+func (p *OrderProcessor) StopAndDrain(ctx context.Context) DrainStats {
+	close(p.done)
+	p.wg.Wait()
+
+	stats := DrainStats{Drained: int(p.flushDrained.Load())}
+	wait := time.Duration(math.MaxInt64)
+	if deadline, ok := ctx.Deadline(); ok {
+		wait = max(time.Until(deadline), 0)
+	}
+	if err := p.pool.ReleaseTimeout(wait); err != nil {
+		stats.Remaining = p.pool.Running()
+		stats.TimedOut = true
+	}
+
+	unsent := 0
+	p.pendingOrders.Range(func(_ string, pending *PendingOrder) bool {
+		if !pending.Aggregation.SignalSent {
+			unsent++
+		}
+		return true
+	})
+	if unsent > 0 {
+		logger.Warn().Int("count", unsent).Msg("pending order aggregations left unsent on shutdown, will be restored on next start")
+	}
+	return stats
+}
+
+// FlushOrders 立即发送地址的待处理聚合，oid 为 0 时发送该地址全部聚合，返回触发发送的聚合数
+func (p *OrderProcessor) FlushOrders(address string, oid int64) int {
+	var keys []string
+	p.pendingOrders.Range(func(key string, pending *PendingOrder) bool {
+		if pending.Aggregation.Address != address {
+			return true
+		}
+		if oid != 0 && pending.Aggregation.Oid != oid {
+			if _, ok := pending.oids.Load(oid); !ok {
+				return true
+			}
+		}
+		keys = append(keys, key)
+		return true
+	})
+
+	for _, key := range keys {
+		p.triggerFlush(key, "manual", "filled")
+	}
+	return len(keys)
+}
+
+// FlushSymbol 立即发送交易对的未发送聚合（资产下架后不会再有后续成交），返回触发发送的聚合数
+func (p *OrderProcessor) FlushSymbol(symbol string) int {
+	var keys []string
+	p.pendingOrders.Range(func(key string, pending *PendingOrder) bool {
+		if pending.Aggregation.Symbol == symbol && !pending.Aggregation.SignalSent {
+			keys = append(keys, key)
+		}
+		return true
+	})
+
+	for _, key := range keys {
+		p.triggerFlush(key, "delisted", "filled")
+	}
+	return len(keys)
+}
+
+// CacheStats 返回待处理订单缓存和状态追踪器的统计信息
+func (p *OrderProcessor) CacheStats() (pending, status map[string]any) {
+	return p.pendingOrders.GetStats(), p.statusTracker.GetStats()
+}
+
 // ActiveCount 返回活跃订单数
 func (p *OrderProcessor) ActiveCount() int {
 	return int(p.pendingOrders.Len())
 }
+
+// PendingOrderStore 未发送聚合的存储接口
+type PendingOrderStore interface {
+	GetPending() ([]*models.OrderAggregation, error)
+}
+
+// pendingRestoreWindow 启动时恢复的未发送聚合的最大时长，更早的视为过期
+const pendingRestoreWindow = 30 * time.Minute
+
+// RestorePending 从数据库恢复未发送的聚合
+// 成交水位在成交到达时即推进，重启后快照会跳过这些成交，需从已持久化的聚合继续；
+// 已超过聚合超时的记录由超时扫描补发
+func (p *OrderProcessor) RestorePending(store PendingOrderStore) (int, error) {
+	if store == nil {
+		return 0, fmt.Errorf("store is nil")
+	}
+
+	aggs, err := store.GetPending()
+	if err != nil {
+		return 0, fmt.Errorf("get pending orders failed: %w", err)
+	}
+
+	since := time.Now().Add(-pendingRestoreWindow)
+	restored := 0
+	for _, agg := range aggs {
+		if len(agg.Fills) == 0 || agg.CreatedAt.Before(since) {
+			continue
+		}
+		if p.deduper != nil && p.deduper.IsSeen(agg.Address, agg.Oid, agg.Direction) {
+			continue
+		}
+
+		key := p.orderKey(agg.Address, agg.Oid, agg.Direction)
+		if agg.Cloid != "" {
+			key = p.cloidKey(agg.Address, agg.Cloid, agg.Direction)
+		}
+
+		pending := &PendingOrder{
+			Aggregation:          agg,
+			FirstFillTime:        agg.CreatedAt,
+			SymbolCache:          p.symbolCache,
+			PositionBalanceCache: p.positionBalanceCache,
+			// 策略在发送和超时扫描时按地址当前的分配选择；逐笔发送策略下已持久化的成交在重启前已发送，恢复后只等待完成，不重复发送
+			strategy: restoredAggregation{processor: p, address: agg.Address},
+		}
+		for _, fill := range agg.Fills {
+			pending.seenTids.Store(fill.Tid, struct{}{})
+			pending.oids.Store(fill.Oid, struct{}{})
+			if agg.Cloid != "" {
+				p.oidCloids.Store(oidRefKey(agg.Address, fill.Oid), agg.Cloid)
+			}
+		}
+		pending.lastFill.Store(time.Unix(agg.LastFillTime, 0).UnixNano())
+
+		if _, loaded := p.pendingOrders.LoadOrStore(key, pending); !loaded {
+			restored++
+		}
+	}
+
+	monitor.SetOrderAggregationActive(int(p.pendingOrders.Len()))
+	logger.Info().
+		Int("count", restored).
+		Dur("window", pendingRestoreWindow).
+		Msg("restored pending order aggregations from database")
+
+	return restored, nil
+}
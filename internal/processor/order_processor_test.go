@@ -1,6 +1,10 @@
 package processor
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -8,7 +12,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/utrading/utrading-hl-monitor/config"
 	"github.com/utrading/utrading-hl-monitor/internal/cache"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
 	"github.com/utrading/utrading-hl-monitor/internal/nats"
 )
 
@@ -494,3 +500,959 @@ func TestOrderProcessor_ConcurrentFills(t *testing.T) {
 	// 应该只有一个聚合订单
 	assert.Equal(t, 1, orderProc.ActiveCount())
 }
+
+// TestSlippageBps 测试滑点计算
+func TestSlippageBps(t *testing.T) {
+	// 买入高于中间价为正滑点
+	assert.InDelta(t, 10.0, slippageBps("B", 100.1, 100), 1e-9)
+	assert.InDelta(t, -10.0, slippageBps("B", 99.9, 100), 1e-9)
+
+	// 卖出低于中间价为正滑点
+	assert.InDelta(t, 10.0, slippageBps("A", 99.9, 100), 1e-9)
+
+	// 无中间价时不计算
+	assert.Equal(t, 0.0, slippageBps("B", 100, 0))
+}
+
+// TestOrderProcessor_MidPrice 测试成交时中间价的获取
+func TestOrderProcessor_MidPrice(t *testing.T) {
+	priceCache := cache.NewPriceCache()
+	priceCache.SetPerpPrice("BTC", 50000)
+	priceCache.SetSpotPrice("@107", 40)
+
+	p := &OrderProcessor{}
+	assert.Equal(t, 0.0, p.midPrice("BTC", "Open Long"), "no price cache")
+
+	p.SetPriceCache(priceCache)
+	assert.Equal(t, 50000.0, p.midPrice("BTC", "Open Long"))
+	assert.Equal(t, 40.0, p.midPrice("@107", "Buy"))
+	assert.Equal(t, 0.0, p.midPrice("ETH", "Open Short"))
+}
+
+// TestOrderProcessor_FlushOnSizeFilled 测试累计成交量达到原始数量时立即发送
+func TestOrderProcessor_FlushOnSizeFilled(t *testing.T) {
+	publisher := newMockPublisher()
+	processor := NewOrderProcessor(publisher, nil, nil, cache.NewSymbolCache(), nil, cache.NewPairCategoryCache())
+	defer processor.Stop()
+
+	fill := func(oid, tid int64, sz, dir string) {
+		require.NoError(t, processor.HandleMessage(OrderFillMessage{
+			Address: "0x123",
+			Fill: hyperliquid.WsOrderFill{
+				Oid: oid, Tid: tid, Sz: sz, Px: "100.0", Dir: dir, Time: time.Now().UnixMilli(),
+			},
+			Direction: dir,
+		}))
+	}
+
+	// 原始数量来自 orderUpdates（open 状态）
+	require.NoError(t, processor.HandleMessage(OrderUpdateMessage{Address: "0x123", Oid: 777, Status: "open", OrigSz: "2"}))
+
+	// 反手订单：两个方向共享原始数量，未达到时不发送
+	fill(777, 1, "0.5", "Close Long")
+	fill(777, 2, "1.2", "Open Short")
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 0, publisher.GetSignalCount())
+
+	// 达到原始数量，无需等待 filled 状态
+	fill(777, 3, "0.3", "Open Short")
+	assert.Eventually(t, func() bool { return publisher.GetSignalCount() == 2 }, time.Second, 10*time.Millisecond)
+	_, ok := processor.origSizes.Get("0x123", 777)
+	assert.False(t, ok)
+
+	// 成交先于原始数量到达（webData2 挂单列表）
+	fill(888, 4, "1", "Open Long")
+	fill(888, 5, "1", "Open Long")
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 2, publisher.GetSignalCount())
+
+	processor.RecordOrigSize("0x123", 888, 2)
+	assert.Eventually(t, func() bool { return publisher.GetSignalCount() == 3 }, time.Second, 10*time.Millisecond)
+}
+
+// blockingPublisher 首次发布阻塞直到 release 关闭，用于构造并发发送
+type blockingPublisher struct {
+	mu      sync.Mutex
+	signals []*nats.HlAddressSignal
+	entered chan struct{}
+	release chan struct{}
+	once    sync.Once
+}
+
+func (b *blockingPublisher) PublishAddressSignal(signal *nats.HlAddressSignal) error {
+	b.once.Do(func() {
+		close(b.entered)
+		<-b.release
+	})
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.signals = append(b.signals, signal)
+	return nil
+}
+
+func (b *blockingPublisher) count() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.signals)
+}
+
+// TestOrderProcessor_ConcurrentFlushTriggers 测试数量触发和状态触发并发执行时只发送一次
+func TestOrderProcessor_ConcurrentFlushTriggers(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&models.HlAddressSignal{}))
+	publisher := &blockingPublisher{entered: make(chan struct{}), release: make(chan struct{})}
+	processor := NewOrderProcessor(publisher, nil, cache.NewDedupCache(time.Minute), cache.NewSymbolCache(), nil, cache.NewPairCategoryCache())
+	defer processor.Stop()
+
+	require.NoError(t, processor.HandleMessage(OrderFillMessage{
+		Address: "0x123",
+		Fill: hyperliquid.WsOrderFill{
+			Oid: 1, Tid: 1, Sz: "1", Px: "100.0", Dir: "Open Long", Time: time.Now().UnixMilli(),
+		},
+		Direction: "Open Long",
+	}))
+	key := processor.orderKey("0x123", 1, "Open Long")
+	_, ok := processor.pendingOrders.Get(key)
+	require.True(t, ok)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		processor.flushOrder(key, "size", "filled")
+	}()
+	<-publisher.entered
+	go func() {
+		defer wg.Done()
+		processor.flushOrder(key, "status", "filled")
+	}()
+	time.Sleep(50 * time.Millisecond)
+	close(publisher.release)
+	wg.Wait()
+
+	assert.Equal(t, 1, publisher.count())
+	_, exists := processor.pendingOrders.Get(key)
+	assert.False(t, exists)
+}
+
+// TestOrderProcessor_CloidGrouping 测试同一 cloid 的改单合并为一个信号
+func TestOrderProcessor_CloidGrouping(t *testing.T) {
+	publisher := newMockPublisher()
+	processor := NewOrderProcessor(publisher, nil, cache.NewDedupCache(time.Minute), cache.NewSymbolCache(), nil, cache.NewPairCategoryCache())
+	defer processor.Stop()
+	processor.SetCloidGrouping(true, 100*time.Millisecond)
+
+	fill := func(oid, tid int64, cloid string) {
+		f := hyperliquid.WsOrderFill{Oid: oid, Tid: tid, Sz: "1", Px: "100.0", Dir: "Open Long", Time: time.Now().UnixMilli()}
+		if cloid != "" {
+			f.Cloid = &cloid
+		}
+		require.NoError(t, processor.HandleMessage(OrderFillMessage{Address: "0x123", Fill: f, Direction: "Open Long"}))
+	}
+	status := func(oid int64, status string) {
+		require.NoError(t, processor.HandleMessage(OrderUpdateMessage{Address: "0x123", Oid: oid, Status: status}))
+	}
+
+	// 撤单后窗口内同 cloid 的新订单成交，合并到同一聚合
+	fill(1, 1, "0xaaa")
+	status(1, "canceled")
+	fill(2, 2, "0xaaa")
+	assert.Equal(t, 1, processor.ActiveCount())
+	status(2, "filled")
+	assert.Eventually(t, func() bool { return publisher.GetSignalCount() == 1 }, time.Second, 10*time.Millisecond)
+
+	signal := publisher.GetLastSignal()
+	assert.Equal(t, "0xaaa", signal.Cloid)
+	assert.Equal(t, []int64{1, 2}, signal.Oids)
+	assert.Equal(t, 2.0, signal.Size)
+
+	// 撤单的延迟发送不会重复触发，已发送的 oid 后续成交被去重
+	time.Sleep(200 * time.Millisecond)
+	fill(1, 3, "0xaaa")
+	assert.Equal(t, 1, publisher.GetSignalCount())
+	assert.Zero(t, processor.ActiveCount())
+
+	// 撤单后窗口内无续单，到期发送
+	fill(3, 4, "0xbbb")
+	status(3, "canceled")
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, 1, publisher.GetSignalCount())
+	assert.Eventually(t, func() bool { return publisher.GetSignalCount() == 2 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []int64{3}, publisher.GetLastSignal().Oids)
+
+	// 不带 cloid 的成交仍按 oid 聚合
+	fill(4, 5, "")
+	_, exists := processor.pendingOrders.Get("0x123-4-Open Long")
+	assert.True(t, exists)
+	status(4, "canceled")
+	assert.Eventually(t, func() bool { return publisher.GetSignalCount() == 3 }, time.Second, 10*time.Millisecond)
+	assert.Empty(t, publisher.GetLastSignal().Cloid)
+}
+
+func TestSizeFilled(t *testing.T) {
+	assert.True(t, sizeFilled(1, 1))
+	assert.True(t, sizeFilled(0.30000000000000004, 0.3))
+	assert.True(t, sizeFilled(0.1+0.2, 0.3))
+	assert.False(t, sizeFilled(0.29, 0.3))
+	assert.False(t, sizeFilled(1, 0))
+}
+
+// TestOrderProcessor_PositionRateBasis 测试按作用域选择仓位比例分母
+func TestOrderProcessor_PositionRateBasis(t *testing.T) {
+	balances := cache.NewPositionBalanceCache()
+	balances.Set("0x123", 2000, 50000, nil, nil)
+	balances.SetMargin("0x123", cache.MarginInfo{Withdrawable: 40000, TotalMarginUsed: 10000})
+
+	strategy, err := NewPositionRateStrategy(config.PositionRate{
+		ScopeBasis: map[string]string{"a": PositionRateBasisFreeCollateral, "b": PositionRateBasisMarginUsed},
+	})
+	require.NoError(t, err)
+
+	p := &OrderProcessor{positionBalanceCache: balances}
+	p.SetPositionRateStrategy(strategy)
+
+	newSignal := func(assetType string) *nats.HlAddressSignal {
+		return &nats.HlAddressSignal{Address: "0x123", AssetType: assetType, Price: 100, Size: 20} // 名义价值 2000
+	}
+
+	cases := []struct {
+		scope, assetType, basis string
+		denominator, rate       float64
+	}{
+		{cache.DefaultScope, "futures", PositionRateBasisAccountValue, 50000, 4},
+		{"a", "futures", PositionRateBasisFreeCollateral, 40000, 5},
+		{"b", "futures", PositionRateBasisMarginUsed, 10000, 20},
+		{"b", "spot", PositionRateBasisSpotTotal, 2000, 100},
+	}
+	for _, c := range cases {
+		signal := newSignal(c.assetType)
+		p.applyPositionRate(signal, c.scope)
+		assert.Equal(t, c.basis, signal.PositionRateBasis, c.scope)
+		assert.InDelta(t, c.denominator, signal.PositionRateDenominator, 1e-9, c.scope)
+		assert.InDelta(t, c.rate, signal.PositionRate, 1e-9, c.scope)
+	}
+
+	// 缺少保证金数据时回退为 100%，分母为 0
+	signal := newSignal("futures")
+	signal.Address = "0x456"
+	p.applyPositionRate(signal, "a")
+	assert.Equal(t, 100.0, signal.PositionRate)
+	assert.Zero(t, signal.PositionRateDenominator)
+
+	_, err = NewPositionRateStrategy(config.PositionRate{Basis: "equity"})
+	assert.Error(t, err)
+}
+
+// TestOrderProcessor_StaleBalance 测试余额过期时标记信号，skip 模式不计算比例
+func TestOrderProcessor_StaleBalance(t *testing.T) {
+	balances := cache.NewPositionBalanceCache()
+	balances.SetStaleTTL(time.Minute)
+	balances.Set("0x123", 2000, 50000, nil, &models.FuturesPositionsData{{Coin: "BTCUSDC", Szi: "4"}})
+
+	p := &OrderProcessor{pairCategoryCache: cache.NewPairCategoryCache(), positionBalanceCache: balances}
+	closeAgg := func(address string) *models.OrderAggregation {
+		return &models.OrderAggregation{
+			Address: address, Symbol: "BTCUSDC", Direction: "Close Long", TotalSize: 2, WeightedAvgPx: 100,
+			Fills: []hyperliquid.WsOrderFill{{Coin: "BTC", Dir: "Close Long"}},
+		}
+	}
+
+	// 余额未过期
+	signal := p.buildSignal(closeAgg("0x123"))
+	require.NotNil(t, signal)
+	assert.False(t, signal.BalanceStale)
+	assert.InDelta(t, 0.4, signal.PositionRate, 1e-9)
+	assert.InDelta(t, 0.5, signal.CloseRate, 1e-9)
+
+	// 从未收到 webData2：annotate 模式照常计算（余额缺失为 100%）并标记
+	signal = p.buildSignal(closeAgg("0x456"))
+	require.NotNil(t, signal)
+	assert.True(t, signal.BalanceStale)
+	assert.Equal(t, 100.0, signal.PositionRate)
+
+	// skip 模式不计算比例
+	strategy, err := NewPositionRateStrategy(config.PositionRate{StaleMode: PositionRateStaleSkip})
+	require.NoError(t, err)
+	p.SetPositionRateStrategy(strategy)
+	balances.SetStaleTTL(time.Nanosecond)
+	time.Sleep(time.Millisecond)
+
+	signal = p.buildSignal(closeAgg("0x123"))
+	require.NotNil(t, signal)
+	assert.True(t, signal.BalanceStale)
+	assert.Zero(t, signal.PositionRate)
+	assert.Zero(t, signal.PositionRateDenominator)
+	assert.Zero(t, signal.CloseRate)
+	assert.Equal(t, PositionRateBasisAccountValue, signal.PositionRateBasis)
+
+	_, err = NewPositionRateStrategy(config.PositionRate{StaleMode: "drop"})
+	assert.Error(t, err)
+}
+
+// TestOrderProcessor_MarketCtx 测试合约信号附带市场上下文，现货信号不附带
+func TestOrderProcessor_MarketCtx(t *testing.T) {
+	marketCtxs := cache.NewMarketCtxCache()
+	marketCtxs.Set("BTC", cache.MarketCtx{DayNtlVlm: 1.5e9, OpenInterest: 30000, Funding: 0.0000125, UpdatedAt: time.Now()})
+
+	p := &OrderProcessor{pairCategoryCache: cache.NewPairCategoryCache()}
+	p.SetMarketCtxCache(marketCtxs)
+
+	signal := p.buildSignal(&models.OrderAggregation{
+		Address: "0x123", Symbol: "BTCUSDC", Direction: "Open Long", TotalSize: 1, WeightedAvgPx: 100,
+		Fills: []hyperliquid.WsOrderFill{{Coin: "BTC", Dir: "Open Long"}},
+	})
+	require.NotNil(t, signal)
+	assert.Equal(t, 1.5e9, signal.DayNtlVlm)
+	assert.Equal(t, 30000.0, signal.OpenInterest)
+	assert.Equal(t, 0.0000125, signal.Funding)
+
+	signal = p.buildSignal(&models.OrderAggregation{
+		Address: "0x123", Symbol: "BTCUSDC", Direction: "Buy", TotalSize: 1, WeightedAvgPx: 100,
+		Fills: []hyperliquid.WsOrderFill{{Coin: "BTC", Dir: "Buy"}},
+	})
+	require.NotNil(t, signal)
+	assert.Zero(t, signal.DayNtlVlm)
+	assert.Zero(t, signal.OpenInterest)
+}
+
+// TestOrderProcessor_SignalDex 测试合约信号附带 builder dex 名称
+func TestOrderProcessor_SignalDex(t *testing.T) {
+	p := &OrderProcessor{pairCategoryCache: cache.NewPairCategoryCache()}
+
+	for coin, dex := range map[string]string{"BTC": "", "xyz:TSLA": "xyz", "flx:GOLD": "flx"} {
+		signal := p.buildSignal(&models.OrderAggregation{
+			Address: "0x123", Symbol: "TESTUSDC", Direction: "Open Long", TotalSize: 1, WeightedAvgPx: 100,
+			Fills: []hyperliquid.WsOrderFill{{Coin: coin, Dir: "Open Long"}},
+		})
+		require.NotNil(t, signal)
+		assert.Equal(t, dex, signal.Dex, coin)
+	}
+}
+
+// TestOrderProcessor_SpotSellMode 测试现货方向映射模式
+func TestOrderProcessor_SpotSellMode(t *testing.T) {
+	balances := cache.NewPositionBalanceCache()
+	balances.Set("0x123", 0, 0, &models.SpotBalancesData{{Coin: "HYPE", Total: "-5"}}, nil)
+
+	newAgg := func(dir, startPosition string) *models.OrderAggregation {
+		return &models.OrderAggregation{
+			Address:   "0x123",
+			Symbol:    "HYPEUSDC",
+			Direction: dir,
+			Fills:     []hyperliquid.WsOrderFill{{StartPosition: startPosition}},
+		}
+	}
+
+	cases := []struct {
+		mode, dir, start string
+		direction, side  string
+	}{
+		{SpotSellModeClose, "Sell", "0", "close", "LONG"},
+		{SpotSellModeClose, "Buy", "-5", "open", "LONG"},
+		{SpotSellModeDetect, "Sell", "10", "close", "LONG"},
+		{SpotSellModeDetect, "Sell", "0", "open", "SHORT"},
+		{SpotSellModeDetect, "Buy", "-5", "close", "SHORT"},
+		{SpotSellModeDetect, "Buy", "0", "open", "LONG"},
+		{SpotSellModeDetect, "Buy", "", "close", "SHORT"}, // 无 startPosition 时取余额缓存
+		{SpotSellModeDetect, "Open Short", "0", "open", "SHORT"},
+	}
+	for _, c := range cases {
+		p := &OrderProcessor{positionBalanceCache: balances}
+		p.SetSpotSellMode(c.mode)
+		direction, side, _, ok := p.mapDirection(newAgg(c.dir, c.start))
+		require.True(t, ok)
+		assert.Equal(t, c.direction, direction, "%s %s %s", c.mode, c.dir, c.start)
+		assert.Equal(t, c.side, side, "%s %s %s", c.mode, c.dir, c.start)
+	}
+
+	p := &OrderProcessor{pairCategoryCache: cache.NewPairCategoryCache()}
+	signal := p.buildSignal(newAgg("Sell", "1"))
+	require.NotNil(t, signal)
+	assert.Equal(t, "Sell", signal.RawDir)
+
+	_, _, _, ok := p.mapDirection(newAgg("Spot Dust Conversion", ""))
+	assert.False(t, ok)
+}
+
+// TestOrderProcessor_PersistFills 测试原始成交写入 hl_fills
+func TestOrderProcessor_PersistFills(t *testing.T) {
+	var mu sync.Mutex
+	written := make(map[string][]BatchItem)
+	writer := NewBatchWriter(&BatchWriterConfig{FlushInterval: time.Hour})
+	writer.upsert = func(table string, items []BatchItem) error {
+		mu.Lock()
+		defer mu.Unlock()
+		written[table] = append(written[table], items...)
+		return nil
+	}
+	writer.Start()
+
+	processor := NewOrderProcessor(newMockPublisher(), writer, nil, cache.NewSymbolCache(), nil, cache.NewPairCategoryCache())
+	defer processor.Stop()
+	processor.SetPersistFills(true)
+
+	fill := hyperliquid.WsOrderFill{Coin: "BTC", Oid: 1, Tid: 7, Sz: "1", Px: "100", Dir: "Open Long", Time: 1700000000000, Hash: "0xhash"}
+	msg := OrderFillMessage{Address: "0x123", Fill: fill, Direction: "Open Long"}
+	require.NoError(t, processor.HandleMessage(msg))
+	require.NoError(t, processor.HandleMessage(msg)) // 重放的成交只写一次
+
+	// 反手成交拆分出的两部分按拆分前的原始成交写入一次
+	reversed := hyperliquid.WsOrderFill{Coin: "BTC", Oid: 2, Tid: 8, Sz: "3", Px: "100", Dir: "Long > Short", StartPosition: "1", Time: 1700000000001}
+	closePart, openPart := reversed, reversed
+	closePart.Dir, closePart.Sz = "Close Long", "1"
+	openPart.Dir, openPart.Sz = "Open Short", "2"
+	require.NoError(t, processor.HandleMessage(OrderFillMessage{Address: "0x123", Fill: closePart, Direction: "Close Long", Reversed: reversed}))
+	require.NoError(t, processor.HandleMessage(OrderFillMessage{Address: "0x123", Fill: openPart, Direction: "Open Short", Reversed: reversed}))
+	writer.Stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, written["hl_fills"], 2)
+	rows := make(map[int64]*models.HlFill)
+	for _, item := range written["hl_fills"] {
+		rows[item.(FillItem).Fill.Tid] = item.(FillItem).Fill
+	}
+	row := rows[7]
+	require.NotNil(t, row)
+	assert.Equal(t, "0x123", row.Address)
+	assert.Equal(t, int64(1700000000000), row.FillTime)
+	assert.Equal(t, fill, row.Raw)
+	require.NotNil(t, rows[8])
+	assert.Equal(t, "Long > Short", rows[8].Dir)
+	assert.Equal(t, reversed, rows[8].Raw)
+}
+
+// TestOrderProcessor_Paused 测试暂停期间保留聚合订单，恢复后由超时扫描发送
+func TestOrderProcessor_Paused(t *testing.T) {
+	publisher := newMockPublisher()
+	p := &OrderProcessor{
+		pendingOrders: NewPendingOrderCache(),
+		publisher:     publisher,
+		timeout:       time.Minute,
+		flushChan:     make(chan flushKey, 10),
+	}
+	p.pendingOrders.Set("0x123-1-Open Long", &PendingOrder{
+		Aggregation:   &models.OrderAggregation{Oid: 1, Address: "0x123", Direction: "Open Long"},
+		FirstFillTime: time.Now().Add(-time.Hour),
+	})
+
+	p.SetPaused(true)
+	p.scanTimeoutOrders()
+	assert.Empty(t, p.flushChan)
+
+	p.flushOrder("0x123-1-Open Long", "status", "filled")
+	assert.Zero(t, publisher.GetSignalCount())
+	_, exists := p.pendingOrders.Get("0x123-1-Open Long")
+	assert.True(t, exists)
+
+	p.SetPaused(false)
+	p.scanTimeoutOrders()
+	require.Len(t, p.flushChan, 1)
+	assert.Equal(t, "timeout", (<-p.flushChan).trigger)
+}
+
+// TestOrderProcessor_EvictOverflow 测试聚合中的订单超过上限时按首笔成交时间提前发送最早的订单
+func TestOrderProcessor_EvictOverflow(t *testing.T) {
+	p := &OrderProcessor{
+		pendingOrders: NewPendingOrderCache(),
+		flushChan:     make(chan flushKey, 10),
+	}
+	p.SetMaxPending(2)
+
+	now := time.Now()
+	for i, age := range []time.Duration{time.Minute, 3 * time.Minute, 2 * time.Minute, 0} {
+		key := fmt.Sprintf("0x123-%d-Open Long", i)
+		p.pendingOrders.Set(key, &PendingOrder{
+			Aggregation:   &models.OrderAggregation{Oid: int64(i), Address: "0x123", Direction: "Open Long"},
+			FirstFillTime: now.Add(-age),
+		})
+	}
+
+	p.evictOverflow()
+	require.Len(t, p.flushChan, 2)
+	first, second := <-p.flushChan, <-p.flushChan
+	assert.Equal(t, "0x123-1-Open Long", first.key)
+	assert.Equal(t, "0x123-2-Open Long", second.key)
+	assert.Equal(t, "evicted", first.trigger)
+	assert.Equal(t, orderStatusEvicted, first.status)
+
+	// 尚未 flush 的订单不重复触发
+	p.evictOverflow()
+	assert.Empty(t, p.flushChan)
+
+	// 未设置上限时不淘汰
+	p.SetMaxPending(0)
+	p.evictOverflow()
+	assert.Empty(t, p.flushChan)
+}
+
+// TestOrderProcessor_EvictedOrderContinues 测试提前淘汰发送后，订单之后的成交开始新的聚合而不是被去重丢弃
+func TestOrderProcessor_EvictedOrderContinues(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&models.HlAddressSignal{}))
+	publisher := newMockPublisher()
+	processor := NewOrderProcessor(publisher, nil, cache.NewDedupCache(time.Minute), cache.NewSymbolCache(), nil, cache.NewPairCategoryCache())
+	defer processor.Stop()
+
+	fill := func(tid int64, sz string) {
+		require.NoError(t, processor.HandleMessage(OrderFillMessage{
+			Address: "0x123",
+			Fill: hyperliquid.WsOrderFill{
+				Oid: 1, Tid: tid, Sz: sz, Px: "100.0", Dir: "Open Long", Time: time.Now().UnixMilli(),
+			},
+			Direction: "Open Long",
+		}))
+	}
+	key := processor.orderKey("0x123", 1, "Open Long")
+
+	fill(1, "1")
+	evicted, ok := processor.pendingOrders.Get(key)
+	require.True(t, ok)
+	processor.flushOrder(key, flushTriggerEvicted, orderStatusEvicted)
+	require.Equal(t, 1, publisher.GetSignalCount())
+	assert.Equal(t, orderStatusEvicted, evicted.Aggregation.OrderStatus)
+	assert.Equal(t, nats.EvictedIdempotencyKey("0x123", 1, 1, "Open Long", ""), publisher.signals[0].IdempotencyKey)
+	assert.False(t, processor.deduper.IsSeen("0x123", 1, "Open Long"))
+
+	// 之后的成交开始新的聚合，完成时按订单幂等键发送
+	fill(2, "0.5")
+	pending, ok := processor.pendingOrders.Get(key)
+	require.True(t, ok)
+	assert.NotSame(t, evicted, pending)
+	processor.flushOrder(key, "status", "filled")
+	require.Equal(t, 2, publisher.GetSignalCount())
+	last := publisher.GetLastSignal()
+	assert.Equal(t, 0.5, last.Size)
+	assert.Equal(t, nats.IdempotencyKey("0x123", 1, "Open Long", ""), last.IdempotencyKey)
+	assert.True(t, processor.deduper.IsSeen("0x123", 1, "Open Long"))
+}
+
+// TestOrderProcessor_FlushOrders 测试按地址/订单手动触发发送
+func TestOrderProcessor_FlushOrders(t *testing.T) {
+	p := &OrderProcessor{
+		pendingOrders: NewPendingOrderCache(),
+		flushChan:     make(chan flushKey, 10),
+	}
+	p.pendingOrders.Set("0x123-1-Open Long", &PendingOrder{Aggregation: &models.OrderAggregation{Oid: 1, Address: "0x123"}})
+	p.pendingOrders.Set("0x123-2-Close Long", &PendingOrder{Aggregation: &models.OrderAggregation{Oid: 2, Address: "0x123"}})
+	p.pendingOrders.Set("0x456-3-Open Long", &PendingOrder{Aggregation: &models.OrderAggregation{Oid: 3, Address: "0x456"}})
+
+	cloidOrder := &PendingOrder{Aggregation: &models.OrderAggregation{Oid: 4, Address: "0x456", Cloid: "0xc"}}
+	cloidOrder.oids.Store(5, struct{}{})
+	p.pendingOrders.Set("0x456-c:0xc-Open Long", cloidOrder)
+
+	assert.Equal(t, 1, p.FlushOrders("0x123", 2))
+	req := <-p.flushChan
+	assert.Equal(t, "0x123-2-Close Long", req.key)
+	assert.Equal(t, "manual", req.trigger)
+
+	assert.Equal(t, 2, p.FlushOrders("0x123", 0))
+	assert.Equal(t, 1, p.FlushOrders("0x456", 5), "matches any oid of a cloid aggregation")
+	assert.Equal(t, 0, p.FlushOrders("0x789", 0))
+	assert.Len(t, p.flushChan, 3)
+}
+
+func TestOrderProcessor_FlushSymbol(t *testing.T) {
+	p := &OrderProcessor{
+		pendingOrders: NewPendingOrderCache(),
+		flushChan:     make(chan flushKey, 10),
+	}
+	p.pendingOrders.Set("0x123-1-Open Long", &PendingOrder{Aggregation: &models.OrderAggregation{Oid: 1, Address: "0x123", Symbol: "OLDUSDC"}})
+	p.pendingOrders.Set("0x456-2-Close Short", &PendingOrder{Aggregation: &models.OrderAggregation{Oid: 2, Address: "0x456", Symbol: "OLDUSDC"}})
+	p.pendingOrders.Set("0x456-3-Open Long", &PendingOrder{Aggregation: &models.OrderAggregation{Oid: 3, Address: "0x456", Symbol: "BTCUSDC"}})
+	// 已发送的聚合不再触发
+	p.pendingOrders.Set("0x789-4-Open Long", &PendingOrder{Aggregation: &models.OrderAggregation{Oid: 4, Address: "0x789", Symbol: "OLDUSDC", SignalSent: true}})
+
+	assert.Equal(t, 2, p.FlushSymbol("OLDUSDC"))
+	for i := 0; i < 2; i++ {
+		req := <-p.flushChan
+		assert.Equal(t, "delisted", req.trigger)
+		assert.Contains(t, []string{"0x123-1-Open Long", "0x456-2-Close Short"}, req.key)
+	}
+	assert.Equal(t, 0, p.FlushSymbol("ETHUSDC"))
+}
+
+// mockPendingStore 模拟未发送聚合存储
+type mockPendingStore struct {
+	aggs []*models.OrderAggregation
+}
+
+func (m *mockPendingStore) GetPending() ([]*models.OrderAggregation, error) {
+	return m.aggs, nil
+}
+
+// TestOrderProcessor_RestorePending 测试启动时恢复未发送的聚合，继续聚合且不重复计入已有成交
+func TestOrderProcessor_RestorePending(t *testing.T) {
+	p := &OrderProcessor{
+		pendingOrders: NewPendingOrderCache(),
+		timeout:       time.Minute,
+		flushChan:     make(chan flushKey, 10),
+	}
+
+	cloid := "0xabc"
+	store := &mockPendingStore{aggs: []*models.OrderAggregation{
+		{
+			Oid: 1, Address: "0x123", Direction: "Open Long", CreatedAt: time.Now(),
+			Fills: []hyperliquid.WsOrderFill{{Oid: 1, Tid: 10, Sz: "1", Px: "100"}},
+		},
+		{
+			Oid: 2, Address: "0x123", Direction: "Open Short", Cloid: cloid, CreatedAt: time.Now(),
+			Fills: []hyperliquid.WsOrderFill{{Oid: 2, Tid: 20, Cloid: &cloid}, {Oid: 3, Tid: 21, Cloid: &cloid}},
+		},
+		// 超出恢复窗口
+		{
+			Oid: 4, Address: "0x123", Direction: "Open Long", CreatedAt: time.Now().Add(-2 * pendingRestoreWindow),
+			Fills: []hyperliquid.WsOrderFill{{Oid: 4, Tid: 40}},
+		},
+	}}
+
+	n, err := p.RestorePending(store)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, 2, p.ActiveCount())
+
+	pending, ok := p.pendingOrders.Get("0x123-1-Open Long")
+	require.True(t, ok)
+	_, seen := pending.seenTids.Load(10)
+	assert.True(t, seen)
+
+	assert.Equal(t, "0x123-c:0xabc-Open Short", p.aggregationKey("0x123", 3, "Open Short"))
+	_, exists := p.pendingOrders.Get("0x123-4-Open Long")
+	assert.False(t, exists)
+
+	// 重启前创建的聚合超时后由超时扫描补发
+	pending.FirstFillTime = time.Now().Add(-time.Hour)
+	p.scanTimeoutOrders()
+	require.Len(t, p.flushChan, 1)
+	assert.Equal(t, "0x123-1-Open Long", (<-p.flushChan).key)
+}
+
+// TestOrderProcessor_RestorePendingStrategy 测试恢复的聚合按地址加载后的策略处理：scalper 完成时不重复发送汇总信号，swing 使用更长的超时
+func TestOrderProcessor_RestorePendingStrategy(t *testing.T) {
+	publisher := newMockPublisher()
+	p := &OrderProcessor{
+		pendingOrders: NewPendingOrderCache(),
+		publisher:     publisher,
+		timeout:       time.Minute,
+		flushChan:     make(chan flushKey, 10),
+	}
+
+	store := &mockPendingStore{aggs: []*models.OrderAggregation{
+		{
+			Oid: 1, Address: "0xabc", Direction: "Open Long", CreatedAt: time.Now(),
+			Fills: []hyperliquid.WsOrderFill{{Oid: 1, Tid: 10, Sz: "1", Px: "100"}},
+		},
+		{
+			Oid: 2, Address: "0xdef", Direction: "Open Long", CreatedAt: time.Now(),
+			Fills: []hyperliquid.WsOrderFill{{Oid: 2, Tid: 20, Sz: "1", Px: "100"}},
+		},
+	}}
+	n, err := p.RestorePending(store)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	// 恢复早于策略表的设置和地址的首次加载
+	strategies := NewAggregationStrategies(time.Hour)
+	strategies.Replace(map[string]string{"0xABC": AggregationScalper, "0xdef": AggregationSwing})
+	p.SetAggregationStrategies(strategies)
+
+	scalper, ok := p.pendingOrders.Get("0xabc-1-Open Long")
+	require.True(t, ok)
+	assert.True(t, scalper.perFill())
+	p.flushOrder("0xabc-1-Open Long", "status", "filled")
+	assert.Zero(t, publisher.GetSignalCount())
+	assert.Equal(t, 1, p.ActiveCount())
+
+	swing, ok := p.pendingOrders.Get("0xdef-2-Open Long")
+	require.True(t, ok)
+	assert.Equal(t, time.Hour, swing.timeout(p.timeout))
+	swing.FirstFillTime = time.Now().Add(-10 * time.Minute)
+	p.scanTimeoutOrders()
+	assert.Empty(t, p.flushChan)
+}
+
+// TestOrderProcessor_ScalperStrategy 测试 scalper 地址每笔成交立即发送，完成时不再发送汇总信号
+func TestOrderProcessor_ScalperStrategy(t *testing.T) {
+	publisher := newMockPublisher()
+	processor := NewOrderProcessor(publisher, nil, cache.NewDedupCache(time.Minute), cache.NewSymbolCache(), nil, cache.NewPairCategoryCache())
+	defer processor.Stop()
+
+	strategies := NewAggregationStrategies(time.Hour)
+	strategies.Replace(map[string]string{"0xABC": AggregationScalper})
+	processor.SetAggregationStrategies(strategies)
+
+	fill := func(tid int64, sz string) {
+		require.NoError(t, processor.HandleMessage(OrderFillMessage{
+			Address: "0xabc",
+			Fill: hyperliquid.WsOrderFill{
+				Oid: 1, Tid: tid, Sz: sz, Px: "100.0", Dir: "Open Long", Time: time.Now().UnixMilli(),
+			},
+			Direction: "Open Long",
+		}))
+	}
+
+	fill(1, "0.5")
+	assert.Eventually(t, func() bool { return publisher.GetSignalCount() == 1 }, time.Second, 10*time.Millisecond)
+	fill(2, "1.5")
+	fill(2, "1.5") // 重复推送
+	assert.Eventually(t, func() bool { return publisher.GetSignalCount() == 2 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, processor.ActiveCount())
+
+	assert.Equal(t, 0.5, publisher.signals[0].Size)
+	assert.Equal(t, 1.5, publisher.signals[1].Size)
+	assert.NotEqual(t, publisher.signals[0].IdempotencyKey, publisher.signals[1].IdempotencyKey)
+	assert.Equal(t, nats.FillIdempotencyKey("0xabc", 1, 2, "Open Long", ""), publisher.signals[1].IdempotencyKey)
+
+	// 订单完成时只结束聚合，累计数量照常持久化
+	require.NoError(t, processor.HandleMessage(OrderUpdateMessage{Address: "0xabc", Oid: 1, Status: "filled"}))
+	assert.Eventually(t, func() bool { return processor.ActiveCount() == 0 }, time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 2, publisher.GetSignalCount())
+
+	// 已完成订单的迟到成交被去重
+	fill(3, "1")
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 2, publisher.GetSignalCount())
+}
+
+// TestOrderProcessor_SwingStrategy 测试 swing 地址使用更长的聚合超时
+func TestOrderProcessor_SwingStrategy(t *testing.T) {
+	publisher := newMockPublisher()
+	processor := NewOrderProcessor(publisher, nil, nil, cache.NewSymbolCache(), nil, cache.NewPairCategoryCache())
+	defer processor.Stop()
+	processor.SetTimeout(20 * time.Millisecond)
+
+	strategies := NewAggregationStrategies(time.Hour)
+	strategies.Replace(map[string]string{"0xswing": AggregationSwing})
+	processor.SetAggregationStrategies(strategies)
+
+	for i, addr := range []string{"0xswing", "0xdefault"} {
+		require.NoError(t, processor.HandleMessage(OrderFillMessage{
+			Address: addr,
+			Fill: hyperliquid.WsOrderFill{
+				Oid: int64(i + 1), Tid: int64(i + 1), Sz: "1", Px: "100.0", Dir: "Open Long", Time: time.Now().UnixMilli(),
+			},
+			Direction: "Open Long",
+		}))
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	processor.scanTimeoutOrders()
+	assert.Eventually(t, func() bool { return publisher.GetSignalCount() == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "0xdefault", publisher.GetLastSignal().Address)
+	_, exists := processor.pendingOrders.Get("0xswing-1-Open Long")
+	assert.True(t, exists)
+}
+
+// TestOrderProcessor_SignalFilter 测试按地址过滤的成交不进入聚合
+func TestOrderProcessor_SignalFilter(t *testing.T) {
+	publisher := newMockPublisher()
+	processor := NewOrderProcessor(publisher, nil, nil, cache.NewSymbolCache(), nil, cache.NewPairCategoryCache())
+	defer processor.Stop()
+
+	filters := NewSignalFilters()
+	filters.Replace(map[string]string{"0xentry": "open,futures"})
+	processor.SetSignalFilters(filters)
+
+	for i, dir := range []string{"Open Long", "Close Long", "Buy", "Open Short"} {
+		require.NoError(t, processor.HandleMessage(OrderFillMessage{
+			Address: "0xEntry",
+			Fill: hyperliquid.WsOrderFill{
+				Oid: int64(i + 1), Tid: int64(i + 1), Sz: "1", Px: "100.0", Dir: dir, Time: time.Now().UnixMilli(),
+			},
+			Direction: dir,
+		}))
+	}
+	// 未配置过滤的地址全部聚合
+	require.NoError(t, processor.HandleMessage(OrderFillMessage{
+		Address:   "0xother",
+		Fill:      hyperliquid.WsOrderFill{Oid: 9, Tid: 9, Sz: "1", Px: "100.0", Dir: "Close Long", Time: time.Now().UnixMilli()},
+		Direction: "Close Long",
+	}))
+
+	assert.Equal(t, 3, processor.ActiveCount())
+	for _, key := range []string{"0xEntry-1-Open Long", "0xEntry-4-Open Short", "0xother-9-Close Long"} {
+		_, exists := processor.pendingOrders.Get(key)
+		assert.True(t, exists, key)
+	}
+}
+
+// TestOrderProcessor_ProgressSignals 测试累计成交越过进度阈值时发送进度信号
+func TestOrderProcessor_ProgressSignals(t *testing.T) {
+	publisher := newMockPublisher()
+	processor := NewOrderProcessor(publisher, nil, cache.NewDedupCache(time.Minute), cache.NewSymbolCache(), nil, cache.NewPairCategoryCache())
+	defer processor.Stop()
+	processor.SetProgressThresholds([]float64{75, 25, 50})
+
+	fill := func(tid int64, sz string) {
+		require.NoError(t, processor.HandleMessage(OrderFillMessage{
+			Address: "0xabc",
+			Fill: hyperliquid.WsOrderFill{
+				Oid: 1, Tid: tid, Sz: sz, Px: "100.0", Dir: "Open Long", Time: time.Now().UnixMilli(),
+			},
+			Direction: "Open Long",
+		}))
+	}
+
+	require.NoError(t, processor.HandleMessage(OrderUpdateMessage{Address: "0xabc", Oid: 1, Status: "open", OrigSz: "4"}))
+
+	fill(1, "0.5")
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 0, publisher.GetSignalCount())
+
+	// 越过 25%
+	fill(2, "0.6")
+	assert.Eventually(t, func() bool { return publisher.GetSignalCount() == 1 }, time.Second, 10*time.Millisecond)
+	first := publisher.signals[0]
+	assert.True(t, first.IsPartial)
+	assert.Equal(t, 27.5, first.FillPercent)
+	assert.InDelta(t, 1.1, first.Size, 1e-9)
+	assert.Equal(t, nats.ProgressIdempotencyKey("0xabc", 1, "Open Long", "", 25), first.IdempotencyKey)
+
+	// 一次越过 50% 和 75%，只按 75% 发送一次
+	fill(3, "1.9")
+	assert.Eventually(t, func() bool { return publisher.GetSignalCount() == 2 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, nats.ProgressIdempotencyKey("0xabc", 1, "Open Long", "", 75), publisher.signals[1].IdempotencyKey)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 2, publisher.GetSignalCount())
+
+	// 成交完成发送完整信号
+	fill(4, "1")
+	assert.Eventually(t, func() bool { return publisher.GetSignalCount() == 3 }, time.Second, 10*time.Millisecond)
+	last := publisher.GetLastSignal()
+	assert.False(t, last.IsPartial)
+	assert.Equal(t, 4.0, last.Size)
+	assert.Equal(t, nats.IdempotencyKey("0xabc", 1, "Open Long", ""), last.IdempotencyKey)
+}
+
+// scopeFailPublisher 指定作用域首次发布失败，记录成功发布的幂等键
+type scopeFailPublisher struct {
+	mu     sync.Mutex
+	failOn map[string]int // 作用域 -> 剩余失败次数
+	keys   []string
+}
+
+func (s *scopeFailPublisher) PublishAddressSignal(signal *nats.HlAddressSignal) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failOn[signal.Scope] > 0 {
+		s.failOn[signal.Scope]--
+		return errors.New("nats down")
+	}
+	s.keys = append(s.keys, signal.IdempotencyKey)
+	return nil
+}
+
+func (s *scopeFailPublisher) published() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.keys...)
+}
+
+// TestOrderProcessor_ProgressRetryAndCompletion 测试进度信号重试只补发失败的作用域，完成发送开始后不再发送进度信号
+func TestOrderProcessor_ProgressRetryAndCompletion(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&models.HlAddressSignal{}))
+	publisher := &scopeFailPublisher{failOn: map[string]int{"b": 1}}
+	processor := NewOrderProcessor(publisher, nil, cache.NewDedupCache(time.Minute), cache.NewSymbolCache(), nil, cache.NewPairCategoryCache())
+	defer processor.Stop()
+	scopes := cache.NewAddressScopes()
+	scopes.Set("0xabc", []string{"a", "b"})
+	processor.SetAddressScopes(scopes)
+	processor.SetProgressThresholds([]float64{50, 90})
+
+	fill := func(tid int64, sz string) {
+		require.NoError(t, processor.HandleMessage(OrderFillMessage{
+			Address: "0xabc",
+			Fill: hyperliquid.WsOrderFill{
+				Oid: 1, Tid: tid, Sz: sz, Px: "100.0", Dir: "Open Long", Time: time.Now().UnixMilli(),
+			},
+			Direction: "Open Long",
+		}))
+	}
+	require.NoError(t, processor.HandleMessage(OrderUpdateMessage{Address: "0xabc", Oid: 1, Status: "open", OrigSz: "2"}))
+
+	// 越过 50%：作用域 b 发送失败
+	fill(1, "1.2")
+	assert.Eventually(t, func() bool { return len(publisher.published()) == 1 }, time.Second, 10*time.Millisecond)
+
+	// 下一笔成交重试，只补发作用域 b
+	fill(2, "0.1")
+	want := []string{
+		nats.ProgressIdempotencyKey("0xabc", 1, "Open Long", "a", 50),
+		nats.ProgressIdempotencyKey("0xabc", 1, "Open Long", "b", 50),
+	}
+	assert.Eventually(t, func() bool { return len(publisher.published()) == 2 }, time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, want, publisher.published())
+
+	// 完成发送失败后，即使越过下一个阈值也不再发送进度信号
+	publisher.mu.Lock()
+	publisher.failOn["a"] = 1
+	publisher.mu.Unlock()
+	key := processor.orderKey("0xabc", 1, "Open Long")
+	processor.flushOrder(key, "status", "filled")
+	pending, ok := processor.pendingOrders.Get(key)
+	require.True(t, ok)
+	fill(3, "0.6")
+	processor.flushProgress(pending)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, want, publisher.published())
+}
+
+// mockLeverageSource 模拟 activeAssetData 查询
+type mockLeverageSource struct {
+	leverage hyperliquid.Leverage
+	err      error
+	calls    int
+}
+
+func (m *mockLeverageSource) UserActiveAssetData(_ context.Context, address, coin string) (*hyperliquid.UserActiveAssetData, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &hyperliquid.UserActiveAssetData{User: address, Coin: coin, Leverage: m.leverage}, nil
+}
+
+// TestOrderProcessor_ApplyLeverage 测试合约信号附带杠杆和保证金模式
+func TestOrderProcessor_ApplyLeverage(t *testing.T) {
+	positionBalanceCache := cache.NewPositionBalanceCache()
+	positionBalanceCache.Set("0x123", 0, 50000.0, nil, &models.FuturesPositionsData{
+		{Coin: "BTCUSDC", Szi: "1", Leverage: models.LeverageItem{Type: "isolated", Value: 10}},
+	})
+	p := NewOrderProcessor(newMockPublisher(), nil, cache.NewDedupCache(30*time.Minute), cache.NewSymbolCache(), positionBalanceCache, nil)
+
+	// 仓位缓存中的杠杆
+	signal := &nats.HlAddressSignal{Address: "0x123", Symbol: "BTCUSDC"}
+	p.applyLeverage(signal, "BTC")
+	assert.Equal(t, 10, signal.Leverage)
+	assert.Equal(t, "isolated", signal.MarginMode)
+
+	// 未设置查询时不附带
+	signal = &nats.HlAddressSignal{Address: "0x123", Symbol: "ETHUSDC"}
+	p.applyLeverage(signal, "ETH")
+	assert.Zero(t, signal.Leverage)
+	assert.Empty(t, signal.MarginMode)
+
+	// 查询失败后冷却期内不再查询
+	source := &mockLeverageSource{err: fmt.Errorf("timeout")}
+	p.SetLeverageSource(source)
+	p.applyLeverage(signal, "ETH")
+	p.applyLeverage(signal, "ETH")
+	assert.Equal(t, 1, source.calls)
+	assert.Zero(t, signal.Leverage)
+
+	// 查询成功后写入仓位缓存
+	source = &mockLeverageSource{leverage: hyperliquid.Leverage{Type: "cross", Value: 25}}
+	p.SetLeverageSource(source)
+	signal = &nats.HlAddressSignal{Address: "0x123", Symbol: "SOLUSDC"}
+	p.applyLeverage(signal, "SOL")
+	p.applyLeverage(signal, "SOL")
+	assert.Equal(t, 1, source.calls)
+	assert.Equal(t, 25, signal.Leverage)
+	assert.Equal(t, "cross", signal.MarginMode)
+	leverage, ok := positionBalanceCache.GetLeverage("0x123", "SOLUSDC")
+	require.True(t, ok)
+	assert.Equal(t, 25, leverage.Value)
+}
@@ -0,0 +1,47 @@
+package processor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+)
+
+// sizeEpsilon 成交量与原始数量比较的容差（字符串转浮点的误差）
+const sizeEpsilon = 1e-9
+
+// origSizeTracker 订单原始数量记录
+// 来源于 orderUpdates 和 webData2 挂单列表，用于成交量达到原始数量时提前 flush
+type origSizeTracker struct {
+	cache *cache.Cache // key: "address-oid", value: float64
+}
+
+// newOrigSizeTracker 创建原始数量记录，ttl 与状态追踪器一致
+func newOrigSizeTracker(ttl time.Duration) *origSizeTracker {
+	return &origSizeTracker{
+		cache: cache.New(ttl, 1*time.Minute),
+	}
+}
+
+// Set 记录订单原始数量
+func (t *origSizeTracker) Set(address string, oid int64, origSz float64) {
+	t.cache.Set(fmt.Sprintf("%s-%d", address, oid), origSz, cache.DefaultExpiration)
+}
+
+// Get 获取订单原始数量
+func (t *origSizeTracker) Get(address string, oid int64) (float64, bool) {
+	if val, found := t.cache.Get(fmt.Sprintf("%s-%d", address, oid)); found {
+		return val.(float64), true
+	}
+	return 0, false
+}
+
+// Remove 移除记录
+func (t *origSizeTracker) Remove(address string, oid int64) {
+	t.cache.Delete(fmt.Sprintf("%s-%d", address, oid))
+}
+
+// sizeFilled 判断累计成交量是否已达到原始数量
+func sizeFilled(total, origSz float64) bool {
+	return origSz > 0 && total >= origSz-sizeEpsilon*origSz
+}
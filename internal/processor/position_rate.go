@@ -0,0 +1,109 @@
+package processor
+
+import (
+	"fmt"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
+)
+
+// 仓位比例分母（合约信号），现货信号始终使用现货总价值
+const (
+	PositionRateBasisAccountValue   = "account_value"   // 账户价值（默认）
+	PositionRateBasisWithdrawable   = "withdrawable"    // 可提取金额
+	PositionRateBasisFreeCollateral = "free_collateral" // 空闲保证金: 账户价值 - 已占用保证金
+	PositionRateBasisMarginUsed     = "margin_used"     // 已占用初始保证金
+	PositionRateBasisSpotTotal      = "spot_total"      // 现货总价值（现货信号）
+)
+
+// 余额过期时的处理方式
+const (
+	PositionRateStaleAnnotate = "annotate" // 照常计算并标记 balance_stale（默认）
+	PositionRateStaleSkip     = "skip"     // 不计算仓位比例和平仓比例（置 0）
+)
+
+// PositionRateStrategy 按去重作用域（逻辑消费者）选择仓位比例分母
+type PositionRateStrategy struct {
+	basis     string
+	scopes    map[string]string
+	staleSkip bool // 余额过期时不计算比例
+}
+
+// NewPositionRateStrategy 创建分母策略，未配置时使用账户价值
+func NewPositionRateStrategy(cfg config.PositionRate) (*PositionRateStrategy, error) {
+	if cfg.Basis == "" {
+		cfg.Basis = PositionRateBasisAccountValue
+	}
+	if !validPositionRateBasis(cfg.Basis) {
+		return nil, fmt.Errorf("position_rate: unknown basis %q", cfg.Basis)
+	}
+
+	scopes := make(map[string]string, len(cfg.ScopeBasis))
+	for scope, basis := range cfg.ScopeBasis {
+		if !validPositionRateBasis(basis) {
+			return nil, fmt.Errorf("position_rate: unknown basis %q for scope %q", basis, scope)
+		}
+		scopes[scope] = basis
+	}
+
+	switch cfg.StaleMode {
+	case "", PositionRateStaleAnnotate, PositionRateStaleSkip:
+	default:
+		return nil, fmt.Errorf("position_rate: unknown stale_mode %q", cfg.StaleMode)
+	}
+
+	return &PositionRateStrategy{basis: cfg.Basis, scopes: scopes, staleSkip: cfg.StaleMode == PositionRateStaleSkip}, nil
+}
+
+// SkipStale 余额过期时是否跳过比例计算
+func (s *PositionRateStrategy) SkipStale() bool {
+	return s != nil && s.staleSkip
+}
+
+// Basis 返回作用域使用的合约分母，未单独配置时使用默认分母
+func (s *PositionRateStrategy) Basis(scope string) string {
+	if s == nil {
+		return PositionRateBasisAccountValue
+	}
+	if basis, ok := s.scopes[scope]; ok {
+		return basis
+	}
+	return s.basis
+}
+
+func validPositionRateBasis(basis string) bool {
+	switch basis {
+	case PositionRateBasisAccountValue, PositionRateBasisWithdrawable,
+		PositionRateBasisFreeCollateral, PositionRateBasisMarginUsed:
+		return true
+	}
+	return false
+}
+
+// positionRateDenominator 按分母策略读取地址的分母金额
+func positionRateDenominator(balances *cache.PositionBalanceCache, address, basis string) (float64, bool) {
+	switch basis {
+	case PositionRateBasisSpotTotal:
+		return balances.GetSpotTotal(address)
+	case PositionRateBasisAccountValue:
+		return balances.GetAccountValue(address)
+	}
+
+	margin, ok := balances.GetMargin(address)
+	if !ok {
+		return 0, false
+	}
+	switch basis {
+	case PositionRateBasisWithdrawable:
+		return margin.Withdrawable, true
+	case PositionRateBasisMarginUsed:
+		return margin.TotalMarginUsed, true
+	case PositionRateBasisFreeCollateral:
+		accountValue, ok := balances.GetAccountValue(address)
+		if !ok {
+			return 0, false
+		}
+		return accountValue - margin.TotalMarginUsed, true
+	}
+	return 0, false
+}
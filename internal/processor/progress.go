@@ -0,0 +1,171 @@
+package processor
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// flushTriggerProgress 累计成交越过进度阈值触发的发送，只发送进度信号，不完成聚合
+const flushTriggerProgress = "progress"
+
+// SetProgressThresholds 设置成交进度阈值（可选，百分比），为空时不发送进度信号
+func (p *OrderProcessor) SetProgressThresholds(thresholds []float64) {
+	sorted := append([]float64(nil), thresholds...)
+	sort.Float64s(sorted)
+	p.progressThresholds = sorted
+}
+
+// checkProgress 订单累计成交越过下一个进度阈值时触发进度信号（反手订单各方向分别计算）
+// 需要订单原始数量；已达到原始数量的订单由完整信号发送
+func (p *OrderProcessor) checkProgress(address string, oid int64) {
+	if len(p.progressThresholds) == 0 {
+		return
+	}
+	origSz, ok := p.origSizes.Get(address, oid)
+	if !ok {
+		return
+	}
+
+	for _, dir := range allDirections {
+		key := p.aggregationKey(address, oid, dir)
+		pending, exists := p.pendingOrders.Get(key)
+		if !exists || pending.Aggregation.SignalSent || pending.completing.Load() || pending.perFill() {
+			continue
+		}
+		filled := pending.filledSize(oid)
+		if sizeFilled(filled, origSz) {
+			continue
+		}
+		next := int(pending.progressSent.Load())
+		if next < len(p.progressThresholds) && filled/origSz*100 >= p.progressThresholds[next] {
+			p.triggerFlush(key, flushTriggerProgress, "")
+		}
+	}
+}
+
+// flushProgress 发送进度信号：按截至此时的累计成交生成 is_partial 信号，聚合继续累计直到完成
+// 一次越过多个阈值时只按最高的阈值发送；发送失败时不推进阈值，由下一笔成交重试（幂等键不变，已发送的作用域跳过）
+// 完成聚合的发送开始后不再发送，保证进度信号不晚于完成信号
+func (p *OrderProcessor) flushProgress(pending *PendingOrder) {
+	pending.sendMu.Lock()
+	defer pending.sendMu.Unlock()
+
+	agg := pending.Aggregation
+	if agg.SignalSent || pending.completing.Load() {
+		return
+	}
+
+	// 成交由消息处理协程并发追加，按快照生成信号
+	pending.fillMu.Lock()
+	if len(agg.Fills) == 0 {
+		pending.fillMu.Unlock()
+		return
+	}
+	oid := agg.Fills[len(agg.Fills)-1].Oid
+	filled := pending.filledSize(oid)
+	snapshot := *agg
+	snapshot.Fills = slices.Clone(agg.Fills)
+	pending.fillMu.Unlock()
+
+	origSz, ok := p.origSizes.Get(agg.Address, oid)
+	if !ok {
+		return
+	}
+	if sizeFilled(filled, origSz) {
+		return
+	}
+	percent := filled / origSz * 100
+
+	level := int(pending.progressSent.Load())
+	crossed := level
+	for crossed < len(p.progressThresholds) && percent >= p.progressThresholds[crossed] {
+		crossed++
+	}
+	if crossed == level {
+		return
+	}
+	threshold := p.progressThresholds[crossed-1]
+
+	// 备实例不发送进度信号，接管后按当时的进度发送
+	if p.leader != nil && !p.leader.IsLeader() {
+		monitor.IncSignalSuppressed()
+		return
+	}
+
+	signal := p.buildSignal(&snapshot)
+	if signal == nil || p.runSignalHooks(signal, &snapshot) {
+		pending.progressSent.Store(int32(crossed))
+		return
+	}
+	signal.IsPartial = true
+	signal.FillPercent = math.Round(percent*100) / 100
+
+	var published, queued []*nats.HlAddressSignal
+	for _, scope := range p.scopes.Get(agg.Address) {
+		if _, sent := pending.progressScopes[threshold][scope]; sent {
+			continue
+		}
+		scoped := *signal
+		scoped.Scope = scope
+		p.applyPositionRate(&scoped, scope)
+		scoped.IdempotencyKey = nats.ProgressIdempotencyKey(agg.Address, agg.Oid, agg.Direction, scope, threshold)
+		if p.outbox != nil {
+			queued = append(queued, &scoped)
+			continue
+		}
+		if err := p.publisher.PublishAddressSignal(&scoped); err != nil {
+			logger.Error().Err(err).Int64("oid", agg.Oid).Str("scope", scope).
+				Str("error_code", nats.ErrorCode(err)).
+				Str("trace_id", signal.TraceID).Msg("publish progress signal failed")
+			p.persistSignals(agg.Oid, published)
+			return
+		}
+		pending.markProgressSent(threshold, scope)
+		published = append(published, &scoped)
+	}
+
+	// 发件箱模式下信号与未完成的聚合在同一事务中写入
+	if len(queued) > 0 {
+		if err := p.outbox.Enqueue(agg, queued); err != nil {
+			logger.Error().Err(err).Int64("oid", agg.Oid).
+				Str("trace_id", signal.TraceID).Msg("enqueue progress signals to outbox failed")
+			return
+		}
+		for _, s := range queued {
+			pending.markProgressSent(threshold, s.Scope)
+		}
+	}
+	pending.progressSent.Store(int32(crossed))
+	delete(pending.progressScopes, threshold)
+
+	monitor.IncOrderFlush(flushTriggerProgress)
+	monitor.ObserveOrderFlushLatency(flushTriggerProgress, time.Since(pending.FirstFillTime).Seconds())
+	p.persistSignals(agg.Oid, published)
+
+	logger.Info().
+		Int64("oid", agg.Oid).
+		Str("symbol", signal.Symbol).
+		Float64("size", signal.Size).
+		Float64("fill_percent", signal.FillPercent).
+		Float64("threshold", threshold).
+		Int("scopes", len(published)+len(queued)).
+		Str("trace_id", signal.TraceID).
+		Msg("progress signal sent")
+}
+
+// markProgressSent 记录进度阈值已发送的作用域，调用方需持有 sendMu
+func (o *PendingOrder) markProgressSent(threshold float64, scope string) {
+	if o.progressScopes == nil {
+		o.progressScopes = make(map[float64]map[string]struct{})
+	}
+	if o.progressScopes[threshold] == nil {
+		o.progressScopes[threshold] = make(map[string]struct{})
+	}
+	o.progressScopes[threshold][scope] = struct{}{}
+}
@@ -0,0 +1,59 @@
+package processor
+
+import (
+	"strings"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+)
+
+// severityLevels 按排序值索引的级别名称
+var severityLevels = [...]string{nats.SeverityInfo, nats.SeverityNotable, nats.SeverityCritical}
+
+// SeverityClassifier 信号级别分类器
+// 成交名义价值和仓位比例分别按阈值定级，取较高者；地址评分达到 boost_score 时再提升一级
+type SeverityClassifier struct {
+	cfg    config.Severity
+	scores map[string]float64 // 小写地址 -> 评分
+}
+
+// NewSeverityClassifier 创建信号级别分类器
+func NewSeverityClassifier(cfg config.Severity) *SeverityClassifier {
+	scores := make(map[string]float64, len(cfg.AddressScores))
+	for address, score := range cfg.AddressScores {
+		scores[strings.ToLower(address)] = score
+	}
+	return &SeverityClassifier{cfg: cfg, scores: scores}
+}
+
+// Score 地址评分
+func (c *SeverityClassifier) Score(address string) (float64, bool) {
+	score, ok := c.scores[strings.ToLower(address)]
+	return score, ok
+}
+
+// Classify 计算信号级别
+// 仓位比例在余额缺失（分母为 0，比例回退为 100）或过期时不参与判断，避免误判为 critical
+func (c *SeverityClassifier) Classify(signal *nats.HlAddressSignal) string {
+	rank := severityRank(signal.NotionalUSD, c.cfg.NotableNotionalUSD, c.cfg.CriticalNotionalUSD)
+	if signal.PositionRateDenominator > 0 && !signal.BalanceStale {
+		rank = max(rank, severityRank(signal.PositionRate, c.cfg.NotablePositionRate, c.cfg.CriticalPositionRate))
+	}
+	if c.cfg.BoostScore > 0 {
+		if score, ok := c.Score(signal.Address); ok && score >= c.cfg.BoostScore {
+			rank = min(rank+1, len(severityLevels)-1)
+		}
+	}
+	return severityLevels[rank]
+}
+
+// severityRank 按阈值定级，阈值为 0 表示不按该级别判断
+func severityRank(value, notable, critical float64) int {
+	switch {
+	case critical > 0 && value >= critical:
+		return 2
+	case notable > 0 && value >= notable:
+		return 1
+	}
+	return 0
+}
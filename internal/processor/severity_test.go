@@ -0,0 +1,45 @@
+package processor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+)
+
+func TestSeverityClassifier_Classify(t *testing.T) {
+	c := NewSeverityClassifier(config.Severity{
+		NotableNotionalUSD:   100000,
+		CriticalNotionalUSD:  1000000,
+		NotablePositionRate:  25,
+		CriticalPositionRate: 75,
+		BoostScore:           80,
+		AddressScores:        map[string]float64{"0xABC": 90, "0xdef": 50},
+	})
+
+	cases := []struct {
+		name   string
+		signal nats.HlAddressSignal
+		want   string
+	}{
+		{"small", nats.HlAddressSignal{Address: "0x1", NotionalUSD: 5000, PositionRate: 5, PositionRateDenominator: 100000}, nats.SeverityInfo},
+		{"notional notable", nats.HlAddressSignal{Address: "0x1", NotionalUSD: 200000}, nats.SeverityNotable},
+		{"notional critical", nats.HlAddressSignal{Address: "0x1", NotionalUSD: 1000000}, nats.SeverityCritical},
+		{"rate critical", nats.HlAddressSignal{Address: "0x1", NotionalUSD: 5000, PositionRate: 80, PositionRateDenominator: 6250}, nats.SeverityCritical},
+		// 余额缺失时比例回退为 100，不参与判断
+		{"rate without balance", nats.HlAddressSignal{Address: "0x1", NotionalUSD: 5000, PositionRate: 100}, nats.SeverityInfo},
+		{"rate stale", nats.HlAddressSignal{Address: "0x1", NotionalUSD: 5000, PositionRate: 80, PositionRateDenominator: 6250, BalanceStale: true}, nats.SeverityInfo},
+		{"score boost", nats.HlAddressSignal{Address: "0xabc", NotionalUSD: 200000}, nats.SeverityCritical},
+		{"score boost capped", nats.HlAddressSignal{Address: "0xabc", NotionalUSD: 2000000}, nats.SeverityCritical},
+		{"score below boost", nats.HlAddressSignal{Address: "0xdef", NotionalUSD: 5000}, nats.SeverityInfo},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.want, c.Classify(&tc.signal), tc.name)
+	}
+
+	// 阈值为 0 时不按该项判断
+	c = NewSeverityClassifier(config.Severity{NotablePositionRate: 10})
+	assert.Equal(t, nats.SeverityInfo, c.Classify(&nats.HlAddressSignal{NotionalUSD: 1e9}))
+}
@@ -0,0 +1,104 @@
+package processor
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// 信号过滤取值，对应 hl_watch_addresses.signal_filter（逗号分隔）
+// 方向和资产类型各自独立：同组内任一匹配即放行，未配置的组不限制
+const (
+	SignalFilterOpen    = "open"    // 只发送开仓信号
+	SignalFilterClose   = "close"   // 只发送平仓信号
+	SignalFilterFutures = "futures" // 只发送合约信号
+	SignalFilterSpot    = "spot"    // 只发送现货信号
+)
+
+// 信号过滤原因（hl_monitor_fills_filtered_total 的 reason 标签）
+const (
+	signalFilterReasonDirection = "direction"
+	signalFilterReasonAssetType = "asset_type"
+)
+
+// SignalFilter 单个地址的信号过滤，零值放行全部信号
+type SignalFilter struct {
+	directions []string // open / close，为空不限制
+	assetTypes []string // futures / spot，为空不限制
+}
+
+// ParseSignalFilter 解析逗号分隔的过滤配置，如 "open" 或 "open,futures"，空字符串放行全部
+func ParseSignalFilter(s string) (SignalFilter, error) {
+	var f SignalFilter
+	for _, token := range strings.Split(s, ",") {
+		token = strings.ToLower(strings.TrimSpace(token))
+		switch token {
+		case "":
+		case SignalFilterOpen, SignalFilterClose:
+			f.directions = append(f.directions, token)
+		case SignalFilterFutures, SignalFilterSpot:
+			f.assetTypes = append(f.assetTypes, token)
+		default:
+			return SignalFilter{}, fmt.Errorf("unknown signal filter %q, want open, close, futures or spot", token)
+		}
+	}
+	return f, nil
+}
+
+// IsZero 是否放行全部信号
+func (f SignalFilter) IsZero() bool {
+	return len(f.directions) == 0 && len(f.assetTypes) == 0
+}
+
+// Check 检查信号方向（open/close）和资产类型（futures/spot），返回是否放行及拦截原因
+func (f SignalFilter) Check(direction, assetType string) (bool, string) {
+	if len(f.directions) > 0 && !slices.Contains(f.directions, direction) {
+		return false, signalFilterReasonDirection
+	}
+	if len(f.assetTypes) > 0 && !slices.Contains(f.assetTypes, assetType) {
+		return false, signalFilterReasonAssetType
+	}
+	return true, ""
+}
+
+// SignalFilters 按地址过滤信号
+// 地址分配由地址加载器定期从 hl_watch_addresses 刷新；未配置的地址放行全部信号
+type SignalFilters struct {
+	addresses atomic.Pointer[map[string]SignalFilter] // 小写地址 -> 过滤
+}
+
+// NewSignalFilters 创建地址信号过滤表
+func NewSignalFilters() *SignalFilters {
+	return &SignalFilters{}
+}
+
+// Replace 替换地址分配（地址 -> 过滤配置），无法解析的配置记录告警并放行全部信号
+func (s *SignalFilters) Replace(assignments map[string]string) {
+	addresses := make(map[string]SignalFilter, len(assignments))
+	for addr, raw := range assignments {
+		f, err := ParseSignalFilter(raw)
+		if err != nil {
+			logger.Warn().Err(err).Str("address", addr).Msg("invalid signal filter, publishing all signals")
+			continue
+		}
+		if f.IsZero() {
+			continue
+		}
+		addresses[strings.ToLower(addr)] = f
+	}
+	s.addresses.Store(&addresses)
+}
+
+// For 获取地址的过滤，未配置时返回零值
+func (s *SignalFilters) For(address string) SignalFilter {
+	if s == nil {
+		return SignalFilter{}
+	}
+	if addresses := s.addresses.Load(); addresses != nil {
+		return (*addresses)[strings.ToLower(address)]
+	}
+	return SignalFilter{}
+}
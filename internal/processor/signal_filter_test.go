@@ -0,0 +1,49 @@
+package processor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSignalFilter(t *testing.T) {
+	f, err := ParseSignalFilter(" Open , futures,")
+	require.NoError(t, err)
+
+	allowed, _ := f.Check("open", "futures")
+	assert.True(t, allowed)
+	allowed, reason := f.Check("close", "futures")
+	assert.False(t, allowed)
+	assert.Equal(t, signalFilterReasonDirection, reason)
+	allowed, reason = f.Check("open", "spot")
+	assert.False(t, allowed)
+	assert.Equal(t, signalFilterReasonAssetType, reason)
+
+	// 同类取并集
+	f, err = ParseSignalFilter("open,close")
+	require.NoError(t, err)
+	allowed, _ = f.Check("close", "spot")
+	assert.True(t, allowed)
+
+	f, err = ParseSignalFilter("")
+	require.NoError(t, err)
+	assert.True(t, f.IsZero())
+
+	_, err = ParseSignalFilter("open,perp")
+	assert.ErrorContains(t, err, `"perp"`)
+}
+
+func TestSignalFilters(t *testing.T) {
+	s := NewSignalFilters()
+	assert.True(t, s.For("0xaaa").IsZero(), "nothing assigned yet")
+
+	s.Replace(map[string]string{"0xAAA": "close", "0xbbb": "bogus", "0xccc": ""})
+	allowed, _ := s.For("0xaaa").Check("open", "futures")
+	assert.False(t, allowed)
+	assert.True(t, s.For("0xbbb").IsZero(), "invalid filter publishes all")
+	assert.True(t, s.For("0xccc").IsZero())
+
+	var none *SignalFilters
+	assert.True(t, none.For("0xaaa").IsZero())
+}
@@ -0,0 +1,132 @@
+package processor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"plugin"
+	"sort"
+
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// ErrDropSignal 钩子返回该错误（可包装）时丢弃信号，订单按已发送处理，不再重试
+var ErrDropSignal = errors.New("signal dropped by hook")
+
+// SignalHook 信号发布前的扩展钩子，可修改、补充（Extra）或丢弃信号
+// 在所有作用域发布前对基础信号执行一次，按注册顺序串行调用；aggregation 只读
+// 返回 ErrDropSignal 丢弃信号；返回其他错误或 panic 时记录日志，信号照常发送（钩子不应留下部分修改）
+type SignalHook interface {
+	BeforeSignal(signal *nats.HlAddressSignal, aggregation *models.OrderAggregation) error
+}
+
+// SignalHookFunc 函数形式的钩子
+type SignalHookFunc func(signal *nats.HlAddressSignal, aggregation *models.OrderAggregation) error
+
+func (f SignalHookFunc) BeforeSignal(signal *nats.HlAddressSignal, aggregation *models.OrderAggregation) error {
+	return f(signal, aggregation)
+}
+
+// NamedSignalHook 带名称的钩子，名称用于日志和指标
+type NamedSignalHook struct {
+	Name string
+	Hook SignalHook
+}
+
+// AddSignalHook 注册信号钩子（可选），需在 Start 前调用
+func (p *OrderProcessor) AddSignalHook(name string, hook SignalHook) {
+	p.hooks = append(p.hooks, NamedSignalHook{Name: name, Hook: hook})
+}
+
+// runSignalHooks 依次执行钩子，返回是否丢弃信号
+func (p *OrderProcessor) runSignalHooks(signal *nats.HlAddressSignal, agg *models.OrderAggregation) bool {
+	for _, h := range p.hooks {
+		err := callSignalHook(h.Hook, signal, agg)
+		switch {
+		case err == nil:
+			continue
+		case errors.Is(err, ErrDropSignal):
+			monitor.IncSignalHookResult(h.Name, "dropped")
+			logger.Info().Err(err).Str("hook", h.Name).Int64("oid", agg.Oid).
+				Str("trace_id", signal.TraceID).Msg("signal dropped by hook")
+			return true
+		default:
+			monitor.IncSignalHookResult(h.Name, "error")
+			logger.Error().Err(err).Str("hook", h.Name).Int64("oid", agg.Oid).
+				Str("trace_id", signal.TraceID).Msg("signal hook failed, sending signal anyway")
+		}
+	}
+	return false
+}
+
+// callSignalHook 调用钩子，panic 转为错误
+func callSignalHook(hook SignalHook, signal *nats.HlAddressSignal, agg *models.OrderAggregation) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("signal hook panic: %v", r)
+		}
+	}()
+	return hook.BeforeSignal(signal, agg)
+}
+
+// SignalHookSymbol 插件导出的钩子变量名
+const SignalHookSymbol = "SignalHook"
+
+// LoadSignalHookPlugins 加载目录下的 Go 插件（*.so，按文件名排序），钩子名称为插件文件名
+// 插件需在本仓库内以相同工具链 go build -buildmode=plugin 构建，并导出 SignalHook 变量：
+// processor.SignalHook 实现或 func(*nats.HlAddressSignal, *models.OrderAggregation) error
+func LoadSignalHookPlugins(dir string) ([]NamedSignalHook, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("signal hook plugin dir: %w", err)
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.so"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	hooks := make([]NamedSignalHook, 0, len(paths))
+	for _, path := range paths {
+		hook, err := loadSignalHookPlugin(path)
+		if err != nil {
+			return nil, fmt.Errorf("load signal hook plugin %s: %w", path, err)
+		}
+		hooks = append(hooks, NamedSignalHook{Name: filepath.Base(path), Hook: hook})
+	}
+	return hooks, nil
+}
+
+func loadSignalHookPlugin(path string) (SignalHook, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	sym, err := p.Lookup(SignalHookSymbol)
+	if err != nil {
+		return nil, err
+	}
+	return signalHookFromSymbol(sym)
+}
+
+// signalHookFromSymbol 将插件导出的符号转换为钩子（Lookup 变量时返回指针）
+func signalHookFromSymbol(sym plugin.Symbol) (SignalHook, error) {
+	switch v := sym.(type) {
+	case *SignalHook:
+		if *v != nil {
+			return *v, nil
+		}
+	case SignalHook:
+		return v, nil
+	case func(*nats.HlAddressSignal, *models.OrderAggregation) error:
+		return SignalHookFunc(v), nil
+	case *func(*nats.HlAddressSignal, *models.OrderAggregation) error:
+		if *v != nil {
+			return SignalHookFunc(*v), nil
+		}
+	}
+	return nil, fmt.Errorf("symbol %s has unsupported type %T", SignalHookSymbol, sym)
+}
@@ -0,0 +1,82 @@
+package processor
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	hyperliquid "github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+)
+
+// TestOrderProcessor_SignalHooks 测试钩子修改、出错和丢弃信号
+func TestOrderProcessor_SignalHooks(t *testing.T) {
+	publisher := newMockPublisher()
+	processor := NewOrderProcessor(publisher, nil, cache.NewDedupCache(time.Minute), cache.NewSymbolCache(), nil, cache.NewPairCategoryCache())
+	defer processor.Stop()
+
+	processor.AddSignalHook("annotate", SignalHookFunc(func(signal *nats.HlAddressSignal, agg *models.OrderAggregation) error {
+		signal.Extra = map[string]string{"desk": "alpha", "oid": fmt.Sprint(agg.Oid)}
+		return nil
+	}))
+	processor.AddSignalHook("broken", SignalHookFunc(func(*nats.HlAddressSignal, *models.OrderAggregation) error {
+		return errors.New("lookup failed")
+	}))
+	processor.AddSignalHook("panics", SignalHookFunc(func(*nats.HlAddressSignal, *models.OrderAggregation) error {
+		panic("boom")
+	}))
+	processor.AddSignalHook("drop-small", SignalHookFunc(func(signal *nats.HlAddressSignal, _ *models.OrderAggregation) error {
+		if signal.Size < 1 {
+			return fmt.Errorf("size %v below minimum: %w", signal.Size, ErrDropSignal)
+		}
+		return nil
+	}))
+
+	flush := func(oid, tid int64, sz string) {
+		fill := hyperliquid.WsOrderFill{Oid: oid, Tid: tid, Sz: sz, Px: "100.0", Dir: "Open Long", Time: time.Now().UnixMilli()}
+		require.NoError(t, processor.HandleMessage(OrderFillMessage{Address: "0x123", Fill: fill, Direction: "Open Long"}))
+		require.NoError(t, processor.HandleMessage(OrderUpdateMessage{Address: "0x123", Oid: oid, Status: "filled"}))
+	}
+
+	// 钩子出错或 panic 不影响发送，修改结果随信号发布
+	flush(1, 1, "2")
+	require.Eventually(t, func() bool { return publisher.GetSignalCount() == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, map[string]string{"desk": "alpha", "oid": "1"}, publisher.GetLastSignal().Extra)
+
+	// 丢弃的信号不发送，订单按已发送处理
+	flush(2, 2, "0.5")
+	require.Eventually(t, func() bool { return processor.ActiveCount() == 0 }, time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 1, publisher.GetSignalCount())
+
+	// 同一订单的后续成交被去重，不会再次聚合
+	fill := hyperliquid.WsOrderFill{Oid: 2, Tid: 3, Sz: "5", Px: "100.0", Dir: "Open Long", Time: time.Now().UnixMilli()}
+	require.NoError(t, processor.HandleMessage(OrderFillMessage{Address: "0x123", Fill: fill, Direction: "Open Long"}))
+	assert.Zero(t, processor.ActiveCount())
+}
+
+func TestSignalHookFromSymbol(t *testing.T) {
+	called := false
+	fn := func(*nats.HlAddressSignal, *models.OrderAggregation) error { called = true; return nil }
+
+	var hook SignalHook = SignalHookFunc(fn)
+	for _, sym := range []any{&hook, hook, fn, &fn} {
+		h, err := signalHookFromSymbol(sym)
+		require.NoError(t, err, "%T", sym)
+		called = false
+		require.NoError(t, h.BeforeSignal(&nats.HlAddressSignal{}, &models.OrderAggregation{}))
+		assert.True(t, called, "%T", sym)
+	}
+
+	var nilHook SignalHook
+	_, err := signalHookFromSymbol(&nilHook)
+	assert.Error(t, err)
+	_, err = signalHookFromSymbol(new(int))
+	assert.Error(t, err)
+}
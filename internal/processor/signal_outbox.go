@@ -0,0 +1,222 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// OutboxStore 信号发件箱存储（由 dao.SignalDAO 实现）
+type OutboxStore interface {
+	EnqueueOutbox(agg *models.OrderAggregation, signals []*nats.HlAddressSignal) error
+	ListUnpublished(limit int) ([]*models.HlAddressSignal, error)
+	CountUnpublished() (int64, error)
+	MarkPublished(id uint, at time.Time) error
+	IncPublishAttempts(id uint) error
+	MarkDeadLettered(id uint, at time.Time) error
+}
+
+// outboxResult 单条发件箱信号的处理结果
+type outboxResult int
+
+const (
+	outboxPublished    outboxResult = iota // 已发布并标记
+	outboxDeadLettered                     // 转入死信，继续处理后续信号
+	outboxRetry                            // 本轮停止，等待下次重试
+)
+
+// 死信原因（指标标签）
+const (
+	deadLetterDecode      = "decode"
+	deadLetterMaxAttempts = "max_attempts"
+)
+
+// SignalOutbox 信号发件箱
+// OrderProcessor 将已发送状态的订单聚合和信号在同一事务中写入 hl_address_signals，
+// 分发器按写入顺序发布未发布的信号并标记；发布失败时停止本轮，保持顺序等待下次重试。
+// 载荷无法解析或失败次数达到 max_attempts 的信号转入死信，不阻塞后续信号
+type SignalOutbox struct {
+	store     OutboxStore
+	publisher Publisher
+	leader    LeaderChecker // 主备角色（可选），备实例不分发
+
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int // 0 不限制
+	notify      chan struct{}
+	mu          sync.Mutex // 串行化分发
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewSignalOutbox 创建信号发件箱
+func NewSignalOutbox(cfg config.Outbox, publisher Publisher) *SignalOutbox {
+	interval := cfg.PollInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &SignalOutbox{
+		store:       dao.Signal(),
+		publisher:   publisher,
+		interval:    interval,
+		batchSize:   batchSize,
+		maxAttempts: cfg.MaxAttempts,
+		notify:      make(chan struct{}, 1),
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
+// SetStore 设置发件箱存储（可选，用于测试）
+func (o *SignalOutbox) SetStore(store OutboxStore) {
+	o.store = store
+}
+
+// SetLeaderChecker 设置主备角色查询（可选），备实例不分发
+func (o *SignalOutbox) SetLeaderChecker(leader LeaderChecker) {
+	o.leader = leader
+}
+
+// Enqueue 在同一事务中写入订单聚合和待发布信号，成功后触发分发
+func (o *SignalOutbox) Enqueue(agg *models.OrderAggregation, signals []*nats.HlAddressSignal) error {
+	if err := o.store.EnqueueOutbox(agg, signals); err != nil {
+		monitor.IncSignalOutboxEnqueueFailures()
+		return err
+	}
+	select {
+	case o.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Start 启动分发，先发布上次退出时遗留的信号
+func (o *SignalOutbox) Start() error {
+	o.wg.Add(1)
+	goplus.Go(func() {
+		defer o.wg.Done()
+		o.run()
+	})
+
+	logger.Info().Dur("poll_interval", o.interval).Int("batch_size", o.batchSize).
+		Int("max_attempts", o.maxAttempts).Msg("signal outbox started")
+	return nil
+}
+
+// Stop 停止分发，发布剩余信号
+func (o *SignalOutbox) Stop() {
+	o.cancel()
+	o.wg.Wait()
+	o.dispatch()
+}
+
+func (o *SignalOutbox) run() {
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	o.dispatch()
+	for {
+		select {
+		case <-o.ctx.Done():
+			return
+		case <-o.notify:
+			o.dispatch()
+		case <-ticker.C:
+			o.dispatch()
+		}
+	}
+}
+
+// dispatch 按写入顺序发布待发布信号，返回本次发布条数
+func (o *SignalOutbox) dispatch() int {
+	if o.leader != nil && !o.leader.IsLeader() {
+		return 0
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	published := 0
+	defer func() {
+		if pending, err := o.store.CountUnpublished(); err == nil {
+			monitor.SetSignalOutboxPending(pending)
+		}
+	}()
+
+	for {
+		rows, err := o.store.ListUnpublished(o.batchSize)
+		if err != nil {
+			logger.Error().Err(err).Msg("list outbox signals failed")
+			return published
+		}
+
+		for _, row := range rows {
+			switch o.publish(row) {
+			case outboxPublished:
+				published++
+			case outboxRetry:
+				return published
+			}
+		}
+		if len(rows) < o.batchSize {
+			return published
+		}
+	}
+}
+
+// publish 发布单条信号并标记
+func (o *SignalOutbox) publish(row *models.HlAddressSignal) outboxResult {
+	var signal nats.HlAddressSignal
+	if err := json.Unmarshal([]byte(row.Payload), &signal); err != nil {
+		logger.Error().Err(err).Uint("id", row.ID).Str("trace_id", row.TraceID).Msg("decode outbox signal failed")
+		return o.deadLetter(row, deadLetterDecode)
+	}
+
+	if err := o.publisher.PublishAddressSignal(&signal); err != nil {
+		attempts := row.PublishAttempts + 1
+		logger.Error().Err(err).Uint("id", row.ID).Str("trace_id", row.TraceID).
+			Int("attempts", attempts).Msg("publish outbox signal failed, will retry")
+		if err := o.store.IncPublishAttempts(row.ID); err != nil {
+			logger.Error().Err(err).Uint("id", row.ID).Msg("record outbox publish attempt failed")
+		}
+		if o.maxAttempts > 0 && attempts >= o.maxAttempts {
+			return o.deadLetter(row, deadLetterMaxAttempts)
+		}
+		return outboxRetry
+	}
+
+	// 标记失败时下次会重新发布，消费者按 idempotency_key 去重
+	if err := o.store.MarkPublished(row.ID, time.Now()); err != nil {
+		logger.Error().Err(err).Uint("id", row.ID).Str("trace_id", row.TraceID).Msg("mark outbox signal published failed")
+		return outboxRetry
+	}
+	return outboxPublished
+}
+
+// deadLetter 将信号转入死信，后续信号继续分发；标记失败时本轮停止
+func (o *SignalOutbox) deadLetter(row *models.HlAddressSignal, reason string) outboxResult {
+	if err := o.store.MarkDeadLettered(row.ID, time.Now()); err != nil {
+		logger.Error().Err(err).Uint("id", row.ID).Str("trace_id", row.TraceID).Msg("mark outbox signal dead-lettered failed")
+		return outboxRetry
+	}
+	monitor.IncSignalOutboxDeadLetters(reason)
+	logger.Warn().Uint("id", row.ID).Str("trace_id", row.TraceID).Str("reason", reason).
+		Msg("outbox signal dead-lettered, skipping")
+	return outboxDeadLettered
+}
@@ -0,0 +1,209 @@
+package processor
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+)
+
+// mockOutboxStore 内存发件箱
+type mockOutboxStore struct {
+	mu         sync.Mutex
+	rows       []*models.HlAddressSignal
+	aggs       []models.OrderAggregation
+	enqueueErr error
+}
+
+func (s *mockOutboxStore) EnqueueOutbox(agg *models.OrderAggregation, signals []*nats.HlAddressSignal) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.enqueueErr != nil {
+		return s.enqueueErr
+	}
+	s.aggs = append(s.aggs, *agg)
+	for _, sig := range signals {
+		payload, err := sig.Marshal()
+		if err != nil {
+			return err
+		}
+		s.rows = append(s.rows, &models.HlAddressSignal{ID: uint(len(s.rows) + 1), TraceID: sig.TraceID, Payload: string(payload)})
+	}
+	return nil
+}
+
+func (s *mockOutboxStore) ListUnpublished(limit int) ([]*models.HlAddressSignal, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []*models.HlAddressSignal
+	for _, row := range s.rows {
+		if row.PublishedAt == nil && row.DeadLetteredAt == nil && len(out) < limit {
+			copied := *row
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (s *mockOutboxStore) CountUnpublished() (int64, error) {
+	rows, _ := s.ListUnpublished(len(s.rows))
+	return int64(len(rows)), nil
+}
+
+func (s *mockOutboxStore) MarkPublished(id uint, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rows[id-1].PublishedAt = &at
+	return nil
+}
+
+func (s *mockOutboxStore) IncPublishAttempts(id uint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rows[id-1].PublishAttempts++
+	return nil
+}
+
+func (s *mockOutboxStore) MarkDeadLettered(id uint, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rows[id-1].DeadLetteredAt = &at
+	return nil
+}
+
+// flakyPublisher 前 failures 次发布失败
+type flakyPublisher struct {
+	mu       sync.Mutex
+	failures int
+	traceIDs []string
+}
+
+func (p *flakyPublisher) PublishAddressSignal(signal *nats.HlAddressSignal) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("nats down")
+	}
+	p.traceIDs = append(p.traceIDs, signal.TraceID)
+	return nil
+}
+
+func (p *flakyPublisher) published() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.traceIDs...)
+}
+
+type staticLeader bool
+
+func (l staticLeader) IsLeader() bool { return bool(l) }
+
+func TestSignalOutbox_Dispatch(t *testing.T) {
+	store := &mockOutboxStore{}
+	publisher := &flakyPublisher{failures: 1}
+	outbox := NewSignalOutbox(config.Outbox{BatchSize: 2}, publisher)
+	outbox.SetStore(store)
+
+	for _, id := range []string{"a", "b", "c"} {
+		require.NoError(t, outbox.Enqueue(&models.OrderAggregation{}, []*nats.HlAddressSignal{{TraceID: id}}))
+	}
+
+	// 备实例不分发
+	outbox.SetLeaderChecker(staticLeader(false))
+	assert.Zero(t, outbox.dispatch())
+	outbox.SetLeaderChecker(nil)
+
+	// 发布失败时停止本轮，保持顺序
+	assert.Zero(t, outbox.dispatch())
+	assert.Equal(t, 1, store.rows[0].PublishAttempts)
+	assert.Empty(t, publisher.published())
+
+	// 重试时按写入顺序发布全部，跨批次
+	assert.Equal(t, 3, outbox.dispatch())
+	assert.Equal(t, []string{"a", "b", "c"}, publisher.published())
+	for _, row := range store.rows {
+		assert.NotNil(t, row.PublishedAt)
+	}
+	assert.Zero(t, outbox.dispatch())
+}
+
+func TestSignalOutbox_DeadLetter(t *testing.T) {
+	store := &mockOutboxStore{}
+	publisher := &flakyPublisher{}
+	outbox := NewSignalOutbox(config.Outbox{BatchSize: 2, MaxAttempts: 2}, publisher)
+	outbox.SetStore(store)
+
+	for _, id := range []string{"a", "b", "c"} {
+		require.NoError(t, outbox.Enqueue(&models.OrderAggregation{}, []*nats.HlAddressSignal{{TraceID: id}}))
+	}
+	// 载荷无法解析的信号转入死信，不阻塞后续信号
+	store.rows[0].Payload = "{"
+
+	assert.Equal(t, 2, outbox.dispatch())
+	assert.Equal(t, []string{"b", "c"}, publisher.published())
+	assert.NotNil(t, store.rows[0].DeadLetteredAt)
+	assert.Nil(t, store.rows[0].PublishedAt)
+	pending, _ := store.CountUnpublished()
+	assert.Zero(t, pending)
+
+	// 发布失败次数达到上限后转入死信，下一条继续发布
+	require.NoError(t, outbox.Enqueue(&models.OrderAggregation{}, []*nats.HlAddressSignal{{TraceID: "d"}, {TraceID: "e"}}))
+	publisher.mu.Lock()
+	publisher.failures = 3
+	publisher.mu.Unlock()
+
+	assert.Zero(t, outbox.dispatch())
+	assert.Nil(t, store.rows[3].DeadLetteredAt)
+	assert.Zero(t, outbox.dispatch())
+	assert.NotNil(t, store.rows[3].DeadLetteredAt)
+	assert.Equal(t, 1, store.rows[4].PublishAttempts)
+	assert.Equal(t, 1, outbox.dispatch())
+	assert.Equal(t, []string{"b", "c", "e"}, publisher.published())
+}
+
+func TestOrderProcessor_SignalOutbox(t *testing.T) {
+	store := &mockOutboxStore{enqueueErr: errors.New("db down")}
+	publisher := &flakyPublisher{}
+	outbox := NewSignalOutbox(config.Outbox{PollInterval: 10 * time.Millisecond}, publisher)
+	outbox.SetStore(store)
+	require.NoError(t, outbox.Start())
+	defer outbox.Stop()
+
+	processor := NewOrderProcessor(publisher, nil, cache.NewDedupCache(time.Minute), cache.NewSymbolCache(), nil, cache.NewPairCategoryCache())
+	defer processor.Stop()
+	processor.SetSignalOutbox(outbox)
+
+	fill := hyperliquid.WsOrderFill{Oid: 1, Tid: 1, Sz: "1", Px: "100.0", Dir: "Open Long", Time: time.Now().UnixMilli()}
+	require.NoError(t, processor.HandleMessage(OrderFillMessage{Address: "0x123", Fill: fill, Direction: "Open Long"}))
+
+	// 写库失败：不发布，订单保留在待处理列表等待重试
+	processor.flushOrder("0x123-1-Open Long", "status", "filled")
+	assert.Equal(t, 1, processor.ActiveCount())
+	time.Sleep(30 * time.Millisecond)
+	assert.Empty(t, publisher.published())
+
+	// 写库成功：聚合与信号同时写入，由发件箱发布
+	store.mu.Lock()
+	store.enqueueErr = nil
+	store.mu.Unlock()
+	processor.flushOrder("0x123-1-Open Long", "status", "filled")
+	assert.Zero(t, processor.ActiveCount())
+	require.Len(t, store.aggs, 1)
+	assert.True(t, store.aggs[0].SignalSent)
+	assert.Equal(t, "filled", store.aggs[0].OrderStatus)
+	assert.Eventually(t, func() bool { return len(publisher.published()) == 1 }, time.Second, 10*time.Millisecond)
+
+	// 已写入发件箱的订单不会再次生成信号
+	processor.flushOrder("0x123-1-Open Long", "status", "filled")
+	assert.Len(t, store.rows, 1)
+}
@@ -2,11 +2,17 @@ package processor
 
 import (
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/patrickmn/go-cache"
+
+	hlcache "github.com/utrading/utrading-hl-monitor/internal/cache"
 )
 
+// statusEntryOverhead 估算单条标记除 key 外的内存占用（map 槽位、cache.Item、状态字符串）
+const statusEntryOverhead = 96
+
 // OrderStatusTracker 订单状态追踪器
 // 用于记录已收到终止状态但还未匹配到 PendingOrder 的订单
 type OrderStatusTracker interface {
@@ -21,43 +27,84 @@ type OrderStatusTracker interface {
 
 	// Clear 清空所有记录（测试用）
 	Clear()
+
+	// GetStats 获取统计信息（条目数、命中率、最早条目、内存估算）
+	GetStats() map[string]any
 }
 
 // statusTracker 状态追踪器实现
 type statusTracker struct {
-	cache *cache.Cache // key: "address-oid", value: status
+	cache  *cache.Cache // key: "address-oid", value: status
+	ttl    time.Duration
+	hits   atomic.Int64
+	misses atomic.Int64
 }
 
 // NewOrderStatusTracker 创建状态追踪器
 // ttl: 记录过期时间，建议 10 分钟
 func NewOrderStatusTracker(ttl time.Duration) OrderStatusTracker {
+	return newStatusTracker(ttl)
+}
+
+func newStatusTracker(ttl time.Duration) *statusTracker {
 	return &statusTracker{
 		cache: cache.New(ttl, 1*time.Minute), // 1分钟清理一次过期项
+		ttl:   ttl,
 	}
 }
 
 // MarkStatus 记录订单状态
 func (t *statusTracker) MarkStatus(address string, oid int64, status string) {
-	key := fmt.Sprintf("%s-%d", address, oid)
-	t.cache.Set(key, status, cache.DefaultExpiration)
+	t.cache.Set(statusCacheKey(address, oid), status, cache.DefaultExpiration)
 }
 
 // GetStatus 获取订单状态
 func (t *statusTracker) GetStatus(address string, oid int64) (string, bool) {
-	key := fmt.Sprintf("%s-%d", address, oid)
-	if val, found := t.cache.Get(key); found {
+	if val, found := t.cache.Get(statusCacheKey(address, oid)); found {
+		t.hits.Add(1)
 		return val.(string), true
 	}
+	t.misses.Add(1)
 	return "", false
 }
 
 // Remove 移除记录
 func (t *statusTracker) Remove(address string, oid int64) {
-	key := fmt.Sprintf("%s-%d", address, oid)
-	t.cache.Delete(key)
+	t.cache.Delete(statusCacheKey(address, oid))
 }
 
 // Clear 清空所有记录
 func (t *statusTracker) Clear() {
 	t.cache.Flush()
 }
+
+// GetStats 获取统计信息
+// 标记写入时间由过期时间减去 TTL 推算（MarkStatus 均使用默认过期时间）
+func (t *statusTracker) GetStats() map[string]any {
+	now := time.Now()
+	var bytes int64
+	var oldest time.Time
+	items := t.cache.Items() // 不含已过期未清理的条目
+	for key, item := range items {
+		bytes += int64(len(key)) + statusEntryOverhead
+		if item.Expiration > 0 {
+			if marked := time.Unix(0, item.Expiration).Add(-t.ttl); oldest.IsZero() || marked.Before(oldest) {
+				oldest = marked
+			}
+		}
+	}
+	hits, misses := t.hits.Load(), t.misses.Load()
+	return map[string]any{
+		"entries":            len(items),
+		"ttl_minutes":        t.ttl.Minutes(),
+		"memory_bytes":       bytes,
+		"hits":               hits,
+		"misses":             misses,
+		"hit_rate":           hlcache.HitRate(hits, misses),
+		"oldest_age_seconds": hlcache.AgeSeconds(oldest, now),
+	}
+}
+
+func statusCacheKey(address string, oid int64) string {
+	return fmt.Sprintf("%s-%d", address, oid)
+}
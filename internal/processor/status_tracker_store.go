@@ -0,0 +1,263 @@
+package processor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// statusCleanupInterval 清理数据库中过期标记的间隔
+const statusCleanupInterval = time.Minute
+
+// StatusMarkStore 订单状态标记持久化接口（由 dao.OrderStatusMarkDAO 实现）
+type StatusMarkStore interface {
+	ListActive(nowMs int64) ([]*models.HlOrderStatusMark, error)
+	BatchUpsert(rows []*models.HlOrderStatusMark) error
+	DeleteOrders(orders map[string][]int64) error
+	DeleteExpired(nowMs int64) (int64, error)
+}
+
+// statusMarkKey 地址 + 订单ID
+type statusMarkKey struct {
+	address string
+	oid     int64
+}
+
+// statusChange 待持久化的标记变更，status 为空表示删除
+type statusChange struct {
+	status    string
+	expiresAt int64 // 毫秒
+}
+
+// PersistentStatusTracker 持久化的订单状态追踪器
+// 内存中的标记按 flush_interval 写入 hl_order_status_marks，启动时加载未过期的标记，
+// 避免终止状态与迟到成交之间重启导致订单聚合等待超时才发送
+type PersistentStatusTracker struct {
+	*statusTracker
+	store    StatusMarkStore
+	ttl      time.Duration
+	interval time.Duration
+
+	mu      sync.Mutex
+	pending map[statusMarkKey]statusChange
+	stored  map[statusMarkKey]int64 // 已写入数据库的标记 -> 过期时间（毫秒），未写入的标记删除时无需访问数据库
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPersistentStatusTracker 创建持久化的订单状态追踪器
+func NewPersistentStatusTracker(cfg config.StatusTracker) *PersistentStatusTracker {
+	ttl := cfg.TTL
+	if ttl <= 0 {
+		ttl = 10 * time.Minute
+	}
+	interval := cfg.FlushInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &PersistentStatusTracker{
+		statusTracker: newStatusTracker(ttl),
+		store:         dao.OrderStatusMark(),
+		ttl:           ttl,
+		interval:      interval,
+		pending:       make(map[statusMarkKey]statusChange),
+		stored:        make(map[statusMarkKey]int64),
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+// SetStore 设置持久化存储（可选，用于测试）
+func (t *PersistentStatusTracker) SetStore(store StatusMarkStore) {
+	t.store = store
+}
+
+// Start 加载未过期的标记并启动定期持久化，需在订阅地址前调用
+func (t *PersistentStatusTracker) Start() error {
+	now := time.Now()
+	rows, err := t.store.ListActive(now.UnixMilli())
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	for _, row := range rows {
+		remaining := time.UnixMilli(row.ExpiresAt).Sub(now)
+		if remaining <= 0 {
+			continue
+		}
+		t.cache.Set(statusCacheKey(row.Address, row.Oid), row.Status, remaining)
+		t.stored[statusMarkKey{address: row.Address, oid: row.Oid}] = row.ExpiresAt
+	}
+	t.mu.Unlock()
+
+	t.wg.Add(1)
+	goplus.Go(func() {
+		defer t.wg.Done()
+		t.run()
+	})
+
+	logger.Info().Int("loaded", len(rows)).Dur("flush_interval", t.interval).Msg("order status tracker started")
+	return nil
+}
+
+// Stop 停止定期持久化并写入剩余变更
+func (t *PersistentStatusTracker) Stop() {
+	t.cancel()
+	t.wg.Wait()
+	t.flush()
+}
+
+func (t *PersistentStatusTracker) run() {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+	cleanup := time.NewTicker(statusCleanupInterval)
+	defer cleanup.Stop()
+
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-ticker.C:
+			t.flush()
+		case <-cleanup.C:
+			t.cleanup(time.Now())
+		}
+	}
+}
+
+// MarkStatus 记录订单状态，等待持久化
+func (t *PersistentStatusTracker) MarkStatus(address string, oid int64, status string) {
+	t.statusTracker.MarkStatus(address, oid, status)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending[statusMarkKey{address: address, oid: oid}] = statusChange{
+		status:    status,
+		expiresAt: time.Now().Add(t.ttl).UnixMilli(),
+	}
+}
+
+// Remove 移除记录，已写入数据库的标记等待删除
+func (t *PersistentStatusTracker) Remove(address string, oid int64) {
+	t.statusTracker.Remove(address, oid)
+
+	key := statusMarkKey{address: address, oid: oid}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.stored[key]; ok {
+		t.pending[key] = statusChange{}
+	} else {
+		delete(t.pending, key)
+	}
+}
+
+// Clear 清空所有记录（不影响数据库）
+func (t *PersistentStatusTracker) Clear() {
+	t.statusTracker.Clear()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending = make(map[statusMarkKey]statusChange)
+	t.stored = make(map[statusMarkKey]int64)
+}
+
+// GetStats 获取统计信息，附加待持久化和已写入数据库的标记数
+func (t *PersistentStatusTracker) GetStats() map[string]any {
+	stats := t.statusTracker.GetStats()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	stats["pending_writes"] = len(t.pending)
+	stats["stored"] = len(t.stored)
+	return stats
+}
+
+// flush 持久化待写入的标记和删除，失败时下次重试
+func (t *PersistentStatusTracker) flush() {
+	t.mu.Lock()
+	batch := t.pending
+	t.pending = make(map[statusMarkKey]statusChange)
+	t.mu.Unlock()
+
+	if len(batch) == 0 {
+		return
+	}
+
+	var upserts []*models.HlOrderStatusMark
+	deletes := make(map[string][]int64)
+	for key, change := range batch {
+		if change.status == "" {
+			deletes[key.address] = append(deletes[key.address], key.oid)
+			continue
+		}
+		upserts = append(upserts, &models.HlOrderStatusMark{
+			Address:   key.address,
+			Oid:       key.oid,
+			Status:    change.status,
+			ExpiresAt: change.expiresAt,
+		})
+	}
+
+	err := t.store.BatchUpsert(upserts)
+	if err == nil {
+		err = t.store.DeleteOrders(deletes)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err != nil {
+		logger.Error().Err(err).Int("count", len(batch)).Msg("persist order status marks failed")
+		// 期间没有新变更的标记放回待写入，下次重试
+		for key, change := range batch {
+			if _, ok := t.pending[key]; !ok {
+				t.pending[key] = change
+			}
+		}
+		return
+	}
+	for key, change := range batch {
+		if change.status == "" {
+			delete(t.stored, key)
+			continue
+		}
+		t.stored[key] = change.expiresAt
+		// 写入期间已移除的标记，下次删除
+		if _, ok := t.pending[key]; !ok {
+			if _, found := t.cache.Get(statusCacheKey(key.address, key.oid)); !found {
+				t.pending[key] = statusChange{}
+			}
+		}
+	}
+}
+
+// cleanup 删除数据库中过期的标记
+func (t *PersistentStatusTracker) cleanup(now time.Time) {
+	nowMs := now.UnixMilli()
+	deleted, err := t.store.DeleteExpired(nowMs)
+	if err != nil {
+		logger.Error().Err(err).Msg("delete expired order status marks failed")
+		return
+	}
+
+	t.mu.Lock()
+	for key, expiresAt := range t.stored {
+		if expiresAt <= nowMs {
+			delete(t.stored, key)
+		}
+	}
+	t.mu.Unlock()
+
+	if deleted > 0 {
+		logger.Debug().Int64("deleted", deleted).Msg("expired order status marks deleted")
+	}
+}
@@ -0,0 +1,188 @@
+package processor
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+// mockStatusMarkStore 内存状态标记存储
+type mockStatusMarkStore struct {
+	mu      sync.Mutex
+	rows    map[statusMarkKey]*models.HlOrderStatusMark
+	deletes int
+	err     error
+}
+
+func newMockStatusMarkStore() *mockStatusMarkStore {
+	return &mockStatusMarkStore{rows: make(map[statusMarkKey]*models.HlOrderStatusMark)}
+}
+
+func (s *mockStatusMarkStore) ListActive(nowMs int64) ([]*models.HlOrderStatusMark, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []*models.HlOrderStatusMark
+	for _, row := range s.rows {
+		if row.ExpiresAt > nowMs {
+			out = append(out, row)
+		}
+	}
+	return out, nil
+}
+
+func (s *mockStatusMarkStore) BatchUpsert(rows []*models.HlOrderStatusMark) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	for _, row := range rows {
+		s.rows[statusMarkKey{address: row.Address, oid: row.Oid}] = row
+	}
+	return nil
+}
+
+func (s *mockStatusMarkStore) DeleteOrders(orders map[string][]int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	for address, oids := range orders {
+		for _, oid := range oids {
+			delete(s.rows, statusMarkKey{address: address, oid: oid})
+			s.deletes++
+		}
+	}
+	return nil
+}
+
+func (s *mockStatusMarkStore) DeleteExpired(nowMs int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var deleted int64
+	for key, row := range s.rows {
+		if row.ExpiresAt <= nowMs {
+			delete(s.rows, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (s *mockStatusMarkStore) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.rows)
+}
+
+func newTestStatusTracker(store StatusMarkStore) *PersistentStatusTracker {
+	t := NewPersistentStatusTracker(config.StatusTracker{TTL: time.Minute, Persist: true, FlushInterval: time.Hour})
+	t.SetStore(store)
+	return t
+}
+
+func TestPersistentStatusTracker_RestoreAfterRestart(t *testing.T) {
+	store := newMockStatusMarkStore()
+
+	first := newTestStatusTracker(store)
+	require.NoError(t, first.Start())
+	first.MarkStatus("0xa", 1, "filled")
+	first.MarkStatus("0xa", 2, "canceled")
+	first.Stop()
+	assert.Equal(t, 2, store.len())
+
+	// 重启后迟到的成交仍能命中预标记
+	second := newTestStatusTracker(store)
+	require.NoError(t, second.Start())
+	defer second.Stop()
+	status, ok := second.GetStatus("0xa", 1)
+	assert.True(t, ok)
+	assert.Equal(t, "filled", status)
+
+	second.Remove("0xa", 1)
+	second.flush()
+	_, ok = second.GetStatus("0xa", 1)
+	assert.False(t, ok)
+	assert.Equal(t, 1, store.len())
+}
+
+func TestPersistentStatusTracker_RemoveBeforeFlushSkipsStore(t *testing.T) {
+	store := newMockStatusMarkStore()
+	tracker := newTestStatusTracker(store)
+
+	tracker.MarkStatus("0xa", 1, "filled")
+	tracker.Remove("0xa", 1)
+	tracker.flush()
+
+	assert.Equal(t, 0, store.len())
+	assert.Equal(t, 0, store.deletes)
+}
+
+func TestPersistentStatusTracker_GetStats(t *testing.T) {
+	store := newMockStatusMarkStore()
+	tracker := newTestStatusTracker(store)
+
+	tracker.MarkStatus("0xa", 1, "filled")
+	tracker.MarkStatus("0xa", 2, "canceled")
+	tracker.flush()
+	tracker.MarkStatus("0xb", 3, "filled")
+
+	_, found := tracker.GetStatus("0xa", 1)
+	assert.True(t, found)
+	_, found = tracker.GetStatus("0xa", 4)
+	assert.False(t, found)
+
+	stats := tracker.GetStats()
+	assert.Equal(t, 3, stats["entries"])
+	assert.EqualValues(t, 1, stats["hits"])
+	assert.EqualValues(t, 1, stats["misses"])
+	assert.Equal(t, 0.5, stats["hit_rate"])
+	assert.Greater(t, stats["memory_bytes"], int64(0))
+	assert.GreaterOrEqual(t, stats["oldest_age_seconds"], 0.0)
+	assert.Less(t, stats["oldest_age_seconds"], 5.0)
+	assert.Equal(t, 1, stats["pending_writes"])
+	assert.Equal(t, 2, stats["stored"])
+}
+
+func TestPersistentStatusTracker_RetryOnFailure(t *testing.T) {
+	store := newMockStatusMarkStore()
+	store.err = errors.New("db down")
+	tracker := newTestStatusTracker(store)
+
+	tracker.MarkStatus("0xa", 1, "filled")
+	tracker.flush()
+	assert.Equal(t, 0, store.len())
+
+	store.err = nil
+	tracker.flush()
+	assert.Equal(t, 1, store.len())
+}
+
+func TestPersistentStatusTracker_SkipExpired(t *testing.T) {
+	store := newMockStatusMarkStore()
+	now := time.Now()
+	require.NoError(t, store.BatchUpsert([]*models.HlOrderStatusMark{
+		{Address: "0xa", Oid: 1, Status: "filled", ExpiresAt: now.Add(-time.Second).UnixMilli()},
+		{Address: "0xa", Oid: 2, Status: "filled", ExpiresAt: now.Add(time.Minute).UnixMilli()},
+	}))
+
+	tracker := newTestStatusTracker(store)
+	require.NoError(t, tracker.Start())
+	defer tracker.Stop()
+
+	_, ok := tracker.GetStatus("0xa", 1)
+	assert.False(t, ok)
+	_, ok = tracker.GetStatus("0xa", 2)
+	assert.True(t, ok)
+
+	tracker.cleanup(now)
+	assert.Equal(t, 1, store.len())
+}
@@ -0,0 +1,334 @@
+package selftest
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	natsgo "github.com/nats-io/nats.go"
+	hl "github.com/sonirico/go-hyperliquid"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/internal/processor"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// 失败阶段（指标标签）
+const (
+	StageInject = "inject" // 注入消息队列失败
+	StageNATS   = "nats"   // 超时未在自检主题收到信号
+	StageDB     = "db"     // 已收到信号但订单聚合未落库
+)
+
+// probeDirection 模拟成交的方向
+const probeDirection = "Open Long"
+
+// Injector 消息注入接口（由 manager.SubscriptionManager 实现）
+type Injector interface {
+	InjectMessage(msg processor.Message) error
+}
+
+// SignalPublisher 信号发布接口
+type SignalPublisher interface {
+	PublishAddressSignal(signal *nats.HlAddressSignal) error
+}
+
+// Transport 自检信号收发（由 nats.Publisher 实现）
+type Transport interface {
+	Publish(subject string, data []byte) error
+	Subscribe(subject string, cb natsgo.MsgHandler) (*natsgo.Subscription, error)
+}
+
+// Store 自检数据落库检查与清理
+type Store interface {
+	IsSignalSent(address string, oid int64) (bool, error)
+	Purge(address string) error
+}
+
+// LeaderChecker 主备角色查询接口，备实例不注入心跳
+type LeaderChecker interface {
+	IsLeader() bool
+}
+
+// probe 单次心跳
+type probe struct {
+	oid        int64
+	fillTime   int64 // 模拟成交时间（毫秒），用于匹配 NATS 收到的信号
+	injectedAt time.Time
+	receivedAt time.Time
+}
+
+// Heartbeat 自检心跳
+// 定期为保留地址注入一笔模拟成交，经消息队列、订单处理器发布到自检主题并落库，
+// 下一周期检查上一笔是否完整走通，记录最近一次端到端成功时间，用于发现链路静默卡死
+type Heartbeat struct {
+	cfg       config.SelfTest
+	injector  Injector
+	transport Transport
+	store     Store
+	leader    LeaderChecker
+
+	mu          sync.Mutex
+	current     *probe
+	lastSuccess time.Time
+
+	sub  *natsgo.Subscription
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewHeartbeat 创建自检心跳
+// 需在创建订阅管理器前调用 Wrap 包装信号发布器，再通过 SetInjector 设置注入目标
+func NewHeartbeat(cfg config.SelfTest, transport Transport) *Heartbeat {
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Minute
+	}
+	if cfg.Subject == "" {
+		cfg.Subject = nats.TopicHLAddressSignal + ".selftest"
+	}
+	if cfg.Coin == "" {
+		cfg.Coin = "BTC"
+	}
+	cfg.Address = strings.ToLower(cfg.Address)
+
+	return &Heartbeat{
+		cfg:       cfg,
+		transport: transport,
+		store:     daoStore{},
+		done:      make(chan struct{}),
+	}
+}
+
+// SetInjector 设置消息注入目标（Start 前必须设置）
+func (h *Heartbeat) SetInjector(injector Injector) {
+	h.injector = injector
+}
+
+// SetStore 设置落库检查（可选，默认使用 DAO）
+func (h *Heartbeat) SetStore(store Store) {
+	h.store = store
+}
+
+// SetLeaderChecker 设置主备角色查询（可选）
+func (h *Heartbeat) SetLeaderChecker(leader LeaderChecker) {
+	h.leader = leader
+}
+
+// LastSuccess 最近一次端到端成功时间
+func (h *Heartbeat) LastSuccess() time.Time {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.lastSuccess
+}
+
+// Wrap 包装信号发布器：测试地址的信号只发布到自检主题，其余信号透传
+// 需包在最外层，避免测试信号进入 webhook 等下游
+func (h *Heartbeat) Wrap(next SignalPublisher) SignalPublisher {
+	return &probePublisher{next: next, hb: h}
+}
+
+// Start 订阅自检主题并启动心跳
+func (h *Heartbeat) Start() error {
+	if h.injector == nil {
+		return errors.New("selftest: injector not set")
+	}
+
+	sub, err := h.transport.Subscribe(h.cfg.Subject, h.onSignal)
+	if err != nil {
+		return err
+	}
+	h.sub = sub
+
+	h.wg.Add(1)
+	goplus.Go(func() {
+		defer h.wg.Done()
+		h.run()
+	})
+
+	logger.Info().Str("address", h.cfg.Address).Str("subject", h.cfg.Subject).
+		Dur("interval", h.cfg.Interval).Msg("selftest heartbeat started")
+	return nil
+}
+
+// Stop 停止心跳
+func (h *Heartbeat) Stop() {
+	close(h.done)
+	h.wg.Wait()
+	if h.sub != nil {
+		_ = h.sub.Unsubscribe()
+	}
+}
+
+func (h *Heartbeat) run() {
+	ticker := time.NewTicker(h.cfg.Interval)
+	defer ticker.Stop()
+
+	h.tick()
+	for {
+		select {
+		case <-h.done:
+			return
+		case <-ticker.C:
+			h.tick()
+		}
+	}
+}
+
+// tick 验证上一次心跳并注入新的心跳
+func (h *Heartbeat) tick() {
+	h.verify()
+
+	if h.leader != nil && !h.leader.IsLeader() {
+		return
+	}
+	h.inject(time.Now())
+}
+
+// inject 注入模拟成交：先记录原始数量，成交量达到原始数量后处理器立即 flush
+func (h *Heartbeat) inject(now time.Time) {
+	p := &probe{
+		oid:        -now.UnixMilli(), // 负数 oid，不与真实订单冲突
+		fillTime:   now.UnixMilli(),
+		injectedAt: now,
+	}
+
+	update := processor.OrderUpdateMessage{
+		Address: h.cfg.Address,
+		Oid:     p.oid,
+		Status:  "open",
+		OrigSz:  "1",
+	}
+	fill := processor.OrderFillMessage{
+		Address: h.cfg.Address,
+		Fill: hl.WsOrderFill{
+			Coin: h.cfg.Coin,
+			Px:   "1",
+			Sz:   "1",
+			Side: "B",
+			Time: p.fillTime,
+			Dir:  probeDirection,
+			Oid:  p.oid,
+			Tid:  p.oid,
+			Hash: "selftest",
+		},
+		Direction: probeDirection,
+	}
+
+	for _, msg := range []processor.Message{update, fill} {
+		if err := h.injector.InjectMessage(msg); err != nil {
+			monitor.IncSelfTestFailure(StageInject)
+			logger.Warn().Err(err).Int64("oid", p.oid).Msg("selftest heartbeat inject failed")
+			return
+		}
+	}
+
+	h.mu.Lock()
+	h.current = p
+	h.mu.Unlock()
+}
+
+// verify 检查上一次心跳是否经 NATS 收到并落库
+func (h *Heartbeat) verify() {
+	h.mu.Lock()
+	p := h.current
+	h.current = nil
+	var receivedAt time.Time
+	if p != nil {
+		receivedAt = p.receivedAt
+	}
+	h.mu.Unlock()
+
+	if p == nil {
+		return
+	}
+
+	if receivedAt.IsZero() {
+		monitor.IncSelfTestFailure(StageNATS)
+		logger.Warn().Int64("oid", p.oid).Time("injected_at", p.injectedAt).
+			Msg("selftest heartbeat signal not received, pipeline may be stalled")
+		return
+	}
+
+	sent, err := h.store.IsSignalSent(h.cfg.Address, p.oid)
+	if err != nil || !sent {
+		monitor.IncSelfTestFailure(StageDB)
+		logger.Warn().Err(err).Int64("oid", p.oid).Msg("selftest heartbeat order not persisted")
+		return
+	}
+
+	latency := receivedAt.Sub(p.injectedAt)
+	h.mu.Lock()
+	h.lastSuccess = receivedAt
+	h.mu.Unlock()
+	monitor.SetSelfTestSuccess(receivedAt, latency)
+	logger.Debug().Int64("oid", p.oid).Dur("latency", latency).Msg("selftest heartbeat ok")
+
+	// 清理测试数据
+	if err := h.store.Purge(h.cfg.Address); err != nil {
+		logger.Warn().Err(err).Msg("purge selftest data failed")
+	}
+}
+
+// onSignal 处理自检主题收到的信号
+func (h *Heartbeat) onSignal(msg *natsgo.Msg) {
+	var signal nats.HlAddressSignal
+	if err := json.Unmarshal(msg.Data, &signal); err != nil {
+		return
+	}
+	h.received(&signal, time.Now())
+}
+
+// received 匹配当前心跳
+func (h *Heartbeat) received(signal *nats.HlAddressSignal, at time.Time) {
+	if !strings.EqualFold(signal.Address, h.cfg.Address) {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.current != nil && h.current.fillTime == signal.Timestamp && h.current.receivedAt.IsZero() {
+		h.current.receivedAt = at
+	}
+}
+
+// probePublisher 将测试地址的信号转发到自检主题
+type probePublisher struct {
+	next SignalPublisher
+	hb   *Heartbeat
+}
+
+func (p *probePublisher) PublishAddressSignal(signal *nats.HlAddressSignal) error {
+	if !strings.EqualFold(signal.Address, p.hb.cfg.Address) {
+		return p.next.PublishAddressSignal(signal)
+	}
+
+	data, err := signal.Marshal()
+	if err != nil {
+		return err
+	}
+	return p.hb.transport.Publish(p.hb.cfg.Subject, data)
+}
+
+// daoStore 基于 DAO 的落库检查
+type daoStore struct{}
+
+func (daoStore) IsSignalSent(address string, oid int64) (bool, error) {
+	return dao.OrderAggregation().IsSignalSent(address, oid)
+}
+
+func (daoStore) Purge(address string) error {
+	if _, err := dao.OrderAggregation().DeleteByAddress(address); err != nil {
+		return err
+	}
+	if _, err := dao.Signal().DeleteByAddress(address); err != nil {
+		return err
+	}
+	return nil
+}
+
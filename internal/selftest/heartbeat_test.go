@@ -0,0 +1,203 @@
+package selftest
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
+	"github.com/utrading/utrading-hl-monitor/internal/dal/gen"
+	"github.com/utrading/utrading-hl-monitor/internal/dao"
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/internal/processor"
+)
+
+// fakeTransport 同步投递到订阅回调
+type fakeTransport struct {
+	mu        sync.Mutex
+	handlers  map[string]natsgo.MsgHandler
+	published map[string]int
+}
+
+func newFakeTransport() *fakeTransport {
+	return &fakeTransport{handlers: make(map[string]natsgo.MsgHandler), published: make(map[string]int)}
+}
+
+func (t *fakeTransport) Publish(subject string, data []byte) error {
+	t.mu.Lock()
+	t.published[subject]++
+	cb := t.handlers[subject]
+	t.mu.Unlock()
+	if cb != nil {
+		cb(&natsgo.Msg{Subject: subject, Data: data})
+	}
+	return nil
+}
+
+func (t *fakeTransport) Subscribe(subject string, cb natsgo.MsgHandler) (*natsgo.Subscription, error) {
+	t.mu.Lock()
+	t.handlers[subject] = cb
+	t.mu.Unlock()
+	return &natsgo.Subscription{Subject: subject}, nil
+}
+
+func (t *fakeTransport) count(subject string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.published[subject]
+}
+
+type recordingPublisher struct {
+	mu      sync.Mutex
+	signals []*nats.HlAddressSignal
+}
+
+func (p *recordingPublisher) PublishAddressSignal(signal *nats.HlAddressSignal) error {
+	p.mu.Lock()
+	p.signals = append(p.signals, signal)
+	p.mu.Unlock()
+	return nil
+}
+
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file:selftest?mode=memory&cache=shared"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.OrderAggregation{}, &models.HlAddressSignal{}))
+	gen.SetDefault(db)
+	dao.InitDAO(db)
+	return db
+}
+
+func testConfig() config.SelfTest {
+	return config.SelfTest{
+		Interval: time.Hour,
+		Address:  "0x5e1f7e5700000000000000000000000000000000",
+		Subject:  "hl_address_signal.selftest",
+	}
+}
+
+// handlerInjector 直接交给处理器（省略消息队列）
+type handlerInjector struct {
+	handler processor.MessageHandler
+}
+
+func (i handlerInjector) InjectMessage(msg processor.Message) error {
+	return i.handler.HandleMessage(msg)
+}
+
+func TestHeartbeat_EndToEnd(t *testing.T) {
+	db := setupTestDB(t)
+
+	transport := newFakeTransport()
+	hb := NewHeartbeat(testConfig(), transport)
+	next := &recordingPublisher{}
+
+	writer := processor.NewBatchWriter(&processor.BatchWriterConfig{FlushInterval: 10 * time.Millisecond})
+	writer.Start()
+	defer writer.Stop()
+
+	op := processor.NewOrderProcessor(hb.Wrap(next), writer, nil, cache.NewSymbolCache(), nil, cache.NewPairCategoryCache())
+	defer op.Stop()
+	hb.SetInjector(handlerInjector{op})
+
+	sub, err := transport.Subscribe(hb.cfg.Subject, hb.onSignal)
+	require.NoError(t, err)
+	hb.sub = sub
+
+	hb.inject(time.Now())
+
+	// 测试信号只发布到自检主题
+	assert.Eventually(t, func() bool { return transport.count(hb.cfg.Subject) == 1 }, 2*time.Second, 10*time.Millisecond)
+	assert.Empty(t, next.signals)
+
+	// 等待订单聚合落库后验证
+	assert.Eventually(t, func() bool {
+		sent, _ := dao.OrderAggregation().IsSignalSent(hb.cfg.Address, hb.current.oid)
+		return sent
+	}, 2*time.Second, 10*time.Millisecond)
+
+	hb.verify()
+	assert.False(t, hb.LastSuccess().IsZero())
+
+	// 成功后清理测试数据
+	var count int64
+	require.NoError(t, db.Model(&models.OrderAggregation{}).Where("address = ?", hb.cfg.Address).Count(&count).Error)
+	assert.Equal(t, int64(0), count)
+}
+
+type fakeStore struct {
+	sent   bool
+	purged int
+}
+
+func (s *fakeStore) IsSignalSent(string, int64) (bool, error) { return s.sent, nil }
+func (s *fakeStore) Purge(string) error                       { s.purged++; return nil }
+
+type fakeInjector struct {
+	msgs []processor.Message
+}
+
+func (i *fakeInjector) InjectMessage(msg processor.Message) error {
+	i.msgs = append(i.msgs, msg)
+	return nil
+}
+
+func TestHeartbeat_Failures(t *testing.T) {
+	transport := newFakeTransport()
+	hb := NewHeartbeat(testConfig(), transport)
+	store := &fakeStore{}
+	injector := &fakeInjector{}
+	hb.SetStore(store)
+	hb.SetInjector(injector)
+
+	// 未收到信号
+	hb.inject(time.Now())
+	require.Len(t, injector.msgs, 2)
+	update := injector.msgs[0].(processor.OrderUpdateMessage)
+	assert.Less(t, update.Oid, int64(0))
+	assert.Equal(t, "1", update.OrigSz)
+	hb.verify()
+	assert.True(t, hb.LastSuccess().IsZero())
+
+	// 收到信号但未落库
+	hb.inject(time.Now().Add(time.Millisecond))
+	hb.received(&nats.HlAddressSignal{Address: hb.cfg.Address, Timestamp: hb.current.fillTime}, time.Now())
+	hb.verify()
+	assert.True(t, hb.LastSuccess().IsZero())
+	assert.Equal(t, 0, store.purged)
+
+	// 其他心跳或其他地址的信号不匹配
+	store.sent = true
+	hb.inject(time.Now().Add(2 * time.Millisecond))
+	hb.received(&nats.HlAddressSignal{Address: hb.cfg.Address, Timestamp: 1}, time.Now())
+	hb.received(&nats.HlAddressSignal{Address: "0xother", Timestamp: hb.current.fillTime}, time.Now())
+	hb.verify()
+	assert.True(t, hb.LastSuccess().IsZero())
+
+	hb.inject(time.Now().Add(3 * time.Millisecond))
+	hb.received(&nats.HlAddressSignal{Address: hb.cfg.Address, Timestamp: hb.current.fillTime}, time.Now())
+	hb.verify()
+	assert.False(t, hb.LastSuccess().IsZero())
+	assert.Equal(t, 1, store.purged)
+}
+
+func TestHeartbeat_WrapPassThrough(t *testing.T) {
+	transport := newFakeTransport()
+	hb := NewHeartbeat(testConfig(), transport)
+	next := &recordingPublisher{}
+	pub := hb.Wrap(next)
+
+	require.NoError(t, pub.PublishAddressSignal(&nats.HlAddressSignal{Address: "0xabc"}))
+	require.NoError(t, pub.PublishAddressSignal(&nats.HlAddressSignal{Address: "0x5E1F7E5700000000000000000000000000000000"}))
+
+	assert.Len(t, next.signals, 1)
+	assert.Equal(t, 1, transport.count(hb.cfg.Subject))
+}
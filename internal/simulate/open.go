@@ -0,0 +1,230 @@
+package simulate
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"container/heap"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// Open 打开录制：path 为文件时直接读取（.gz 后缀按 gzip 解压）；
+// 为目录时（如 capture 存储目录或其中某个频道、地址的子目录）读取其中全部 .ndjson / .ndjson.gz 文件，
+// 同一子目录内的文件按文件名（即录制时间）顺序拼接，不同子目录之间按 ts 归并为一个有序流
+func Open(path string) (io.ReadCloser, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return openFile(path)
+	}
+
+	groups := make(map[string][]string) // 子目录 -> 文件
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !(strings.HasSuffix(p, ".ndjson") || strings.HasSuffix(p, ".ndjson.gz")) {
+			return nil
+		}
+		dir := filepath.Dir(p)
+		groups[dir] = append(groups[dir], p)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, errors.New("no .ndjson or .ndjson.gz files in " + path)
+	}
+
+	dirs := make([]string, 0, len(groups))
+	for dir := range groups {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	streams := make([]io.ReadCloser, 0, len(groups))
+	for _, dir := range dirs {
+		files := groups[dir]
+		sort.Strings(files)
+		streams = append(streams, &chainReader{paths: files})
+	}
+	if len(streams) == 1 {
+		return streams[0], nil
+	}
+	return mergeStreams(streams), nil
+}
+
+// openFile 打开单个录制文件
+func openFile(path string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(path, ".gz") {
+		return f, nil
+	}
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &gzipFile{Reader: zr, f: f}, nil
+}
+
+type gzipFile struct {
+	*gzip.Reader
+	f *os.File
+}
+
+func (g *gzipFile) Close() error {
+	err := g.Reader.Close()
+	if closeErr := g.f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// chainReader 依次读取多个文件，文件之间补一个换行，避免末行无换行时与下一个文件的首行相连
+type chainReader struct {
+	paths []string
+	cur   io.ReadCloser
+	sep   bool
+}
+
+func (c *chainReader) Read(p []byte) (int, error) {
+	for {
+		if c.sep && len(p) > 0 {
+			c.sep = false
+			p[0] = '\n'
+			return 1, nil
+		}
+		if c.cur == nil {
+			if len(c.paths) == 0 {
+				return 0, io.EOF
+			}
+			r, err := openFile(c.paths[0])
+			if err != nil {
+				return 0, err
+			}
+			c.cur, c.paths = r, c.paths[1:]
+		}
+		n, err := c.cur.Read(p)
+		if err == io.EOF {
+			err = c.cur.Close()
+			c.cur, c.sep = nil, true
+			if n > 0 || err != nil {
+				return n, err
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+func (c *chainReader) Close() error {
+	if c.cur != nil {
+		return c.cur.Close()
+	}
+	return nil
+}
+
+// mergeStream 归并中的一路
+type mergeStream struct {
+	idx     int // ts 相同时按子目录顺序输出，结果与运行无关
+	scanner *bufio.Scanner
+	line    []byte
+	ts      int64
+}
+
+// next 读取下一条非空行，返回 false 表示已读完
+func (s *mergeStream) next() (bool, error) {
+	for s.scanner.Scan() {
+		line := s.scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		s.line = append(s.line[:0], line...)
+		s.ts = gjson.GetBytes(s.line, "ts").Int()
+		return true, nil
+	}
+	return false, s.scanner.Err()
+}
+
+type mergeHeap []*mergeStream
+
+func (h mergeHeap) Len() int { return len(h) }
+func (h mergeHeap) Less(i, j int) bool {
+	if h[i].ts != h[j].ts {
+		return h[i].ts < h[j].ts
+	}
+	return h[i].idx < h[j].idx
+}
+func (h mergeHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *mergeHeap) Push(x any)   { *h = append(*h, x.(*mergeStream)) }
+func (h *mergeHeap) Pop() any {
+	old := *h
+	s := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return s
+}
+
+// mergeStreams 按 ts 归并多个各自有序的 NDJSON 流
+func mergeStreams(streams []io.ReadCloser) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		defer func() {
+			for _, r := range streams {
+				_ = r.Close()
+			}
+		}()
+		pw.CloseWithError(writeMerged(pw, streams))
+	}()
+	return pr
+}
+
+func writeMerged(w io.Writer, streams []io.ReadCloser) error {
+	h := make(mergeHeap, 0, len(streams))
+	for i, r := range streams {
+		s := &mergeStream{idx: i, scanner: bufio.NewScanner(r)}
+		s.scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+		ok, err := s.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			h = append(h, s)
+		}
+	}
+	heap.Init(&h)
+
+	bw := bufio.NewWriter(w)
+	for h.Len() > 0 {
+		s := h[0]
+		if _, err := bw.Write(s.line); err != nil {
+			return err
+		}
+		if err := bw.WriteByte('\n'); err != nil {
+			return err
+		}
+		ok, err := s.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Fix(&h, 0)
+		} else {
+			heap.Pop(&h)
+		}
+	}
+	return bw.Flush()
+}
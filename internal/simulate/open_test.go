@@ -0,0 +1,67 @@
+package simulate
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeGzipMembers(t *testing.T, path string, members ...string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	for _, m := range members {
+		zw := gzip.NewWriter(f)
+		_, err := io.WriteString(zw, m)
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+	}
+}
+
+func TestOpen_Gzip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "capture.ndjson.gz")
+	writeGzipMembers(t, path, capture[:60], capture[60:])
+
+	r, err := Open(path)
+	require.NoError(t, err)
+	defer r.Close()
+	users, err := Users(r)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0xabc", "0xdef"}, users)
+}
+
+func TestOpen_DirMergesByTs(t *testing.T) {
+	dir := t.TempDir()
+	writeGzipMembers(t, filepath.Join(dir, "userFills", "0xabc", "20260101T000000Z.ndjson.gz"),
+		`{"ts":1100,"channel":"userFills","data":{"user":"0xabc"}}`+"\n")
+	writeGzipMembers(t, filepath.Join(dir, "userFills", "0xabc", "20260101T001000Z.ndjson.gz"),
+		`{"ts":1400,"channel":"userFills","data":{"user":"0xabc"}}`+"\n")
+	writeGzipMembers(t, filepath.Join(dir, "webData2", "0xabc", "20260101T000000Z.ndjson.gz"),
+		`{"ts":1000,"channel":"webData2","data":{"user":"0xabc"}}`+"\n"+
+			`{"ts":1300,"channel":"webData2","data":{"user":"0xabc"}}`+"\n")
+	// 末行无换行的未压缩文件
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "orderUpdates", "all"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "orderUpdates", "all", "a.ndjson"),
+		[]byte(`{"ts":1200,"channel":"orderUpdates","data":[]}`), 0o644))
+
+	r, err := Open(dir)
+	require.NoError(t, err)
+	defer r.Close()
+
+	var ts []int64
+	require.NoError(t, Scan(r, func(rec Record) error {
+		ts = append(ts, rec.Ts)
+		return nil
+	}))
+	assert.Equal(t, []int64{1000, 1100, 1200, 1300, 1400}, ts)
+
+	_, err = Open(t.TempDir())
+	assert.Error(t, err)
+}
@@ -0,0 +1,118 @@
+package simulate
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+)
+
+// maxDiffs 对比 golden 时最多报告的差异条数
+const maxDiffs = 20
+
+// Recorder 记录回放产生的信号，替代 NATS 发布器
+type Recorder struct {
+	mu      sync.Mutex
+	signals []*nats.HlAddressSignal
+}
+
+// NewRecorder 创建信号记录器
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// PublishAddressSignal 记录信号
+func (r *Recorder) PublishAddressSignal(signal *nats.HlAddressSignal) error {
+	cp := *signal
+	r.mu.Lock()
+	r.signals = append(r.signals, &cp)
+	r.mu.Unlock()
+	return nil
+}
+
+// Count 已记录的信号数
+func (r *Recorder) Count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.signals)
+}
+
+// Signals 规范化后的信号：清空每次运行都不同的 trace_id，按时间、地址、幂等键排序，结果与处理并发无关
+func (r *Recorder) Signals() []*nats.HlAddressSignal {
+	r.mu.Lock()
+	signals := make([]*nats.HlAddressSignal, 0, len(r.signals))
+	for _, s := range r.signals {
+		cp := *s
+		cp.TraceID = ""
+		signals = append(signals, &cp)
+	}
+	r.mu.Unlock()
+
+	sort.SliceStable(signals, func(i, j int) bool {
+		a, b := signals[i], signals[j]
+		if a.Timestamp != b.Timestamp {
+			return a.Timestamp < b.Timestamp
+		}
+		if a.Address != b.Address {
+			return a.Address < b.Address
+		}
+		return a.IdempotencyKey < b.IdempotencyKey
+	})
+	return signals
+}
+
+// WriteSignals 将信号写为 NDJSON（golden 文件格式）
+func WriteSignals(w io.Writer, signals []*nats.HlAddressSignal) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	for _, s := range signals {
+		if err := enc.Encode(s); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// ReadSignals 读取 NDJSON 格式的信号
+func ReadSignals(r io.Reader) ([]*nats.HlAddressSignal, error) {
+	var signals []*nats.HlAddressSignal
+	dec := json.NewDecoder(r)
+	for dec.More() {
+		var s nats.HlAddressSignal
+		if err := dec.Decode(&s); err != nil {
+			return nil, fmt.Errorf("signal %d: %w", len(signals)+1, err)
+		}
+		signals = append(signals, &s)
+	}
+	return signals, nil
+}
+
+// Diff 逐条对比信号与 golden，返回差异描述（最多 maxDiffs 条），一致时返回 nil
+func Diff(got, want []*nats.HlAddressSignal) []string {
+	var diffs []string
+	for i := 0; i < max(len(got), len(want)) && len(diffs) < maxDiffs; i++ {
+		var g, w []byte
+		if i < len(got) {
+			g, _ = json.Marshal(got[i])
+		}
+		if i < len(want) {
+			w, _ = json.Marshal(want[i])
+		}
+		switch {
+		case g == nil:
+			diffs = append(diffs, fmt.Sprintf("#%d missing: %s", i+1, w))
+		case w == nil:
+			diffs = append(diffs, fmt.Sprintf("#%d unexpected: %s", i+1, g))
+		case string(g) != string(w):
+			diffs = append(diffs, fmt.Sprintf("#%d\n  got:  %s\n  want: %s", i+1, g, w))
+		}
+	}
+	if len(got) != len(want) && len(diffs) < maxDiffs {
+		diffs = append(diffs, fmt.Sprintf("signal count: got %d, want %d", len(got), len(want)))
+	}
+	return diffs
+}
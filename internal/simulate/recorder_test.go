@@ -0,0 +1,44 @@
+package simulate
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+)
+
+func TestRecorder_Signals(t *testing.T) {
+	r := NewRecorder()
+	require.NoError(t, r.PublishAddressSignal(&nats.HlAddressSignal{Address: "0xb", Timestamp: 2, IdempotencyKey: "k3", TraceID: "t1"}))
+	require.NoError(t, r.PublishAddressSignal(&nats.HlAddressSignal{Address: "0xb", Timestamp: 1, IdempotencyKey: "k2", TraceID: "t2"}))
+	require.NoError(t, r.PublishAddressSignal(&nats.HlAddressSignal{Address: "0xa", Timestamp: 1, IdempotencyKey: "k1", TraceID: "t3"}))
+	assert.Equal(t, 3, r.Count())
+
+	signals := r.Signals()
+	require.Len(t, signals, 3)
+	for i, key := range []string{"k1", "k2", "k3"} {
+		assert.Equal(t, key, signals[i].IdempotencyKey)
+		assert.Empty(t, signals[i].TraceID)
+	}
+
+	t.Run("GoldenRoundTrip", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteSignals(&buf, signals))
+		golden, err := ReadSignals(&buf)
+		require.NoError(t, err)
+		assert.Nil(t, Diff(signals, golden))
+	})
+
+	t.Run("Diff", func(t *testing.T) {
+		changed := *signals[1]
+		changed.Size = 2
+		diffs := Diff(signals, []*nats.HlAddressSignal{signals[0], &changed})
+		require.Len(t, diffs, 3)
+		assert.Contains(t, diffs[0], "#2")
+		assert.Contains(t, diffs[1], "#3 unexpected")
+		assert.Equal(t, "signal count: got 3, want 2", diffs[2])
+	})
+}
@@ -0,0 +1,141 @@
+package simulate
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/utrading/utrading-hl-monitor/internal/ws"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// maxRecordSize 单条录制消息的最大长度（与 ws 客户端读取上限一致并留余量）
+const maxRecordSize = 4 * 1024 * 1024
+
+// Record 录制的一条 WS 消息（NDJSON 每行一条），ts 为接收时间（毫秒），缺失时不等待
+type Record struct {
+	Ts      int64           `json:"ts"`
+	Channel ws.Channel      `json:"channel"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// Stats 回放统计
+type Stats struct {
+	Messages int           // 注入的消息数
+	Failed   int           // 注入失败的消息数
+	Elapsed  time.Duration // 回放耗时
+}
+
+// ParseSpeed 解析回放速度：10x、10、0.5x 为相对录制时间的倍速，max 表示不等待
+func ParseSpeed(s string) (float64, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "max" {
+		return 0, nil
+	}
+	speed, err := strconv.ParseFloat(strings.TrimSuffix(s, "x"), 64)
+	if err != nil || speed <= 0 {
+		return 0, fmt.Errorf("invalid speed %q, want e.g. 1x, 10x or max", s)
+	}
+	return speed, nil
+}
+
+// Scan 逐行读取 NDJSON 录制，空行忽略
+func Scan(r io.Reader, fn func(Record) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		raw := scanner.Bytes()
+		if len(strings.TrimSpace(string(raw))) == 0 {
+			continue
+		}
+		var rec Record
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		if rec.Channel == "" {
+			return fmt.Errorf("line %d: missing channel", line)
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// Users 录制中出现的地址（消息 data.user），按首次出现顺序，用于回放前建立订阅
+func Users(r io.Reader) ([]string, error) {
+	seen := make(map[string]struct{})
+	var users []string
+	err := Scan(r, func(rec Record) error {
+		user := gjson.GetBytes(rec.Data, "user").String()
+		if user == "" {
+			return nil
+		}
+		if _, ok := seen[user]; !ok {
+			seen[user] = struct{}{}
+			users = append(users, user)
+		}
+		return nil
+	})
+	return users, err
+}
+
+// Replayer 按录制时间间隔（除以 speed）将消息依次注入 sink
+type Replayer struct {
+	speed float64 // 0 表示不等待
+	sink  func(ws.WsMessage) error
+}
+
+// NewReplayer 创建回放器
+func NewReplayer(speed float64, sink func(ws.WsMessage) error) *Replayer {
+	return &Replayer{speed: speed, sink: sink}
+}
+
+// Run 回放 r 中的全部消息；ctx 取消时提前返回已回放的统计
+func (p *Replayer) Run(ctx context.Context, r io.Reader) (Stats, error) {
+	var stats Stats
+	start := time.Now()
+	var firstTs int64
+
+	err := Scan(r, func(rec Record) error {
+		if p.speed > 0 && rec.Ts > 0 {
+			if firstTs == 0 {
+				firstTs = rec.Ts
+			}
+			// 按相对首条消息的时间对齐，避免逐条 sleep 的误差累积
+			due := start.Add(time.Duration(float64(rec.Ts-firstTs) * float64(time.Millisecond) / p.speed))
+			if wait := time.Until(due); wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return ctx.Err()
+				case <-timer.C:
+				}
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		stats.Messages++
+		if err := p.sink(ws.WsMessage{Channel: rec.Channel, Data: rec.Data}); err != nil {
+			stats.Failed++
+			logger.Warn().Err(err).Str("channel", string(rec.Channel)).Msg("inject recorded message failed")
+		}
+		return nil
+	})
+
+	stats.Elapsed = time.Since(start)
+	return stats, err
+}
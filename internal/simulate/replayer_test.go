@@ -0,0 +1,86 @@
+package simulate
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/internal/ws"
+)
+
+const capture = `{"ts":1000,"channel":"webData2","data":{"user":"0xabc"}}
+{"ts":1100,"channel":"userFills","data":{"user":"0xabc","fills":[]}}
+
+{"ts":1200,"channel":"orderUpdates","data":[]}
+{"ts":1300,"channel":"userFills","data":{"user":"0xdef","fills":[]}}
+`
+
+func TestParseSpeed(t *testing.T) {
+	for in, want := range map[string]float64{"1x": 1, "10x": 10, "0.5x": 0.5, "20": 20, "MAX": 0} {
+		got, err := ParseSpeed(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	for _, in := range []string{"", "fast", "0x", "-2x"} {
+		_, err := ParseSpeed(in)
+		assert.Error(t, err, in)
+	}
+}
+
+func TestUsers(t *testing.T) {
+	users, err := Users(strings.NewReader(capture))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0xabc", "0xdef"}, users)
+
+	_, err = Users(strings.NewReader("{\"channel\":\"userFills\"}\nnot json\n"))
+	assert.ErrorContains(t, err, "line 2")
+}
+
+func TestReplayer_Run(t *testing.T) {
+	t.Run("Speed", func(t *testing.T) {
+		var channels []ws.Channel
+		replayer := NewReplayer(10, func(msg ws.WsMessage) error {
+			channels = append(channels, msg.Channel)
+			return nil
+		})
+
+		stats, err := replayer.Run(context.Background(), strings.NewReader(capture))
+		require.NoError(t, err)
+		assert.Equal(t, 4, stats.Messages)
+		assert.Equal(t, []ws.Channel{ws.ChannelWebData2, ws.ChannelUserFills, ws.ChannelOrderUpdates, ws.ChannelUserFills}, channels)
+		// 录制跨度 300ms，10 倍速约 30ms
+		assert.GreaterOrEqual(t, stats.Elapsed, 30*time.Millisecond)
+		assert.Less(t, stats.Elapsed, 300*time.Millisecond)
+	})
+
+	t.Run("MaxSpeedCountsFailures", func(t *testing.T) {
+		replayer := NewReplayer(0, func(msg ws.WsMessage) error {
+			if msg.Channel == ws.ChannelOrderUpdates {
+				return assert.AnError
+			}
+			return nil
+		})
+
+		stats, err := replayer.Run(context.Background(), strings.NewReader(capture))
+		require.NoError(t, err)
+		assert.Equal(t, 4, stats.Messages)
+		assert.Equal(t, 1, stats.Failed)
+		assert.Less(t, stats.Elapsed, 30*time.Millisecond)
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		replayer := NewReplayer(0.01, func(ws.WsMessage) error {
+			cancel()
+			return nil
+		})
+
+		stats, err := replayer.Run(ctx, strings.NewReader(capture))
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, stats.Messages)
+	})
+}
@@ -2,10 +2,11 @@ package symbol
 
 import (
 	"context"
-	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/sonirico/go-hyperliquid"
+	"github.com/spf13/cast"
 	"github.com/utrading/utrading-hl-monitor/internal/cache"
 	"github.com/utrading/utrading-hl-monitor/pkg/logger"
 )
@@ -13,19 +14,40 @@ import (
 // Loader Symbol 元数据加载器
 type Loader struct {
 	cache          *cache.SymbolCache
+	normalizer     *Normalizer
 	client         *hyperliquid.Info
 	httpURL        string
 	reloadInterval time.Duration
+	marketCtxs     *cache.MarketCtxCache // 合约市场上下文（可选）
+	dexes          *cache.DexRegistry    // 永续 dex 注册表，随元数据一起刷新
+	onDelisted     atomic.Pointer[DelistedHandler]
+	loaded         bool // 首次加载完成后才通知新下架的资产
 	done           chan struct{}
 }
 
+// DelistedAsset 重载元数据时新发现的已下架合约资产
+type DelistedAsset struct {
+	Coin   string // 原始资产名（如 BTC、xyz:GOLD）
+	Symbol string // 规范化后的 symbol
+}
+
+// DelistedHandler 新下架资产回调，在重载协程中执行
+type DelistedHandler func(assets []DelistedAsset)
+
 // NewLoader 创建 Loader，首次加载失败会返回错误
-func NewLoader(symbolCache *cache.SymbolCache, httpURL string) (*Loader, error) {
+// normalizer 为 nil 时使用内置规则
+func NewLoader(symbolCache *cache.SymbolCache, normalizer *Normalizer, httpURL string) (*Loader, error) {
+	if normalizer == nil {
+		normalizer = DefaultNormalizer()
+	}
+
 	sl := &Loader{
 		cache:          symbolCache,
+		normalizer:     normalizer,
 		client:         hyperliquid.NewInfo(context.TODO(), httpURL, false, nil, nil),
 		httpURL:        httpURL,
 		reloadInterval: 2 * time.Hour,
+		dexes:          cache.NewDexRegistry(),
 		done:           make(chan struct{}),
 	}
 
@@ -36,6 +58,7 @@ func NewLoader(symbolCache *cache.SymbolCache, httpURL string) (*Loader, error)
 	logger.Info().
 		Int("spot_count", sl.getSpotCount()).
 		Int("perp_count", sl.getPerpCount()).
+		Strs("dexes", sl.dexes.Names()).
 		Msg("Loader initialized")
 
 	return sl, nil
@@ -59,6 +82,41 @@ func (sl *Loader) Start() {
 	}()
 }
 
+// StartMarketCtxs 按 interval 刷新主 dex 合约的市场上下文（24h 成交额、未平仓量、资金费率）
+// 首次加载失败只记录日志，信号缺少上下文时相应字段为空
+func (sl *Loader) StartMarketCtxs(marketCtxs *cache.MarketCtxCache, interval time.Duration) {
+	sl.marketCtxs = marketCtxs
+	if err := sl.loadMarketCtxs(); err != nil {
+		logger.Error().Err(err).Msg("load market contexts failed")
+	}
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := sl.loadMarketCtxs(); err != nil {
+					logger.Error().Err(err).Msg("reload market contexts failed")
+				}
+			case <-sl.done:
+				return
+			}
+		}
+	}()
+}
+
+// SetDelistedHandler 设置新下架资产回调（可选）
+// 启动时已下架的资产只标记不通知，之后每次重载新变为 isDelisted 的资产通知一次
+func (sl *Loader) SetDelistedHandler(handler DelistedHandler) {
+	sl.onDelisted.Store(&handler)
+}
+
+// DexRegistry 返回永续 dex 注册表
+func (sl *Loader) DexRegistry() *cache.DexRegistry {
+	return sl.dexes
+}
+
 // Close 停止重载
 func (sl *Loader) Close() {
 	close(sl.done)
@@ -76,12 +134,23 @@ func (sl *Loader) loadMeta() error {
 
 	sl.buildSpotCache(spotMeta)
 
+	// dex 注册表加载失败时沿用上次结果，不影响 symbol 映射
+	if dexes, err := sl.client.PerpDexs(ctx); err != nil {
+		logger.Warn().Err(err).Msg("load perp dexes failed")
+	} else {
+		sl.dexes.Set(dexes)
+	}
+
 	perpMeta, err := sl.client.PerpMeta(ctx)
 	if err != nil {
 		return err
 	}
 
-	sl.buildPerpCache(perpMeta)
+	delisted := sl.buildPerpCache(perpMeta)
+	if sl.loaded && len(delisted) > 0 {
+		sl.notifyDelisted(delisted)
+	}
+	sl.loaded = true
 
 	logger.Info().
 		Int("spot_count", sl.getSpotCount()).
@@ -91,6 +160,36 @@ func (sl *Loader) loadMeta() error {
 	return nil
 }
 
+// loadMarketCtxs 从 metaAndAssetCtxs 刷新合约市场上下文
+func (sl *Loader) loadMarketCtxs() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	metaCtxs, err := sl.client.MetaAndAssetCtxs(ctx)
+	if err != nil {
+		return err
+	}
+
+	sl.buildMarketCtxs(metaCtxs, time.Now())
+	return nil
+}
+
+// buildMarketCtxs 按 universe 下标对应资产与上下文
+func (sl *Loader) buildMarketCtxs(metaCtxs *hyperliquid.MetaAndAssetCtxs, now time.Time) {
+	for i, assetInfo := range metaCtxs.Universe {
+		if i >= len(metaCtxs.Ctxs) {
+			break
+		}
+		assetCtx := metaCtxs.Ctxs[i]
+		sl.marketCtxs.Set(assetInfo.Name, cache.MarketCtx{
+			DayNtlVlm:    cast.ToFloat64(assetCtx.DayNtlVlm),
+			OpenInterest: cast.ToFloat64(assetCtx.OpenInterest),
+			Funding:      cast.ToFloat64(assetCtx.Funding),
+			UpdatedAt:    now,
+		})
+	}
+}
+
 // buildSpotCache 构建现货缓存
 func (sl *Loader) buildSpotCache(spotMeta *hyperliquid.SpotMeta) {
 	spotTokenLen := len(spotMeta.Tokens)
@@ -104,33 +203,52 @@ func (sl *Loader) buildSpotCache(spotMeta *hyperliquid.SpotMeta) {
 
 		baseToken := spotMeta.Tokens[spotInfo.Tokens[0]]
 		quoteCoin := spotMeta.Tokens[spotInfo.Tokens[1]].Name
-		baseCoin := hyperliquid.MainnetToAlias(baseToken.Name)
-		symbol := baseCoin + quoteCoin
+		symbol, ok := sl.normalizer.Spot(baseToken.Name, quoteCoin)
+		if !ok {
+			continue
+		}
 		sl.cache.SetSpotSymbol(spotInfo.Name, symbol)
 	}
 }
 
-// buildPerpCache 构建合约缓存
-func (sl *Loader) buildPerpCache(perpMeta []*hyperliquid.Meta) {
+// notifyDelisted 记录并通知新下架的资产
+func (sl *Loader) notifyDelisted(assets []DelistedAsset) {
+	for _, asset := range assets {
+		logger.Warn().
+			Str("coin", asset.Coin).
+			Str("symbol", asset.Symbol).
+			Msg("perp asset delisted")
+	}
+	if handler := sl.onDelisted.Load(); handler != nil && *handler != nil {
+		(*handler)(assets)
+	}
+}
+
+// buildPerpCache 构建合约缓存，返回本次新标记为下架的资产
+func (sl *Loader) buildPerpCache(perpMeta []*hyperliquid.Meta) []DelistedAsset {
+	var delisted []DelistedAsset
 	for _, meta := range perpMeta {
 		for _, assetInfo := range meta.Universe {
-			cleanName := assetInfo.Name
-			if strings.Contains(assetInfo.Name, ":") {
-				parts := strings.Split(assetInfo.Name, ":")
-				if len(parts) == 2 && parts[0] == "xyz" {
-					cleanName = parts[1]
-				}
+			cleanName, symbol, ok := sl.normalizer.Perp(assetInfo.Name)
+			if !ok {
+				logger.Debug().Str("asset", assetInfo.Name).Msg("no symbol rule matched, skipped")
+				continue
 			}
 
-			cleanName = hyperliquid.MainnetToAlias(cleanName)
-			symbol := cleanName + "USDC"
-			sl.cache.SetPerpSymbol(cleanName, symbol)
+			sl.cache.SetPerpSymbol(assetInfo.Name, symbol)
+			if assetInfo.IsDelisted && sl.cache.MarkPerpDelisted(assetInfo.Name, symbol) {
+				delisted = append(delisted, DelistedAsset{Coin: assetInfo.Name, Symbol: symbol})
+			}
 
+			// 同时按规范化 coin 建立映射，已被其他资产（通常是主 dex 同名资产）占用时不覆盖
 			if assetInfo.Name != cleanName {
-				sl.cache.SetPerpSymbol(assetInfo.Name, symbol)
+				if _, exists := sl.cache.GetPerpSymbol(cleanName); !exists {
+					sl.cache.SetPerpSymbol(cleanName, symbol)
+				}
 			}
 		}
 	}
+	return delisted
 }
 
 // getSpotCount 获取现货缓存数量
@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/sonirico/go-hyperliquid"
+
 	"github.com/utrading/utrading-hl-monitor/internal/cache"
 )
 
@@ -14,7 +16,7 @@ func TestLoader_NewLoader(t *testing.T) {
 
 	symbolCache := cache.NewSymbolCache()
 
-	loader, err := NewLoader(symbolCache, "https://api.hyperliquid.xyz/info")
+	loader, err := NewLoader(symbolCache, nil, "https://api.hyperliquid.xyz/info")
 	if err != nil {
 		t.Skipf("Skip test due to API error: %v", err)
 	}
@@ -41,7 +43,7 @@ func TestLoader_ReloadLoop(t *testing.T) {
 
 	symbolCache := cache.NewSymbolCache()
 
-	loader, err := NewLoader(symbolCache, "https://api.hyperliquid.xyz/info")
+	loader, err := NewLoader(symbolCache, nil, "https://api.hyperliquid.xyz/info")
 	if err != nil {
 		t.Skipf("Skip test due to API error: %v", err)
 	}
@@ -60,7 +62,7 @@ func TestLoader_SpotMapping(t *testing.T) {
 
 	symbolCache := cache.NewSymbolCache()
 
-	loader, err := NewLoader(symbolCache, "https://api.hyperliquid.xyz/info")
+	loader, err := NewLoader(symbolCache, nil, "https://api.hyperliquid.xyz/info")
 	if err != nil {
 		t.Skipf("Skip test due to API error: %v", err)
 	}
@@ -92,7 +94,7 @@ func TestLoader_PerpMapping(t *testing.T) {
 
 	symbolCache := cache.NewSymbolCache()
 
-	loader, err := NewLoader(symbolCache, "https://api.hyperliquid.xyz/info")
+	loader, err := NewLoader(symbolCache, nil, "https://api.hyperliquid.xyz/info")
 	if err != nil {
 		t.Skipf("Skip test due to API error: %v", err)
 	}
@@ -116,3 +118,68 @@ func TestLoader_PerpMapping(t *testing.T) {
 		}
 	}
 }
+
+func TestLoader_BuildMarketCtxs(t *testing.T) {
+	marketCtxs := cache.NewMarketCtxCache()
+	sl := &Loader{marketCtxs: marketCtxs}
+
+	now := time.Now()
+	sl.buildMarketCtxs(&hyperliquid.MetaAndAssetCtxs{
+		Meta: hyperliquid.Meta{Universe: []hyperliquid.AssetInfo{{Name: "BTC"}, {Name: "ETH"}, {Name: "SOL"}}},
+		Ctxs: []hyperliquid.AssetCtx{
+			{DayNtlVlm: "1500000000.5", OpenInterest: "30000.1", Funding: "0.0000125"},
+			{DayNtlVlm: "800000000", OpenInterest: "500000", Funding: "-0.00001"},
+		},
+	}, now)
+
+	btc, ok := marketCtxs.Get("BTC")
+	if !ok {
+		t.Fatal("BTC market context should be cached")
+	}
+	if btc.DayNtlVlm != 1500000000.5 || btc.OpenInterest != 30000.1 || btc.Funding != 0.0000125 || !btc.UpdatedAt.Equal(now) {
+		t.Errorf("unexpected BTC market context: %+v", btc)
+	}
+
+	eth, _ := marketCtxs.Get("ETH")
+	if eth.Funding != -0.00001 {
+		t.Errorf("expected ETH funding -0.00001, got %v", eth.Funding)
+	}
+
+	// 上下文数量少于 universe 时跳过多出的资产
+	if _, ok := marketCtxs.Get("SOL"); ok {
+		t.Error("SOL should be skipped without context")
+	}
+}
+
+func TestLoader_BuildPerpCacheDelisted(t *testing.T) {
+	symbolCache := cache.NewSymbolCache()
+	sl := &Loader{cache: symbolCache, normalizer: DefaultNormalizer()}
+
+	var notified []DelistedAsset
+	sl.SetDelistedHandler(func(assets []DelistedAsset) {
+		notified = append(notified, assets...)
+	})
+
+	meta := &hyperliquid.Meta{Universe: []hyperliquid.AssetInfo{{Name: "BTC"}, {Name: "OLD", IsDelisted: true}}}
+	delisted := sl.buildPerpCache([]*hyperliquid.Meta{meta})
+	if len(delisted) != 1 || delisted[0].Coin != "OLD" {
+		t.Fatalf("unexpected delisted assets: %+v", delisted)
+	}
+	if !symbolCache.IsPerpDelisted("OLD") || symbolCache.IsPerpDelisted("BTC") {
+		t.Error("only OLD should be marked delisted")
+	}
+	if _, ok := symbolCache.GetPerpSymbol("OLD"); !ok {
+		t.Error("delisted asset should keep its symbol mapping")
+	}
+
+	// 重载时已标记的资产不再返回，新下架的资产返回一次
+	meta.Universe[0].IsDelisted = true
+	delisted = sl.buildPerpCache([]*hyperliquid.Meta{meta})
+	if len(delisted) != 1 || delisted[0].Coin != "BTC" {
+		t.Fatalf("unexpected delisted assets on reload: %+v", delisted)
+	}
+	sl.notifyDelisted(delisted)
+	if len(notified) != 1 || notified[0].Coin != "BTC" {
+		t.Errorf("unexpected notified assets: %+v", notified)
+	}
+}
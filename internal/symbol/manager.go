@@ -2,37 +2,55 @@ package symbol
 
 import (
 	"github.com/sonirico/go-hyperliquid"
+	"github.com/utrading/utrading-hl-monitor/config"
 	"github.com/utrading/utrading-hl-monitor/internal/cache"
 )
 
 // Manager Symbol 管理器（纯容器）
-// 统一管理 SymbolCache、Loader 和 PriceCache 的生命周期
+// 统一管理 SymbolCache、Loader、PriceCache、MarketCtxCache 和 DexRegistry 的生命周期
 type Manager struct {
 	symbolCache *cache.SymbolCache
+	normalizer  *Normalizer
 	loader      *Loader
 	priceCache  *cache.PriceCache
+	marketCtxs  *cache.MarketCtxCache
 }
 
 // NewManager 创建 Symbol 管理器
 // 首次加载失败会返回错误，确保服务启动时 Symbol 数据可用
-func NewManager() (*Manager, error) {
-	// 1. 创建缓存
+func NewManager(cfg config.Symbol) (*Manager, error) {
+	// 1. 编译 symbol 规范化规则
+	normalizer, err := NewNormalizer(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 创建缓存
 	symbolCache := cache.NewSymbolCache()
 	priceCache := cache.NewPriceCache()
 
-	// 2. 创建加载器（立即加载，失败返回错误）
-	loader, err := NewLoader(symbolCache, hyperliquid.MainnetAPIURL)
+	// 3. 创建加载器（立即加载，失败返回错误）
+	loader, err := NewLoader(symbolCache, normalizer, hyperliquid.MainnetAPIURL)
 	if err != nil {
 		return nil, err
 	}
 
-	// 3. 启动后台重载
+	// 4. 只映射 perpDexs 中登记的 dex 资产，启动后台重载
+	normalizer.SetDexRegistry(loader.DexRegistry())
 	loader.Start()
 
+	// 5. 刷新合约市场上下文（可选）
+	marketCtxs := cache.NewMarketCtxCache()
+	if cfg.MarketCtxInterval > 0 {
+		loader.StartMarketCtxs(marketCtxs, cfg.MarketCtxInterval)
+	}
+
 	return &Manager{
 		symbolCache: symbolCache,
+		normalizer:  normalizer,
 		loader:      loader,
 		priceCache:  priceCache,
+		marketCtxs:  marketCtxs,
 	}, nil
 }
 
@@ -47,7 +65,39 @@ func (m *Manager) SymbolCache() *cache.SymbolCache {
 	return m.symbolCache
 }
 
+// Normalizer 返回 symbol 规范化器
+func (m *Manager) Normalizer() *Normalizer {
+	return m.normalizer
+}
+
 // PriceCache 返回价格缓存
 func (m *Manager) PriceCache() *cache.PriceCache {
 	return m.priceCache
 }
+
+// MarketCtxCache 返回合约市场上下文缓存，未开启刷新时为空缓存
+func (m *Manager) MarketCtxCache() *cache.MarketCtxCache {
+	return m.marketCtxs
+}
+
+// DexRegistry 返回永续 dex 注册表
+func (m *Manager) DexRegistry() *cache.DexRegistry {
+	return m.loader.DexRegistry()
+}
+
+// SetRenames 设置 symbol 改名映射（旧 symbol -> 新 symbol），同时改写已加载的 symbol，之后的重载同样按映射改名
+func (m *Manager) SetRenames(perp, spot map[string]string) {
+	m.normalizer.SetRenames(perp, spot)
+	m.symbolCache.RenamePerpSymbols(m.normalizer.RenamePerp)
+	m.symbolCache.RenameSpotSymbols(m.normalizer.RenameSpot)
+}
+
+// SetDelistedHandler 设置新下架合约资产回调（可选），在后台重载时触发
+func (m *Manager) SetDelistedHandler(handler DelistedHandler) {
+	m.loader.SetDelistedHandler(handler)
+}
+
+// Info 返回 Hyperliquid Info 客户端
+func (m *Manager) Info() *hyperliquid.Info {
+	return m.loader.client
+}
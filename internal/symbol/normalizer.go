@@ -0,0 +1,167 @@
+package symbol
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/sonirico/go-hyperliquid"
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
+)
+
+// Normalizer 按配置规则将 Hyperliquid 资产名映射为下游 symbol
+type Normalizer struct {
+	perp  []normalizeRule
+	spot  []normalizeRule
+	dexes *cache.DexRegistry // dex 注册表（可选），未登记 dex 的资产不做映射
+
+	renamesMu   sync.RWMutex
+	perpRenames map[string]string // 合约 symbol 改名映射（可选），旧 symbol -> 新 symbol
+	spotRenames map[string]string // 现货 symbol 改名映射（可选）
+}
+
+type normalizeRule struct {
+	re     *regexp.Regexp
+	coin   string
+	symbol string
+}
+
+// NewNormalizer 编译规则，未配置的一侧使用内置规则
+func NewNormalizer(cfg config.Symbol) (*Normalizer, error) {
+	defaults := config.Default().Symbol
+	if len(cfg.PerpRules) == 0 {
+		cfg.PerpRules = defaults.PerpRules
+	}
+	if len(cfg.SpotRules) == 0 {
+		cfg.SpotRules = defaults.SpotRules
+	}
+
+	perp, err := compileRules("perp", cfg.PerpRules)
+	if err != nil {
+		return nil, err
+	}
+	spot, err := compileRules("spot", cfg.SpotRules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Normalizer{perp: perp, spot: spot}, nil
+}
+
+// DefaultNormalizer 内置规则：去掉 builder dex 前缀（如 xyz:），合约追加 USDC，现货拼接 quote
+func DefaultNormalizer() *Normalizer {
+	n, err := NewNormalizer(config.Symbol{})
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func compileRules(kind string, rules []config.SymbolRule) ([]normalizeRule, error) {
+	compiled := make([]normalizeRule, 0, len(rules))
+	for i, r := range rules {
+		if r.Symbol == "" {
+			return nil, fmt.Errorf("symbol %s rule %d: empty symbol template", kind, i)
+		}
+		re, err := regexp.Compile(r.Match)
+		if err != nil {
+			return nil, fmt.Errorf("symbol %s rule %d: %w", kind, i, err)
+		}
+		coin := r.Coin
+		if coin == "" {
+			coin = "$0"
+		}
+		compiled = append(compiled, normalizeRule{re: re, coin: coin, symbol: r.Symbol})
+	}
+	return compiled, nil
+}
+
+// SetDexRegistry 设置 dex 注册表，需在使用前调用
+func (n *Normalizer) SetDexRegistry(dexes *cache.DexRegistry) {
+	n.dexes = dexes
+}
+
+// Perp 规范化合约资产名，返回规范化 coin 与下游 symbol；
+// 未命中任何规则或所属 dex 未在注册表登记时 ok 为 false
+func (n *Normalizer) Perp(name string) (coin, symbol string, ok bool) {
+	if n.dexes != nil && !n.dexes.Known(name) {
+		return "", "", false
+	}
+	coin, symbol, ok = apply(n.perp, name, "")
+	if ok {
+		symbol = n.RenamePerp(symbol)
+	}
+	return coin, symbol, ok
+}
+
+// Spot 规范化现货交易对（base 为 token 名），未命中任何规则时 ok 为 false
+func (n *Normalizer) Spot(base, quote string) (symbol string, ok bool) {
+	_, symbol, ok = apply(n.spot, base, quote)
+	if ok {
+		symbol = n.RenameSpot(symbol)
+	}
+	return symbol, ok
+}
+
+// SetRenames 设置 symbol 改名映射（旧 symbol -> 新 symbol），规则生成的 symbol 命中时替换为新 symbol
+func (n *Normalizer) SetRenames(perp, spot map[string]string) {
+	n.renamesMu.Lock()
+	n.perpRenames = perp
+	n.spotRenames = spot
+	n.renamesMu.Unlock()
+}
+
+// RenamePerp 按改名映射返回合约 symbol 的当前名称，未改名时原样返回
+func (n *Normalizer) RenamePerp(symbol string) string {
+	n.renamesMu.RLock()
+	defer n.renamesMu.RUnlock()
+	return rename(n.perpRenames, symbol)
+}
+
+// RenameSpot 按改名映射返回现货 symbol 的当前名称，未改名时原样返回
+func (n *Normalizer) RenameSpot(symbol string) string {
+	n.renamesMu.RLock()
+	defer n.renamesMu.RUnlock()
+	return rename(n.spotRenames, symbol)
+}
+
+// rename 沿映射链查找最终名称（A -> B、B -> C 时 A 映射为 C），最多跳转 len(renames) 次以防成环
+func rename(renames map[string]string, symbol string) string {
+	for range len(renames) {
+		renamed, ok := renames[symbol]
+		if !ok || renamed == symbol {
+			break
+		}
+		symbol = renamed
+	}
+	return symbol
+}
+
+// Accepts 判断 ws 推送的 coin 是否需要处理：非 dex 资产（含现货 @107、PURR/USDC）恒为 true，
+// dex 资产（带 : 前缀）须属于已登记的 dex 并命中合约规则
+func (n *Normalizer) Accepts(coin string) bool {
+	if !strings.Contains(coin, ":") {
+		return true
+	}
+	_, _, ok := n.Perp(coin)
+	return ok
+}
+
+func apply(rules []normalizeRule, name, quote string) (coin, symbol string, ok bool) {
+	for _, r := range rules {
+		m := r.re.FindStringSubmatchIndex(name)
+		if m == nil {
+			continue
+		}
+
+		coin = string(r.re.ExpandString(nil, r.coin, name, m))
+		coin = hyperliquid.MainnetToAlias(coin)
+
+		symbol = string(r.re.ExpandString(nil, r.symbol, name, m))
+		symbol = strings.NewReplacer("{coin}", coin, "{quote}", quote).Replace(symbol)
+		return coin, symbol, true
+	}
+	return "", "", false
+}
@@ -0,0 +1,129 @@
+package symbol
+
+import (
+	"testing"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
+)
+
+func TestNormalizer_DefaultRules(t *testing.T) {
+	n := DefaultNormalizer()
+
+	coin, symbol, ok := n.Perp("BTC")
+	require.True(t, ok)
+	assert.Equal(t, "BTC", coin)
+	assert.Equal(t, "BTCUSDC", symbol)
+
+	coin, symbol, ok = n.Perp("xyz:TSLA")
+	require.True(t, ok)
+	assert.Equal(t, "TSLA", coin)
+	assert.Equal(t, "TSLAUSDC", symbol)
+
+	coin, symbol, ok = n.Perp("abc:FOO")
+	require.True(t, ok, "any builder dex is mapped by the built-in rules")
+	assert.Equal(t, "FOO", coin)
+	assert.Equal(t, "FOOUSDC", symbol)
+
+	symbol, ok = n.Spot("HYPE", "USDC")
+	require.True(t, ok)
+	assert.Equal(t, "HYPEUSDC", symbol)
+
+	assert.True(t, n.Accepts("BTC"))
+	assert.True(t, n.Accepts("@107"))
+	assert.True(t, n.Accepts("PURR/USDC"))
+	assert.True(t, n.Accepts("xyz:TSLA"))
+	assert.True(t, n.Accepts("abc:FOO"))
+}
+
+func TestNormalizer_DexRegistry(t *testing.T) {
+	n := DefaultNormalizer()
+	dexes := cache.NewDexRegistry()
+	n.SetDexRegistry(dexes)
+
+	assert.True(t, n.Accepts("abc:FOO"), "empty registry does not filter")
+
+	dexes.Set([]hl.PerpDex{{}, {Name: "xyz", FullName: "XYZ"}})
+	assert.True(t, n.Accepts("BTC"))
+	assert.True(t, n.Accepts("xyz:TSLA"))
+	assert.False(t, n.Accepts("abc:FOO"), "unregistered dex should be ignored")
+
+	_, _, ok := n.Perp("abc:FOO")
+	assert.False(t, ok)
+}
+
+func TestNormalizer_CustomRules(t *testing.T) {
+	n, err := NewNormalizer(config.Symbol{
+		PerpRules: []config.SymbolRule{
+			{Match: `^flx:([A-Z0-9]+)-([A-Z]+)$`, Coin: "$1", Symbol: "{coin}$2"},
+			{Match: `^k([A-Z0-9]+)$`, Symbol: "1000${1}USDC"},
+			{Match: `^([^:]+)$`, Coin: "$1", Symbol: "{coin}USDT"},
+		},
+	})
+	require.NoError(t, err)
+
+	coin, symbol, ok := n.Perp("flx:BTC-USD")
+	require.True(t, ok)
+	assert.Equal(t, "BTC", coin)
+	assert.Equal(t, "BTCUSD", symbol)
+
+	coin, symbol, ok = n.Perp("kPEPE")
+	require.True(t, ok)
+	assert.Equal(t, "kPEPE", coin, "coin defaults to the whole match")
+	assert.Equal(t, "1000PEPEUSDC", symbol)
+
+	_, symbol, ok = n.Perp("ETH")
+	require.True(t, ok)
+	assert.Equal(t, "ETHUSDT", symbol)
+
+	assert.False(t, n.Accepts("xyz:TSLA"), "custom perp rules replace the built-in ones")
+
+	// 未配置现货规则时使用内置规则
+	symbol, ok = n.Spot("PURR", "USDC")
+	require.True(t, ok)
+	assert.Equal(t, "PURRUSDC", symbol)
+}
+
+func TestNormalizer_InvalidRules(t *testing.T) {
+	_, err := NewNormalizer(config.Symbol{
+		PerpRules: []config.SymbolRule{{Match: `^(`, Symbol: "{coin}USDC"}},
+	})
+	assert.Error(t, err)
+
+	_, err = NewNormalizer(config.Symbol{
+		SpotRules: []config.SymbolRule{{Match: `^(.+)$`}},
+	})
+	assert.Error(t, err)
+}
+
+func TestNormalizer_Renames(t *testing.T) {
+	n := DefaultNormalizer()
+	n.SetRenames(map[string]string{"FOOUSDC": "BARUSDC", "BARUSDC": "BAZUSDC", "AUSDC": "BUSDC", "BUSDC": "AUSDC"}, nil)
+
+	coin, symbol, ok := n.Perp("FOO")
+	require.True(t, ok)
+	assert.Equal(t, "FOO", coin, "coin keeps the asset name")
+	assert.Equal(t, "BAZUSDC", symbol, "rename chains are followed")
+
+	_, symbol, _ = n.Perp("ETH")
+	assert.Equal(t, "ETHUSDC", symbol)
+	assert.NotEmpty(t, n.RenamePerp("AUSDC"), "cycles terminate")
+
+	symbol, _ = n.Spot("FOO", "USDC")
+	assert.Equal(t, "FOOUSDC", symbol, "perp renames do not apply to spot")
+
+	symbolCache := cache.NewSymbolCache()
+	symbolCache.SetPerpSymbol("FOO", "FOOUSDC")
+	symbolCache.RenamePerpSymbols(n.RenamePerp)
+	symbol, _ = symbolCache.GetPerpSymbol("FOO")
+	assert.Equal(t, "BAZUSDC", symbol)
+	name, ok := symbolCache.GetPerpName("BAZUSDC")
+	require.True(t, ok)
+	assert.Equal(t, "FOO", name)
+	_, ok = symbolCache.GetPerpName("FOOUSDC")
+	assert.False(t, ok)
+}
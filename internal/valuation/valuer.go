@@ -0,0 +1,106 @@
+package valuation
+
+import (
+	"strings"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
+)
+
+// QuoteUSD 默认计价货币
+const QuoteUSD = "USD"
+
+// DefaultStablecoins 默认按 1 USD 计价的稳定币篮子
+var DefaultStablecoins = []string{"USDC", "USDT", "USDH"}
+
+// Valuer 仓位估值器
+// 所有价值先以 USD 计算（稳定币篮子按 1 USD），再按计价货币的 USD 价格换算
+type Valuer struct {
+	quote       string
+	stablecoins map[string]bool
+	stableOrder []string // 现货报价币种的查找顺序
+	prices      *cache.PriceCache
+	symbols     *cache.SymbolCache
+}
+
+// NewValuer 创建估值器
+func NewValuer(cfg config.Valuation, prices *cache.PriceCache, symbols *cache.SymbolCache) *Valuer {
+	quote := strings.ToUpper(strings.TrimSpace(cfg.Quote))
+	if quote == "" {
+		quote = QuoteUSD
+	}
+
+	stables := cfg.Stablecoins
+	if len(stables) == 0 {
+		stables = DefaultStablecoins
+	}
+
+	v := &Valuer{
+		quote:       quote,
+		stablecoins: make(map[string]bool, len(stables)),
+		prices:      prices,
+		symbols:     symbols,
+	}
+	for _, coin := range stables {
+		coin = strings.ToUpper(coin)
+		if !v.stablecoins[coin] {
+			v.stablecoins[coin] = true
+			v.stableOrder = append(v.stableOrder, coin)
+		}
+	}
+	return v
+}
+
+// Quote 计价货币
+func (v *Valuer) Quote() string {
+	return v.quote
+}
+
+// IsStable 判断币种是否在稳定币篮子中
+func (v *Valuer) IsStable(coin string) bool {
+	return v.stablecoins[coin]
+}
+
+// SpotPriceUSD 获取现货币种的 USD 价格，依次查找 <coin><稳定币> 交易对
+func (v *Valuer) SpotPriceUSD(coin string) (float64, bool) {
+	if v.IsStable(coin) {
+		return 1, true
+	}
+	if v.symbols == nil || v.prices == nil {
+		return 0, false
+	}
+
+	for _, base := range v.stableOrder {
+		assetName, exists := v.symbols.GetSpotName(coin + base)
+		if !exists {
+			continue
+		}
+		return v.prices.GetSpotPrice(assetName)
+	}
+	return 0, false
+}
+
+// PriceUSD 获取币种的 USD 价格，优先现货，其次合约标记价
+func (v *Valuer) PriceUSD(coin string) (float64, bool) {
+	if px, ok := v.SpotPriceUSD(coin); ok && px > 0 {
+		return px, true
+	}
+	if v.prices != nil {
+		if px, ok := v.prices.GetPerpPrice(coin); ok && px > 0 {
+			return px, true
+		}
+	}
+	return 0, false
+}
+
+// ToQuote 将 USD 价值换算为计价货币，计价货币无价格时返回 false
+func (v *Valuer) ToQuote(usd float64) (float64, bool) {
+	if v.quote == QuoteUSD || v.IsStable(v.quote) {
+		return usd, true
+	}
+	px, ok := v.PriceUSD(v.quote)
+	if !ok {
+		return 0, false
+	}
+	return usd / px, true
+}
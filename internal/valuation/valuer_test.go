@@ -0,0 +1,73 @@
+package valuation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/cache"
+)
+
+func newTestCaches() (*cache.PriceCache, *cache.SymbolCache) {
+	symbols := cache.NewSymbolCache()
+	symbols.SetSpotSymbol("@107", "HYPEUSDC")
+	symbols.SetSpotSymbol("@142", "UBTCUSDC")
+	prices := cache.NewPriceCache()
+	prices.SetSpotPrice("@107", 40)
+	prices.SetSpotPrice("@142", 100000)
+	prices.SetPerpPrice("BTC", 100000)
+	return prices, symbols
+}
+
+func TestValuer_StableBasket(t *testing.T) {
+	prices, symbols := newTestCaches()
+
+	// 默认篮子
+	v := NewValuer(config.Valuation{}, prices, symbols)
+	assert.Equal(t, QuoteUSD, v.Quote())
+	assert.True(t, v.IsStable("USDT"))
+
+	px, ok := v.SpotPriceUSD("USDC")
+	assert.True(t, ok)
+	assert.Equal(t, 1.0, px)
+
+	px, ok = v.SpotPriceUSD("HYPE")
+	assert.True(t, ok)
+	assert.Equal(t, 40.0, px)
+
+	// 自定义篮子：USDC 不再视为稳定币，且 HYPEUSDC 不参与查价
+	v = NewValuer(config.Valuation{Stablecoins: []string{"usde"}}, prices, symbols)
+	assert.True(t, v.IsStable("USDE"))
+	assert.False(t, v.IsStable("USDC"))
+	_, ok = v.SpotPriceUSD("HYPE")
+	assert.False(t, ok)
+}
+
+func TestValuer_ToQuote(t *testing.T) {
+	prices, symbols := newTestCaches()
+
+	// USD 计价保持原值
+	v := NewValuer(config.Valuation{}, prices, symbols)
+	value, ok := v.ToQuote(250)
+	assert.True(t, ok)
+	assert.Equal(t, 250.0, value)
+
+	// BTC 计价使用合约标记价
+	v = NewValuer(config.Valuation{Quote: "btc"}, prices, symbols)
+	assert.Equal(t, "BTC", v.Quote())
+	value, ok = v.ToQuote(50000)
+	assert.True(t, ok)
+	assert.Equal(t, 0.5, value)
+
+	// 现货优先
+	v = NewValuer(config.Valuation{Quote: "UBTC"}, prices, symbols)
+	value, ok = v.ToQuote(200000)
+	assert.True(t, ok)
+	assert.Equal(t, 2.0, value)
+
+	// 计价货币无价格
+	v = NewValuer(config.Valuation{Quote: "ETH"}, prices, symbols)
+	_, ok = v.ToQuote(100)
+	assert.False(t, ok)
+}
@@ -0,0 +1,159 @@
+package watchdog
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// Heartbeat 后台循环心跳，循环每次迭代（含空闲时的定时 tick）调用 Beat
+type Heartbeat struct {
+	last atomic.Int64 // UnixNano
+}
+
+// NewHeartbeat 创建心跳，初始为当前时间
+func NewHeartbeat() *Heartbeat {
+	h := &Heartbeat{}
+	h.Beat()
+	return h
+}
+
+// Beat 记录一次心跳
+func (h *Heartbeat) Beat() {
+	h.last.Store(time.Now().UnixNano())
+}
+
+// Last 返回最近一次心跳时间
+func (h *Heartbeat) Last() time.Time {
+	return time.Unix(0, h.last.Load())
+}
+
+// Component 被看门狗监控的组件
+type Component struct {
+	Name      string
+	Heartbeat *Heartbeat        // Probe 非空时可为空，由看门狗创建
+	Probe     func(beat func()) // 可选，每次检查时调用，供没有后台循环的组件（如协程池）提交探测任务，任务执行时调用 beat，需非阻塞
+	Restart   func()            // 可选，心跳停滞时重启组件，为空时只记录日志和指标
+}
+
+// watched 组件及其停滞状态
+type watched struct {
+	Component
+	stalled     bool
+	lastRestart time.Time
+}
+
+// Supervisor 内部组件看门狗
+// 每隔 check_interval 检查各组件心跳，超过 stall_timeout 未更新时记录日志和指标，
+// 开启 restart 时重启组件；同一组件两次重启至少间隔 stall_timeout，给重启后的组件留出恢复时间
+type Supervisor struct {
+	cfg config.Watchdog
+	now func() time.Time
+
+	mu         sync.Mutex
+	components []*watched
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewSupervisor 创建看门狗
+func NewSupervisor(cfg config.Watchdog) *Supervisor {
+	return &Supervisor{
+		cfg:  cfg,
+		now:  time.Now,
+		done: make(chan struct{}),
+	}
+}
+
+// Watch 注册被监控的组件，需在 Start 前调用
+func (s *Supervisor) Watch(c Component) {
+	if c.Heartbeat == nil {
+		c.Heartbeat = NewHeartbeat()
+	}
+	s.mu.Lock()
+	s.components = append(s.components, &watched{Component: c})
+	s.mu.Unlock()
+}
+
+// Start 启动定期检查
+func (s *Supervisor) Start() {
+	s.wg.Add(1)
+	goplus.Go(func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.cfg.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.Check()
+			case <-s.done:
+				return
+			}
+		}
+	})
+
+	logger.Info().
+		Dur("check_interval", s.cfg.CheckInterval).
+		Dur("stall_timeout", s.cfg.StallTimeout).
+		Bool("restart", s.cfg.Restart).
+		Int("components", len(s.components)).
+		Msg("watchdog started")
+}
+
+// Stop 停止检查
+func (s *Supervisor) Stop() {
+	close(s.done)
+	s.wg.Wait()
+}
+
+// Check 检查一次全部组件
+func (s *Supervisor) Check() {
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, c := range s.components {
+		if c.Probe != nil {
+			c.Probe(c.Heartbeat.Beat)
+		}
+
+		age := now.Sub(c.Heartbeat.Last())
+		monitor.SetComponentHeartbeatAge(c.Name, age)
+
+		if age <= s.cfg.StallTimeout {
+			if c.stalled {
+				c.stalled = false
+				logger.Info().Str("component", c.Name).Msg("component heartbeat recovered")
+			}
+			continue
+		}
+
+		if !c.stalled {
+			c.stalled = true
+			monitor.IncWatchdogStall(c.Name)
+			logger.Error().
+				Str("component", c.Name).
+				Dur("since_heartbeat", age).
+				Msg("component heartbeat stalled")
+		}
+
+		if s.cfg.Restart && c.Restart != nil && now.Sub(c.lastRestart) >= s.cfg.StallTimeout {
+			c.lastRestart = now
+			c.Restart()
+			monitor.IncWatchdogRestart(c.Name)
+			logger.Warn().
+				Str("component", c.Name).
+				Dur("since_heartbeat", age).
+				Msg("component restarted by watchdog")
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package watchdog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+)
+
+func TestSupervisor_Check(t *testing.T) {
+	s := NewSupervisor(config.Watchdog{CheckInterval: time.Second, StallTimeout: time.Minute, Restart: true})
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	loop := NewHeartbeat()
+	restarts := 0
+	s.Watch(Component{Name: "loop", Heartbeat: loop, Restart: func() { restarts++ }})
+
+	probes := 0
+	probeHealthy := true
+	s.Watch(Component{
+		Name: "pool",
+		Probe: func(beat func()) {
+			probes++
+			if probeHealthy {
+				beat()
+			}
+		},
+	})
+
+	s.Check()
+	assert.Equal(t, 0, restarts)
+	assert.False(t, s.components[0].stalled)
+	assert.Equal(t, 1, probes)
+
+	// 心跳停滞：重启一次，stall_timeout 内不再重启
+	now = now.Add(2 * time.Minute)
+	probeHealthy = false
+	s.Check()
+	assert.True(t, s.components[0].stalled)
+	assert.Equal(t, 1, restarts)
+	assert.True(t, s.components[1].stalled, "probe task did not run")
+
+	now = now.Add(30 * time.Second)
+	s.Check()
+	assert.Equal(t, 1, restarts)
+
+	now = now.Add(30 * time.Second)
+	s.Check()
+	assert.Equal(t, 2, restarts)
+
+	// 恢复
+	loop.Beat()
+	now = time.Now()
+	probeHealthy = true
+	s.Check()
+	assert.False(t, s.components[0].stalled)
+	assert.False(t, s.components[1].stalled)
+	assert.Equal(t, 2, restarts)
+}
+
+func TestSupervisor_NoRestart(t *testing.T) {
+	s := NewSupervisor(config.Watchdog{CheckInterval: time.Second, StallTimeout: time.Minute})
+	s.now = func() time.Time { return time.Now().Add(time.Hour) }
+
+	restarts := 0
+	s.Watch(Component{Name: "loop", Heartbeat: NewHeartbeat(), Restart: func() { restarts++ }})
+
+	s.Check()
+	assert.True(t, s.components[0].stalled)
+	assert.Equal(t, 0, restarts, "restart disabled, only log and metrics")
+}
@@ -0,0 +1,232 @@
+package watchlist
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+// 导入导出格式
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+// csvHeader CSV 列，导入时按列名匹配，顺序无关，address 以外的列可省略
+var csvHeader = []string{"player_id", "address", "nickname", "is_system", "tags", "channels", "comment", "aggregation", "signal_filter"}
+
+var addressPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+// Entry 导出的监控地址，不含自增 ID 和时间戳，可在不同环境间迁移
+type Entry struct {
+	PlayerID uint     `json:"player_id"`
+	Address  string   `json:"address"`
+	Nickname string   `json:"nickname,omitempty"`
+	IsSystem bool     `json:"is_system,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	Channels []string `json:"channels,omitempty"`
+	Comment  string   `json:"comment,omitempty"`
+
+	Aggregation  string   `json:"aggregation,omitempty"`
+	SignalFilter []string `json:"signal_filter,omitempty"`
+}
+
+// ValidFormat 是否为支持的格式
+func ValidFormat(format string) bool {
+	return format == FormatCSV || format == FormatJSON
+}
+
+// Export 按格式写出监控地址，JSON 为数组，CSV 首行为列名
+func Export(w io.Writer, format string, rows []*models.HlWatchAddress) error {
+	entries := make([]Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, Entry{
+			PlayerID: row.PlayerID,
+			Address:  row.Address,
+			Nickname: row.Nickname,
+			IsSystem: row.IsSystem,
+			Tags:     splitList(row.Tags),
+			Channels: splitList(row.Channels),
+			Comment:  row.Comment,
+
+			Aggregation:  row.Aggregation,
+			SignalFilter: splitList(row.SignalFilter),
+		})
+	}
+
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(csvHeader); err != nil {
+			return err
+		}
+		for _, e := range entries {
+			record := []string{
+				strconv.FormatUint(uint64(e.PlayerID), 10),
+				e.Address,
+				e.Nickname,
+				strconv.FormatBool(e.IsSystem),
+				strings.Join(e.Tags, ","),
+				strings.Join(e.Channels, ","),
+				e.Comment,
+				e.Aggregation,
+				strings.Join(e.SignalFilter, ","),
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	default:
+		return fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// Import 按格式读取监控地址并校验：地址格式不合法时报错，同一玩家的重复地址以最后一条为准
+func Import(r io.Reader, format string) ([]*models.HlWatchAddress, error) {
+	var entries []Entry
+	switch format {
+	case FormatJSON:
+		if err := json.NewDecoder(r).Decode(&entries); err != nil {
+			return nil, fmt.Errorf("decode json: %w", err)
+		}
+	case FormatCSV:
+		var err error
+		if entries, err = readCSV(r); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+
+	rows := make([]*models.HlWatchAddress, 0, len(entries))
+	index := make(map[string]int, len(entries))
+	for i, e := range entries {
+		address := strings.TrimSpace(e.Address)
+		if !addressPattern.MatchString(address) {
+			return nil, fmt.Errorf("entry %d: invalid address %q", i+1, e.Address)
+		}
+		row := &models.HlWatchAddress{
+			PlayerID: e.PlayerID,
+			Address:  address,
+			Nickname: strings.TrimSpace(e.Nickname),
+			IsSystem: e.IsSystem,
+			Tags:     joinList(e.Tags),
+			Channels: joinList(e.Channels),
+			Comment:  strings.TrimSpace(e.Comment),
+
+			Aggregation:  strings.ToLower(strings.TrimSpace(e.Aggregation)),
+			SignalFilter: strings.ToLower(joinList(e.SignalFilter)),
+		}
+
+		key := fmt.Sprintf("%d|%s", row.PlayerID, strings.ToLower(address))
+		if j, ok := index[key]; ok {
+			rows[j] = row
+			continue
+		}
+		index[key] = len(rows)
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// readCSV 读取 CSV，首行为列名
+func readCSV(r io.Reader) ([]Entry, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if !slices.Contains(csvHeader, name) {
+			return nil, fmt.Errorf("unknown csv column %q", name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["address"]; !ok {
+		return nil, errors.New("csv header missing address column")
+	}
+
+	var entries []Entry
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv: %w", err)
+		}
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		e := Entry{
+			Address:  field("address"),
+			Nickname: field("nickname"),
+			Tags:     splitList(field("tags")),
+			Channels: splitList(field("channels")),
+			Comment:  field("comment"),
+
+			Aggregation:  field("aggregation"),
+			SignalFilter: splitList(field("signal_filter")),
+		}
+		if v := field("player_id"); v != "" {
+			id, err := strconv.ParseUint(v, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid player_id %q", line, v)
+			}
+			e.PlayerID = uint(id)
+		}
+		if v := field("is_system"); v != "" {
+			if e.IsSystem, err = strconv.ParseBool(v); err != nil {
+				return nil, fmt.Errorf("line %d: invalid is_system %q", line, v)
+			}
+		}
+		entries = append(entries, e)
+	}
+}
+
+// splitList 拆分逗号分隔的列表，去除空白和空项
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// joinList 去除空白、空项和重复项后以逗号连接，保留原有顺序
+func joinList(items []string) string {
+	var kept []string
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" && !slices.Contains(kept, item) {
+			kept = append(kept, item)
+		}
+	}
+	return strings.Join(kept, ",")
+}
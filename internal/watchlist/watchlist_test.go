@@ -0,0 +1,79 @@
+package watchlist
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/internal/models"
+)
+
+const (
+	addrA = "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	addrB = "0x2222222222222222222222222222222222222222"
+)
+
+func TestExportImport_RoundTrip(t *testing.T) {
+	rows := []*models.HlWatchAddress{
+		{ID: 7, PlayerID: 1, Address: addrA, Nickname: "whale, \"A\"", Tags: "whale,smart-money", Channels: "nats,webhook", Comment: "多行\n备注", Aggregation: "scalper", SignalFilter: "open,futures"},
+		{ID: 8, PlayerID: 2, Address: addrB, IsSystem: true},
+	}
+
+	for _, format := range []string{FormatCSV, FormatJSON} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Export(&buf, format, rows))
+
+			imported, err := Import(&buf, format)
+			require.NoError(t, err)
+			require.Len(t, imported, 2)
+			// 自增 ID 不导出
+			assert.Equal(t, &models.HlWatchAddress{
+				PlayerID: 1, Address: addrA, Nickname: "whale, \"A\"", Tags: "whale,smart-money", Channels: "nats,webhook", Comment: "多行\n备注",
+				Aggregation: "scalper", SignalFilter: "open,futures",
+			}, imported[0])
+			assert.Equal(t, &models.HlWatchAddress{PlayerID: 2, Address: addrB, IsSystem: true}, imported[1])
+		})
+	}
+}
+
+func TestImport_CSV(t *testing.T) {
+	// 列顺序无关，可省略列，列表去重去空白，同一玩家的重复地址以最后一条为准
+	input := "\ufeffaddress,tags,player_id\n" +
+		addrA + ",\" whale , ,whale,fund\",1\n" +
+		addrB + ",,1\n" +
+		"0x" + strings.ToUpper(addrA[2:]) + ",late,1\n"
+	rows, err := Import(strings.NewReader(input), FormatCSV)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "late", rows[0].Tags)
+	assert.Equal(t, addrB, rows[1].Address)
+
+	_, err = Import(strings.NewReader("nickname\nfoo\n"), FormatCSV)
+	assert.ErrorContains(t, err, "missing address column")
+
+	_, err = Import(strings.NewReader("address,label\n"+addrA+",x\n"), FormatCSV)
+	assert.ErrorContains(t, err, `unknown csv column "label"`)
+
+	_, err = Import(strings.NewReader("address,player_id\n"+addrA+",abc\n"), FormatCSV)
+	assert.ErrorContains(t, err, "line 2: invalid player_id")
+
+	_, err = Import(strings.NewReader("address\n0x123\n"), FormatCSV)
+	assert.ErrorContains(t, err, `entry 1: invalid address "0x123"`)
+
+	rows, err = Import(strings.NewReader(""), FormatCSV)
+	require.NoError(t, err)
+	assert.Empty(t, rows)
+}
+
+func TestFormat(t *testing.T) {
+	assert.True(t, ValidFormat(FormatCSV))
+	assert.False(t, ValidFormat("xml"))
+
+	assert.ErrorContains(t, Export(&bytes.Buffer{}, "xml", nil), "unsupported format")
+	_, err := Import(strings.NewReader("[]"), "xml")
+	assert.ErrorContains(t, err, "unsupported format")
+}
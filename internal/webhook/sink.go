@@ -0,0 +1,252 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/monitor"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+	"github.com/utrading/utrading-hl-monitor/pkg/goplus"
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// 请求头
+const (
+	HeaderSignature      = "X-Signature"       // sha256=<hex(hmac(secret, timestamp + "." + body))>
+	HeaderTimestamp      = "X-Timestamp"       // 签名时间（Unix 秒），消费方可据此拒绝重放
+	HeaderIdempotencyKey = "X-Idempotency-Key" // 信号幂等键
+)
+
+// 投递结果（指标标签）
+const (
+	resultSuccess     = "success"
+	resultFailed      = "failed"
+	resultQueueFull   = "queue_full"
+	resultBreakerOpen = "breaker_open"
+)
+
+// SignalPublisher 信号发布接口
+type SignalPublisher interface {
+	PublishAddressSignal(signal *nats.HlAddressSignal) error
+}
+
+// Sink webhook 信号投递器，每个端点独立队列、重试和熔断，投递异步进行不阻塞信号发布
+type Sink struct {
+	cfg       config.Webhook
+	client    *http.Client
+	endpoints []*endpoint
+	done      chan struct{}
+	wg        sync.WaitGroup
+}
+
+// endpoint 单个 webhook 端点
+type endpoint struct {
+	name    string
+	url     string
+	secret  string
+	minRank int // 只投递不低于该级别的信号
+	queue   chan delivery
+	breaker *breaker
+}
+
+// delivery 待投递的信号
+type delivery struct {
+	body           []byte
+	idempotencyKey string
+}
+
+// NewSink 创建 webhook 投递器
+func NewSink(cfg config.Webhook) *Sink {
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 1000
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 5 * time.Second
+	}
+
+	s := &Sink{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+		done:   make(chan struct{}),
+	}
+	for _, ep := range cfg.Endpoints {
+		name := ep.Name
+		if name == "" {
+			name = endpointName(ep.URL)
+		}
+		s.endpoints = append(s.endpoints, &endpoint{
+			name:    name,
+			url:     ep.URL,
+			secret:  ep.Secret,
+			minRank: nats.SeverityRank(ep.MinSeverity),
+			queue:   make(chan delivery, cfg.QueueSize),
+			breaker: newBreaker(cfg.BreakerThreshold, cfg.BreakerCooldown),
+		})
+	}
+	return s
+}
+
+// Start 启动各端点的投递协程
+func (s *Sink) Start() {
+	for _, ep := range s.endpoints {
+		ep := ep
+		s.wg.Add(1)
+		goplus.Go(func() {
+			defer s.wg.Done()
+			s.run(ep)
+		})
+	}
+	logger.Info().Int("endpoints", len(s.endpoints)).Msg("webhook sink started")
+}
+
+// Stop 停止投递，队列中未投递的信号将被丢弃
+func (s *Sink) Stop() {
+	close(s.done)
+	s.wg.Wait()
+	logger.Info().Msg("webhook sink stopped")
+}
+
+// Wrap 包装信号发布器，主发布器发布成功后异步投递到 webhook
+func (s *Sink) Wrap(next SignalPublisher) SignalPublisher {
+	return &teePublisher{next: next, sink: s}
+}
+
+// Deliver 将信号放入各端点队列
+func (s *Sink) Deliver(signal *nats.HlAddressSignal) {
+	body, err := signal.Marshal()
+	if err != nil {
+		logger.Error().Err(err).Msg("marshal webhook signal failed")
+		return
+	}
+
+	d := delivery{body: body, idempotencyKey: signal.IdempotencyKey}
+	rank := nats.SeverityRank(signal.Severity)
+	for _, ep := range s.endpoints {
+		if rank < ep.minRank {
+			continue
+		}
+		select {
+		case ep.queue <- d:
+		default:
+			monitor.IncWebhookDelivery(ep.name, resultQueueFull)
+			logger.Warn().Str("endpoint", ep.name).Str("trace_id", signal.TraceID).
+				Msg("webhook queue full, signal dropped")
+		}
+	}
+}
+
+// run 端点投递循环
+func (s *Sink) run(ep *endpoint) {
+	for {
+		select {
+		case <-s.done:
+			return
+		case d := <-ep.queue:
+			s.deliver(ep, d)
+		}
+	}
+}
+
+// deliver 投递单个信号，失败按指数退避重试
+func (s *Sink) deliver(ep *endpoint, d delivery) {
+	backoff := s.cfg.RetryBackoff
+	for attempt := 0; attempt <= s.cfg.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-s.done:
+				return
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		if !ep.breaker.Allow(time.Now()) {
+			monitor.IncWebhookDelivery(ep.name, resultBreakerOpen)
+			return
+		}
+
+		err := s.post(ep, d)
+		if err == nil {
+			ep.breaker.Success()
+			monitor.SetWebhookBreakerOpen(ep.name, false)
+			monitor.IncWebhookDelivery(ep.name, resultSuccess)
+			return
+		}
+
+		open := ep.breaker.Failure(time.Now())
+		monitor.SetWebhookBreakerOpen(ep.name, open)
+		logger.Warn().Err(err).Str("endpoint", ep.name).Int("attempt", attempt+1).
+			Bool("breaker_open", open).Msg("webhook delivery failed")
+	}
+	monitor.IncWebhookDelivery(ep.name, resultFailed)
+}
+
+// post 发送签名请求
+func (s *Sink) post(ep *endpoint, d delivery) error {
+	req, err := http.NewRequest(http.MethodPost, ep.url, bytes.NewReader(d.body))
+	if err != nil {
+		return err
+	}
+
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderTimestamp, ts)
+	if ep.secret != "" {
+		req.Header.Set(HeaderSignature, "sha256="+Sign(ep.secret, ts, d.body))
+	}
+	if d.idempotencyKey != "" {
+		req.Header.Set(HeaderIdempotencyKey, d.idempotencyKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign 计算请求签名：hex(hmac_sha256(secret, timestamp + "." + body))
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// endpointName 从 URL 中提取 host 作为端点名称，避免在日志和指标中暴露路径参数
+func endpointName(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return "unknown"
+	}
+	return u.Host
+}
+
+// teePublisher 主发布器成功后同时投递 webhook
+type teePublisher struct {
+	next SignalPublisher
+	sink *Sink
+}
+
+func (p *teePublisher) PublishAddressSignal(signal *nats.HlAddressSignal) error {
+	if err := p.next.PublishAddressSignal(signal); err != nil {
+		return err
+	}
+	p.sink.Deliver(signal)
+	return nil
+}
@@ -0,0 +1,152 @@
+package webhook
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/utrading/utrading-hl-monitor/config"
+	"github.com/utrading/utrading-hl-monitor/internal/nats"
+)
+
+type mockPublisher struct {
+	err error
+}
+
+func (p *mockPublisher) PublishAddressSignal(*nats.HlAddressSignal) error { return p.err }
+
+func newTestSink(url string) *Sink {
+	return NewSink(config.Webhook{
+		Endpoints:        []config.WebhookEndpoint{{Name: "test", URL: url, Secret: "secret"}},
+		Timeout:          time.Second,
+		MaxRetries:       2,
+		RetryBackoff:     10 * time.Millisecond,
+		QueueSize:        10,
+		BreakerThreshold: 3,
+		BreakerCooldown:  time.Hour,
+	})
+}
+
+func TestSink_SignedDelivery(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received []*http.Request
+		bodies   [][]byte
+		attempts atomic.Int32
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 第一次请求失败，验证重试
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		received = append(received, r)
+		bodies = append(bodies, body)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	sink := newTestSink(server.URL)
+	sink.Start()
+	defer sink.Stop()
+
+	pub := sink.Wrap(&mockPublisher{})
+	signal := &nats.HlAddressSignal{Address: "0xabc", Symbol: "BTCUSDT", IdempotencyKey: "key-1"}
+	require.NoError(t, pub.PublishAddressSignal(signal))
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	r, body := received[0], bodies[0]
+	assert.Equal(t, int32(2), attempts.Load())
+	assert.Equal(t, "key-1", r.Header.Get(HeaderIdempotencyKey))
+	assert.Equal(t, "sha256="+Sign("secret", r.Header.Get(HeaderTimestamp), body), r.Header.Get(HeaderSignature))
+}
+
+func TestSink_MinSeverity(t *testing.T) {
+	sink := NewSink(config.Webhook{
+		Endpoints: []config.WebhookEndpoint{
+			{Name: "all", URL: "http://localhost/all"},
+			{Name: "oncall", URL: "http://localhost/oncall", MinSeverity: nats.SeverityCritical},
+		},
+		QueueSize: 10,
+	})
+
+	sink.Deliver(&nats.HlAddressSignal{Address: "0xabc"})
+	sink.Deliver(&nats.HlAddressSignal{Address: "0xabc", Severity: nats.SeverityNotable})
+	sink.Deliver(&nats.HlAddressSignal{Address: "0xabc", Severity: nats.SeverityCritical})
+
+	assert.Len(t, sink.endpoints[0].queue, 3)
+	assert.Len(t, sink.endpoints[1].queue, 1)
+}
+
+func TestSink_PrimaryFailureSkipsWebhook(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+	}))
+	defer server.Close()
+
+	sink := newTestSink(server.URL)
+	sink.Start()
+	defer sink.Stop()
+
+	pub := sink.Wrap(&mockPublisher{err: errors.New("nats down")})
+	assert.Error(t, pub.PublishAddressSignal(&nats.HlAddressSignal{Address: "0xabc"}))
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(0), hits.Load())
+}
+
+func TestSink_BreakerOpens(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	sink := newTestSink(server.URL)
+	ep := sink.endpoints[0]
+
+	// 首个信号用尽重试（3 次请求）后熔断，后续信号不再请求
+	sink.deliver(ep, delivery{body: []byte(`{}`)})
+	assert.Equal(t, int32(3), hits.Load())
+	assert.True(t, ep.breaker.Open())
+
+	sink.deliver(ep, delivery{body: []byte(`{}`)})
+	assert.Equal(t, int32(3), hits.Load())
+}
+
+func TestBreaker_HalfOpen(t *testing.T) {
+	b := newBreaker(2, time.Minute)
+	now := time.Now()
+
+	assert.False(t, b.Failure(now))
+	assert.True(t, b.Failure(now))
+	assert.False(t, b.Allow(now.Add(30*time.Second)), "冷却期内拒绝")
+
+	// 冷却期结束只放行一个试探请求
+	later := now.Add(2 * time.Minute)
+	assert.True(t, b.Allow(later))
+	assert.False(t, b.Allow(later))
+
+	b.Success()
+	assert.False(t, b.Open())
+	assert.True(t, b.Allow(later))
+}
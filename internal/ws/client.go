@@ -2,8 +2,11 @@ package ws
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"net/url"
 	"sync"
 	"time"
 
@@ -12,10 +15,10 @@ import (
 )
 
 const (
-	writeWait      = 10 * time.Second // 写入超时
-	pongWait       = 60 * time.Second // 读取超时（应大于心跳间隔）
-	pingPeriod     = 50 * time.Second // 心跳间隔
-	maxMessageSize = 1024 * 1024 * 2  // 最大消息限制 2MB
+	writeWait           = 10 * time.Second // 写入超时
+	defaultReadTimeout  = 60 * time.Second // 默认读取超时（应大于心跳间隔）
+	defaultPingInterval = 50 * time.Second // 默认心跳间隔
+	maxMessageSize      = 1024 * 1024 * 2  // 最大消息限制 2MB
 )
 
 type Client struct {
@@ -31,6 +34,23 @@ type Client struct {
 	// 回调
 	onMessage    func(wsMessage) error
 	onDisconnect func()
+
+	// 心跳：每隔 pingInterval 发送 Ping，readTimeout 内未收到任何消息（含 pong）视为连接失效
+	pingInterval time.Duration
+	readTimeout  time.Duration
+
+	// 压缩与流量统计
+	compression bool          // 协商 permessage-deflate
+	stats       *TrafficStats // 流量统计（可选）
+
+	// 握手附加请求头（如鉴权令牌）与代理，供经鉴权代理接入的部署使用
+	headers http.Header // 握手请求附加头（可选）
+	proxy   *url.URL    // http（CONNECT）或 socks5 代理（可选）
+
+	// 连接状态（受 mu 保护），供 /status 按连接展示
+	connectedAt time.Time // 最近一次建连成功的时间
+	lastErr     string    // 最近一次连接错误
+	lastErrAt   time.Time // 最近一次连接错误的时间
 }
 
 func NewClient(url string) *Client {
@@ -38,8 +58,10 @@ func NewClient(url string) *Client {
 		panic("ws: URL cannot be empty")
 	}
 	return &Client{
-		url:  url,
-		done: make(chan struct{}),
+		url:          url,
+		done:         make(chan struct{}),
+		pingInterval: defaultPingInterval,
+		readTimeout:  defaultReadTimeout,
 	}
 }
 
@@ -52,26 +74,41 @@ func (c *Client) Connect(ctx context.Context) error {
 	c.mu.Unlock()
 
 	dialer := websocket.Dialer{
-		HandshakeTimeout: 10 * time.Second,
+		HandshakeTimeout:  10 * time.Second,
+		EnableCompression: c.compression,
+	}
+	if c.stats != nil {
+		dialer.NetDialContext = countingDialer(c.stats)
+	}
+	if c.proxy != nil {
+		dialer.Proxy = http.ProxyURL(c.proxy)
 	}
 
-	conn, _, err := dialer.DialContext(ctx, c.url, nil)
+	conn, resp, err := dialer.DialContext(ctx, c.url, c.headers)
 	if err != nil {
-		return fmt.Errorf("dial error: %w", err)
+		err = fmt.Errorf("dial error: %w", err)
+		c.recordError(err)
+		return err
+	}
+	if c.compression && resp != nil {
+		logger.Debug().
+			Str("extensions", resp.Header.Get("Sec-Websocket-Extensions")).
+			Msg("ws compression negotiated")
 	}
 
 	// 配置连接参数
 	conn.SetReadLimit(maxMessageSize)
-	conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetReadDeadline(time.Now().Add(c.readTimeout))
 
 	// 处理标准 Pong 帧（如果服务器发送标准控制帧）
 	conn.SetPongHandler(func(string) error {
-		conn.SetReadDeadline(time.Now().Add(pongWait))
+		conn.SetReadDeadline(time.Now().Add(c.readTimeout))
 		return nil
 	})
 
 	c.mu.Lock()
 	c.conn = conn
+	c.connectedAt = time.Now()
 	c.mu.Unlock()
 
 	// 核心优化：监控 Context 和 done 信号，主动关闭连接
@@ -135,8 +172,25 @@ func (c *Client) readPump() {
 			return
 		}
 
-		_, msg, err := conn.ReadMessage()
+		buf := getFrameBuffer()
+		_, r, err := conn.NextReader()
+		if err == nil {
+			_, err = buf.ReadFrom(r)
+		}
 		if err != nil {
+			var netErr net.Error
+			timeout := errors.As(err, &netErr) && netErr.Timeout()
+			select {
+			case <-c.done:
+			default:
+				if timeout {
+					// 半开连接：写入仍可能成功，但读取超时内收不到任何消息（含 pong）
+					err = fmt.Errorf("heartbeat timeout: no message within %s", c.readTimeout)
+					logger.Warn().Str("url", c.url).Dur("read_timeout", c.readTimeout).Msg("ws heartbeat timeout, reconnecting")
+				}
+				c.recordError(fmt.Errorf("read error: %w", err))
+			}
+			putFrameBuffer(buf)
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				logger.Error().Err(err).Msg("ws read error")
 			}
@@ -144,37 +198,32 @@ func (c *Client) readPump() {
 		}
 
 		// 每次读取成功，刷新 ReadDeadline
-		conn.SetReadDeadline(time.Now().Add(pongWait))
+		receivedAt := time.Now()
+		conn.SetReadDeadline(receivedAt.Add(c.readTimeout))
 
-		// 从对象池获取 wsMessage
-		wsMsg := msgPool.Get().(*WsMessage)
+		if c.stats != nil {
+			c.stats.addPayload(buf.Len())
+		}
 
-		if err = json.Unmarshal(msg, wsMsg); err != nil {
+		// 只解析外层并拷贝 data，读取缓冲区随即放回对象池
+		wsMsg, err := parseMessage(buf.Bytes())
+		putFrameBuffer(buf)
+		if err != nil {
 			logger.Warn().Err(err).Msg("unmarshal ws message error")
-			// 放回池中（重置）
-			wsMsg.Data = nil
-			wsMsg.Channel = ""
-			msgPool.Put(wsMsg)
 			continue
 		}
+		wsMsg.ReceivedAt = receivedAt
 
 		if c.onMessage != nil {
-			if err = c.onMessage(*wsMsg); err != nil {
+			if err = c.onMessage(wsMsg); err != nil {
 				logger.Error().Err(err).Msg("onMessage callback error")
 			}
 		}
-
-		// 放回池中（重置字段避免内存泄漏）
-		// 注意：Data 字段引用的是 msg 的字节数组，会被下次读取覆盖
-		// 所以这里只需清空指针即可
-		wsMsg.Data = nil
-		wsMsg.Channel = ""
-		msgPool.Put(wsMsg)
 	}
 }
 
 func (c *Client) pingPump() {
-	ticker := time.NewTicker(pingPeriod)
+	ticker := time.NewTicker(c.pingInterval)
 	defer ticker.Stop()
 
 	for {
@@ -182,7 +231,13 @@ func (c *Client) pingPump() {
 		case <-c.done:
 			return
 		case <-ticker.C:
+			if !c.IsConnected() {
+				return
+			}
 			if err := c.Ping(); err != nil {
+				// 心跳写入失败，立即关闭连接触发重连，不等待读取超时
+				c.recordError(fmt.Errorf("ping error: %w", err))
+				c.internalClose()
 				return
 			}
 		}
@@ -242,7 +297,48 @@ func (c *Client) writeJSONWithDeadline(v any) error {
 	}
 
 	conn.SetWriteDeadline(time.Now().Add(writeWait))
-	return conn.WriteJSON(v)
+	if err := conn.WriteJSON(v); err != nil {
+		err = fmt.Errorf("write error: %w", err)
+		c.recordError(err)
+		return err
+	}
+	return nil
+}
+
+// recordError 记录最近一次连接错误
+func (c *Client) recordError(err error) {
+	c.mu.Lock()
+	c.lastErr = err.Error()
+	c.lastErrAt = time.Now()
+	c.mu.Unlock()
+}
+
+// inheritError 继承旧连接的最近错误，重连后仍能看到断开原因
+func (c *Client) inheritError(old *Client) {
+	msg, at := old.LastError()
+	if msg == "" {
+		return
+	}
+	c.mu.Lock()
+	c.lastErr, c.lastErrAt = msg, at
+	c.mu.Unlock()
+}
+
+// LastError 获取最近一次连接错误及其时间，没有错误时返回空字符串
+func (c *Client) LastError() (string, time.Time) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lastErr, c.lastErrAt
+}
+
+// ConnectedAt 获取最近一次建连成功的时间，当前未连接时返回零值
+func (c *Client) ConnectedAt() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.conn == nil {
+		return time.Time{}
+	}
+	return c.connectedAt
 }
 
 func (c *Client) notifyDisconnect() {
@@ -255,6 +351,36 @@ func (c *Client) notifyDisconnect() {
 	}
 }
 
+// EnableCompression 启用 permessage-deflate 协商，需在 Connect 前调用
+func (c *Client) EnableCompression(enabled bool) {
+	c.compression = enabled
+}
+
+// SetHeartbeat 设置心跳间隔和读取超时，需在 Connect 前调用；非正值保持默认
+func (c *Client) SetHeartbeat(pingInterval, readTimeout time.Duration) {
+	if pingInterval > 0 {
+		c.pingInterval = pingInterval
+	}
+	if readTimeout > 0 {
+		c.readTimeout = readTimeout
+	}
+}
+
+// SetTrafficStats 设置流量统计，需在 Connect 前调用
+func (c *Client) SetTrafficStats(stats *TrafficStats) {
+	c.stats = stats
+}
+
+// SetHeaders 设置握手请求附加头，需在 Connect 前调用
+func (c *Client) SetHeaders(headers http.Header) {
+	c.headers = headers
+}
+
+// SetProxy 设置代理，http 代理通过 CONNECT 建立隧道，代理 URL 中的 user:pass 作为 Basic 鉴权；需在 Connect 前调用
+func (c *Client) SetProxy(proxy *url.URL) {
+	c.proxy = proxy
+}
+
 func (c *Client) SetMessageHandler(handler func(wsMessage) error) {
 	c.onMessage = handler
 }
@@ -3,8 +3,13 @@ package ws
 import (
 	"context"
 	"encoding/json"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -225,3 +230,217 @@ func TestClientMessageDispatch(t *testing.T) {
 		t.Error("message handler was not called")
 	}
 }
+
+func TestClientCompression(t *testing.T) {
+	upgrader := websocket.Upgrader{EnableCompression: true}
+	negotiated := make(chan bool, 1)
+
+	// 高度重复的大消息，压缩后应明显小于原始大小
+	users := make([]string, 2000)
+	for i := range users {
+		users[i] = "0x0000000000000000000000000000000000000000"
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		negotiated <- r.Header.Get("Sec-Websocket-Extensions") != ""
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("server upgrade failed: %v", err)
+			return
+		}
+		defer conn.Close()
+
+		if err := conn.WriteJSON(map[string]any{"channel": "allMids", "data": users}); err != nil {
+			t.Errorf("server write failed: %v", err)
+		}
+		time.Sleep(500 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	stats := &TrafficStats{}
+	var observedPayload atomic.Int64
+	stats.SetObserver(func(wire, payload int) {
+		observedPayload.Add(int64(payload))
+	})
+
+	received := make(chan struct{}, 1)
+	client := NewClient("ws" + server.URL[len("http"):])
+	client.EnableCompression(true)
+	client.SetTrafficStats(stats)
+	client.SetMessageHandler(func(msg wsMessage) error {
+		received <- struct{}{}
+		return nil
+	})
+
+	if err := client.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect() failed: %v", err)
+	}
+	defer client.Close()
+
+	if !<-negotiated {
+		t.Fatal("client did not offer permessage-deflate")
+	}
+
+	select {
+	case <-received:
+	case <-time.After(2 * time.Second):
+		t.Fatal("message handler was not called")
+	}
+
+	if stats.PayloadBytes() < uint64(len(users)*40) {
+		t.Errorf("payload bytes = %d, want >= %d", stats.PayloadBytes(), len(users)*40)
+	}
+	if ratio := stats.CompressionRatio(); ratio <= 0 || ratio >= 0.5 {
+		t.Errorf("compression ratio = %.3f, want (0, 0.5)", ratio)
+	}
+	if uint64(observedPayload.Load()) != stats.PayloadBytes() {
+		t.Errorf("observed payload = %d, want %d", observedPayload.Load(), stats.PayloadBytes())
+	}
+}
+
+func TestClientHeartbeatTimeout(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+
+	// 半开连接：服务端不读取也不回复任何消息
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		time.Sleep(2 * time.Second)
+	}))
+	defer server.Close()
+
+	disconnected := make(chan struct{}, 1)
+	client := NewClient("ws" + server.URL[len("http"):])
+	client.SetHeartbeat(50*time.Millisecond, 200*time.Millisecond)
+	client.SetDisconnectCallback(func() { disconnected <- struct{}{} })
+
+	start := time.Now()
+	if err := client.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect() failed: %v", err)
+	}
+	defer client.Close()
+
+	select {
+	case <-disconnected:
+	case <-time.After(time.Second):
+		t.Fatal("half-open connection was not detected")
+	}
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("disconnected after %s, before read timeout", elapsed)
+	}
+	if msg, _ := client.LastError(); !strings.Contains(msg, "heartbeat timeout") {
+		t.Errorf("LastError() = %q, want heartbeat timeout", msg)
+	}
+}
+
+func TestClientHeartbeatKeepsAlive(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+
+	// 服务端回复应用层 ping，连接应保持
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			var cmd map[string]any
+			if err := conn.ReadJSON(&cmd); err != nil {
+				return
+			}
+			if cmd["method"] == "ping" {
+				if err := conn.WriteJSON(map[string]any{"channel": "pong"}); err != nil {
+					return
+				}
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("ws" + server.URL[len("http"):])
+	client.SetHeartbeat(50*time.Millisecond, 200*time.Millisecond)
+
+	if err := client.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect() failed: %v", err)
+	}
+	defer client.Close()
+
+	time.Sleep(600 * time.Millisecond)
+	if !client.IsConnected() {
+		msg, _ := client.LastError()
+		t.Fatalf("connection dropped despite pongs: %s", msg)
+	}
+}
+
+// newConnectProxy 启动一个 HTTP CONNECT 代理，记录收到的 Proxy-Authorization
+func newConnectProxy(t *testing.T, auth chan<- string) *httptest.Server {
+	t.Helper()
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "CONNECT only", http.StatusMethodNotAllowed)
+			return
+		}
+		auth <- r.Header.Get("Proxy-Authorization")
+
+		upstream, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			upstream.Close()
+			return
+		}
+		_, _ = conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+		go func() {
+			_, _ = io.Copy(upstream, conn)
+			upstream.Close()
+		}()
+		_, _ = io.Copy(conn, upstream)
+		conn.Close()
+	}))
+	t.Cleanup(proxy.Close)
+	return proxy
+}
+
+func TestClientConnectHeadersAndProxy(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	token := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token <- r.Header.Get("Authorization")
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		<-time.After(time.Second)
+	}))
+	defer server.Close()
+
+	proxyAuth := make(chan string, 1)
+	proxy := newConnectProxy(t, proxyAuth)
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxyURL.User = url.UserPassword("user", "pass")
+
+	client := NewClient("ws" + server.URL[len("http"):])
+	client.SetHeaders(http.Header{"Authorization": []string{"Bearer secret"}})
+	client.SetProxy(proxyURL)
+	if err := client.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect() failed: %v", err)
+	}
+	defer client.Close()
+
+	if got := <-proxyAuth; got != "Basic dXNlcjpwYXNz" {
+		t.Errorf("Proxy-Authorization = %q, want Basic dXNlcjpwYXNz", got)
+	}
+	if got := <-token; got != "Bearer secret" {
+		t.Errorf("Authorization = %q, want Bearer secret", got)
+	}
+}
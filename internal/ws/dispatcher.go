@@ -1,6 +1,9 @@
 package ws
 
 import (
+	"sync/atomic"
+	"time"
+
 	"github.com/panjf2000/ants/v2"
 	"github.com/tidwall/gjson"
 	"github.com/utrading/utrading-hl-monitor/pkg/logger"
@@ -8,8 +11,15 @@ import (
 
 // Dispatcher 消息分发器
 type Dispatcher struct {
-	pm   *PoolManager
-	pool *ants.Pool
+	pm       *PoolManager
+	pool     atomic.Pointer[ants.Pool] // 看门狗重启时替换
+	poolSize int
+	sync     bool // 在调用方协程中同步执行回调（离线回放，保持消息顺序）
+
+	probing atomic.Bool // 存活探测任务尚未执行
+
+	schema *SchemaChecker  // 上游消息结构检查（可选）
+	tap    func(WsMessage) // 原始消息旁路（可选，如录制），需非阻塞
 }
 
 // NewDispatcher 创建分发器
@@ -18,22 +28,71 @@ func NewDispatcher(pm *PoolManager, poolSize int) *Dispatcher {
 		poolSize = 1000 // 默认值调大
 	}
 	pool, _ := ants.NewPool(poolSize)
-	return &Dispatcher{
-		pm:   pm,
-		pool: pool,
+	d := &Dispatcher{
+		pm:       pm,
+		poolSize: poolSize,
+	}
+	d.pool.Store(pool)
+	return d
+}
+
+// Probe 向协程池提交存活探测任务，任务执行时调用 beat
+// 协程池满且回调全部阻塞时任务不会执行，上一次探测未完成时不重复提交
+func (d *Dispatcher) Probe(beat func()) {
+	if d.sync {
+		beat()
+		return
+	}
+	if !d.probing.CompareAndSwap(false, true) {
+		return
+	}
+	// 协程池满时 Submit 阻塞，在独立协程中提交
+	go func() {
+		err := d.pool.Load().Submit(func() {
+			d.probing.Store(false)
+			beat()
+		})
+		if err != nil {
+			d.probing.Store(false)
+		}
+	}()
+}
+
+// Restart 替换协程池，旧协程池中阻塞的回调不再占用分发能力
+// 旧协程池关闭后，阻塞在其 Submit 上的分发返回错误并降级为同步执行
+func (d *Dispatcher) Restart() {
+	if d.sync {
+		return
+	}
+	pool, err := ants.NewPool(d.poolSize)
+	if err != nil {
+		logger.Error().Err(err).Msg("create dispatcher pool failed")
+		return
 	}
+	old := d.pool.Swap(pool)
+	old.Release()
+	d.probing.Store(false)
 }
 
 // Dispatch 处理收到的消息
 func (d *Dispatcher) Dispatch(msg wsMessage) error {
 	channel := string(msg.Channel)
 
+	if d.schema != nil {
+		d.schema.Check(msg)
+	}
+	if d.tap != nil {
+		d.tap(msg)
+	}
+
 	// 根据不同频道类型处理
 	switch channel {
 	case string(ChannelWebData2):
 		d.dispatchWebData2(msg)
 	case string(ChannelUserFills):
-		d.dispatchUserFills(msg)
+		d.dispatchByUser(ChannelUserFills, msg)
+	case string(ChannelUserNonFundingLedgerUpdates):
+		d.dispatchByUser(ChannelUserNonFundingLedgerUpdates, msg)
 	case string(ChannelOrderUpdates):
 		d.broadcastToChannel(ChannelOrderUpdates, msg)
 	default:
@@ -45,9 +104,7 @@ func (d *Dispatcher) Dispatch(msg wsMessage) error {
 
 // dispatchWebData2
 func (d *Dispatcher) dispatchWebData2(msg wsMessage) {
-	// 假设 msg.Data 是 []byte
-	jsonStr := string(msg.Data)
-	user := gjson.Get(jsonStr, "user").String()
+	user := gjson.GetBytes(msg.Data, "user").String()
 
 	if user == "" {
 		// 无法解析 User，广播到所有 WebData2 订阅
@@ -59,17 +116,16 @@ func (d *Dispatcher) dispatchWebData2(msg wsMessage) {
 	d.dispatchToKey(key, msg)
 }
 
-// dispatchUserFills
-func (d *Dispatcher) dispatchUserFills(msg wsMessage) {
-	jsonStr := string(msg.Data)
-	user := gjson.Get(jsonStr, "user").String()
+// dispatchByUser 按消息中的 user 字段分发（userFills / userNonFundingLedgerUpdates）
+func (d *Dispatcher) dispatchByUser(channel Channel, msg wsMessage) {
+	user := gjson.GetBytes(msg.Data, "user").String()
 
 	if user == "" {
-		d.broadcastToChannel(ChannelUserFills, msg)
+		d.broadcastToChannel(channel, msg)
 		return
 	}
 
-	key := string(ChannelUserFills) + ":" + user
+	key := string(channel) + ":" + user
 	d.dispatchToKey(key, msg)
 }
 
@@ -123,7 +179,11 @@ func (d *Dispatcher) getCallbacksByKey(key string) []Callback {
 	defer d.pm.subscriptionsMu.RUnlock()
 
 	info, exists := d.pm.subscriptions[key]
-	if !exists || len(info.callbacks) == 0 {
+	if !exists {
+		return nil
+	}
+	info.lastSeen.Store(time.Now().UnixMilli())
+	if len(info.callbacks) == 0 {
 		return nil
 	}
 
@@ -137,11 +197,22 @@ func (d *Dispatcher) getCallbacksByKey(key string) []Callback {
 
 // executeCallbacks 统一执行逻辑
 func (d *Dispatcher) executeCallbacks(cbs []Callback, msg wsMessage, logKey string) {
+	// 多个回调共享同一次解析（Decode 按类型缓存结果）
+	if len(cbs) > 1 && msg.frame == nil {
+		msg.frame = new(decodedFrame)
+	}
 	for _, cb := range cbs {
 		// 显式捕获变量
 		callback := cb
 
-		err := d.pool.Submit(func() {
+		if d.sync {
+			if err := callback(msg); err != nil {
+				logger.Error().Err(err).Str("channel", string(msg.Channel)).Str("key", logKey).Msg("callback error")
+			}
+			continue
+		}
+
+		err := d.pool.Load().Submit(func() {
 			if err := callback(msg); err != nil {
 				logger.Error().Err(err).
 					Str("channel", string(msg.Channel)).
@@ -168,7 +239,7 @@ func (d *Dispatcher) executeCallbacks(cbs []Callback, msg wsMessage, logKey stri
 
 // Close 关闭分发器
 func (d *Dispatcher) Close() {
-	if d.pool != nil {
-		d.pool.Release()
+	if pool := d.pool.Load(); pool != nil {
+		pool.Release()
 	}
 }
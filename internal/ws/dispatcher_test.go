@@ -107,3 +107,34 @@ func TestDispatcherDispatchAllMids(t *testing.T) {
 		t.Error("callback was not called")
 	}
 }
+
+func TestDispatcherProbeAndRestart(t *testing.T) {
+	d := NewDispatcher(nil, 1)
+	defer d.Close()
+
+	// 唯一的 worker 被阻塞，探测任务无法执行
+	block := make(chan struct{})
+	defer close(block)
+	if err := d.pool.Load().Submit(func() { <-block }); err != nil {
+		t.Fatalf("Submit() failed: %v", err)
+	}
+
+	beats := make(chan struct{}, 2)
+	beat := func() { beats <- struct{}{} }
+
+	d.Probe(beat)
+	select {
+	case <-beats:
+		t.Fatal("probe ran while pool was blocked")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	// 重启后探测任务在新协程池中执行
+	d.Restart()
+	d.Probe(beat)
+	select {
+	case <-beats:
+	case <-time.After(time.Second):
+		t.Fatal("probe did not run after restart")
+	}
+}
@@ -0,0 +1,126 @@
+package ws
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// maxPooledFrame 超过该容量的读取缓冲区不放回对象池，避免偶发的大消息长期占用内存
+const maxPooledFrame = 256 * 1024
+
+// framePool 复用读取缓冲区：一帧消息读入缓冲区后只拷贝 data 部分，缓冲区在解析后即可复用
+var framePool = sync.Pool{
+	New: func() any {
+		return bytes.NewBuffer(make([]byte, 0, 16*1024))
+	},
+}
+
+func getFrameBuffer() *bytes.Buffer {
+	buf := framePool.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+func putFrameBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledFrame {
+		return
+	}
+	framePool.Put(buf)
+}
+
+// parseMessage 解析 {"channel":...,"data":...} 消息外层
+// frame 可在返回后复用：Data 为拷贝，Channel 为常量或拷贝
+func parseMessage(frame []byte) (WsMessage, error) {
+	var msg WsMessage
+	in := jlexer.Lexer{Data: frame}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "channel":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				msg.Channel = internChannel(in.UnsafeString())
+			}
+		case "data":
+			msg.Data = append(json.RawMessage(nil), in.Raw()...)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	in.Consumed()
+	return msg, in.Error()
+}
+
+// knownChannels 服务端推送的已知频道
+var knownChannels = []Channel{
+	ChannelWebData2, ChannelUserFills, ChannelOrderUpdates, ChannelAllMids, ChannelL2Book,
+	ChannelTrades, ChannelCandle, ChannelBbo, ChannelSpotAssetCtxs, ChannelUserNonFundingLedgerUpdates,
+	"pong", "subscriptionResponse",
+}
+
+// internChannel 已知频道返回常量，避免每条消息分配频道字符串
+// s 引用读取缓冲区，不能直接返回
+func internChannel(s string) Channel {
+	for _, ch := range knownChannels {
+		if string(ch) == s {
+			return ch
+		}
+	}
+	return Channel(strings.Clone(s))
+}
+
+// decodedFrame 同一条消息分发给多个回调（如 orderUpdates 广播）时共享的解析结果，每种目标类型只解析一次
+// 各回调拿到同一份数据（切片和指针共享底层内存），回调须只读
+type decodedFrame struct {
+	mu      sync.Mutex
+	results map[reflect.Type]any // 目标类型 -> decodeResult[T]
+}
+
+type decodeResult[T any] struct {
+	value T
+	err   error
+}
+
+// decodeMessage 将消息 data 解析为 T，多回调消息按目标类型复用首次解析的结果
+func decodeMessage[T any](msg WsMessage) (T, error) {
+	f := msg.frame
+	if f == nil {
+		return unmarshalData[T](msg.Data)
+	}
+
+	typ := reflect.TypeFor[T]()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if r, ok := f.results[typ].(decodeResult[T]); ok {
+		return r.value, r.err
+	}
+	value, err := unmarshalData[T](msg.Data)
+	if f.results == nil {
+		f.results = make(map[reflect.Type]any, 1)
+	}
+	f.results[typ] = decodeResult[T]{value: value, err: err}
+	return value, err
+}
+
+// unmarshalData 实现 easyjson.Unmarshaler 的类型（hl.WsOrderFills、hl.WebData2 等）直接用 easyjson 解析，
+// 跳过 encoding/json 的预扫描和反射
+func unmarshalData[T any](data []byte) (T, error) {
+	var value T
+	if u, ok := any(&value).(easyjson.Unmarshaler); ok {
+		err := easyjson.Unmarshal(data, u)
+		return value, err
+	}
+	err := json.Unmarshal(data, &value)
+	return value, err
+}
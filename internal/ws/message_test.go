@@ -0,0 +1,206 @@
+package ws
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+func TestParseMessage(t *testing.T) {
+	frame := []byte(`{"channel":"userFills","data":{"user":"0xabc","fills":[]},"extra":[1,{"a":2}]}`)
+	msg, err := parseMessage(frame)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.Channel != ChannelUserFills {
+		t.Errorf("channel = %q", msg.Channel)
+	}
+	if string(msg.Data) != `{"user":"0xabc","fills":[]}` {
+		t.Errorf("data = %s", msg.Data)
+	}
+
+	// 读取缓冲区复用后消息内容不变
+	copy(frame, strings.Repeat("x", len(frame)))
+	if msg.Channel != ChannelUserFills || string(msg.Data) != `{"user":"0xabc","fills":[]}` {
+		t.Errorf("message references frame buffer: %q %s", msg.Channel, msg.Data)
+	}
+
+	frame = []byte(`{"channel":"newChannel","data":null}`)
+	msg, err = parseMessage(frame)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	copy(frame, strings.Repeat("x", len(frame)))
+	if msg.Channel != "newChannel" || string(msg.Data) != "null" {
+		t.Errorf("unexpected message: %q %s", msg.Channel, msg.Data)
+	}
+
+	for _, bad := range []string{`[1,2]`, `{"channel":`, `{"channel":"pong"} trailing`, ``} {
+		if _, err := parseMessage([]byte(bad)); err == nil {
+			t.Errorf("parseMessage(%q) expected error", bad)
+		}
+	}
+}
+
+func TestDispatchSharedDecode(t *testing.T) {
+	pm := NewOfflinePoolManager()
+	defer pm.Close()
+
+	var got [][]hl.WsOrder
+	for i := 0; i < 3; i++ {
+		sub := Subscription{Channel: ChannelOrderUpdates, User: fmt.Sprintf("0x%d", i)}
+		if _, err := Subscribe(pm, sub, func(orders []hl.WsOrder) error {
+			got = append(got, orders)
+			return nil
+		}); err != nil {
+			t.Fatalf("Subscribe() failed: %v", err)
+		}
+	}
+	// 其他类型的回调按各自类型共享一次解析，与回调执行顺序无关
+	var raw [][]map[string]any
+	for i := 0; i < 2; i++ {
+		sub := Subscription{Channel: ChannelOrderUpdates, User: fmt.Sprintf("0xraw%d", i)}
+		if _, err := Subscribe(pm, sub, func(orders []map[string]any) error {
+			raw = append(raw, orders)
+			return nil
+		}); err != nil {
+			t.Fatalf("Subscribe() failed: %v", err)
+		}
+	}
+
+	data := `[{"order":{"coin":"BTC","side":"B","limitPx":"100","sz":"1","oid":7,"timestamp":1,"origSz":"1"},"status":"open","statusTimestamp":1}]`
+	if err := pm.Inject(WsMessage{Channel: ChannelOrderUpdates, Data: []byte(data)}); err != nil {
+		t.Fatalf("Inject() failed: %v", err)
+	}
+
+	if len(got) != 3 {
+		t.Fatalf("callbacks = %d, want 3", len(got))
+	}
+	for _, orders := range got {
+		if len(orders) != 1 || orders[0].Order.Oid != 7 {
+			t.Fatalf("unexpected orders: %+v", orders)
+		}
+		if &orders[0] != &got[0][0] {
+			t.Error("expected callbacks to share one decoded value")
+		}
+	}
+	if len(raw) != 2 {
+		t.Fatalf("raw callbacks = %d, want 2", len(raw))
+	}
+	for _, orders := range raw {
+		if len(orders) != 1 || orders[0]["status"] != "open" {
+			t.Fatalf("unexpected raw orders: %+v", orders)
+		}
+		if &orders[0] != &raw[0][0] {
+			t.Error("expected raw callbacks to share one decoded value")
+		}
+	}
+}
+
+// orderFillsPayload 构造包含 n 条成交的 userFills 数据
+func orderFillsPayload(n int) []byte {
+	var b strings.Builder
+	b.WriteString(`{"user":"0x0000000000000000000000000000000000000001","isSnapshot":false,"fills":[`)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, `{"coin":"BTC","px":"100000.0","sz":"0.01","side":"B","time":1760000000000,"startPosition":"0.0","dir":"Open Long","closedPnl":"0.0","hash":"0xabc","oid":%d,"crossed":true,"fee":"0.1","tid":%d,"feeToken":"USDC"}`, i, i)
+	}
+	b.WriteString(`]}`)
+	return []byte(b.String())
+}
+
+func BenchmarkParseMessage(b *testing.B) {
+	frame := []byte(`{"channel":"userFills","data":` + string(orderFillsPayload(10)) + `}`)
+
+	b.Run("jlexer", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(frame)))
+		for i := 0; i < b.N; i++ {
+			if _, err := parseMessage(frame); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("encoding_json", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(frame)))
+		for i := 0; i < b.N; i++ {
+			var msg WsMessage
+			if err := json.Unmarshal(frame, &msg); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkDecodeOrderFills(b *testing.B) {
+	data := orderFillsPayload(10)
+
+	b.Run("easyjson", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := unmarshalData[hl.WsOrderFills](data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("encoding_json", func(b *testing.B) {
+		type stdOrderFills hl.WsOrderFills
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var v stdOrderFills
+			if err := json.Unmarshal(data, &v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkDispatchOrderUpdates orderUpdates 广播给所有订阅地址的回调
+func BenchmarkDispatchOrderUpdates(b *testing.B) {
+	const subscribers = 100
+	data := []byte(`[{"order":{"coin":"BTC","side":"B","limitPx":"100000.0","sz":"0.01","oid":7,"timestamp":1760000000000,"origSz":"0.01"},"status":"open","statusTimestamp":1760000000000}]`)
+
+	b.Run("shared", func(b *testing.B) {
+		pm := NewOfflinePoolManager()
+		defer pm.Close()
+		for i := 0; i < subscribers; i++ {
+			sub := Subscription{Channel: ChannelOrderUpdates, User: fmt.Sprintf("0x%d", i)}
+			if _, err := Subscribe(pm, sub, func([]hl.WsOrder) error { return nil }); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if err := pm.Inject(WsMessage{Channel: ChannelOrderUpdates, Data: data}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("per_callback", func(b *testing.B) {
+		pm := NewOfflinePoolManager()
+		defer pm.Close()
+		for i := 0; i < subscribers; i++ {
+			sub := Subscription{Channel: ChannelOrderUpdates, User: fmt.Sprintf("0x%d", i)}
+			if _, err := pm.Subscribe(sub, func(msg WsMessage) error {
+				var orders []hl.WsOrder
+				return json.Unmarshal(msg.Data, &orders)
+			}); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if err := pm.Inject(WsMessage{Channel: ChannelOrderUpdates, Data: data}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"net/http"
+	"net/url"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -16,6 +19,29 @@ type subscriptionInfo struct {
 	subscription Subscription
 	callbacks    map[int64]Callback
 	connection   *ConnectionWrapper
+	lastSeen     atomic.Int64 // 最后收到消息的时间（毫秒）
+}
+
+// SubscriptionState 订阅状态快照
+type SubscriptionState struct {
+	Subscription    Subscription
+	LastSeen        time.Time // 最后收到消息的时间，零值表示尚未收到
+	ConnectionIndex int       // 所在连接序号，-1 表示连接已不在池中
+}
+
+// connectionKeysSample 每个连接在统计中展示的订阅 key 数量上限
+const connectionKeysSample = 5
+
+// ConnectionStats 单个连接的状态快照
+type ConnectionStats struct {
+	Index             int        `json:"index"`
+	Connected         bool       `json:"connected"`
+	SubscriptionCount int        `json:"subscription_count"`
+	KeysSample        []string   `json:"keys_sample,omitempty"` // 按字典序取前几个订阅 key
+	LastError         string     `json:"last_error,omitempty"`
+	LastErrorAt       *time.Time `json:"last_error_at,omitempty"`
+	ConnectedSince    *time.Time `json:"connected_since,omitempty"`
+	Uptime            string     `json:"uptime,omitempty"`
 }
 
 // PoolManager 连接池管理器
@@ -34,6 +60,17 @@ type PoolManager struct {
 
 	reconnectMu      sync.Mutex    // 保证同一时间只有一个重连过程在跑
 	reconnectBackoff time.Duration // 当前退避时间
+
+	compression bool          // 新建连接时协商 permessage-deflate
+	traffic     *TrafficStats // 所有连接的流量统计
+
+	pingInterval time.Duration // 新建连接的心跳间隔，0 使用默认
+	readTimeout  time.Duration // 新建连接的读取超时，0 使用默认
+
+	headers http.Header // 新建连接的握手附加头
+	proxy   *url.URL    // 新建连接使用的代理
+
+	offline bool // 离线模式（模拟回放）：不建立连接，消息由 Inject 注入
 }
 
 // SubscriptionHandle 订阅句柄
@@ -50,11 +87,29 @@ func NewPoolManager(url string, maxConns, maxSubs int) *PoolManager {
 		maxConnections:   maxConns,
 		maxSubscriptions: maxSubs,
 		subscriptions:    make(map[string]*subscriptionInfo),
+		traffic:          &TrafficStats{},
 	}
 	pm.dispatcher = NewDispatcher(pm, 100)
 	return pm
 }
 
+// NewOfflinePoolManager 创建离线连接池（模拟回放）
+// 订阅只登记回调不建立连接，消息通过 Inject 注入并在调用方协程中同步分发，保持注入顺序
+func NewOfflinePoolManager() *PoolManager {
+	pm := NewPoolManager("", 0, 0)
+	pm.offline = true
+	pm.dispatcher.sync = true
+	return pm
+}
+
+// Inject 注入一条消息，按订阅分发（仅离线模式）
+func (pm *PoolManager) Inject(msg WsMessage) error {
+	if !pm.offline {
+		return fmt.Errorf("inject is only supported by offline pool manager")
+	}
+	return pm.dispatcher.Dispatch(msg)
+}
+
 func (pm *PoolManager) Start(ctx context.Context) error {
 	pm.mu.Lock()
 	defer pm.mu.Unlock()
@@ -62,6 +117,10 @@ func (pm *PoolManager) Start(ctx context.Context) error {
 	if pm.started.Load() {
 		return nil
 	}
+	if pm.offline {
+		pm.started.Store(true)
+		return nil
+	}
 
 	// 创建初始连接
 	wrapper, err := pm.createConnectionLocked(ctx)
@@ -128,6 +187,60 @@ func (pm *PoolManager) IsReconnecting() bool {
 	return false
 }
 
+// SetCompression 设置是否协商 permessage-deflate，需在 Start 前调用
+func (pm *PoolManager) SetCompression(enabled bool) {
+	pm.compression = enabled
+}
+
+// SetHeartbeat 设置新建连接的心跳间隔和读取超时，需在 Start 前调用
+func (pm *PoolManager) SetHeartbeat(pingInterval, readTimeout time.Duration) {
+	pm.pingInterval = pingInterval
+	pm.readTimeout = readTimeout
+}
+
+// SetDialOptions 设置新建连接的握手附加头和代理（均可为空），需在 Start 前调用
+func (pm *PoolManager) SetDialOptions(headers http.Header, proxy *url.URL) {
+	pm.headers = headers
+	pm.proxy = proxy
+}
+
+// SetSchemaChecker 设置上游消息结构检查（可选），需在 Start 前调用
+func (pm *PoolManager) SetSchemaChecker(checker *SchemaChecker) {
+	pm.dispatcher.schema = checker
+}
+
+// SetMessageTap 设置原始消息旁路（可选），每条消息分发前调用，fn 不可阻塞，需在 Start 前调用
+func (pm *PoolManager) SetMessageTap(fn func(WsMessage)) {
+	pm.dispatcher.tap = fn
+}
+
+// ProbeDispatcher 向分发协程池提交存活探测任务，任务执行时调用 beat（供看门狗使用）
+func (pm *PoolManager) ProbeDispatcher(beat func()) {
+	pm.dispatcher.Probe(beat)
+}
+
+// RestartDispatcher 替换分发协程池（供看门狗使用）
+func (pm *PoolManager) RestartDispatcher() {
+	pm.dispatcher.Restart()
+}
+
+// Traffic 获取流量统计
+func (pm *PoolManager) Traffic() *TrafficStats {
+	return pm.traffic
+}
+
+// newClient 按连接池配置创建客户端
+func (pm *PoolManager) newClient() *Client {
+	client := NewClient(pm.url)
+	client.EnableCompression(pm.compression)
+	client.SetHeartbeat(pm.pingInterval, pm.readTimeout)
+	client.SetTrafficStats(pm.traffic)
+	client.SetHeaders(pm.headers)
+	client.SetProxy(pm.proxy)
+	client.SetMessageHandler(pm.dispatcher.Dispatch)
+	return client
+}
+
 // GetStats 获取连接池统计信息
 func (pm *PoolManager) GetStats() map[string]any {
 	pm.mu.RLock()
@@ -142,7 +255,43 @@ func (pm *PoolManager) GetStats() map[string]any {
 		"connection_count":   len(pm.connections),
 		"subscription_count": subCount,
 		"started":            pm.started.Load(),
+		"compression":        pm.compression,
+		"wire_bytes":         pm.traffic.WireBytes(),
+		"payload_bytes":      pm.traffic.PayloadBytes(),
+		"connections":        connectionStats(pm.connections),
+	}
+}
+
+// ConnectionStats 获取每个连接的状态，便于定位具体哪条连接异常
+func (pm *PoolManager) ConnectionStats() []ConnectionStats {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+	return connectionStats(pm.connections)
+}
+
+func connectionStats(conns []*ConnectionWrapper) []ConnectionStats {
+	now := time.Now()
+	stats := make([]ConnectionStats, 0, len(conns))
+	for i, cw := range conns {
+		keys := cw.GetSubscriptionKeys()
+		sort.Strings(keys)
+		st := ConnectionStats{
+			Index:             i,
+			Connected:         cw.Client().IsConnected(),
+			SubscriptionCount: len(keys),
+			KeysSample:        keys[:min(len(keys), connectionKeysSample)],
+		}
+		if msg, at := cw.Client().LastError(); msg != "" {
+			st.LastError = msg
+			st.LastErrorAt = &at
+		}
+		if since := cw.Client().ConnectedAt(); !since.IsZero() {
+			st.ConnectedSince = &since
+			st.Uptime = now.Sub(since).Truncate(time.Second).String()
+		}
+		stats = append(stats, st)
 	}
+	return stats
 }
 
 // SubscriptionCount 获取订阅总数
@@ -152,6 +301,45 @@ func (pm *PoolManager) SubscriptionCount() int {
 	return len(pm.subscriptions)
 }
 
+// MarkSeen 记录订阅收到消息（用于无法在分发层按地址路由的频道，如 orderUpdates）
+func (pm *PoolManager) MarkSeen(sub Subscription) {
+	pm.subscriptionsMu.RLock()
+	defer pm.subscriptionsMu.RUnlock()
+
+	if info, ok := pm.subscriptions[sub.Key()]; ok {
+		info.lastSeen.Store(time.Now().UnixMilli())
+	}
+}
+
+// SubscriptionStates 获取所有订阅的最后消息时间和所在连接
+func (pm *PoolManager) SubscriptionStates() []SubscriptionState {
+	pm.mu.RLock()
+	connIndex := make(map[*ConnectionWrapper]int, len(pm.connections))
+	for i, cw := range pm.connections {
+		connIndex[cw] = i
+	}
+	pm.mu.RUnlock()
+
+	pm.subscriptionsMu.RLock()
+	defer pm.subscriptionsMu.RUnlock()
+
+	states := make([]SubscriptionState, 0, len(pm.subscriptions))
+	for _, info := range pm.subscriptions {
+		state := SubscriptionState{
+			Subscription:    info.subscription,
+			ConnectionIndex: -1,
+		}
+		if ms := info.lastSeen.Load(); ms > 0 {
+			state.LastSeen = time.UnixMilli(ms)
+		}
+		if idx, ok := connIndex[info.connection]; ok {
+			state.ConnectionIndex = idx
+		}
+		states = append(states, state)
+	}
+	return states
+}
+
 // Subscribe 订阅
 func (pm *PoolManager) Subscribe(sub Subscription, callback Callback) (*SubscriptionHandle, error) {
 	key := sub.Key()
@@ -164,6 +352,14 @@ func (pm *PoolManager) Subscribe(sub Subscription, callback Callback) (*Subscrip
 		pm.subscriptionsMu.Unlock()
 		return &SubscriptionHandle{id: handleID, key: key, pm: pm}, nil
 	}
+	if pm.offline {
+		pm.subscriptions[key] = &subscriptionInfo{
+			subscription: sub,
+			callbacks:    map[int64]Callback{handleID: callback},
+		}
+		pm.subscriptionsMu.Unlock()
+		return &SubscriptionHandle{id: handleID, key: key, pm: pm}, nil
+	}
 	pm.subscriptionsMu.Unlock()
 
 	// 2. 获取连接（锁外）
@@ -263,8 +459,7 @@ func (pm *PoolManager) acquireConnection() (*ConnectionWrapper, error) {
 
 // createConnectionLocked 必须在持有 mu 时调用
 func (pm *PoolManager) createConnectionLocked(ctx context.Context) (*ConnectionWrapper, error) {
-	client := NewClient(pm.url)
-	client.SetMessageHandler(pm.dispatcher.Dispatch)
+	client := pm.newClient()
 
 	// 设置断开回调
 	// 注意：回调在一个单独的 goroutine 中执行
@@ -371,16 +566,17 @@ func (pm *PoolManager) repairConnections() error {
 		// 2. 创建新连接
 		// 注意：这里我们不调用 createConnectionLocked，因为我们是在替换特定位置
 		// 且不希望 append 到切片尾部
-		newClient := NewClient(pm.url)
-		newClient.SetMessageHandler(pm.dispatcher.Dispatch)
+		newClient := pm.newClient()
 		newClient.SetDisconnectCallback(func() {
 			go pm.handleDisconnect()
 		})
 
 		if err := newClient.Connect(context.Background()); err != nil {
 			logger.Error().Err(err).Int("index", i).Msg("Failed to reconnect specific client")
+			cw.Client().recordError(fmt.Errorf("reconnect failed: %w", err))
 			return err
 		}
+		newClient.inheritError(cw.Client())
 
 		newWrapper := NewConnectionWrapper(newClient)
 
@@ -419,3 +419,112 @@ func TestConnectionWrapperGetSubscriptions(t *testing.T) {
 		t.Error("GetSubscriptionKeys() did not return all expected keys")
 	}
 }
+
+func TestPoolManagerSubscriptionStates(t *testing.T) {
+	pool := NewPoolManager("wss://example.com/ws", 2, 10)
+	conn := NewConnectionWrapper(NewClient("wss://example.com/ws"))
+	pool.connections = append(pool.connections, conn)
+
+	sub := Subscription{Channel: ChannelOrderUpdates, User: "0xabc"}
+	pool.subscriptions[sub.Key()] = &subscriptionInfo{
+		subscription: sub,
+		callbacks:    map[int64]Callback{},
+		connection:   conn,
+	}
+
+	states := pool.SubscriptionStates()
+	if len(states) != 1 {
+		t.Fatalf("SubscriptionStates() len = %d, want 1", len(states))
+	}
+	if !states[0].LastSeen.IsZero() {
+		t.Error("LastSeen should be zero before any message")
+	}
+	if states[0].ConnectionIndex != 0 {
+		t.Errorf("ConnectionIndex = %d, want 0", states[0].ConnectionIndex)
+	}
+
+	pool.MarkSeen(sub)
+	states = pool.SubscriptionStates()
+	if time.Since(states[0].LastSeen) > time.Second {
+		t.Errorf("LastSeen = %v, want recent", states[0].LastSeen)
+	}
+}
+
+func TestPoolManagerConnectionStats(t *testing.T) {
+	pool := NewPoolManager("wss://example.com/ws", 2, 10)
+	conn := NewConnectionWrapper(NewClient("wss://example.com/ws"))
+	for i := 0; i < 7; i++ {
+		conn.AddSubscription(fmt.Sprintf("webData2:0x%d", i))
+	}
+	conn.Client().recordError(fmt.Errorf("read error: EOF"))
+	pool.connections = append(pool.connections, conn, NewConnectionWrapper(NewClient("wss://example.com/ws")))
+
+	stats := pool.ConnectionStats()
+	if len(stats) != 2 {
+		t.Fatalf("ConnectionStats() len = %d, want 2", len(stats))
+	}
+	if stats[0].SubscriptionCount != 7 || len(stats[0].KeysSample) != connectionKeysSample {
+		t.Errorf("stats[0] = %+v, want 7 subscriptions and %d sampled keys", stats[0], connectionKeysSample)
+	}
+	if stats[0].KeysSample[0] != "webData2:0x0" {
+		t.Errorf("KeysSample[0] = %q, want sorted keys", stats[0].KeysSample[0])
+	}
+	if stats[0].LastError != "read error: EOF" || stats[0].LastErrorAt == nil {
+		t.Errorf("LastError = %q, want recorded error", stats[0].LastError)
+	}
+	if stats[0].Connected || stats[0].ConnectedSince != nil {
+		t.Error("disconnected client should not report uptime")
+	}
+	if stats[1].Index != 1 || stats[1].LastError != "" || len(stats[1].KeysSample) != 0 {
+		t.Errorf("stats[1] = %+v, want empty connection", stats[1])
+	}
+
+	// 重连后的新连接保留断开原因
+	fresh := NewClient("wss://example.com/ws")
+	fresh.inheritError(conn.Client())
+	if msg, _ := fresh.LastError(); msg != "read error: EOF" {
+		t.Errorf("inherited LastError = %q", msg)
+	}
+}
+
+func TestOfflinePoolManagerInject(t *testing.T) {
+	pm := NewOfflinePoolManager()
+	if err := pm.Start(context.Background()); err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+	defer pm.Close()
+
+	var got []string
+	handle, err := pm.Subscribe(Subscription{Channel: ChannelUserFills, User: "0xabc"}, func(msg WsMessage) error {
+		got = append(got, string(msg.Data))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Subscribe() failed: %v", err)
+	}
+	if pm.ConnectionCount() != 0 {
+		t.Errorf("ConnectionCount() = %d, want 0", pm.ConnectionCount())
+	}
+
+	// 同步分发，保持注入顺序
+	for _, data := range []string{`{"user":"0xabc","n":1}`, `{"user":"0xdef","n":2}`, `{"user":"0xabc","n":3}`} {
+		if err := pm.Inject(WsMessage{Channel: ChannelUserFills, Data: []byte(data)}); err != nil {
+			t.Fatalf("Inject() failed: %v", err)
+		}
+	}
+	want := []string{`{"user":"0xabc","n":1}`, `{"user":"0xabc","n":3}`}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if err := handle.Unsubscribe(); err != nil {
+		t.Fatalf("Unsubscribe() failed: %v", err)
+	}
+	if pm.SubscriptionCount() != 0 {
+		t.Errorf("SubscriptionCount() = %d, want 0", pm.SubscriptionCount())
+	}
+
+	if err := NewPoolManager("ws://localhost", 1, 1).Inject(WsMessage{}); err == nil {
+		t.Error("Inject() on online pool manager should fail")
+	}
+}
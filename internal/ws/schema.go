@@ -0,0 +1,240 @@
+package ws
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// 字段差异类型
+const (
+	DriftUnknown = "unknown" // 出现未登记的字段（上游新增或改名）
+	DriftMissing = "missing" // 缺少依赖的字段（上游删除或改名）
+	DriftInvalid = "invalid" // 消息不是 JSON 对象
+)
+
+const (
+	schemaMaxFields     = 64   // 指标中单独统计的字段差异上限，超出后记为 other，避免标签膨胀
+	schemaPayloadLogMax = 2048 // 日志中采样载荷的最大字节数
+)
+
+// fieldSpec 期望的字段集合
+type fieldSpec struct {
+	required []string              // 依赖的字段，缺失时计为 missing
+	optional []string              // 已知但可缺省的字段
+	nested   map[string]*fieldSpec // 对象或对象数组字段的子字段，数组只检查第一个元素
+}
+
+// schemaSpecs 各频道的期望字段，与 Hyperliquid 文档及 go-hyperliquid 类型保持一致
+var schemaSpecs = map[Channel]*fieldSpec{
+	ChannelWebData2: {
+		required: []string{"user", "clearinghouseState", "spotState", "serverTime"},
+		optional: []string{
+			"leadingVaults", "totalVaultEquity", "openOrders", "agentAddress", "agentValidUntil", "cumLedger",
+			"meta", "assetCtxs", "isVault", "twapStates", "spotAssetCtxs", "perpsAtOpenInterestCap",
+		},
+		nested: map[string]*fieldSpec{
+			"clearinghouseState": {
+				required: []string{"marginSummary", "crossMarginSummary", "withdrawable", "assetPositions"},
+				optional: []string{"crossMaintenanceMarginUsed", "time"},
+				nested: map[string]*fieldSpec{
+					"marginSummary":      marginSummarySpec,
+					"crossMarginSummary": marginSummarySpec,
+					"assetPositions": {
+						required: []string{"type", "position"},
+						nested: map[string]*fieldSpec{
+							"position": {
+								required: []string{"coin", "szi", "entryPx", "leverage"},
+								optional: []string{
+									"positionValue", "unrealizedPnl", "returnOnEquity", "liquidationPx",
+									"marginUsed", "maxLeverage", "cumFunding",
+								},
+							},
+						},
+					},
+				},
+			},
+			"spotState": {
+				required: []string{"balances"},
+				optional: []string{"evmEscrows"},
+				nested: map[string]*fieldSpec{
+					"balances": {
+						required: []string{"coin", "total"},
+						optional: []string{"token", "hold", "entryNtl"},
+					},
+				},
+			},
+		},
+	},
+	ChannelUserFills: {
+		required: []string{"user", "fills"},
+		optional: []string{"isSnapshot"},
+		nested: map[string]*fieldSpec{
+			"fills": {
+				required: []string{"coin", "px", "sz", "side", "time", "dir", "oid", "tid", "hash"},
+				optional: []string{
+					"startPosition", "closedPnl", "crossed", "fee", "feeToken", "builderFee",
+					"cloid", "liquidation", "twapId",
+				},
+			},
+		},
+	},
+}
+
+var marginSummarySpec = &fieldSpec{
+	required: []string{"accountValue", "totalMarginUsed"},
+	optional: []string{"totalNtlPos", "totalRawUsd"},
+}
+
+// SchemaDrift 一次检查发现的字段差异
+type SchemaDrift struct {
+	Kind  string // unknown / missing / invalid
+	Field string // 字段路径，如 clearinghouseState.assetPositions[].position.cumFunding
+}
+
+// DriftObserver 字段差异回调（用于上报指标）
+type DriftObserver func(channel, kind, field string)
+
+// SchemaChecker 上游消息结构检查
+// 按频道抽样检查 webData2 / userFills 的字段集合，发现未登记或缺失的字段时上报指标，
+// 并按字段限频记录采样载荷，便于在 Hyperliquid 调整结构后及时发现，而不是静默解析出零值
+type SchemaChecker struct {
+	every       uint64
+	logInterval time.Duration
+	counters    map[Channel]*atomic.Uint64
+	observer    DriftObserver
+
+	mu     sync.Mutex
+	logged map[string]time.Time // channel|kind|field -> 上次记录日志的时间
+	now    func() time.Time
+}
+
+// NewSchemaChecker 创建结构检查器，每个频道每 every 条消息检查一条，同一字段差异至多每 logInterval 记录一次载荷
+func NewSchemaChecker(every int, logInterval time.Duration) *SchemaChecker {
+	c := &SchemaChecker{
+		every:       uint64(max(every, 1)),
+		logInterval: logInterval,
+		counters:    make(map[Channel]*atomic.Uint64, len(schemaSpecs)),
+		logged:      make(map[string]time.Time),
+		now:         time.Now,
+	}
+	for channel := range schemaSpecs {
+		c.counters[channel] = &atomic.Uint64{}
+	}
+	return c
+}
+
+// SetObserver 设置字段差异回调，需在开始检查前调用
+func (c *SchemaChecker) SetObserver(fn DriftObserver) {
+	c.observer = fn
+}
+
+// Check 抽样检查消息，返回发现的字段差异（未抽中或无差异时为空）
+func (c *SchemaChecker) Check(msg WsMessage) []SchemaDrift {
+	spec, ok := schemaSpecs[msg.Channel]
+	if !ok {
+		return nil
+	}
+	if n := c.counters[msg.Channel].Add(1); (n-1)%c.every != 0 {
+		return nil
+	}
+
+	var drifts []SchemaDrift
+	data := gjson.ParseBytes(msg.Data)
+	if !data.IsObject() {
+		drifts = append(drifts, SchemaDrift{Kind: DriftInvalid})
+	} else {
+		drifts = spec.check(data, "", drifts)
+	}
+	for _, d := range drifts {
+		c.report(msg, d)
+	}
+	return drifts
+}
+
+// report 上报指标并按字段限频记录采样载荷
+func (c *SchemaChecker) report(msg WsMessage, d SchemaDrift) {
+	key := string(msg.Channel) + "|" + d.Kind + "|" + d.Field
+	now := c.now()
+
+	c.mu.Lock()
+	last, seen := c.logged[key]
+	field := d.Field
+	if !seen && len(c.logged) >= schemaMaxFields {
+		field = "other"
+		key = string(msg.Channel) + "|" + d.Kind + "|" + field
+		last, seen = c.logged[key]
+	}
+	shouldLog := !seen || now.Sub(last) >= c.logInterval
+	if shouldLog {
+		c.logged[key] = now
+	}
+	c.mu.Unlock()
+
+	if c.observer != nil {
+		c.observer(string(msg.Channel), d.Kind, field)
+	}
+	if !shouldLog {
+		return
+	}
+
+	payload := msg.Data
+	if len(payload) > schemaPayloadLogMax {
+		payload = payload[:schemaPayloadLogMax]
+	}
+	logger.Warn().
+		Str("channel", string(msg.Channel)).
+		Str("kind", d.Kind).
+		Str("field", d.Field).
+		Int("payload_bytes", len(msg.Data)).
+		Bytes("payload", payload).
+		Msg("ws payload schema drift")
+}
+
+// check 检查对象的字段集合，嵌套字段递归检查
+func (s *fieldSpec) check(obj gjson.Result, prefix string, drifts []SchemaDrift) []SchemaDrift {
+	present := make(map[string]struct{})
+	obj.ForEach(func(key, value gjson.Result) bool {
+		name := key.String()
+		present[name] = struct{}{}
+		if !s.known(name) {
+			drifts = append(drifts, SchemaDrift{Kind: DriftUnknown, Field: prefix + name})
+			return true
+		}
+
+		nested, ok := s.nested[name]
+		if !ok {
+			return true
+		}
+		path := prefix + name
+		if value.IsArray() {
+			value = value.Get("0")
+			path += "[]"
+		}
+		if value.IsObject() {
+			drifts = nested.check(value, path+".", drifts)
+		}
+		return true
+	})
+
+	for _, name := range s.required {
+		if _, ok := present[name]; !ok {
+			drifts = append(drifts, SchemaDrift{Kind: DriftMissing, Field: prefix + name})
+		}
+	}
+	return drifts
+}
+
+// known 字段是否已登记
+func (s *fieldSpec) known(name string) bool {
+	if slices.Contains(s.required, name) || slices.Contains(s.optional, name) {
+		return true
+	}
+	_, ok := s.nested[name]
+	return ok
+}
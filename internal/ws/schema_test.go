@@ -0,0 +1,90 @@
+package ws
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWebData2 = `{"user":"0xabc","serverTime":1700000000000,"isVault":false,
+"clearinghouseState":{"marginSummary":{"accountValue":"100","totalNtlPos":"0","totalRawUsd":"100","totalMarginUsed":"0"},
+"crossMarginSummary":{"accountValue":"100","totalNtlPos":"0","totalRawUsd":"100","totalMarginUsed":"0"},
+"crossMaintenanceMarginUsed":"0","withdrawable":"100","time":1700000000000,
+"assetPositions":[{"type":"oneWay","position":{"coin":"BTC","szi":"0.1","entryPx":"100000","leverage":{"type":"cross","value":10},
+"positionValue":"10000","unrealizedPnl":"0","returnOnEquity":"0","liquidationPx":null,"marginUsed":"1000","maxLeverage":40,
+"cumFunding":{"allTime":"0","sinceOpen":"0","sinceChange":"0"}}}]},
+"spotState":{"balances":[{"coin":"USDC","token":0,"hold":"0","total":"100","entryNtl":"0"}]}}`
+
+func TestSchemaChecker_Check(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		observed []string
+	)
+	c := NewSchemaChecker(1, time.Hour)
+	c.SetObserver(func(channel, kind, field string) {
+		mu.Lock()
+		defer mu.Unlock()
+		observed = append(observed, channel+"|"+kind+"|"+field)
+	})
+
+	// 与期望结构一致
+	assert.Empty(t, c.Check(WsMessage{Channel: ChannelWebData2, Data: []byte(testWebData2)}))
+	assert.Empty(t, c.Check(WsMessage{Channel: ChannelUserFills, Data: []byte(
+		`{"user":"0xabc","isSnapshot":true,"fills":[{"coin":"BTC","px":"1","sz":"1","side":"B","time":1,"dir":"Open Long",` +
+			`"oid":1,"tid":1,"hash":"0x1","startPosition":"0","closedPnl":"0","crossed":true,"fee":"0","feeToken":"USDC"}]}`)}))
+
+	// 新增字段、字段改名
+	drifts := c.Check(WsMessage{Channel: ChannelUserFills, Data: []byte(
+		`{"user":"0xabc","fills":[{"coin":"BTC","price":"1","sz":"1","side":"B","time":1,"dir":"Open Long","oid":1,"tid":1,"hash":"0x1"}],"version":2}`)})
+	assert.ElementsMatch(t, []SchemaDrift{
+		{Kind: DriftUnknown, Field: "fills[].price"},
+		{Kind: DriftMissing, Field: "fills[].px"},
+		{Kind: DriftUnknown, Field: "version"},
+	}, drifts)
+
+	drifts = c.Check(WsMessage{Channel: ChannelWebData2, Data: []byte(
+		`{"user":"0xabc","serverTime":1,"spotState":{"balances":[]},"clearinghouseState":{"marginSummary":{"accountValue":"1"},` +
+			`"crossMarginSummary":{"accountValue":"1","totalMarginUsed":"0"},"withdrawable":"1","assetPositions":[]}}`)})
+	assert.Equal(t, []SchemaDrift{{Kind: DriftMissing, Field: "clearinghouseState.marginSummary.totalMarginUsed"}}, drifts)
+
+	assert.Equal(t, []SchemaDrift{{Kind: DriftInvalid}}, c.Check(WsMessage{Channel: ChannelUserFills, Data: []byte(`[]`)}))
+
+	// 未登记的频道不检查
+	assert.Empty(t, c.Check(WsMessage{Channel: ChannelOrderUpdates, Data: []byte(`[{"foo":1}]`)}))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Contains(t, observed, "userFills|unknown|fills[].price")
+	assert.Contains(t, observed, "webData2|missing|clearinghouseState.marginSummary.totalMarginUsed")
+	assert.Len(t, observed, 5)
+}
+
+func TestSchemaChecker_Sampling(t *testing.T) {
+	c := NewSchemaChecker(3, time.Hour)
+	msg := WsMessage{Channel: ChannelUserFills, Data: []byte(`{"user":"0xabc"}`)}
+
+	var checked int
+	for range 7 {
+		if len(c.Check(msg)) > 0 {
+			checked++
+		}
+	}
+	assert.Equal(t, 3, checked) // 第 1、4、7 条
+}
+
+func TestSchemaChecker_FieldLimit(t *testing.T) {
+	var fields []string
+	c := NewSchemaChecker(1, time.Hour)
+	c.SetObserver(func(_, _, field string) { fields = append(fields, field) })
+
+	for i := range schemaMaxFields + 5 {
+		data := []byte(`{"user":"0xabc","fills":[],"f` + string(rune('A'+i%26)) + string(rune('a'+i/26)) + `":1}`)
+		require.Len(t, c.Check(WsMessage{Channel: ChannelUserFills, Data: data}), 1)
+	}
+	assert.Len(t, fields, schemaMaxFields+5)
+	assert.Equal(t, "other", fields[len(fields)-1])
+	assert.NotEqual(t, "other", fields[schemaMaxFields-1])
+}
@@ -0,0 +1,41 @@
+package ws
+
+import (
+	"fmt"
+	"time"
+)
+
+// Subscribe 订阅并将消息解析为 T 后回调（如 hl.WsOrderFills、[]hl.WsOrder、hl.WebData2）
+// 重连后的重新订阅由 PoolManager 负责
+func Subscribe[T any](pm *PoolManager, sub Subscription, callback func(T) error) (*SubscriptionHandle, error) {
+	return pm.Subscribe(sub, Decode(callback))
+}
+
+// SubscribeReceived 与 Subscribe 相同，回调额外获得本地接收时间（离线注入/回放的消息为零值）
+// 用于计算交易所时间戳到本地接收的延迟
+func SubscribeReceived[T any](pm *PoolManager, sub Subscription, callback func(T, time.Time) error) (*SubscriptionHandle, error) {
+	return pm.Subscribe(sub, DecodeReceived(callback))
+}
+
+// Decode 将类型化回调包装为 Callback，解析失败时返回错误（由分发器记录日志）
+// 同一条消息分发给多个回调时只解析一次，各回调共享解析结果，回调内不得修改
+func Decode[T any](callback func(T) error) Callback {
+	return func(msg WsMessage) error {
+		data, err := decodeMessage[T](msg)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal %s: %w", msg.Channel, err)
+		}
+		return callback(data)
+	}
+}
+
+// DecodeReceived 与 Decode 相同，回调额外获得消息的本地接收时间
+func DecodeReceived[T any](callback func(T, time.Time) error) Callback {
+	return func(msg WsMessage) error {
+		data, err := decodeMessage[T](msg)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal %s: %w", msg.Channel, err)
+		}
+		return callback(data, msg.ReceivedAt)
+	}
+}
@@ -0,0 +1,82 @@
+package ws
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"time"
+)
+
+// ByteObserver 流量观察回调，wire 为线上（压缩后）字节数，payload 为解压后的消息字节数
+type ByteObserver func(wire, payload int)
+
+// TrafficStats 连接流量统计（所有连接共享）
+type TrafficStats struct {
+	wireBytes    atomic.Uint64
+	payloadBytes atomic.Uint64
+	observer     atomic.Pointer[ByteObserver]
+}
+
+// WireBytes 线上接收的字节数（启用压缩时为压缩后大小，包含帧头）
+func (s *TrafficStats) WireBytes() uint64 {
+	return s.wireBytes.Load()
+}
+
+// PayloadBytes 解压后的消息字节数
+func (s *TrafficStats) PayloadBytes() uint64 {
+	return s.payloadBytes.Load()
+}
+
+// CompressionRatio 压缩比（线上字节/消息字节），无数据时返回 0
+func (s *TrafficStats) CompressionRatio() float64 {
+	payload := s.PayloadBytes()
+	if payload == 0 {
+		return 0
+	}
+	return float64(s.WireBytes()) / float64(payload)
+}
+
+// SetObserver 设置流量观察回调（用于上报指标）
+func (s *TrafficStats) SetObserver(fn ByteObserver) {
+	s.observer.Store(&fn)
+}
+
+func (s *TrafficStats) addWire(n int) {
+	s.wireBytes.Add(uint64(n))
+	if fn := s.observer.Load(); fn != nil && *fn != nil {
+		(*fn)(n, 0)
+	}
+}
+
+func (s *TrafficStats) addPayload(n int) {
+	s.payloadBytes.Add(uint64(n))
+	if fn := s.observer.Load(); fn != nil && *fn != nil {
+		(*fn)(0, n)
+	}
+}
+
+// countingConn 统计底层连接读取的字节数
+type countingConn struct {
+	net.Conn
+	stats *TrafficStats
+}
+
+func (c *countingConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	if n > 0 {
+		c.stats.addWire(n)
+	}
+	return n, err
+}
+
+// countingDialer 返回统计读取字节数的拨号函数
+func countingDialer(stats *TrafficStats) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := dialer.DialContext(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		return &countingConn{Conn: conn, stats: stats}, nil
+	}
+}
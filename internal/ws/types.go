@@ -3,24 +3,9 @@ package ws
 
 import (
 	"encoding/json"
-	"sync"
+	"time"
 )
 
-// 对象池：复用 wsMessage 结构体
-var msgPool = sync.Pool{
-	New: func() any {
-		return &WsMessage{}
-	},
-}
-
-// 对象池：复用字节缓冲区（用于 JSON 解析）
-var bytePool = sync.Pool{
-	New: func() any {
-		b := make([]byte, 0, 4096) // 预分配 4KB
-		return &b
-	},
-}
-
 // Channel Hyperliquid WebSocket 频道
 type Channel string
 
@@ -34,6 +19,8 @@ const (
 	ChannelCandle        Channel = "candle"
 	ChannelBbo           Channel = "bbo"
 	ChannelSpotAssetCtxs Channel = "spotAssetCtxs"
+
+	ChannelUserNonFundingLedgerUpdates Channel = "userNonFundingLedgerUpdates"
 )
 
 // Subscription 订阅请求
@@ -58,6 +45,11 @@ func (s Subscription) Key() string {
 type WsMessage struct {
 	Channel Channel         `json:"channel"`
 	Data    json.RawMessage `json:"data"`
+
+	// ReceivedAt 本地读取到该消息的时间（离线注入/回放的消息为零值）
+	ReceivedAt time.Time `json:"-"`
+
+	frame *decodedFrame // 多回调共享的解析结果，由分发器设置
 }
 
 // wsMessage 私有消息类型（内部使用）
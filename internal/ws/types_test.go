@@ -2,6 +2,7 @@ package ws
 
 import (
 	"testing"
+	"time"
 )
 
 func TestSubscriptionKey(t *testing.T) {
@@ -43,3 +44,50 @@ func TestSubscriptionKey(t *testing.T) {
 		})
 	}
 }
+
+func TestDecode(t *testing.T) {
+	type fills struct {
+		User  string `json:"user"`
+		Fills []struct {
+			Oid int64 `json:"oid"`
+		} `json:"fills"`
+	}
+
+	var got fills
+	callback := Decode(func(data fills) error {
+		got = data
+		return nil
+	})
+
+	err := callback(WsMessage{Channel: ChannelUserFills, Data: []byte(`{"user":"0xabc","fills":[{"oid":1}]}`)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.User != "0xabc" || len(got.Fills) != 1 || got.Fills[0].Oid != 1 {
+		t.Errorf("unexpected payload: %+v", got)
+	}
+
+	if err := callback(WsMessage{Channel: ChannelUserFills, Data: []byte(`[1,2]`)}); err == nil {
+		t.Error("expected unmarshal error")
+	}
+}
+
+func TestDecodeReceived(t *testing.T) {
+	receivedAt := time.UnixMilli(1760000000000)
+
+	var got time.Time
+	callback := DecodeReceived(func(data map[string]any, at time.Time) error {
+		if data["user"] != "0xabc" {
+			t.Errorf("unexpected payload: %+v", data)
+		}
+		got = at
+		return nil
+	})
+
+	if err := callback(WsMessage{Channel: ChannelUserFills, Data: []byte(`{"user":"0xabc"}`), ReceivedAt: receivedAt}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.Equal(receivedAt) {
+		t.Errorf("receivedAt = %v, want %v", got, receivedAt)
+	}
+}
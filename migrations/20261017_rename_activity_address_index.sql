@@ -0,0 +1,9 @@
+-- hl_address_activity 唯一索引改名：uidx_address 与 hl_position_cache 重名，
+-- SQLite 中索引名全库唯一，重名会导致建表失败
+-- MySQL 已部署环境在升级前执行；若已由 AutoMigrate 创建新索引，仅删除旧索引即可
+
+ALTER TABLE hl_address_activity
+RENAME INDEX uidx_address TO uidx_activity_address;
+
+-- 已存在 uidx_activity_address 时改为执行：
+-- ALTER TABLE hl_address_activity DROP INDEX uidx_address;
@@ -1,5 +1,11 @@
 package hyperliquid
 
+import (
+	"sort"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
 //go:generate easyjson -all
 
 // Action structs with deterministic field ordering for consistent MessagePack serialization
@@ -248,3 +254,122 @@ type MultiSigAction struct {
 	Signers    []string       `json:"signers"    msgpack:"signers"`
 	Signatures []string       `json:"signatures" msgpack:"signatures"`
 }
+
+// SpotDeployTokenSpec represents the token spec of a spot deploy register token action
+type SpotDeployTokenSpec struct {
+	Name        string `json:"name"        msgpack:"name"`
+	SzDecimals  int    `json:"szDecimals"  msgpack:"szDecimals"`
+	WeiDecimals int    `json:"weiDecimals" msgpack:"weiDecimals"`
+}
+
+// SpotDeployRegisterToken represents the registerToken2 payload of a spot deploy action
+type SpotDeployRegisterToken struct {
+	Spec     SpotDeployTokenSpec `json:"spec"     msgpack:"spec"`
+	MaxGas   int                 `json:"maxGas"   msgpack:"maxGas"`
+	FullName string              `json:"fullName" msgpack:"fullName"`
+}
+
+// SpotDeployRegisterTokenAction represents spot deploy register token action
+type SpotDeployRegisterTokenAction struct {
+	Type           string                  `json:"type"           msgpack:"type"`
+	RegisterToken2 SpotDeployRegisterToken `json:"registerToken2" msgpack:"registerToken2"`
+}
+
+// SpotDeployUserGenesisAction represents spot deploy user genesis action.
+// Balances is a map, its keys are sorted when the action is signed.
+type SpotDeployUserGenesisAction struct {
+	Type     string             `json:"type"     msgpack:"type"`
+	Balances map[string]float64 `json:"balances" msgpack:"balances"`
+}
+
+// EncodeMsgpack packs the action with Balances keys sorted. The msgpack encoder
+// only sorts keys of map[string]any / map[string]string / map[string]bool, so
+// without it the action hash would depend on map iteration order.
+func (a SpotDeployUserGenesisAction) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if err := enc.EncodeMapLen(2); err != nil {
+		return err
+	}
+	if err := enc.EncodeString("type"); err != nil {
+		return err
+	}
+	if err := enc.EncodeString(a.Type); err != nil {
+		return err
+	}
+	if err := enc.EncodeString("balances"); err != nil {
+		return err
+	}
+	if a.Balances == nil {
+		return enc.EncodeNil()
+	}
+
+	users := make([]string, 0, len(a.Balances))
+	for user := range a.Balances {
+		users = append(users, user)
+	}
+	sort.Strings(users)
+
+	if err := enc.EncodeMapLen(len(users)); err != nil {
+		return err
+	}
+	for _, user := range users {
+		if err := enc.EncodeString(user); err != nil {
+			return err
+		}
+		if err := enc.EncodeFloat64(a.Balances[user]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SpotDeployFreezePrivilegeAction represents spot deploy enable/revoke freeze privilege action
+type SpotDeployFreezePrivilegeAction struct {
+	Type string `json:"type" msgpack:"type"`
+}
+
+// SpotDeployFreezeUserAction represents spot deploy freeze user action
+type SpotDeployFreezeUserAction struct {
+	Type        string `json:"type"        msgpack:"type"`
+	UserAddress string `json:"userAddress" msgpack:"userAddress"`
+}
+
+// SpotDeployGenesisAction represents spot deploy genesis action
+type SpotDeployGenesisAction struct {
+	Type     string `json:"type"     msgpack:"type"`
+	Deployer string `json:"deployer" msgpack:"deployer"`
+	DexName  string `json:"dexName"  msgpack:"dexName"`
+}
+
+// SpotDeployRegisterSpotAction represents spot deploy register spot action
+type SpotDeployRegisterSpotAction struct {
+	Type       string `json:"type"       msgpack:"type"`
+	BaseToken  string `json:"baseToken"  msgpack:"baseToken"`
+	QuoteToken string `json:"quoteToken" msgpack:"quoteToken"`
+}
+
+// SpotDeployRegisterHyperliquidityAction represents spot deploy register hyperliquidity action
+type SpotDeployRegisterHyperliquidityAction struct {
+	Type   string   `json:"type"   msgpack:"type"`
+	Name   string   `json:"name"   msgpack:"name"`
+	Tokens []string `json:"tokens" msgpack:"tokens"`
+}
+
+// SpotDeploySetDeployerTradingFeeShareAction represents spot deploy set deployer trading fee share action
+type SpotDeploySetDeployerTradingFeeShareAction struct {
+	Type     string  `json:"type"     msgpack:"type"`
+	FeeShare float64 `json:"feeShare" msgpack:"feeShare"`
+}
+
+// PerpDeployRegisterAssetAction represents perp deploy register asset action
+type PerpDeployRegisterAssetAction struct {
+	Type         string             `json:"type"         msgpack:"type"`
+	Asset        string             `json:"asset"        msgpack:"asset"`
+	PerpDexInput PerpDexSchemaInput `json:"perpDexInput" msgpack:"perpDexInput"`
+}
+
+// PerpDeploySetOracleAction represents perp deploy set oracle action
+type PerpDeploySetOracleAction struct {
+	Type          string `json:"type"          msgpack:"type"`
+	Asset         string `json:"asset"         msgpack:"asset"`
+	OracleAddress string `json:"oracleAddress" msgpack:"oracleAddress"`
+}
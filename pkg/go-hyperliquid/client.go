@@ -26,11 +26,31 @@ const (
 	httpErrorStatusCode = 400
 )
 
+// Doer sends an HTTP request and returns its response. *http.Client implements it.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc adapts an ordinary function to the Doer interface.
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the REST request pipeline for logging, tracing, caching and
+// the like. It may modify the request, inspect the response returned by next,
+// or short-circuit by returning its own response without calling next.
+type Middleware func(next Doer) Doer
+
 type Client struct {
-	logger     *zerolog.Logger
-	debug      bool
-	baseURL    string
-	httpClient *http.Client
+	logger      *zerolog.Logger
+	debug       bool
+	baseURL     string
+	httpClient  *http.Client
+	middlewares []Middleware
+	doer        Doer
 }
 
 func NewClient(baseURL string, opts ...ClientOpt) *Client {
@@ -47,6 +67,12 @@ func NewClient(baseURL string, opts ...ClientOpt) *Client {
 		opt.Apply(cli)
 	}
 
+	// The first registered middleware is the outermost one.
+	cli.doer = cli.httpClient
+	for i := len(cli.middlewares) - 1; i >= 0; i-- {
+		cli.doer = cli.middlewares[i](cli.doer)
+	}
+
 	return cli
 }
 
@@ -74,7 +100,7 @@ func (c *Client) post(ctx context.Context, path string, payload any) ([]byte, er
 		c.logger.Debug().Msgf("HTTP request: method:POST, url:%s, body:%s", url, string(jsonData))
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doer.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
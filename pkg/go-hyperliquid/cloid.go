@@ -0,0 +1,51 @@
+package hyperliquid
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidCloid is returned when a client order id is not a 16-byte hex string.
+var ErrInvalidCloid = errors.New("invalid cloid")
+
+// cloidHexLen is the number of hex digits in a cloid (16 bytes).
+const cloidHexLen = 32
+
+// NewCloid parses a client order id. The value must be 16 bytes of hex, with or
+// without the 0x prefix; it is normalized to the lowercase 0x-prefixed form the
+// API returns, so ids compare equal across requests and responses.
+func NewCloid(value string) (Cloid, error) {
+	raw := strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(value), "0x"), "0X")
+	if len(raw) != cloidHexLen {
+		return Cloid{}, fmt.Errorf("%w: %q must be %d hex digits", ErrInvalidCloid, value, cloidHexLen)
+	}
+	if _, err := hex.DecodeString(raw); err != nil {
+		return Cloid{}, fmt.Errorf("%w: %q is not hex", ErrInvalidCloid, value)
+	}
+	return Cloid{Value: "0x" + strings.ToLower(raw)}, nil
+}
+
+// CloidFromInt builds a cloid from an integer, zero-padded to 16 bytes.
+func CloidFromInt(n uint64) Cloid {
+	return Cloid{Value: fmt.Sprintf("0x%032x", n)}
+}
+
+// String returns the wire representation of the cloid.
+func (c Cloid) String() string {
+	return c.Value
+}
+
+// Equal reports whether two cloids refer to the same id, ignoring case and prefix.
+func (c Cloid) Equal(other string) bool {
+	parsed, err := NewCloid(other)
+	if err != nil {
+		return false
+	}
+	normalized, err := NewCloid(c.Value)
+	if err != nil {
+		return false
+	}
+	return parsed.Value == normalized.Value
+}
@@ -0,0 +1,92 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCloid(t *testing.T) {
+	c, err := NewCloid("0x00000000000000000000000000000ABC")
+	require.NoError(t, err)
+	assert.Equal(t, "0x00000000000000000000000000000abc", c.String())
+
+	c, err = NewCloid("00000000000000000000000000000abc")
+	require.NoError(t, err)
+	assert.Equal(t, "0x00000000000000000000000000000abc", c.String())
+
+	assert.Equal(t, "0x00000000000000000000000000000abc", CloidFromInt(0xabc).String())
+	assert.True(t, CloidFromInt(0xabc).Equal("0x00000000000000000000000000000ABC"))
+	assert.False(t, CloidFromInt(0xabc).Equal("0x00000000000000000000000000000abd"))
+
+	for _, invalid := range []string{"", "0x1234", "0xzz000000000000000000000000000abc", "0x00000000000000000000000000000abc00"} {
+		_, err := NewCloid(invalid)
+		assert.ErrorIs(t, err, ErrInvalidCloid, invalid)
+	}
+}
+
+func TestOrderStatusByCloid(t *testing.T) {
+	var payload map[string]any
+	response := `{"status":"order","order":{"order":{"coin":"BTC","side":"B","limitPx":"50000.0","sz":"0.001","oid":123,"timestamp":1,"origSz":"0.001","cloid":"0x00000000000000000000000000000abc"},"status":"open","statusTimestamp":2}}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		_, _ = io.WriteString(w, response)
+	}))
+	defer srv.Close()
+
+	info := NewInfo(context.TODO(), srv.URL, true, &Meta{}, &SpotMeta{})
+	res, err := info.OrderStatusByCloid(context.TODO(), "0xuser", Cloid{Value: "00000000000000000000000000000ABC"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "orderStatus", payload["type"])
+	assert.Equal(t, "0x00000000000000000000000000000abc", payload["oid"])
+	assert.Equal(t, int64(123), res.Order.Order.Oid)
+	assert.Equal(t, OrderStatusValueOpen, res.Order.Status)
+
+	// unknown cloid
+	response = `{"status":"unknownOid"}`
+	res, err = info.OrderStatusByCloid(context.TODO(), "0xuser", CloidFromInt(0xabc))
+	require.NoError(t, err)
+	assert.Equal(t, OrderQueryStatusError, res.Status)
+
+	// a response for another order is rejected
+	response = `{"status":"order","order":{"order":{"coin":"BTC","oid":124,"cloid":"0x00000000000000000000000000000abd"},"status":"open","statusTimestamp":2}}`
+	_, err = info.OrderStatusByCloid(context.TODO(), "0xuser", CloidFromInt(0xabc))
+	assert.ErrorContains(t, err, "cloid mismatch")
+
+	_, err = info.OrderStatusByCloid(context.TODO(), "0xuser", Cloid{Value: "abc"})
+	assert.ErrorIs(t, err, ErrInvalidCloid)
+}
+
+func TestCancelOrderByCloid(t *testing.T) {
+	var got struct {
+		Action CancelByCloidAction `json:"action"`
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = io.WriteString(w, `{"status":"ok","response":{"type":"cancel","data":{"statuses":["success"]}}}`)
+	}))
+	defer srv.Close()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	meta := &Meta{Universe: []AssetInfo{{Name: "BTC", SzDecimals: 5}, {Name: "ETH", SzDecimals: 4}}}
+	ex := NewExchange(context.TODO(), key, srv.URL, meta, "", "0xuser", &SpotMeta{})
+
+	_, err = ex.CancelOrderByCloid(context.TODO(), "ETH", Cloid{Value: "0x00000000000000000000000000000ABC"})
+	require.NoError(t, err)
+	require.Len(t, got.Action.Cancels, 1)
+	assert.Equal(t, "cancelByCloid", got.Action.Type)
+	assert.Equal(t, 1, got.Action.Cancels[0].Asset)
+	assert.Equal(t, "0x00000000000000000000000000000abc", got.Action.Cancels[0].ClientID)
+
+	_, err = ex.CancelOrderByCloid(context.TODO(), "ETH", Cloid{Value: "0x12"})
+	assert.ErrorIs(t, err, ErrInvalidCloid)
+}
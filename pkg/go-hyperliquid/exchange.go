@@ -3,21 +3,37 @@ package hyperliquid
 import (
 	"context"
 	"crypto/ecdsa"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/rs/zerolog/log"
 )
 
 type Exchange struct {
-	debug        bool
-	isMainnet    bool
-	client       *Client
-	privateKey   *ecdsa.PrivateKey
+	debug       bool
+	isMainnet   bool
+	client      *Client
+	privateKey  *ecdsa.PrivateKey
+	accountAddr string
+	info        *Info
+	lastNonce   atomic.Int64
+	nonceStore  NonceStore
+	nonceState  nonceState
+
+	// settingsMu guards vault and expiresAfter, see actionParams
+	settingsMu   sync.RWMutex
 	vault        string
-	accountAddr  string
-	info         *Info
 	expiresAfter *int64
-	lastNonce    atomic.Int64
+
+	// multi-account support, see exchange_accounts.go
+	label           string
+	accounts        *accountRegistry
+	pendingAccounts []ExchangeAccount
 
 	clientOpts []ClientOpt
 	infoOpts   []InfoOpt
@@ -36,6 +52,7 @@ func NewExchange(
 		privateKey:  privateKey,
 		vault:       vaultAddr,
 		accountAddr: accountAddr,
+		accounts:    newAccountRegistry(),
 	}
 
 	for _, opt := range opts {
@@ -52,10 +69,22 @@ func NewExchange(
 	ex.client = NewClient(baseURL, ex.clientOpts...)
 	ex.info = NewInfo(ctx, baseURL, true, meta, spotMeta, ex.infoOpts...)
 
+	if err := ex.restoreNonce(); err != nil {
+		log.Error().Err(err).Msg("restore nonce failed")
+	}
+
+	for _, account := range ex.pendingAccounts {
+		if err := ex.AddAccount(account); err != nil {
+			log.Error().Err(err).Str("label", account.Label).Msg("skip invalid exchange account")
+		}
+	}
+	ex.pendingAccounts = nil
+
 	return ex
 }
 
-// nextNonce returns either the current timestamp in milliseconds or incremented by one to prevent duplicates
+// nextNonce returns either the current timestamp in milliseconds or incremented by one to prevent duplicates.
+// Nonces are strictly increasing across goroutines and, with a NonceStore, across restarts.
 // Nonces must be within (T - 2 days, T + 1 day), where T is the unix millisecond timestamp on the block of the transaction.
 // See https://hyperliquid.gitbook.io/hyperliquid-docs/for-developers/api/nonces-and-api-wallets#hyperliquid-nonces
 func (e *Exchange) nextNonce() int64 {
@@ -70,16 +99,130 @@ func (e *Exchange) nextNonce() int64 {
 
 		// Try to publish our candidate; if someone beat us, retry.
 		if e.lastNonce.CompareAndSwap(last, candidate) {
+			e.persistNonce(candidate)
 			return candidate
 		}
 	}
 }
 
-// SetExpiresAfter sets the expiration time for actions
-// If expiresAfter is nil, actions will not have an expiration time
-// If expiresAfter is set, actions will include this expiration nonce
+// addressHexLen is the number of hex digits in an address (20 bytes).
+const addressHexLen = 40
+
+// SetExpiresAfter sets the expiration time for actions, as a unix millisecond timestamp.
+// Every L1 action signed afterwards includes it in both the signature and the payload;
+// the exchange rejects actions that arrive after it. Passing nil clears the expiration.
+// The value is not validated, use SetExpiresAfterChecked to reject bad timestamps.
 func (e *Exchange) SetExpiresAfter(expiresAfter *int64) {
-	e.expiresAfter = expiresAfter
+	var value *int64
+	if expiresAfter != nil {
+		// copy so later writes through the caller's pointer do not leak into signing
+		v := *expiresAfter
+		value = &v
+	}
+
+	e.settingsMu.Lock()
+	e.expiresAfter = value
+	e.settingsMu.Unlock()
+}
+
+// SetExpiresAfterChecked is SetExpiresAfter with validation: timestamps that are not
+// positive or already in the past are rejected and the current setting is kept.
+func (e *Exchange) SetExpiresAfterChecked(expiresAfter *int64) error {
+	if expiresAfter != nil {
+		if *expiresAfter <= 0 {
+			return ValidationError{
+				Field:   "expiresAfter",
+				Message: fmt.Sprintf("must be a positive unix millisecond timestamp, got %d", *expiresAfter),
+			}
+		}
+		if now := time.Now().UnixMilli(); *expiresAfter <= now {
+			return ValidationError{
+				Field:   "expiresAfter",
+				Message: fmt.Sprintf("%d is already in the past (now %d)", *expiresAfter, now),
+			}
+		}
+	}
+
+	e.SetExpiresAfter(expiresAfter)
+	return nil
+}
+
+// ExpiresAfter returns the expiration time applied to actions, or nil if none is set.
+func (e *Exchange) ExpiresAfter() *int64 {
+	e.settingsMu.RLock()
+	defer e.settingsMu.RUnlock()
+
+	if e.expiresAfter == nil {
+		return nil
+	}
+	v := *e.expiresAfter
+	return &v
+}
+
+// SetVaultAddress sets the vault or sub-account the Exchange trades on behalf of.
+// The address must be 0x followed by 40 hex digits and is stored lowercased.
+// Passing an empty string clears it, so actions act on the signer's own account.
+func (e *Exchange) SetVaultAddress(address string) error {
+	address, err := normalizeVaultAddress(address)
+	if err != nil {
+		return err
+	}
+
+	e.settingsMu.Lock()
+	e.vault = address
+	e.settingsMu.Unlock()
+	return nil
+}
+
+// normalizeVaultAddress validates a vault address and lowercases it; empty stays empty.
+func normalizeVaultAddress(address string) (string, error) {
+	address = strings.TrimSpace(address)
+	if address == "" {
+		return "", nil
+	}
+	raw, ok := strings.CutPrefix(strings.ToLower(address), "0x")
+	if !ok || len(raw) != addressHexLen {
+		return "", ValidationError{
+			Field:   "vaultAddress",
+			Message: fmt.Sprintf("%q must be 0x followed by %d hex digits", address, addressHexLen),
+		}
+	}
+	if _, err := hex.DecodeString(raw); err != nil {
+		return "", ValidationError{Field: "vaultAddress", Message: fmt.Sprintf("%q is not hex", address)}
+	}
+	return "0x" + raw, nil
+}
+
+// VaultAddress returns the vault or sub-account the Exchange trades on behalf of.
+func (e *Exchange) VaultAddress() string {
+	e.settingsMu.RLock()
+	defer e.settingsMu.RUnlock()
+	return e.vault
+}
+
+// actionParams is the vault address and expiration an action is signed and posted with.
+type actionParams struct {
+	vault        string
+	expiresAfter *int64
+}
+
+// actionParams snapshots the settings once per action, so a concurrent
+// SetVaultAddress or SetExpiresAfter cannot make the signature and payload disagree.
+func (e *Exchange) actionParams() actionParams {
+	e.settingsMu.RLock()
+	defer e.settingsMu.RUnlock()
+	return actionParams{vault: e.vault, expiresAfter: e.expiresAfter}
+}
+
+// withoutVault returns the params for actions that always act on the signer's own account.
+func (p actionParams) withoutVault() actionParams {
+	p.vault = ""
+	return p
+}
+
+// signL1Action signs an L1 action with the given params.
+func (e *Exchange) signL1Action(action any, nonce int64, p actionParams) (SignatureResult, error) {
+	return SignL1Action(e.privateKey, action, p.vault, nonce, p.expiresAfter, e.isMainnet)
 }
 
 // SetLastNonce allows for resuming from a persisted nonce, e.g. the nonce was stored before a restart
@@ -91,20 +234,14 @@ func (e *Exchange) SetLastNonce(n int64) {
 // executeAction executes an action and unmarshals the response into the given result
 func (e *Exchange) executeAction(ctx context.Context, action, result any) error {
 	nonce := e.nextNonce()
+	params := e.actionParams()
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return err
 	}
@@ -116,11 +253,14 @@ func (e *Exchange) executeAction(ctx context.Context, action, result any) error
 	return nil
 }
 
+// postAction posts a signed action. The vault address and expiration in the payload
+// must be the ones the action was signed with, otherwise the signature does not verify.
 func (e *Exchange) postAction(
 	ctx context.Context,
 	action any,
 	signature SignatureResult,
 	nonce int64,
+	params actionParams,
 ) ([]byte, error) {
 	payload := map[string]any{
 		"action":    action,
@@ -128,29 +268,24 @@ func (e *Exchange) postAction(
 		"signature": signature,
 	}
 
-	if e.vault != "" {
-		// Handle vault address based on action type
-		if actionMap, ok := action.(map[string]any); ok {
-			if actionMap["type"] != "usdClassTransfer" {
-				payload["vaultAddress"] = e.vault
-			} else {
-				payload["vaultAddress"] = nil
-			}
-		} else {
-			// For struct types, we need to use reflection or type assertion
-			// For now, assume it's not usdClassTransfer
-			payload["vaultAddress"] = e.vault
-		}
+	if params.vault != "" {
+		payload["vaultAddress"] = params.vault
 	}
 
 	// Add expiration time if set
-	if e.expiresAfter != nil {
-		payload["expiresAfter"] = *e.expiresAfter
+	if params.expiresAfter != nil {
+		payload["expiresAfter"] = *params.expiresAfter
 	}
 
 	return e.client.post(ctx, "/exchange", payload)
 }
 
+// Ping checks that the exchange API is reachable and returns the round-trip latency.
+// It uses an unsigned info request, so no nonce is consumed.
+func (e *Exchange) Ping(ctx context.Context) (time.Duration, error) {
+	return e.info.Ping(ctx)
+}
+
 func (e *Exchange) Info() *Info {
 	return e.info
 }
@@ -0,0 +1,142 @@
+package hyperliquid
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrAccountNotFound is returned when no account is registered under the requested label.
+var ErrAccountNotFound = errors.New("account not found")
+
+// ExchangeAccount describes a signing identity managed by a multi-account Exchange.
+// PrivateKey may be the account's own key or an agent (API wallet) key approved for it.
+// NonceStore optionally persists the account's nonces, see ExchangeOptNonceStore.
+type ExchangeAccount struct {
+	Label          string
+	PrivateKey     *ecdsa.PrivateKey
+	VaultAddress   string
+	AccountAddress string
+	NonceStore     NonceStore
+}
+
+// accountRegistry holds the per-account Exchange views shared by an Exchange and its accounts.
+type accountRegistry struct {
+	mu       sync.RWMutex
+	accounts map[string]*Exchange
+	labels   []string
+}
+
+func newAccountRegistry() *accountRegistry {
+	return &accountRegistry{accounts: make(map[string]*Exchange)}
+}
+
+// ExchangeOptAccounts registers additional accounts on the Exchange at construction time.
+func ExchangeOptAccounts(accounts ...ExchangeAccount) ExchangeOpt {
+	return func(e *Exchange) {
+		e.pendingAccounts = append(e.pendingAccounts, accounts...)
+	}
+}
+
+// AddAccount registers an account under its label. Actions sent through the returned view
+// of Account(label) are signed with that account's key and carry its vault address.
+// The HTTP client and metadata are shared; nonces and expiresAfter are tracked per account.
+func (e *Exchange) AddAccount(account ExchangeAccount) error {
+	if account.Label == "" {
+		return ValidationError{Field: "label", Message: "account label is required"}
+	}
+	if account.PrivateKey == nil {
+		return ValidationError{Field: "privateKey", Message: "private key is required"}
+	}
+	vault, err := normalizeVaultAddress(account.VaultAddress)
+	if err != nil {
+		return err
+	}
+
+	r := e.accounts
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.accounts[account.Label]; exists {
+		return ValidationError{
+			Field:   "label",
+			Message: fmt.Sprintf("account %q already registered", account.Label),
+		}
+	}
+
+	ex := &Exchange{
+		debug:       e.debug,
+		isMainnet:   e.isMainnet,
+		client:      e.client,
+		info:        e.info,
+		privateKey:  account.PrivateKey,
+		vault:       vault,
+		accountAddr: account.AccountAddress,
+		nonceStore:  account.NonceStore,
+		label:       account.Label,
+		accounts:    r,
+	}
+	if err := ex.restoreNonce(); err != nil {
+		return fmt.Errorf("restore nonce for account %q: %w", account.Label, err)
+	}
+
+	r.accounts[account.Label] = ex
+	r.labels = append(r.labels, account.Label)
+	return nil
+}
+
+// RemoveAccount unregisters the account with the given label.
+// Views already obtained through Account keep working.
+func (e *Exchange) RemoveAccount(label string) bool {
+	r := e.accounts
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.accounts[label]; !exists {
+		return false
+	}
+	delete(r.accounts, label)
+	for i, l := range r.labels {
+		if l == label {
+			r.labels = append(r.labels[:i], r.labels[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// Account returns the Exchange that signs with the account registered under label.
+// All Exchange methods called on it are routed to that account's signer.
+func (e *Exchange) Account(label string) (*Exchange, error) {
+	r := e.accounts
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ex, ok := r.accounts[label]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrAccountNotFound, label)
+	}
+	return ex, nil
+}
+
+// Accounts returns the labels of the registered accounts in registration order.
+func (e *Exchange) Accounts() []string {
+	r := e.accounts
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	labels := make([]string, len(r.labels))
+	copy(labels, r.labels)
+	return labels
+}
+
+// Label returns the account label of this Exchange, or an empty string for the primary account.
+func (e *Exchange) Label() string {
+	return e.label
+}
+
+// AccountAddress returns the address of the account this Exchange acts on.
+func (e *Exchange) AccountAddress() string {
+	return e.accountAddr
+}
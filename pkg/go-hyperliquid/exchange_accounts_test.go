@@ -0,0 +1,95 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeAccounts(t *testing.T) {
+	type request struct {
+		Nonce        int64           `json:"nonce"`
+		VaultAddress string          `json:"vaultAddress"`
+		Signature    SignatureResult `json:"signature"`
+	}
+	var got request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = io.WriteString(w, `{}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	primaryKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	aliceKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	bobKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	meta := &Meta{Universe: []AssetInfo{{Name: "BTC", SzDecimals: 5}}}
+	ex := NewExchange(
+		context.TODO(), primaryKey, srv.URL, meta, "", "0xprimary", &SpotMeta{},
+		ExchangeOptAccounts(
+			ExchangeAccount{Label: "alice", PrivateKey: aliceKey, AccountAddress: "0xalice"},
+			ExchangeAccount{Label: "invalid"},
+		),
+	)
+	require.NoError(t, ex.AddAccount(ExchangeAccount{
+		Label:          "bob",
+		PrivateKey:     bobKey,
+		VaultAddress:   "0x1111111111111111111111111111111111111111",
+		AccountAddress: "0xbob",
+	}))
+	require.Equal(t, []string{"alice", "bob"}, ex.Accounts())
+
+	// duplicate labels and unknown accounts are rejected
+	require.Error(t, ex.AddAccount(ExchangeAccount{Label: "bob", PrivateKey: bobKey}))
+	_, err = ex.Account("carol")
+	require.ErrorIs(t, err, ErrAccountNotFound)
+
+	// each account view signs with its own key and carries its own vault address
+	for _, tc := range []struct {
+		label string
+		key   any
+		vault string
+	}{
+		{label: "alice", key: aliceKey},
+		{label: "bob", key: bobKey, vault: "0x1111111111111111111111111111111111111111"},
+	} {
+		account, err := ex.Account(tc.label)
+		require.NoError(t, err)
+		require.Equal(t, tc.label, account.Label())
+		require.Equal(t, "0x"+tc.label, account.AccountAddress())
+
+		_, err = account.UpdateLeverage(context.TODO(), 5, "BTC", true)
+		require.NoError(t, err)
+		require.Equal(t, tc.vault, got.VaultAddress)
+
+		want, err := SignL1Action(
+			account.privateKey,
+			UpdateLeverageAction{Type: "updateLeverage", Asset: 0, IsCross: true, Leverage: 5},
+			tc.vault,
+			got.Nonce,
+			nil,
+			true,
+		)
+		require.NoError(t, err)
+		require.Equal(t, want, got.Signature)
+		require.Same(t, tc.key, account.privateKey)
+	}
+
+	// accounts share the HTTP client but track nonces independently
+	alice, _ := ex.Account("alice")
+	require.Same(t, ex.Client(), alice.Client())
+	require.Zero(t, ex.lastNonce.Load())
+
+	require.True(t, ex.RemoveAccount("alice"))
+	require.False(t, ex.RemoveAccount("alice"))
+	require.Equal(t, []string{"bob"}, ex.Accounts())
+}
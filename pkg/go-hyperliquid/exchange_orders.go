@@ -71,6 +71,7 @@ func newOrderTypeWire(o CreateOrderRequest) orderWireType {
 }
 
 func newCreateOrderAction(
+	ctx context.Context,
 	e *Exchange,
 	orders []CreateOrderRequest,
 	info *BuilderInfo,
@@ -87,8 +88,13 @@ func newCreateOrderAction(
 			return OrderAction{}, fmt.Errorf("failed to wire size for order %d: %w", i, err)
 		}
 
+		asset, err := e.info.resolveAsset(ctx, order.Coin)
+		if err != nil {
+			return OrderAction{}, fmt.Errorf("order %d: %w", i, err)
+		}
+
 		orderWire := OrderWire{
-			Asset:      e.info.NameToAsset(order.Coin),
+			Asset:      asset,
 			IsBuy:      order.IsBuy,
 			LimitPx:    priceWire,
 			Size:       sizeWire,
@@ -134,12 +140,16 @@ func (e *Exchange) Order(
 	return data.Statuses[0], nil
 }
 
+// BulkOrders places several orders in one action. Orders can succeed
+// partially: when any order is rejected the response is returned together
+// with the first rejection as an *OrderError; use the OrderResponse helpers
+// (Failed, FailedOrders) to inspect or retry the failed legs.
 func (e *Exchange) BulkOrders(
 	ctx context.Context,
 	orders []CreateOrderRequest,
 	builder *BuilderInfo,
 ) (result *APIResponse[OrderResponse], err error) {
-	action, err := newCreateOrderAction(e, orders, builder)
+	action, err := newCreateOrderAction(ctx, e, orders, builder)
 	if err != nil {
 		return nil, err
 	}
@@ -149,11 +159,8 @@ func (e *Exchange) BulkOrders(
 	}
 
 	if result != nil {
-		// check if any of the statuses has an error set
-		for _, s := range result.Data.Statuses {
-			if s.Error != nil {
-				return result, fmt.Errorf("%s", *s.Error)
-			}
+		if err := result.Data.FirstError(); err != nil {
+			return result, err
 		}
 	}
 
@@ -166,6 +173,7 @@ type ModifyOrderRequest struct {
 }
 
 func newModifyOrderAction(
+	ctx context.Context,
 	e *Exchange,
 	modifyRequest ModifyOrderRequest,
 ) (ModifyAction, error) {
@@ -193,8 +201,13 @@ func newModifyOrderAction(
 		}
 	}
 
+	asset, err := e.info.resolveAsset(ctx, modifyRequest.Order.Coin)
+	if err != nil {
+		return ModifyAction{}, err
+	}
+
 	order := OrderWire{
-		Asset:      e.info.NameToAsset(modifyRequest.Order.Coin),
+		Asset:      asset,
 		IsBuy:      modifyRequest.Order.IsBuy,
 		LimitPx:    priceWire,
 		Size:       sizeWire,
@@ -211,12 +224,13 @@ func newModifyOrderAction(
 }
 
 func newModifyOrdersAction(
+	ctx context.Context,
 	e *Exchange,
 	modifyRequests []ModifyOrderRequest,
 ) (BatchModifyAction, error) {
 	modifies := make([]ModifyAction, len(modifyRequests))
 	for i, req := range modifyRequests {
-		modify, err := newModifyOrderAction(e, req)
+		modify, err := newModifyOrderAction(ctx, e, req)
 		if err != nil {
 			return BatchModifyAction{}, fmt.Errorf("failed to create modify request %d: %w", i, err)
 		}
@@ -236,7 +250,7 @@ func (e *Exchange) ModifyOrder(
 	req ModifyOrderRequest,
 ) (result OrderStatus, err error) {
 	resp := APIResponse[OrderResponse]{}
-	action, err := newModifyOrderAction(e, req)
+	action, err := newModifyOrderAction(ctx, e, req)
 	if err != nil {
 		return result, fmt.Errorf("failed to create modify action: %w", err)
 	}
@@ -267,7 +281,7 @@ func (e *Exchange) BulkModifyOrders(
 	modifyRequests []ModifyOrderRequest,
 ) ([]OrderStatus, error) {
 	resp := APIResponse[OrderResponse]{}
-	action, err := newModifyOrdersAction(e, modifyRequests)
+	action, err := newModifyOrdersAction(ctx, e, modifyRequests)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create bulk modify action: %w", err)
 	}
@@ -289,6 +303,55 @@ func (e *Exchange) BulkModifyOrders(
 	return data.Statuses, nil
 }
 
+// BatchModify modifies multiple orders in a single batchModify action and
+// returns the typed per-order statuses. Unlike BulkModifyOrders, a rejected
+// modify does not fail the whole call; inspect ModifyResponse.FirstError or
+// each status instead.
+func (e *Exchange) BatchModify(
+	ctx context.Context,
+	modifyRequests []ModifyOrderRequest,
+) (*ModifyResponse, error) {
+	action, err := newModifyOrdersAction(ctx, e, modifyRequests)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create batch modify action: %w", err)
+	}
+
+	resp := APIResponse[OrderResponse]{}
+	if err := e.executeAction(ctx, action, &resp); err != nil {
+		return nil, fmt.Errorf("failed to batch modify orders: %w", err)
+	}
+
+	return newModifyResponse(resp), nil
+}
+
+// newModifyResponse converts a raw modify API response into a ModifyResponse
+func newModifyResponse(resp APIResponse[OrderResponse]) *ModifyResponse {
+	if !resp.Ok {
+		return &ModifyResponse{
+			Status: resp.Status,
+			Error:  resp.Err,
+		}
+	}
+
+	return &ModifyResponse{
+		Status: resp.Status,
+		Data:   resp.Data.Statuses,
+	}
+}
+
+// FirstError returns the top-level error or the first per-order error, if any
+func (r *ModifyResponse) FirstError() error {
+	if r.Error != "" {
+		return fmt.Errorf("%s", r.Error)
+	}
+	for i, s := range r.Data {
+		if s.Error != nil {
+			return fmt.Errorf("modify %d: %s", i, *s.Error)
+		}
+	}
+	return nil
+}
+
 // MarketOpen opens a market position
 func (e *Exchange) MarketOpen(
 	ctx context.Context,
@@ -332,7 +395,7 @@ func (e *Exchange) MarketClose(
 ) (OrderStatus, error) {
 	address := e.accountAddr
 	if address == "" {
-		address = e.vault
+		address = e.VaultAddress()
 	}
 
 	userState, err := e.info.UserState(ctx, address, "")
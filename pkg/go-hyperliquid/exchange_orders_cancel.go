@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/sonirico/vago/slices"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 type (
@@ -35,12 +35,17 @@ func (e *Exchange) BulkCancel(
 	ctx context.Context,
 	requests []CancelOrderRequest,
 ) (res *APIResponse[CancelOrderResponse], err error) {
-	cancels := slices.Map(requests, func(req CancelOrderRequest) CancelOrderWire {
-		return CancelOrderWire{
-			Asset:   e.info.NameToAsset(req.Coin),
+	cancels := make([]CancelOrderWire, len(requests))
+	for i, req := range requests {
+		asset, err := e.info.resolveAsset(ctx, req.Coin)
+		if err != nil {
+			return nil, err
+		}
+		cancels[i] = CancelOrderWire{
+			Asset:   asset,
 			OrderID: req.OrderID,
 		}
-	})
+	}
 
 	action := CancelAction{
 		Type:    "cancel",
@@ -65,6 +70,48 @@ func (e *Exchange) BulkCancel(
 	return
 }
 
+// CancelAllOrders lists the account's open orders via Info and cancels them in a
+// single bulk cancel action. If coin is empty, open orders for every coin are cancelled.
+// Returns a nil response and no error when there is nothing to cancel.
+func (e *Exchange) CancelAllOrders(
+	ctx context.Context,
+	coin string,
+) (res *APIResponse[CancelOrderResponse], err error) {
+	orders, err := e.info.OpenOrders(ctx, e.ownerAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]CancelOrderRequest, 0, len(orders))
+	for _, o := range orders {
+		if coin != "" && o.Coin != coin {
+			continue
+		}
+		requests = append(requests, CancelOrderRequest{
+			Coin:    o.Coin,
+			OrderID: o.Oid,
+		})
+	}
+
+	if len(requests) == 0 {
+		return nil, nil
+	}
+
+	return e.BulkCancel(ctx, requests)
+}
+
+// ownerAddress is the address whose orders the actions of this Exchange act on:
+// the vault if one is set, then the account address, then the signer itself.
+func (e *Exchange) ownerAddress() string {
+	if vault := e.VaultAddress(); vault != "" {
+		return vault
+	}
+	if e.accountAddr != "" {
+		return e.accountAddr
+	}
+	return crypto.PubkeyToAddress(e.privateKey.PublicKey).Hex()
+}
+
 type CancelOrderRequestByCloid struct {
 	Coin  string
 	Cloid string
@@ -82,16 +129,35 @@ func (e *Exchange) CancelByCloid(
 	})
 }
 
+// CancelOrderByCloid cancels an order by a typed client order id. The cloid is
+// validated and normalized before signing, matching the form used when placing it.
+func (e *Exchange) CancelOrderByCloid(
+	ctx context.Context,
+	coin string,
+	cloid Cloid,
+) (*APIResponse[CancelOrderResponse], error) {
+	normalized, err := NewCloid(cloid.Value)
+	if err != nil {
+		return nil, err
+	}
+	return e.CancelByCloid(ctx, coin, normalized.Value)
+}
+
 func (e *Exchange) BulkCancelByCloids(
 	ctx context.Context,
 	requests []CancelOrderRequestByCloid,
 ) (res *APIResponse[CancelOrderResponse], err error) {
-	cancels := slices.Map(requests, func(req CancelOrderRequestByCloid) CancelByCloidWire {
-		return CancelByCloidWire{
-			Asset:    e.info.NameToAsset(req.Coin),
+	cancels := make([]CancelByCloidWire, len(requests))
+	for i, req := range requests {
+		asset, err := e.info.resolveAsset(ctx, req.Coin)
+		if err != nil {
+			return nil, err
+		}
+		cancels[i] = CancelByCloidWire{
+			Asset:    asset,
 			ClientID: req.Cloid,
 		}
-	})
+	}
 
 	action := CancelByCloidAction{
 		Type:    "cancelByCloid",
@@ -2,9 +2,15 @@ package hyperliquid
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -219,3 +225,110 @@ func TestCancel(t *testing.T) {
 		})
 	}
 }
+
+func TestCancelAllOrders(t *testing.T) {
+	cases := []struct {
+		name       string
+		coin       string
+		openOrders string
+		wantOids   []int64
+	}{
+		{
+			name:       "cancel only matching coin",
+			coin:       "BTC",
+			openOrders: `[{"coin":"BTC","limitPx":"50000","oid":1,"side":"B","sz":"0.1","timestamp":1},{"coin":"ETH","limitPx":"4000","oid":2,"side":"A","sz":"1","timestamp":1},{"coin":"BTC","limitPx":"51000","oid":3,"side":"A","sz":"0.2","timestamp":1}]`,
+			wantOids:   []int64{1, 3},
+		},
+		{
+			name:       "empty coin cancels all",
+			coin:       "",
+			openOrders: `[{"coin":"BTC","limitPx":"50000","oid":1,"side":"B","sz":"0.1","timestamp":1},{"coin":"ETH","limitPx":"4000","oid":2,"side":"A","sz":"1","timestamp":1}]`,
+			wantOids:   []int64{1, 2},
+		},
+		{
+			name:       "nothing to cancel",
+			coin:       "BTC",
+			openOrders: `[{"coin":"ETH","limitPx":"4000","oid":2,"side":"A","sz":"1","timestamp":1}]`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var cancelled []int64
+			exchangeCalls := 0
+			ex := newMockExchange(tt, func(w http.ResponseWriter, r *http.Request) {
+				var payload map[string]any
+				require.NoError(tt, json.NewDecoder(r.Body).Decode(&payload))
+
+				if r.URL.Path == "/info" {
+					require.Equal(tt, "openOrders", payload["type"])
+					_, _ = io.WriteString(w, tc.openOrders)
+					return
+				}
+
+				exchangeCalls++
+				action := payload["action"].(map[string]any)
+				require.Equal(tt, "cancel", action["type"])
+				statuses := make([]string, 0)
+				for _, c := range action["cancels"].([]any) {
+					cancelled = append(cancelled, int64(c.(map[string]any)["o"].(float64)))
+					statuses = append(statuses, `"success"`)
+				}
+				_, _ = io.WriteString(w, `{"status":"ok","response":{"type":"cancel","data":{"statuses":[`+
+					strings.Join(statuses, ",")+`]}}}`)
+			})
+
+			resp, err := ex.CancelAllOrders(context.TODO(), tc.coin)
+			require.NoError(tt, err)
+
+			if len(tc.wantOids) == 0 {
+				require.Nil(tt, resp)
+				require.Zero(tt, exchangeCalls)
+				return
+			}
+
+			require.Equal(tt, 1, exchangeCalls)
+			require.NotNil(tt, resp)
+			require.True(tt, resp.Ok)
+			require.Equal(tt, tc.wantOids, cancelled)
+		})
+	}
+}
+
+func TestCancelAllOrdersQueriedAddress(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+	vault := "0x1111111111111111111111111111111111111111"
+	account := "0x2222222222222222222222222222222222222222"
+
+	cases := []struct {
+		name    string
+		vault   string
+		account string
+		want    string
+	}{
+		{name: "signer fallback", want: signer},
+		{name: "account address", account: account, want: account},
+		{name: "vault over account", vault: vault, account: account, want: vault},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var queried string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var payload map[string]any
+				require.NoError(tt, json.NewDecoder(r.Body).Decode(&payload))
+				queried, _ = payload["user"].(string)
+				_, _ = io.WriteString(w, `[]`)
+			}))
+			defer srv.Close()
+
+			ex := NewExchange(context.TODO(), privateKey, srv.URL, &Meta{}, tc.vault, tc.account, &SpotMeta{})
+			resp, err := ex.CancelAllOrders(context.TODO(), "")
+			require.NoError(tt, err)
+			require.Nil(tt, resp)
+			require.Equal(tt, tc.want, queried)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -294,3 +295,119 @@ func TestOrders(t *testing.T) {
 		})
 	}
 }
+
+// newMockExchange creates an Exchange backed by a local HTTP handler with static metadata,
+// so tests do not need network access.
+func newMockExchange(t *testing.T, handler http.HandlerFunc) *Exchange {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	accountAddr := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+	meta := &Meta{Universe: []AssetInfo{
+		{Name: "BTC", SzDecimals: 5},
+		{Name: "ETH", SzDecimals: 4},
+	}}
+
+	return NewExchange(context.TODO(), privateKey, srv.URL, meta, "", accountAddr, &SpotMeta{})
+}
+
+func TestBatchModify(t *testing.T) {
+	var gotAction map[string]any
+	ex := newMockExchange(t, func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		gotAction = payload["action"].(map[string]any)
+		_, _ = io.WriteString(w, `{"status":"ok","response":{"type":"order","data":{"statuses":[`+
+			`{"resting":{"oid":11}},{"error":"Cannot modify canceled or filled order"}]}}}`)
+	})
+
+	resp, err := ex.BatchModify(context.TODO(), []ModifyOrderRequest{
+		{Oid: int64(1), Order: CreateOrderRequest{
+			Coin: "BTC", IsBuy: true, Price: 50000, Size: 0.001,
+			OrderType: OrderType{Limit: &LimitOrderType{Tif: TifGtc}},
+		}},
+		{Oid: int64(2), Order: CreateOrderRequest{
+			Coin: "ETH", IsBuy: false, Price: 4000, Size: 0.1,
+			OrderType: OrderType{Limit: &LimitOrderType{Tif: TifGtc}},
+		}},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "batchModify", gotAction["type"])
+	require.Len(t, gotAction["modifies"], 2)
+
+	require.Equal(t, "ok", resp.Status)
+	require.Len(t, resp.Data, 2)
+	require.NotNil(t, resp.Data[0].Resting)
+	require.Equal(t, int64(11), resp.Data[0].Resting.Oid)
+	require.EqualError(t, resp.FirstError(), "modify 1: Cannot modify canceled or filled order")
+}
+
+func TestBatchModify_ErrStatus(t *testing.T) {
+	ex := newMockExchange(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"status":"err","response":"User or API Wallet does not exist."}`)
+	})
+
+	resp, err := ex.BatchModify(context.TODO(), []ModifyOrderRequest{
+		{Oid: int64(1), Order: CreateOrderRequest{
+			Coin: "BTC", IsBuy: true, Price: 50000, Size: 0.001,
+			OrderType: OrderType{Limit: &LimitOrderType{Tif: TifGtc}},
+		}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Data)
+	require.EqualError(t, resp.FirstError(), "User or API Wallet does not exist.")
+}
+
+func TestBulkOrders_PartialSuccess(t *testing.T) {
+	ex := newMockExchange(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"status":"ok","response":{"type":"order","data":{"statuses":[`+
+			`{"resting":{"oid":11}},{"error":"Insufficient margin to place order."},`+
+			`{"filled":{"totalSz":"0.1","avgPx":"4000.0","oid":12}},{"error":"Order has invalid price."}]}}}`)
+	})
+
+	orders := []CreateOrderRequest{
+		{Coin: "BTC", IsBuy: true, Price: 50000, Size: 0.001, OrderType: OrderType{Limit: &LimitOrderType{Tif: TifGtc}}},
+		{Coin: "BTC", IsBuy: true, Price: 49000, Size: 0.002, OrderType: OrderType{Limit: &LimitOrderType{Tif: TifGtc}}},
+		{Coin: "ETH", IsBuy: false, Price: 4000, Size: 0.1, OrderType: OrderType{Limit: &LimitOrderType{Tif: TifIoc}}},
+		{Coin: "ETH", IsBuy: false, Price: 1, Size: 0.1, OrderType: OrderType{Limit: &LimitOrderType{Tif: TifGtc}}},
+	}
+	resp, err := ex.BulkOrders(context.TODO(), orders, nil)
+	require.NotNil(t, resp)
+
+	var orderErr *OrderError
+	require.ErrorAs(t, err, &orderErr)
+	require.Equal(t, 1, orderErr.Index)
+	require.EqualError(t, err, "Insufficient margin to place order.")
+
+	var outcomes []OrderOutcome
+	var oids []int64
+	for _, s := range resp.Data.All() {
+		outcomes = append(outcomes, s.Outcome())
+		oids = append(oids, s.Oid())
+	}
+	require.Equal(t, []OrderOutcome{OrderOutcomeResting, OrderOutcomeError, OrderOutcomeFilled, OrderOutcomeError}, outcomes)
+	require.Equal(t, []int64{11, 0, 12, 0}, oids)
+
+	var succeeded []int
+	for i := range resp.Data.Succeeded() {
+		succeeded = append(succeeded, i)
+	}
+	require.Equal(t, []int{0, 2}, succeeded)
+	require.Equal(t, []int{1, 3}, resp.Data.FailedIndices())
+	require.Nil(t, resp.Data.Err(0))
+	require.EqualError(t, resp.Data.Err(3), "Order has invalid price.")
+	require.EqualError(t, resp.Data.Errors(), "Insufficient margin to place order.\nOrder has invalid price.")
+
+	retry, err := FailedOrders(resp.Data, orders)
+	require.NoError(t, err)
+	require.Equal(t, []CreateOrderRequest{orders[1], orders[3]}, retry)
+
+	_, err = FailedOrders(resp.Data, orders[:2])
+	require.Error(t, err)
+}
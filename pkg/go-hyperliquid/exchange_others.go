@@ -17,9 +17,14 @@ func (e *Exchange) UpdateLeverage(
 	name string,
 	isCross bool,
 ) (*UserState, error) {
+	asset, err := e.info.resolveAsset(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
 	action := UpdateLeverageAction{
 		Type:     "updateLeverage",
-		Asset:    e.info.NameToAsset(name),
+		Asset:    asset,
 		IsCross:  isCross,
 		Leverage: leverage,
 	}
@@ -36,9 +41,14 @@ func (e *Exchange) UpdateIsolatedMargin(
 	amount float64,
 	name string,
 ) (*UserState, error) {
+	asset, err := e.info.resolveAsset(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
 	action := UpdateIsolatedMarginAction{
 		Type:  "updateIsolatedMargin",
-		Asset: e.info.NameToAsset(name),
+		Asset: asset,
 		IsBuy: amount > 0,
 		Ntli:  abs(amount),
 	}
@@ -58,7 +68,8 @@ func (e *Exchange) SlippagePrice(
 	slippage float64,
 	px *float64,
 ) (float64, error) {
-	coin := e.info.nameToCoin[name]
+	assets := e.info.assets.Load()
+	coin := assets.nameToCoin[name]
 	var price float64
 
 	if px != nil {
@@ -76,7 +87,7 @@ func (e *Exchange) SlippagePrice(
 		}
 	}
 
-	asset := e.info.coinToAsset[coin]
+	asset := assets.coinToAsset[coin]
 	isSpot := asset >= 10000
 
 	// Calculate slippage
@@ -94,7 +105,7 @@ func (e *Exchange) SlippagePrice(
 	if isSpot {
 		decimals = 8
 	}
-	szDecimals := e.info.assetToDecimal[asset]
+	szDecimals := assets.assetToDecimal[asset]
 
 	return roundToDecimals(price, decimals-szDecimals), nil
 }
@@ -105,25 +116,19 @@ func (e *Exchange) ScheduleCancel(
 	scheduleTime *int64,
 ) (*ScheduleCancelResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams()
 
 	action := ScheduleCancelAction{
 		Type: "scheduleCancel",
 		Time: scheduleTime,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -138,25 +143,19 @@ func (e *Exchange) ScheduleCancel(
 // SetReferrer sets a referral code
 func (e *Exchange) SetReferrer(ctx context.Context, code string) (*SetReferrerResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
 	action := SetReferrerAction{
 		Type: "setReferrer",
 		Code: code,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		"", // No vault address for referrer
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -174,25 +173,19 @@ func (e *Exchange) CreateSubAccount(
 	name string,
 ) (*CreateSubAccountResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
 	action := CreateSubAccountAction{
 		Type: "createSubAccount",
 		Name: name,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		"", // No vault address for sub-account creation
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -211,11 +204,15 @@ func (e *Exchange) UsdClassTransfer(
 	toPerp bool,
 ) (*TransferResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams()
 
+	// The sub-account travels in the amount; like the Python SDK, the action is
+	// signed and posted without a vault address.
 	strAmount := formatFloat(amount)
-	if e.vault != "" {
-		strAmount += " subaccount:" + e.vault
+	if params.vault != "" {
+		strAmount += " subaccount:" + params.vault
 	}
+	params = params.withoutVault()
 
 	action := UsdClassTransferAction{
 		Type:   "usdClassTransfer",
@@ -224,19 +221,12 @@ func (e *Exchange) UsdClassTransfer(
 		Nonce:  nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -256,6 +246,7 @@ func (e *Exchange) SubAccountTransfer(
 	usd int,
 ) (*TransferResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
 	action := SubAccountTransferAction{
 		Type:           "subAccountTransfer",
@@ -264,19 +255,12 @@ func (e *Exchange) SubAccountTransfer(
 		Usd:            usd,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		"", // No vault address
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -296,6 +280,7 @@ func (e *Exchange) VaultUsdTransfer(
 	usd int,
 ) (*TransferResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
 	action := VaultUsdTransferAction{
 		Type:         "vaultTransfer",
@@ -304,19 +289,12 @@ func (e *Exchange) VaultUsdTransfer(
 		Usd:          usd,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		"", // No vault address
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -336,6 +314,7 @@ func (e *Exchange) CreateVault(
 	initialUsd int,
 ) (*CreateVaultResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
 	action := CreateVaultAction{
 		Type:        "createVault",
@@ -344,19 +323,12 @@ func (e *Exchange) CreateVault(
 		InitialUsd:  initialUsd,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		"", // No vault address
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -375,6 +347,7 @@ func (e *Exchange) VaultModify(
 	alwaysCloseOnWithdraw bool,
 ) (*TransferResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
 	action := VaultModifyAction{
 		Type:                  "vaultModify",
@@ -383,19 +356,12 @@ func (e *Exchange) VaultModify(
 		AlwaysCloseOnWithdraw: alwaysCloseOnWithdraw,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		"", // No vault address
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -413,6 +379,7 @@ func (e *Exchange) VaultDistribute(
 	usd int,
 ) (*TransferResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
 	action := VaultDistributeAction{
 		Type:         "vaultDistribute",
@@ -420,19 +387,12 @@ func (e *Exchange) VaultDistribute(
 		Usd:          usd,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		"", // No vault address
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -451,6 +411,7 @@ func (e *Exchange) UsdTransfer(
 	destination string,
 ) (*TransferResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams()
 
 	action := UsdTransferAction{
 		Type:        "usdSend",
@@ -459,19 +420,12 @@ func (e *Exchange) UsdTransfer(
 		Time:        nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -490,6 +444,7 @@ func (e *Exchange) SpotTransfer(
 	destination, token string,
 ) (*TransferResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams()
 
 	action := SpotTransferAction{
 		Type:        "spotSend",
@@ -499,19 +454,12 @@ func (e *Exchange) SpotTransfer(
 		Time:        nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -526,25 +474,19 @@ func (e *Exchange) SpotTransfer(
 // UseBigBlocks enables or disables big blocks
 func (e *Exchange) UseBigBlocks(ctx context.Context, enable bool) (*ApprovalResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
 	action := UseBigBlocksAction{
 		Type:           "evmUserModify",
 		UsingBigBlocks: enable,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		"", // No vault address
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -564,6 +506,7 @@ func (e *Exchange) PerpDexClassTransfer(
 	toPerp bool,
 ) (*TransferResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams()
 
 	action := PerpDexClassTransferAction{
 		Type:   "perpDexClassTransfer",
@@ -573,19 +516,12 @@ func (e *Exchange) PerpDexClassTransfer(
 		ToPerp: toPerp,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -606,6 +542,7 @@ func (e *Exchange) SubAccountSpotTransfer(
 	amount float64,
 ) (*TransferResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams()
 
 	action := SubAccountSpotTransferAction{
 		Type:           "subAccountSpotTransfer",
@@ -615,19 +552,12 @@ func (e *Exchange) SubAccountSpotTransfer(
 		Amount:         amount,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -647,6 +577,7 @@ func (e *Exchange) TokenDelegate(
 	isUndelegate bool,
 ) (*TransferResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams()
 
 	action := TokenDelegateAction{
 		Type:         "tokenDelegate",
@@ -656,19 +587,12 @@ func (e *Exchange) TokenDelegate(
 		Nonce:        nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -687,6 +611,7 @@ func (e *Exchange) WithdrawFromBridge(
 	destination string,
 ) (*TransferResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams()
 
 	action := WithdrawFromBridgeAction{
 		Type:        "withdraw3",
@@ -695,19 +620,12 @@ func (e *Exchange) WithdrawFromBridge(
 		Time:        nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -739,6 +657,7 @@ func (e *Exchange) ApproveAgent(
 
 	agentAddress := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
 	nonce := e.nextNonce()
+	params := e.actionParams()
 
 	action := ApproveAgentAction{
 		Type:         "approveAgent",
@@ -747,19 +666,12 @@ func (e *Exchange) ApproveAgent(
 		Nonce:        nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, "", err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, "", err
 	}
@@ -778,6 +690,7 @@ func (e *Exchange) ApproveBuilderFee(
 	maxFeeRate string,
 ) (*ApprovalResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams()
 
 	action := ApproveBuilderFeeAction{
 		Type:       "approveBuilderFee",
@@ -786,19 +699,12 @@ func (e *Exchange) ApproveBuilderFee(
 		Nonce:      nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -817,6 +723,7 @@ func (e *Exchange) ConvertToMultiSigUser(
 	threshold int,
 ) (*MultiSigConversionResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams()
 
 	// Sort users as done in Python
 	sort.Strings(authorizedUsers)
@@ -837,19 +744,12 @@ func (e *Exchange) ConvertToMultiSigUser(
 		Nonce:   nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -862,6 +762,9 @@ func (e *Exchange) ConvertToMultiSigUser(
 }
 
 // Spot Deploy Methods
+//
+// Deploy and validator actions act on the signer's own account, so they are
+// signed and posted without the vault address but still honor expiresAfter.
 
 // SpotDeployRegisterToken registers a new spot token
 func (e *Exchange) SpotDeployRegisterToken(
@@ -873,33 +776,27 @@ func (e *Exchange) SpotDeployRegisterToken(
 	fullName string,
 ) (*SpotDeployResponse, error) {
 	nonce := e.nextNonce()
-
-	action := map[string]any{
-		"type": "spotDeploy",
-		"registerToken2": map[string]any{
-			"spec": map[string]any{
-				"name":        tokenName,
-				"szDecimals":  szDecimals,
-				"weiDecimals": weiDecimals,
+	params := e.actionParams().withoutVault()
+
+	action := SpotDeployRegisterTokenAction{
+		Type: "spotDeploy",
+		RegisterToken2: SpotDeployRegisterToken{
+			Spec: SpotDeployTokenSpec{
+				Name:        tokenName,
+				SzDecimals:  szDecimals,
+				WeiDecimals: weiDecimals,
 			},
-			"maxGas":   maxGas,
-			"fullName": fullName,
+			MaxGas:   maxGas,
+			FullName: fullName,
 		},
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		"", // No vault address for spot deploy
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -917,25 +814,19 @@ func (e *Exchange) SpotDeployUserGenesis(
 	balances map[string]float64,
 ) (*SpotDeployResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
-	action := map[string]any{
-		"type":     "spotDeployUserGenesis",
-		"balances": balances,
+	action := SpotDeployUserGenesisAction{
+		Type:     "spotDeployUserGenesis",
+		Balances: balances,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -952,24 +843,18 @@ func (e *Exchange) SpotDeployEnableFreezePrivilege(
 	ctx context.Context,
 ) (*SpotDeployResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
-	action := map[string]any{
-		"type": "spotDeployEnableFreezePrivilege",
+	action := SpotDeployFreezePrivilegeAction{
+		Type: "spotDeployEnableFreezePrivilege",
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -987,25 +872,19 @@ func (e *Exchange) SpotDeployFreezeUser(
 	userAddress string,
 ) (*SpotDeployResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
-	action := map[string]any{
-		"type":        "spotDeployFreezeUser",
-		"userAddress": userAddress,
+	action := SpotDeployFreezeUserAction{
+		Type:        "spotDeployFreezeUser",
+		UserAddress: userAddress,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1022,24 +901,18 @@ func (e *Exchange) SpotDeployRevokeFreezePrivilege(
 	ctx context.Context,
 ) (*SpotDeployResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
-	action := map[string]any{
-		"type": "spotDeployRevokeFreezePrivilege",
+	action := SpotDeployFreezePrivilegeAction{
+		Type: "spotDeployRevokeFreezePrivilege",
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1058,26 +931,20 @@ func (e *Exchange) SpotDeployGenesis(
 	dexName string,
 ) (*SpotDeployResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
-	action := map[string]any{
-		"type":     "spotDeployGenesis",
-		"deployer": deployer,
-		"dexName":  dexName,
+	action := SpotDeployGenesisAction{
+		Type:     "spotDeployGenesis",
+		Deployer: deployer,
+		DexName:  dexName,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1096,26 +963,20 @@ func (e *Exchange) SpotDeployRegisterSpot(
 	quoteToken string,
 ) (*SpotDeployResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
-	action := map[string]any{
-		"type":       "spotDeployRegisterSpot",
-		"baseToken":  baseToken,
-		"quoteToken": quoteToken,
+	action := SpotDeployRegisterSpotAction{
+		Type:       "spotDeployRegisterSpot",
+		BaseToken:  baseToken,
+		QuoteToken: quoteToken,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1134,26 +995,20 @@ func (e *Exchange) SpotDeployRegisterHyperliquidity(
 	tokens []string,
 ) (*SpotDeployResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
-	action := map[string]any{
-		"type":   "spotDeployRegisterHyperliquidity",
-		"name":   name,
-		"tokens": tokens,
+	action := SpotDeployRegisterHyperliquidityAction{
+		Type:   "spotDeployRegisterHyperliquidity",
+		Name:   name,
+		Tokens: tokens,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1171,25 +1026,19 @@ func (e *Exchange) SpotDeploySetDeployerTradingFeeShare(
 	feeShare float64,
 ) (*SpotDeployResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
-	action := map[string]any{
-		"type":     "spotDeploySetDeployerTradingFeeShare",
-		"feeShare": feeShare,
+	action := SpotDeploySetDeployerTradingFeeShareAction{
+		Type:     "spotDeploySetDeployerTradingFeeShare",
+		FeeShare: feeShare,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1210,26 +1059,20 @@ func (e *Exchange) PerpDeployRegisterAsset(
 	perpDexInput PerpDexSchemaInput,
 ) (*PerpDeployResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
-	action := map[string]any{
-		"type":         "perpDeployRegisterAsset",
-		"asset":        asset,
-		"perpDexInput": perpDexInput,
+	action := PerpDeployRegisterAssetAction{
+		Type:         "perpDeployRegisterAsset",
+		Asset:        asset,
+		PerpDexInput: perpDexInput,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1248,26 +1091,20 @@ func (e *Exchange) PerpDeploySetOracle(
 	oracleAddress string,
 ) (*SpotDeployResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
-	action := map[string]any{
-		"type":          "perpDeploySetOracle",
-		"asset":         asset,
-		"oracleAddress": oracleAddress,
+	action := PerpDeploySetOracleAction{
+		Type:          "perpDeploySetOracle",
+		Asset:         asset,
+		OracleAddress: oracleAddress,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1284,24 +1121,18 @@ func (e *Exchange) PerpDeploySetOracle(
 // CSignerUnjailSelf unjails self as consensus signer
 func (e *Exchange) CSignerUnjailSelf(ctx context.Context) (*ValidatorResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
 	action := map[string]any{
 		"type": "cSignerUnjailSelf",
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1316,24 +1147,18 @@ func (e *Exchange) CSignerUnjailSelf(ctx context.Context) (*ValidatorResponse, e
 // CSignerJailSelf jails self as consensus signer
 func (e *Exchange) CSignerJailSelf(ctx context.Context) (*ValidatorResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
 	action := map[string]any{
 		"type": "cSignerJailSelf",
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1351,25 +1176,19 @@ func (e *Exchange) CSignerInner(
 	innerAction map[string]any,
 ) (*ValidatorResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
 	action := map[string]any{
 		"type":        "cSignerInner",
 		"innerAction": innerAction,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1389,25 +1208,19 @@ func (e *Exchange) CValidatorRegister(
 	validatorProfile map[string]any,
 ) (*ValidatorResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
 	action := map[string]any{
 		"type":             "cValidatorRegister",
 		"validatorProfile": validatorProfile,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1425,25 +1238,19 @@ func (e *Exchange) CValidatorChangeProfile(
 	newProfile map[string]any,
 ) (*ValidatorResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
 	action := map[string]any{
 		"type":       "cValidatorChangeProfile",
 		"newProfile": newProfile,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1458,24 +1265,18 @@ func (e *Exchange) CValidatorChangeProfile(
 // CValidatorUnregister unregisters as consensus validator
 func (e *Exchange) CValidatorUnregister(ctx context.Context) (*ValidatorResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams().withoutVault()
 
 	action := map[string]any{
 		"type": "cValidatorUnregister",
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(action, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postAction(ctx, action, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1494,6 +1295,7 @@ func (e *Exchange) MultiSig(
 	signatures []string,
 ) (*MultiSigResponse, error) {
 	nonce := e.nextNonce()
+	params := e.actionParams()
 
 	multiSigAction := map[string]any{
 		"type":       "multiSig",
@@ -1502,19 +1304,12 @@ func (e *Exchange) MultiSig(
 		"signatures": signatures,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		multiSigAction,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.isMainnet,
-	)
+	sig, err := e.signL1Action(multiSigAction, nonce, params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, multiSigAction, sig, nonce)
+	resp, err := e.postAction(ctx, multiSigAction, sig, nonce, params)
 	if err != nil {
 		return nil, err
 	}
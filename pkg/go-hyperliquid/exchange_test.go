@@ -1,11 +1,19 @@
 package hyperliquid
 
 import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 	"time"
 
 	"testing/synctest"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNextNonce(t *testing.T) {
@@ -144,3 +152,120 @@ func TestNextNonce_ConcurrencyUniqueness(t *testing.T) {
 		}
 	})
 }
+
+func TestPing(t *testing.T) {
+	ex := newMockExchange(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/info", r.URL.Path)
+		_, _ = io.WriteString(w, `{"BTC":"50000"}`)
+	})
+
+	latency, err := ex.Ping(context.TODO())
+	require.NoError(t, err)
+	require.Positive(t, latency)
+}
+
+func TestPing_Error(t *testing.T) {
+	ex := newMockExchange(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := ex.Ping(context.TODO())
+	require.Error(t, err)
+}
+
+func TestExchangeSettingsValidation(t *testing.T) {
+	var e Exchange
+
+	past := time.Now().Add(-time.Minute).UnixMilli()
+	negative := int64(-1)
+	future := time.Now().Add(time.Hour).UnixMilli()
+
+	var verr ValidationError
+	require.ErrorAs(t, e.SetExpiresAfterChecked(&past), &verr)
+	require.ErrorAs(t, e.SetExpiresAfterChecked(&negative), &verr)
+	require.Nil(t, e.ExpiresAfter())
+
+	require.NoError(t, e.SetExpiresAfterChecked(&future))
+	future++ // the setter keeps its own copy
+	require.Equal(t, future-1, *e.ExpiresAfter())
+	require.NoError(t, e.SetExpiresAfterChecked(nil))
+	require.Nil(t, e.ExpiresAfter())
+
+	// the unchecked setter keeps its original signature and accepts any value
+	e.SetExpiresAfter(&past)
+	require.Equal(t, past, *e.ExpiresAfter())
+	e.SetExpiresAfter(nil)
+	require.Nil(t, e.ExpiresAfter())
+
+	require.ErrorAs(t, e.SetVaultAddress("vault-address"), &verr)
+	require.ErrorAs(t, e.SetVaultAddress("0x123"), &verr)
+	require.ErrorAs(t, e.SetVaultAddress("0xzz11111111111111111111111111111111111111"), &verr)
+	require.Empty(t, e.VaultAddress())
+
+	require.NoError(t, e.SetVaultAddress(" 0xABCDEF1111111111111111111111111111111111 "))
+	require.Equal(t, "0xabcdef1111111111111111111111111111111111", e.VaultAddress())
+	require.NoError(t, e.SetVaultAddress(""))
+	require.Empty(t, e.VaultAddress())
+}
+
+func TestExchangeSettingsAppliedToActions(t *testing.T) {
+	type request struct {
+		Action       map[string]any  `json:"action"`
+		Nonce        int64           `json:"nonce"`
+		VaultAddress *string         `json:"vaultAddress"`
+		ExpiresAfter *int64          `json:"expiresAfter"`
+		Signature    SignatureResult `json:"signature"`
+	}
+	var got request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = request{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = io.WriteString(w, `{}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	meta := &Meta{Universe: []AssetInfo{{Name: "BTC", SzDecimals: 5}}}
+	ex := NewExchange(context.TODO(), key, srv.URL, meta, "", "", &SpotMeta{})
+
+	const vault = "0x1111111111111111111111111111111111111111"
+	expiresAfter := time.Now().Add(time.Hour).UnixMilli()
+	require.NoError(t, ex.SetVaultAddress(vault))
+	ex.SetExpiresAfter(&expiresAfter)
+
+	// the payload carries exactly the vault and expiration the action was signed with
+	verify := func(action any, wantVault string) {
+		t.Helper()
+		if wantVault == "" {
+			require.Nil(t, got.VaultAddress)
+		} else {
+			require.NotNil(t, got.VaultAddress)
+			require.Equal(t, wantVault, *got.VaultAddress)
+		}
+		require.NotNil(t, got.ExpiresAfter)
+		require.Equal(t, expiresAfter, *got.ExpiresAfter)
+
+		want, err := SignL1Action(key, action, wantVault, got.Nonce, &expiresAfter, true)
+		require.NoError(t, err)
+		require.Equal(t, want, got.Signature)
+	}
+
+	_, err = ex.UpdateLeverage(context.TODO(), 5, "BTC", true)
+	require.NoError(t, err)
+	verify(UpdateLeverageAction{Type: "updateLeverage", Asset: 0, IsCross: true, Leverage: 5}, vault)
+
+	_, err = ex.SpotDeployGenesis(context.TODO(), "0xdeployer", "dex")
+	require.NoError(t, err)
+	verify(SpotDeployGenesisAction{Type: "spotDeployGenesis", Deployer: "0xdeployer", DexName: "dex"}, "")
+
+	_, err = ex.CreateSubAccount(context.TODO(), "sub")
+	require.NoError(t, err)
+	verify(CreateSubAccountAction{Type: "createSubAccount", Name: "sub"}, "")
+
+	_, err = ex.UsdClassTransfer(context.TODO(), 10, true)
+	require.NoError(t, err)
+	amount := formatFloat(10) + " subaccount:" + vault
+	require.Equal(t, amount, got.Action["amount"])
+	verify(UsdClassTransferAction{Type: "usdClassTransfer", Amount: amount, ToPerp: true, Nonce: got.Nonce}, "")
+}
@@ -3,8 +3,12 @@ package hyperliquid
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 const (
@@ -12,21 +16,30 @@ const (
 	spotAssetIndexOffset = 10000
 )
 
+// ErrUnknownAsset is returned by exchange actions when a coin is not in the
+// asset map even after refetching meta.
+var ErrUnknownAsset = errors.New("unknown asset")
+
 type Info struct {
-	debug          bool
-	client         *Client
+	debug      bool
+	client     *Client
+	baseURL    string
+	assets     atomic.Pointer[assetMaps]
+	refreshMu  sync.Mutex    // serializes RefreshMeta
+	refreshGen atomic.Uint64 // incremented after each successful refresh
+	clientOpts []ClientOpt
+}
+
+// assetMaps is an immutable snapshot of the name/asset mappings, replaced as
+// a whole on RefreshMeta so readers never observe a partial update.
+type assetMaps struct {
 	coinToAsset    map[string]int
 	nameToCoin     map[string]string
 	assetToDecimal map[int]int
-	clientOpts     []ClientOpt
 }
 
 func NewInfo(ctx context.Context, baseURL string, skipWS bool, meta *Meta, spotMeta *SpotMeta, opts ...InfoOpt) *Info {
-	info := &Info{
-		coinToAsset:    make(map[string]int),
-		nameToCoin:     make(map[string]string),
-		assetToDecimal: make(map[int]int),
-	}
+	info := &Info{baseURL: baseURL}
 
 	for _, opt := range opts {
 		opt.Apply(info)
@@ -40,16 +53,10 @@ func NewInfo(ctx context.Context, baseURL string, skipWS bool, meta *Meta, spotM
 
 	if meta == nil {
 		var err error
-		perpMeta, err := info.PerpMeta(ctx)
+		meta, err = info.combinedPerpMeta(ctx)
 		if err != nil {
 			panic(err)
 		}
-		meta = &Meta{
-			Universe: make([]AssetInfo, 0),
-		}
-		for _, v := range perpMeta {
-			meta.Universe = append(meta.Universe, v.Universe...)
-		}
 	}
 
 	if spotMeta == nil {
@@ -60,20 +67,45 @@ func NewInfo(ctx context.Context, baseURL string, skipWS bool, meta *Meta, spotM
 		}
 	}
 
+	info.assets.Store(newAssetMaps(baseURL, meta, spotMeta))
+	return info
+}
+
+// combinedPerpMeta fetches the perp meta of all dexes as a single universe.
+func (i *Info) combinedPerpMeta(ctx context.Context) (*Meta, error) {
+	perpMeta, err := i.PerpMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+	meta := &Meta{
+		Universe: make([]AssetInfo, 0),
+	}
+	for _, v := range perpMeta {
+		meta.Universe = append(meta.Universe, v.Universe...)
+	}
+	return meta, nil
+}
+
+func newAssetMaps(baseURL string, meta *Meta, spotMeta *SpotMeta) *assetMaps {
+	m := &assetMaps{
+		coinToAsset:    make(map[string]int),
+		nameToCoin:     make(map[string]string),
+		assetToDecimal: make(map[int]int),
+	}
+
 	// Map perp assets
 	for asset, assetInfo := range meta.Universe {
-		coin := assetInfo.Name
-		if strings.Contains(assetInfo.Name, ":") {
-			parts := strings.Split(assetInfo.Name, ":")
-			if len(parts) != 2 || parts[0] != "xyz" {
-				continue
+		m.coinToAsset[assetInfo.Name] = asset
+		m.nameToCoin[assetInfo.Name] = assetInfo.Name
+		m.assetToDecimal[asset] = assetInfo.SzDecimals
+
+		// Builder dex assets ("xyz:TSLA") are also reachable by their bare coin,
+		// unless the main dex or an earlier dex already lists that coin.
+		if _, coin := SplitDexCoin(assetInfo.Name); coin != assetInfo.Name {
+			if _, exists := m.nameToCoin[coin]; !exists {
+				m.nameToCoin[coin] = assetInfo.Name
 			}
-			coin = parts[1]
 		}
-
-		info.coinToAsset[assetInfo.Name] = asset
-		info.nameToCoin[coin] = assetInfo.Name
-		info.assetToDecimal[asset] = assetInfo.SzDecimals
 	}
 
 	tokens := make(map[int]string)
@@ -103,12 +135,74 @@ func NewInfo(ctx context.Context, baseURL string, skipWS bool, meta *Meta, spotM
 		symbol := baseCoin + quoteCoin
 
 		asset := spotInfo.Index + spotAssetIndexOffset
-		info.coinToAsset[spotInfo.Name] = asset
-		info.nameToCoin[symbol] = spotInfo.Name
-		info.assetToDecimal[asset] = baseToken.SzDecimals
+		m.coinToAsset[spotInfo.Name] = asset
+		m.nameToCoin[symbol] = spotInfo.Name
+		m.assetToDecimal[asset] = baseToken.SzDecimals
 	}
 
-	return info
+	return m
+}
+
+// RefreshMeta refetches perp and spot meta and rebuilds the asset map, e.g.
+// after a new coin is listed. Concurrent callers are serialized.
+func (i *Info) RefreshMeta(ctx context.Context) error {
+	i.refreshMu.Lock()
+	defer i.refreshMu.Unlock()
+	return i.refreshMetaLocked(ctx)
+}
+
+func (i *Info) refreshMetaLocked(ctx context.Context) error {
+	meta, err := i.combinedPerpMeta(ctx)
+	if err != nil {
+		return fmt.Errorf("refresh meta: %w", err)
+	}
+	spotMeta, err := i.SpotMeta(ctx)
+	if err != nil {
+		return fmt.Errorf("refresh meta: %w", err)
+	}
+	i.assets.Store(newAssetMaps(i.baseURL, meta, spotMeta))
+	i.refreshGen.Add(1)
+	return nil
+}
+
+// coin returns the exchange coin name for a user-facing name ("BTC", "PURR/USDC"
+// alias, "xyz:TSLA"), or "" if unknown.
+func (i *Info) coin(name string) string {
+	return i.assets.Load().nameToCoin[name]
+}
+
+func (i *Info) lookupAsset(name string) (int, bool) {
+	m := i.assets.Load()
+	coin, ok := m.nameToCoin[name]
+	if !ok {
+		return 0, false
+	}
+	asset, ok := m.coinToAsset[coin]
+	return asset, ok
+}
+
+// resolveAsset returns the asset index for name, refetching meta once when the
+// name is not in the asset map (a coin listed after Info was created).
+func (i *Info) resolveAsset(ctx context.Context, name string) (int, error) {
+	if asset, ok := i.lookupAsset(name); ok {
+		return asset, nil
+	}
+
+	gen := i.refreshGen.Load()
+	i.refreshMu.Lock()
+	// Another caller refreshed while we waited; its map is as fresh as ours would be.
+	if i.refreshGen.Load() == gen {
+		if err := i.refreshMetaLocked(ctx); err != nil {
+			i.refreshMu.Unlock()
+			return 0, fmt.Errorf("%w %q: %w", ErrUnknownAsset, name, err)
+		}
+	}
+	i.refreshMu.Unlock()
+
+	if asset, ok := i.lookupAsset(name); ok {
+		return asset, nil
+	}
+	return 0, fmt.Errorf("%w %q", ErrUnknownAsset, name)
 }
 
 // postTimeRangeRequest makes a POST request with time range parameters
@@ -287,9 +381,12 @@ func (i *Info) SpotMeta(ctx context.Context) (*SpotMeta, error) {
 	return &spotMeta, nil
 }
 
+// NameToAsset returns the asset index for name, or 0 if unknown. Exchange
+// actions resolve names through the asset map and refetch meta on a miss; use
+// RefreshMeta to pick up newly listed coins here.
 func (i *Info) NameToAsset(name string) int {
-	coin := i.nameToCoin[name]
-	return i.coinToAsset[coin]
+	asset, _ := i.lookupAsset(name)
+	return asset
 }
 
 func (i *Info) UserState(ctx context.Context, address, dex string) (*UserState, error) {
@@ -372,6 +469,18 @@ func (i *Info) AllMids(ctx context.Context) (map[string]string, error) {
 	return result, nil
 }
 
+// Ping issues a lightweight info request and returns the round-trip latency.
+// The response body is not decoded; any transport or HTTP error is returned.
+func (i *Info) Ping(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+	if _, err := i.client.post(ctx, "/info", map[string]any{
+		"type": "allMids",
+	}); err != nil {
+		return time.Since(start), fmt.Errorf("failed to ping: %w", err)
+	}
+	return time.Since(start), nil
+}
+
 func (i *Info) UserFills(ctx context.Context, address string) ([]Fill, error) {
 	resp, err := i.client.post(ctx, "/info", map[string]any{
 		"type": "userFills",
@@ -502,7 +611,7 @@ func (i *Info) FundingHistory(
 	startTime int64,
 	endTime *int64,
 ) ([]FundingHistory, error) {
-	coin := i.nameToCoin[name]
+	coin := i.coin(name)
 	resp, err := i.postTimeRangeRequest(
 		ctx,
 		"fundingHistory",
@@ -543,7 +652,7 @@ func (i *Info) UserFundingHistory(
 func (i *Info) L2Snapshot(ctx context.Context, name string) (*L2Book, error) {
 	resp, err := i.client.post(ctx, "/info", map[string]any{
 		"type": "l2Book",
-		"coin": i.nameToCoin[name],
+		"coin": i.coin(name),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch L2 snapshot: %w", err)
@@ -562,7 +671,7 @@ func (i *Info) CandlesSnapshot(
 	startTime, endTime int64,
 ) ([]Candle, error) {
 	req := map[string]any{
-		"coin":      i.nameToCoin[name],
+		"coin":      i.coin(name),
 		"interval":  interval,
 		"startTime": startTime,
 		"endTime":   endTime,
@@ -599,6 +708,23 @@ func (i *Info) UserFees(ctx context.Context, address string) (*UserFees, error)
 	return &result, nil
 }
 
+// UserRateLimit returns the address-based REST request budget of address.
+func (i *Info) UserRateLimit(ctx context.Context, address string) (*UserRateLimit, error) {
+	resp, err := i.client.post(ctx, "/info", map[string]any{
+		"type": "userRateLimit",
+		"user": address,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user rate limit: %w", err)
+	}
+
+	var result UserRateLimit
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal user rate limit: %w", err)
+	}
+	return &result, nil
+}
+
 func (i *Info) UserActiveAssetData(
 	ctx context.Context,
 	address string,
@@ -692,6 +818,8 @@ func (i *Info) QueryOrderByOid(
 	return &result, nil
 }
 
+// QueryOrderByCloid queries an order status by a raw cloid string. The value is
+// sent as-is; prefer OrderStatusByCloid, which validates and normalizes the id.
 func (i *Info) QueryOrderByCloid(
 	ctx context.Context,
 	user, cloid string,
@@ -712,6 +840,43 @@ func (i *Info) QueryOrderByCloid(
 	return &result, nil
 }
 
+// OrderStatusByCloid queries an order status by client order id. The orderStatus
+// request takes either an oid or a cloid in its "oid" field; the cloid is sent in
+// normalized form and the returned order must carry the same cloid, so a lookup
+// never silently resolves to a different order.
+func (i *Info) OrderStatusByCloid(
+	ctx context.Context,
+	user string,
+	cloid Cloid,
+) (*OrderQueryResult, error) {
+	normalized, err := NewCloid(cloid.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := i.client.post(ctx, "/info", map[string]any{
+		"type": "orderStatus",
+		"user": user,
+		"oid":  normalized.Value,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch order status by cloid: %w", err)
+	}
+
+	var result OrderQueryResult
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal order status: %w", err)
+	}
+
+	if result.Status == OrderQueryStatusSuccess {
+		got := result.Order.Order.Cloid
+		if got == nil || !normalized.Equal(*got) {
+			return nil, fmt.Errorf("order status cloid mismatch: requested %s", normalized.Value)
+		}
+	}
+	return &result, nil
+}
+
 func (i *Info) QueryReferralState(ctx context.Context, user string) (*ReferralState, error) {
 	resp, err := i.client.post(ctx, "/info", map[string]any{
 		"type": "referral",
@@ -763,8 +928,111 @@ func (i *Info) QueryUserToMultiSigSigners(
 	return result, nil
 }
 
-// PerpDexs returns the list of available perpetual dexes
-func (i *Info) PerpDexs(ctx context.Context) ([]string, error) {
+// ErrVaultNotFound is returned when the requested address is not a vault.
+var ErrVaultNotFound = errors.New("vault not found")
+
+// VaultDetails returns the details of a vault. Addresses that are not vaults
+// yield ErrVaultNotFound.
+func (i *Info) VaultDetails(ctx context.Context, vaultAddress string) (*VaultDetails, error) {
+	return i.vaultDetails(ctx, vaultAddress, "")
+}
+
+// VaultDetailsForUser is like VaultDetails and additionally fills
+// FollowerState with the given user's position in the vault.
+func (i *Info) VaultDetailsForUser(
+	ctx context.Context,
+	vaultAddress, user string,
+) (*VaultDetails, error) {
+	return i.vaultDetails(ctx, vaultAddress, user)
+}
+
+func (i *Info) vaultDetails(ctx context.Context, vaultAddress, user string) (*VaultDetails, error) {
+	payload := map[string]any{
+		"type":         "vaultDetails",
+		"vaultAddress": vaultAddress,
+	}
+	if user != "" {
+		payload["user"] = user
+	}
+
+	resp, err := i.client.post(ctx, "/info", payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch vault details: %w", err)
+	}
+
+	var result *VaultDetails
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal vault details: %w", err)
+	}
+	if result == nil {
+		return nil, fmt.Errorf("%w: %s", ErrVaultNotFound, vaultAddress)
+	}
+	return result, nil
+}
+
+// ErrTokenNotFound is returned when the requested token id does not exist.
+var ErrTokenNotFound = errors.New("token not found")
+
+// TokenDetails returns supply, deployer and genesis information of a spot
+// token. tokenID is the token's hex id (SpotTokenInfo.TokenID); unknown ids
+// yield ErrTokenNotFound.
+func (i *Info) TokenDetails(ctx context.Context, tokenID string) (*TokenDetails, error) {
+	resp, err := i.client.post(ctx, "/info", map[string]any{
+		"type":    "tokenDetails",
+		"tokenId": tokenID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch token details: %w", err)
+	}
+
+	var result *TokenDetails
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token details: %w", err)
+	}
+	if result == nil {
+		return nil, fmt.Errorf("%w: %s", ErrTokenNotFound, tokenID)
+	}
+	return result, nil
+}
+
+// UserVaultEquities returns the vaults the user has deposited into.
+func (i *Info) UserVaultEquities(ctx context.Context, address string) ([]UserVaultEquity, error) {
+	resp, err := i.client.post(ctx, "/info", map[string]any{
+		"type": "userVaultEquities",
+		"user": address,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user vault equities: %w", err)
+	}
+
+	var result []UserVaultEquity
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal user vault equities: %w", err)
+	}
+	return result, nil
+}
+
+// DelegatorHistory returns the user's staking history: delegations,
+// undelegations, deposits into and withdrawals from the staking balance.
+func (i *Info) DelegatorHistory(ctx context.Context, address string) ([]DelegatorEvent, error) {
+	resp, err := i.client.post(ctx, "/info", map[string]any{
+		"type": "delegatorHistory",
+		"user": address,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch delegator history: %w", err)
+	}
+
+	var result []DelegatorEvent
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal delegator history: %w", err)
+	}
+	return result, nil
+}
+
+// PerpDexs returns the list of available perpetual dexes in the order used by allPerpMetas.
+// The main dex is reported as null by the API and returned as a PerpDex with an empty Name.
+func (i *Info) PerpDexs(ctx context.Context) ([]PerpDex, error) {
 	resp, err := i.client.post(ctx, "/info", map[string]any{
 		"type": "perpDexs",
 	})
@@ -772,9 +1040,25 @@ func (i *Info) PerpDexs(ctx context.Context) ([]string, error) {
 		return nil, fmt.Errorf("failed to fetch perp dexs: %w", err)
 	}
 
-	var result []string
-	if err := json.Unmarshal(resp, &result); err != nil {
+	var raw []*PerpDex
+	if err := json.Unmarshal(resp, &raw); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal perp dexs: %w", err)
 	}
+	result := make([]PerpDex, len(raw))
+	for idx, dex := range raw {
+		if dex != nil {
+			result[idx] = *dex
+		}
+	}
 	return result, nil
 }
+
+// SplitDexCoin splits a perp asset name of a builder-deployed dex ("xyz:TSLA") into
+// its dex and coin. Assets of the main dex return an empty dex.
+func SplitDexCoin(name string) (dex, coin string) {
+	dex, coin, found := strings.Cut(name, ":")
+	if !found || dex == "" || coin == "" {
+		return "", name
+	}
+	return dex, coin
+}
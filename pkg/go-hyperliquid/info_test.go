@@ -2,8 +2,14 @@ package hyperliquid
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -675,3 +681,353 @@ func TestUserActiveAssetData(t *testing.T) {
 		})
 	}
 }
+
+func TestUserRateLimit(t *testing.T) {
+	var payload map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		_, _ = io.WriteString(w, `{"cumVlm":"2854574.593578","nRequestsUsed":2890,"nRequestsCap":12864,"nRequestsSurplus":0}`)
+	}))
+	defer srv.Close()
+
+	info := NewInfo(context.TODO(), srv.URL, true, &Meta{}, &SpotMeta{})
+	res, err := info.UserRateLimit(context.TODO(), "0xabc")
+	require.NoError(t, err)
+
+	assert.Equal(t, "userRateLimit", payload["type"])
+	assert.Equal(t, "0xabc", payload["user"])
+	assert.Equal(t, "2854574.593578", res.CumVlm)
+	assert.Equal(t, int64(2890), res.NRequestsUsed)
+	assert.Equal(t, int64(12864), res.NRequestsCap)
+	assert.Equal(t, int64(9974), res.Remaining())
+	assert.InDelta(t, 0.2247, res.UsedRatio(), 0.0001)
+}
+
+func TestUserRateLimit_Exhausted(t *testing.T) {
+	r := UserRateLimit{NRequestsUsed: 120, NRequestsCap: 100}
+	assert.Equal(t, int64(0), r.Remaining())
+	assert.Equal(t, 1.2, r.UsedRatio())
+	assert.Equal(t, 0.0, UserRateLimit{}.UsedRatio())
+}
+
+func TestVaultDetails(t *testing.T) {
+	var payload map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		if payload["vaultAddress"] != "0xdfc24b077bc1425ad1dea75bcb6f8158e10df303" {
+			_, _ = io.WriteString(w, `null`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"name":"Hyperliquidity Provider (HLP)","vaultAddress":"0xdfc24b077bc1425ad1dea75bcb6f8158e10df303","leader":"0x677d831aef5328190852e24f13c46cac05f984e7","description":"This community-owned vault provides liquidity to Hyperliquid.","apr":0.0361,"leaderFraction":0.0,"leaderCommission":0.0,"maxDistributable":0.0,"maxWithdrawable":0.0,"isClosed":false,"allowDeposits":true}`)
+	}))
+	defer srv.Close()
+
+	info := NewInfo(context.TODO(), srv.URL, true, &Meta{}, &SpotMeta{})
+	res, err := info.VaultDetails(context.TODO(), "0xdfc24b077bc1425ad1dea75bcb6f8158e10df303")
+	require.NoError(t, err)
+
+	assert.Equal(t, "vaultDetails", payload["type"])
+	assert.Equal(t, "Hyperliquidity Provider (HLP)", res.Name)
+	assert.Equal(t, "0x677d831aef5328190852e24f13c46cac05f984e7", res.Leader)
+	assert.InDelta(t, 0.0361, res.Apr, 1e-9)
+	assert.True(t, res.AllowDeposits)
+
+	_, err = info.VaultDetails(context.TODO(), "0xabc")
+	assert.ErrorIs(t, err, ErrVaultNotFound)
+}
+
+func TestVaultDetails_PortfolioAndFollowers(t *testing.T) {
+	var payload map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		_, _ = io.WriteString(w, `{"name":"Test Vault","vaultAddress":"0x1","leader":"0x2","description":"","portfolio":[["day",{"accountValueHistory":[[1734397526634,"1000.5"],[1734483926634,"1010.0"]],"pnlHistory":[[1734397526634,"0.0"],[1734483926634,"9.5"]],"vlm":"2500.0"}],["allTime",{"accountValueHistory":[],"pnlHistory":[],"vlm":"0.0"}]],"apr":0.12,"followerState":{"user":"0x3","vaultEquity":"500.0","pnl":"12.5","allTimePnl":"30.0","daysFollowing":4,"vaultEntryTime":1734000000000,"lockupUntil":1734500000000},"leaderFraction":0.1,"leaderCommission":0.1,"followers":[{"user":"0x3","vaultEquity":"500.0","pnl":"12.5","allTimePnl":"30.0","daysFollowing":4,"vaultEntryTime":1734000000000,"lockupUntil":1734500000000}],"maxDistributable":100.0,"maxWithdrawable":90.0,"isClosed":false,"relationship":{"type":"parent","data":{"childAddresses":["0x4","0x5"]}},"allowDeposits":true,"alwaysCloseOnWithdraw":false}`)
+	}))
+	defer srv.Close()
+
+	info := NewInfo(context.TODO(), srv.URL, true, &Meta{}, &SpotMeta{})
+	res, err := info.VaultDetailsForUser(context.TODO(), "0x1", "0x3")
+	require.NoError(t, err)
+	assert.Equal(t, "0x3", payload["user"])
+
+	day, ok := res.PortfolioPeriod("day")
+	require.True(t, ok)
+	require.Len(t, day.AccountValueHistory, 2)
+	assert.Equal(t, int64(1734483926634), day.AccountValueHistory[1].First)
+	assert.Equal(t, "9.5", day.PnlHistory[1].Second)
+	assert.Equal(t, "2500.0", day.Vlm)
+	_, ok = res.PortfolioPeriod("week")
+	assert.False(t, ok)
+
+	require.NotNil(t, res.FollowerState)
+	assert.Equal(t, "500.0", res.FollowerState.VaultEquity)
+	require.Len(t, res.Followers, 1)
+	assert.Equal(t, 4, res.Followers[0].DaysFollowing)
+	require.NotNil(t, res.Relationship)
+	assert.Equal(t, "parent", res.Relationship.Type)
+	assert.Equal(t, []string{"0x4", "0x5"}, res.Relationship.Data.ChildAddresses)
+
+	payload = nil
+	_, err = info.VaultDetails(context.TODO(), "0x1")
+	require.NoError(t, err)
+	assert.NotContains(t, payload, "user")
+}
+
+func TestTokenDetails(t *testing.T) {
+	var payload map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		if payload["tokenId"] != "0x3d8a82efa63e86d54a1922c2afdac61e" {
+			_, _ = io.WriteString(w, `null`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"name":"TEST","maxSupply":"1852229076.12716007","totalSupply":"851681534.05516005","circulatingSupply":"851681534.05516005","szDecimals":0,"weiDecimals":5,"midPx":null,"markPx":"3.2025","prevDayPx":"3.2025","genesis":{"userBalances":[["0x0000000000000000000000000000000000000001","1000000000.0"]],"existingTokenBalances":[[1,"500.0"]]},"deployer":"0x0000000000000000000000000000000000000001","deployGas":"100.0","deployTime":"2024-06-05T10:50:57.943","seededUsdc":"0.0","nonCirculatingUserBalances":[["0x0000000000000000000000000000000000000002","10.0"]],"futureEmissions":"0.0"}`)
+	}))
+	defer srv.Close()
+
+	info := NewInfo(context.TODO(), srv.URL, true, &Meta{}, &SpotMeta{})
+	res, err := info.TokenDetails(context.TODO(), "0x3d8a82efa63e86d54a1922c2afdac61e")
+	require.NoError(t, err)
+
+	assert.Equal(t, "tokenDetails", payload["type"])
+	assert.Equal(t, "TEST", res.Name)
+	assert.Equal(t, "851681534.05516005", res.CirculatingSupply)
+	assert.Equal(t, 0, res.SzDecimals)
+	assert.Equal(t, 5, res.WeiDecimals)
+	assert.Empty(t, res.MidPx)
+	assert.Equal(t, "0x0000000000000000000000000000000000000001", res.Deployer)
+	require.NotNil(t, res.Genesis)
+	require.Len(t, res.Genesis.UserBalances, 1)
+	assert.Equal(t, "1000000000.0", res.Genesis.UserBalances[0].Second)
+	require.Len(t, res.Genesis.ExistingTokenBalances, 1)
+	assert.Equal(t, 1, res.Genesis.ExistingTokenBalances[0].First)
+	require.Len(t, res.NonCirculatingUserBalances, 1)
+	assert.Equal(t, "10.0", res.NonCirculatingUserBalances[0].Second)
+
+	_, err = info.TokenDetails(context.TODO(), "0xabc")
+	assert.ErrorIs(t, err, ErrTokenNotFound)
+}
+
+func TestSpotMeta_TokenByID(t *testing.T) {
+	meta := &SpotMeta{Tokens: []SpotTokenInfo{
+		{Name: "USDC", TokenID: "0x6d1e7cde53ba9467b783cb7c530ce054"},
+		{Name: "HYPE", TokenID: "0x0d01dc56dcaaca66ad901c959b4011ec", WeiDecimals: 8, SzDecimals: 2,
+			EvmContract: &EvmContract{Address: "0x2222222222222222222222222222222222222222"}},
+	}}
+
+	token, ok := meta.TokenByID("0x0D01DC56DCAACA66AD901C959B4011EC")
+	require.True(t, ok)
+	assert.Equal(t, "HYPE", token.Name)
+	assert.Equal(t, 8, token.WeiDecimals)
+	require.NotNil(t, token.EvmContract)
+
+	_, ok = meta.TokenByID("0x1")
+	assert.False(t, ok)
+}
+
+func TestUserVaultEquities(t *testing.T) {
+	var payload map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		_, _ = io.WriteString(w, `[{"vaultAddress":"0xdfc24b077bc1425ad1dea75bcb6f8158e10df303","equity":"742500.082809","lockedUntilTimestamp":1734500000000}]`)
+	}))
+	defer srv.Close()
+
+	info := NewInfo(context.TODO(), srv.URL, true, &Meta{}, &SpotMeta{})
+	res, err := info.UserVaultEquities(context.TODO(), "0xabc")
+	require.NoError(t, err)
+
+	assert.Equal(t, "userVaultEquities", payload["type"])
+	assert.Equal(t, "0xabc", payload["user"])
+	require.Len(t, res, 1)
+	assert.Equal(t, "742500.082809", res[0].Equity)
+	assert.Equal(t, int64(1734500000000), res[0].LockedUntilTimestamp)
+}
+
+func TestDelegatorHistory(t *testing.T) {
+	var payload map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		_, _ = io.WriteString(w, `[
+			{"time":1735380381000,"hash":"0x1","delta":{"delegate":{"validator":"0x5ac9","amount":"10000.0","isUndelegate":true}}},
+			{"time":1735380000000,"hash":"0x2","delta":{"cDeposit":{"amount":"10000.0"}}},
+			{"time":1735370000000,"hash":"0x3","delta":{"withdrawal":{"amount":"5.0","phase":"initiated"}}}
+		]`)
+	}))
+	defer srv.Close()
+
+	info := NewInfo(context.TODO(), srv.URL, true, &Meta{}, &SpotMeta{})
+	res, err := info.DelegatorHistory(context.TODO(), "0xabc")
+	require.NoError(t, err)
+
+	assert.Equal(t, "delegatorHistory", payload["type"])
+	require.Len(t, res, 3)
+	require.NotNil(t, res[0].Delta.Delegate)
+	assert.True(t, res[0].Delta.Delegate.IsUndelegate)
+	assert.Equal(t, "0x5ac9", res[0].Delta.Delegate.Validator)
+	require.NotNil(t, res[1].Delta.CDeposit)
+	assert.Equal(t, "10000.0", res[1].Delta.CDeposit.Amount)
+	assert.Nil(t, res[1].Delta.Delegate)
+	require.NotNil(t, res[2].Delta.Withdrawal)
+	assert.Equal(t, "initiated", res[2].Delta.Withdrawal.Phase)
+}
+
+func TestPerpDexs(t *testing.T) {
+	var payload map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		_, _ = io.WriteString(w, `[null,{"name":"xyz","fullName":"XYZ","deployer":"0x88806a71d74ad0a510b350545c9ae490912f0888","oracleUpdater":null}]`)
+	}))
+	defer srv.Close()
+
+	info := NewInfo(context.TODO(), srv.URL, true, &Meta{}, &SpotMeta{})
+	dexes, err := info.PerpDexs(context.TODO())
+	require.NoError(t, err)
+
+	assert.Equal(t, "perpDexs", payload["type"])
+	require.Len(t, dexes, 2)
+	assert.Equal(t, PerpDex{}, dexes[0])
+	assert.Equal(t, "xyz", dexes[1].Name)
+	assert.Equal(t, "XYZ", dexes[1].FullName)
+}
+
+func TestNewInfoBuilderDexAssets(t *testing.T) {
+	meta := &Meta{Universe: []AssetInfo{
+		{Name: "BTC", SzDecimals: 5},
+		{Name: "xyz:BTC", SzDecimals: 4},
+		{Name: "xyz:TSLA", SzDecimals: 3},
+		{Name: "flx:GOLD", SzDecimals: 2},
+	}}
+	info := NewInfo(context.TODO(), MainnetAPIURL, true, meta, &SpotMeta{})
+
+	assert.Equal(t, 0, info.NameToAsset("BTC"), "main dex keeps the bare coin")
+	assert.Equal(t, 1, info.NameToAsset("xyz:BTC"))
+	assert.Equal(t, 2, info.NameToAsset("TSLA"))
+	assert.Equal(t, 3, info.NameToAsset("flx:GOLD"))
+	assert.Equal(t, 3, info.NameToAsset("GOLD"))
+}
+
+func TestInfoRefreshMeta(t *testing.T) {
+	var metaCalls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		switch payload["type"] {
+		case "allPerpMetas":
+			metaCalls++
+			_, _ = io.WriteString(w, `[{"universe":[{"name":"BTC","szDecimals":5},{"name":"NEWCOIN","szDecimals":1}],"marginTables":[]}]`)
+		case "spotMeta":
+			_, _ = io.WriteString(w, `{"universe":[{"name":"@1","tokens":[1,0],"index":1}],"tokens":[{"name":"USDC","index":0,"szDecimals":8},{"name":"NEWTOKEN","index":1,"szDecimals":2}]}`)
+		default:
+			t.Errorf("unexpected request type %v", payload["type"])
+		}
+	}))
+	defer srv.Close()
+
+	info := NewInfo(context.TODO(), srv.URL, true, &Meta{Universe: []AssetInfo{{Name: "BTC", SzDecimals: 5}}}, &SpotMeta{})
+
+	// Known names resolve without refetching meta
+	asset, err := info.resolveAsset(context.TODO(), "BTC")
+	require.NoError(t, err)
+	assert.Equal(t, 0, asset)
+	assert.Equal(t, 0, metaCalls)
+
+	// A coin listed after startup triggers a single refresh
+	assert.Equal(t, 0, info.NameToAsset("NEWCOIN"))
+	asset, err = info.resolveAsset(context.TODO(), "NEWCOIN")
+	require.NoError(t, err)
+	assert.Equal(t, 1, asset)
+	assert.Equal(t, 1, metaCalls)
+	assert.Equal(t, 1, info.NameToAsset("NEWCOIN"))
+	assert.Equal(t, 10001, info.NameToAsset("NEWTOKENUSDC"))
+
+	// Still unknown after the refresh
+	_, err = info.resolveAsset(context.TODO(), "NOPE")
+	assert.ErrorIs(t, err, ErrUnknownAsset)
+	assert.Equal(t, 2, metaCalls)
+
+	require.NoError(t, info.RefreshMeta(context.TODO()))
+	assert.Equal(t, 3, metaCalls)
+}
+
+func TestSplitDexCoin(t *testing.T) {
+	for name, want := range map[string][2]string{
+		"BTC":      {"", "BTC"},
+		"xyz:TSLA": {"xyz", "TSLA"},
+		"@107":     {"", "@107"},
+		":BTC":     {"", ":BTC"},
+	} {
+		dex, coin := SplitDexCoin(name)
+		assert.Equal(t, want, [2]string{dex, coin}, name)
+	}
+}
+
+type countingTransport struct {
+	calls int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestInfoOptHTTPClientAndMiddleware(t *testing.T) {
+	var traceHeader string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceHeader = r.Header.Get("X-Trace-Id")
+		_, _ = io.WriteString(w, `[{"name":"xyz"}]`)
+	}))
+	defer srv.Close()
+
+	transport := &countingTransport{}
+	var order []string
+	var statuses []int
+	tracing := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			order = append(order, "tracing")
+			req.Header.Set("X-Trace-Id", "t-1")
+			resp, err := next.Do(req)
+			if err == nil {
+				statuses = append(statuses, resp.StatusCode)
+			}
+			return resp, err
+		})
+	}
+	logging := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			order = append(order, "logging")
+			return next.Do(req)
+		})
+	}
+
+	info := NewInfo(context.TODO(), srv.URL, true, &Meta{}, &SpotMeta{},
+		InfoOptHTTPClient(&http.Client{Transport: transport}),
+		InfoOptMiddleware(tracing, logging),
+	)
+	dexes, err := info.PerpDexs(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, dexes, 1)
+
+	assert.Equal(t, 1, transport.calls, "requests go through the injected client")
+	assert.Equal(t, "t-1", traceHeader)
+	assert.Equal(t, []string{"tracing", "logging"}, order, "first middleware is outermost")
+	assert.Equal(t, []int{http.StatusOK}, statuses)
+}
+
+func TestClientOptMiddlewareShortCircuit(t *testing.T) {
+	cached := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`[null]`)),
+				Request:    req,
+			}, nil
+		})
+	}
+
+	// Nothing listens on port 0: the request would fail if it reached the network
+	info := NewInfo(context.TODO(), "http://127.0.0.1:0", true, &Meta{}, &SpotMeta{},
+		InfoOptClientOptions(ClientOptMiddleware(cached)))
+	dexes, err := info.PerpDexs(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, []PerpDex{{}}, dexes)
+}
@@ -0,0 +1,249 @@
+package hyperliquid
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/scrypt"
+)
+
+// ErrKeystoreMAC is returned when a keystore cannot be decrypted with the given passphrase.
+var ErrKeystoreMAC = errors.New("could not decrypt keystore with given passphrase")
+
+// KeySource supplies the signing key of an Exchange. Loading the key through a KeySource
+// lets services keep it in an encrypted keystore or behind a KMS instead of placing the
+// raw hex key in their configuration files. See NewExchangeFromKeySource.
+type KeySource interface {
+	PrivateKey(ctx context.Context) (*ecdsa.PrivateKey, error)
+}
+
+// KeySourceFunc adapts a function to KeySource, e.g. to plug in an age or vault backend.
+type KeySourceFunc func(ctx context.Context) (*ecdsa.PrivateKey, error)
+
+func (f KeySourceFunc) PrivateKey(ctx context.Context) (*ecdsa.PrivateKey, error) {
+	return f(ctx)
+}
+
+// PassphraseFunc returns the passphrase of an encrypted keystore.
+type PassphraseFunc func(ctx context.Context) (string, error)
+
+// PassphraseFromEnv reads the keystore passphrase from an environment variable.
+func PassphraseFromEnv(name string) PassphraseFunc {
+	return func(context.Context) (string, error) {
+		passphrase, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("keystore passphrase: environment variable %s is not set", name)
+		}
+		return passphrase, nil
+	}
+}
+
+// HexKeySource returns the key encoded as hex, with or without 0x prefix.
+func HexKeySource(hexKey string) KeySource {
+	return KeySourceFunc(func(context.Context) (*ecdsa.PrivateKey, error) {
+		return parseHexKey(hexKey)
+	})
+}
+
+// EnvKeySource reads the hex encoded key from an environment variable when it is needed.
+func EnvKeySource(name string) KeySource {
+	return KeySourceFunc(func(context.Context) (*ecdsa.PrivateKey, error) {
+		hexKey, ok := os.LookupEnv(name)
+		if !ok {
+			return nil, fmt.Errorf("private key: environment variable %s is not set", name)
+		}
+		return parseHexKey(hexKey)
+	})
+}
+
+// KeystoreFileSource decrypts a geth (Web3 Secret Storage v3) keystore file,
+// as written by `geth account new` or `cast wallet import`.
+func KeystoreFileSource(path string, passphrase PassphraseFunc) KeySource {
+	return KeySourceFunc(func(ctx context.Context) (*ecdsa.PrivateKey, error) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read keystore: %w", err)
+		}
+		pass, err := passphrase(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return DecryptKeystore(data, pass)
+	})
+}
+
+// Decrypter decrypts key material, typically a KMS client (AWS KMS Decrypt, GCP KMS, Vault transit).
+type Decrypter interface {
+	Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error)
+}
+
+// EncryptedKeySource decrypts an envelope encrypted key with the given Decrypter.
+// The plaintext may be the 32 raw key bytes or the hex encoded key.
+func EncryptedKeySource(ciphertext []byte, decrypter Decrypter) KeySource {
+	return KeySourceFunc(func(ctx context.Context) (*ecdsa.PrivateKey, error) {
+		plaintext, err := decrypter.Decrypt(ctx, ciphertext)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt private key: %w", err)
+		}
+		defer clear(plaintext)
+		if len(plaintext) == 32 {
+			return crypto.ToECDSA(plaintext)
+		}
+		return parseHexKey(string(bytes.TrimSpace(plaintext)))
+	})
+}
+
+func parseHexKey(hexKey string) (*ecdsa.PrivateKey, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(hexKey), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	return key, nil
+}
+
+// NewExchangeFromKeySource is like NewExchange but loads the signing key from source.
+func NewExchangeFromKeySource(
+	ctx context.Context,
+	source KeySource,
+	baseURL string,
+	meta *Meta,
+	vaultAddr, accountAddr string,
+	spotMeta *SpotMeta,
+	opts ...ExchangeOpt,
+) (*Exchange, error) {
+	if source == nil {
+		return nil, ValidationError{Field: "keySource", Message: "key source is required"}
+	}
+	privateKey, err := source.PrivateKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load private key: %w", err)
+	}
+	return NewExchange(ctx, privateKey, baseURL, meta, vaultAddr, accountAddr, spotMeta, opts...), nil
+}
+
+type keystoreJSON struct {
+	Address string `json:"address"`
+	Crypto  struct {
+		Cipher       string `json:"cipher"`
+		CipherText   string `json:"ciphertext"`
+		CipherParams struct {
+			IV string `json:"iv"`
+		} `json:"cipherparams"`
+		KDF       string          `json:"kdf"`
+		KDFParams json.RawMessage `json:"kdfparams"`
+		MAC       string          `json:"mac"`
+	} `json:"crypto"`
+	Version int `json:"version"`
+}
+
+type keystoreKDFParams struct {
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	// scrypt
+	N int `json:"n"`
+	R int `json:"r"`
+	P int `json:"p"`
+	// pbkdf2
+	C   int    `json:"c"`
+	PRF string `json:"prf"`
+}
+
+// DecryptKeystore decrypts a Web3 Secret Storage v3 keystore (scrypt or pbkdf2, aes-128-ctr).
+func DecryptKeystore(data []byte, passphrase string) (*ecdsa.PrivateKey, error) {
+	var ks keystoreJSON
+	if err := json.Unmarshal(data, &ks); err != nil {
+		return nil, fmt.Errorf("parse keystore: %w", err)
+	}
+	if ks.Version != 3 {
+		return nil, fmt.Errorf("unsupported keystore version %d", ks.Version)
+	}
+	if ks.Crypto.Cipher != "aes-128-ctr" {
+		return nil, fmt.Errorf("unsupported keystore cipher %q", ks.Crypto.Cipher)
+	}
+
+	var params keystoreKDFParams
+	if err := json.Unmarshal(ks.Crypto.KDFParams, &params); err != nil {
+		return nil, fmt.Errorf("parse keystore kdfparams: %w", err)
+	}
+	derivedKey, err := deriveKeystoreKey(ks.Crypto.KDF, params, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(derivedKey)
+	if len(derivedKey) < 32 {
+		return nil, fmt.Errorf("keystore dklen %d is too short", len(derivedKey))
+	}
+
+	cipherText, err := hex.DecodeString(ks.Crypto.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keystore ciphertext: %w", err)
+	}
+	mac, err := hex.DecodeString(ks.Crypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keystore mac: %w", err)
+	}
+	iv, err := hex.DecodeString(ks.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keystore iv: %w", err)
+	}
+
+	calculatedMAC := crypto.Keccak256(derivedKey[16:32], cipherText)
+	if subtle.ConstantTimeCompare(calculatedMAC, mac) != 1 {
+		return nil, ErrKeystoreMAC
+	}
+
+	block, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != block.BlockSize() {
+		return nil, fmt.Errorf("invalid keystore iv length %d", len(iv))
+	}
+	plaintext := make([]byte, len(cipherText))
+	defer clear(plaintext)
+	cipher.NewCTR(block, iv).XORKeyStream(plaintext, cipherText)
+
+	privateKey, err := crypto.ToECDSA(plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keystore key: %w", err)
+	}
+
+	if ks.Address != "" {
+		address := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+		if !strings.EqualFold(strings.TrimPrefix(address, "0x"), strings.TrimPrefix(ks.Address, "0x")) {
+			return nil, fmt.Errorf("keystore address %s does not match decrypted key %s", ks.Address, address)
+		}
+	}
+	return privateKey, nil
+}
+
+func deriveKeystoreKey(kdf string, params keystoreKDFParams, passphrase string) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keystore salt: %w", err)
+	}
+	switch kdf {
+	case "scrypt":
+		return scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, params.DKLen)
+	case "pbkdf2":
+		if params.PRF != "hmac-sha256" {
+			return nil, fmt.Errorf("unsupported keystore pbkdf2 prf %q", params.PRF)
+		}
+		return pbkdf2.Key(sha256.New, passphrase, salt, params.C, params.DKLen)
+	default:
+		return nil, fmt.Errorf("unsupported keystore kdf %q", kdf)
+	}
+}
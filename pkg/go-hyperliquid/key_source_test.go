@@ -0,0 +1,89 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors from the Web3 Secret Storage Definition.
+const (
+	keystoreTestPassphrase = "testpassword"
+	keystoreTestKey        = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+
+	keystorePBKDF2 = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2","kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256","salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+	keystoreScrypt = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"83dbcc02d8ccb40e466191a123791e0e"},"ciphertext":"d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c","kdf":"scrypt","kdfparams":{"dklen":32,"n":262144,"p":8,"r":1,"salt":"ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},"mac":"2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+)
+
+func TestDecryptKeystore(t *testing.T) {
+	for name, data := range map[string]string{"pbkdf2": keystorePBKDF2, "scrypt": keystoreScrypt} {
+		t.Run(name, func(t *testing.T) {
+			key, err := DecryptKeystore([]byte(data), keystoreTestPassphrase)
+			require.NoError(t, err)
+			require.Equal(t, keystoreTestKey, hex.EncodeToString(crypto.FromECDSA(key)))
+
+			_, err = DecryptKeystore([]byte(data), "wrong")
+			require.ErrorIs(t, err, ErrKeystoreMAC)
+		})
+	}
+}
+
+func TestKeystoreFileSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "key.json")
+	require.NoError(t, os.WriteFile(path, []byte(keystorePBKDF2), 0o600))
+
+	t.Setenv("HL_KEYSTORE_PASSPHRASE", keystoreTestPassphrase)
+	key, err := KeystoreFileSource(path, PassphraseFromEnv("HL_KEYSTORE_PASSPHRASE")).PrivateKey(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, keystoreTestKey, hex.EncodeToString(crypto.FromECDSA(key)))
+
+	_, err = KeystoreFileSource(path, PassphraseFromEnv("HL_KEYSTORE_MISSING")).PrivateKey(context.Background())
+	require.Error(t, err)
+}
+
+type stubDecrypter struct {
+	plaintext []byte
+	err       error
+}
+
+func (d stubDecrypter) Decrypt(context.Context, []byte) ([]byte, error) {
+	return append([]byte(nil), d.plaintext...), d.err
+}
+
+func TestEncryptedKeySource(t *testing.T) {
+	raw, err := hex.DecodeString(keystoreTestKey)
+	require.NoError(t, err)
+
+	for name, plaintext := range map[string][]byte{
+		"raw": raw,
+		"hex": []byte("0x" + keystoreTestKey + "\n"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			key, err := EncryptedKeySource([]byte("ciphertext"), stubDecrypter{plaintext: plaintext}).
+				PrivateKey(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, keystoreTestKey, hex.EncodeToString(crypto.FromECDSA(key)))
+		})
+	}
+
+	kmsErr := errors.New("access denied")
+	_, err = EncryptedKeySource(nil, stubDecrypter{err: kmsErr}).PrivateKey(context.Background())
+	require.ErrorIs(t, err, kmsErr)
+}
+
+func TestNewExchangeFromKeySource(t *testing.T) {
+	_, err := NewExchangeFromKeySource(context.Background(), nil, TestnetAPIURL, &Meta{}, "", "", &SpotMeta{})
+	require.Error(t, err)
+
+	t.Setenv("HL_PRIVATE_KEY", keystoreTestKey)
+	ex, err := NewExchangeFromKeySource(context.Background(), EnvKeySource("HL_PRIVATE_KEY"),
+		TestnetAPIURL, &Meta{}, "", "", &SpotMeta{})
+	require.NoError(t, err)
+	require.Equal(t, keystoreTestKey, hex.EncodeToString(crypto.FromECDSA(ex.privateKey)))
+}
@@ -0,0 +1,129 @@
+package hyperliquid
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// NonceStore persists the last nonce used by an Exchange so that a restarted process
+// never reuses a nonce, even when it comes back within the same millisecond.
+type NonceStore interface {
+	// Load returns the last persisted nonce, or 0 if none was stored yet.
+	Load() (int64, error)
+	// Save persists n as the last used nonce.
+	Save(n int64) error
+}
+
+// FileNonceStore stores the last nonce as a decimal number in a file.
+// Writes go through a temporary file and a rename, so a crash never leaves a torn value.
+type FileNonceStore struct {
+	path string
+}
+
+// NewFileNonceStore creates a NonceStore backed by the file at path.
+func NewFileNonceStore(path string) *FileNonceStore {
+	return &FileNonceStore{path: path}
+}
+
+func (s *FileNonceStore) Load() (int64, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	raw := strings.TrimSpace(string(data))
+	if raw == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid nonce in %s: %w", s.path, err)
+	}
+	return n, nil
+}
+
+func (s *FileNonceStore) Save(n int64) error {
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(strconv.FormatInt(n, 10)); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// ExchangeOptNonceStore persists every nonce handed out by the Exchange to store
+// and resumes from the stored value on construction.
+func ExchangeOptNonceStore(store NonceStore) ExchangeOpt {
+	return func(e *Exchange) {
+		e.nonceStore = store
+	}
+}
+
+// nonceState tracks which nonce has been persisted, so concurrent saves never move the stored value backwards.
+type nonceState struct {
+	mu        sync.Mutex
+	persisted int64
+}
+
+// restoreNonce resumes the nonce sequence from the configured store.
+func (e *Exchange) restoreNonce() error {
+	if e.nonceStore == nil {
+		return nil
+	}
+	n, err := e.nonceStore.Load()
+	if err != nil {
+		return err
+	}
+	for {
+		last := e.lastNonce.Load()
+		if n <= last || e.lastNonce.CompareAndSwap(last, n) {
+			break
+		}
+	}
+	e.nonceState.mu.Lock()
+	e.nonceState.persisted = n
+	e.nonceState.mu.Unlock()
+	return nil
+}
+
+// persistNonce saves n to the nonce store unless a newer nonce was already saved.
+// Failures are logged and do not fail the action: the in-memory sequence stays monotonic.
+func (e *Exchange) persistNonce(n int64) {
+	if e.nonceStore == nil {
+		return
+	}
+
+	e.nonceState.mu.Lock()
+	defer e.nonceState.mu.Unlock()
+
+	if n <= e.nonceState.persisted {
+		return
+	}
+	if err := e.nonceStore.Save(n); err != nil {
+		log.Warn().Err(err).Int64("nonce", n).Msg("persist nonce failed")
+		return
+	}
+	e.nonceState.persisted = n
+}
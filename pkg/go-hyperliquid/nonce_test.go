@@ -0,0 +1,92 @@
+package hyperliquid
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingNonceStore keeps saved nonces in memory and fails the test if they ever go backwards.
+type recordingNonceStore struct {
+	t     *testing.T
+	mu    sync.Mutex
+	last  int64
+	saves int
+}
+
+func (s *recordingNonceStore) Load() (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.last, nil
+}
+
+func (s *recordingNonceStore) Save(n int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n <= s.last {
+		s.t.Errorf("nonce saved out of order: %d after %d", n, s.last)
+	}
+	s.last = n
+	s.saves++
+	return nil
+}
+
+func TestFileNonceStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nonce")
+	store := NewFileNonceStore(path)
+
+	n, err := store.Load()
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	require.NoError(t, store.Save(1700000000123))
+	n, err = store.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(1700000000123), n)
+
+	require.NoError(t, os.WriteFile(path, []byte("garbage"), 0o600))
+	_, err = store.Load()
+	require.Error(t, err)
+}
+
+func TestNextNonce_ResumesFromStore(t *testing.T) {
+	// the previous process used a nonce ahead of the local clock (same millisecond or clock skew)
+	stored := time.Now().UnixMilli() + 60_000
+	store := &recordingNonceStore{t: t, last: stored}
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	meta := &Meta{Universe: []AssetInfo{{Name: "BTC", SzDecimals: 5}}}
+	ex := NewExchange(context.TODO(), key, "http://127.0.0.1:0", meta, "", "", &SpotMeta{},
+		ExchangeOptNonceStore(store))
+
+	require.Equal(t, stored+1, ex.nextNonce())
+	require.Equal(t, stored+2, ex.nextNonce())
+	require.Equal(t, stored+2, store.last)
+}
+
+func TestNextNonce_ConcurrentPersistence(t *testing.T) {
+	store := &recordingNonceStore{t: t}
+	e := &Exchange{nonceStore: store}
+
+	const N = 200
+	var wg sync.WaitGroup
+	wg.Add(N)
+	for i := 0; i < N; i++ {
+		go func() {
+			defer wg.Done()
+			e.nextNonce()
+		}()
+	}
+	wg.Wait()
+
+	// the stored value is the newest nonce handed out, never an older one
+	require.Equal(t, e.lastNonce.Load(), store.last)
+	require.LessOrEqual(t, store.saves, N)
+}
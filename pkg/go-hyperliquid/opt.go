@@ -1,6 +1,9 @@
 package hyperliquid
 
 import (
+	"net/http"
+	"net/url"
+
 	"github.com/rs/zerolog/log"
 )
 
@@ -24,6 +27,25 @@ func WsOptDebugMode() WsOpt {
 	}
 }
 
+// WsOptHeaders adds headers to the websocket handshake request, e.g. an auth
+// token required by a gateway in front of the API.
+func WsOptHeaders(headers http.Header) WsOpt {
+	return func(w *WebsocketClient) {
+		w.headers = headers
+	}
+}
+
+// WsOptProxy dials the websocket through a proxy. http:// proxies are used via
+// CONNECT, with userinfo in the URL sent as Basic Proxy-Authorization; socks5://
+// is also supported. A nil URL is ignored.
+func WsOptProxy(proxy *url.URL) WsOpt {
+	return func(w *WebsocketClient) {
+		if proxy != nil {
+			w.proxy = proxy
+		}
+	}
+}
+
 func InfoOptDebugMode() InfoOpt {
 	return func(i *Info) {
 		i.debug = true
@@ -43,6 +65,24 @@ func ClientOptDebugMode() ClientOpt {
 	}
 }
 
+// ClientOptHTTPClient replaces the default http.Client, e.g. to route REST
+// calls through a proxy or share a tuned transport. A nil client is ignored.
+func ClientOptHTTPClient(httpClient *http.Client) ClientOpt {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// ClientOptMiddleware appends request/response middleware. Middleware run in
+// registration order, the first one wrapping all the others.
+func ClientOptMiddleware(middlewares ...Middleware) ClientOpt {
+	return func(c *Client) {
+		c.middlewares = append(c.middlewares, middlewares...)
+	}
+}
+
 // ExchangeOptClientOptions allows passing of ClientOpt to Client
 func ExchangeOptClientOptions(opts ...ClientOpt) ExchangeOpt {
 	return func(e *Exchange) {
@@ -63,3 +103,13 @@ func InfoOptClientOptions(opts ...ClientOpt) InfoOpt {
 		i.clientOpts = append(i.clientOpts, opts...)
 	}
 }
+
+// InfoOptHTTPClient sets the http.Client used by Info's REST client
+func InfoOptHTTPClient(httpClient *http.Client) InfoOpt {
+	return InfoOptClientOptions(ClientOptHTTPClient(httpClient))
+}
+
+// InfoOptMiddleware appends request/response middleware to Info's REST client
+func InfoOptMiddleware(middlewares ...Middleware) InfoOpt {
+	return InfoOptClientOptions(ClientOptMiddleware(middlewares...))
+}
@@ -0,0 +1,142 @@
+package hyperliquid
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// OrderOutcome classifies a single per-order status returned by the exchange
+type OrderOutcome string
+
+const (
+	OrderOutcomeResting OrderOutcome = "resting"
+	OrderOutcomeFilled  OrderOutcome = "filled"
+	OrderOutcomeError   OrderOutcome = "error"
+	// OrderOutcomeUnknown is reported for statuses with none of the known keys
+	// (e.g. "waitingForFill" on trigger orders)
+	OrderOutcomeUnknown OrderOutcome = "unknown"
+)
+
+// Outcome returns the kind of status
+func (s *OrderStatus) Outcome() OrderOutcome {
+	switch {
+	case s.Error != nil:
+		return OrderOutcomeError
+	case s.Filled != nil:
+		return OrderOutcomeFilled
+	case s.Resting != nil:
+		return OrderOutcomeResting
+	default:
+		return OrderOutcomeUnknown
+	}
+}
+
+// Oid returns the exchange order id of a resting or filled order, or 0
+func (s *OrderStatus) Oid() int64 {
+	switch {
+	case s.Resting != nil:
+		return s.Resting.Oid
+	case s.Filled != nil:
+		return int64(s.Filled.Oid)
+	default:
+		return 0
+	}
+}
+
+// OrderError is a per-order rejection inside a bulk response. Index is the
+// position of the order in the request slice.
+type OrderError struct {
+	Index   int
+	Message string
+}
+
+func (e *OrderError) Error() string {
+	return e.Message
+}
+
+// Err returns the per-order rejection, if any, as an *OrderError
+func (r OrderResponse) Err(i int) error {
+	if i < 0 || i >= len(r.Statuses) || r.Statuses[i].Error == nil {
+		return nil
+	}
+	return &OrderError{Index: i, Message: *r.Statuses[i].Error}
+}
+
+// All iterates over the per-order statuses. The index matches the position
+// of the order in the request slice, since the exchange answers in order.
+func (r OrderResponse) All() iter.Seq2[int, OrderStatus] {
+	return func(yield func(int, OrderStatus) bool) {
+		for i, s := range r.Statuses {
+			if !yield(i, s) {
+				return
+			}
+		}
+	}
+}
+
+// Succeeded iterates over the orders that are resting or filled
+func (r OrderResponse) Succeeded() iter.Seq2[int, OrderStatus] {
+	return r.filter(func(s *OrderStatus) bool {
+		o := s.Outcome()
+		return o == OrderOutcomeResting || o == OrderOutcomeFilled
+	})
+}
+
+// Failed iterates over the orders that were rejected
+func (r OrderResponse) Failed() iter.Seq2[int, OrderStatus] {
+	return r.filter(func(s *OrderStatus) bool {
+		return s.Outcome() == OrderOutcomeError
+	})
+}
+
+func (r OrderResponse) filter(keep func(*OrderStatus) bool) iter.Seq2[int, OrderStatus] {
+	return func(yield func(int, OrderStatus) bool) {
+		for i := range r.Statuses {
+			if keep(&r.Statuses[i]) && !yield(i, r.Statuses[i]) {
+				return
+			}
+		}
+	}
+}
+
+// FailedIndices returns the request indices of the rejected orders
+func (r OrderResponse) FailedIndices() []int {
+	var out []int
+	for i := range r.Failed() {
+		out = append(out, i)
+	}
+	return out
+}
+
+// FirstError returns the first per-order rejection as an *OrderError, if any
+func (r OrderResponse) FirstError() error {
+	for i := range r.Failed() {
+		return r.Err(i)
+	}
+	return nil
+}
+
+// Errors joins every per-order rejection; each wrapped error is an *OrderError
+func (r OrderResponse) Errors() error {
+	var errs []error
+	for i := range r.Failed() {
+		errs = append(errs, r.Err(i))
+	}
+	return errors.Join(errs...)
+}
+
+// FailedOrders returns the subset of reqs whose orders were rejected, so
+// callers can retry only the failed legs of a bulk request. reqs must be the
+// slice that was sent; a length mismatch with the response is reported as an
+// error because indices can no longer be trusted.
+func FailedOrders[T any](r OrderResponse, reqs []T) ([]T, error) {
+	if len(reqs) != len(r.Statuses) {
+		return nil, fmt.Errorf("response has %d statuses for %d orders", len(r.Statuses), len(reqs))
+	}
+	var out []T
+	for i := range r.Failed() {
+		out = append(out, reqs[i])
+	}
+	return out, nil
+}
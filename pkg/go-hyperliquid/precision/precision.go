@@ -0,0 +1,129 @@
+// Package precision provides decimal-safe helpers for formatting and rounding
+// Hyperliquid order prices and sizes.
+//
+// Hyperliquid validates prices and sizes against the asset's szDecimals
+// (see https://hyperliquid.gitbook.io/hyperliquid-docs/for-developers/api/tick-and-lot-size):
+//
+//   - sizes are rounded to szDecimals decimal places (lot size);
+//   - prices may have at most 5 significant figures and at most
+//     MaxDecimals - szDecimals decimal places, where MaxDecimals is 6 for
+//     perps and 8 for spot. Integer prices are always allowed.
+//
+// All helpers operate on decimal.Decimal so callers never round-trip
+// through float64 when building orders.
+package precision
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// MaxSignificantFigures is the maximum number of significant figures allowed in a price.
+	MaxSignificantFigures = 5
+	// PerpMaxDecimals is the maximum number of price decimals for perps before subtracting szDecimals.
+	PerpMaxDecimals = 6
+	// SpotMaxDecimals is the maximum number of price decimals for spot before subtracting szDecimals.
+	SpotMaxDecimals = 8
+	// WireMaxDecimals is the maximum number of decimals accepted in a wire-formatted number.
+	WireMaxDecimals = 8
+)
+
+// ErrWirePrecision is returned when a number cannot be represented on the wire without rounding.
+var ErrWirePrecision = errors.New("precision: value has more than 8 decimal places")
+
+// MaxPriceDecimals returns the maximum number of decimal places allowed for a price.
+func MaxPriceDecimals(szDecimals int, isSpot bool) int32 {
+	maxDecimals := PerpMaxDecimals
+	if isSpot {
+		maxDecimals = SpotMaxDecimals
+	}
+	return int32(max(maxDecimals-szDecimals, 0))
+}
+
+// RoundPrice rounds px (half away from zero) to at most 5 significant figures
+// and at most MaxPriceDecimals decimal places. Prices whose integer part already
+// has 5 or more digits are rounded to an integer.
+func RoundPrice(px decimal.Decimal, szDecimals int, isSpot bool) decimal.Decimal {
+	if px.IsZero() {
+		return px
+	}
+	places := min(sigFigDecimals(px, MaxSignificantFigures), MaxPriceDecimals(szDecimals, isSpot))
+	return px.Round(max(places, 0))
+}
+
+// RoundSize rounds sz (half away from zero) to szDecimals decimal places.
+func RoundSize(sz decimal.Decimal, szDecimals int) decimal.Decimal {
+	return sz.Round(int32(max(szDecimals, 0)))
+}
+
+// TruncateSize truncates sz toward zero to szDecimals decimal places, so the
+// result never exceeds the requested size (e.g. when closing a full position).
+func TruncateSize(sz decimal.Decimal, szDecimals int) decimal.Decimal {
+	return sz.Truncate(int32(max(szDecimals, 0)))
+}
+
+// ValidPrice reports whether px is accepted as-is by the exchange.
+func ValidPrice(px decimal.Decimal, szDecimals int, isSpot bool) bool {
+	if !px.IsPositive() {
+		return false
+	}
+	return px.Equal(RoundPrice(px, szDecimals, isSpot))
+}
+
+// ValidSize reports whether sz is a positive multiple of the asset's lot size.
+func ValidSize(sz decimal.Decimal, szDecimals int) bool {
+	return sz.IsPositive() && sz.Equal(RoundSize(sz, szDecimals))
+}
+
+// ToWire formats d the way the exchange expects numbers in signed actions:
+// plain decimal notation, no trailing zeros, and "0" for negative zero.
+// It returns ErrWirePrecision if d has more than 8 decimal places.
+func ToWire(d decimal.Decimal) (string, error) {
+	if !d.Equal(d.Truncate(WireMaxDecimals)) {
+		return "", fmt.Errorf("%w: %s", ErrWirePrecision, d.String())
+	}
+	if d.IsZero() {
+		return "0", nil
+	}
+	// String() already omits trailing zeros and never uses exponent notation.
+	return d.String(), nil
+}
+
+// PriceToWire rounds px with RoundPrice and formats it with ToWire.
+func PriceToWire(px decimal.Decimal, szDecimals int, isSpot bool) string {
+	// RoundPrice never leaves more than 8 decimal places.
+	s, _ := ToWire(RoundPrice(px, szDecimals, isSpot))
+	return s
+}
+
+// SizeToWire rounds sz with RoundSize and formats it with ToWire.
+func SizeToWire(sz decimal.Decimal, szDecimals int) (string, error) {
+	return ToWire(RoundSize(sz, szDecimals))
+}
+
+// ApplySlippage moves px by slippage (e.g. 0.01 for 1%) in the unfavourable
+// direction for the taker and rounds the result with RoundPrice.
+func ApplySlippage(px, slippage decimal.Decimal, isBuy bool, szDecimals int, isSpot bool) decimal.Decimal {
+	factor := decimal.NewFromInt(1)
+	if isBuy {
+		factor = factor.Add(slippage)
+	} else {
+		factor = factor.Sub(slippage)
+	}
+	return RoundPrice(px.Mul(factor), szDecimals, isSpot)
+}
+
+// sigFigDecimals returns the number of decimal places that keeps sigFigs
+// significant figures of d. The result is negative when the integer part has
+// more than sigFigs digits.
+func sigFigDecimals(d decimal.Decimal, sigFigs int) int32 {
+	// Position of the most significant digit: 0 for [1,10), 2 for [100,1000), -3 for [0.001,0.01).
+	// Count coefficient digits exactly; NumDigits estimates via float log10.
+	digits := len(new(big.Int).Abs(d.Coefficient()).String())
+	msd := int32(digits) + d.Exponent() - 1
+	return int32(sigFigs) - 1 - msd
+}
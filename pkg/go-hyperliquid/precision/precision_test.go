@@ -0,0 +1,89 @@
+package precision
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func d(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
+
+func TestRoundPrice(t *testing.T) {
+	tests := []struct {
+		name       string
+		px         string
+		szDecimals int
+		isSpot     bool
+		expected   string
+	}{
+		{name: "keeps 5 significant figures", px: "123.456", szDecimals: 0, expected: "123.46"},
+		{name: "integer part wider than 5 digits rounds to integer", px: "110454.7", szDecimals: 0, expected: "110455"},
+		{name: "power of ten", px: "1000.123", szDecimals: 0, expected: "1000.1"},
+		{name: "small fraction", px: "0.00252312", szDecimals: 0, isSpot: true, expected: "0.0025231"},
+		{name: "perp caps fraction at 6 decimals", px: "0.00252312", szDecimals: 0, expected: "0.002523"},
+		{name: "perp max decimals minus szDecimals", px: "0.00252312", szDecimals: 2, expected: "0.0025"},
+		{name: "spot allows 8 decimals", px: "0.00252312", szDecimals: 2, isSpot: true, expected: "0.002523"},
+		{name: "rounding carries into integer", px: "99999.5", szDecimals: 0, expected: "100000"},
+		{name: "float64 noise", px: "0.30000000000000004", szDecimals: 1, expected: "0.3"},
+		{name: "negative", px: "-123.456", szDecimals: 0, expected: "-123.46"},
+		{name: "zero", px: "0", szDecimals: 0, expected: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RoundPrice(d(tt.px), tt.szDecimals, tt.isSpot)
+			assert.True(t, d(tt.expected).Equal(got), "got %s", got)
+		})
+	}
+}
+
+func TestValidPrice(t *testing.T) {
+	assert.True(t, ValidPrice(d("123.45"), 0, false))
+	assert.True(t, ValidPrice(d("1234567"), 5, false), "integer prices are always valid")
+	assert.False(t, ValidPrice(d("123.456"), 0, false), "6 significant figures")
+	assert.False(t, ValidPrice(d("0.0025231"), 2, false), "too many decimals for szDecimals")
+	assert.False(t, ValidPrice(d("0"), 0, false))
+}
+
+func TestSize(t *testing.T) {
+	assert.Equal(t, "1.235", RoundSize(d("1.2345"), 3).String())
+	assert.Equal(t, "1.234", TruncateSize(d("1.2349"), 3).String())
+	assert.Equal(t, "12", RoundSize(d("12.4"), 0).String())
+
+	assert.True(t, ValidSize(d("0.001"), 3))
+	assert.False(t, ValidSize(d("0.0015"), 3))
+	assert.False(t, ValidSize(d("-1"), 3))
+}
+
+func TestToWire(t *testing.T) {
+	for in, want := range map[string]string{
+		"1.50000000": "1.5",
+		"100":        "100",
+		"-0.0":       "0",
+		"0.00000001": "0.00000001",
+		"1e-5":       "0.00001",
+		"123456789":  "123456789",
+	} {
+		got, err := ToWire(d(in))
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+
+	_, err := ToWire(d("0.000000001"))
+	assert.ErrorIs(t, err, ErrWirePrecision)
+
+	assert.Equal(t, "0.0025", PriceToWire(d("0.00252312"), 2, false))
+	sz, err := SizeToWire(d("1.23456789"), 4)
+	require.NoError(t, err)
+	assert.Equal(t, "1.2346", sz)
+}
+
+func TestApplySlippage(t *testing.T) {
+	assert.Equal(t, "2020", ApplySlippage(d("2000"), d("0.01"), true, 4, false).String())
+	assert.Equal(t, "1980", ApplySlippage(d("2000"), d("0.01"), false, 4, false).String())
+	assert.Equal(t, "0.15231", ApplySlippage(d("0.1508"), d("0.01"), true, 0, false).String())
+}
@@ -24,19 +24,25 @@ func addressToBytes(address string) []byte {
 	return bytes
 }
 
-// actionHash implements the same logic as Python's action_hash function
-func actionHash(action any, vaultAddress string, nonce int64, expiresAfter *int64) []byte {
-	// Pack action using msgpack (like Python's msgpack.packb)
+// packAction packs an action using msgpack (like Python's msgpack.packb)
+func packAction(action any) ([]byte, error) {
 	var buf bytes.Buffer
 	enc := msgpack.NewEncoder(&buf)
 	enc.SetSortMapKeys(true)
 	enc.UseCompactInts(true)
 
-	err := enc.Encode(action)
+	if err := enc.Encode(action); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// actionHash implements the same logic as Python's action_hash function
+func actionHash(action any, vaultAddress string, nonce int64, expiresAfter *int64) []byte {
+	data, err := packAction(action)
 	if err != nil {
 		panic(fmt.Sprintf("failed to marshal action: %v", err))
 	}
-	data := buf.Bytes()
 
 	// Add nonce as 8 bytes big endian
 	if nonce < 0 {
@@ -314,3 +314,129 @@ func TestDebugActionHash(t *testing.T) {
 	require.NoError(t, err)
 	t.Logf("Generated signature: R=%s, S=%s, V=%d", signature.R, signature.S, signature.V)
 }
+
+// TestDeployActionHashes pins the msgpack layout of the deploy actions. The expected
+// hashes were computed from Python-style dicts (insertion ordered keys), so a field
+// reordering or a missing msgpack tag shows up as a hash mismatch.
+func TestDeployActionHashes(t *testing.T) {
+	const nonce = int64(1700000000000)
+	oracleUpdater := "0xcccc000000000000000000000000000000000003"
+
+	tests := []struct {
+		name   string
+		action any
+		hash   string
+	}{
+		{
+			name: "spot_deploy_register_token",
+			action: SpotDeployRegisterTokenAction{
+				Type: "spotDeploy",
+				RegisterToken2: SpotDeployRegisterToken{
+					Spec:     SpotDeployTokenSpec{Name: "TEST", SzDecimals: 2, WeiDecimals: 8},
+					MaxGas:   1000000,
+					FullName: "Test Token",
+				},
+			},
+			hash: "bce4fcb59fe7e4c7d1ab4cf2bc71b69c73d8b08e4ebce7a831ab558991b26fd4",
+		},
+		{
+			name: "spot_deploy_user_genesis",
+			action: SpotDeployUserGenesisAction{
+				Type: "spotDeployUserGenesis",
+				Balances: map[string]float64{
+					"0xbbbb000000000000000000000000000000000002": 1.5,
+					"0xaaaa000000000000000000000000000000000001": 2,
+				},
+			},
+			hash: "c05e19eb3d86f0f038e1c09d78d9a359a443ac2b213dab9e49dcaf08ca430644",
+		},
+		{
+			name:   "spot_deploy_enable_freeze_privilege",
+			action: SpotDeployFreezePrivilegeAction{Type: "spotDeployEnableFreezePrivilege"},
+			hash:   "3247470b9887dadec9a4ec4193ce3fb3fa8317c4f4cfa262c61af2ccc4c4d33f",
+		},
+		{
+			name: "spot_deploy_freeze_user",
+			action: SpotDeployFreezeUserAction{
+				Type:        "spotDeployFreezeUser",
+				UserAddress: "0xaaaa000000000000000000000000000000000001",
+			},
+			hash: "44abdcc2a869fb277b3a08defb0984d4a4752fe2249c03a88a6f241bc812349e",
+		},
+		{
+			name:   "spot_deploy_revoke_freeze_privilege",
+			action: SpotDeployFreezePrivilegeAction{Type: "spotDeployRevokeFreezePrivilege"},
+			hash:   "3fd83b7af7c683670359978ee9bd96f86386e0baa0c2944fcb511ad5e97b67a4",
+		},
+		{
+			name:   "spot_deploy_genesis",
+			action: SpotDeployGenesisAction{Type: "spotDeployGenesis", Deployer: "0xdeployer", DexName: "dex"},
+			hash:   "055e3f279dd318073be799cb9d3a1ccee2a4c0b7bad05cd1b8aef98eed176caa",
+		},
+		{
+			name:   "spot_deploy_register_spot",
+			action: SpotDeployRegisterSpotAction{Type: "spotDeployRegisterSpot", BaseToken: "TEST", QuoteToken: "USDC"},
+			hash:   "9d4cd7b04ab1ceb385c0b8861d1afe1d24dea3a074f74deba7cba6ea8008722c",
+		},
+		{
+			name: "spot_deploy_register_hyperliquidity",
+			action: SpotDeployRegisterHyperliquidityAction{
+				Type:   "spotDeployRegisterHyperliquidity",
+				Name:   "TEST/USDC",
+				Tokens: []string{"TEST", "USDC"},
+			},
+			hash: "eedcdd46694d29311b4bdc30f6ad0f654ef99baf436bc1990c0bd2553d808056",
+		},
+		{
+			name:   "spot_deploy_set_deployer_trading_fee_share",
+			action: SpotDeploySetDeployerTradingFeeShareAction{Type: "spotDeploySetDeployerTradingFeeShare", FeeShare: 0.25},
+			hash:   "e4db1228d09864fa4c2a8ce835cb12d5c39ec75ce706f05d9f1afcf19a8838aa",
+		},
+		{
+			name: "perp_deploy_register_asset",
+			action: PerpDeployRegisterAssetAction{
+				Type:         "perpDeployRegisterAsset",
+				Asset:        "TEST",
+				PerpDexInput: PerpDexSchemaInput{FullName: "Test Dex", CollateralToken: 0},
+			},
+			hash: "48a6589d07ddec0a0457cbab833a1a3936d9d59cbbf4feafdcc4a5ac4212e222",
+		},
+		{
+			name: "perp_deploy_register_asset_oracle_updater",
+			action: PerpDeployRegisterAssetAction{
+				Type:  "perpDeployRegisterAsset",
+				Asset: "TEST",
+				PerpDexInput: PerpDexSchemaInput{
+					FullName:        "Test Dex",
+					CollateralToken: 0,
+					OracleUpdater:   &oracleUpdater,
+				},
+			},
+			hash: "820551147676f836c7913b79ff4c5e3556118ddf5078491932044b13bc541ea7",
+		},
+		{
+			name: "perp_deploy_set_oracle",
+			action: PerpDeploySetOracleAction{
+				Type:          "perpDeploySetOracle",
+				Asset:         "TEST",
+				OracleAddress: "0xcccc000000000000000000000000000000000003",
+			},
+			hash: "734d6b1b3eb3f7574dc337976fdd9294e7b0419a03b3b3f3fcba31cbc91dc52b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.hash, hex.EncodeToString(actionHash(tt.action, "", nonce, nil)))
+		})
+	}
+
+	t.Run("expires_after", func(t *testing.T) {
+		expiresAfter := nonce + 60000
+		action := SpotDeployGenesisAction{Type: "spotDeployGenesis", Deployer: "0xdeployer", DexName: "dex"}
+		assert.Equal(t,
+			"7ac3a8ac013d9aa8c5fdb96809fe42014c7025d9c9754b28acc2986289f0c6da",
+			hex.EncodeToString(actionHash(action, "", nonce, &expiresAfter)),
+		)
+	})
+}
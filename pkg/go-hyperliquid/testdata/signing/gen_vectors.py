@@ -0,0 +1,203 @@
+#!/usr/bin/env python3
+"""Regenerate the signing golden files with the Hyperliquid Python SDK.
+
+    pip install hyperliquid-python-sdk
+    python3 testdata/signing/gen_vectors.py
+
+Each case mirrors one case of signingGoldenCases in signing_golden_test.go
+(same name, same field order and value types). Every golden file must come
+from this script or the SDK's own signing tests; the Go test skips cases
+without a file and rejects files from any other source.
+
+Only L1 actions (sign_l1_action) are listed. Actions the SDK signs as
+user-signed EIP-712 messages (usdSend, spotSend, withdraw3, usdClassTransfer,
+perpDexClassTransfer, tokenDelegate, approveAgent, approveBuilderFee,
+convertToMultiSigUser) or with sign_multi_sig_action (multiSig) use a
+different scheme and have no vectors.
+"""
+
+import json
+import os
+
+import eth_account
+import msgpack
+from hyperliquid.utils.signing import action_hash, sign_l1_action
+
+KEY = "0x0123456789012345678901234567890123456789012345678901234567890123"
+NONCE = 1700000000000
+
+PY_VAULT = "0x1719884eb866cb12b2287399b15f7db5e7d775ea"
+PY_SUB_ACCOUNT = "0x1d9470d4b963f552e6f671a81619d395877bf409"
+USER = "0xaaaa000000000000000000000000000000000001"
+OTHER = "0xbbbb000000000000000000000000000000000002"
+CLOID = "0x00000000000000000000000000000001"
+PURR = "PURR:0xc4bf3f870c0e9465323c0b6ed28096c2"
+
+
+def limit_order(asset, px, sz, tif, cloid=None):
+    wire = {"a": asset, "b": True, "p": px, "s": sz, "r": False, "t": {"limit": {"tif": tif}}}
+    if cloid is not None:
+        wire["c"] = cloid
+    return wire
+
+
+def order_action(*orders, grouping="na", builder=None):
+    action = {"type": "order", "orders": list(orders), "grouping": grouping}
+    if builder is not None:
+        action["builder"] = builder
+    return action
+
+
+ORDER = limit_order(1, "100", "100", "Gtc")
+DUMMY = {"type": "dummy", "num": 100000000000}
+
+
+def case(action, nonce=NONCE, vault=None, expires_after=None, mainnet=False, hash_only=False):
+    return dict(
+        action=action,
+        nonce=nonce,
+        vault=vault,
+        expires_after=expires_after,
+        mainnet=mainnet,
+        hash_only=hash_only,
+    )
+
+
+CASES = {
+    # tests/signing_test.py of the Python SDK
+    "l1_dummy_mainnet": case(DUMMY, nonce=0, mainnet=True),
+    "l1_dummy_testnet": case(DUMMY, nonce=0),
+    "l1_dummy_vault_mainnet": case(DUMMY, nonce=0, vault=PY_VAULT, mainnet=True),
+    "l1_dummy_vault_testnet": case(DUMMY, nonce=0, vault=PY_VAULT),
+    "order_phantom_agent": case(
+        order_action(limit_order(4, "1670.1", "0.0147", "Ioc")),
+        nonce=1677777606040,
+        mainnet=True,
+        hash_only=True,
+    ),
+    "order_mainnet": case(order_action(ORDER), nonce=0, mainnet=True),
+    "order_testnet": case(order_action(ORDER), nonce=0),
+    "order_cloid_mainnet": case(order_action(limit_order(1, "100", "100", "Gtc", CLOID)), nonce=0, mainnet=True),
+    "order_cloid_testnet": case(order_action(limit_order(1, "100", "100", "Gtc", CLOID)), nonce=0),
+    "sub_account_transfer_mainnet": case(
+        {"type": "subAccountTransfer", "subAccountUser": PY_SUB_ACCOUNT, "isDeposit": True, "usd": 10},
+        nonce=0,
+        mainnet=True,
+    ),
+    "sub_account_transfer_testnet": case(
+        {"type": "subAccountTransfer", "subAccountUser": PY_SUB_ACCOUNT, "isDeposit": True, "usd": 10},
+        nonce=0,
+    ),
+    "schedule_cancel_mainnet": case({"type": "scheduleCancel"}, nonce=0, mainnet=True),
+    "schedule_cancel_testnet": case({"type": "scheduleCancel"}, nonce=0),
+    "schedule_cancel_time_mainnet": case({"type": "scheduleCancel", "time": 123456789}, nonce=0, mainnet=True),
+    "schedule_cancel_time_testnet": case({"type": "scheduleCancel", "time": 123456789}, nonce=0),
+    # every other typed L1 action in actions.go
+    "order_vault": case(order_action(ORDER), vault=PY_VAULT),
+    "order_expires_after": case(order_action(ORDER), expires_after=NONCE + 60000),
+    "order_trigger": case(
+        order_action(
+            {
+                "a": 0,
+                "b": False,
+                "p": "95000",
+                "s": "0.01",
+                "r": True,
+                "t": {"trigger": {"triggerPx": 95000.5, "isMarket": True, "tpsl": "sl"}},
+            },
+            grouping="positionTpsl",
+        )
+    ),
+    "order_builder": case(order_action(limit_order(0, "100.5", "1", "Alo"), builder={"b": OTHER, "f": 10})),
+    "cancel": case({"type": "cancel", "cancels": [{"a": 0, "o": 12345}]}),
+    "cancel_by_cloid": case({"type": "cancelByCloid", "cancels": [{"asset": 0, "cloid": CLOID}]}),
+    "modify": case({"type": "modify", "oid": 12345, "order": ORDER}),
+    "batch_modify": case(
+        {
+            "type": "batchModify",
+            "modifies": [
+                {"oid": 12345, "order": ORDER},
+                {"oid": CLOID, "order": limit_order(1, "101", "50", "Ioc")},
+            ],
+        }
+    ),
+    "update_leverage": case({"type": "updateLeverage", "asset": 0, "isCross": True, "leverage": 10}),
+    "update_isolated_margin": case({"type": "updateIsolatedMargin", "asset": 0, "isBuy": True, "ntli": 1.5}),
+    "sub_account_spot_transfer": case(
+        {
+            "type": "subAccountSpotTransfer",
+            "subAccountUser": PY_SUB_ACCOUNT,
+            "isDeposit": False,
+            "token": PURR,
+            "amount": 1.5,
+        }
+    ),
+    "vault_usd_transfer": case({"type": "vaultTransfer", "vaultAddress": PY_VAULT, "isDeposit": True, "usd": 5000000}),
+    "create_vault": case(
+        {"type": "createVault", "name": "Test Vault", "description": "golden", "initialUsd": 100000000}
+    ),
+    "vault_modify": case(
+        {"type": "vaultModify", "vaultAddress": PY_VAULT, "allowDeposits": True, "alwaysCloseOnWithdraw": False}
+    ),
+    "vault_distribute": case({"type": "vaultDistribute", "vaultAddress": PY_VAULT, "usd": 1000000}),
+    "set_referrer": case({"type": "setReferrer", "code": "TEST123"}),
+    "create_sub_account": case({"type": "createSubAccount", "name": "TestAccount"}),
+    "use_big_blocks": case({"type": "evmUserModify", "usingBigBlocks": True}),
+    "spot_deploy_register_token": case(
+        {
+            "type": "spotDeploy",
+            "registerToken2": {
+                "spec": {"name": "TEST", "szDecimals": 2, "weiDecimals": 8},
+                "maxGas": 1000000,
+                "fullName": "Test Token",
+            },
+        }
+    ),
+    "spot_deploy_user_genesis": case({"type": "spotDeployUserGenesis", "balances": {USER: 2.5, OTHER: 1.5}}),
+    "spot_deploy_enable_freeze_privilege": case({"type": "spotDeployEnableFreezePrivilege"}),
+    "spot_deploy_freeze_user": case({"type": "spotDeployFreezeUser", "userAddress": USER}),
+    "spot_deploy_revoke_freeze_privilege": case({"type": "spotDeployRevokeFreezePrivilege"}),
+    "spot_deploy_genesis": case({"type": "spotDeployGenesis", "deployer": "0xdeployer", "dexName": "dex"}),
+    "spot_deploy_register_spot": case({"type": "spotDeployRegisterSpot", "baseToken": "TEST", "quoteToken": "USDC"}),
+    "spot_deploy_register_hyperliquidity": case(
+        {"type": "spotDeployRegisterHyperliquidity", "name": "TEST/USDC", "tokens": ["TEST", "USDC"]}
+    ),
+    "spot_deploy_set_deployer_trading_fee_share": case(
+        {"type": "spotDeploySetDeployerTradingFeeShare", "feeShare": 0.25}
+    ),
+    "perp_deploy_register_asset": case(
+        {
+            "type": "perpDeployRegisterAsset",
+            "asset": "TEST",
+            "perpDexInput": {"fullName": "Test Dex", "collateralToken": 0, "oracleUpdater": USER},
+        }
+    ),
+    "perp_deploy_set_oracle": case({"type": "perpDeploySetOracle", "asset": "TEST", "oracleAddress": USER}),
+}
+
+
+def main():
+    wallet = eth_account.Account.from_key(KEY)
+    out_dir = os.path.dirname(os.path.abspath(__file__))
+
+    for name, c in CASES.items():
+        vector = {"source": "python-sdk", "isMainnet": c["mainnet"], "nonce": c["nonce"]}
+        if c["vault"] is not None:
+            vector["vaultAddress"] = c["vault"]
+        if c["expires_after"] is not None:
+            vector["expiresAfter"] = c["expires_after"]
+        vector["msgpack"] = msgpack.packb(c["action"]).hex()
+        vector["actionHash"] = action_hash(c["action"], c["vault"], c["nonce"], c["expires_after"]).hex()
+        if not c["hash_only"]:
+            vector["signature"] = sign_l1_action(
+                wallet, c["action"], c["vault"], c["nonce"], c["expires_after"], c["mainnet"]
+            )
+
+        with open(os.path.join(out_dir, name + ".json"), "w") as f:
+            json.dump(vector, f, indent=2)
+            f.write("\n")
+        print("wrote", name)
+
+
+if __name__ == "__main__":
+    main()
@@ -1,5 +1,7 @@
 package hyperliquid
 
+import "strings"
+
 //go:generate easyjson -all
 
 type Side string
@@ -113,6 +115,17 @@ type SpotMeta struct {
 	Tokens   []SpotTokenInfo `json:"tokens"`
 }
 
+// TokenByID returns the spot token with the given token id, including its
+// decimals and EVM contract linkage.
+func (m *SpotMeta) TokenByID(tokenID string) (*SpotTokenInfo, bool) {
+	for i := range m.Tokens {
+		if strings.EqualFold(m.Tokens[i].TokenID, tokenID) {
+			return &m.Tokens[i], true
+		}
+	}
+	return nil, false
+}
+
 type SpotAssetCtx struct {
 	DayNtlVlm         string  `json:"dayNtlVlm"`
 	DayBaseVlm        string  `json:"dayBaseVlm"`
@@ -152,8 +165,8 @@ type TriggerOrderType struct {
 }
 
 type BuilderInfo struct {
-	Builder string `json:"b"`
-	Fee     int    `json:"f"`
+	Builder string `json:"b" msgpack:"b"`
+	Fee     int    `json:"f" msgpack:"f"`
 }
 
 type CancelRequest struct {
@@ -175,9 +188,9 @@ func (c Cloid) ToRaw() string {
 }
 
 type PerpDexSchemaInput struct {
-	FullName        string  `json:"fullName"`
-	CollateralToken int     `json:"collateralToken"`
-	OracleUpdater   *string `json:"oracleUpdater"`
+	FullName        string  `json:"fullName"        msgpack:"fullName"`
+	CollateralToken int     `json:"collateralToken" msgpack:"collateralToken"`
+	OracleUpdater   *string `json:"oracleUpdater"   msgpack:"oracleUpdater"`
 }
 
 type AssetPosition struct {
@@ -402,6 +415,35 @@ type UserFees struct {
 	UserSpotAddRate        string       `json:"userSpotAddRate"`
 }
 
+// UserRateLimit is the address-based REST request budget returned by the
+// userRateLimit info endpoint. The cap grows with cumulative traded volume.
+type UserRateLimit struct {
+	CumVlm           string `json:"cumVlm"`
+	NRequestsUsed    int64  `json:"nRequestsUsed"`
+	NRequestsCap     int64  `json:"nRequestsCap"`
+	NRequestsSurplus int64  `json:"nRequestsSurplus"`
+}
+
+// Remaining returns how many requests are left before the cap is reached,
+// including any surplus.
+func (r UserRateLimit) Remaining() int64 {
+	remaining := r.NRequestsCap + r.NRequestsSurplus - r.NRequestsUsed
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// UsedRatio returns the fraction of the budget already consumed (0 when the
+// cap is unknown).
+func (r UserRateLimit) UsedRatio() float64 {
+	budget := r.NRequestsCap + r.NRequestsSurplus
+	if budget <= 0 {
+		return 0
+	}
+	return float64(r.NRequestsUsed) / float64(budget)
+}
+
 type UserActiveAssetData struct {
 	User             string   `json:"user"`
 	Coin             string   `json:"coin"`
@@ -477,6 +519,107 @@ type MultiSigSigner struct {
 	Threshold int    `json:"threshold"`
 }
 
+// VaultDetails is the response of the vaultDetails info request.
+//
+//easyjson:skip
+type VaultDetails struct {
+	Name                  string                           `json:"name"`
+	VaultAddress          string                           `json:"vaultAddress"`
+	Leader                string                           `json:"leader"`
+	Description           string                           `json:"description"`
+	Portfolio             []Tuple2[string, VaultPortfolio] `json:"portfolio,omitempty"` // keyed by period: day, week, month, allTime, perpDay, ...
+	Apr                   float64                          `json:"apr"`
+	FollowerState         *VaultFollower                   `json:"followerState,omitempty"` // set only when the request carries a user
+	LeaderFraction        float64                          `json:"leaderFraction"`
+	LeaderCommission      float64                          `json:"leaderCommission"`
+	Followers             []VaultFollower                  `json:"followers,omitempty"`
+	MaxDistributable      float64                          `json:"maxDistributable"`
+	MaxWithdrawable       float64                          `json:"maxWithdrawable"`
+	IsClosed              bool                             `json:"isClosed"`
+	Relationship          *VaultRelationship               `json:"relationship,omitempty"`
+	AllowDeposits         bool                             `json:"allowDeposits"`
+	AlwaysCloseOnWithdraw bool                             `json:"alwaysCloseOnWithdraw"`
+}
+
+// PortfolioPeriod returns the portfolio history of the given period, e.g. "day" or "allTime".
+func (v *VaultDetails) PortfolioPeriod(period string) (*VaultPortfolio, bool) {
+	for i := range v.Portfolio {
+		if v.Portfolio[i].First == period {
+			return &v.Portfolio[i].Second, true
+		}
+	}
+	return nil, false
+}
+
+// VaultPortfolio is the account value and pnl history of a vault over one period.
+//
+//easyjson:skip
+type VaultPortfolio struct {
+	AccountValueHistory []Tuple2[int64, string] `json:"accountValueHistory"` // [timestamp ms, value]
+	PnlHistory          []Tuple2[int64, string] `json:"pnlHistory"`          // [timestamp ms, value]
+	Vlm                 string                  `json:"vlm"`
+}
+
+// VaultFollower is a depositor of a vault.
+type VaultFollower struct {
+	User           string `json:"user"`
+	VaultEquity    string `json:"vaultEquity"`
+	Pnl            string `json:"pnl"`
+	AllTimePnl     string `json:"allTimePnl"`
+	DaysFollowing  int    `json:"daysFollowing"`
+	VaultEntryTime int64  `json:"vaultEntryTime"`
+	LockupUntil    int64  `json:"lockupUntil"`
+}
+
+// VaultRelationship describes whether a vault is a standalone, parent or child vault.
+type VaultRelationship struct {
+	Type string                 `json:"type"` // normal, parent, child
+	Data *VaultRelationshipData `json:"data,omitempty"`
+}
+
+type VaultRelationshipData struct {
+	ChildAddresses []string `json:"childAddresses,omitempty"`
+}
+
+// UserVaultEquity is a user's deposit in a single vault.
+type UserVaultEquity struct {
+	VaultAddress         string `json:"vaultAddress"`
+	Equity               string `json:"equity"`
+	LockedUntilTimestamp int64  `json:"lockedUntilTimestamp,omitempty"`
+}
+
+// DelegatorEvent is one entry of the delegatorHistory info request.
+type DelegatorEvent struct {
+	Time  int64          `json:"time"`
+	Hash  string         `json:"hash"`
+	Delta DelegatorDelta `json:"delta"`
+}
+
+// DelegatorDelta holds exactly one of the staking balance changes.
+type DelegatorDelta struct {
+	Delegate   *DelegatorDelegate   `json:"delegate,omitempty"`
+	CDeposit   *DelegatorDeposit    `json:"cDeposit,omitempty"`
+	Withdrawal *DelegatorWithdrawal `json:"withdrawal,omitempty"`
+}
+
+// DelegatorDelegate is a delegation to, or undelegation from, a validator.
+type DelegatorDelegate struct {
+	Validator    string `json:"validator"`
+	Amount       string `json:"amount"`
+	IsUndelegate bool   `json:"isUndelegate"`
+}
+
+// DelegatorDeposit is a transfer from the spot balance into the staking balance.
+type DelegatorDeposit struct {
+	Amount string `json:"amount"`
+}
+
+// DelegatorWithdrawal is a transfer from the staking balance back to spot.
+type DelegatorWithdrawal struct {
+	Amount string `json:"amount"`
+	Phase  string `json:"phase"` // initiated, finalized
+}
+
 type BulkOrderResponse struct {
 	Status string        `json:"status"`
 	Data   []OrderStatus `json:"data,omitempty"`
@@ -576,3 +719,44 @@ type TxStatus struct {
 	Coin   string `json:"coin"`
 	Status string `json:"status"`
 }
+
+// TokenDetails is the response of the tokenDetails info request. Supplies,
+// prices and balances are decimal strings; fields the API reports as null
+// (e.g. deployer and deploy info of genesis tokens, midPx without a book) are empty.
+//
+//easyjson:skip
+type TokenDetails struct {
+	Name                       string                   `json:"name"`
+	MaxSupply                  string                   `json:"maxSupply"`
+	TotalSupply                string                   `json:"totalSupply"`
+	CirculatingSupply          string                   `json:"circulatingSupply"`
+	SzDecimals                 int                      `json:"szDecimals"`
+	WeiDecimals                int                      `json:"weiDecimals"`
+	MidPx                      string                   `json:"midPx"`
+	MarkPx                     string                   `json:"markPx"`
+	PrevDayPx                  string                   `json:"prevDayPx"`
+	Genesis                    *TokenGenesis            `json:"genesis,omitempty"`
+	Deployer                   string                   `json:"deployer"`
+	DeployGas                  string                   `json:"deployGas"`
+	DeployTime                 string                   `json:"deployTime"` // UTC, e.g. 2024-06-05T10:50:57.943
+	SeededUsdc                 string                   `json:"seededUsdc"`
+	NonCirculatingUserBalances []Tuple2[string, string] `json:"nonCirculatingUserBalances"` // [user, balance]
+	FutureEmissions            string                   `json:"futureEmissions"`
+}
+
+// TokenGenesis is the genesis distribution of a spot token.
+//
+//easyjson:skip
+type TokenGenesis struct {
+	UserBalances          []Tuple2[string, string] `json:"userBalances"`          // [user, balance]
+	ExistingTokenBalances []Tuple2[int, string]    `json:"existingTokenBalances"` // [token index, balance]
+}
+
+// PerpDex is a perpetual dex returned by the perpDexs info request.
+// The first entry is the main dex, which has an empty Name.
+type PerpDex struct {
+	Name          string `json:"name"`
+	FullName      string `json:"fullName"`
+	Deployer      string `json:"deployer"`
+	OracleUpdater string `json:"oracleUpdater,omitempty"`
+}
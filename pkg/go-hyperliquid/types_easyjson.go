@@ -131,7 +131,7 @@ func (v *WsMsg) UnmarshalJSON(data []byte) error {
 func (v *WsMsg) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid1(in *jlexer.Lexer, out *ValidatorResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid1(in *jlexer.Lexer, out *VaultRelationshipData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -145,23 +145,32 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid1(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "status":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Status = string(in.String())
-			}
-		case "txHash":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.TxHash = string(in.String())
-			}
-		case "error":
+		case "childAddresses":
 			if in.IsNull() {
 				in.Skip()
+				out.ChildAddresses = nil
 			} else {
-				out.Error = string(in.String())
+				in.Delim('[')
+				if out.ChildAddresses == nil {
+					if !in.IsDelim(']') {
+						out.ChildAddresses = make([]string, 0, 4)
+					} else {
+						out.ChildAddresses = []string{}
+					}
+				} else {
+					out.ChildAddresses = (out.ChildAddresses)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v3 = string(in.String())
+					}
+					out.ChildAddresses = append(out.ChildAddresses, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -173,52 +182,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid1(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid1(out *jwriter.Writer, in ValidatorResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid1(out *jwriter.Writer, in VaultRelationshipData) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"status\":"
+	if len(in.ChildAddresses) != 0 {
+		const prefix string = ",\"childAddresses\":"
+		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.Status))
-	}
-	if in.TxHash != "" {
-		const prefix string = ",\"txHash\":"
-		out.RawString(prefix)
-		out.String(string(in.TxHash))
-	}
-	if in.Error != "" {
-		const prefix string = ",\"error\":"
-		out.RawString(prefix)
-		out.String(string(in.Error))
+		{
+			out.RawByte('[')
+			for v4, v5 := range in.ChildAddresses {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v5))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ValidatorResponse) MarshalJSON() ([]byte, error) {
+func (v VaultRelationshipData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ValidatorResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v VaultRelationshipData) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ValidatorResponse) UnmarshalJSON(data []byte) error {
+func (v *VaultRelationshipData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ValidatorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *VaultRelationshipData) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid1(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid2(in *jlexer.Lexer, out *VIPTier) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid2(in *jlexer.Lexer, out *VaultRelationship) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -232,23 +241,25 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid2(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "add":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Add = string(in.String())
-			}
-		case "cross":
+		case "type":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Cross = string(in.String())
+				out.Type = string(in.String())
 			}
-		case "ntlCutoff":
+		case "data":
 			if in.IsNull() {
 				in.Skip()
+				out.Data = nil
 			} else {
-				out.NtlCutoff = string(in.String())
+				if out.Data == nil {
+					out.Data = new(VaultRelationshipData)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Data).UnmarshalEasyJSON(in)
+				}
 			}
 		default:
 			in.SkipRecursive()
@@ -260,52 +271,47 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid2(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid2(out *jwriter.Writer, in VIPTier) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid2(out *jwriter.Writer, in VaultRelationship) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"add\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Add))
-	}
-	{
-		const prefix string = ",\"cross\":"
-		out.RawString(prefix)
-		out.String(string(in.Cross))
+		out.String(string(in.Type))
 	}
-	{
-		const prefix string = ",\"ntlCutoff\":"
+	if in.Data != nil {
+		const prefix string = ",\"data\":"
 		out.RawString(prefix)
-		out.String(string(in.NtlCutoff))
+		(*in.Data).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v VIPTier) MarshalJSON() ([]byte, error) {
+func (v VaultRelationship) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v VIPTier) MarshalEasyJSON(w *jwriter.Writer) {
+func (v VaultRelationship) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *VIPTier) UnmarshalJSON(data []byte) error {
+func (v *VaultRelationship) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *VIPTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *VaultRelationship) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid2(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid3(in *jlexer.Lexer, out *UserVolume) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid3(in *jlexer.Lexer, out *VaultFollower) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -319,29 +325,47 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid3(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "date":
+		case "user":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Date = string(in.String())
+				out.User = string(in.String())
 			}
-		case "exchange":
+		case "vaultEquity":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Exchange = string(in.String())
+				out.VaultEquity = string(in.String())
 			}
-		case "userAdd":
+		case "pnl":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.UserAdd = string(in.String())
+				out.Pnl = string(in.String())
 			}
-		case "userCross":
+		case "allTimePnl":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.UserCross = string(in.String())
+				out.AllTimePnl = string(in.String())
+			}
+		case "daysFollowing":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.DaysFollowing = int(in.Int())
+			}
+		case "vaultEntryTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.VaultEntryTime = int64(in.Int64())
+			}
+		case "lockupUntil":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LockupUntil = int64(in.Int64())
 			}
 		default:
 			in.SkipRecursive()
@@ -353,57 +377,72 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid3(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid3(out *jwriter.Writer, in UserVolume) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid3(out *jwriter.Writer, in VaultFollower) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"date\":"
+		const prefix string = ",\"user\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Date))
+		out.String(string(in.User))
 	}
 	{
-		const prefix string = ",\"exchange\":"
+		const prefix string = ",\"vaultEquity\":"
 		out.RawString(prefix)
-		out.String(string(in.Exchange))
+		out.String(string(in.VaultEquity))
 	}
 	{
-		const prefix string = ",\"userAdd\":"
+		const prefix string = ",\"pnl\":"
 		out.RawString(prefix)
-		out.String(string(in.UserAdd))
+		out.String(string(in.Pnl))
 	}
 	{
-		const prefix string = ",\"userCross\":"
+		const prefix string = ",\"allTimePnl\":"
 		out.RawString(prefix)
-		out.String(string(in.UserCross))
+		out.String(string(in.AllTimePnl))
+	}
+	{
+		const prefix string = ",\"daysFollowing\":"
+		out.RawString(prefix)
+		out.Int(int(in.DaysFollowing))
+	}
+	{
+		const prefix string = ",\"vaultEntryTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.VaultEntryTime))
+	}
+	{
+		const prefix string = ",\"lockupUntil\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LockupUntil))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserVolume) MarshalJSON() ([]byte, error) {
+func (v VaultFollower) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserVolume) MarshalEasyJSON(w *jwriter.Writer) {
+func (v VaultFollower) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserVolume) UnmarshalJSON(data []byte) error {
+func (v *VaultFollower) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserVolume) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *VaultFollower) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid3(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid4(in *jlexer.Lexer, out *UserState) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid4(in *jlexer.Lexer, out *ValidatorResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -417,50 +456,23 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid4(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "assetPositions":
-			if in.IsNull() {
-				in.Skip()
-				out.AssetPositions = nil
-			} else {
-				in.Delim('[')
-				if out.AssetPositions == nil {
-					if !in.IsDelim(']') {
-						out.AssetPositions = make([]AssetPosition, 0, 0)
-					} else {
-						out.AssetPositions = []AssetPosition{}
-					}
-				} else {
-					out.AssetPositions = (out.AssetPositions)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v3 AssetPosition
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v3).UnmarshalEasyJSON(in)
-					}
-					out.AssetPositions = append(out.AssetPositions, v3)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "crossMarginSummary":
+		case "status":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.CrossMarginSummary).UnmarshalEasyJSON(in)
+				out.Status = string(in.String())
 			}
-		case "marginSummary":
+		case "txHash":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.MarginSummary).UnmarshalEasyJSON(in)
+				out.TxHash = string(in.String())
 			}
-		case "withdrawable":
+		case "error":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Withdrawable = string(in.String())
+				out.Error = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -472,68 +484,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid4(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid4(out *jwriter.Writer, in UserState) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid4(out *jwriter.Writer, in ValidatorResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"assetPositions\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix[1:])
-		if in.AssetPositions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v4, v5 := range in.AssetPositions {
-				if v4 > 0 {
-					out.RawByte(',')
-				}
-				(v5).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"crossMarginSummary\":"
-		out.RawString(prefix)
-		(in.CrossMarginSummary).MarshalEasyJSON(out)
+		out.String(string(in.Status))
 	}
-	{
-		const prefix string = ",\"marginSummary\":"
+	if in.TxHash != "" {
+		const prefix string = ",\"txHash\":"
 		out.RawString(prefix)
-		(in.MarginSummary).MarshalEasyJSON(out)
+		out.String(string(in.TxHash))
 	}
-	{
-		const prefix string = ",\"withdrawable\":"
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
 		out.RawString(prefix)
-		out.String(string(in.Withdrawable))
+		out.String(string(in.Error))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserState) MarshalJSON() ([]byte, error) {
+func (v ValidatorResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserState) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ValidatorResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserState) UnmarshalJSON(data []byte) error {
+func (v *ValidatorResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserState) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ValidatorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid4(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid5(in *jlexer.Lexer, out *UserFundingHistory) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid5(in *jlexer.Lexer, out *VIPTier) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -547,29 +543,23 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid5(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "user":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.User = string(in.String())
-			}
-		case "type":
+		case "add":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Type = string(in.String())
+				out.Add = string(in.String())
 			}
-		case "startTime":
+		case "cross":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.StartTime = int64(in.Int64())
+				out.Cross = string(in.String())
 			}
-		case "endTime":
+		case "ntlCutoff":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.EndTime = int64(in.Int64())
+				out.NtlCutoff = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -581,57 +571,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid5(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid5(out *jwriter.Writer, in UserFundingHistory) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid5(out *jwriter.Writer, in VIPTier) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"add\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.User))
-	}
-	{
-		const prefix string = ",\"type\":"
-		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.String(string(in.Add))
 	}
 	{
-		const prefix string = ",\"startTime\":"
+		const prefix string = ",\"cross\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.StartTime))
+		out.String(string(in.Cross))
 	}
 	{
-		const prefix string = ",\"endTime\":"
+		const prefix string = ",\"ntlCutoff\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.EndTime))
+		out.String(string(in.NtlCutoff))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserFundingHistory) MarshalJSON() ([]byte, error) {
+func (v VIPTier) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserFundingHistory) MarshalEasyJSON(w *jwriter.Writer) {
+func (v VIPTier) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserFundingHistory) UnmarshalJSON(data []byte) error {
+func (v *VIPTier) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserFundingHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *VIPTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid5(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid6(in *jlexer.Lexer, out *UserFees) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid6(in *jlexer.Lexer, out *UserVolume) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -645,68 +630,29 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid6(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "activeReferralDiscount":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.ActiveReferralDiscount = string(in.String())
-			}
-		case "dailyUserVlm":
-			if in.IsNull() {
-				in.Skip()
-				out.DailyUserVolume = nil
-			} else {
-				in.Delim('[')
-				if out.DailyUserVolume == nil {
-					if !in.IsDelim(']') {
-						out.DailyUserVolume = make([]UserVolume, 0, 1)
-					} else {
-						out.DailyUserVolume = []UserVolume{}
-					}
-				} else {
-					out.DailyUserVolume = (out.DailyUserVolume)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v6 UserVolume
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v6).UnmarshalEasyJSON(in)
-					}
-					out.DailyUserVolume = append(out.DailyUserVolume, v6)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "feeSchedule":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.FeeSchedule).UnmarshalEasyJSON(in)
-			}
-		case "userAddRate":
+		case "date":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.UserAddRate = string(in.String())
+				out.Date = string(in.String())
 			}
-		case "userCrossRate":
+		case "exchange":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.UserCrossRate = string(in.String())
+				out.Exchange = string(in.String())
 			}
-		case "userSpotCrossRate":
+		case "userAdd":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.UserSpotCrossRate = string(in.String())
+				out.UserAdd = string(in.String())
 			}
-		case "userSpotAddRate":
+		case "userCross":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.UserSpotAddRate = string(in.String())
+				out.UserCross = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -718,83 +664,57 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid6(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid6(out *jwriter.Writer, in UserFees) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid6(out *jwriter.Writer, in UserVolume) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"activeReferralDiscount\":"
+		const prefix string = ",\"date\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ActiveReferralDiscount))
-	}
-	{
-		const prefix string = ",\"dailyUserVlm\":"
-		out.RawString(prefix)
-		if in.DailyUserVolume == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v7, v8 := range in.DailyUserVolume {
-				if v7 > 0 {
-					out.RawByte(',')
-				}
-				(v8).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"feeSchedule\":"
-		out.RawString(prefix)
-		(in.FeeSchedule).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"userAddRate\":"
-		out.RawString(prefix)
-		out.String(string(in.UserAddRate))
+		out.String(string(in.Date))
 	}
 	{
-		const prefix string = ",\"userCrossRate\":"
+		const prefix string = ",\"exchange\":"
 		out.RawString(prefix)
-		out.String(string(in.UserCrossRate))
+		out.String(string(in.Exchange))
 	}
 	{
-		const prefix string = ",\"userSpotCrossRate\":"
+		const prefix string = ",\"userAdd\":"
 		out.RawString(prefix)
-		out.String(string(in.UserSpotCrossRate))
+		out.String(string(in.UserAdd))
 	}
 	{
-		const prefix string = ",\"userSpotAddRate\":"
+		const prefix string = ",\"userCross\":"
 		out.RawString(prefix)
-		out.String(string(in.UserSpotAddRate))
+		out.String(string(in.UserCross))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserFees) MarshalJSON() ([]byte, error) {
+func (v UserVolume) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserFees) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserVolume) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserFees) UnmarshalJSON(data []byte) error {
+func (v *UserVolume) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserFees) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserVolume) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid6(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid7(in *jlexer.Lexer, out *UserActiveAssetData) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid7(in *jlexer.Lexer, out *UserVaultEquity) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -808,83 +728,23 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid7(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "user":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.User = string(in.String())
-			}
-		case "coin":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Coin = string(in.String())
-			}
-		case "leverage":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Leverage).UnmarshalEasyJSON(in)
-			}
-		case "maxTradeSzs":
+		case "vaultAddress":
 			if in.IsNull() {
 				in.Skip()
-				out.MaxTradeSzs = nil
 			} else {
-				in.Delim('[')
-				if out.MaxTradeSzs == nil {
-					if !in.IsDelim(']') {
-						out.MaxTradeSzs = make([]string, 0, 4)
-					} else {
-						out.MaxTradeSzs = []string{}
-					}
-				} else {
-					out.MaxTradeSzs = (out.MaxTradeSzs)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v9 string
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						v9 = string(in.String())
-					}
-					out.MaxTradeSzs = append(out.MaxTradeSzs, v9)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.VaultAddress = string(in.String())
 			}
-		case "availableToTrade":
+		case "equity":
 			if in.IsNull() {
 				in.Skip()
-				out.AvailableToTrade = nil
 			} else {
-				in.Delim('[')
-				if out.AvailableToTrade == nil {
-					if !in.IsDelim(']') {
-						out.AvailableToTrade = make([]string, 0, 4)
-					} else {
-						out.AvailableToTrade = []string{}
-					}
-				} else {
-					out.AvailableToTrade = (out.AvailableToTrade)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v10 string
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						v10 = string(in.String())
-					}
-					out.AvailableToTrade = append(out.AvailableToTrade, v10)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.Equity = string(in.String())
 			}
-		case "markPx":
+		case "lockedUntilTimestamp":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.MarkPx = string(in.String())
+				out.LockedUntilTimestamp = int64(in.Int64())
 			}
 		default:
 			in.SkipRecursive()
@@ -896,89 +756,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid7(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid7(out *jwriter.Writer, in UserActiveAssetData) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid7(out *jwriter.Writer, in UserVaultEquity) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"vaultAddress\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.User))
-	}
-	{
-		const prefix string = ",\"coin\":"
-		out.RawString(prefix)
-		out.String(string(in.Coin))
-	}
-	{
-		const prefix string = ",\"leverage\":"
-		out.RawString(prefix)
-		(in.Leverage).MarshalEasyJSON(out)
+		out.String(string(in.VaultAddress))
 	}
 	{
-		const prefix string = ",\"maxTradeSzs\":"
-		out.RawString(prefix)
-		if in.MaxTradeSzs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v11, v12 := range in.MaxTradeSzs {
-				if v11 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v12))
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"availableToTrade\":"
+		const prefix string = ",\"equity\":"
 		out.RawString(prefix)
-		if in.AvailableToTrade == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v13, v14 := range in.AvailableToTrade {
-				if v13 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v14))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Equity))
 	}
-	{
-		const prefix string = ",\"markPx\":"
+	if in.LockedUntilTimestamp != 0 {
+		const prefix string = ",\"lockedUntilTimestamp\":"
 		out.RawString(prefix)
-		out.String(string(in.MarkPx))
+		out.Int64(int64(in.LockedUntilTimestamp))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserActiveAssetData) MarshalJSON() ([]byte, error) {
+func (v UserVaultEquity) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserActiveAssetData) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserVaultEquity) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserActiveAssetData) UnmarshalJSON(data []byte) error {
+func (v *UserVaultEquity) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserActiveAssetData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserVaultEquity) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid7(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid8(in *jlexer.Lexer, out *TxStatus) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid8(in *jlexer.Lexer, out *UserState) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -992,17 +815,50 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid8(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "coin":
+		case "assetPositions":
 			if in.IsNull() {
 				in.Skip()
+				out.AssetPositions = nil
 			} else {
-				out.Coin = string(in.String())
+				in.Delim('[')
+				if out.AssetPositions == nil {
+					if !in.IsDelim(']') {
+						out.AssetPositions = make([]AssetPosition, 0, 0)
+					} else {
+						out.AssetPositions = []AssetPosition{}
+					}
+				} else {
+					out.AssetPositions = (out.AssetPositions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v6 AssetPosition
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v6).UnmarshalEasyJSON(in)
+					}
+					out.AssetPositions = append(out.AssetPositions, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "status":
+		case "crossMarginSummary":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Status = string(in.String())
+				(out.CrossMarginSummary).UnmarshalEasyJSON(in)
+			}
+		case "marginSummary":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.MarginSummary).UnmarshalEasyJSON(in)
+			}
+		case "withdrawable":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Withdrawable = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -1014,47 +870,68 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid8(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid8(out *jwriter.Writer, in TxStatus) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid8(out *jwriter.Writer, in UserState) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"coin\":"
+		const prefix string = ",\"assetPositions\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Coin))
-	}
-	{
-		const prefix string = ",\"status\":"
-		out.RawString(prefix)
-		out.String(string(in.Status))
-	}
+		if in.AssetPositions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v7, v8 := range in.AssetPositions {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				(v8).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"crossMarginSummary\":"
+		out.RawString(prefix)
+		(in.CrossMarginSummary).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"marginSummary\":"
+		out.RawString(prefix)
+		(in.MarginSummary).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"withdrawable\":"
+		out.RawString(prefix)
+		out.String(string(in.Withdrawable))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TxStatus) MarshalJSON() ([]byte, error) {
+func (v UserState) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TxStatus) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserState) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TxStatus) UnmarshalJSON(data []byte) error {
+func (v *UserState) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TxStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserState) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid8(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid9(in *jlexer.Lexer, out *TriggerOrderType) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid9(in *jlexer.Lexer, out *UserRateLimit) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1068,23 +945,29 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid9(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "triggerPx":
+		case "cumVlm":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TriggerPx = float64(in.Float64())
+				out.CumVlm = string(in.String())
 			}
-		case "isMarket":
+		case "nRequestsUsed":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.IsMarket = bool(in.Bool())
+				out.NRequestsUsed = int64(in.Int64())
 			}
-		case "tpsl":
+		case "nRequestsCap":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Tpsl = Tpsl(in.String())
+				out.NRequestsCap = int64(in.Int64())
+			}
+		case "nRequestsSurplus":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.NRequestsSurplus = int64(in.Int64())
 			}
 		default:
 			in.SkipRecursive()
@@ -1096,52 +979,57 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid9(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid9(out *jwriter.Writer, in TriggerOrderType) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid9(out *jwriter.Writer, in UserRateLimit) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"triggerPx\":"
+		const prefix string = ",\"cumVlm\":"
 		out.RawString(prefix[1:])
-		out.Float64(float64(in.TriggerPx))
+		out.String(string(in.CumVlm))
 	}
 	{
-		const prefix string = ",\"isMarket\":"
+		const prefix string = ",\"nRequestsUsed\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsMarket))
+		out.Int64(int64(in.NRequestsUsed))
 	}
 	{
-		const prefix string = ",\"tpsl\":"
+		const prefix string = ",\"nRequestsCap\":"
 		out.RawString(prefix)
-		out.String(string(in.Tpsl))
+		out.Int64(int64(in.NRequestsCap))
+	}
+	{
+		const prefix string = ",\"nRequestsSurplus\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.NRequestsSurplus))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TriggerOrderType) MarshalJSON() ([]byte, error) {
+func (v UserRateLimit) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TriggerOrderType) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserRateLimit) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TriggerOrderType) UnmarshalJSON(data []byte) error {
+func (v *UserRateLimit) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TriggerOrderType) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserRateLimit) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid9(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid10(in *jlexer.Lexer, out *TransferResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid10(in *jlexer.Lexer, out *UserFundingHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1155,23 +1043,29 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid10(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "status":
+		case "user":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Status = string(in.String())
+				out.User = string(in.String())
 			}
-		case "txHash":
+		case "type":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TxHash = string(in.String())
+				out.Type = string(in.String())
 			}
-		case "error":
+		case "startTime":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Error = string(in.String())
+				out.StartTime = int64(in.Int64())
+			}
+		case "endTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EndTime = int64(in.Int64())
 			}
 		default:
 			in.SkipRecursive()
@@ -1183,52 +1077,57 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid10(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid10(out *jwriter.Writer, in TransferResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid10(out *jwriter.Writer, in UserFundingHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"user\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Status))
+		out.String(string(in.User))
 	}
-	if in.TxHash != "" {
-		const prefix string = ",\"txHash\":"
+	{
+		const prefix string = ",\"type\":"
 		out.RawString(prefix)
-		out.String(string(in.TxHash))
+		out.String(string(in.Type))
 	}
-	if in.Error != "" {
-		const prefix string = ",\"error\":"
+	{
+		const prefix string = ",\"startTime\":"
 		out.RawString(prefix)
-		out.String(string(in.Error))
+		out.Int64(int64(in.StartTime))
+	}
+	{
+		const prefix string = ",\"endTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.EndTime))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TransferResponse) MarshalJSON() ([]byte, error) {
+func (v UserFundingHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TransferResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserFundingHistory) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TransferResponse) UnmarshalJSON(data []byte) error {
+func (v *UserFundingHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TransferResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserFundingHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid10(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid11(in *jlexer.Lexer, out *Tiers) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid11(in *jlexer.Lexer, out *UserFees) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1242,60 +1141,69 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid11(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "mm":
+		case "activeReferralDiscount":
 			if in.IsNull() {
 				in.Skip()
-				out.MM = nil
 			} else {
-				in.Delim('[')
-				if out.MM == nil {
-					if !in.IsDelim(']') {
-						out.MM = make([]MMTier, 0, 2)
-					} else {
-						out.MM = []MMTier{}
-					}
-				} else {
-					out.MM = (out.MM)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v15 MMTier
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v15).UnmarshalEasyJSON(in)
-					}
-					out.MM = append(out.MM, v15)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.ActiveReferralDiscount = string(in.String())
 			}
-		case "vip":
+		case "dailyUserVlm":
 			if in.IsNull() {
 				in.Skip()
-				out.VIP = nil
+				out.DailyUserVolume = nil
 			} else {
 				in.Delim('[')
-				if out.VIP == nil {
+				if out.DailyUserVolume == nil {
 					if !in.IsDelim(']') {
-						out.VIP = make([]VIPTier, 0, 1)
+						out.DailyUserVolume = make([]UserVolume, 0, 1)
 					} else {
-						out.VIP = []VIPTier{}
+						out.DailyUserVolume = []UserVolume{}
 					}
 				} else {
-					out.VIP = (out.VIP)[:0]
+					out.DailyUserVolume = (out.DailyUserVolume)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v16 VIPTier
+					var v9 UserVolume
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v16).UnmarshalEasyJSON(in)
+						(v9).UnmarshalEasyJSON(in)
 					}
-					out.VIP = append(out.VIP, v16)
+					out.DailyUserVolume = append(out.DailyUserVolume, v9)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "feeSchedule":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.FeeSchedule).UnmarshalEasyJSON(in)
+			}
+		case "userAddRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UserAddRate = string(in.String())
+			}
+		case "userCrossRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UserCrossRate = string(in.String())
+			}
+		case "userSpotCrossRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UserSpotCrossRate = string(in.String())
+			}
+		case "userSpotAddRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UserSpotAddRate = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1306,69 +1214,83 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid11(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid11(out *jwriter.Writer, in Tiers) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid11(out *jwriter.Writer, in UserFees) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"mm\":"
+		const prefix string = ",\"activeReferralDiscount\":"
 		out.RawString(prefix[1:])
-		if in.MM == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v17, v18 := range in.MM {
-				if v17 > 0 {
-					out.RawByte(',')
-				}
-				(v18).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.ActiveReferralDiscount))
 	}
 	{
-		const prefix string = ",\"vip\":"
+		const prefix string = ",\"dailyUserVlm\":"
 		out.RawString(prefix)
-		if in.VIP == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.DailyUserVolume == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v19, v20 := range in.VIP {
-				if v19 > 0 {
+			for v10, v11 := range in.DailyUserVolume {
+				if v10 > 0 {
 					out.RawByte(',')
 				}
-				(v20).MarshalEasyJSON(out)
+				(v11).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"feeSchedule\":"
+		out.RawString(prefix)
+		(in.FeeSchedule).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"userAddRate\":"
+		out.RawString(prefix)
+		out.String(string(in.UserAddRate))
+	}
+	{
+		const prefix string = ",\"userCrossRate\":"
+		out.RawString(prefix)
+		out.String(string(in.UserCrossRate))
+	}
+	{
+		const prefix string = ",\"userSpotCrossRate\":"
+		out.RawString(prefix)
+		out.String(string(in.UserSpotCrossRate))
+	}
+	{
+		const prefix string = ",\"userSpotAddRate\":"
+		out.RawString(prefix)
+		out.String(string(in.UserSpotAddRate))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Tiers) MarshalJSON() ([]byte, error) {
+func (v UserFees) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Tiers) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserFees) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Tiers) UnmarshalJSON(data []byte) error {
+func (v *UserFees) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Tiers) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserFees) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid11(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid12(in *jlexer.Lexer, out *SubAccount) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid12(in *jlexer.Lexer, out *UserActiveAssetData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1382,112 +1304,177 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid12(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "name":
+		case "user":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Name = string(in.String())
+				out.User = string(in.String())
 			}
-		case "user":
+		case "coin":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.User = string(in.String())
+				out.Coin = string(in.String())
 			}
-		case "permissions":
+		case "leverage":
 			if in.IsNull() {
 				in.Skip()
-				out.Permissions = nil
+			} else {
+				(out.Leverage).UnmarshalEasyJSON(in)
+			}
+		case "maxTradeSzs":
+			if in.IsNull() {
+				in.Skip()
+				out.MaxTradeSzs = nil
 			} else {
 				in.Delim('[')
-				if out.Permissions == nil {
+				if out.MaxTradeSzs == nil {
 					if !in.IsDelim(']') {
-						out.Permissions = make([]string, 0, 4)
+						out.MaxTradeSzs = make([]string, 0, 4)
 					} else {
-						out.Permissions = []string{}
+						out.MaxTradeSzs = []string{}
 					}
 				} else {
-					out.Permissions = (out.Permissions)[:0]
+					out.MaxTradeSzs = (out.MaxTradeSzs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v21 string
+					var v12 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						v21 = string(in.String())
+						v12 = string(in.String())
 					}
-					out.Permissions = append(out.Permissions, v21)
+					out.MaxTradeSzs = append(out.MaxTradeSzs, v12)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid12(out *jwriter.Writer, in SubAccount) {
-	out.RawByte('{')
-	first := true
+		case "availableToTrade":
+			if in.IsNull() {
+				in.Skip()
+				out.AvailableToTrade = nil
+			} else {
+				in.Delim('[')
+				if out.AvailableToTrade == nil {
+					if !in.IsDelim(']') {
+						out.AvailableToTrade = make([]string, 0, 4)
+					} else {
+						out.AvailableToTrade = []string{}
+					}
+				} else {
+					out.AvailableToTrade = (out.AvailableToTrade)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v13 = string(in.String())
+					}
+					out.AvailableToTrade = append(out.AvailableToTrade, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "markPx":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarkPx = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid12(out *jwriter.Writer, in UserActiveAssetData) {
+	out.RawByte('{')
+	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"user\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
+		out.String(string(in.User))
 	}
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix)
-		out.String(string(in.User))
+		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"permissions\":"
+		const prefix string = ",\"leverage\":"
 		out.RawString(prefix)
-		if in.Permissions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		(in.Leverage).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"maxTradeSzs\":"
+		out.RawString(prefix)
+		if in.MaxTradeSzs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v22, v23 := range in.Permissions {
-				if v22 > 0 {
+			for v14, v15 := range in.MaxTradeSzs {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v15))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"availableToTrade\":"
+		out.RawString(prefix)
+		if in.AvailableToTrade == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v16, v17 := range in.AvailableToTrade {
+				if v16 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v23))
+				out.String(string(v17))
 			}
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"markPx\":"
+		out.RawString(prefix)
+		out.String(string(in.MarkPx))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SubAccount) MarshalJSON() ([]byte, error) {
+func (v UserActiveAssetData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SubAccount) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserActiveAssetData) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SubAccount) UnmarshalJSON(data []byte) error {
+func (v *UserActiveAssetData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SubAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserActiveAssetData) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid12(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid13(in *jlexer.Lexer, out *StakingSummary) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid13(in *jlexer.Lexer, out *TxStatus) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1501,29 +1488,17 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid13(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "delegated":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Delegated = string(in.String())
-			}
-		case "undelegated":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Undelegated = string(in.String())
-			}
-		case "totalPendingWithdrawal":
+		case "coin":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TotalPendingWithdrawal = string(in.String())
+				out.Coin = string(in.String())
 			}
-		case "nPendingWithdrawals":
+		case "status":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.NPendingWithdrawals = int(in.Int())
+				out.Status = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -1535,57 +1510,47 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid13(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid13(out *jwriter.Writer, in StakingSummary) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid13(out *jwriter.Writer, in TxStatus) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"delegated\":"
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Delegated))
-	}
-	{
-		const prefix string = ",\"undelegated\":"
-		out.RawString(prefix)
-		out.String(string(in.Undelegated))
-	}
-	{
-		const prefix string = ",\"totalPendingWithdrawal\":"
-		out.RawString(prefix)
-		out.String(string(in.TotalPendingWithdrawal))
+		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"nPendingWithdrawals\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		out.Int(int(in.NPendingWithdrawals))
+		out.String(string(in.Status))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v StakingSummary) MarshalJSON() ([]byte, error) {
+func (v TxStatus) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StakingSummary) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TxStatus) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *StakingSummary) UnmarshalJSON(data []byte) error {
+func (v *TxStatus) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StakingSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TxStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid13(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid14(in *jlexer.Lexer, out *StakingReward) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid14(in *jlexer.Lexer, out *TriggerOrderType) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1599,23 +1564,23 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid14(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "time":
+		case "triggerPx":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Time = int64(in.Int64())
+				out.TriggerPx = float64(in.Float64())
 			}
-		case "source":
+		case "isMarket":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Source = string(in.String())
+				out.IsMarket = bool(in.Bool())
 			}
-		case "totalAmount":
+		case "tpsl":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TotalAmount = string(in.String())
+				out.Tpsl = Tpsl(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -1627,52 +1592,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid14(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid14(out *jwriter.Writer, in StakingReward) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid14(out *jwriter.Writer, in TriggerOrderType) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"time\":"
+		const prefix string = ",\"triggerPx\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.Time))
+		out.Float64(float64(in.TriggerPx))
 	}
 	{
-		const prefix string = ",\"source\":"
+		const prefix string = ",\"isMarket\":"
 		out.RawString(prefix)
-		out.String(string(in.Source))
+		out.Bool(bool(in.IsMarket))
 	}
 	{
-		const prefix string = ",\"totalAmount\":"
+		const prefix string = ",\"tpsl\":"
 		out.RawString(prefix)
-		out.String(string(in.TotalAmount))
+		out.String(string(in.Tpsl))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v StakingReward) MarshalJSON() ([]byte, error) {
+func (v TriggerOrderType) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StakingReward) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TriggerOrderType) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *StakingReward) UnmarshalJSON(data []byte) error {
+func (v *TriggerOrderType) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StakingReward) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TriggerOrderType) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid14(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid15(in *jlexer.Lexer, out *StakingDelegation) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid15(in *jlexer.Lexer, out *TransferResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1686,23 +1651,23 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid15(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "validator":
+		case "status":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Validator = string(in.String())
+				out.Status = string(in.String())
 			}
-		case "amount":
+		case "txHash":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Amount = string(in.String())
+				out.TxHash = string(in.String())
 			}
-		case "lockedUntilTimestamp":
+		case "error":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.LockedUntilTimestamp = int64(in.Int64())
+				out.Error = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -1714,52 +1679,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid15(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid15(out *jwriter.Writer, in StakingDelegation) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid15(out *jwriter.Writer, in TransferResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"validator\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Validator))
+		out.String(string(in.Status))
 	}
-	{
-		const prefix string = ",\"amount\":"
+	if in.TxHash != "" {
+		const prefix string = ",\"txHash\":"
 		out.RawString(prefix)
-		out.String(string(in.Amount))
+		out.String(string(in.TxHash))
 	}
-	{
-		const prefix string = ",\"lockedUntilTimestamp\":"
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.LockedUntilTimestamp))
+		out.String(string(in.Error))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v StakingDelegation) MarshalJSON() ([]byte, error) {
+func (v TransferResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StakingDelegation) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TransferResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *StakingDelegation) UnmarshalJSON(data []byte) error {
+func (v *TransferResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StakingDelegation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TransferResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid15(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid16(in *jlexer.Lexer, out *SpotUserState) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid16(in *jlexer.Lexer, out *Tiers) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1773,29 +1738,56 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid16(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "balances":
+		case "mm":
 			if in.IsNull() {
 				in.Skip()
-				out.Balances = nil
+				out.MM = nil
 			} else {
 				in.Delim('[')
-				if out.Balances == nil {
+				if out.MM == nil {
 					if !in.IsDelim(']') {
-						out.Balances = make([]SpotBalance, 0, 0)
+						out.MM = make([]MMTier, 0, 2)
 					} else {
-						out.Balances = []SpotBalance{}
+						out.MM = []MMTier{}
 					}
 				} else {
-					out.Balances = (out.Balances)[:0]
+					out.MM = (out.MM)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v18 MMTier
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v18).UnmarshalEasyJSON(in)
+					}
+					out.MM = append(out.MM, v18)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "vip":
+			if in.IsNull() {
+				in.Skip()
+				out.VIP = nil
+			} else {
+				in.Delim('[')
+				if out.VIP == nil {
+					if !in.IsDelim(']') {
+						out.VIP = make([]VIPTier, 0, 1)
+					} else {
+						out.VIP = []VIPTier{}
+					}
+				} else {
+					out.VIP = (out.VIP)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v24 SpotBalance
+					var v19 VIPTier
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v24).UnmarshalEasyJSON(in)
+						(v19).UnmarshalEasyJSON(in)
 					}
-					out.Balances = append(out.Balances, v24)
+					out.VIP = append(out.VIP, v19)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1810,22 +1802,38 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid16(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid16(out *jwriter.Writer, in SpotUserState) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid16(out *jwriter.Writer, in Tiers) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"balances\":"
+		const prefix string = ",\"mm\":"
 		out.RawString(prefix[1:])
-		if in.Balances == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.MM == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v25, v26 := range in.Balances {
-				if v25 > 0 {
+			for v20, v21 := range in.MM {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				(v21).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"vip\":"
+		out.RawString(prefix)
+		if in.VIP == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v22, v23 := range in.VIP {
+				if v22 > 0 {
 					out.RawByte(',')
 				}
-				(v26).MarshalEasyJSON(out)
+				(v23).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1834,29 +1842,29 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid16(out *jwriter.Writer,
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SpotUserState) MarshalJSON() ([]byte, error) {
+func (v Tiers) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid16(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotUserState) MarshalEasyJSON(w *jwriter.Writer) {
+func (v Tiers) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid16(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotUserState) UnmarshalJSON(data []byte) error {
+func (v *Tiers) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid16(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotUserState) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *Tiers) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid16(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid17(in *jlexer.Lexer, out *SpotTokenInfo) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid17(in *jlexer.Lexer, out *SubAccount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1876,63 +1884,38 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid17(in *jlexer.Lexer, ou
 			} else {
 				out.Name = string(in.String())
 			}
-		case "szDecimals":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.SzDecimals = int(in.Int())
-			}
-		case "weiDecimals":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.WeiDecimals = int(in.Int())
-			}
-		case "index":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Index = int(in.Int())
-			}
-		case "tokenId":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.TokenID = string(in.String())
-			}
-		case "isCanonical":
+		case "user":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.IsCanonical = bool(in.Bool())
+				out.User = string(in.String())
 			}
-		case "evmContract":
+		case "permissions":
 			if in.IsNull() {
 				in.Skip()
-				out.EvmContract = nil
+				out.Permissions = nil
 			} else {
-				if out.EvmContract == nil {
-					out.EvmContract = new(EvmContract)
-				}
-				if in.IsNull() {
-					in.Skip()
+				in.Delim('[')
+				if out.Permissions == nil {
+					if !in.IsDelim(']') {
+						out.Permissions = make([]string, 0, 4)
+					} else {
+						out.Permissions = []string{}
+					}
 				} else {
-					(*out.EvmContract).UnmarshalEasyJSON(in)
-				}
-			}
-		case "fullName":
-			if in.IsNull() {
-				in.Skip()
-				out.FullName = nil
-			} else {
-				if out.FullName == nil {
-					out.FullName = new(string)
+					out.Permissions = (out.Permissions)[:0]
 				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					*out.FullName = string(in.String())
+				for !in.IsDelim(']') {
+					var v24 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v24 = string(in.String())
+					}
+					out.Permissions = append(out.Permissions, v24)
+					in.WantComma()
 				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -1944,7 +1927,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid17(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid17(out *jwriter.Writer, in SpotTokenInfo) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid17(out *jwriter.Writer, in SubAccount) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1954,75 +1937,53 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid17(out *jwriter.Writer,
 		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"szDecimals\":"
-		out.RawString(prefix)
-		out.Int(int(in.SzDecimals))
-	}
-	{
-		const prefix string = ",\"weiDecimals\":"
-		out.RawString(prefix)
-		out.Int(int(in.WeiDecimals))
-	}
-	{
-		const prefix string = ",\"index\":"
-		out.RawString(prefix)
-		out.Int(int(in.Index))
-	}
-	{
-		const prefix string = ",\"tokenId\":"
-		out.RawString(prefix)
-		out.String(string(in.TokenID))
-	}
-	{
-		const prefix string = ",\"isCanonical\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsCanonical))
-	}
-	{
-		const prefix string = ",\"evmContract\":"
+		const prefix string = ",\"user\":"
 		out.RawString(prefix)
-		if in.EvmContract == nil {
-			out.RawString("null")
-		} else {
-			(*in.EvmContract).MarshalEasyJSON(out)
-		}
+		out.String(string(in.User))
 	}
 	{
-		const prefix string = ",\"fullName\":"
+		const prefix string = ",\"permissions\":"
 		out.RawString(prefix)
-		if in.FullName == nil {
+		if in.Permissions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.FullName))
+			out.RawByte('[')
+			for v25, v26 := range in.Permissions {
+				if v25 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v26))
+			}
+			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SpotTokenInfo) MarshalJSON() ([]byte, error) {
+func (v SubAccount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid17(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotTokenInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SubAccount) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid17(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotTokenInfo) UnmarshalJSON(data []byte) error {
+func (v *SubAccount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid17(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotTokenInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SubAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid17(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid18(in *jlexer.Lexer, out *SpotMetaAndAssetCtxs) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid18(in *jlexer.Lexer, out *StakingSummary) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2036,38 +1997,29 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid18(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Meta":
+		case "delegated":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Meta).UnmarshalEasyJSON(in)
+				out.Delegated = string(in.String())
 			}
-		case "Ctxs":
+		case "undelegated":
 			if in.IsNull() {
 				in.Skip()
-				out.Ctxs = nil
 			} else {
-				in.Delim('[')
-				if out.Ctxs == nil {
-					if !in.IsDelim(']') {
-						out.Ctxs = make([]SpotAssetCtx, 0, 0)
-					} else {
-						out.Ctxs = []SpotAssetCtx{}
-					}
-				} else {
-					out.Ctxs = (out.Ctxs)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v27 SpotAssetCtx
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v27).UnmarshalEasyJSON(in)
-					}
-					out.Ctxs = append(out.Ctxs, v27)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.Undelegated = string(in.String())
+			}
+		case "totalPendingWithdrawal":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TotalPendingWithdrawal = string(in.String())
+			}
+		case "nPendingWithdrawals":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.NPendingWithdrawals = int(in.Int())
 			}
 		default:
 			in.SkipRecursive()
@@ -2079,58 +2031,57 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid18(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid18(out *jwriter.Writer, in SpotMetaAndAssetCtxs) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid18(out *jwriter.Writer, in StakingSummary) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Meta\":"
+		const prefix string = ",\"delegated\":"
 		out.RawString(prefix[1:])
-		(in.Meta).MarshalEasyJSON(out)
+		out.String(string(in.Delegated))
 	}
 	{
-		const prefix string = ",\"Ctxs\":"
+		const prefix string = ",\"undelegated\":"
 		out.RawString(prefix)
-		if in.Ctxs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v28, v29 := range in.Ctxs {
-				if v28 > 0 {
-					out.RawByte(',')
-				}
-				(v29).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Undelegated))
+	}
+	{
+		const prefix string = ",\"totalPendingWithdrawal\":"
+		out.RawString(prefix)
+		out.String(string(in.TotalPendingWithdrawal))
+	}
+	{
+		const prefix string = ",\"nPendingWithdrawals\":"
+		out.RawString(prefix)
+		out.Int(int(in.NPendingWithdrawals))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SpotMetaAndAssetCtxs) MarshalJSON() ([]byte, error) {
+func (v StakingSummary) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid18(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotMetaAndAssetCtxs) MarshalEasyJSON(w *jwriter.Writer) {
+func (v StakingSummary) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid18(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotMetaAndAssetCtxs) UnmarshalJSON(data []byte) error {
+func (v *StakingSummary) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid18(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotMetaAndAssetCtxs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *StakingSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid18(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid19(in *jlexer.Lexer, out *SpotMeta) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid19(in *jlexer.Lexer, out *StakingReward) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2144,59 +2095,23 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid19(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "universe":
+		case "time":
 			if in.IsNull() {
 				in.Skip()
-				out.Universe = nil
 			} else {
-				in.Delim('[')
-				if out.Universe == nil {
-					if !in.IsDelim(']') {
-						out.Universe = make([]SpotAssetInfo, 0, 1)
-					} else {
-						out.Universe = []SpotAssetInfo{}
-					}
-				} else {
-					out.Universe = (out.Universe)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v30 SpotAssetInfo
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v30).UnmarshalEasyJSON(in)
-					}
-					out.Universe = append(out.Universe, v30)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.Time = int64(in.Int64())
 			}
-		case "tokens":
+		case "source":
 			if in.IsNull() {
 				in.Skip()
-				out.Tokens = nil
 			} else {
-				in.Delim('[')
-				if out.Tokens == nil {
-					if !in.IsDelim(']') {
-						out.Tokens = make([]SpotTokenInfo, 0, 0)
-					} else {
-						out.Tokens = []SpotTokenInfo{}
-					}
-				} else {
-					out.Tokens = (out.Tokens)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v31 SpotTokenInfo
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v31).UnmarshalEasyJSON(in)
-					}
-					out.Tokens = append(out.Tokens, v31)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.Source = string(in.String())
+			}
+		case "totalAmount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TotalAmount = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -2208,69 +2123,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid19(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid19(out *jwriter.Writer, in SpotMeta) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid19(out *jwriter.Writer, in StakingReward) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"universe\":"
+		const prefix string = ",\"time\":"
 		out.RawString(prefix[1:])
-		if in.Universe == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v32, v33 := range in.Universe {
-				if v32 > 0 {
-					out.RawByte(',')
-				}
-				(v33).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.Int64(int64(in.Time))
 	}
 	{
-		const prefix string = ",\"tokens\":"
+		const prefix string = ",\"source\":"
 		out.RawString(prefix)
-		if in.Tokens == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v34, v35 := range in.Tokens {
-				if v34 > 0 {
-					out.RawByte(',')
-				}
-				(v35).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Source))
+	}
+	{
+		const prefix string = ",\"totalAmount\":"
+		out.RawString(prefix)
+		out.String(string(in.TotalAmount))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SpotMeta) MarshalJSON() ([]byte, error) {
+func (v StakingReward) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid19(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotMeta) MarshalEasyJSON(w *jwriter.Writer) {
+func (v StakingReward) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid19(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotMeta) UnmarshalJSON(data []byte) error {
+func (v *StakingReward) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid19(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotMeta) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *StakingReward) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid19(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid20(in *jlexer.Lexer, out *SpotDeployResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid20(in *jlexer.Lexer, out *StakingDelegation) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2284,23 +2182,23 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid20(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "status":
+		case "validator":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Status = string(in.String())
+				out.Validator = string(in.String())
 			}
-		case "txHash":
+		case "amount":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TxHash = string(in.String())
+				out.Amount = string(in.String())
 			}
-		case "error":
+		case "lockedUntilTimestamp":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Error = string(in.String())
+				out.LockedUntilTimestamp = int64(in.Int64())
 			}
 		default:
 			in.SkipRecursive()
@@ -2312,52 +2210,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid20(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid20(out *jwriter.Writer, in SpotDeployResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid20(out *jwriter.Writer, in StakingDelegation) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"validator\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Status))
+		out.String(string(in.Validator))
 	}
-	if in.TxHash != "" {
-		const prefix string = ",\"txHash\":"
+	{
+		const prefix string = ",\"amount\":"
 		out.RawString(prefix)
-		out.String(string(in.TxHash))
+		out.String(string(in.Amount))
 	}
-	if in.Error != "" {
-		const prefix string = ",\"error\":"
+	{
+		const prefix string = ",\"lockedUntilTimestamp\":"
 		out.RawString(prefix)
-		out.String(string(in.Error))
+		out.Int64(int64(in.LockedUntilTimestamp))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SpotDeployResponse) MarshalJSON() ([]byte, error) {
+func (v StakingDelegation) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid20(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotDeployResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v StakingDelegation) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid20(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotDeployResponse) UnmarshalJSON(data []byte) error {
+func (v *StakingDelegation) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid20(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotDeployResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *StakingDelegation) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid20(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid21(in *jlexer.Lexer, out *SpotBalance) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid21(in *jlexer.Lexer, out *SpotUserState) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2371,35 +2269,32 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid21(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "coin":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Coin = string(in.String())
-			}
-		case "token":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Token = int(in.Int())
-			}
-		case "hold":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Hold = string(in.String())
-			}
-		case "total":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Total = string(in.String())
-			}
-		case "entryNtl":
+		case "balances":
 			if in.IsNull() {
 				in.Skip()
+				out.Balances = nil
 			} else {
-				out.EntryNtl = string(in.String())
+				in.Delim('[')
+				if out.Balances == nil {
+					if !in.IsDelim(']') {
+						out.Balances = make([]SpotBalance, 0, 0)
+					} else {
+						out.Balances = []SpotBalance{}
+					}
+				} else {
+					out.Balances = (out.Balances)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v27 SpotBalance
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v27).UnmarshalEasyJSON(in)
+					}
+					out.Balances = append(out.Balances, v27)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -2411,62 +2306,53 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid21(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid21(out *jwriter.Writer, in SpotBalance) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid21(out *jwriter.Writer, in SpotUserState) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"coin\":"
+		const prefix string = ",\"balances\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Coin))
-	}
-	{
-		const prefix string = ",\"token\":"
-		out.RawString(prefix)
-		out.Int(int(in.Token))
-	}
-	{
-		const prefix string = ",\"hold\":"
-		out.RawString(prefix)
-		out.String(string(in.Hold))
-	}
-	{
-		const prefix string = ",\"total\":"
-		out.RawString(prefix)
-		out.String(string(in.Total))
-	}
-	{
-		const prefix string = ",\"entryNtl\":"
-		out.RawString(prefix)
-		out.String(string(in.EntryNtl))
+		if in.Balances == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v28, v29 := range in.Balances {
+				if v28 > 0 {
+					out.RawByte(',')
+				}
+				(v29).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SpotBalance) MarshalJSON() ([]byte, error) {
+func (v SpotUserState) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid21(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotBalance) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SpotUserState) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotBalance) UnmarshalJSON(data []byte) error {
+func (v *SpotUserState) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotBalance) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotUserState) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid21(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid22(in *jlexer.Lexer, out *SpotAssetInfo) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid22(in *jlexer.Lexer, out *SpotTokenInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2486,32 +2372,17 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid22(in *jlexer.Lexer, ou
 			} else {
 				out.Name = string(in.String())
 			}
-		case "tokens":
+		case "szDecimals":
 			if in.IsNull() {
 				in.Skip()
-				out.Tokens = nil
 			} else {
-				in.Delim('[')
-				if out.Tokens == nil {
-					if !in.IsDelim(']') {
-						out.Tokens = make([]int, 0, 8)
-					} else {
-						out.Tokens = []int{}
-					}
-				} else {
-					out.Tokens = (out.Tokens)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v36 int
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						v36 = int(in.Int())
-					}
-					out.Tokens = append(out.Tokens, v36)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.SzDecimals = int(in.Int())
+			}
+		case "weiDecimals":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WeiDecimals = int(in.Int())
 			}
 		case "index":
 			if in.IsNull() {
@@ -2519,12 +2390,46 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid22(in *jlexer.Lexer, ou
 			} else {
 				out.Index = int(in.Int())
 			}
+		case "tokenId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TokenID = string(in.String())
+			}
 		case "isCanonical":
 			if in.IsNull() {
 				in.Skip()
 			} else {
 				out.IsCanonical = bool(in.Bool())
 			}
+		case "evmContract":
+			if in.IsNull() {
+				in.Skip()
+				out.EvmContract = nil
+			} else {
+				if out.EvmContract == nil {
+					out.EvmContract = new(EvmContract)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.EvmContract).UnmarshalEasyJSON(in)
+				}
+			}
+		case "fullName":
+			if in.IsNull() {
+				in.Skip()
+				out.FullName = nil
+			} else {
+				if out.FullName == nil {
+					out.FullName = new(string)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.FullName = string(in.String())
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2535,7 +2440,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid22(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid22(out *jwriter.Writer, in SpotAssetInfo) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid22(out *jwriter.Writer, in SpotTokenInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2545,58 +2450,75 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid22(out *jwriter.Writer,
 		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"tokens\":"
+		const prefix string = ",\"szDecimals\":"
 		out.RawString(prefix)
-		if in.Tokens == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v37, v38 := range in.Tokens {
-				if v37 > 0 {
-					out.RawByte(',')
-				}
-				out.Int(int(v38))
-			}
-			out.RawByte(']')
-		}
+		out.Int(int(in.SzDecimals))
+	}
+	{
+		const prefix string = ",\"weiDecimals\":"
+		out.RawString(prefix)
+		out.Int(int(in.WeiDecimals))
 	}
 	{
 		const prefix string = ",\"index\":"
 		out.RawString(prefix)
 		out.Int(int(in.Index))
 	}
+	{
+		const prefix string = ",\"tokenId\":"
+		out.RawString(prefix)
+		out.String(string(in.TokenID))
+	}
 	{
 		const prefix string = ",\"isCanonical\":"
 		out.RawString(prefix)
 		out.Bool(bool(in.IsCanonical))
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v SpotAssetInfo) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid22(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotAssetInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid22(w, v)
+	{
+		const prefix string = ",\"evmContract\":"
+		out.RawString(prefix)
+		if in.EvmContract == nil {
+			out.RawString("null")
+		} else {
+			(*in.EvmContract).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"fullName\":"
+		out.RawString(prefix)
+		if in.FullName == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.FullName))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SpotTokenInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SpotTokenInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid22(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotAssetInfo) UnmarshalJSON(data []byte) error {
+func (v *SpotTokenInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid22(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotAssetInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotTokenInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid22(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid23(in *jlexer.Lexer, out *SpotAssetCtx) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid23(in *jlexer.Lexer, out *SpotMetaAndAssetCtxs) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2610,49 +2532,38 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid23(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "dayNtlVlm":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.DayNtlVlm = string(in.String())
-			}
-		case "markPx":
+		case "Meta":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.MarkPx = string(in.String())
+				(out.Meta).UnmarshalEasyJSON(in)
 			}
-		case "midPx":
+		case "Ctxs":
 			if in.IsNull() {
 				in.Skip()
-				out.MidPx = nil
+				out.Ctxs = nil
 			} else {
-				if out.MidPx == nil {
-					out.MidPx = new(string)
-				}
-				if in.IsNull() {
-					in.Skip()
+				in.Delim('[')
+				if out.Ctxs == nil {
+					if !in.IsDelim(']') {
+						out.Ctxs = make([]SpotAssetCtx, 0, 0)
+					} else {
+						out.Ctxs = []SpotAssetCtx{}
+					}
 				} else {
-					*out.MidPx = string(in.String())
+					out.Ctxs = (out.Ctxs)[:0]
 				}
-			}
-		case "prevDayPx":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.PrevDayPx = string(in.String())
-			}
-		case "circulatingSupply":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.CirculatingSupply = string(in.String())
-			}
-		case "coin":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Coin = string(in.String())
+				for !in.IsDelim(']') {
+					var v30 SpotAssetCtx
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v30).UnmarshalEasyJSON(in)
+					}
+					out.Ctxs = append(out.Ctxs, v30)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -2664,71 +2575,58 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid23(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid23(out *jwriter.Writer, in SpotAssetCtx) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid23(out *jwriter.Writer, in SpotMetaAndAssetCtxs) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"dayNtlVlm\":"
+		const prefix string = ",\"Meta\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.DayNtlVlm))
-	}
-	{
-		const prefix string = ",\"markPx\":"
-		out.RawString(prefix)
-		out.String(string(in.MarkPx))
+		(in.Meta).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"midPx\":"
+		const prefix string = ",\"Ctxs\":"
 		out.RawString(prefix)
-		if in.MidPx == nil {
+		if in.Ctxs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.MidPx))
+			out.RawByte('[')
+			for v31, v32 := range in.Ctxs {
+				if v31 > 0 {
+					out.RawByte(',')
+				}
+				(v32).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"prevDayPx\":"
-		out.RawString(prefix)
-		out.String(string(in.PrevDayPx))
-	}
-	{
-		const prefix string = ",\"circulatingSupply\":"
-		out.RawString(prefix)
-		out.String(string(in.CirculatingSupply))
-	}
-	{
-		const prefix string = ",\"coin\":"
-		out.RawString(prefix)
-		out.String(string(in.Coin))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SpotAssetCtx) MarshalJSON() ([]byte, error) {
+func (v SpotMetaAndAssetCtxs) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid23(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotAssetCtx) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SpotMetaAndAssetCtxs) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid23(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotAssetCtx) UnmarshalJSON(data []byte) error {
+func (v *SpotMetaAndAssetCtxs) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid23(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotAssetCtx) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotMetaAndAssetCtxs) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid23(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid24(in *jlexer.Lexer, out *SetReferrerResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid24(in *jlexer.Lexer, out *SpotMeta) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2742,17 +2640,59 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid24(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "status":
+		case "universe":
 			if in.IsNull() {
 				in.Skip()
+				out.Universe = nil
 			} else {
-				out.Status = string(in.String())
+				in.Delim('[')
+				if out.Universe == nil {
+					if !in.IsDelim(']') {
+						out.Universe = make([]SpotAssetInfo, 0, 1)
+					} else {
+						out.Universe = []SpotAssetInfo{}
+					}
+				} else {
+					out.Universe = (out.Universe)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v33 SpotAssetInfo
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v33).UnmarshalEasyJSON(in)
+					}
+					out.Universe = append(out.Universe, v33)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "error":
+		case "tokens":
 			if in.IsNull() {
 				in.Skip()
+				out.Tokens = nil
 			} else {
-				out.Error = string(in.String())
+				in.Delim('[')
+				if out.Tokens == nil {
+					if !in.IsDelim(']') {
+						out.Tokens = make([]SpotTokenInfo, 0, 0)
+					} else {
+						out.Tokens = []SpotTokenInfo{}
+					}
+				} else {
+					out.Tokens = (out.Tokens)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 SpotTokenInfo
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v34).UnmarshalEasyJSON(in)
+					}
+					out.Tokens = append(out.Tokens, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -2764,47 +2704,69 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid24(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid24(out *jwriter.Writer, in SetReferrerResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid24(out *jwriter.Writer, in SpotMeta) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"universe\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Status))
+		if in.Universe == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.Universe {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				(v36).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
-	if in.Error != "" {
-		const prefix string = ",\"error\":"
+	{
+		const prefix string = ",\"tokens\":"
 		out.RawString(prefix)
-		out.String(string(in.Error))
+		if in.Tokens == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v37, v38 := range in.Tokens {
+				if v37 > 0 {
+					out.RawByte(',')
+				}
+				(v38).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SetReferrerResponse) MarshalJSON() ([]byte, error) {
+func (v SpotMeta) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid24(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SetReferrerResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SpotMeta) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid24(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SetReferrerResponse) UnmarshalJSON(data []byte) error {
+func (v *SpotMeta) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid24(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SetReferrerResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotMeta) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid24(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid25(in *jlexer.Lexer, out *ScheduleCancelResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid25(in *jlexer.Lexer, out *SpotDeployResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2824,6 +2786,12 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid25(in *jlexer.Lexer, ou
 			} else {
 				out.Status = string(in.String())
 			}
+		case "txHash":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TxHash = string(in.String())
+			}
 		case "error":
 			if in.IsNull() {
 				in.Skip()
@@ -2840,7 +2808,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid25(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid25(out *jwriter.Writer, in ScheduleCancelResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid25(out *jwriter.Writer, in SpotDeployResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2849,6 +2817,11 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid25(out *jwriter.Writer,
 		out.RawString(prefix[1:])
 		out.String(string(in.Status))
 	}
+	if in.TxHash != "" {
+		const prefix string = ",\"txHash\":"
+		out.RawString(prefix)
+		out.String(string(in.TxHash))
+	}
 	if in.Error != "" {
 		const prefix string = ",\"error\":"
 		out.RawString(prefix)
@@ -2858,29 +2831,29 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid25(out *jwriter.Writer,
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ScheduleCancelResponse) MarshalJSON() ([]byte, error) {
+func (v SpotDeployResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid25(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ScheduleCancelResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SpotDeployResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid25(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ScheduleCancelResponse) UnmarshalJSON(data []byte) error {
+func (v *SpotDeployResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid25(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ScheduleCancelResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotDeployResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid25(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid26(in *jlexer.Lexer, out *ReferralState) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid26(in *jlexer.Lexer, out *SpotBalance) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2894,44 +2867,35 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid26(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "referralCode":
+		case "coin":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.ReferralCode = string(in.String())
+				out.Coin = string(in.String())
 			}
-		case "referrer":
+		case "token":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Referrer = string(in.String())
+				out.Token = int(in.Int())
 			}
-		case "referred":
+		case "hold":
 			if in.IsNull() {
 				in.Skip()
-				out.Referred = nil
 			} else {
-				in.Delim('[')
-				if out.Referred == nil {
-					if !in.IsDelim(']') {
-						out.Referred = make([]string, 0, 4)
-					} else {
-						out.Referred = []string{}
-					}
-				} else {
-					out.Referred = (out.Referred)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v39 string
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						v39 = string(in.String())
-					}
-					out.Referred = append(out.Referred, v39)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.Hold = string(in.String())
+			}
+		case "total":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Total = string(in.String())
+			}
+		case "entryNtl":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EntryNtl = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -2943,63 +2907,62 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid26(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid26(out *jwriter.Writer, in ReferralState) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid26(out *jwriter.Writer, in SpotBalance) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"referralCode\":"
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ReferralCode))
+		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"referrer\":"
+		const prefix string = ",\"token\":"
 		out.RawString(prefix)
-		out.String(string(in.Referrer))
+		out.Int(int(in.Token))
 	}
 	{
-		const prefix string = ",\"referred\":"
+		const prefix string = ",\"hold\":"
 		out.RawString(prefix)
-		if in.Referred == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v40, v41 := range in.Referred {
-				if v40 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v41))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Hold))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.String(string(in.Total))
+	}
+	{
+		const prefix string = ",\"entryNtl\":"
+		out.RawString(prefix)
+		out.String(string(in.EntryNtl))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReferralState) MarshalJSON() ([]byte, error) {
+func (v SpotBalance) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid26(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReferralState) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SpotBalance) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid26(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReferralState) UnmarshalJSON(data []byte) error {
+func (v *SpotBalance) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid26(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReferralState) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotBalance) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid26(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid27(in *jlexer.Lexer, out *QueriedOrder) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid27(in *jlexer.Lexer, out *SpotAssetInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3013,103 +2976,50 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid27(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "coin":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Coin = string(in.String())
-			}
-		case "side":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Side = OrderSide(in.String())
-			}
-		case "limitPx":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.LimitPx = string(in.String())
-			}
-		case "sz":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Sz = string(in.String())
-			}
-		case "oid":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Oid = int64(in.Int64())
-			}
-		case "timestamp":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Timestamp = int64(in.Int64())
-			}
-		case "triggerCondition":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.TriggerCondition = string(in.String())
-			}
-		case "isTrigger":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.IsTrigger = bool(in.Bool())
-			}
-		case "triggerPx":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.TriggerPx = string(in.String())
-			}
-		case "isPositionTpsl":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.IsPositionTpsl = bool(in.Bool())
-			}
-		case "reduceOnly":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.ReduceOnly = bool(in.Bool())
-			}
-		case "orderType":
+		case "name":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.OrderType = string(in.String())
+				out.Name = string(in.String())
 			}
-		case "origSz":
+		case "tokens":
 			if in.IsNull() {
 				in.Skip()
+				out.Tokens = nil
 			} else {
-				out.OrigSz = string(in.String())
+				in.Delim('[')
+				if out.Tokens == nil {
+					if !in.IsDelim(']') {
+						out.Tokens = make([]int, 0, 8)
+					} else {
+						out.Tokens = []int{}
+					}
+				} else {
+					out.Tokens = (out.Tokens)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v39 int
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v39 = int(in.Int())
+					}
+					out.Tokens = append(out.Tokens, v39)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "tif":
+		case "index":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Tif = Tif(in.String())
+				out.Index = int(in.Int())
 			}
-		case "cloid":
+		case "isCanonical":
 			if in.IsNull() {
 				in.Skip()
-				out.Cloid = nil
 			} else {
-				if out.Cloid == nil {
-					out.Cloid = new(string)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					*out.Cloid = string(in.String())
-				}
+				out.IsCanonical = bool(in.Bool())
 			}
 		default:
 			in.SkipRecursive()
@@ -3121,116 +3031,68 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid27(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid27(out *jwriter.Writer, in QueriedOrder) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid27(out *jwriter.Writer, in SpotAssetInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"coin\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Coin))
-	}
-	{
-		const prefix string = ",\"side\":"
-		out.RawString(prefix)
-		out.String(string(in.Side))
-	}
-	{
-		const prefix string = ",\"limitPx\":"
-		out.RawString(prefix)
-		out.String(string(in.LimitPx))
-	}
-	{
-		const prefix string = ",\"sz\":"
-		out.RawString(prefix)
-		out.String(string(in.Sz))
-	}
-	{
-		const prefix string = ",\"oid\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Oid))
-	}
-	{
-		const prefix string = ",\"timestamp\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Timestamp))
-	}
-	{
-		const prefix string = ",\"triggerCondition\":"
-		out.RawString(prefix)
-		out.String(string(in.TriggerCondition))
-	}
-	{
-		const prefix string = ",\"isTrigger\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsTrigger))
-	}
-	{
-		const prefix string = ",\"triggerPx\":"
-		out.RawString(prefix)
-		out.String(string(in.TriggerPx))
-	}
-	{
-		const prefix string = ",\"isPositionTpsl\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsPositionTpsl))
-	}
-	{
-		const prefix string = ",\"reduceOnly\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ReduceOnly))
-	}
-	{
-		const prefix string = ",\"orderType\":"
-		out.RawString(prefix)
-		out.String(string(in.OrderType))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"origSz\":"
+		const prefix string = ",\"tokens\":"
 		out.RawString(prefix)
-		out.String(string(in.OrigSz))
+		if in.Tokens == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v40, v41 := range in.Tokens {
+				if v40 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v41))
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"tif\":"
+		const prefix string = ",\"index\":"
 		out.RawString(prefix)
-		out.String(string(in.Tif))
+		out.Int(int(in.Index))
 	}
 	{
-		const prefix string = ",\"cloid\":"
+		const prefix string = ",\"isCanonical\":"
 		out.RawString(prefix)
-		if in.Cloid == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Cloid))
-		}
+		out.Bool(bool(in.IsCanonical))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueriedOrder) MarshalJSON() ([]byte, error) {
+func (v SpotAssetInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid27(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v QueriedOrder) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SpotAssetInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid27(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueriedOrder) UnmarshalJSON(data []byte) error {
+func (v *SpotAssetInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid27(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *QueriedOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotAssetInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid27(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid28(in *jlexer.Lexer, out *Position) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid28(in *jlexer.Lexer, out *SpotAssetCtx) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3244,75 +3106,61 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid28(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "coin":
+		case "dayNtlVlm":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Coin = string(in.String())
+				out.DayNtlVlm = string(in.String())
 			}
-		case "entryPx":
+		case "dayBaseVlm":
 			if in.IsNull() {
 				in.Skip()
-				out.EntryPx = nil
 			} else {
-				if out.EntryPx == nil {
-					out.EntryPx = new(string)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					*out.EntryPx = string(in.String())
-				}
+				out.DayBaseVlm = string(in.String())
 			}
-		case "leverage":
+		case "markPx":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Leverage).UnmarshalEasyJSON(in)
+				out.MarkPx = string(in.String())
 			}
-		case "liquidationPx":
+		case "midPx":
 			if in.IsNull() {
 				in.Skip()
-				out.LiquidationPx = nil
+				out.MidPx = nil
 			} else {
-				if out.LiquidationPx == nil {
-					out.LiquidationPx = new(string)
+				if out.MidPx == nil {
+					out.MidPx = new(string)
 				}
 				if in.IsNull() {
 					in.Skip()
 				} else {
-					*out.LiquidationPx = string(in.String())
+					*out.MidPx = string(in.String())
 				}
 			}
-		case "marginUsed":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.MarginUsed = string(in.String())
-			}
-		case "positionValue":
+		case "prevDayPx":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.PositionValue = string(in.String())
+				out.PrevDayPx = string(in.String())
 			}
-		case "returnOnEquity":
+		case "circulatingSupply":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.ReturnOnEquity = string(in.String())
+				out.CirculatingSupply = string(in.String())
 			}
-		case "szi":
+		case "coin":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Szi = string(in.String())
+				out.Coin = string(in.String())
 			}
-		case "unrealizedPnl":
+		case "totalSupply":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.UnrealizedPnl = string(in.String())
+				out.TotalSupply = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -3324,90 +3172,81 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid28(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid28(out *jwriter.Writer, in Position) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid28(out *jwriter.Writer, in SpotAssetCtx) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"coin\":"
+		const prefix string = ",\"dayNtlVlm\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Coin))
+		out.String(string(in.DayNtlVlm))
 	}
 	{
-		const prefix string = ",\"entryPx\":"
+		const prefix string = ",\"dayBaseVlm\":"
 		out.RawString(prefix)
-		if in.EntryPx == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.EntryPx))
-		}
+		out.String(string(in.DayBaseVlm))
 	}
 	{
-		const prefix string = ",\"leverage\":"
+		const prefix string = ",\"markPx\":"
 		out.RawString(prefix)
-		(in.Leverage).MarshalEasyJSON(out)
+		out.String(string(in.MarkPx))
 	}
 	{
-		const prefix string = ",\"liquidationPx\":"
+		const prefix string = ",\"midPx\":"
 		out.RawString(prefix)
-		if in.LiquidationPx == nil {
+		if in.MidPx == nil {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.LiquidationPx))
+			out.String(string(*in.MidPx))
 		}
 	}
 	{
-		const prefix string = ",\"marginUsed\":"
-		out.RawString(prefix)
-		out.String(string(in.MarginUsed))
-	}
-	{
-		const prefix string = ",\"positionValue\":"
+		const prefix string = ",\"prevDayPx\":"
 		out.RawString(prefix)
-		out.String(string(in.PositionValue))
+		out.String(string(in.PrevDayPx))
 	}
 	{
-		const prefix string = ",\"returnOnEquity\":"
+		const prefix string = ",\"circulatingSupply\":"
 		out.RawString(prefix)
-		out.String(string(in.ReturnOnEquity))
+		out.String(string(in.CirculatingSupply))
 	}
 	{
-		const prefix string = ",\"szi\":"
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix)
-		out.String(string(in.Szi))
+		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"unrealizedPnl\":"
+		const prefix string = ",\"totalSupply\":"
 		out.RawString(prefix)
-		out.String(string(in.UnrealizedPnl))
+		out.String(string(in.TotalSupply))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Position) MarshalJSON() ([]byte, error) {
+func (v SpotAssetCtx) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid28(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Position) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SpotAssetCtx) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid28(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Position) UnmarshalJSON(data []byte) error {
+func (v *SpotAssetCtx) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid28(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Position) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotAssetCtx) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid28(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid29(in *jlexer.Lexer, out *PerpDexSchemaInput) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid29(in *jlexer.Lexer, out *SetReferrerResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3421,31 +3260,17 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid29(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "fullName":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.FullName = string(in.String())
-			}
-		case "collateralToken":
+		case "status":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.CollateralToken = int(in.Int())
+				out.Status = string(in.String())
 			}
-		case "oracleUpdater":
+		case "error":
 			if in.IsNull() {
 				in.Skip()
-				out.OracleUpdater = nil
 			} else {
-				if out.OracleUpdater == nil {
-					out.OracleUpdater = new(string)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					*out.OracleUpdater = string(in.String())
-				}
+				out.Error = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -3457,56 +3282,47 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid29(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid29(out *jwriter.Writer, in PerpDexSchemaInput) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid29(out *jwriter.Writer, in SetReferrerResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"fullName\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.FullName))
-	}
-	{
-		const prefix string = ",\"collateralToken\":"
-		out.RawString(prefix)
-		out.Int(int(in.CollateralToken))
+		out.String(string(in.Status))
 	}
-	{
-		const prefix string = ",\"oracleUpdater\":"
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
 		out.RawString(prefix)
-		if in.OracleUpdater == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.OracleUpdater))
-		}
+		out.String(string(in.Error))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PerpDexSchemaInput) MarshalJSON() ([]byte, error) {
+func (v SetReferrerResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid29(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PerpDexSchemaInput) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SetReferrerResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid29(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PerpDexSchemaInput) UnmarshalJSON(data []byte) error {
+func (v *SetReferrerResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid29(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PerpDexSchemaInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SetReferrerResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid29(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid30(in *jlexer.Lexer, out *PerpDeployResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid30(in *jlexer.Lexer, out *ScheduleCancelResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3526,8 +3342,12 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid30(in *jlexer.Lexer, ou
 			} else {
 				out.Status = string(in.String())
 			}
-		case "data":
-			easyjson6601e8cdDecode(in, &out.Data)
+		case "error":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Error = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3538,7 +3358,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid30(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid30(out *jwriter.Writer, in PerpDeployResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid30(out *jwriter.Writer, in ScheduleCancelResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3547,40 +3367,38 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid30(out *jwriter.Writer,
 		out.RawString(prefix[1:])
 		out.String(string(in.Status))
 	}
-	{
-		const prefix string = ",\"data\":"
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
 		out.RawString(prefix)
-		easyjson6601e8cdEncode(out, in.Data)
+		out.String(string(in.Error))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PerpDeployResponse) MarshalJSON() ([]byte, error) {
+func (v ScheduleCancelResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid30(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PerpDeployResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ScheduleCancelResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid30(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PerpDeployResponse) UnmarshalJSON(data []byte) error {
+func (v *ScheduleCancelResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid30(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PerpDeployResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ScheduleCancelResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid30(l, v)
 }
-func easyjson6601e8cdDecode(in *jlexer.Lexer, out *struct {
-	Statuses []TxStatus `json:"statuses"`
-}) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid31(in *jlexer.Lexer, out *ReferralState) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3594,29 +3412,41 @@ func easyjson6601e8cdDecode(in *jlexer.Lexer, out *struct {
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "statuses":
+		case "referralCode":
 			if in.IsNull() {
 				in.Skip()
-				out.Statuses = nil
+			} else {
+				out.ReferralCode = string(in.String())
+			}
+		case "referrer":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Referrer = string(in.String())
+			}
+		case "referred":
+			if in.IsNull() {
+				in.Skip()
+				out.Referred = nil
 			} else {
 				in.Delim('[')
-				if out.Statuses == nil {
+				if out.Referred == nil {
 					if !in.IsDelim(']') {
-						out.Statuses = make([]TxStatus, 0, 2)
+						out.Referred = make([]string, 0, 4)
 					} else {
-						out.Statuses = []TxStatus{}
+						out.Referred = []string{}
 					}
 				} else {
-					out.Statuses = (out.Statuses)[:0]
+					out.Referred = (out.Referred)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v42 TxStatus
+					var v42 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v42).UnmarshalEasyJSON(in)
+						v42 = string(in.String())
 					}
-					out.Statuses = append(out.Statuses, v42)
+					out.Referred = append(out.Referred, v42)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -3631,31 +3461,63 @@ func easyjson6601e8cdDecode(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncode(out *jwriter.Writer, in struct {
-	Statuses []TxStatus `json:"statuses"`
-}) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid31(out *jwriter.Writer, in ReferralState) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"statuses\":"
+		const prefix string = ",\"referralCode\":"
 		out.RawString(prefix[1:])
-		if in.Statuses == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.String(string(in.ReferralCode))
+	}
+	{
+		const prefix string = ",\"referrer\":"
+		out.RawString(prefix)
+		out.String(string(in.Referrer))
+	}
+	{
+		const prefix string = ",\"referred\":"
+		out.RawString(prefix)
+		if in.Referred == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v43, v44 := range in.Statuses {
+			for v43, v44 := range in.Referred {
 				if v43 > 0 {
 					out.RawByte(',')
 				}
-				(v44).MarshalEasyJSON(out)
+				out.String(string(v44))
 			}
 			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid31(in *jlexer.Lexer, out *OrderType) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReferralState) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReferralState) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReferralState) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReferralState) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid31(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid32(in *jlexer.Lexer, out *QueriedOrder) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3669,28 +3531,684 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid31(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "limit":
+		case "coin":
 			if in.IsNull() {
 				in.Skip()
-				out.Limit = nil
 			} else {
-				if out.Limit == nil {
-					out.Limit = new(LimitOrderType)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					(*out.Limit).UnmarshalEasyJSON(in)
-				}
+				out.Coin = string(in.String())
 			}
-		case "trigger":
+		case "side":
 			if in.IsNull() {
 				in.Skip()
-				out.Trigger = nil
 			} else {
-				if out.Trigger == nil {
-					out.Trigger = new(TriggerOrderType)
-				}
+				out.Side = OrderSide(in.String())
+			}
+		case "limitPx":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LimitPx = string(in.String())
+			}
+		case "sz":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Sz = string(in.String())
+			}
+		case "oid":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Oid = int64(in.Int64())
+			}
+		case "timestamp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Timestamp = int64(in.Int64())
+			}
+		case "triggerCondition":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TriggerCondition = string(in.String())
+			}
+		case "isTrigger":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsTrigger = bool(in.Bool())
+			}
+		case "triggerPx":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TriggerPx = string(in.String())
+			}
+		case "isPositionTpsl":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsPositionTpsl = bool(in.Bool())
+			}
+		case "reduceOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReduceOnly = bool(in.Bool())
+			}
+		case "orderType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderType = string(in.String())
+			}
+		case "origSz":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigSz = string(in.String())
+			}
+		case "tif":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Tif = Tif(in.String())
+			}
+		case "cloid":
+			if in.IsNull() {
+				in.Skip()
+				out.Cloid = nil
+			} else {
+				if out.Cloid == nil {
+					out.Cloid = new(string)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.Cloid = string(in.String())
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid32(out *jwriter.Writer, in QueriedOrder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"coin\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Coin))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"limitPx\":"
+		out.RawString(prefix)
+		out.String(string(in.LimitPx))
+	}
+	{
+		const prefix string = ",\"sz\":"
+		out.RawString(prefix)
+		out.String(string(in.Sz))
+	}
+	{
+		const prefix string = ",\"oid\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Oid))
+	}
+	{
+		const prefix string = ",\"timestamp\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	{
+		const prefix string = ",\"triggerCondition\":"
+		out.RawString(prefix)
+		out.String(string(in.TriggerCondition))
+	}
+	{
+		const prefix string = ",\"isTrigger\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsTrigger))
+	}
+	{
+		const prefix string = ",\"triggerPx\":"
+		out.RawString(prefix)
+		out.String(string(in.TriggerPx))
+	}
+	{
+		const prefix string = ",\"isPositionTpsl\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPositionTpsl))
+	}
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"orderType\":"
+		out.RawString(prefix)
+		out.String(string(in.OrderType))
+	}
+	{
+		const prefix string = ",\"origSz\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigSz))
+	}
+	{
+		const prefix string = ",\"tif\":"
+		out.RawString(prefix)
+		out.String(string(in.Tif))
+	}
+	{
+		const prefix string = ",\"cloid\":"
+		out.RawString(prefix)
+		if in.Cloid == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Cloid))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueriedOrder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueriedOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueriedOrder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueriedOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid32(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid33(in *jlexer.Lexer, out *Position) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "coin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Coin = string(in.String())
+			}
+		case "entryPx":
+			if in.IsNull() {
+				in.Skip()
+				out.EntryPx = nil
+			} else {
+				if out.EntryPx == nil {
+					out.EntryPx = new(string)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.EntryPx = string(in.String())
+				}
+			}
+		case "leverage":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Leverage).UnmarshalEasyJSON(in)
+			}
+		case "liquidationPx":
+			if in.IsNull() {
+				in.Skip()
+				out.LiquidationPx = nil
+			} else {
+				if out.LiquidationPx == nil {
+					out.LiquidationPx = new(string)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.LiquidationPx = string(in.String())
+				}
+			}
+		case "marginUsed":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarginUsed = string(in.String())
+			}
+		case "positionValue":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionValue = string(in.String())
+			}
+		case "returnOnEquity":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReturnOnEquity = string(in.String())
+			}
+		case "szi":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Szi = string(in.String())
+			}
+		case "unrealizedPnl":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UnrealizedPnl = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid33(out *jwriter.Writer, in Position) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"coin\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Coin))
+	}
+	{
+		const prefix string = ",\"entryPx\":"
+		out.RawString(prefix)
+		if in.EntryPx == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.EntryPx))
+		}
+	}
+	{
+		const prefix string = ",\"leverage\":"
+		out.RawString(prefix)
+		(in.Leverage).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"liquidationPx\":"
+		out.RawString(prefix)
+		if in.LiquidationPx == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.LiquidationPx))
+		}
+	}
+	{
+		const prefix string = ",\"marginUsed\":"
+		out.RawString(prefix)
+		out.String(string(in.MarginUsed))
+	}
+	{
+		const prefix string = ",\"positionValue\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionValue))
+	}
+	{
+		const prefix string = ",\"returnOnEquity\":"
+		out.RawString(prefix)
+		out.String(string(in.ReturnOnEquity))
+	}
+	{
+		const prefix string = ",\"szi\":"
+		out.RawString(prefix)
+		out.String(string(in.Szi))
+	}
+	{
+		const prefix string = ",\"unrealizedPnl\":"
+		out.RawString(prefix)
+		out.String(string(in.UnrealizedPnl))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Position) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Position) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Position) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Position) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid33(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid34(in *jlexer.Lexer, out *PerpDexSchemaInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "fullName":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FullName = string(in.String())
+			}
+		case "collateralToken":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CollateralToken = int(in.Int())
+			}
+		case "oracleUpdater":
+			if in.IsNull() {
+				in.Skip()
+				out.OracleUpdater = nil
+			} else {
+				if out.OracleUpdater == nil {
+					out.OracleUpdater = new(string)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.OracleUpdater = string(in.String())
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid34(out *jwriter.Writer, in PerpDexSchemaInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"fullName\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.FullName))
+	}
+	{
+		const prefix string = ",\"collateralToken\":"
+		out.RawString(prefix)
+		out.Int(int(in.CollateralToken))
+	}
+	{
+		const prefix string = ",\"oracleUpdater\":"
+		out.RawString(prefix)
+		if in.OracleUpdater == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.OracleUpdater))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PerpDexSchemaInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PerpDexSchemaInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PerpDexSchemaInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PerpDexSchemaInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid34(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid35(in *jlexer.Lexer, out *PerpDeployResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = string(in.String())
+			}
+		case "data":
+			easyjson6601e8cdDecode(in, &out.Data)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid35(out *jwriter.Writer, in PerpDeployResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		easyjson6601e8cdEncode(out, in.Data)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PerpDeployResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PerpDeployResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PerpDeployResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PerpDeployResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid35(l, v)
+}
+func easyjson6601e8cdDecode(in *jlexer.Lexer, out *struct {
+	Statuses []TxStatus `json:"statuses"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "statuses":
+			if in.IsNull() {
+				in.Skip()
+				out.Statuses = nil
+			} else {
+				in.Delim('[')
+				if out.Statuses == nil {
+					if !in.IsDelim(']') {
+						out.Statuses = make([]TxStatus, 0, 2)
+					} else {
+						out.Statuses = []TxStatus{}
+					}
+				} else {
+					out.Statuses = (out.Statuses)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v45 TxStatus
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v45).UnmarshalEasyJSON(in)
+					}
+					out.Statuses = append(out.Statuses, v45)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncode(out *jwriter.Writer, in struct {
+	Statuses []TxStatus `json:"statuses"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"statuses\":"
+		out.RawString(prefix[1:])
+		if in.Statuses == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v46, v47 := range in.Statuses {
+				if v46 > 0 {
+					out.RawByte(',')
+				}
+				(v47).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid36(in *jlexer.Lexer, out *OrderType) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "limit":
+			if in.IsNull() {
+				in.Skip()
+				out.Limit = nil
+			} else {
+				if out.Limit == nil {
+					out.Limit = new(LimitOrderType)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Limit).UnmarshalEasyJSON(in)
+				}
+			}
+		case "trigger":
+			if in.IsNull() {
+				in.Skip()
+				out.Trigger = nil
+			} else {
+				if out.Trigger == nil {
+					out.Trigger = new(TriggerOrderType)
+				}
 				if in.IsNull() {
 					in.Skip()
 				} else {
@@ -3707,7 +4225,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid31(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid31(out *jwriter.Writer, in OrderType) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid36(out *jwriter.Writer, in OrderType) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3733,27 +4251,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid31(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v OrderType) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid31(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid36(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v OrderType) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid31(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid36(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *OrderType) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid31(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid36(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *OrderType) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid31(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid36(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid32(in *jlexer.Lexer, out *OrderQueryResult) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid37(in *jlexer.Lexer, out *OrderQueryResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3789,7 +4307,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid32(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid32(out *jwriter.Writer, in OrderQueryResult) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid37(out *jwriter.Writer, in OrderQueryResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3809,27 +4327,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid32(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v OrderQueryResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid32(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid37(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v OrderQueryResult) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid32(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid37(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *OrderQueryResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid32(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid37(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *OrderQueryResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid32(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid37(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid33(in *jlexer.Lexer, out *OrderQueryResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid38(in *jlexer.Lexer, out *OrderQueryResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3871,7 +4389,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid33(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid33(out *jwriter.Writer, in OrderQueryResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid38(out *jwriter.Writer, in OrderQueryResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3896,27 +4414,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid33(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v OrderQueryResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid33(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid38(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v OrderQueryResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid33(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid38(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *OrderQueryResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid33(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid38(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *OrderQueryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid33(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid38(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid34(in *jlexer.Lexer, out *OpenOrder) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(in *jlexer.Lexer, out *OpenOrder) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3976,7 +4494,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid34(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid34(out *jwriter.Writer, in OpenOrder) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid39(out *jwriter.Writer, in OpenOrder) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4016,27 +4534,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid34(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v OpenOrder) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid34(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid39(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v OpenOrder) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid34(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid39(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *OpenOrder) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid34(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *OpenOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid34(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid35(in *jlexer.Lexer, out *MultiSigSigner) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid40(in *jlexer.Lexer, out *MultiSigSigner) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4072,7 +4590,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid35(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid35(out *jwriter.Writer, in MultiSigSigner) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid40(out *jwriter.Writer, in MultiSigSigner) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4092,27 +4610,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid35(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v MultiSigSigner) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid35(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid40(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MultiSigSigner) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid35(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid40(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MultiSigSigner) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid35(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid40(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MultiSigSigner) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid35(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid40(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid36(in *jlexer.Lexer, out *MultiSigResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid41(in *jlexer.Lexer, out *MultiSigResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4154,7 +4672,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid36(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid36(out *jwriter.Writer, in MultiSigResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid41(out *jwriter.Writer, in MultiSigResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4179,27 +4697,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid36(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v MultiSigResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid36(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid41(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MultiSigResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid36(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid41(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MultiSigResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid36(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid41(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MultiSigResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid36(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid41(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid37(in *jlexer.Lexer, out *MultiSigConversionResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(in *jlexer.Lexer, out *MultiSigConversionResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4241,7 +4759,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid37(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid37(out *jwriter.Writer, in MultiSigConversionResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(out *jwriter.Writer, in MultiSigConversionResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4266,27 +4784,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid37(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v MultiSigConversionResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid37(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MultiSigConversionResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid37(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MultiSigConversionResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid37(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MultiSigConversionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid37(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid38(in *jlexer.Lexer, out *ModifyResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(in *jlexer.Lexer, out *ModifyResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4322,9 +4840,9 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid38(in *jlexer.Lexer, ou
 					out.Data = (out.Data)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v45 OrderStatus
-					easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(in, &v45)
-					out.Data = append(out.Data, v45)
+					var v48 OrderStatus
+					easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid44(in, &v48)
+					out.Data = append(out.Data, v48)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4345,7 +4863,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid38(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid38(out *jwriter.Writer, in ModifyResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid43(out *jwriter.Writer, in ModifyResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4359,11 +4877,11 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid38(out *jwriter.Writer,
 		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v46, v47 := range in.Data {
-				if v46 > 0 {
+			for v49, v50 := range in.Data {
+				if v49 > 0 {
 					out.RawByte(',')
 				}
-				easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid39(out, v47)
+				easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid44(out, v50)
 			}
 			out.RawByte(']')
 		}
@@ -4379,27 +4897,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid38(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v ModifyResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid38(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid43(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ModifyResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid38(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid43(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ModifyResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid38(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ModifyResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid38(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(in *jlexer.Lexer, out *OrderStatus) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid44(in *jlexer.Lexer, out *OrderStatus) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4421,7 +4939,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(in *jlexer.Lexer, ou
 				if out.Resting == nil {
 					out.Resting = new(OrderStatusResting)
 				}
-				easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid40(in, out.Resting)
+				easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid45(in, out.Resting)
 			}
 		case "filled":
 			if in.IsNull() {
@@ -4431,7 +4949,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(in *jlexer.Lexer, ou
 				if out.Filled == nil {
 					out.Filled = new(OrderStatusFilled)
 				}
-				easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid41(in, out.Filled)
+				easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid46(in, out.Filled)
 			}
 		case "error":
 			if in.IsNull() {
@@ -4457,7 +4975,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid39(out *jwriter.Writer, in OrderStatus) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid44(out *jwriter.Writer, in OrderStatus) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4465,7 +4983,7 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid39(out *jwriter.Writer,
 		const prefix string = ",\"resting\":"
 		first = false
 		out.RawString(prefix[1:])
-		easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid40(out, *in.Resting)
+		easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid45(out, *in.Resting)
 	}
 	if in.Filled != nil {
 		const prefix string = ",\"filled\":"
@@ -4475,7 +4993,7 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid39(out *jwriter.Writer,
 		} else {
 			out.RawString(prefix)
 		}
-		easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid41(out, *in.Filled)
+		easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid46(out, *in.Filled)
 	}
 	if in.Error != nil {
 		const prefix string = ",\"error\":"
@@ -4489,7 +5007,7 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid39(out *jwriter.Writer,
 	}
 	out.RawByte('}')
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid41(in *jlexer.Lexer, out *OrderStatusFilled) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid46(in *jlexer.Lexer, out *OrderStatusFilled) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4531,7 +5049,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid41(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid41(out *jwriter.Writer, in OrderStatusFilled) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid46(out *jwriter.Writer, in OrderStatusFilled) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4552,7 +5070,7 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid41(out *jwriter.Writer,
 	}
 	out.RawByte('}')
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid40(in *jlexer.Lexer, out *OrderStatusResting) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid45(in *jlexer.Lexer, out *OrderStatusResting) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4602,7 +5120,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid40(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid40(out *jwriter.Writer, in OrderStatusResting) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid45(out *jwriter.Writer, in OrderStatusResting) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4627,7 +5145,7 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid40(out *jwriter.Writer,
 	}
 	out.RawByte('}')
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(in *jlexer.Lexer, out *MetaAndAssetCtxs) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(in *jlexer.Lexer, out *MetaAndAssetCtxs) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4657,13 +5175,13 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(in *jlexer.Lexer, ou
 					out.Ctxs = (out.Ctxs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v48 AssetCtx
+					var v51 AssetCtx
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v48).UnmarshalEasyJSON(in)
+						(v51).UnmarshalEasyJSON(in)
 					}
-					out.Ctxs = append(out.Ctxs, v48)
+					out.Ctxs = append(out.Ctxs, v51)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4684,13 +5202,13 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(in *jlexer.Lexer, ou
 					out.Universe = (out.Universe)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v49 AssetInfo
+					var v52 AssetInfo
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v49).UnmarshalEasyJSON(in)
+						(v52).UnmarshalEasyJSON(in)
 					}
-					out.Universe = append(out.Universe, v49)
+					out.Universe = append(out.Universe, v52)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4711,13 +5229,13 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(in *jlexer.Lexer, ou
 					out.MarginTables = (out.MarginTables)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v50 MarginTable
+					var v53 MarginTable
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v50).UnmarshalEasyJSON(in)
+						(v53).UnmarshalEasyJSON(in)
 					}
-					out.MarginTables = append(out.MarginTables, v50)
+					out.MarginTables = append(out.MarginTables, v53)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4732,7 +5250,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(out *jwriter.Writer, in MetaAndAssetCtxs) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid47(out *jwriter.Writer, in MetaAndAssetCtxs) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4743,11 +5261,11 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(out *jwriter.Writer,
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v51, v52 := range in.Ctxs {
-				if v51 > 0 {
+			for v54, v55 := range in.Ctxs {
+				if v54 > 0 {
 					out.RawByte(',')
 				}
-				(v52).MarshalEasyJSON(out)
+				(v55).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -4759,11 +5277,11 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(out *jwriter.Writer,
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v53, v54 := range in.Universe {
-				if v53 > 0 {
+			for v56, v57 := range in.Universe {
+				if v56 > 0 {
 					out.RawByte(',')
 				}
-				(v54).MarshalEasyJSON(out)
+				(v57).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -4775,11 +5293,11 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(out *jwriter.Writer,
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v55, v56 := range in.MarginTables {
-				if v55 > 0 {
+			for v58, v59 := range in.MarginTables {
+				if v58 > 0 {
 					out.RawByte(',')
 				}
-				(v56).MarshalEasyJSON(out)
+				(v59).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -4790,27 +5308,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v MetaAndAssetCtxs) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid47(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MetaAndAssetCtxs) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid47(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MetaAndAssetCtxs) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MetaAndAssetCtxs) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(in *jlexer.Lexer, out *Meta) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(in *jlexer.Lexer, out *Meta) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4840,13 +5358,13 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(in *jlexer.Lexer, ou
 					out.Universe = (out.Universe)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v57 AssetInfo
+					var v60 AssetInfo
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v57).UnmarshalEasyJSON(in)
+						(v60).UnmarshalEasyJSON(in)
 					}
-					out.Universe = append(out.Universe, v57)
+					out.Universe = append(out.Universe, v60)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4867,13 +5385,13 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(in *jlexer.Lexer, ou
 					out.MarginTables = (out.MarginTables)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v58 MarginTable
+					var v61 MarginTable
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v58).UnmarshalEasyJSON(in)
+						(v61).UnmarshalEasyJSON(in)
 					}
-					out.MarginTables = append(out.MarginTables, v58)
+					out.MarginTables = append(out.MarginTables, v61)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4888,7 +5406,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid43(out *jwriter.Writer, in Meta) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid48(out *jwriter.Writer, in Meta) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4899,11 +5417,11 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid43(out *jwriter.Writer,
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v59, v60 := range in.Universe {
-				if v59 > 0 {
+			for v62, v63 := range in.Universe {
+				if v62 > 0 {
 					out.RawByte(',')
 				}
-				(v60).MarshalEasyJSON(out)
+				(v63).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -4915,11 +5433,11 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid43(out *jwriter.Writer,
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v61, v62 := range in.MarginTables {
-				if v61 > 0 {
+			for v64, v65 := range in.MarginTables {
+				if v64 > 0 {
 					out.RawByte(',')
 				}
-				(v62).MarshalEasyJSON(out)
+				(v65).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -4930,27 +5448,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid43(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v Meta) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid43(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid48(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Meta) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid43(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid48(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Meta) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Meta) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid44(in *jlexer.Lexer, out *MarginTier) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(in *jlexer.Lexer, out *MarginTier) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4986,7 +5504,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid44(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid44(out *jwriter.Writer, in MarginTier) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid49(out *jwriter.Writer, in MarginTier) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5006,27 +5524,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid44(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v MarginTier) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid44(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid49(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarginTier) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid44(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid49(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarginTier) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid44(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarginTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid44(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid45(in *jlexer.Lexer, out *MarginTable) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(in *jlexer.Lexer, out *MarginTable) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5068,13 +5586,13 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid45(in *jlexer.Lexer, ou
 					out.MarginTiers = (out.MarginTiers)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v63 MarginTier
+					var v66 MarginTier
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v63).UnmarshalEasyJSON(in)
+						(v66).UnmarshalEasyJSON(in)
 					}
-					out.MarginTiers = append(out.MarginTiers, v63)
+					out.MarginTiers = append(out.MarginTiers, v66)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5089,7 +5607,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid45(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid45(out *jwriter.Writer, in MarginTable) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid50(out *jwriter.Writer, in MarginTable) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5110,11 +5628,11 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid45(out *jwriter.Writer,
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v64, v65 := range in.MarginTiers {
-				if v64 > 0 {
+			for v67, v68 := range in.MarginTiers {
+				if v67 > 0 {
 					out.RawByte(',')
 				}
-				(v65).MarshalEasyJSON(out)
+				(v68).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -5125,27 +5643,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid45(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v MarginTable) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid45(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid50(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarginTable) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid45(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid50(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarginTable) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid45(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarginTable) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid45(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid46(in *jlexer.Lexer, out *MarginSummary) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(in *jlexer.Lexer, out *MarginSummary) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5193,7 +5711,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid46(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid46(out *jwriter.Writer, in MarginSummary) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid51(out *jwriter.Writer, in MarginSummary) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5223,27 +5741,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid46(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v MarginSummary) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid46(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid51(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarginSummary) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid46(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid51(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarginSummary) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid46(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarginSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid46(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(in *jlexer.Lexer, out *MMTier) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid52(in *jlexer.Lexer, out *MMTier) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5263,11 +5781,259 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(in *jlexer.Lexer, ou
 			} else {
 				out.Add = string(in.String())
 			}
-		case "makerFractionCutoff":
+		case "makerFractionCutoff":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MakerFractionCutoff = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid52(out *jwriter.Writer, in MMTier) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"add\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Add))
+	}
+	{
+		const prefix string = ",\"makerFractionCutoff\":"
+		out.RawString(prefix)
+		out.String(string(in.MakerFractionCutoff))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MMTier) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid52(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MMTier) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid52(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MMTier) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid52(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MMTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid52(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(in *jlexer.Lexer, out *LimitOrderType) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "tif":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Tif = Tif(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid53(out *jwriter.Writer, in LimitOrderType) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tif\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Tif))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LimitOrderType) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid53(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LimitOrderType) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid53(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LimitOrderType) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LimitOrderType) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(in *jlexer.Lexer, out *Leverage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = string(in.String())
+			}
+		case "value":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Value = int(in.Int())
+			}
+		case "rawUsd":
+			if in.IsNull() {
+				in.Skip()
+				out.RawUsd = nil
+			} else {
+				if out.RawUsd == nil {
+					out.RawUsd = new(string)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.RawUsd = string(in.String())
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid54(out *jwriter.Writer, in Leverage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		out.Int(int(in.Value))
+	}
+	if in.RawUsd != nil {
+		const prefix string = ",\"rawUsd\":"
+		out.RawString(prefix)
+		out.String(string(*in.RawUsd))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Leverage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid54(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Leverage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid54(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Leverage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Leverage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid55(in *jlexer.Lexer, out *FundingHistory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "coin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Coin = string(in.String())
+			}
+		case "fundingRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FundingRate = string(in.String())
+			}
+		case "premium":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Premium = string(in.String())
+			}
+		case "time":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.MakerFractionCutoff = string(in.String())
+				out.Time = int64(in.Int64())
 			}
 		default:
 			in.SkipRecursive()
@@ -5279,47 +6045,57 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid47(out *jwriter.Writer, in MMTier) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid55(out *jwriter.Writer, in FundingHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"add\":"
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Add))
+		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"makerFractionCutoff\":"
+		const prefix string = ",\"fundingRate\":"
 		out.RawString(prefix)
-		out.String(string(in.MakerFractionCutoff))
+		out.String(string(in.FundingRate))
+	}
+	{
+		const prefix string = ",\"premium\":"
+		out.RawString(prefix)
+		out.String(string(in.Premium))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MMTier) MarshalJSON() ([]byte, error) {
+func (v FundingHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid47(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid55(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MMTier) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid47(w, v)
+func (v FundingHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid55(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MMTier) UnmarshalJSON(data []byte) error {
+func (v *FundingHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid55(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MMTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(l, v)
+func (v *FundingHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid55(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(in *jlexer.Lexer, out *LimitOrderType) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid56(in *jlexer.Lexer, out *FrontendOpenOrder) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5333,11 +6109,83 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "tif":
+		case "coin":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Tif = Tif(in.String())
+				out.Coin = string(in.String())
+			}
+		case "isPositionTpsl":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsPositionTpSl = bool(in.Bool())
+			}
+		case "isTrigger":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsTrigger = bool(in.Bool())
+			}
+		case "limitPx":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LimitPx = float64(in.Float64Str())
+			}
+		case "oid":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Oid = int64(in.Int64())
+			}
+		case "orderType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderType = string(in.String())
+			}
+		case "origSz":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigSz = float64(in.Float64Str())
+			}
+		case "reduceOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReduceOnly = bool(in.Bool())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = OrderSide(in.String())
+			}
+		case "sz":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Sz = float64(in.Float64Str())
+			}
+		case "timestamp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Timestamp = int64(in.Int64())
+			}
+		case "triggerCondition":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TriggerCondition = string(in.String())
+			}
+		case "triggerPx":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TriggerPx = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -5349,42 +6197,102 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid48(out *jwriter.Writer, in LimitOrderType) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid56(out *jwriter.Writer, in FrontendOpenOrder) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"tif\":"
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Tif))
+		out.String(string(in.Coin))
+	}
+	{
+		const prefix string = ",\"isPositionTpsl\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPositionTpSl))
+	}
+	{
+		const prefix string = ",\"isTrigger\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsTrigger))
+	}
+	{
+		const prefix string = ",\"limitPx\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.LimitPx))
+	}
+	{
+		const prefix string = ",\"oid\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Oid))
+	}
+	{
+		const prefix string = ",\"orderType\":"
+		out.RawString(prefix)
+		out.String(string(in.OrderType))
+	}
+	{
+		const prefix string = ",\"origSz\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.OrigSz))
+	}
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"sz\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.Sz))
+	}
+	{
+		const prefix string = ",\"timestamp\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	{
+		const prefix string = ",\"triggerCondition\":"
+		out.RawString(prefix)
+		out.String(string(in.TriggerCondition))
+	}
+	{
+		const prefix string = ",\"triggerPx\":"
+		out.RawString(prefix)
+		out.String(string(in.TriggerPx))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v LimitOrderType) MarshalJSON() ([]byte, error) {
+func (v FrontendOpenOrder) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid48(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid56(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v LimitOrderType) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid48(w, v)
+func (v FrontendOpenOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid56(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *LimitOrderType) UnmarshalJSON(data []byte) error {
+func (v *FrontendOpenOrder) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid56(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *LimitOrderType) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(l, v)
+func (v *FrontendOpenOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid56(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(in *jlexer.Lexer, out *Leverage) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid57(in *jlexer.Lexer, out *Fill) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5398,31 +6306,95 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "type":
+		case "closedPnl":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClosedPnl = string(in.String())
+			}
+		case "coin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Coin = string(in.String())
+			}
+		case "crossed":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Crossed = bool(in.Bool())
+			}
+		case "dir":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Dir = string(in.String())
+			}
+		case "hash":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Hash = string(in.String())
+			}
+		case "oid":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Oid = int64(in.Int64())
+			}
+		case "px":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = string(in.String())
+			}
+		case "startPosition":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StartPosition = string(in.String())
+			}
+		case "sz":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Size = string(in.String())
+			}
+		case "time":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Type = string(in.String())
+				out.Time = int64(in.Int64())
 			}
-		case "value":
+		case "fee":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Value = int(in.Int())
+				out.Fee = string(in.String())
 			}
-		case "rawUsd":
+		case "feeToken":
 			if in.IsNull() {
 				in.Skip()
-				out.RawUsd = nil
 			} else {
-				if out.RawUsd == nil {
-					out.RawUsd = new(string)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					*out.RawUsd = string(in.String())
-				}
+				out.FeeToken = string(in.String())
+			}
+		case "builderFee":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BuilderFee = string(in.String())
+			}
+		case "tid":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Tid = int64(in.Int64())
 			}
 		default:
 			in.SkipRecursive()
@@ -5434,52 +6406,112 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid49(out *jwriter.Writer, in Leverage) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid57(out *jwriter.Writer, in Fill) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"closedPnl\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.String(string(in.ClosedPnl))
 	}
 	{
-		const prefix string = ",\"value\":"
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix)
-		out.Int(int(in.Value))
+		out.String(string(in.Coin))
 	}
-	if in.RawUsd != nil {
-		const prefix string = ",\"rawUsd\":"
+	{
+		const prefix string = ",\"crossed\":"
 		out.RawString(prefix)
-		out.String(string(*in.RawUsd))
+		out.Bool(bool(in.Crossed))
+	}
+	{
+		const prefix string = ",\"dir\":"
+		out.RawString(prefix)
+		out.String(string(in.Dir))
+	}
+	{
+		const prefix string = ",\"hash\":"
+		out.RawString(prefix)
+		out.String(string(in.Hash))
+	}
+	{
+		const prefix string = ",\"oid\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Oid))
+	}
+	{
+		const prefix string = ",\"px\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"startPosition\":"
+		out.RawString(prefix)
+		out.String(string(in.StartPosition))
+	}
+	{
+		const prefix string = ",\"sz\":"
+		out.RawString(prefix)
+		out.String(string(in.Size))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"fee\":"
+		out.RawString(prefix)
+		out.String(string(in.Fee))
+	}
+	{
+		const prefix string = ",\"feeToken\":"
+		out.RawString(prefix)
+		out.String(string(in.FeeToken))
+	}
+	if in.BuilderFee != "" {
+		const prefix string = ",\"builderFee\":"
+		out.RawString(prefix)
+		out.String(string(in.BuilderFee))
+	}
+	{
+		const prefix string = ",\"tid\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Tid))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Leverage) MarshalJSON() ([]byte, error) {
+func (v Fill) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid49(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid57(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Leverage) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid49(w, v)
+func (v Fill) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid57(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Leverage) UnmarshalJSON(data []byte) error {
+func (v *Fill) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid57(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Leverage) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(l, v)
+func (v *Fill) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid57(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(in *jlexer.Lexer, out *FundingHistory) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid58(in *jlexer.Lexer, out *FeeSchedule) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5493,29 +6525,29 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "coin":
+		case "add":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Coin = string(in.String())
+				out.Add = string(in.String())
 			}
-		case "fundingRate":
+		case "cross":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.FundingRate = string(in.String())
+				out.Cross = string(in.String())
 			}
-		case "premium":
+		case "referralDiscount":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Premium = string(in.String())
+				out.ReferralDiscount = string(in.String())
 			}
-		case "time":
+		case "tiers":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Time = int64(in.Int64())
+				(out.Tiers).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -5527,57 +6559,57 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid50(out *jwriter.Writer, in FundingHistory) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid58(out *jwriter.Writer, in FeeSchedule) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"coin\":"
+		const prefix string = ",\"add\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Coin))
+		out.String(string(in.Add))
 	}
 	{
-		const prefix string = ",\"fundingRate\":"
+		const prefix string = ",\"cross\":"
 		out.RawString(prefix)
-		out.String(string(in.FundingRate))
+		out.String(string(in.Cross))
 	}
 	{
-		const prefix string = ",\"premium\":"
+		const prefix string = ",\"referralDiscount\":"
 		out.RawString(prefix)
-		out.String(string(in.Premium))
+		out.String(string(in.ReferralDiscount))
 	}
 	{
-		const prefix string = ",\"time\":"
+		const prefix string = ",\"tiers\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Time))
+		(in.Tiers).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FundingHistory) MarshalJSON() ([]byte, error) {
+func (v FeeSchedule) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid50(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid58(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FundingHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid50(w, v)
+func (v FeeSchedule) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid58(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FundingHistory) UnmarshalJSON(data []byte) error {
+func (v *FeeSchedule) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid58(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FundingHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(l, v)
+func (v *FeeSchedule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid58(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(in *jlexer.Lexer, out *FrontendOpenOrder) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid59(in *jlexer.Lexer, out *EvmContract) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5591,83 +6623,93 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "coin":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Coin = string(in.String())
-			}
-		case "isPositionTpsl":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.IsPositionTpSl = bool(in.Bool())
-			}
-		case "isTrigger":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.IsTrigger = bool(in.Bool())
-			}
-		case "limitPx":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.LimitPx = float64(in.Float64Str())
-			}
-		case "oid":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Oid = int64(in.Int64())
-			}
-		case "orderType":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.OrderType = string(in.String())
-			}
-		case "origSz":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.OrigSz = float64(in.Float64Str())
-			}
-		case "reduceOnly":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.ReduceOnly = bool(in.Bool())
-			}
-		case "side":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Side = OrderSide(in.String())
-			}
-		case "sz":
+		case "address":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Sz = float64(in.Float64Str())
+				out.Address = string(in.String())
 			}
-		case "timestamp":
+		case "evm_extra_wei_decimals":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Timestamp = int64(in.Int64())
+				out.EvmExtraWeiDecimals = int(in.Int())
 			}
-		case "triggerCondition":
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid59(out *jwriter.Writer, in EvmContract) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Address))
+	}
+	{
+		const prefix string = ",\"evm_extra_wei_decimals\":"
+		out.RawString(prefix)
+		out.Int(int(in.EvmExtraWeiDecimals))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EvmContract) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid59(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EvmContract) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid59(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EvmContract) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid59(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EvmContract) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid59(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(in *jlexer.Lexer, out *DelegatorWithdrawal) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "amount":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TriggerCondition = string(in.String())
+				out.Amount = string(in.String())
 			}
-		case "triggerPx":
+		case "phase":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TriggerPx = string(in.String())
+				out.Phase = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -5679,102 +6721,47 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid51(out *jwriter.Writer, in FrontendOpenOrder) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid60(out *jwriter.Writer, in DelegatorWithdrawal) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"coin\":"
+		const prefix string = ",\"amount\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Coin))
-	}
-	{
-		const prefix string = ",\"isPositionTpsl\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsPositionTpSl))
-	}
-	{
-		const prefix string = ",\"isTrigger\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsTrigger))
-	}
-	{
-		const prefix string = ",\"limitPx\":"
-		out.RawString(prefix)
-		out.Float64Str(float64(in.LimitPx))
-	}
-	{
-		const prefix string = ",\"oid\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Oid))
-	}
-	{
-		const prefix string = ",\"orderType\":"
-		out.RawString(prefix)
-		out.String(string(in.OrderType))
-	}
-	{
-		const prefix string = ",\"origSz\":"
-		out.RawString(prefix)
-		out.Float64Str(float64(in.OrigSz))
-	}
-	{
-		const prefix string = ",\"reduceOnly\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ReduceOnly))
-	}
-	{
-		const prefix string = ",\"side\":"
-		out.RawString(prefix)
-		out.String(string(in.Side))
-	}
-	{
-		const prefix string = ",\"sz\":"
-		out.RawString(prefix)
-		out.Float64Str(float64(in.Sz))
-	}
-	{
-		const prefix string = ",\"timestamp\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Timestamp))
-	}
-	{
-		const prefix string = ",\"triggerCondition\":"
-		out.RawString(prefix)
-		out.String(string(in.TriggerCondition))
+		out.String(string(in.Amount))
 	}
 	{
-		const prefix string = ",\"triggerPx\":"
+		const prefix string = ",\"phase\":"
 		out.RawString(prefix)
-		out.String(string(in.TriggerPx))
+		out.String(string(in.Phase))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FrontendOpenOrder) MarshalJSON() ([]byte, error) {
+func (v DelegatorWithdrawal) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid51(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid60(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FrontendOpenOrder) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid51(w, v)
+func (v DelegatorWithdrawal) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid60(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FrontendOpenOrder) UnmarshalJSON(data []byte) error {
+func (v *DelegatorWithdrawal) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FrontendOpenOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(l, v)
+func (v *DelegatorWithdrawal) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid52(in *jlexer.Lexer, out *Fill) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(in *jlexer.Lexer, out *DelegatorEvent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5788,95 +6775,23 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid52(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "closedPnl":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.ClosedPnl = string(in.String())
-			}
-		case "coin":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Coin = string(in.String())
-			}
-		case "crossed":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Crossed = bool(in.Bool())
-			}
-		case "dir":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Dir = string(in.String())
-			}
-		case "hash":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Hash = string(in.String())
-			}
-		case "oid":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Oid = int64(in.Int64())
-			}
-		case "px":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Price = string(in.String())
-			}
-		case "side":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Side = string(in.String())
-			}
-		case "startPosition":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.StartPosition = string(in.String())
-			}
-		case "sz":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Size = string(in.String())
-			}
 		case "time":
 			if in.IsNull() {
 				in.Skip()
 			} else {
 				out.Time = int64(in.Int64())
 			}
-		case "fee":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Fee = string(in.String())
-			}
-		case "feeToken":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.FeeToken = string(in.String())
-			}
-		case "builderFee":
+		case "hash":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.BuilderFee = string(in.String())
+				out.Hash = string(in.String())
 			}
-		case "tid":
+		case "delta":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Tid = int64(in.Int64())
+				(out.Delta).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -5888,112 +6803,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid52(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid52(out *jwriter.Writer, in Fill) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(out *jwriter.Writer, in DelegatorEvent) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"closedPnl\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.ClosedPnl))
-	}
-	{
-		const prefix string = ",\"coin\":"
-		out.RawString(prefix)
-		out.String(string(in.Coin))
-	}
-	{
-		const prefix string = ",\"crossed\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Crossed))
-	}
-	{
-		const prefix string = ",\"dir\":"
-		out.RawString(prefix)
-		out.String(string(in.Dir))
-	}
-	{
-		const prefix string = ",\"hash\":"
-		out.RawString(prefix)
-		out.String(string(in.Hash))
-	}
-	{
-		const prefix string = ",\"oid\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Oid))
-	}
-	{
-		const prefix string = ",\"px\":"
-		out.RawString(prefix)
-		out.String(string(in.Price))
-	}
-	{
-		const prefix string = ",\"side\":"
-		out.RawString(prefix)
-		out.String(string(in.Side))
-	}
-	{
-		const prefix string = ",\"startPosition\":"
-		out.RawString(prefix)
-		out.String(string(in.StartPosition))
-	}
-	{
-		const prefix string = ",\"sz\":"
-		out.RawString(prefix)
-		out.String(string(in.Size))
-	}
 	{
 		const prefix string = ",\"time\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Time))
-	}
-	{
-		const prefix string = ",\"fee\":"
-		out.RawString(prefix)
-		out.String(string(in.Fee))
-	}
-	{
-		const prefix string = ",\"feeToken\":"
-		out.RawString(prefix)
-		out.String(string(in.FeeToken))
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Time))
 	}
-	if in.BuilderFee != "" {
-		const prefix string = ",\"builderFee\":"
+	{
+		const prefix string = ",\"hash\":"
 		out.RawString(prefix)
-		out.String(string(in.BuilderFee))
+		out.String(string(in.Hash))
 	}
 	{
-		const prefix string = ",\"tid\":"
+		const prefix string = ",\"delta\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Tid))
+		(in.Delta).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Fill) MarshalJSON() ([]byte, error) {
+func (v DelegatorEvent) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid52(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Fill) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid52(w, v)
+func (v DelegatorEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Fill) UnmarshalJSON(data []byte) error {
+func (v *DelegatorEvent) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid52(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Fill) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid52(l, v)
+func (v *DelegatorEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(in *jlexer.Lexer, out *FeeSchedule) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid62(in *jlexer.Lexer, out *DelegatorDeposit) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6007,29 +6862,112 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "add":
+		case "amount":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Add = string(in.String())
+				out.Amount = string(in.String())
 			}
-		case "cross":
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid62(out *jwriter.Writer, in DelegatorDeposit) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Amount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DelegatorDeposit) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid62(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DelegatorDeposit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid62(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DelegatorDeposit) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid62(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DelegatorDeposit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid62(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid63(in *jlexer.Lexer, out *DelegatorDelta) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "delegate":
 			if in.IsNull() {
 				in.Skip()
+				out.Delegate = nil
 			} else {
-				out.Cross = string(in.String())
+				if out.Delegate == nil {
+					out.Delegate = new(DelegatorDelegate)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Delegate).UnmarshalEasyJSON(in)
+				}
 			}
-		case "referralDiscount":
+		case "cDeposit":
 			if in.IsNull() {
 				in.Skip()
+				out.CDeposit = nil
 			} else {
-				out.ReferralDiscount = string(in.String())
+				if out.CDeposit == nil {
+					out.CDeposit = new(DelegatorDeposit)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.CDeposit).UnmarshalEasyJSON(in)
+				}
 			}
-		case "tiers":
+		case "withdrawal":
 			if in.IsNull() {
 				in.Skip()
+				out.Withdrawal = nil
 			} else {
-				(out.Tiers).UnmarshalEasyJSON(in)
+				if out.Withdrawal == nil {
+					out.Withdrawal = new(DelegatorWithdrawal)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Withdrawal).UnmarshalEasyJSON(in)
+				}
 			}
 		default:
 			in.SkipRecursive()
@@ -6041,57 +6979,63 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid53(out *jwriter.Writer, in FeeSchedule) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid63(out *jwriter.Writer, in DelegatorDelta) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"add\":"
+	if in.Delegate != nil {
+		const prefix string = ",\"delegate\":"
+		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.Add))
-	}
-	{
-		const prefix string = ",\"cross\":"
-		out.RawString(prefix)
-		out.String(string(in.Cross))
+		(*in.Delegate).MarshalEasyJSON(out)
 	}
-	{
-		const prefix string = ",\"referralDiscount\":"
-		out.RawString(prefix)
-		out.String(string(in.ReferralDiscount))
+	if in.CDeposit != nil {
+		const prefix string = ",\"cDeposit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.CDeposit).MarshalEasyJSON(out)
 	}
-	{
-		const prefix string = ",\"tiers\":"
-		out.RawString(prefix)
-		(in.Tiers).MarshalEasyJSON(out)
+	if in.Withdrawal != nil {
+		const prefix string = ",\"withdrawal\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Withdrawal).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FeeSchedule) MarshalJSON() ([]byte, error) {
+func (v DelegatorDelta) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid53(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid63(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FeeSchedule) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid53(w, v)
+func (v DelegatorDelta) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid63(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FeeSchedule) UnmarshalJSON(data []byte) error {
+func (v *DelegatorDelta) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid63(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FeeSchedule) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(l, v)
+func (v *DelegatorDelta) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid63(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(in *jlexer.Lexer, out *EvmContract) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid64(in *jlexer.Lexer, out *DelegatorDelegate) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6105,17 +7049,23 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "address":
+		case "validator":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Address = string(in.String())
+				out.Validator = string(in.String())
 			}
-		case "evm_extra_wei_decimals":
+		case "amount":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.EvmExtraWeiDecimals = int(in.Int())
+				out.Amount = string(in.String())
+			}
+		case "isUndelegate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsUndelegate = bool(in.Bool())
 			}
 		default:
 			in.SkipRecursive()
@@ -6127,47 +7077,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid54(out *jwriter.Writer, in EvmContract) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid64(out *jwriter.Writer, in DelegatorDelegate) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"address\":"
+		const prefix string = ",\"validator\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Address))
+		out.String(string(in.Validator))
 	}
 	{
-		const prefix string = ",\"evm_extra_wei_decimals\":"
+		const prefix string = ",\"amount\":"
 		out.RawString(prefix)
-		out.Int(int(in.EvmExtraWeiDecimals))
+		out.String(string(in.Amount))
+	}
+	{
+		const prefix string = ",\"isUndelegate\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsUndelegate))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v EvmContract) MarshalJSON() ([]byte, error) {
+func (v DelegatorDelegate) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid54(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid64(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v EvmContract) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid54(w, v)
+func (v DelegatorDelegate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid64(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *EvmContract) UnmarshalJSON(data []byte) error {
+func (v *DelegatorDelegate) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid64(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *EvmContract) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(l, v)
+func (v *DelegatorDelegate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid64(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid55(in *jlexer.Lexer, out *CreateVaultResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid65(in *jlexer.Lexer, out *CreateVaultResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6209,7 +7164,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid55(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid55(out *jwriter.Writer, in CreateVaultResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid65(out *jwriter.Writer, in CreateVaultResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6234,27 +7189,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid55(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v CreateVaultResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid55(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid65(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateVaultResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid55(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid65(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateVaultResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid55(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid65(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateVaultResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid55(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid65(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid56(in *jlexer.Lexer, out *CreateSubAccountResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid66(in *jlexer.Lexer, out *CreateSubAccountResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6304,7 +7259,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid56(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid56(out *jwriter.Writer, in CreateSubAccountResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid66(out *jwriter.Writer, in CreateSubAccountResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6329,27 +7284,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid56(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v CreateSubAccountResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid56(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid66(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateSubAccountResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid56(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid66(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateSubAccountResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid56(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid66(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateSubAccountResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid56(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid66(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid57(in *jlexer.Lexer, out *Cloid) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid67(in *jlexer.Lexer, out *Cloid) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6379,7 +7334,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid57(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid57(out *jwriter.Writer, in Cloid) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid67(out *jwriter.Writer, in Cloid) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6394,27 +7349,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid57(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v Cloid) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid57(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid67(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Cloid) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid57(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid67(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Cloid) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid57(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid67(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Cloid) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid57(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid67(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid58(in *jlexer.Lexer, out *CancelResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid68(in *jlexer.Lexer, out *CancelResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6464,7 +7419,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid58(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid58(out *jwriter.Writer, in CancelResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid68(out *jwriter.Writer, in CancelResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6489,27 +7444,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid58(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v CancelResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid58(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid68(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CancelResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid58(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid68(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CancelResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid58(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid68(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CancelResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid58(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid68(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid59(in *jlexer.Lexer, out *CancelRequest) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid69(in *jlexer.Lexer, out *CancelRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6545,7 +7500,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid59(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid59(out *jwriter.Writer, in CancelRequest) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid69(out *jwriter.Writer, in CancelRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6565,27 +7520,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid59(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v CancelRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid59(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid69(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CancelRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid59(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid69(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CancelRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid59(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid69(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CancelRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid59(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid69(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(in *jlexer.Lexer, out *CancelByCloidRequest) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid70(in *jlexer.Lexer, out *CancelByCloidRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6621,7 +7576,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid60(out *jwriter.Writer, in CancelByCloidRequest) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid70(out *jwriter.Writer, in CancelByCloidRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6641,27 +7596,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid60(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v CancelByCloidRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid60(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid70(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CancelByCloidRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid60(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid70(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CancelByCloidRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid70(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CancelByCloidRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid70(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(in *jlexer.Lexer, out *BulkOrderResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid71(in *jlexer.Lexer, out *BulkOrderResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6697,9 +7652,9 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(in *jlexer.Lexer, ou
 					out.Data = (out.Data)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v66 OrderStatus
-					easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(in, &v66)
-					out.Data = append(out.Data, v66)
+					var v69 OrderStatus
+					easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid44(in, &v69)
+					out.Data = append(out.Data, v69)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -6720,7 +7675,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(out *jwriter.Writer, in BulkOrderResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid71(out *jwriter.Writer, in BulkOrderResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6734,11 +7689,11 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(out *jwriter.Writer,
 		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v67, v68 := range in.Data {
-				if v67 > 0 {
+			for v70, v71 := range in.Data {
+				if v70 > 0 {
 					out.RawByte(',')
 				}
-				easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid39(out, v68)
+				easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid44(out, v71)
 			}
 			out.RawByte(']')
 		}
@@ -6754,27 +7709,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v BulkOrderResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid71(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BulkOrderResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid71(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BulkOrderResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid71(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BulkOrderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid71(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid62(in *jlexer.Lexer, out *BulkCancelResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid72(in *jlexer.Lexer, out *BulkCancelResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6810,13 +7765,13 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid62(in *jlexer.Lexer, ou
 					out.Data = (out.Data)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v69 OpenOrder
+					var v72 OpenOrder
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v69).UnmarshalEasyJSON(in)
+						(v72).UnmarshalEasyJSON(in)
 					}
-					out.Data = append(out.Data, v69)
+					out.Data = append(out.Data, v72)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -6837,7 +7792,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid62(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid62(out *jwriter.Writer, in BulkCancelResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid72(out *jwriter.Writer, in BulkCancelResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6851,11 +7806,11 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid62(out *jwriter.Writer,
 		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v70, v71 := range in.Data {
-				if v70 > 0 {
+			for v73, v74 := range in.Data {
+				if v73 > 0 {
 					out.RawByte(',')
 				}
-				(v71).MarshalEasyJSON(out)
+				(v74).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -6871,27 +7826,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid62(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v BulkCancelResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid62(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid72(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BulkCancelResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid62(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid72(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BulkCancelResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid62(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid72(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BulkCancelResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid62(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid72(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid63(in *jlexer.Lexer, out *BuilderInfo) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid73(in *jlexer.Lexer, out *BuilderInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6927,7 +7882,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid63(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid63(out *jwriter.Writer, in BuilderInfo) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid73(out *jwriter.Writer, in BuilderInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6947,27 +7902,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid63(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v BuilderInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid63(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid73(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BuilderInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid63(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid73(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BuilderInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid63(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid73(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BuilderInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid63(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid73(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid64(in *jlexer.Lexer, out *AssetPosition) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid74(in *jlexer.Lexer, out *AssetPosition) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7003,7 +7958,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid64(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid64(out *jwriter.Writer, in AssetPosition) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid74(out *jwriter.Writer, in AssetPosition) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7023,27 +7978,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid64(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v AssetPosition) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid64(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid74(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AssetPosition) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid64(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid74(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AssetPosition) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid64(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid74(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AssetPosition) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid64(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid74(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid65(in *jlexer.Lexer, out *AssetInfo) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid75(in *jlexer.Lexer, out *AssetInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7103,7 +8058,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid65(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid65(out *jwriter.Writer, in AssetInfo) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid75(out *jwriter.Writer, in AssetInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7143,27 +8098,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid65(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v AssetInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid65(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid75(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AssetInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid65(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid75(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AssetInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid65(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid75(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AssetInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid65(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid75(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid66(in *jlexer.Lexer, out *AssetCtx) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid76(in *jlexer.Lexer, out *AssetCtx) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7241,13 +8196,13 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid66(in *jlexer.Lexer, ou
 					out.ImpactPxs = (out.ImpactPxs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v72 string
+					var v75 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						v72 = string(in.String())
+						v75 = string(in.String())
 					}
-					out.ImpactPxs = append(out.ImpactPxs, v72)
+					out.ImpactPxs = append(out.ImpactPxs, v75)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -7268,7 +8223,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid66(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid66(out *jwriter.Writer, in AssetCtx) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid76(out *jwriter.Writer, in AssetCtx) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7319,11 +8274,11 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid66(out *jwriter.Writer,
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v73, v74 := range in.ImpactPxs {
-				if v73 > 0 {
+			for v76, v77 := range in.ImpactPxs {
+				if v76 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v74))
+				out.String(string(v77))
 			}
 			out.RawByte(']')
 		}
@@ -7339,27 +8294,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid66(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v AssetCtx) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid66(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid76(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AssetCtx) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid66(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid76(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AssetCtx) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid66(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid76(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AssetCtx) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid66(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid76(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid67(in *jlexer.Lexer, out *ApprovalResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid77(in *jlexer.Lexer, out *ApprovalResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7401,7 +8356,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid67(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid67(out *jwriter.Writer, in ApprovalResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid77(out *jwriter.Writer, in ApprovalResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7426,27 +8381,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid67(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v ApprovalResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid67(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid77(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ApprovalResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid67(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid77(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ApprovalResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid67(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid77(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ApprovalResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid67(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid77(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid68(in *jlexer.Lexer, out *AgentApprovalResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid78(in *jlexer.Lexer, out *AgentApprovalResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7488,7 +8443,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid68(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid68(out *jwriter.Writer, in AgentApprovalResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid78(out *jwriter.Writer, in AgentApprovalResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7513,23 +8468,23 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid68(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v AgentApprovalResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid68(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid78(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AgentApprovalResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid68(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid78(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AgentApprovalResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid68(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid78(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AgentApprovalResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid68(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid78(l, v)
 }
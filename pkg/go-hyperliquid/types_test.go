@@ -289,3 +289,31 @@ func TestSpotAssetCtx_MarshalJSON(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+func TestWsUserNonFundingLedgerUpdates_UnmarshalJSON(t *testing.T) {
+	data := `{
+		"isSnapshot": false,
+		"user": "0xabc",
+		"nonFundingLedgerUpdates": [
+			{"time": 1700000000000, "hash": "0x01", "delta": {"type": "withdraw", "usdc": "1000.5", "nonce": 7, "fee": 1}},
+			{"time": 1700000000001, "hash": "0x02", "delta": {"type": "spotTransfer", "token": "HYPE", "amount": "3", "usdcValue": 75.3, "user": "0xabc", "destination": "0xdef", "fee": "0"}}
+		]
+	}`
+
+	var updates WsUserNonFundingLedgerUpdates
+	require.NoError(t, json.Unmarshal([]byte(data), &updates))
+	require.Equal(t, "0xabc", updates.User)
+	require.Equal(t, keyUserNonFundingLedgerUpdates("0xabc"), updates.Key())
+	require.Len(t, updates.Updates, 2)
+
+	withdraw := updates.Updates[0].Delta
+	assert.Equal(t, "withdraw", withdraw.Type)
+	assert.Equal(t, json.Number("1000.5"), withdraw.Usdc)
+	assert.Equal(t, json.Number("1"), withdraw.Fee)
+	assert.Equal(t, int64(7), withdraw.Nonce)
+
+	transfer := updates.Updates[1].Delta
+	assert.Equal(t, "HYPE", transfer.Token)
+	assert.Equal(t, json.Number("75.3"), transfer.UsdcValue)
+	assert.Equal(t, "0xdef", transfer.Destination)
+}
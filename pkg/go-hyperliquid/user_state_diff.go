@@ -0,0 +1,269 @@
+package hyperliquid
+
+import (
+	"sort"
+	"sync"
+)
+
+// AccountSnapshot is a normalized view of an account built from either a
+// clearinghouseState (UserState) REST response or a webData2 push, so that
+// consecutive snapshots from either source can be compared.
+type AccountSnapshot struct {
+	Positions    map[string]Position    // keyed by coin, flat positions omitted
+	SpotBalances map[string]SpotBalance // keyed by coin, zero balances omitted
+	Margin       MarginSummary          // cross margin summary when present
+	Withdrawable string
+}
+
+// SnapshotFromUserState builds a snapshot from a UserState and an optional
+// SpotUserState (nil leaves spot balances empty).
+func SnapshotFromUserState(state *UserState, spot *SpotUserState) AccountSnapshot {
+	snap := newAccountSnapshot()
+	if state != nil {
+		snap.addPositions(state.AssetPositions)
+		snap.Margin = state.CrossMarginSummary
+		if snap.Margin == (MarginSummary{}) {
+			snap.Margin = state.MarginSummary
+		}
+		snap.Withdrawable = state.Withdrawable
+	}
+	if spot != nil {
+		snap.addBalances(spot.Balances)
+	}
+	return snap
+}
+
+// SnapshotFromWebData2 builds a snapshot from a webData2 push.
+func SnapshotFromWebData2(data *WebData2) AccountSnapshot {
+	snap := newAccountSnapshot()
+	if data == nil {
+		return snap
+	}
+	if state := data.ClearinghouseState; state != nil {
+		snap.addPositions(state.AssetPositions)
+		switch {
+		case state.CrossMarginSummary != nil:
+			snap.Margin = *state.CrossMarginSummary
+		case state.MarginSummary != nil:
+			snap.Margin = *state.MarginSummary
+		}
+		snap.Withdrawable = state.Withdrawable
+	}
+	if data.SpotState != nil {
+		snap.addBalances(data.SpotState.Balances)
+	}
+	return snap
+}
+
+func newAccountSnapshot() AccountSnapshot {
+	return AccountSnapshot{
+		Positions:    make(map[string]Position),
+		SpotBalances: make(map[string]SpotBalance),
+	}
+}
+
+func (s AccountSnapshot) addPositions(positions []AssetPosition) {
+	for _, ap := range positions {
+		if ap.Position.Coin == "" || parseFloat(ap.Position.Szi) == 0 {
+			continue
+		}
+		s.Positions[ap.Position.Coin] = ap.Position
+	}
+}
+
+func (s AccountSnapshot) addBalances(balances []SpotBalance) {
+	for _, b := range balances {
+		if b.Coin == "" || parseFloat(b.Total) == 0 {
+			continue
+		}
+		s.SpotBalances[b.Coin] = b
+	}
+}
+
+// PositionChangeKind classifies a perp position change between snapshots.
+type PositionChangeKind string
+
+const (
+	PositionOpened  PositionChangeKind = "opened"  // flat -> non-zero
+	PositionClosed  PositionChangeKind = "closed"  // non-zero -> flat
+	PositionResized PositionChangeKind = "resized" // size changed, same side
+	PositionFlipped PositionChangeKind = "flipped" // long <-> short
+)
+
+// PositionChange describes how one coin's position changed. Szi values are
+// signed (positive long, negative short); Prev / Curr are nil when flat.
+type PositionChange struct {
+	Coin    string
+	Kind    PositionChangeKind
+	PrevSzi float64
+	Szi     float64
+	Prev    *Position
+	Curr    *Position
+}
+
+// Delta returns the signed size change.
+func (c PositionChange) Delta() float64 {
+	return c.Szi - c.PrevSzi
+}
+
+// BalanceChange describes a spot balance change; totals are zero when the
+// balance did not exist in that snapshot.
+type BalanceChange struct {
+	Coin      string
+	PrevTotal float64
+	Total     float64
+}
+
+// ValueChange is a numeric field before and after.
+type ValueChange struct {
+	Prev float64
+	Curr float64
+}
+
+// Delta returns Curr - Prev.
+func (c ValueChange) Delta() float64 {
+	return c.Curr - c.Prev
+}
+
+// MarginChange holds the margin fields of two snapshots. It is only reported
+// when at least one of them changed.
+type MarginChange struct {
+	AccountValue    ValueChange
+	TotalMarginUsed ValueChange
+	TotalNtlPos     ValueChange
+	Withdrawable    ValueChange
+}
+
+// UserStateDiff is the typed difference between two account snapshots.
+// Positions and Balances are sorted by coin.
+type UserStateDiff struct {
+	Positions []PositionChange
+	Balances  []BalanceChange
+	Margin    *MarginChange // nil when margin is unchanged
+}
+
+// Empty reports whether nothing changed.
+func (d UserStateDiff) Empty() bool {
+	return len(d.Positions) == 0 && len(d.Balances) == 0 && d.Margin == nil
+}
+
+// HoldingsChanged reports whether positions or spot balances changed,
+// ignoring margin-only changes caused by mark price moves.
+func (d UserStateDiff) HoldingsChanged() bool {
+	return len(d.Positions) > 0 || len(d.Balances) > 0
+}
+
+// DiffAccountSnapshots computes the changes from prev to curr.
+func DiffAccountSnapshots(prev, curr AccountSnapshot) UserStateDiff {
+	var diff UserStateDiff
+
+	for _, coin := range unionKeys(prev.Positions, curr.Positions) {
+		p, hadPrev := prev.Positions[coin]
+		c, hasCurr := curr.Positions[coin]
+		change := PositionChange{Coin: coin}
+		if hadPrev {
+			change.Prev, change.PrevSzi = &p, parseFloat(p.Szi)
+		}
+		if hasCurr {
+			change.Curr, change.Szi = &c, parseFloat(c.Szi)
+		}
+
+		switch {
+		case !hadPrev:
+			change.Kind = PositionOpened
+		case !hasCurr:
+			change.Kind = PositionClosed
+		case change.PrevSzi == change.Szi:
+			continue
+		case (change.PrevSzi > 0) != (change.Szi > 0):
+			change.Kind = PositionFlipped
+		default:
+			change.Kind = PositionResized
+		}
+		diff.Positions = append(diff.Positions, change)
+	}
+
+	for _, coin := range unionKeys(prev.SpotBalances, curr.SpotBalances) {
+		change := BalanceChange{
+			Coin:      coin,
+			PrevTotal: parseFloat(prev.SpotBalances[coin].Total),
+			Total:     parseFloat(curr.SpotBalances[coin].Total),
+		}
+		if change.PrevTotal != change.Total {
+			diff.Balances = append(diff.Balances, change)
+		}
+	}
+
+	margin := MarginChange{
+		AccountValue:    valueChange(prev.Margin.AccountValue, curr.Margin.AccountValue),
+		TotalMarginUsed: valueChange(prev.Margin.TotalMarginUsed, curr.Margin.TotalMarginUsed),
+		TotalNtlPos:     valueChange(prev.Margin.TotalNtlPos, curr.Margin.TotalNtlPos),
+		Withdrawable:    valueChange(prev.Withdrawable, curr.Withdrawable),
+	}
+	if margin.AccountValue.Delta() != 0 || margin.TotalMarginUsed.Delta() != 0 ||
+		margin.TotalNtlPos.Delta() != 0 || margin.Withdrawable.Delta() != 0 {
+		diff.Margin = &margin
+	}
+
+	return diff
+}
+
+func valueChange(prev, curr string) ValueChange {
+	return ValueChange{Prev: parseFloat(prev), Curr: parseFloat(curr)}
+}
+
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// UserStateDiffer keeps the latest snapshot per user and diffs each new
+// snapshot against it, for consumers of a UserState / webData2 stream.
+// It is safe for concurrent use.
+type UserStateDiffer struct {
+	mu    sync.Mutex
+	users map[string]AccountSnapshot
+}
+
+// NewUserStateDiffer creates an empty differ.
+func NewUserStateDiffer() *UserStateDiffer {
+	return &UserStateDiffer{users: make(map[string]AccountSnapshot)}
+}
+
+// Update stores snap as the latest snapshot for user and returns the diff
+// against the previous one. ok is false for the first snapshot of a user,
+// which only establishes the baseline.
+func (d *UserStateDiffer) Update(user string, snap AccountSnapshot) (diff UserStateDiff, ok bool) {
+	d.mu.Lock()
+	prev, seen := d.users[user]
+	d.users[user] = snap
+	d.mu.Unlock()
+
+	if !seen {
+		return UserStateDiff{}, false
+	}
+	return DiffAccountSnapshots(prev, snap), true
+}
+
+// Forget drops the stored snapshot for user; the next Update is a baseline.
+func (d *UserStateDiffer) Forget(user string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.users, user)
+}
+
+// Len returns the number of tracked users.
+func (d *UserStateDiffer) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.users)
+}
@@ -0,0 +1,94 @@
+package hyperliquid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPositions(kv ...string) []AssetPosition {
+	var out []AssetPosition
+	for i := 0; i < len(kv); i += 2 {
+		out = append(out, AssetPosition{Type: "oneWay", Position: Position{Coin: kv[i], Szi: kv[i+1]}})
+	}
+	return out
+}
+
+func TestDiffAccountSnapshots(t *testing.T) {
+	prev := SnapshotFromWebData2(&WebData2{
+		ClearinghouseState: &ClearinghouseState{
+			CrossMarginSummary: &MarginSummary{AccountValue: "1000", TotalMarginUsed: "100"},
+			Withdrawable:       "900",
+			AssetPositions:     testPositions("BTC", "0.5", "ETH", "-2", "SOL", "10", "DOGE", "0"),
+		},
+		SpotState: &SpotState{Balances: []SpotBalance{{Coin: "USDC", Total: "50"}, {Coin: "HYPE", Total: "1"}}},
+	})
+	curr := SnapshotFromUserState(&UserState{
+		CrossMarginSummary: MarginSummary{AccountValue: "1200", TotalMarginUsed: "100"},
+		Withdrawable:       "900",
+		AssetPositions:     testPositions("BTC", "0.8", "ETH", "1", "SOL", "10", "ARB", "-5"),
+	}, &SpotUserState{Balances: []SpotBalance{{Coin: "USDC", Total: "50"}, {Coin: "PURR", Total: "3"}}})
+
+	diff := DiffAccountSnapshots(prev, curr)
+	require.False(t, diff.Empty())
+	assert.True(t, diff.HoldingsChanged())
+
+	require.Len(t, diff.Positions, 3)
+	assert.Equal(t, "ARB", diff.Positions[0].Coin)
+	assert.Equal(t, PositionOpened, diff.Positions[0].Kind)
+	assert.Nil(t, diff.Positions[0].Prev)
+	assert.Equal(t, -5.0, diff.Positions[0].Delta())
+
+	assert.Equal(t, "BTC", diff.Positions[1].Coin)
+	assert.Equal(t, PositionResized, diff.Positions[1].Kind)
+	assert.InDelta(t, 0.3, diff.Positions[1].Delta(), 1e-9)
+
+	assert.Equal(t, "ETH", diff.Positions[2].Coin)
+	assert.Equal(t, PositionFlipped, diff.Positions[2].Kind)
+	assert.Equal(t, 3.0, diff.Positions[2].Delta())
+
+	assert.Equal(t, []BalanceChange{
+		{Coin: "HYPE", PrevTotal: 1, Total: 0},
+		{Coin: "PURR", PrevTotal: 0, Total: 3},
+	}, diff.Balances)
+
+	require.NotNil(t, diff.Margin)
+	assert.Equal(t, 200.0, diff.Margin.AccountValue.Delta())
+	assert.Zero(t, diff.Margin.Withdrawable.Delta())
+
+	// closing all positions
+	closed := DiffAccountSnapshots(curr, SnapshotFromUserState(&UserState{
+		CrossMarginSummary: curr.Margin,
+		Withdrawable:       "900",
+	}, &SpotUserState{Balances: []SpotBalance{{Coin: "USDC", Total: "50"}, {Coin: "PURR", Total: "3"}}}))
+	require.Len(t, closed.Positions, 4)
+	for _, c := range closed.Positions {
+		assert.Equal(t, PositionClosed, c.Kind)
+		assert.Nil(t, c.Curr)
+	}
+	assert.Empty(t, closed.Balances)
+	assert.Nil(t, closed.Margin)
+}
+
+func TestUserStateDiffer(t *testing.T) {
+	d := NewUserStateDiffer()
+	snap := SnapshotFromUserState(&UserState{AssetPositions: testPositions("BTC", "1")}, nil)
+
+	_, ok := d.Update("0xabc", snap)
+	assert.False(t, ok, "first snapshot is a baseline")
+
+	diff, ok := d.Update("0xabc", snap)
+	require.True(t, ok)
+	assert.True(t, diff.Empty())
+
+	diff, ok = d.Update("0xabc", SnapshotFromUserState(&UserState{AssetPositions: testPositions("BTC", "2")}, nil))
+	require.True(t, ok)
+	require.Len(t, diff.Positions, 1)
+	assert.Equal(t, PositionResized, diff.Positions[0].Kind)
+
+	assert.Equal(t, 1, d.Len())
+	d.Forget("0xabc")
+	_, ok = d.Update("0xabc", snap)
+	assert.False(t, ok)
+}
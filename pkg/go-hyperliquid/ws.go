@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"sync"
 	"sync/atomic"
@@ -40,6 +41,11 @@ type WebsocketClient struct {
 	reconnectWait         time.Duration
 	debug                 bool
 	logger                *zerolog.Logger
+	compression           bool
+	headers               http.Header
+	proxy                 *url.URL
+	wireBytes             atomic.Uint64
+	payloadBytes          atomic.Uint64
 }
 
 func NewWebsocketClient(baseURL string, opts ...WsOpt) *WebsocketClient {
@@ -72,6 +78,10 @@ func NewWebsocketClient(baseURL string, opts ...WsOpt) *WebsocketClient {
 			ChannelUserFills:     NewMsgDispatcher[WsOrderFills](ChannelUserFills),
 			ChannelSpotAssetCtxs: NewMsgDispatcher[SpotAssetCtxs](ChannelSpotAssetCtxs),
 			ChannelSubResponse:   NewNoopDispatcher(),
+
+			ChannelUserNonFundingLedgerUpdates: NewMsgDispatcher[WsUserNonFundingLedgerUpdates](
+				ChannelUserNonFundingLedgerUpdates,
+			),
 		},
 	}
 
@@ -90,10 +100,16 @@ func (w *WebsocketClient) Connect(ctx context.Context) error {
 		return nil
 	}
 
-	dialer := websocket.Dialer{}
+	dialer := websocket.Dialer{
+		EnableCompression: w.compression,
+		NetDialContext:    w.dialCounting,
+	}
+	if w.proxy != nil {
+		dialer.Proxy = http.ProxyURL(w.proxy)
+	}
 
 	//nolint:bodyclose // WebSocket connections don't have response bodies to close
-	conn, _, err := dialer.DialContext(ctx, w.url, nil)
+	conn, _, err := dialer.DialContext(ctx, w.url, w.headers)
 	if err != nil {
 		return fmt.Errorf("websocket dial: %w", err)
 	}
@@ -208,6 +224,7 @@ func (w *WebsocketClient) readPump(ctx context.Context) {
 				}
 				return
 			}
+			w.payloadBytes.Add(uint64(len(msg)))
 
 			if w.debug {
 				w.logDebugf("[<] %s", string(msg))
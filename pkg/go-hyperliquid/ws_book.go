@@ -0,0 +1,188 @@
+package hyperliquid
+
+import (
+	"sort"
+	"sync"
+)
+
+// Book is a locally maintained L2 order book fed by l2Book websocket updates.
+// Hyperliquid pushes full snapshots per update, so each message replaces the
+// book; updates with a timestamp older than the current book are dropped to
+// guard against out-of-order delivery after reconnects.
+type Book struct {
+	coin string
+
+	mu      sync.RWMutex
+	bids    []Level // sorted by price, descending
+	asks    []Level // sorted by price, ascending
+	time    int64
+	updates int64
+	stale   int64
+
+	sub *Subscription
+}
+
+// NewBook creates an empty book for coin. Use Apply to feed it manually or
+// WebsocketClient.SubscribeBook to keep it in sync with the exchange.
+func NewBook(coin string) *Book {
+	return &Book{coin: coin}
+}
+
+// SubscribeBook subscribes to l2Book updates for params.Coin and maintains a
+// local Book. onUpdate, if non-nil, is invoked after every applied update.
+func (w *WebsocketClient) SubscribeBook(
+	params L2BookSubscriptionParams,
+	onUpdate func(*Book, error),
+) (*Book, error) {
+	book := NewBook(params.Coin)
+
+	sub, err := w.L2Book(params, func(update L2Book, err error) {
+		if err != nil {
+			if onUpdate != nil {
+				onUpdate(book, err)
+			}
+			return
+		}
+		if book.Apply(update) && onUpdate != nil {
+			onUpdate(book, nil)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	book.mu.Lock()
+	book.sub = sub
+	book.mu.Unlock()
+
+	return book, nil
+}
+
+// Apply replaces the book with update. It returns false when the update is
+// for another coin or older than the current book.
+func (b *Book) Apply(update L2Book) bool {
+	if update.Coin != "" && b.coin != "" && update.Coin != b.coin {
+		return false
+	}
+
+	var bids, asks []Level
+	if len(update.Levels) > 0 {
+		bids = append(bids, update.Levels[0]...)
+	}
+	if len(update.Levels) > 1 {
+		asks = append(asks, update.Levels[1]...)
+	}
+	sort.Slice(bids, func(i, j int) bool { return bids[i].Px > bids[j].Px })
+	sort.Slice(asks, func(i, j int) bool { return asks[i].Px < asks[j].Px })
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if update.Time < b.time {
+		b.stale++
+		return false
+	}
+
+	b.bids = bids
+	b.asks = asks
+	b.time = update.Time
+	b.updates++
+	return true
+}
+
+// Coin returns the coin this book tracks.
+func (b *Book) Coin() string {
+	return b.coin
+}
+
+// Time returns the exchange timestamp (ms) of the last applied update.
+func (b *Book) Time() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.time
+}
+
+// BestBid returns the highest bid level.
+func (b *Book) BestBid() (Level, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if len(b.bids) == 0 {
+		return Level{}, false
+	}
+	return b.bids[0], true
+}
+
+// BestAsk returns the lowest ask level.
+func (b *Book) BestAsk() (Level, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if len(b.asks) == 0 {
+		return Level{}, false
+	}
+	return b.asks[0], true
+}
+
+// Mid returns the midpoint between best bid and best ask.
+func (b *Book) Mid() (float64, bool) {
+	bid, okBid := b.BestBid()
+	ask, okAsk := b.BestAsk()
+	if !okBid || !okAsk {
+		return 0, false
+	}
+	return (bid.Px + ask.Px) / 2, true
+}
+
+// DepthAt returns the cumulative size available from the top of the book up
+// to and including px: asks priced <= px when px is at or above the best ask,
+// bids priced >= px when px is at or below the best bid, and 0 inside the
+// spread.
+func (b *Book) DepthAt(px float64) float64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	var depth float64
+	if len(b.asks) > 0 && px >= b.asks[0].Px {
+		for _, lvl := range b.asks {
+			if lvl.Px > px {
+				break
+			}
+			depth += lvl.Sz
+		}
+		return depth
+	}
+	if len(b.bids) > 0 && px <= b.bids[0].Px {
+		for _, lvl := range b.bids {
+			if lvl.Px < px {
+				break
+			}
+			depth += lvl.Sz
+		}
+	}
+	return depth
+}
+
+// Levels returns copies of the bid and ask sides.
+func (b *Book) Levels() (bids, asks []Level) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return append([]Level(nil), b.bids...), append([]Level(nil), b.asks...)
+}
+
+// Stats returns the number of applied and dropped (stale) updates.
+func (b *Book) Stats() (updates, stale int64) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.updates, b.stale
+}
+
+// Close unsubscribes from the underlying l2Book subscription, if any.
+func (b *Book) Close() {
+	b.mu.Lock()
+	sub := b.sub
+	b.sub = nil
+	b.mu.Unlock()
+
+	if sub != nil && sub.Close != nil {
+		sub.Close()
+	}
+}
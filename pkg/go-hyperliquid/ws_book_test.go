@@ -0,0 +1,77 @@
+package hyperliquid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBook_Apply(t *testing.T) {
+	book := NewBook("BTC")
+
+	_, ok := book.BestBid()
+	assert.False(t, ok)
+	_, ok = book.Mid()
+	assert.False(t, ok)
+
+	// levels arrive unsorted; the book keeps bids descending and asks ascending
+	applied := book.Apply(L2Book{
+		Coin: "BTC",
+		Time: 100,
+		Levels: [][]Level{
+			{{Px: 99, Sz: 2}, {Px: 100, Sz: 1}, {Px: 98, Sz: 3}},
+			{{Px: 102, Sz: 2}, {Px: 101, Sz: 1}, {Px: 103, Sz: 4}},
+		},
+	})
+	require.True(t, applied)
+
+	bid, ok := book.BestBid()
+	require.True(t, ok)
+	assert.Equal(t, 100.0, bid.Px)
+
+	ask, ok := book.BestAsk()
+	require.True(t, ok)
+	assert.Equal(t, 101.0, ask.Px)
+
+	mid, ok := book.Mid()
+	require.True(t, ok)
+	assert.Equal(t, 100.5, mid)
+	assert.Equal(t, int64(100), book.Time())
+
+	t.Run("DepthAt", func(t *testing.T) {
+		assert.Equal(t, 1.0, book.DepthAt(101))
+		assert.Equal(t, 3.0, book.DepthAt(102.5))
+		assert.Equal(t, 7.0, book.DepthAt(200))
+		assert.Equal(t, 3.0, book.DepthAt(99))
+		assert.Equal(t, 6.0, book.DepthAt(1))
+		assert.Equal(t, 0.0, book.DepthAt(100.5), "inside the spread")
+	})
+
+	t.Run("StaleUpdateDropped", func(t *testing.T) {
+		assert.False(t, book.Apply(L2Book{Coin: "BTC", Time: 99, Levels: [][]Level{{}, {}}}))
+		bid, _ := book.BestBid()
+		assert.Equal(t, 100.0, bid.Px)
+
+		updates, stale := book.Stats()
+		assert.Equal(t, int64(1), updates)
+		assert.Equal(t, int64(1), stale)
+	})
+
+	t.Run("OtherCoinIgnored", func(t *testing.T) {
+		assert.False(t, book.Apply(L2Book{Coin: "ETH", Time: 200}))
+	})
+
+	t.Run("SnapshotReplacesBook", func(t *testing.T) {
+		require.True(t, book.Apply(L2Book{
+			Coin:   "BTC",
+			Time:   101,
+			Levels: [][]Level{{{Px: 97, Sz: 1}}, {}},
+		}))
+		bids, asks := book.Levels()
+		assert.Len(t, bids, 1)
+		assert.Empty(t, asks)
+		_, ok := book.BestAsk()
+		assert.False(t, ok)
+	})
+}
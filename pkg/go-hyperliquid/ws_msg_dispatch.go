@@ -7,6 +7,8 @@ import (
 
 type msgDispatcher interface {
 	Dispatch(subs []*uniqSubscriber, msg wsMessage) error
+	// accepts reports whether v has the payload type this dispatcher delivers.
+	accepts(v any) bool
 }
 
 type msgDispatcherFunc[T any] func(subs []*uniqSubscriber, msg wsMessage) error
@@ -15,6 +17,11 @@ func (d msgDispatcherFunc[T]) Dispatch(subs []*uniqSubscriber, msg wsMessage) er
 	return d(subs, msg)
 }
 
+func (d msgDispatcherFunc[T]) accepts(v any) bool {
+	_, ok := v.(T)
+	return ok
+}
+
 func NewMsgDispatcher[T subscriptable](channel string) msgDispatcher {
 	return msgDispatcherFunc[T](func(subs []*uniqSubscriber, msg wsMessage) error {
 		if msg.Channel != channel {
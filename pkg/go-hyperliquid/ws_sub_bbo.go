@@ -1,27 +1,51 @@
 package hyperliquid
 
-import "fmt"
-
 type BboSubscriptionParams struct {
 	Coin string
 }
 
+// Bbo subscribes to best bid/offer updates for params.Coin. The exchange
+// pushes a new Bbo whenever the top of the book changes on a block.
 func (w *WebsocketClient) Bbo(
 	params BboSubscriptionParams,
 	callback func(Bbo, error),
 ) (*Subscription, error) {
-	remotePayload := remoteBboSubscriptionPayload{
-		Type: ChannelBbo,
-		Coin: params.Coin,
+	return Subscribe(w, ChannelBbo, SubscriptionParams{Coin: params.Coin}, callback)
+}
+
+// BestBid returns the best bid level. The exchange sends null for an empty
+// side, which decodes to a zero Level and is reported as missing.
+func (b Bbo) BestBid() (Level, bool) {
+	return b.side(0)
+}
+
+// BestAsk returns the best ask level, see BestBid for empty sides.
+func (b Bbo) BestAsk() (Level, bool) {
+	return b.side(1)
+}
+
+// Mid returns the midpoint between best bid and best ask.
+func (b Bbo) Mid() (float64, bool) {
+	bid, okBid := b.BestBid()
+	ask, okAsk := b.BestAsk()
+	if !okBid || !okAsk {
+		return 0, false
 	}
+	return (bid.Px + ask.Px) / 2, true
+}
 
-	return w.subscribe(remotePayload, func(msg any) {
-		bbo, ok := msg.(Bbo)
-		if !ok {
-			callback(Bbo{}, fmt.Errorf("invalid message type"))
-			return
-		}
+// SpreadBps returns the bid/ask spread in basis points of the mid.
+func (b Bbo) SpreadBps() (float64, bool) {
+	mid, ok := b.Mid()
+	if !ok || mid <= 0 {
+		return 0, false
+	}
+	return (b.Bbo[1].Px - b.Bbo[0].Px) / mid * 1e4, true
+}
 
-		callback(bbo, nil)
-	})
+func (b Bbo) side(i int) (Level, bool) {
+	if len(b.Bbo) <= i || b.Bbo[i].Px <= 0 {
+		return Level{}, false
+	}
+	return b.Bbo[i], true
 }
@@ -0,0 +1,14 @@
+package hyperliquid
+
+type UserNonFundingLedgerUpdatesSubscriptionParams struct {
+	User string
+}
+
+// UserNonFundingLedgerUpdates subscribes to a user's deposits, withdrawals and transfers.
+// The first message is a snapshot of recent history (IsSnapshot is true).
+func (w *WebsocketClient) UserNonFundingLedgerUpdates(
+	params UserNonFundingLedgerUpdatesSubscriptionParams,
+	callback func(WsUserNonFundingLedgerUpdates, error),
+) (*Subscription, error) {
+	return Subscribe(w, ChannelUserNonFundingLedgerUpdates, SubscriptionParams{User: params.User}, callback)
+}
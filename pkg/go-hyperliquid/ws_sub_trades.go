@@ -1,29 +1,66 @@
 package hyperliquid
 
 import (
-	"fmt"
+	"strconv"
+	"strings"
 )
 
 type TradesSubscriptionParams struct {
 	Coin string
 }
 
+// Trades subscribes to public trades for params.Coin. Each message carries
+// the trades of one block, oldest first.
 func (w *WebsocketClient) Trades(
 	params TradesSubscriptionParams,
 	callback func([]Trade, error),
 ) (*Subscription, error) {
-	remotePayload := remoteTradesSubscriptionPayload{
-		Type: ChannelTrades,
-		Coin: params.Coin,
+	return Subscribe(w, ChannelTrades, SubscriptionParams{Coin: params.Coin},
+		func(trades Trades, err error) {
+			callback(trades, err)
+		})
+}
+
+// Price returns the trade price, or 0 if it cannot be parsed.
+func (t Trade) Price() float64 {
+	px, _ := strconv.ParseFloat(t.Px, 64)
+	return px
+}
+
+// Size returns the trade size, or 0 if it cannot be parsed.
+func (t Trade) Size() float64 {
+	sz, _ := strconv.ParseFloat(t.Sz, 64)
+	return sz
+}
+
+// TakerSide returns the aggressor side: SideBid when the taker bought,
+// SideAsk when the taker sold.
+func (t Trade) TakerSide() Side {
+	return Side(t.Side)
+}
+
+// Buyer returns the buying address. Users is [buyer, seller].
+func (t Trade) Buyer() string {
+	if len(t.Users) < 1 {
+		return ""
 	}
+	return t.Users[0]
+}
 
-	return w.subscribe(remotePayload, func(msg any) {
-		trades, ok := msg.(Trades)
-		if !ok {
-			callback(nil, fmt.Errorf("SubscribeToTrades invalid message type"))
-			return
-		}
+// Seller returns the selling address.
+func (t Trade) Seller() string {
+	if len(t.Users) < 2 {
+		return ""
+	}
+	return t.Users[1]
+}
 
-		callback(trades, nil)
-	})
+// Involves reports whether user was either counterparty of the trade.
+func (t Trade) Involves(user string) bool {
+	for _, u := range t.Users {
+		if strings.EqualFold(u, user) {
+			return true
+		}
+	}
+	return false
 }
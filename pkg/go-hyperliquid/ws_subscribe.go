@@ -0,0 +1,98 @@
+package hyperliquid
+
+import "fmt"
+
+// SubscriptionParams identifies a subscription on any channel. Only the fields
+// the channel understands are sent, the rest are ignored.
+type SubscriptionParams struct {
+	User     string  // userFills, orderUpdates, webData2, notification, userNonFundingLedgerUpdates
+	Coin     string  // trades, l2Book, candle, bbo
+	Interval string  // candle
+	Dex      *string // allMids
+	NSigFigs int     // l2Book
+	Mantissa int     // l2Book
+}
+
+// Subscribe subscribes to channel and delivers each message decoded into T,
+// the payload type the channel is registered with:
+//
+//	userFills                   WsOrderFills
+//	orderUpdates                WsOrders
+//	webData2                    WebData2
+//	trades                      Trades
+//	l2Book                      L2Book
+//	candle                      Candles
+//	allMids                     AllMids
+//	bbo                         Bbo
+//	notification                Notification
+//	spotAssetCtxs               SpotAssetCtxs
+//	userNonFundingLedgerUpdates WsUserNonFundingLedgerUpdates
+//
+// Subscriptions with the same key share one remote subscription and are
+// replayed after a reconnect. An error is returned when the channel is not
+// subscribable or T does not match its payload type.
+func Subscribe[T any](
+	w *WebsocketClient,
+	channel string,
+	params SubscriptionParams,
+	callback func(T, error),
+) (*Subscription, error) {
+	if callback == nil {
+		return nil, fmt.Errorf("callback cannot be nil")
+	}
+
+	payload, err := subscriptionPayload(channel, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var zero T
+	if dispatcher, ok := w.msgDispatcherRegistry[channel]; !ok || !dispatcher.accepts(zero) {
+		return nil, fmt.Errorf("channel %s does not deliver %T", channel, zero)
+	}
+
+	return w.subscribe(payload, func(msg any) {
+		data, ok := msg.(T)
+		if !ok {
+			callback(zero, fmt.Errorf("invalid message type %T on channel %s", msg, channel))
+			return
+		}
+
+		callback(data, nil)
+	})
+}
+
+// subscriptionPayload builds the remote subscription payload for channel.
+func subscriptionPayload(channel string, params SubscriptionParams) (subscriptable, error) {
+	switch channel {
+	case ChannelUserFills:
+		return remoteOrderFillsSubscriptionPayload{Type: channel, User: params.User}, nil
+	case ChannelOrderUpdates:
+		return remoteOrderUpdatesSubscriptionPayload{Type: channel, User: params.User}, nil
+	case ChannelWebData2:
+		return remoteWebData2SubscriptionPayload{Type: channel, User: params.User}, nil
+	case ChannelNotification:
+		return remoteNotificationSubscriptionPayload{Type: channel, User: params.User}, nil
+	case ChannelUserNonFundingLedgerUpdates:
+		return remoteUserNonFundingLedgerUpdatesSubscriptionPayload{Type: channel, User: params.User}, nil
+	case ChannelTrades:
+		return remoteTradesSubscriptionPayload{Type: channel, Coin: params.Coin}, nil
+	case ChannelBbo:
+		return remoteBboSubscriptionPayload{Type: channel, Coin: params.Coin}, nil
+	case ChannelL2Book:
+		return remoteL2BookSubscriptionPayload{
+			Type:     channel,
+			Coin:     params.Coin,
+			NSigFigs: params.NSigFigs,
+			Mantissa: params.Mantissa,
+		}, nil
+	case ChannelCandle:
+		return remoteCandlesSubscriptionPayload{Type: channel, Coin: params.Coin, Interval: params.Interval}, nil
+	case ChannelAllMids:
+		return remoteAllMidsSubscriptionPayload{Type: channel, Dex: params.Dex}, nil
+	case ChannelSpotAssetCtxs:
+		return remoteSpotAssetCtxsSubscriptionPayload{Type: channel}, nil
+	default:
+		return nil, fmt.Errorf("unsupported subscription channel: %s", channel)
+	}
+}
@@ -0,0 +1,195 @@
+package hyperliquid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribe(t *testing.T) {
+	t.Run("TypedPayload", func(t *testing.T) {
+		ws := NewWebsocketClient(MainnetAPIURL)
+
+		var got []WsOrderFills
+		sub, err := Subscribe(ws, ChannelUserFills, SubscriptionParams{User: "0xabc"},
+			func(fills WsOrderFills, err error) {
+				require.NoError(t, err)
+				got = append(got, fills)
+			})
+		require.NoError(t, err)
+
+		require.NoError(t, ws.dispatch(wsMessage{
+			Channel: ChannelUserFills,
+			Data:    []byte(`{"user":"0xabc","fills":[{"coin":"BTC","oid":1}]}`),
+		}))
+		// other users are not delivered
+		require.NoError(t, ws.dispatch(wsMessage{
+			Channel: ChannelUserFills,
+			Data:    []byte(`{"user":"0xdef","fills":[{"coin":"ETH","oid":2}]}`),
+		}))
+
+		require.Len(t, got, 1)
+		assert.Equal(t, "0xabc", got[0].User)
+		require.Len(t, got[0].Fills, 1)
+		assert.Equal(t, int64(1), got[0].Fills[0].Oid)
+
+		sub.Close()
+		require.NoError(t, ws.dispatch(wsMessage{
+			Channel: ChannelUserFills,
+			Data:    []byte(`{"user":"0xabc","fills":[]}`),
+		}))
+		assert.Len(t, got, 1)
+	})
+
+	t.Run("SliceChannel", func(t *testing.T) {
+		ws := NewWebsocketClient(MainnetAPIURL)
+
+		var got Trades
+		_, err := Subscribe(ws, ChannelTrades, SubscriptionParams{Coin: "BTC"},
+			func(trades Trades, err error) {
+				require.NoError(t, err)
+				got = trades
+			})
+		require.NoError(t, err)
+
+		require.NoError(t, ws.dispatch(wsMessage{
+			Channel: ChannelTrades,
+			Data:    []byte(`[{"coin":"BTC","side":"B","px":"100","sz":"1"}]`),
+		}))
+		require.Len(t, got, 1)
+		assert.Equal(t, "100", got[0].Px)
+	})
+
+	t.Run("Trades", func(t *testing.T) {
+		ws := NewWebsocketClient(MainnetAPIURL)
+
+		var got []Trade
+		_, err := ws.Trades(TradesSubscriptionParams{Coin: "ETH"}, func(trades []Trade, err error) {
+			require.NoError(t, err)
+			got = trades
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, ws.dispatch(wsMessage{
+			Channel: ChannelTrades,
+			Data: []byte(`[{"coin":"ETH","side":"A","px":"2500.5","sz":"0.4","time":1700000000000,` +
+				`"hash":"0x01","tid":7,"users":["0xBuyer","0xseller"]}]`),
+		}))
+		require.Len(t, got, 1)
+		trade := got[0]
+		assert.Equal(t, 2500.5, trade.Price())
+		assert.Equal(t, 0.4, trade.Size())
+		assert.Equal(t, SideAsk, trade.TakerSide())
+		assert.Equal(t, "0xBuyer", trade.Buyer())
+		assert.Equal(t, "0xseller", trade.Seller())
+		assert.True(t, trade.Involves("0xbuyer"))
+		assert.False(t, trade.Involves("0xother"))
+	})
+
+	t.Run("Bbo", func(t *testing.T) {
+		ws := NewWebsocketClient(MainnetAPIURL)
+
+		var got []Bbo
+		_, err := ws.Bbo(BboSubscriptionParams{Coin: "BTC"}, func(bbo Bbo, err error) {
+			require.NoError(t, err)
+			got = append(got, bbo)
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, ws.dispatch(wsMessage{
+			Channel: ChannelBbo,
+			Data: []byte(`{"coin":"BTC","time":1700000000000,` +
+				`"bbo":[{"px":"99990","sz":"1.5","n":3},{"px":"100010","sz":"2","n":1}]}`),
+		}))
+		// empty ask side
+		require.NoError(t, ws.dispatch(wsMessage{
+			Channel: ChannelBbo,
+			Data:    []byte(`{"coin":"BTC","time":1700000000001,"bbo":[{"px":"99990","sz":"1.5","n":3},null]}`),
+		}))
+		// other coins are not delivered
+		require.NoError(t, ws.dispatch(wsMessage{
+			Channel: ChannelBbo,
+			Data:    []byte(`{"coin":"ETH","time":1700000000000,"bbo":[null,null]}`),
+		}))
+		require.Len(t, got, 2)
+
+		bid, ok := got[0].BestBid()
+		require.True(t, ok)
+		assert.Equal(t, Level{N: 3, Px: 99990, Sz: 1.5}, bid)
+		ask, ok := got[0].BestAsk()
+		require.True(t, ok)
+		assert.Equal(t, 100010.0, ask.Px)
+		mid, ok := got[0].Mid()
+		require.True(t, ok)
+		assert.Equal(t, 100000.0, mid)
+		spread, ok := got[0].SpreadBps()
+		require.True(t, ok)
+		assert.InDelta(t, 2.0, spread, 1e-9)
+
+		_, ok = got[1].BestAsk()
+		assert.False(t, ok)
+		_, ok = got[1].Mid()
+		assert.False(t, ok)
+		_, ok = got[1].SpreadBps()
+		assert.False(t, ok)
+	})
+
+	t.Run("PayloadTypeMismatch", func(t *testing.T) {
+		ws := NewWebsocketClient(MainnetAPIURL)
+
+		_, err := Subscribe(ws, ChannelUserFills, SubscriptionParams{User: "0xabc"},
+			func(WebData2, error) {})
+		assert.Error(t, err)
+
+		_, err = Subscribe(ws, ChannelPong, SubscriptionParams{}, func(any, error) {})
+		assert.Error(t, err)
+
+		_, err = Subscribe[WsOrderFills](ws, ChannelUserFills, SubscriptionParams{}, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("ReplayedOnConnect", func(t *testing.T) {
+		commands := make(chan wsCommand, 4)
+		upgrader := websocket.Upgrader{}
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+
+			var cmd wsCommand
+			if err := conn.ReadJSON(&cmd); err == nil {
+				commands <- cmd
+			}
+			time.Sleep(200 * time.Millisecond)
+		}))
+		t.Cleanup(srv.Close)
+
+		ws := NewWebsocketClient(MainnetAPIURL)
+		ws.url = "ws" + strings.TrimPrefix(srv.URL, "http")
+
+		// subscribed while disconnected, sent once the connection is up
+		_, err := Subscribe(ws, ChannelWebData2, SubscriptionParams{User: "0xabc"},
+			func(WebData2, error) {})
+		require.NoError(t, err)
+
+		require.NoError(t, ws.Connect(context.Background()))
+		t.Cleanup(func() { _ = ws.Close() })
+
+		select {
+		case cmd := <-commands:
+			assert.Equal(t, "subscribe", cmd.Method)
+			assert.Equal(t, map[string]any{"type": ChannelWebData2, "user": "0xabc"}, cmd.Subscription)
+		case <-time.After(2 * time.Second):
+			t.Fatal("subscription not replayed")
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package hyperliquid
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+)
+
+// WsOptCompression negotiates permessage-deflate with the server.
+// Large, repetitive streams such as webData2 compress very well.
+func WsOptCompression() WsOpt {
+	return func(w *WebsocketClient) {
+		w.compression = true
+	}
+}
+
+// WsTrafficStats holds received byte counters for a WebsocketClient.
+type WsTrafficStats struct {
+	// WireBytes is the number of bytes read from the network, i.e. after compression.
+	WireBytes uint64
+	// PayloadBytes is the number of message bytes after decompression.
+	PayloadBytes uint64
+}
+
+// CompressionRatio returns WireBytes/PayloadBytes, or 0 if nothing was received yet.
+func (s WsTrafficStats) CompressionRatio() float64 {
+	if s.PayloadBytes == 0 {
+		return 0
+	}
+	return float64(s.WireBytes) / float64(s.PayloadBytes)
+}
+
+// TrafficStats returns the bytes received since the client was created, across reconnects.
+func (w *WebsocketClient) TrafficStats() WsTrafficStats {
+	return WsTrafficStats{
+		WireBytes:    w.wireBytes.Load(),
+		PayloadBytes: w.payloadBytes.Load(),
+	}
+}
+
+// countingConn counts bytes read from the underlying connection.
+type countingConn struct {
+	net.Conn
+	n *atomic.Uint64
+}
+
+func (c *countingConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.n.Add(uint64(n))
+	return n, err
+}
+
+func (w *WebsocketClient) dialCounting(ctx context.Context, network, addr string) (net.Conn, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, network, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &countingConn{Conn: conn, n: &w.wireBytes}, nil
+}
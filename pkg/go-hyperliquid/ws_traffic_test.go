@@ -0,0 +1,45 @@
+package hyperliquid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebsocketClientCompression(t *testing.T) {
+	offered := make(chan string, 1)
+	upgrader := websocket.Upgrader{EnableCompression: true}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offered <- r.Header.Get("Sec-Websocket-Extensions")
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		msg := `{"channel":"pong","data":"` + strings.Repeat("a", 64*1024) + `"}`
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(msg))
+		time.Sleep(300 * time.Millisecond)
+	}))
+	t.Cleanup(srv.Close)
+
+	ws := NewWebsocketClient(MainnetAPIURL, WsOptCompression())
+	ws.url = "ws" + strings.TrimPrefix(srv.URL, "http")
+	require.NoError(t, ws.Connect(context.Background()))
+	t.Cleanup(func() { _ = ws.Close() })
+
+	require.Contains(t, <-offered, "permessage-deflate")
+	require.Eventually(t, func() bool {
+		return ws.TrafficStats().PayloadBytes > 64*1024
+	}, 2*time.Second, 10*time.Millisecond)
+
+	stats := ws.TrafficStats()
+	require.Greater(t, stats.WireBytes, uint64(0))
+	require.Less(t, stats.CompressionRatio(), 0.1)
+}
@@ -19,6 +19,8 @@ const (
 	ChannelBbo           string = "bbo"
 	ChannelSpotAssetCtxs string = "spotAssetCtxs"
 	ChannelSubResponse   string = "subscriptionResponse"
+
+	ChannelUserNonFundingLedgerUpdates string = "userNonFundingLedgerUpdates"
 )
 
 type wsMessage struct {
@@ -149,6 +151,40 @@ type (
 		Liquidation   *FillLiquidation `json:"liquidation,omitempty"`
 		FeeToken      string           `json:"feeToken"`             // the token the fee was paid in
 		BuilderFee    *string          `json:"builderFee,omitempty"` // amount paid to builder, also included in fee
+		Cloid         *string          `json:"cloid,omitempty"`      // client order id, present when the order was placed with one
+	}
+
+	// WsUserNonFundingLedgerUpdates carries a user's balance changes that are not
+	// trades or funding: deposits, withdrawals, transfers, vault flows and the like.
+	WsUserNonFundingLedgerUpdates struct {
+		IsSnapshot bool             `json:"isSnapshot"`
+		User       string           `json:"user"`
+		Updates    []WsLedgerUpdate `json:"nonFundingLedgerUpdates"`
+	}
+
+	WsLedgerUpdate struct {
+		Time  int64         `json:"time"`
+		Hash  string        `json:"hash"`
+		Delta WsLedgerDelta `json:"delta"`
+	}
+
+	// WsLedgerDelta is the union of all ledger delta kinds, discriminated by Type
+	// (deposit, withdraw, internalTransfer, subAccountTransfer, spotTransfer,
+	// accountClassTransfer, vaultDeposit, vaultWithdraw, ...). Fields that do not
+	// apply to a kind are left empty. Amounts are sent as strings or numbers.
+	WsLedgerDelta struct {
+		Type            string      `json:"type"`
+		Usdc            json.Number `json:"usdc,omitempty"`
+		Token           string      `json:"token,omitempty"`     // spotTransfer
+		Amount          json.Number `json:"amount,omitempty"`    // spotTransfer, in token units
+		UsdcValue       json.Number `json:"usdcValue,omitempty"` // spotTransfer
+		User            string      `json:"user,omitempty"`      // sender of transfers
+		Destination     string      `json:"destination,omitempty"`
+		Fee             json.Number `json:"fee,omitempty"`
+		Nonce           int64       `json:"nonce,omitempty"`  // withdraw
+		ToPerp          bool        `json:"toPerp,omitempty"` // accountClassTransfer
+		Vault           string      `json:"vault,omitempty"`  // vault deltas
+		NetWithdrawnUsd json.Number `json:"netWithdrawnUsd,omitempty"`
 	}
 
 	FillLiquidation struct {
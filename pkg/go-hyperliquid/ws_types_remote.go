@@ -99,6 +99,19 @@ func (p remoteOrderFillsSubscriptionPayload) Key() string {
 	return keyUserFills(p.User)
 }
 
+type remoteUserNonFundingLedgerUpdatesSubscriptionPayload struct {
+	Type string `json:"type"`
+	User string `json:"user"`
+}
+
+func (p remoteUserNonFundingLedgerUpdatesSubscriptionPayload) Channel() string {
+	return p.Type
+}
+
+func (p remoteUserNonFundingLedgerUpdatesSubscriptionPayload) Key() string {
+	return keyUserNonFundingLedgerUpdates(p.User)
+}
+
 type remoteWebData2SubscriptionPayload struct {
 	Type string `json:"type"`
 	User string `json:"user"`
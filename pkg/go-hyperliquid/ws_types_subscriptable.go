@@ -58,6 +58,10 @@ func (w WsOrderFills) Key() string {
 	return keyUserFills(w.User)
 }
 
+func (w WsUserNonFundingLedgerUpdates) Key() string {
+	return keyUserNonFundingLedgerUpdates(w.User)
+}
+
 func (w SpotAssetCtxs) Key() string {
 	return ChannelSpotAssetCtxs
 }
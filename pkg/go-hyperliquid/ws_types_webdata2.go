@@ -0,0 +1,144 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// WebData2 is skipped by the easyjson generator because WebData2Meta uses the
+// generic Tuple2. It is the largest message on the websocket (every asset
+// context of every dex), so it gets a hand-written decoder: all fields except
+// meta, leadingVaults and twapStates go through the generated easyjson code,
+// the rest fall back to encoding/json.
+
+var _ easyjson.Unmarshaler = (*WebData2)(nil)
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WebData2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	v.UnmarshalEasyJSON(&r)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WebData2) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "clearinghouseState":
+			v.ClearinghouseState = decodeEasyJSONPtr[ClearinghouseState](in)
+		case "leadingVaults":
+			in.AddError(json.Unmarshal(in.Raw(), &v.LeadingVaults))
+		case "totalVaultEquity":
+			v.TotalVaultEquity = in.String()
+		case "openOrders":
+			v.OpenOrders = decodeEasyJSONSlice[WsBasicOrder](in)
+		case "agentAddress":
+			if in.IsNull() {
+				in.Skip()
+				v.AgentAddress = nil
+			} else {
+				s := in.String()
+				v.AgentAddress = &s
+			}
+		case "agentValidUntil":
+			if in.IsNull() {
+				in.Skip()
+				v.AgentValidUntil = nil
+			} else {
+				n := in.Int64()
+				v.AgentValidUntil = &n
+			}
+		case "cumLedger":
+			v.CumLedger = in.String()
+		case "meta":
+			in.AddError(json.Unmarshal(in.Raw(), &v.Meta))
+		case "assetCtxs":
+			v.AssetCtxs = decodeEasyJSONSlice[AssetCtx](in)
+		case "serverTime":
+			v.ServerTime = in.Int64()
+		case "isVault":
+			v.IsVault = in.Bool()
+		case "user":
+			v.User = in.String()
+		case "twapStates":
+			in.AddError(json.Unmarshal(in.Raw(), &v.TwapStates))
+		case "spotState":
+			v.SpotState = decodeEasyJSONPtr[SpotState](in)
+		case "spotAssetCtxs":
+			v.SpotAssetCtxs = decodeEasyJSONSlice[SpotAssetCtx](in)
+		case "perpsAtOpenInterestCap":
+			if in.IsNull() {
+				in.Skip()
+				v.PerpsAtOpenInterestCap = nil
+			} else {
+				in.Delim('[')
+				v.PerpsAtOpenInterestCap = []string{}
+				for !in.IsDelim(']') {
+					v.PerpsAtOpenInterestCap = append(v.PerpsAtOpenInterestCap, in.String())
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+
+// decodeEasyJSONPtr decodes an optional object, returning nil for JSON null.
+func decodeEasyJSONPtr[T any, PT interface {
+	*T
+	easyjson.Unmarshaler
+}](in *jlexer.Lexer) *T {
+	if in.IsNull() {
+		in.Skip()
+		return nil
+	}
+	out := new(T)
+	PT(out).UnmarshalEasyJSON(in)
+	return out
+}
+
+// decodeEasyJSONSlice decodes an array, returning nil for JSON null and an
+// empty slice for [] like encoding/json.
+func decodeEasyJSONSlice[T any, PT interface {
+	*T
+	easyjson.Unmarshaler
+}](in *jlexer.Lexer) []T {
+	if in.IsNull() {
+		in.Skip()
+		return nil
+	}
+	in.Delim('[')
+	out := []T{}
+	for !in.IsDelim(']') {
+		var item T
+		if in.IsNull() {
+			in.Skip()
+		} else {
+			PT(&item).UnmarshalEasyJSON(in)
+		}
+		out = append(out, item)
+		in.WantComma()
+	}
+	in.Delim(']')
+	return out
+}
@@ -0,0 +1,104 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mailru/easyjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stdWebData2 has WebData2's fields without its UnmarshalJSON, so
+// encoding/json decodes it by reflection.
+type stdWebData2 WebData2
+
+// webData2Payload builds a webData2 message with the given number of perp
+// asset contexts (mainnet has a few hundred).
+func webData2Payload(assets int) []byte {
+	var b strings.Builder
+	b.WriteString(`{"clearinghouseState":{"marginSummary":{"accountValue":"10234.5","totalNtlPos":"5000.0","totalRawUsd":"15234.5","totalMarginUsed":"500.0"},` +
+		`"crossMarginSummary":{"accountValue":"10234.5","totalNtlPos":"5000.0","totalRawUsd":"15234.5","totalMarginUsed":"500.0"},` +
+		`"crossMaintenanceMarginUsed":"120.0","withdrawable":"9734.5",` +
+		`"assetPositions":[{"type":"oneWay","position":{"coin":"BTC","szi":"0.05","leverage":{"type":"cross","value":20},"entryPx":"100000.0","positionValue":"5000.0","unrealizedPnl":"12.5","returnOnEquity":"0.05","liquidationPx":null,"marginUsed":"250.0"}},` +
+		`{"type":"oneWay","position":{"coin":"ETH","szi":"-1.0","leverage":{"type":"isolated","value":5,"rawUsd":"4000.0"},"entryPx":"3500.0","positionValue":"3400.0","unrealizedPnl":"100.0","returnOnEquity":"0.14","liquidationPx":"4100.0","marginUsed":"700.0"}}],"time":1760000000000},`)
+	b.WriteString(`"leadingVaults":[],"totalVaultEquity":"0.0",`)
+	b.WriteString(`"openOrders":[{"coin":"BTC","side":"B","limitPx":"95000.0","sz":"0.01","oid":123,"timestamp":1760000000000,"origSz":"0.01","cloid":"0x00000000000000000000000000000001"},` +
+		`{"coin":"ETH","side":"A","limitPx":"3600.0","sz":"0.5","oid":124,"timestamp":1760000000001,"origSz":"1.0","cloid":null}],`)
+	b.WriteString(`"agentAddress":"0x0000000000000000000000000000000000000001","agentValidUntil":1760100000000,"cumLedger":"10000.0",`)
+	b.WriteString(`"meta":{"universe":[{"szDecimals":5,"name":"BTC","maxLeverage":40,"marginTableId":56},{"szDecimals":2,"name":"OLD","maxLeverage":3,"marginTableId":3,"isDelisted":true,"onlyIsolated":true}],` +
+		`"marginTables":[[56,{"description":"tiered 40x","marginTiers":[{"lowerBound":"0.0","maxLeverage":40},{"lowerBound":"150000000.0","maxLeverage":20}]}]]},`)
+	b.WriteString(`"assetCtxs":[`)
+	for i := 0; i < assets; i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, `{"funding":"0.0000125","openInterest":"%d.5","prevDayPx":"1.234","dayNtlVlm":"123456789.0","premium":"0.0001","oraclePx":"1.235","markPx":"1.236","midPx":"1.2355","impactPxs":["1.235","1.236"],"dayBaseVlm":"100000000.0"}`, i)
+	}
+	b.WriteString(`],"serverTime":1760000000500,"isVault":false,"user":"0x0000000000000000000000000000000000000002","twapStates":[[1,{"coin":"BTC","sz":"1.0"}]],`)
+	b.WriteString(`"spotState":{"balances":[{"coin":"USDC","token":0,"hold":"0.0","total":"1000.0","entryNtl":"0.0"},{"coin":"HYPE","token":150,"hold":"1.0","total":"10.0","entryNtl":"400.0"}]},`)
+	b.WriteString(`"spotAssetCtxs":[{"prevDayPx":"40.0","dayNtlVlm":"1000000.0","markPx":"41.0","midPx":null,"circulatingSupply":"1000.0","coin":"@107","totalSupply":"2000.0","dayBaseVlm":"25000.0"}],`)
+	b.WriteString(`"perpsAtOpenInterestCap":["OLD"],"unknownField":{"nested":[1,2,3]}}`)
+	return []byte(b.String())
+}
+
+func TestWebData2UnmarshalMatchesEncodingJSON(t *testing.T) {
+	data := webData2Payload(3)
+
+	var want stdWebData2
+	require.NoError(t, json.Unmarshal(data, &want))
+
+	var got WebData2
+	require.NoError(t, easyjson.Unmarshal(data, &got))
+	assert.Equal(t, WebData2(want), got)
+
+	// encoding/json picks up the easyjson decoder
+	var viaJSON WebData2
+	require.NoError(t, json.Unmarshal(data, &viaJSON))
+	assert.Equal(t, got, viaJSON)
+
+	require.NotNil(t, got.Meta)
+	require.Len(t, got.Meta.MarginTables, 1)
+	assert.Equal(t, 56, got.Meta.MarginTables[0].First)
+	assert.Len(t, got.TwapStates, 1)
+	assert.Nil(t, got.OpenOrders[1].Cloid)
+
+	// null and empty values
+	var empty WebData2
+	require.NoError(t, easyjson.Unmarshal([]byte(`{"clearinghouseState":null,"openOrders":[],"assetCtxs":null,"agentAddress":null}`), &empty))
+	assert.Nil(t, empty.ClearinghouseState)
+	assert.NotNil(t, empty.OpenOrders)
+	assert.Empty(t, empty.OpenOrders)
+	assert.Nil(t, empty.AssetCtxs)
+	assert.Nil(t, empty.AgentAddress)
+
+	assert.Error(t, easyjson.Unmarshal([]byte(`{"meta":{"universe":"x"}}`), &empty))
+	assert.Error(t, easyjson.Unmarshal([]byte(`{"user":`), &empty))
+}
+
+func BenchmarkWebData2Unmarshal(b *testing.B) {
+	data := webData2Payload(200)
+
+	b.Run("easyjson", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			var v WebData2
+			if err := easyjson.Unmarshal(data, &v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("encoding_json", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			var v stdWebData2
+			if err := json.Unmarshal(data, &v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,218 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+// DefaultStopTimeout 组件默认停止超时
+const DefaultStopTimeout = 5 * time.Second
+
+// Component 生命周期组件
+// 依赖组件先于本组件启动、晚于本组件停止
+type Component struct {
+	Name      string
+	DependsOn []string
+	Start     func(ctx context.Context) error // 可选，为空表示已在注册前启动
+	Stop      func(ctx context.Context) error // 可选
+	Timeout   time.Duration                   // 停止超时，为空使用 Manager 默认值
+}
+
+// Manager 生命周期管理器
+// 按依赖拓扑序启动组件，按相反顺序停止，每个组件的停止受超时控制
+type Manager struct {
+	mu          sync.Mutex
+	components  map[string]*Component
+	names       []string // 注册顺序，保证无依赖关系的组件顺序稳定
+	started     []*Component
+	stopTimeout time.Duration
+}
+
+// NewManager 创建生命周期管理器
+func NewManager(stopTimeout time.Duration) *Manager {
+	if stopTimeout <= 0 {
+		stopTimeout = DefaultStopTimeout
+	}
+	return &Manager{
+		components:  make(map[string]*Component),
+		stopTimeout: stopTimeout,
+	}
+}
+
+// Register 注册组件
+func (m *Manager) Register(c Component) error {
+	if c.Name == "" {
+		return errors.New("lifecycle: component name is empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.components[c.Name]; ok {
+		return fmt.Errorf("lifecycle: component %q already registered", c.Name)
+	}
+	m.components[c.Name] = &c
+	m.names = append(m.names, c.Name)
+	return nil
+}
+
+// MustRegister 注册组件，失败时 panic
+func (m *Manager) MustRegister(c Component) {
+	if err := m.Register(c); err != nil {
+		panic(err)
+	}
+}
+
+// Order 返回组件启动顺序（停止顺序与之相反）
+func (m *Manager) Order() ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	comps, err := m.sorted()
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(comps))
+	for i, c := range comps {
+		names[i] = c.Name
+	}
+	return names, nil
+}
+
+// Start 按依赖顺序启动尚未启动的组件，任一组件失败时停止已启动的组件并返回错误
+func (m *Manager) Start(ctx context.Context) error {
+	m.mu.Lock()
+	comps, err := m.sorted()
+	if err != nil {
+		m.mu.Unlock()
+		return err
+	}
+
+	isStarted := make(map[*Component]bool, len(m.started))
+	for _, c := range m.started {
+		isStarted[c] = true
+	}
+
+	for _, c := range comps {
+		if isStarted[c] {
+			continue
+		}
+		if c.Start != nil {
+			if err := c.Start(ctx); err != nil {
+				m.mu.Unlock()
+				_ = m.Stop(context.Background())
+				return fmt.Errorf("lifecycle: start %s: %w", c.Name, err)
+			}
+		}
+		m.started = append(m.started, c)
+		logger.Debug().Str("component", c.Name).Msg("component started")
+	}
+	m.mu.Unlock()
+	return nil
+}
+
+// Stop 按启动的相反顺序停止组件
+// 单个组件超时或失败不影响后续组件，所有错误合并返回
+func (m *Manager) Stop(ctx context.Context) error {
+	m.mu.Lock()
+	started := m.started
+	m.started = nil
+	m.mu.Unlock()
+
+	var errs []error
+	for i := len(started) - 1; i >= 0; i-- {
+		c := started[i]
+		if c.Stop == nil {
+			continue
+		}
+		if err := m.stopComponent(ctx, c); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// stopComponent 在超时控制下停止单个组件
+func (m *Manager) stopComponent(ctx context.Context, c *Component) error {
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = m.stopTimeout
+	}
+	stopCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	begin := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		done <- c.Stop(stopCtx)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			logger.Error().Err(err).Str("component", c.Name).Msg("component stop failed")
+			return fmt.Errorf("lifecycle: stop %s: %w", c.Name, err)
+		}
+		logger.Info().Str("component", c.Name).Dur("elapsed", time.Since(begin)).Msg("component stopped")
+		return nil
+	case <-stopCtx.Done():
+		logger.Warn().Str("component", c.Name).Dur("timeout", timeout).Msg("component stop timeout")
+		return fmt.Errorf("lifecycle: stop %s: %w", c.Name, stopCtx.Err())
+	}
+}
+
+// sorted 按依赖关系拓扑排序，调用方需持有锁
+func (m *Manager) sorted() ([]*Component, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int, len(m.components))
+	result := make([]*Component, 0, len(m.components))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		c := m.components[name]
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("lifecycle: dependency cycle: %v", append(path, name))
+		}
+
+		state[name] = visiting
+		for _, dep := range c.DependsOn {
+			if _, ok := m.components[dep]; !ok {
+				return fmt.Errorf("lifecycle: %s depends on unknown component %q", name, dep)
+			}
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		result = append(result, c)
+		return nil
+	}
+
+	for _, name := range m.names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Func 将无返回值的停止函数适配为组件回调
+func Func(fn func()) func(ctx context.Context) error {
+	return func(context.Context) error {
+		fn()
+		return nil
+	}
+}
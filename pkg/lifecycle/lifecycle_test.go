@@ -0,0 +1,127 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *recorder) add(event string) {
+	r.mu.Lock()
+	r.events = append(r.events, event)
+	r.mu.Unlock()
+}
+
+func (r *recorder) component(name string, deps ...string) Component {
+	return Component{
+		Name:      name,
+		DependsOn: deps,
+		Start:     func(context.Context) error { r.add("start " + name); return nil },
+		Stop:      func(context.Context) error { r.add("stop " + name); return nil },
+	}
+}
+
+func TestManager_DependencyOrder(t *testing.T) {
+	rec := &recorder{}
+	m := NewManager(time.Second)
+
+	// 注册顺序与依赖顺序相反
+	m.MustRegister(rec.component("loader", "subscription", "position"))
+	m.MustRegister(rec.component("subscription", "position", "writer"))
+	m.MustRegister(rec.component("position", "writer"))
+	m.MustRegister(rec.component("writer", "db"))
+	m.MustRegister(rec.component("db"))
+
+	order, err := m.Order()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"db", "writer", "position", "subscription", "loader"}, order)
+
+	require.NoError(t, m.Start(context.Background()))
+	require.NoError(t, m.Stop(context.Background()))
+
+	assert.Equal(t, []string{
+		"start db", "start writer", "start position", "start subscription", "start loader",
+		"stop loader", "stop subscription", "stop position", "stop writer", "stop db",
+	}, rec.events)
+}
+
+func TestManager_RegisterErrors(t *testing.T) {
+	m := NewManager(0)
+	assert.Error(t, m.Register(Component{}))
+	assert.NoError(t, m.Register(Component{Name: "a"}))
+	assert.Error(t, m.Register(Component{Name: "a"}))
+	assert.Panics(t, func() { m.MustRegister(Component{Name: "a"}) })
+}
+
+func TestManager_InvalidGraph(t *testing.T) {
+	m := NewManager(0)
+	m.MustRegister(Component{Name: "a", DependsOn: []string{"missing"}})
+	_, err := m.Order()
+	assert.ErrorContains(t, err, "unknown component")
+
+	m = NewManager(0)
+	m.MustRegister(Component{Name: "a", DependsOn: []string{"b"}})
+	m.MustRegister(Component{Name: "b", DependsOn: []string{"a"}})
+	_, err = m.Order()
+	assert.ErrorContains(t, err, "cycle")
+	assert.Error(t, m.Start(context.Background()))
+}
+
+func TestManager_StartFailureStopsStarted(t *testing.T) {
+	rec := &recorder{}
+	m := NewManager(time.Second)
+	m.MustRegister(rec.component("db"))
+	m.MustRegister(rec.component("writer", "db"))
+	m.MustRegister(Component{
+		Name:      "loader",
+		DependsOn: []string{"writer"},
+		Start:     func(context.Context) error { return errors.New("boom") },
+		Stop:      func(context.Context) error { rec.add("stop loader"); return nil },
+	})
+
+	err := m.Start(context.Background())
+	assert.ErrorContains(t, err, "start loader")
+	assert.Equal(t, []string{"start db", "start writer", "stop writer", "stop db"}, rec.events)
+}
+
+func TestManager_StopTimeoutContinues(t *testing.T) {
+	rec := &recorder{}
+	m := NewManager(time.Second)
+	m.MustRegister(rec.component("db"))
+	m.MustRegister(Component{
+		Name:      "slow",
+		DependsOn: []string{"db"},
+		Stop: func(ctx context.Context) error {
+			<-ctx.Done()
+			time.Sleep(50 * time.Millisecond)
+			return nil
+		},
+		Timeout: 20 * time.Millisecond,
+	})
+	m.MustRegister(Component{
+		Name:      "broken",
+		DependsOn: []string{"db"},
+		Stop:      func(context.Context) error { return errors.New("close failed") },
+	})
+
+	require.NoError(t, m.Start(context.Background()))
+	err := m.Stop(context.Background())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "close failed")
+
+	// 超时和失败的组件不影响依赖组件停止
+	assert.Equal(t, []string{"start db", "stop db"}, rec.events)
+
+	// 重复 Stop 不会再次停止
+	assert.NoError(t, m.Stop(context.Background()))
+}
@@ -11,20 +11,25 @@ var (
 )
 
 func setLogLevel(level string) {
+	base := zerolog.InfoLevel
 	switch level {
 	case DEBUG:
-		zerolog.SetGlobalLevel(zerolog.DebugLevel)
-	case INFO:
-		zerolog.SetGlobalLevel(zerolog.InfoLevel)
+		base = zerolog.DebugLevel
 	case WARN:
-		zerolog.SetGlobalLevel(zerolog.WarnLevel)
+		base = zerolog.WarnLevel
 	case ERROR:
-		zerolog.SetGlobalLevel(zerolog.ErrorLevel)
+		base = zerolog.ErrorLevel
 	case FATAL:
-		zerolog.SetGlobalLevel(zerolog.FatalLevel)
-	default:
-		zerolog.SetGlobalLevel(zerolog.InfoLevel)
+		base = zerolog.FatalLevel
 	}
+
+	levelMu.Lock()
+	defer levelMu.Unlock()
+	var modules map[string]zerolog.Level
+	if st := levels.Load(); st != nil {
+		modules = st.modules
+	}
+	storeLevels(base, modules)
 }
 
 // LevelFileEntry 定义单个日志级别文件配置
@@ -0,0 +1,166 @@
+package logger
+
+import (
+	"fmt"
+	"maps"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+)
+
+// levelState 全局级别与模块级别，运行时可修改
+// zerolog 全局级别设为其中最低的级别，高于模块级别的事件由 moduleLevelHook 丢弃
+type levelState struct {
+	base    zerolog.Level
+	modules map[string]zerolog.Level // 模块（调用方包名，如 processor、ws）-> 级别
+	highest zerolog.Level            // base 与所有模块级别中最高的级别，不低于它的事件无需判断模块
+}
+
+var (
+	levelMu sync.Mutex // 串行化级别修改
+	levels  atomic.Pointer[levelState]
+)
+
+// storeLevels 更新级别状态并同步 zerolog 全局级别
+func storeLevels(base zerolog.Level, modules map[string]zerolog.Level) {
+	lowest, highest := base, base
+	for _, l := range modules {
+		lowest = min(lowest, l)
+		highest = max(highest, l)
+	}
+	levels.Store(&levelState{base: base, modules: modules, highest: highest})
+	zerolog.SetGlobalLevel(lowest)
+}
+
+// parseLevelName 解析级别名称（trace / debug / info / warn / error / fatal / panic）
+func parseLevelName(level string) (zerolog.Level, error) {
+	l, err := zerolog.ParseLevel(strings.ToLower(strings.TrimSpace(level)))
+	if err != nil || l == zerolog.NoLevel || l == zerolog.Disabled {
+		return zerolog.NoLevel, fmt.Errorf("invalid log level %q", level)
+	}
+	return l, nil
+}
+
+// ValidateLevel 校验级别名称
+func ValidateLevel(level string) error {
+	_, err := parseLevelName(level)
+	return err
+}
+
+// SetLevel 运行时修改全局日志级别，保留已设置的模块级别
+func SetLevel(level string) error {
+	l, err := parseLevelName(level)
+	if err != nil {
+		return err
+	}
+
+	levelMu.Lock()
+	defer levelMu.Unlock()
+	var modules map[string]zerolog.Level
+	if st := levels.Load(); st != nil {
+		modules = st.modules
+	}
+	storeLevels(l, modules)
+	return nil
+}
+
+// SetModuleLevel 运行时设置模块的日志级别，覆盖全局级别；level 为空时删除该模块的设置
+// 模块为调用方所在包的名称，如 processor、manager、ws
+func SetModuleLevel(module, level string) error {
+	module = strings.TrimSpace(module)
+	if module == "" {
+		return fmt.Errorf("empty module name")
+	}
+	var l zerolog.Level
+	if level != "" {
+		var err error
+		if l, err = parseLevelName(level); err != nil {
+			return err
+		}
+	}
+
+	levelMu.Lock()
+	defer levelMu.Unlock()
+	base := zerolog.GlobalLevel()
+	modules := make(map[string]zerolog.Level)
+	if st := levels.Load(); st != nil {
+		base = st.base
+		maps.Copy(modules, st.modules)
+	}
+	if level == "" {
+		delete(modules, module)
+	} else {
+		modules[module] = l
+	}
+	storeLevels(base, modules)
+	return nil
+}
+
+// GetLevel 当前全局日志级别
+func GetLevel() string {
+	if st := levels.Load(); st != nil {
+		return st.base.String()
+	}
+	return zerolog.GlobalLevel().String()
+}
+
+// ModuleLevels 当前各模块的日志级别
+func ModuleLevels() map[string]string {
+	st := levels.Load()
+	if st == nil {
+		return map[string]string{}
+	}
+	result := make(map[string]string, len(st.modules))
+	for module, l := range st.modules {
+		result[module] = l.String()
+	}
+	return result
+}
+
+// moduleLevelHook 按调用方模块过滤事件，未设置模块级别时不做任何判断
+type moduleLevelHook struct{}
+
+func (moduleLevelHook) Run(e *zerolog.Event, level zerolog.Level, _ string) {
+	st := levels.Load()
+	if st == nil || len(st.modules) == 0 || level >= st.highest {
+		return
+	}
+	threshold := st.base
+	if l, ok := st.modules[callerModule()]; ok {
+		threshold = l
+	}
+	if level < threshold {
+		e.Discard()
+	}
+}
+
+// callerModule 跳过 zerolog 和本包的栈帧，返回调用方函数所在包的名称
+func callerModule() string {
+	var pcs [16]uintptr
+	n := runtime.Callers(3, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "github.com/rs/zerolog") &&
+			!strings.HasPrefix(frame.Function, "github.com/utrading/utrading-hl-monitor/pkg/logger.") {
+			return packageName(frame.Function)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// packageName 从完整函数名中取包名，如 github.com/x/internal/processor.(*OrderProcessor).flushOrder -> processor
+func packageName(function string) string {
+	if i := strings.LastIndex(function, "/"); i >= 0 {
+		function = function[i+1:]
+	}
+	if i := strings.Index(function, "."); i >= 0 {
+		function = function[:i]
+	}
+	return function
+}
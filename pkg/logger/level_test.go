@@ -0,0 +1,65 @@
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetLevel(t *testing.T) {
+	t.Cleanup(func() { setLogLevel(INFO); _ = SetModuleLevel("processor", "") })
+
+	require.NoError(t, SetLevel("warn"))
+	assert.Equal(t, "warn", GetLevel())
+	assert.Equal(t, zerolog.WarnLevel, zerolog.GlobalLevel())
+	assert.Error(t, SetLevel("verbose"))
+	assert.Error(t, SetLevel(""))
+
+	// 模块级别低于全局级别时，全局级别降为最低的级别
+	require.NoError(t, SetModuleLevel("processor", "debug"))
+	assert.Equal(t, zerolog.DebugLevel, zerolog.GlobalLevel())
+	assert.Equal(t, map[string]string{"processor": "debug"}, ModuleLevels())
+	assert.Error(t, SetModuleLevel("processor", "verbose"))
+	assert.Error(t, SetModuleLevel("", "debug"))
+
+	// 修改全局级别保留模块级别，删除模块级别后恢复
+	require.NoError(t, SetLevel("error"))
+	assert.Equal(t, zerolog.DebugLevel, zerolog.GlobalLevel())
+	require.NoError(t, SetModuleLevel("processor", ""))
+	assert.Equal(t, zerolog.ErrorLevel, zerolog.GlobalLevel())
+	assert.Empty(t, ModuleLevels())
+}
+
+func TestModuleLevelHook(t *testing.T) {
+	t.Cleanup(func() { setLogLevel(INFO); _ = SetModuleLevel("ws", ""); _ = SetModuleLevel("testing", "") })
+
+	var buf bytes.Buffer
+	l := zerolog.New(&buf).Hook(moduleLevelHook{})
+
+	// 测试函数由 testing 包调用，本包栈帧被跳过，模块为 testing
+	require.NoError(t, SetLevel("info"))
+	require.NoError(t, SetModuleLevel("ws", "debug"))
+	l.Debug().Msg("filtered")
+	assert.Empty(t, buf.String())
+
+	require.NoError(t, SetModuleLevel("testing", "debug"))
+	l.Debug().Msg("kept")
+	assert.Contains(t, buf.String(), "kept")
+
+	// 模块级别高于全局级别时丢弃该模块的低级别日志
+	buf.Reset()
+	require.NoError(t, SetModuleLevel("testing", "error"))
+	l.Warn().Msg("filtered")
+	l.Error().Msg("kept")
+	assert.NotContains(t, buf.String(), "filtered")
+	assert.Contains(t, buf.String(), "kept")
+}
+
+func TestPackageName(t *testing.T) {
+	assert.Equal(t, "processor", packageName("github.com/utrading/utrading-hl-monitor/internal/processor.(*OrderProcessor).flushOrder"))
+	assert.Equal(t, "main", packageName("main.main"))
+	assert.Equal(t, "go-hyperliquid", packageName("github.com/sonirico/go-hyperliquid.(*WebsocketClient).readPump"))
+}
@@ -99,7 +99,7 @@ func setWriter(config Config) {
 	cleanupOldWriters()
 	lumberjackWriters = newLumberjackWriters
 	multiLevelWriter = zerolog.MultiLevelWriter(newWriters...)
-	log.Logger = zerolog.New(multiLevelWriter).With().Timestamp().Caller().Logger()
+	log.Logger = zerolog.New(multiLevelWriter).Hook(moduleLevelHook{}).With().Timestamp().Caller().Logger()
 }
 
 // cleanupOldWriters 清理旧的 writers
@@ -0,0 +1,171 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AWSCredentials AWS 访问凭据
+type AWSCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string // 临时凭据（STS）时非空
+}
+
+// AWSCredentialsFromEnv 从标准环境变量读取凭据
+func AWSCredentialsFromEnv() AWSCredentials {
+	return AWSCredentials{
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+}
+
+// AWS AWS Secrets Manager 读取器（GetSecretValue，SigV4 签名）
+type AWS struct {
+	region   string
+	endpoint string
+	creds    AWSCredentials
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewAWS 创建 AWS Secrets Manager 读取器，endpoint 为空时使用区域默认地址
+func NewAWS(region, endpoint string, creds AWSCredentials) *AWS {
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://secretsmanager.%s.amazonaws.com", region)
+	}
+	return &AWS{
+		region:   region,
+		endpoint: strings.TrimRight(endpoint, "/"),
+		creds:    creds,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		now:      time.Now,
+	}
+}
+
+// Fetch 读取密钥，带 #key 时将 SecretString 解析为 JSON 并取出字段
+func (a *AWS) Fetch(ctx context.Context, ref string) (string, error) {
+	path, key, err := splitRef(ref)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(map[string]string{"SecretId": path})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	SignV4(req, sha256Hex(body), "secretsmanager", a.region, a.creds, a.now())
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("secrets: aws request %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("secrets: aws %s: status %d", path, resp.StatusCode)
+	}
+
+	var result struct {
+		SecretString string `json:"SecretString"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return "", fmt.Errorf("secrets: decode aws response %s: %w", path, err)
+	}
+	if key == "" {
+		return result.SecretString, nil
+	}
+
+	var data map[string]any
+	if err := json.Unmarshal([]byte(result.SecretString), &data); err != nil {
+		return "", fmt.Errorf("secrets: aws secret %s is not a JSON object: %w", path, err)
+	}
+	return pickKey(data, path, key)
+}
+
+// SignV4 按 AWS Signature Version 4 为请求签名，签名范围为请求已设置的全部头部及 host
+// payloadHash 为请求体 SHA-256 的十六进制编码（S3 要求同时设置 X-Amz-Content-Sha256 头部）
+func SignV4(req *http.Request, payloadHash string, service, region string, creds AWSCredentials, t time.Time) {
+	t = t.UTC()
+	amzDate := t.Format("20060102T150405Z")
+	date := t.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	uri := req.URL.EscapedPath()
+	if uri == "" {
+		uri = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uri,
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+creds.SecretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		creds.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,43 @@
+// Package secrets 从外部密钥管理服务读取敏感配置（数据库 DSN、NATS 凭据等），
+// 避免明文写入配置文件
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Fetcher 密钥读取接口
+// ref 格式为 <path>#<key>：path 为密钥路径（Vault KV 路径或 AWS SecretId），
+// key 为密钥内 JSON 字段名，省略时返回整个密钥值（仅 AWS 支持）
+type Fetcher interface {
+	Fetch(ctx context.Context, ref string) (string, error)
+}
+
+// splitRef 拆分密钥引用为路径和字段名
+func splitRef(ref string) (path, key string, err error) {
+	path, key, _ = strings.Cut(ref, "#")
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return "", "", fmt.Errorf("secrets: empty path in ref %q", ref)
+	}
+	return path, key, nil
+}
+
+// pickKey 从 JSON 对象中取出字段，非字符串值按 JSON 文本返回
+func pickKey(data map[string]any, path, key string) (string, error) {
+	value, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("secrets: key %q not found in %s", key, path)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
@@ -0,0 +1,74 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Vault HashiCorp Vault KV v2 读取器
+type Vault struct {
+	address string
+	token   string
+	mount   string
+	client  *http.Client
+}
+
+// NewVault 创建 Vault 读取器，mount 为空时使用 secret
+func NewVault(address, token, mount string) *Vault {
+	if mount == "" {
+		mount = "secret"
+	}
+	return &Vault{
+		address: strings.TrimRight(address, "/"),
+		token:   token,
+		mount:   strings.Trim(mount, "/"),
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Fetch 读取 KV v2 密钥的指定字段
+func (v *Vault) Fetch(ctx context.Context, ref string) (string, error) {
+	path, key, err := splitRef(ref)
+	if err != nil {
+		return "", err
+	}
+	if key == "" {
+		return "", fmt.Errorf("secrets: vault ref %q requires #key", ref)
+	}
+
+	url := fmt.Sprintf("%s/v1/%s/data/%s", v.address, v.mount, path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("secrets: vault request %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("secrets: vault %s: status %d", path, resp.StatusCode)
+	}
+
+	var result struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("secrets: decode vault response %s: %w", path, err)
+	}
+	return pickKey(result.Data.Data, path, key)
+}
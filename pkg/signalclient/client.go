@@ -0,0 +1,231 @@
+package signalclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/utrading/utrading-hl-monitor/pkg/logger"
+)
+
+const (
+	defaultDedupTTL  = 10 * time.Minute
+	defaultDedupSize = 100000
+
+	defaultReplayBatch = 256
+	replayFetchWait    = 2 * time.Second
+)
+
+// Handler 信号处理函数，返回错误时该信号不记为已处理（JetStream 订阅会重新投递）
+type Handler func(s *Signal) error
+
+// Options 客户端配置
+type Options struct {
+	Subject   string        // 订阅主题，默认 SubjectSignals，可用 GroupSubject/GroupWildcard 订阅分组主题
+	Queue     string        // 队列组，多个实例共同消费时设置，为空时每个实例收到全部信号
+	Scopes    []string      // 只处理这些去重作用域的信号，为空时处理全部
+	DedupTTL  time.Duration // 幂等键保留时长，默认 10m，小于 0 时不去重
+	DedupSize int           // 最多保留的幂等键数，默认 100000
+
+	// ErrorHandler 消息解码或处理失败时调用（可选），默认记录日志
+	ErrorHandler func(subject string, err error)
+}
+
+// JetStreamOptions JetStream 订阅与回放配置，需有 stream 捕获订阅主题
+type JetStreamOptions struct {
+	Stream    string        // stream 名称
+	Durable   string        // 持久化消费者名称，为空时使用临时消费者（回放始终使用临时消费者）
+	StartTime time.Time     // 从该时间起投递，与 StartSeq 都为零时投递全部
+	StartSeq  uint64        // 从该序号起投递
+	AckWait   time.Duration // 未确认时重新投递的等待时间，默认使用服务端配置
+	Batch     int           // 回放每次拉取的消息数，默认 256
+}
+
+// Client 信号订阅客户端
+type Client struct {
+	conn   *nats.Conn
+	opts   Options
+	scopes map[string]struct{}
+	dedup  *dedup
+}
+
+// New 创建信号订阅客户端，conn 由调用方管理
+func New(conn *nats.Conn, opts Options) *Client {
+	if opts.Subject == "" {
+		opts.Subject = SubjectSignals
+	}
+	if opts.DedupTTL == 0 {
+		opts.DedupTTL = defaultDedupTTL
+	}
+	if opts.DedupSize <= 0 {
+		opts.DedupSize = defaultDedupSize
+	}
+
+	c := &Client{conn: conn, opts: opts}
+	if len(opts.Scopes) > 0 {
+		c.scopes = make(map[string]struct{}, len(opts.Scopes))
+		for _, scope := range opts.Scopes {
+			c.scopes[scope] = struct{}{}
+		}
+	}
+	if opts.DedupTTL > 0 {
+		c.dedup = newDedup(opts.DedupTTL, opts.DedupSize)
+	}
+	return c
+}
+
+// Subscribe 通过 NATS core 订阅实时信号，连接断开期间的信号会丢失
+func (c *Client) Subscribe(handler Handler) (*nats.Subscription, error) {
+	cb := func(msg *nats.Msg) {
+		if _, err := c.handle(msg.Data, handler); err != nil {
+			c.reportError(msg.Subject, err)
+		}
+	}
+	if c.opts.Queue != "" {
+		return c.conn.QueueSubscribe(c.opts.Subject, c.opts.Queue, cb)
+	}
+	return c.conn.Subscribe(c.opts.Subject, cb)
+}
+
+// SubscribeJetStream 通过 JetStream 订阅信号，处理成功后确认，处理失败时重新投递，无法解码的消息不再投递
+func (c *Client) SubscribeJetStream(opts JetStreamOptions, handler Handler) (*nats.Subscription, error) {
+	js, err := c.conn.JetStream()
+	if err != nil {
+		return nil, fmt.Errorf("init jetstream failed: %w", err)
+	}
+
+	subOpts := append(jetStreamSubOpts(opts), nats.ManualAck())
+	if opts.Durable != "" {
+		subOpts = append(subOpts, nats.Durable(opts.Durable))
+	}
+	cb := func(msg *nats.Msg) {
+		if _, err := c.handle(msg.Data, handler); err != nil {
+			c.reportError(msg.Subject, err)
+			if errors.Is(err, errDecode) {
+				_ = msg.Term()
+			} else {
+				_ = msg.Nak()
+			}
+			return
+		}
+		_ = msg.Ack()
+	}
+
+	if c.opts.Queue != "" {
+		return js.QueueSubscribe(c.opts.Subject, c.opts.Queue, cb, subOpts...)
+	}
+	return js.Subscribe(c.opts.Subject, cb, subOpts...)
+}
+
+// Replay 从 JetStream 回放历史信号，投递到回放开始时的最后一条后返回处理的信号数
+// 回放同样经过作用域过滤与去重，处理失败时停止回放并返回错误
+func (c *Client) Replay(ctx context.Context, opts JetStreamOptions, handler Handler) (int, error) {
+	js, err := c.conn.JetStream()
+	if err != nil {
+		return 0, fmt.Errorf("init jetstream failed: %w", err)
+	}
+	batch := opts.Batch
+	if batch <= 0 {
+		batch = defaultReplayBatch
+	}
+
+	sub, err := js.PullSubscribe(c.opts.Subject, "", jetStreamSubOpts(opts)...)
+	if err != nil {
+		return 0, fmt.Errorf("create replay consumer failed: %w", err)
+	}
+	defer func() { _ = sub.Unsubscribe() }()
+
+	handled := 0
+	for {
+		if err = ctx.Err(); err != nil {
+			return handled, err
+		}
+
+		msgs, err := sub.Fetch(batch, nats.MaxWait(replayFetchWait))
+		if errors.Is(err, nats.ErrTimeout) {
+			return handled, nil
+		}
+		if err != nil {
+			return handled, fmt.Errorf("fetch replay messages failed: %w", err)
+		}
+
+		done := false
+		for _, msg := range msgs {
+			ok, err := c.handle(msg.Data, handler)
+			if errors.Is(err, errDecode) {
+				c.reportError(msg.Subject, err)
+			} else if err != nil {
+				return handled, err
+			}
+			if ok {
+				handled++
+			}
+			_ = msg.Ack()
+
+			if meta, err := msg.Metadata(); err == nil && meta.NumPending == 0 {
+				done = true
+			}
+		}
+		if done {
+			return handled, nil
+		}
+	}
+}
+
+var errDecode = errors.New("decode signal failed")
+
+// handle 解码并处理一条信号，返回是否调用了 handler
+// 作用域不匹配或幂等键在去重窗口内的信号直接跳过
+func (c *Client) handle(data []byte, handler Handler) (bool, error) {
+	s, err := Decode(data)
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", errDecode, err)
+	}
+	if c.scopes != nil {
+		if _, ok := c.scopes[s.Scope]; !ok {
+			return false, nil
+		}
+	}
+	if c.dedup != nil && s.IdempotencyKey != "" && c.dedup.Seen(s.IdempotencyKey) {
+		return false, nil
+	}
+
+	if err = handler(s); err != nil {
+		return true, err
+	}
+	if c.dedup != nil && s.IdempotencyKey != "" {
+		c.dedup.Mark(s.IdempotencyKey)
+	}
+	return true, nil
+}
+
+func (c *Client) reportError(subject string, err error) {
+	if c.opts.ErrorHandler != nil {
+		c.opts.ErrorHandler(subject, err)
+		return
+	}
+	logger.Warn().Err(err).Str("subject", subject).Msg("handle hl signal failed")
+}
+
+// jetStreamSubOpts 绑定 stream 与起始位置的订阅选项
+func jetStreamSubOpts(opts JetStreamOptions) []nats.SubOpt {
+	subOpts := []nats.SubOpt{nats.AckExplicit()}
+	if opts.Stream != "" {
+		subOpts = append(subOpts, nats.BindStream(opts.Stream))
+	}
+	switch {
+	case opts.StartSeq > 0:
+		subOpts = append(subOpts, nats.StartSequence(opts.StartSeq))
+	case !opts.StartTime.IsZero():
+		subOpts = append(subOpts, nats.StartTime(opts.StartTime))
+	default:
+		subOpts = append(subOpts, nats.DeliverAll())
+	}
+	if opts.AckWait > 0 {
+		subOpts = append(subOpts, nats.AckWait(opts.AckWait))
+	}
+	return subOpts
+}
@@ -0,0 +1,155 @@
+package signalclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hlnats "github.com/utrading/utrading-hl-monitor/internal/nats"
+)
+
+func TestDecode_MatchesPublishedSignal(t *testing.T) {
+	published := &hlnats.HlAddressSignal{
+		Address:                 "0xabc",
+		AssetType:               "futures",
+		Symbol:                  "BTCUSDC",
+		Dex:                     "xyz",
+		CoinType:                "A",
+		Direction:               "open",
+		Side:                    "LONG",
+		RawDir:                  "Open Long",
+		PositionRate:            15.5,
+		CloseRate:               0.2,
+		Size:                    1.5,
+		Price:                   65000,
+		Timestamp:               1700000000000,
+		Scope:                   "copy",
+		MidPx:                   64990,
+		SlippageBps:             1.5,
+		NotionalUSD:             97500,
+		QuoteCurrency:           "USDT",
+		NotionalQuote:           97480,
+		AddressLabel:            "whale",
+		DayNtlVlm:               1e9,
+		OpenInterest:            2000,
+		Funding:                 0.0001,
+		PositionRateBasis:       "account_value",
+		PositionRateDenominator: 629032,
+		BalanceStale:            true,
+		Severity:                "critical",
+		Cloid:                   "0x01",
+		Oids:                    []int64{1, 2},
+		IsPartial:               true,
+		FillPercent:             50,
+		Extra:                   map[string]string{"k": "v"},
+		IdempotencyKey:          "key",
+		TraceID:                 "trace",
+	}
+	data, err := json.Marshal(published)
+	require.NoError(t, err)
+
+	// 发布端新增字段时需同步 Signal
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var s Signal
+	require.NoError(t, dec.Decode(&s))
+
+	again, err := json.Marshal(&s)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(again))
+	assert.True(t, s.IsOpen())
+	assert.False(t, s.IsSpot())
+	assert.Equal(t, int64(1700000000000), s.Time().UnixMilli())
+}
+
+func TestClient_Handle(t *testing.T) {
+	c := New(nil, Options{Scopes: []string{"", "copy"}})
+	var got []string
+	fail := false
+	handler := func(s *Signal) error {
+		if fail {
+			return errors.New("boom")
+		}
+		got = append(got, s.IdempotencyKey)
+		return nil
+	}
+
+	msg := func(key, scope string) []byte {
+		data, _ := json.Marshal(&Signal{IdempotencyKey: key, Scope: scope})
+		return data
+	}
+
+	ok, err := c.handle(msg("a", ""), handler)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+
+	// 重复投递跳过
+	ok, err = c.handle(msg("a", ""), handler)
+	assert.False(t, ok)
+	assert.NoError(t, err)
+
+	// 作用域不匹配跳过
+	ok, _ = c.handle(msg("b", "other"), handler)
+	assert.False(t, ok)
+
+	// 处理失败不记为已处理，重新投递时再次处理
+	fail = true
+	ok, err = c.handle(msg("c", "copy"), handler)
+	assert.True(t, ok)
+	assert.Error(t, err)
+	fail = false
+	ok, err = c.handle(msg("c", "copy"), handler)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+
+	_, err = c.handle([]byte("{"), handler)
+	assert.ErrorIs(t, err, errDecode)
+
+	assert.Equal(t, []string{"a", "c"}, got)
+}
+
+func TestClient_DedupDisabled(t *testing.T) {
+	c := New(nil, Options{DedupTTL: -1})
+	calls := 0
+	data, _ := json.Marshal(&Signal{IdempotencyKey: "a"})
+	for i := 0; i < 2; i++ {
+		_, err := c.handle(data, func(*Signal) error { calls++; return nil })
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, SubjectSignals, c.opts.Subject)
+}
+
+func TestDedup_TTLAndSize(t *testing.T) {
+	d := newDedup(time.Minute, 2)
+	now := time.Now()
+	d.now = func() time.Time { return now }
+
+	d.Mark("a")
+	d.Mark("b")
+	assert.True(t, d.Seen("a"))
+
+	// 超过容量淘汰最早的记录
+	d.Mark("c")
+	assert.False(t, d.Seen("a"))
+	assert.True(t, d.Seen("b"))
+	assert.Equal(t, 2, d.Len())
+
+	// 超过 ttl 过期
+	now = now.Add(2 * time.Minute)
+	assert.False(t, d.Seen("c"))
+	assert.Equal(t, 0, d.Len())
+}
+
+func TestSubjects(t *testing.T) {
+	assert.Equal(t, "hl.signal.whales.BTCUSDC", GroupSubject("whales", "BTCUSDC"))
+	assert.Equal(t, "hl.signal.a_b_c.x_y", GroupSubject("a.b*c", "x>y"))
+	assert.Equal(t, "hl.signal._._", GroupSubject("", ""))
+	assert.Equal(t, "hl.signal.whales.>", GroupWildcard("whales"))
+	assert.Equal(t, "hl.signal.*.ETHUSDC", SymbolWildcard("ETHUSDC"))
+}
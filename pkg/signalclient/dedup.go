@@ -0,0 +1,81 @@
+package signalclient
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// dedup 按幂等键去重，记录保留 ttl，超过 size 时淘汰最早的记录
+type dedup struct {
+	mu    sync.Mutex
+	ttl   time.Duration
+	size  int
+	items map[string]*list.Element
+	order *list.List // 按写入时间排序，Front 最早
+
+	now func() time.Time
+}
+
+type dedupEntry struct {
+	key string
+	at  time.Time
+}
+
+func newDedup(ttl time.Duration, size int) *dedup {
+	return &dedup{
+		ttl:   ttl,
+		size:  size,
+		items: make(map[string]*list.Element),
+		order: list.New(),
+		now:   time.Now,
+	}
+}
+
+// Seen 幂等键是否已处理过（ttl 内）
+func (d *dedup) Seen(key string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.expireLocked()
+	_, ok := d.items[key]
+	return ok
+}
+
+// Mark 记录幂等键已处理
+func (d *dedup) Mark(key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if e, ok := d.items[key]; ok {
+		e.Value.(*dedupEntry).at = d.now()
+		d.order.MoveToBack(e)
+		return
+	}
+	d.items[key] = d.order.PushBack(&dedupEntry{key: key, at: d.now()})
+	for d.size > 0 && d.order.Len() > d.size {
+		d.removeLocked(d.order.Front())
+	}
+}
+
+// Len 当前记录数
+func (d *dedup) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.order.Len()
+}
+
+func (d *dedup) expireLocked() {
+	deadline := d.now().Add(-d.ttl)
+	for e := d.order.Front(); e != nil; e = d.order.Front() {
+		if e.Value.(*dedupEntry).at.After(deadline) {
+			return
+		}
+		d.removeLocked(e)
+	}
+}
+
+func (d *dedup) removeLocked(e *list.Element) {
+	d.order.Remove(e)
+	delete(d.items, e.Value.(*dedupEntry).key)
+}
@@ -0,0 +1,149 @@
+// Package signalclient hl_monitor 信号的 Go 消费端
+//
+// 订阅 NATS 上的地址信号并解码为 Signal，按 idempotency_key 去重，
+// 支持从 JetStream 回放历史信号（需有 stream 捕获信号主题）：
+//
+//	client := signalclient.New(conn, signalclient.Options{Queue: "copy-trader"})
+//	sub, err := client.Subscribe(func(s *signalclient.Signal) error {
+//		return follow(s)
+//	})
+package signalclient
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// 信号主题
+const (
+	SubjectSignals  = "hl_address_signal"          // 默认主题，全部信号
+	SubjectCritical = "hl_address_signal.critical" // critical 信号额外发布的主题（[severity] critical_subject 默认值）
+
+	// DefaultGroupTemplate 地址分组主题的默认模板（[address_groups] default_template）
+	DefaultGroupTemplate = "hl.signal.{group}.{symbol}"
+)
+
+// 信号取值
+const (
+	DirectionOpen  = "open"
+	DirectionClose = "close"
+
+	SideLong  = "LONG"
+	SideShort = "SHORT"
+
+	AssetTypeFutures = "futures"
+	AssetTypeSpot    = "spot"
+
+	SeverityInfo     = "info"
+	SeverityNotable  = "notable"
+	SeverityCritical = "critical"
+
+	MarginModeCross    = "cross"
+	MarginModeIsolated = "isolated"
+)
+
+// Signal 地址信号，与 hl_monitor 发布的消息体一致
+type Signal struct {
+	Address      string  `json:"address"`         // 监控地址
+	AssetType    string  `json:"asset_type"`      // spot/futures
+	Symbol       string  `json:"symbol"`          // 交易对
+	Dex          string  `json:"dex,omitempty"`   // 合约所属 builder dex（如 xyz），主 dex 与现货为空
+	CoinType     string  `json:"coin_type"`       // 币种类型: A/B/C/D
+	Direction    string  `json:"direction"`       // open/close
+	Side         string  `json:"side"`            // LONG/SHORT
+	RawDir       string  `json:"raw_dir"`         // Hyperliquid 原始成交方向: Open Long/Close Short/Buy/Sell 等
+	PositionRate float64 `json:"position_rate"`   // 仓位比例: 百分比，如 15.50%
+	CloseRate    float64 `json:"close_rate"`      // 平仓比例: 平仓数量/当前仓位
+	Size         float64 `json:"size"`            // 数量
+	Price        float64 `json:"price"`           // 价格
+	Timestamp    int64   `json:"timestamp"`       // 首笔成交时间（毫秒）
+	Scope        string  `json:"scope,omitempty"` // 去重作用域（逻辑消费者），默认为空
+
+	MidPx       float64 `json:"mid_px,omitempty"`       // 首笔成交时的中间价/标记价
+	SlippageBps float64 `json:"slippage_bps,omitempty"` // 成交均价相对中间价的滑点(bp)，正数表示劣于中间价
+
+	NotionalUSD   float64 `json:"notional_usd,omitempty"`   // 成交名义价值(USD)
+	QuoteCurrency string  `json:"quote_currency,omitempty"` // 计价货币
+	NotionalQuote float64 `json:"notional_quote,omitempty"` // 成交名义价值(计价货币)
+
+	AddressLabel string `json:"address_label,omitempty"` // 地址标签
+
+	DayNtlVlm    float64 `json:"day_ntl_vlm,omitempty"`   // 合约 24 小时成交额(USD)
+	OpenInterest float64 `json:"open_interest,omitempty"` // 合约未平仓量
+	Funding      float64 `json:"funding,omitempty"`       // 合约当前资金费率
+
+	Leverage   int    `json:"leverage,omitempty"`    // 交易者在该合约上的杠杆倍数，未知时为 0
+	MarginMode string `json:"margin_mode,omitempty"` // 保证金模式: cross/isolated，未知时为空
+
+	PositionRateBasis       string  `json:"position_rate_basis,omitempty"`       // 仓位比例分母
+	PositionRateDenominator float64 `json:"position_rate_denominator,omitempty"` // 分母金额(USD)
+	BalanceStale            bool    `json:"balance_stale,omitempty"`             // 余额过期，仓位比例可能不准确
+
+	Severity string `json:"severity,omitempty"` // 信号级别: info/notable/critical
+
+	Cloid string  `json:"cloid,omitempty"` // 客户端订单 ID
+	Oids  []int64 `json:"oids,omitempty"`  // 按 cloid 聚合时包含的全部订单 ID
+
+	IsPartial   bool    `json:"is_partial,omitempty"`   // 进度信号，size/price 为截至此时的累计成交，订单完成后仍有完整信号
+	FillPercent float64 `json:"fill_percent,omitempty"` // 进度信号的累计成交占订单原始数量的百分比
+
+	Extra map[string]string `json:"extra,omitempty"` // 信号钩子附加的扩展字段
+
+	IdempotencyKey string `json:"idempotency_key"` // 幂等键，重复投递时不变
+	TraceID        string `json:"trace_id"`        // 追踪 ID
+}
+
+// Decode 解码信号消息体
+func Decode(data []byte) (*Signal, error) {
+	var s Signal
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Time 返回首笔成交时间
+func (s *Signal) Time() time.Time {
+	return time.UnixMilli(s.Timestamp)
+}
+
+// IsOpen 是否为开仓信号
+func (s *Signal) IsOpen() bool {
+	return s.Direction == DirectionOpen
+}
+
+// IsSpot 是否为现货信号
+func (s *Signal) IsSpot() bool {
+	return s.AssetType == AssetTypeSpot
+}
+
+// GroupSubject 按默认模板返回分组主题，如 GroupSubject("whales", "BTCUSDC") = "hl.signal.whales.BTCUSDC"
+func GroupSubject(group, symbol string) string {
+	return "hl.signal." + SubjectToken(group) + "." + SubjectToken(symbol)
+}
+
+// GroupWildcard 按默认模板返回分组全部信号的通配主题，如 "hl.signal.whales.>"
+func GroupWildcard(group string) string {
+	return "hl.signal." + SubjectToken(group) + ".>"
+}
+
+// SymbolWildcard 按默认模板返回所有分组中某交易对信号的通配主题，如 "hl.signal.*.BTCUSDC"
+func SymbolWildcard(symbol string) string {
+	return "hl.signal.*." + SubjectToken(symbol)
+}
+
+// SubjectToken 将取值转换为单个主题片段，规则与 hl_monitor 渲染分组主题一致：
+// 主题分隔符、通配符和空白替换为 "_"，空值为 "_"
+func SubjectToken(s string) string {
+	if s == "" {
+		return "_"
+	}
+	return strings.Map(func(c rune) rune {
+		switch c {
+		case '.', '*', '>', ' ', '\t', '\r', '\n':
+			return '_'
+		}
+		return c
+	}, s)
+}